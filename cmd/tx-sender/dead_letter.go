@@ -0,0 +1,153 @@
+package txsender
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/consensys/orchestrate/pkg/broker"
+	pkgsarama "github.com/consensys/orchestrate/pkg/broker/sarama"
+	encoding "github.com/consensys/orchestrate/pkg/encoding/proto"
+	"github.com/consensys/orchestrate/pkg/errors"
+	orchestrateclient "github.com/consensys/orchestrate/pkg/sdk/client"
+	authkey "github.com/consensys/orchestrate/pkg/toolkit/app/auth/key"
+	"github.com/consensys/orchestrate/pkg/types/tx"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/services/tx-sender/deadletter"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func newDeadLetterCommand() *cobra.Command {
+	var client sarama.Client
+	var producer sarama.SyncProducer
+	filter := &deadletter.Filter{}
+
+	deadLetterCmd := &cobra.Command{
+		Use:   "dead-letter",
+		Short: "Inspect and replay the messages moved to the dead-letter topic",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			utils.PreRunBindFlags(viper.GetViper(), cmd.Flags(), "tx-sender")
+
+			cfg, err := pkgsarama.NewSaramaConfig()
+			if err != nil {
+				return err
+			}
+
+			// Jobs are fetched with the API key so that entries of every tenant can be checked before being replayed
+			orchestrateclient.Init()
+
+			client, err = pkgsarama.NewClient(viper.GetStringSlice(pkgsarama.KafkaURLViperKey), cfg)
+			if err != nil {
+				return err
+			}
+
+			producer, err = pkgsarama.NewSyncProducerFromClient(client)
+			return err
+		},
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			if producer != nil {
+				_ = producer.Close()
+			}
+			if client == nil {
+				return
+			}
+			if err := client.Close(); err != nil {
+				log.WithError(err).Warn("could not close Kafka client")
+			}
+		},
+	}
+
+	// Kafka flags
+	pkgsarama.KafkaProducerFlags(deadLetterCmd.PersistentFlags())
+	pkgsarama.KafkaTopicTxDeadLetter(deadLetterCmd.PersistentFlags())
+	orchestrateclient.Flags(deadLetterCmd.PersistentFlags())
+	authkey.Flags(deadLetterCmd.PersistentFlags())
+	deadLetterCmd.PersistentFlags().Int32Var(&filter.Partition, "partition", -1, "Partition of the dead-letter topic (all partitions if negative)")
+	deadLetterCmd.PersistentFlags().Int64Var(&filter.Offset, "offset", -1, "Offset of the entry in the dead-letter topic partition (all entries if negative)")
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List dead-letter entries",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inspector := deadletter.NewInspector(client, producer, orchestrateclient.GlobalClient(),
+				viper.GetString(pkgsarama.TxDeadLetterViperKey))
+			deadLetters, err := inspector.List(cmd.Context(), filter)
+			if err != nil {
+				return err
+			}
+
+			return printDeadLetters(cmd.OutOrStdout(), deadLetters)
+		},
+	}
+	listCmd.Flags().IntVar(&filter.Limit, "limit", 100, "Maximum number of entries to list (0 for no limit)")
+	deadLetterCmd.AddCommand(listCmd)
+
+	var all bool
+	replayCmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Replay dead-letter entries onto the tx-sender topic",
+		Long: `Replays the entry selected by --partition and --offset, or all the entries with --all, onto the tx-sender topic.
+Entries already replayed, or whose job is PENDING or in a final status, are skipped`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !all && (filter.Partition < 0 || filter.Offset < 0) {
+				return errors.InvalidParameterError("--partition and --offset are required to replay a single entry, use --all to replay all the entries")
+			}
+
+			inspector := deadletter.NewInspector(client, producer, orchestrateclient.GlobalClient(),
+				viper.GetString(pkgsarama.TxDeadLetterViperKey))
+			deadLetters, err := inspector.List(cmd.Context(), filter)
+			if err != nil {
+				return err
+			}
+
+			if len(deadLetters) == 0 {
+				return errors.NotFoundError("no dead-letter entry found")
+			}
+
+			replayed, err := inspector.Replay(cmd.Context(), deadLetters, viper.GetString(pkgsarama.TxSenderViperKey))
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "%d message(s) replayed, %d skipped\n", len(replayed), len(deadLetters)-len(replayed))
+			return err
+		},
+	}
+	pkgsarama.KafkaTopicTxSender(replayCmd.Flags())
+	replayCmd.Flags().BoolVar(&all, "all", false, "Replay all the entries matching --partition and --offset")
+	deadLetterCmd.AddCommand(replayCmd)
+
+	return deadLetterCmd
+}
+
+func printDeadLetters(out io.Writer, deadLetters []*broker.DeadLetter) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "PARTITION\tOFFSET\tFAILED AT\tATTEMPTS\tSOURCE\tJOB\tREPLAYED\tERROR")
+	for _, deadLetter := range deadLetters {
+		_, _ = fmt.Fprintf(w, "%d\t%d\t%s\t%d\t%s\t%s\t%t\t%s\n",
+			deadLetter.Partition,
+			deadLetter.Offset,
+			deadLetter.FailedAt.Format(time.RFC3339),
+			deadLetter.Attempts,
+			fmt.Sprintf("%s/%d@%d", deadLetter.OriginalTopic, deadLetter.OriginalPartition, deadLetter.OriginalOffset),
+			jobUUID(deadLetter),
+			deadLetter.Replayed,
+			deadLetter.Error,
+		)
+	}
+
+	return w.Flush()
+}
+
+func jobUUID(deadLetter *broker.DeadLetter) string {
+	txEnvelope := &tx.TxEnvelope{}
+	if err := encoding.Unmarshal(deadLetter.Value, txEnvelope); err != nil {
+		return "-"
+	}
+
+	if jobUUID := txEnvelope.GetJobUUID(); jobUUID != "" {
+		return jobUUID
+	}
+
+	return "-"
+}
@@ -34,6 +34,7 @@ require (
 	github.com/justinas/alice v1.2.0
 	github.com/mitchellh/copystructure v1.0.0
 	github.com/mitchellh/mapstructure v1.4.3
+	github.com/nats-io/nats.go v1.16.0
 	github.com/nmvalera/striped-mutex v0.1.0
 	github.com/opentracing/opentracing-go v1.2.0
 	github.com/oxtoacart/bpool v0.0.0-20190530202638-03653db5a59c
@@ -0,0 +1,102 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	healthz "github.com/heptiolabs/healthcheck"
+)
+
+// Message is a message produced to or consumed from a topic of the message broker
+type Message struct {
+	Topic string
+	// Key selects the partition of the message, messages with the same key are consumed in order
+	Key     []byte
+	Value   []byte
+	Headers map[string]string
+
+	// Position of the message in the topic, set on consumed messages
+	Partition int32
+	Offset    int64
+	Timestamp time.Time
+
+	// Metadata is kept on the messages returned in ProducerErrors and never sent to the broker
+	Metadata interface{}
+}
+
+// ProducerError is the error of a message which could not be produced
+type ProducerError struct {
+	Msg *Message
+	Err error
+}
+
+func (pe ProducerError) Error() string {
+	return fmt.Sprintf("failed to produce message to %s: %s", pe.Msg.Topic, pe.Err)
+}
+
+// ProducerErrors is returned by Producer.SendMessages when some of the messages could not be produced
+type ProducerErrors []*ProducerError
+
+func (pe ProducerErrors) Error() string {
+	return fmt.Sprintf("failed to deliver %d messages", len(pe))
+}
+
+// Producer publishes messages to the topics of the message broker
+type Producer interface {
+	// SendMessage produces a message and returns its partition and offset
+	SendMessage(msg *Message) (partition int32, offset int64, err error)
+
+	// SendMessages produces a batch of messages, ProducerErrors is returned when only some of them failed
+	SendMessages(msgs []*Message) error
+
+	Close() error
+}
+
+// ConsumerGroup consumes the partitions of topics shared between the members of a group
+type ConsumerGroup interface {
+	// Consume joins the group and consumes the topics with the given handler until the context is canceled, a claim
+	// returns an error or the group is rebalanced. It must be called again to keep on consuming after a rebalance
+	Consume(ctx context.Context, topics []string, handler ConsumerGroupHandler) error
+
+	Close() error
+}
+
+// ConsumerGroupHandler processes the claims of a consumer group session
+type ConsumerGroupHandler interface {
+	// Setup is run at the beginning of a new session, before ConsumeClaim
+	Setup(session ConsumerGroupSession) error
+
+	// Cleanup is run at the end of a session, once all ConsumeClaim goroutines have exited
+	Cleanup(session ConsumerGroupSession) error
+
+	// ConsumeClaim processes the messages of a claim until its messages channel is closed
+	ConsumeClaim(session ConsumerGroupSession, claim ConsumerGroupClaim) error
+}
+
+// ConsumerGroupSession is the session of a consumer group member
+type ConsumerGroupSession interface {
+	Context() context.Context
+	MemberID() string
+	Claims() map[string][]int32
+
+	// MarkMessage marks a message as consumed
+	MarkMessage(msg *Message)
+
+	// Commit commits the messages marked as consumed
+	Commit()
+}
+
+// ConsumerGroupClaim is a partition of a topic claimed by a consumer group member
+type ConsumerGroupClaim interface {
+	Topic() string
+	Partition() int32
+	Messages() <-chan *Message
+}
+
+// Broker creates the producer and consumer groups of a message broker
+type Broker interface {
+	Producer() Producer
+	NewConsumerGroup(groupID string) (ConsumerGroup, error)
+	Checker() healthz.Check
+}
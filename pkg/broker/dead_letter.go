@@ -0,0 +1,162 @@
+package broker
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+)
+
+// Headers set on the messages moved to a dead-letter topic
+const (
+	DeadLetterTopicHeader     = "x-dead-letter-topic"
+	DeadLetterPartitionHeader = "x-dead-letter-partition"
+	DeadLetterOffsetHeader    = "x-dead-letter-offset"
+	DeadLetterAttemptsHeader  = "x-dead-letter-attempts"
+	DeadLetterErrorHeader     = "x-dead-letter-error"
+	DeadLetterErrorCodeHeader = "x-dead-letter-error-code"
+	DeadLetterFailedAtHeader  = "x-dead-letter-failed-at"
+	// DeadLetterReplayedHeader marks the entries recording the position of a dead-letter which has been replayed
+	DeadLetterReplayedHeader = "x-dead-letter-replayed"
+)
+
+// DeadLetter is a message which could not be processed, as read from a dead-letter topic
+type DeadLetter struct {
+	// Position of the entry in the dead-letter topic
+	Partition int32
+	Offset    int64
+
+	// Position of the original message
+	OriginalTopic     string
+	OriginalPartition int32
+	OriginalOffset    int64
+
+	Attempts  int
+	Error     string
+	ErrorCode string
+	FailedAt  time.Time
+	// Replayed indicates whether the entry has already been replayed
+	Replayed bool
+
+	Key     []byte
+	Value   []byte
+	Headers map[string]string
+}
+
+// NewDeadLetterMessage creates the message moving the given message to a dead-letter topic. The original key, value and
+// headers are kept untouched so that the message can be replayed as is
+func NewDeadLetterMessage(msg *Message, topic string, err error, attempts int) *Message {
+	ierr := errors.FromError(err)
+
+	headers := make(map[string]string, len(msg.Headers)+7)
+	for key, value := range msg.Headers {
+		if !isDeadLetterHeader(key) {
+			headers[key] = value
+		}
+	}
+
+	headers[DeadLetterTopicHeader] = msg.Topic
+	headers[DeadLetterPartitionHeader] = strconv.FormatInt(int64(msg.Partition), 10)
+	headers[DeadLetterOffsetHeader] = strconv.FormatInt(msg.Offset, 10)
+	headers[DeadLetterAttemptsHeader] = strconv.Itoa(attempts)
+	headers[DeadLetterErrorHeader] = ierr.Error()
+	headers[DeadLetterErrorCodeHeader] = ierr.Hex()
+	headers[DeadLetterFailedAtHeader] = time.Now().UTC().Format(time.RFC3339Nano)
+
+	return &Message{
+		Topic:   topic,
+		Key:     msg.Key,
+		Value:   msg.Value,
+		Headers: headers,
+	}
+}
+
+// ParseDeadLetter extracts the dead-letter details of a message read from a dead-letter topic
+func ParseDeadLetter(msg *Message) *DeadLetter {
+	deadLetter := &DeadLetter{
+		Partition:         msg.Partition,
+		Offset:            msg.Offset,
+		OriginalPartition: -1,
+		OriginalOffset:    -1,
+		Key:               msg.Key,
+		Value:             msg.Value,
+		Headers:           make(map[string]string),
+	}
+
+	for key, value := range msg.Headers {
+		switch key {
+		case DeadLetterTopicHeader:
+			deadLetter.OriginalTopic = value
+		case DeadLetterPartitionHeader:
+			if partition, err := strconv.ParseInt(value, 10, 32); err == nil {
+				deadLetter.OriginalPartition = int32(partition)
+			}
+		case DeadLetterOffsetHeader:
+			if offset, err := strconv.ParseInt(value, 10, 64); err == nil {
+				deadLetter.OriginalOffset = offset
+			}
+		case DeadLetterAttemptsHeader:
+			deadLetter.Attempts, _ = strconv.Atoi(value)
+		case DeadLetterErrorHeader:
+			deadLetter.Error = value
+		case DeadLetterErrorCodeHeader:
+			deadLetter.ErrorCode = value
+		case DeadLetterFailedAtHeader:
+			deadLetter.FailedAt, _ = time.Parse(time.RFC3339Nano, value)
+		default:
+			deadLetter.Headers[key] = value
+		}
+	}
+
+	return deadLetter
+}
+
+// ReplayMessage creates the message publishing a dead-letter again with its original key, value and headers
+func (d *DeadLetter) ReplayMessage(topic string) *Message {
+	headers := make(map[string]string, len(d.Headers))
+	for key, value := range d.Headers {
+		headers[key] = value
+	}
+
+	return &Message{
+		Topic:   topic,
+		Key:     d.Key,
+		Value:   d.Value,
+		Headers: headers,
+	}
+}
+
+// ReplayedMarker creates the message recording in the dead-letter topic that the entry has been replayed
+func (d *DeadLetter) ReplayedMarker(topic string) *Message {
+	return &Message{
+		Topic:   topic,
+		Key:     d.Key,
+		Headers: map[string]string{DeadLetterReplayedHeader: fmt.Sprintf("%d/%d", d.Partition, d.Offset)},
+	}
+}
+
+// ParseReplayedMarker returns the position of the dead-letter replayed recorded by a message of a dead-letter topic, or
+// -1 if it cannot be parsed. Returns false if the message is a dead-letter entry
+func ParseReplayedMarker(msg *Message) (partition int32, offset int64, ok bool) {
+	value, ok := msg.Headers[DeadLetterReplayedHeader]
+	if !ok {
+		return 0, 0, false
+	}
+
+	if _, err := fmt.Sscanf(value, "%d/%d", &partition, &offset); err != nil {
+		return -1, -1, true
+	}
+
+	return partition, offset, true
+}
+
+func isDeadLetterHeader(key string) bool {
+	switch key {
+	case DeadLetterTopicHeader, DeadLetterPartitionHeader, DeadLetterOffsetHeader, DeadLetterAttemptsHeader,
+		DeadLetterErrorHeader, DeadLetterErrorCodeHeader, DeadLetterFailedAtHeader, DeadLetterReplayedHeader:
+		return true
+	default:
+		return false
+	}
+}
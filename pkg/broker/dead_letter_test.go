@@ -0,0 +1,70 @@
+// +build unit
+
+package broker
+
+import (
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeadLetter(t *testing.T) {
+	msg := &Message{
+		Topic:     "topic-tx-sender",
+		Partition: 2,
+		Offset:    42,
+		Key:       []byte("key"),
+		Value:     []byte("value"),
+		Headers: map[string]string{
+			"Authorization":          "Bearer token",
+			DeadLetterAttemptsHeader: "1",
+		},
+	}
+
+	deadLetterMsg := NewDeadLetterMessage(msg, "topic-tx-dead-letter", errors.InvalidParameterError("invalid nonce"), 3)
+	assert.Equal(t, "topic-tx-dead-letter", deadLetterMsg.Topic)
+
+	deadLetterMsg.Partition = 0
+	deadLetterMsg.Offset = 7
+	deadLetter := ParseDeadLetter(deadLetterMsg)
+
+	assert.Equal(t, int32(0), deadLetter.Partition)
+	assert.Equal(t, int64(7), deadLetter.Offset)
+	assert.Equal(t, "topic-tx-sender", deadLetter.OriginalTopic)
+	assert.Equal(t, int32(2), deadLetter.OriginalPartition)
+	assert.Equal(t, int64(42), deadLetter.OriginalOffset)
+	assert.Equal(t, 3, deadLetter.Attempts)
+	assert.Contains(t, deadLetter.Error, "invalid nonce")
+	assert.Equal(t, errors.FromError(errors.InvalidParameterError("")).Hex(), deadLetter.ErrorCode)
+	assert.False(t, deadLetter.FailedAt.IsZero())
+	assert.Equal(t, map[string]string{"Authorization": "Bearer token"}, deadLetter.Headers)
+
+	replay := deadLetter.ReplayMessage("topic-tx-sender")
+	assert.Equal(t, "topic-tx-sender", replay.Topic)
+	assert.Equal(t, []byte("key"), replay.Key)
+	assert.Equal(t, []byte("value"), replay.Value)
+	assert.Equal(t, map[string]string{"Authorization": "Bearer token"}, replay.Headers)
+}
+
+func TestDeadLetter_ReplayedMarker(t *testing.T) {
+	deadLetter := &DeadLetter{Partition: 2, Offset: 42, Key: []byte("key"), Value: []byte("value")}
+
+	marker := deadLetter.ReplayedMarker("topic-tx-dead-letter")
+	assert.Equal(t, "topic-tx-dead-letter", marker.Topic)
+	assert.Equal(t, []byte("key"), marker.Key)
+	assert.Empty(t, marker.Value)
+
+	partition, offset, ok := ParseReplayedMarker(marker)
+	assert.True(t, ok)
+	assert.Equal(t, int32(2), partition)
+	assert.Equal(t, int64(42), offset)
+
+	_, _, ok = ParseReplayedMarker(&Message{Headers: map[string]string{DeadLetterAttemptsHeader: "1"}})
+	assert.False(t, ok)
+
+	partition, offset, ok = ParseReplayedMarker(&Message{Headers: map[string]string{DeadLetterReplayedHeader: "invalid"}})
+	assert.True(t, ok)
+	assert.Equal(t, int32(-1), partition)
+	assert.Equal(t, int64(-1), offset)
+}
@@ -0,0 +1,44 @@
+package multi
+
+import (
+	"fmt"
+
+	"github.com/consensys/orchestrate/pkg/broker/nats"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault(TypeViperKey, typeDefault)
+	_ = viper.BindEnv(TypeViperKey, typeEnv)
+}
+
+const (
+	KafkaType = "kafka"
+	NATSType  = "nats"
+)
+
+var availableTypes = []string{
+	KafkaType,
+	NATSType,
+}
+
+const (
+	typeFlag     = "broker-type"
+	TypeViperKey = "broker.type"
+	typeDefault  = KafkaType
+	typeEnv      = "BROKER_TYPE"
+)
+
+// Flags register the message broker type flag and the flags of the brokers which are not Kafka
+func Flags(f *pflag.FlagSet) {
+	brokerType(f)
+	nats.Flags(f)
+}
+
+func brokerType(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Type of message broker (one of %q)
+Environment variable: %q`, availableTypes, typeEnv)
+	f.String(typeFlag, typeDefault, desc)
+	_ = viper.BindPFlag(TypeViperKey, f.Lookup(typeFlag))
+}
@@ -0,0 +1,34 @@
+// +build unit
+
+package multi
+
+import (
+	"os"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBrokerType(t *testing.T) {
+	flgs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	Flags(flgs)
+
+	expected := "kafka"
+	assert.Equal(t, expected, viper.GetString(TypeViperKey), "Default")
+
+	expected = "env-broker"
+	_ = os.Setenv(typeEnv, expected)
+	assert.Equal(t, expected, viper.GetString(TypeViperKey), "From Environment Variable")
+	_ = os.Unsetenv(typeEnv)
+
+	args := []string{
+		"--broker-type=nats",
+	}
+	err := flgs.Parse(args)
+	assert.NoError(t, err, "No error expected")
+
+	expected = "nats"
+	assert.Equal(t, expected, viper.GetString(TypeViperKey), "From flag")
+}
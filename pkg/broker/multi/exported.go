@@ -0,0 +1,51 @@
+package multi
+
+import (
+	"context"
+	"sync"
+
+	"github.com/consensys/orchestrate/pkg/broker"
+	"github.com/consensys/orchestrate/pkg/broker/nats"
+	pkgsarama "github.com/consensys/orchestrate/pkg/broker/sarama"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/spf13/viper"
+)
+
+const component = "broker"
+
+var (
+	globalBroker broker.Broker
+	initOnce     = &sync.Once{}
+)
+
+// Init initializes the message broker of the configured type
+func Init(ctx context.Context) {
+	initOnce.Do(func() {
+		if globalBroker != nil {
+			return
+		}
+
+		brokerType := viper.GetString(TypeViperKey)
+		switch brokerType {
+		case NATSType:
+			nats.Init(ctx)
+			globalBroker = nats.GlobalBroker()
+		case KafkaType:
+			pkgsarama.InitSyncProducer(ctx)
+			globalBroker = pkgsarama.NewBroker(viper.GetStringSlice(pkgsarama.KafkaURLViperKey),
+				pkgsarama.GlobalSyncProducer(), pkgsarama.GlobalClientChecker())
+		default:
+			log.FromContext(ctx).SetComponent(component).Fatalf("invalid broker type %q", brokerType)
+		}
+	})
+}
+
+// GlobalBroker returns the global message broker
+func GlobalBroker() broker.Broker {
+	return globalBroker
+}
+
+// SetGlobalBroker sets the global message broker
+func SetGlobalBroker(b broker.Broker) {
+	globalBroker = b
+}
@@ -0,0 +1,85 @@
+package nats
+
+import (
+	"sync"
+
+	"github.com/consensys/orchestrate/pkg/broker"
+	"github.com/consensys/orchestrate/pkg/errors"
+	healthz "github.com/heptiolabs/healthcheck"
+	"github.com/nats-io/nats.go"
+)
+
+const component = "broker.nats"
+
+type natsBroker struct {
+	conn     *nats.Conn
+	js       nats.JetStreamContext
+	cfg      *Config
+	streams  *streams
+	producer broker.Producer
+}
+
+// NewBroker creates the NATS JetStream implementation of the message broker. Each topic is stored in a stream
+// partitioned into cfg.Partitions subjects
+func NewBroker(conn *nats.Conn, cfg *Config) (broker.Broker, error) {
+	js, err := conn.JetStream()
+	if err != nil {
+		return nil, errors.NATSConnectionError("failed to create JetStream context: %s", err).SetComponent(component)
+	}
+
+	strms := &streams{js: js, replicas: cfg.Replicas}
+	return &natsBroker{
+		conn:     conn,
+		js:       js,
+		cfg:      cfg,
+		streams:  strms,
+		producer: newProducer(js, strms, cfg.Partitions),
+	}, nil
+}
+
+func (b *natsBroker) Producer() broker.Producer {
+	return b.producer
+}
+
+func (b *natsBroker) NewConsumerGroup(groupID string) (broker.ConsumerGroup, error) {
+	return newConsumerGroup(b.js, b.streams, groupID, b.cfg), nil
+}
+
+func (b *natsBroker) Checker() healthz.Check {
+	return func() error {
+		if !b.conn.IsConnected() {
+			return errors.NATSConnectionError("not connected to NATS (%s)", b.conn.Status().String())
+		}
+		return nil
+	}
+}
+
+// streams creates the stream of a topic the first time it is used
+type streams struct {
+	js       nats.JetStreamContext
+	replicas int
+	created  sync.Map
+}
+
+func (s *streams) ensure(topic string) (string, error) {
+	name := streamName(topic)
+	if _, ok := s.created.Load(name); ok {
+		return name, nil
+	}
+
+	_, err := s.js.StreamInfo(name)
+	if err == nats.ErrStreamNotFound {
+		_, err = s.js.AddStream(&nats.StreamConfig{
+			Name:     name,
+			Subjects: []string{topic + ".*"},
+			Storage:  nats.FileStorage,
+			Replicas: s.replicas,
+		})
+	}
+	if err != nil {
+		return "", errors.NATSConnectionError("failed to create stream of topic %s: %s", topic, err).SetComponent(component)
+	}
+
+	s.created.Store(name, true)
+	return name, nil
+}
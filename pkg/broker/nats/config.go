@@ -0,0 +1,100 @@
+package nats
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault(URLViperKey, urlDefault)
+	_ = viper.BindEnv(URLViperKey, urlEnv)
+	viper.SetDefault(PartitionsViperKey, partitionsDefault)
+	_ = viper.BindEnv(PartitionsViperKey, partitionsEnv)
+	viper.SetDefault(ReplicasViperKey, replicasDefault)
+	_ = viper.BindEnv(ReplicasViperKey, replicasEnv)
+	viper.SetDefault(AckWaitViperKey, ackWaitDefault)
+	_ = viper.BindEnv(AckWaitViperKey, ackWaitEnv)
+}
+
+const (
+	urlFlag     = "nats-url"
+	URLViperKey = "nats.url"
+	urlDefault  = "nats://localhost:4222"
+	urlEnv      = "NATS_URL"
+)
+
+const (
+	partitionsFlag     = "nats-partitions"
+	PartitionsViperKey = "nats.partitions"
+	partitionsDefault  = 8
+	partitionsEnv      = "NATS_PARTITIONS"
+)
+
+const (
+	replicasFlag     = "nats-stream-replicas"
+	ReplicasViperKey = "nats.stream.replicas"
+	replicasDefault  = 1
+	replicasEnv      = "NATS_STREAM_REPLICAS"
+)
+
+const (
+	ackWaitFlag     = "nats-ack-wait"
+	AckWaitViperKey = "nats.ack-wait"
+	ackWaitDefault  = 2 * time.Minute
+	ackWaitEnv      = "NATS_ACK_WAIT"
+)
+
+// Flags register flags for NATS JetStream
+func Flags(f *pflag.FlagSet) {
+	natsURL(f)
+	partitions(f)
+	replicas(f)
+	ackWait(f)
+}
+
+func natsURL(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`URL of the NATS server(s) to connect to, comma separated.
+Environment variable: %q`, urlEnv)
+	f.String(urlFlag, urlDefault, desc)
+	_ = viper.BindPFlag(URLViperKey, f.Lookup(urlFlag))
+}
+
+func partitions(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Number of subjects each topic is partitioned into. Messages with the same key are consumed in order, it must not be changed once messages have been produced.
+Environment variable: %q`, partitionsEnv)
+	f.Int(partitionsFlag, partitionsDefault, desc)
+	_ = viper.BindPFlag(PartitionsViperKey, f.Lookup(partitionsFlag))
+}
+
+func replicas(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Number of replicas of the JetStream streams created for the topics.
+Environment variable: %q`, replicasEnv)
+	f.Int(replicasFlag, replicasDefault, desc)
+	_ = viper.BindPFlag(ReplicasViperKey, f.Lookup(replicasFlag))
+}
+
+func ackWait(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Time after which a consumed message which has not been acknowledged is delivered again. Messages being processed are marked in progress every third of it.
+Environment variable: %q`, ackWaitEnv)
+	f.Duration(ackWaitFlag, ackWaitDefault, desc)
+	_ = viper.BindPFlag(AckWaitViperKey, f.Lookup(ackWaitFlag))
+}
+
+type Config struct {
+	URL        string
+	Partitions int
+	Replicas   int
+	AckWait    time.Duration
+}
+
+func NewConfig(vipr *viper.Viper) *Config {
+	return &Config{
+		URL:        vipr.GetString(URLViperKey),
+		Partitions: vipr.GetInt(PartitionsViperKey),
+		Replicas:   vipr.GetInt(ReplicasViperKey),
+		AckWait:    vipr.GetDuration(AckWaitViperKey),
+	}
+}
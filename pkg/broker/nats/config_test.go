@@ -0,0 +1,75 @@
+// +build unit
+
+package nats
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNATSURL(t *testing.T) {
+	flgs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	natsURL(flgs)
+
+	assert.Equal(t, urlDefault, viper.GetString(URLViperKey), "Default")
+
+	expected := "nats://nats-env:4222"
+	_ = os.Setenv(urlEnv, expected)
+	assert.Equal(t, expected, viper.GetString(URLViperKey), "From Environment Variable")
+	_ = os.Unsetenv(urlEnv)
+
+	expected = "nats://nats-flag:4222"
+	err := flgs.Parse([]string{fmt.Sprintf("--%s=%s", urlFlag, expected)})
+	assert.NoError(t, err, "No error expected")
+	assert.Equal(t, expected, viper.GetString(URLViperKey), "From flag")
+}
+
+func TestNATSPartitions(t *testing.T) {
+	flgs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	partitions(flgs)
+
+	assert.Equal(t, partitionsDefault, viper.GetInt(PartitionsViperKey), "Default")
+
+	_ = os.Setenv(partitionsEnv, "16")
+	assert.Equal(t, 16, viper.GetInt(PartitionsViperKey), "From Environment Variable")
+	_ = os.Unsetenv(partitionsEnv)
+
+	err := flgs.Parse([]string{fmt.Sprintf("--%s=%d", partitionsFlag, 32)})
+	assert.NoError(t, err, "No error expected")
+	assert.Equal(t, 32, viper.GetInt(PartitionsViperKey), "From flag")
+}
+
+func TestNATSAckWait(t *testing.T) {
+	flgs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	ackWait(flgs)
+
+	assert.Equal(t, ackWaitDefault, viper.GetDuration(AckWaitViperKey), "Default")
+
+	_ = os.Setenv(ackWaitEnv, "1m")
+	assert.Equal(t, time.Minute, viper.GetDuration(AckWaitViperKey), "From Environment Variable")
+	_ = os.Unsetenv(ackWaitEnv)
+
+	err := flgs.Parse([]string{fmt.Sprintf("--%s=%s", ackWaitFlag, "10s")})
+	assert.NoError(t, err, "No error expected")
+	assert.Equal(t, 10*time.Second, viper.GetDuration(AckWaitViperKey), "From flag")
+}
+
+func TestNewConfig(t *testing.T) {
+	vipr := viper.New()
+	vipr.Set(URLViperKey, "nats://nats:4222")
+	vipr.Set(PartitionsViperKey, 4)
+	vipr.Set(ReplicasViperKey, 3)
+	vipr.Set(AckWaitViperKey, "5s")
+
+	cfg := NewConfig(vipr)
+	assert.Equal(t, "nats://nats:4222", cfg.URL)
+	assert.Equal(t, 4, cfg.Partitions)
+	assert.Equal(t, 3, cfg.Replicas)
+	assert.Equal(t, 5*time.Second, cfg.AckWait)
+}
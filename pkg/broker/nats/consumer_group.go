@@ -0,0 +1,285 @@
+package nats
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/broker"
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/gofrs/uuid"
+	"github.com/nats-io/nats.go"
+)
+
+// fetchRetryDelay is the time waited before fetching messages again after a failure
+var fetchRetryDelay = time.Second
+
+// inProgressInterval is the interval at which messages being processed are marked in progress, well within AckWait
+func inProgressInterval(ackWait time.Duration) time.Duration {
+	if ackWait <= 0 {
+		ackWait = ackWaitDefault
+	}
+	return ackWait / 3
+}
+
+// consumerGroup consumes every partition of the topics through a durable pull consumer shared by the members of the
+// group. Consumers accept a single message pending acknowledgement so that the messages of a partition are processed
+// one at a time and in order, whatever the number of members
+type consumerGroup struct {
+	js       nats.JetStreamContext
+	streams  *streams
+	groupID  string
+	memberID string
+	cfg      *Config
+	logger   *log.Logger
+}
+
+func newConsumerGroup(js nats.JetStreamContext, strms *streams, groupID string, cfg *Config) *consumerGroup {
+	return &consumerGroup{
+		js:       js,
+		streams:  strms,
+		groupID:  groupID,
+		memberID: uuid.Must(uuid.NewV4()).String(),
+		cfg:      cfg,
+		logger:   log.NewLogger().SetComponent(component).WithField("group", groupID),
+	}
+}
+
+// Consume consumes all the partitions of the topics until the context is canceled or a claim returns an error. As
+// partitions are not assigned to a single member, there is no rebalance
+func (g *consumerGroup) Consume(ctx context.Context, topics []string, handler broker.ConsumerGroupHandler) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sess := &session{
+		ctx:      ctx,
+		memberID: g.memberID,
+		claims:   make(map[string][]int32),
+		pending:  make(map[*broker.Message]*nats.Msg),
+	}
+	defer sess.nakPending()
+
+	var claims []*claim
+	for _, topic := range topics {
+		for partition := int32(0); partition < int32(g.cfg.Partitions); partition++ {
+			sub, err := g.subscribe(topic, partition)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = sub.Unsubscribe()
+			}()
+
+			sess.claims[topic] = append(sess.claims[topic], partition)
+			claims = append(claims, &claim{
+				topic:     topic,
+				partition: partition,
+				sub:       sub,
+				msgs:      make(chan *broker.Message),
+			})
+		}
+	}
+
+	if err := handler.Setup(sess); err != nil {
+		return err
+	}
+
+	// Messages being processed are kept in progress so that they are not delivered again when processing them takes
+	// longer than AckWait
+	inProgressDone := make(chan struct{})
+	go func() {
+		defer close(inProgressDone)
+		sess.keepInProgress(inProgressInterval(g.cfg.AckWait))
+	}()
+
+	var consumeErr error
+	errOnce := &sync.Once{}
+	wg := &sync.WaitGroup{}
+	for _, c := range claims {
+		wg.Add(2)
+		go func(c *claim) {
+			defer wg.Done()
+			g.fetch(ctx, sess, c)
+		}(c)
+		go func(c *claim) {
+			defer wg.Done()
+			if err := handler.ConsumeClaim(sess, c); err != nil {
+				errOnce.Do(func() {
+					consumeErr = err
+				})
+			}
+			// Stops every claim as soon as one of them exits
+			cancel()
+		}(c)
+	}
+	wg.Wait()
+	<-inProgressDone
+
+	if err := handler.Cleanup(sess); err != nil && consumeErr == nil {
+		consumeErr = err
+	}
+
+	return consumeErr
+}
+
+func (g *consumerGroup) Close() error {
+	return nil
+}
+
+func (g *consumerGroup) subscribe(topic string, partition int32) (*nats.Subscription, error) {
+	stream, err := g.streams.ensure(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	durable := consumerName(g.groupID, partition)
+	_, err = g.js.ConsumerInfo(stream, durable)
+	if err == nats.ErrConsumerNotFound {
+		_, err = g.js.AddConsumer(stream, &nats.ConsumerConfig{
+			Durable:       durable,
+			FilterSubject: Subject(topic, partition),
+			DeliverPolicy: nats.DeliverAllPolicy,
+			AckPolicy:     nats.AckExplicitPolicy,
+			AckWait:       g.cfg.AckWait,
+			MaxAckPending: 1,
+		})
+	}
+	if err != nil {
+		return nil, errors.NATSConnectionError("failed to create consumer %s of topic %s: %s", durable, topic, err).
+			SetComponent(component)
+	}
+
+	// Binding to the consumer keeps it on the server when unsubscribing
+	sub, err := g.js.PullSubscribe(Subject(topic, partition), durable, nats.Bind(stream, durable))
+	if err != nil {
+		return nil, errors.NATSConnectionError("failed to subscribe to consumer %s of topic %s: %s", durable, topic, err).
+			SetComponent(component)
+	}
+
+	return sub, nil
+}
+
+// fetch pulls the messages of a claim one at a time until the context is canceled
+func (g *consumerGroup) fetch(ctx context.Context, sess *session, c *claim) {
+	logger := g.logger.WithContext(ctx).WithField("topic", c.topic).WithField("partition", c.partition)
+	defer close(c.msgs)
+
+	for ctx.Err() == nil {
+		natsMsgs, err := c.sub.Fetch(1, nats.Context(ctx))
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err == nats.ErrTimeout || err == context.DeadlineExceeded:
+			continue
+		case err != nil:
+			logger.WithError(err).Warnf("failed to fetch messages, retrying in %v...", fetchRetryDelay)
+			select {
+			case <-ctx.Done():
+			case <-time.After(fetchRetryDelay):
+			}
+			continue
+		}
+
+		for _, natsMsg := range natsMsgs {
+			msg, err := newMessage(natsMsg)
+			if err != nil {
+				// Messages which do not belong to a partition cannot be processed
+				logger.WithError(err).Error("dropping invalid message")
+				_ = natsMsg.Term()
+				continue
+			}
+
+			sess.track(msg, natsMsg)
+			select {
+			case c.msgs <- msg:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+type session struct {
+	ctx      context.Context
+	memberID string
+	claims   map[string][]int32
+
+	mux     sync.Mutex
+	pending map[*broker.Message]*nats.Msg
+}
+
+func (s *session) Context() context.Context { return s.ctx }
+
+func (s *session) MemberID() string { return s.memberID }
+
+func (s *session) Claims() map[string][]int32 { return s.claims }
+
+// MarkMessage acknowledges the message so that the next message of its partition is delivered
+func (s *session) MarkMessage(msg *broker.Message) {
+	s.mux.Lock()
+	natsMsg, ok := s.pending[msg]
+	delete(s.pending, msg)
+	s.mux.Unlock()
+
+	if ok {
+		_ = natsMsg.Ack()
+	}
+}
+
+// Commit does nothing as messages are acknowledged when marked
+func (s *session) Commit() {}
+
+func (s *session) track(msg *broker.Message, natsMsg *nats.Msg) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.pending[msg] = natsMsg
+}
+
+// keepInProgress resets the AckWait of the messages which have not been marked yet every interval, until the session
+// ends
+func (s *session) keepInProgress(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			s.mux.Lock()
+			natsMsgs := make([]*nats.Msg, 0, len(s.pending))
+			for _, natsMsg := range s.pending {
+				natsMsgs = append(natsMsgs, natsMsg)
+			}
+			s.mux.Unlock()
+
+			for _, natsMsg := range natsMsgs {
+				_ = natsMsg.InProgress()
+			}
+		}
+	}
+}
+
+// nakPending delivers again right away the messages which have not been marked when the session ends
+func (s *session) nakPending() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	for msg, natsMsg := range s.pending {
+		_ = natsMsg.Nak()
+		delete(s.pending, msg)
+	}
+}
+
+type claim struct {
+	topic     string
+	partition int32
+	sub       *nats.Subscription
+	msgs      chan *broker.Message
+}
+
+func (c *claim) Topic() string { return c.topic }
+
+func (c *claim) Partition() int32 { return c.partition }
+
+func (c *claim) Messages() <-chan *broker.Message { return c.msgs }
@@ -0,0 +1,57 @@
+package nats
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/broker"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/nats-io/nats.go"
+	"github.com/spf13/viper"
+)
+
+var (
+	globalBroker broker.Broker
+	initOnce     = &sync.Once{}
+)
+
+// Init initializes the NATS JetStream message broker
+func Init(ctx context.Context) {
+	initOnce.Do(func() {
+		if globalBroker != nil {
+			return
+		}
+
+		cfg := NewConfig(viper.GetViper())
+		logger := log.FromContext(ctx).SetComponent(component).WithField("url", cfg.URL)
+
+		conn, err := nats.Connect(cfg.URL,
+			nats.Name("orchestrate"),
+			nats.MaxReconnects(-1),
+			nats.ReconnectWait(time.Second),
+		)
+		if err != nil {
+			logger.WithError(err).Fatal("could not connect to server")
+			return
+		}
+
+		globalBroker, err = NewBroker(conn, cfg)
+		if err != nil {
+			logger.WithError(err).Fatal("could not create broker")
+			return
+		}
+
+		logger.WithField("partitions", cfg.Partitions).Info("broker ready")
+	})
+}
+
+// GlobalBroker returns the global NATS JetStream message broker
+func GlobalBroker() broker.Broker {
+	return globalBroker
+}
+
+// SetGlobalBroker sets the global NATS JetStream message broker
+func SetGlobalBroker(b broker.Broker) {
+	globalBroker = b
+}
@@ -0,0 +1,53 @@
+package nats
+
+import (
+	"github.com/consensys/orchestrate/pkg/broker"
+	"github.com/nats-io/nats.go"
+)
+
+// KeyHeader is the header holding the key of a message, as NATS messages have no key
+const KeyHeader = "x-message-key"
+
+func newNATSMessage(msg *broker.Message, partition int32) *nats.Msg {
+	natsMsg := nats.NewMsg(Subject(msg.Topic, partition))
+	natsMsg.Data = msg.Value
+
+	for key, value := range msg.Headers {
+		natsMsg.Header.Set(key, value)
+	}
+	if len(msg.Key) > 0 {
+		natsMsg.Header.Set(KeyHeader, string(msg.Key))
+	}
+
+	return natsMsg
+}
+
+func newMessage(natsMsg *nats.Msg) (*broker.Message, error) {
+	topic, partition, err := parseSubject(natsMsg.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &broker.Message{
+		Topic:     topic,
+		Value:     natsMsg.Data,
+		Headers:   make(map[string]string, len(natsMsg.Header)),
+		Partition: partition,
+	}
+
+	for key := range natsMsg.Header {
+		if key == KeyHeader {
+			msg.Key = []byte(natsMsg.Header.Get(key))
+			continue
+		}
+		msg.Headers[key] = natsMsg.Header.Get(key)
+	}
+
+	// Messages consumed from a stream carry their sequence, used as offset
+	if metadata, err := natsMsg.Metadata(); err == nil {
+		msg.Offset = int64(metadata.Sequence.Stream)
+		msg.Timestamp = metadata.Timestamp
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,55 @@
+package nats
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+// Partition returns the partition of a message key. Messages with the same key, such as the partition key of an
+// envelope, are published on the same subject and consumed in order. Messages without key are spread randomly
+func Partition(key []byte, partitions int) int32 {
+	if partitions <= 1 {
+		return 0
+	}
+
+	if len(key) == 0 {
+		return rand.Int31n(int32(partitions))
+	}
+
+	hasher := fnv.New32a()
+	_, _ = hasher.Write(key)
+	return int32(hasher.Sum32() % uint32(partitions))
+}
+
+// Subject returns the subject of a topic partition
+func Subject(topic string, partition int32) string {
+	return fmt.Sprintf("%s.%d", topic, partition)
+}
+
+// parseSubject returns the topic and partition of a subject
+func parseSubject(subject string) (topic string, partition int32, err error) {
+	idx := strings.LastIndex(subject, ".")
+	if idx < 0 {
+		return "", 0, fmt.Errorf("subject %q is not partitioned", subject)
+	}
+
+	p, err := strconv.ParseInt(subject[idx+1:], 10, 32)
+	if err != nil {
+		return "", 0, fmt.Errorf("subject %q is not partitioned", subject)
+	}
+
+	return subject[:idx], int32(p), nil
+}
+
+// streamName returns the name of the stream holding the messages of a topic, stream names cannot contain dots
+func streamName(topic string) string {
+	return strings.ReplaceAll(topic, ".", "_")
+}
+
+// consumerName returns the name of the durable consumer of a partition shared by the members of a group
+func consumerName(groupID string, partition int32) string {
+	return fmt.Sprintf("%s-%d", strings.ReplaceAll(groupID, ".", "_"), partition)
+}
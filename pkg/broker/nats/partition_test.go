@@ -0,0 +1,61 @@
+// +build unit
+
+package nats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartition(t *testing.T) {
+	t.Run("should always return the same partition for the same key", func(t *testing.T) {
+		key := []byte("0x7e654d251da770a068413677967f6d3ea2fea9e4@1")
+		partition := Partition(key, 8)
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, partition, Partition(key, 8))
+		}
+	})
+
+	t.Run("should return a partition in range", func(t *testing.T) {
+		for _, key := range []string{"", "a", "b", "account@chain", "another-account@chain"} {
+			partition := Partition([]byte(key), 5)
+			assert.True(t, partition >= 0 && partition < 5, "partition %d of key %q is out of range", partition, key)
+		}
+	})
+
+	t.Run("should return the first partition if there is a single one", func(t *testing.T) {
+		assert.Equal(t, int32(0), Partition([]byte("key"), 1))
+		assert.Equal(t, int32(0), Partition(nil, 0))
+	})
+}
+
+func TestSubject(t *testing.T) {
+	t.Run("should parse the subject of a partition", func(t *testing.T) {
+		topic, partition, err := parseSubject(Subject("topic-tx-sender", 3))
+		require.NoError(t, err)
+		assert.Equal(t, "topic-tx-sender", topic)
+		assert.Equal(t, int32(3), partition)
+	})
+
+	t.Run("should keep the dots of the topic", func(t *testing.T) {
+		topic, partition, err := parseSubject("orchestrate.tx.sender.12")
+		require.NoError(t, err)
+		assert.Equal(t, "orchestrate.tx.sender", topic)
+		assert.Equal(t, int32(12), partition)
+	})
+
+	t.Run("should fail if the subject is not partitioned", func(t *testing.T) {
+		_, _, err := parseSubject("topic-tx-sender")
+		assert.Error(t, err)
+
+		_, _, err = parseSubject("topic.tx-sender")
+		assert.Error(t, err)
+	})
+
+	t.Run("should name streams and consumers without dots", func(t *testing.T) {
+		assert.Equal(t, "orchestrate_tx_sender", streamName("orchestrate.tx.sender"))
+		assert.Equal(t, "group_api-2", consumerName("group.api", 2))
+	})
+}
@@ -0,0 +1,57 @@
+package nats
+
+import (
+	"github.com/consensys/orchestrate/pkg/broker"
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/nats-io/nats.go"
+)
+
+type producer struct {
+	js         nats.JetStreamContext
+	streams    *streams
+	partitions int
+}
+
+func newProducer(js nats.JetStreamContext, strms *streams, partitions int) *producer {
+	return &producer{
+		js:         js,
+		streams:    strms,
+		partitions: partitions,
+	}
+}
+
+// SendMessage publishes a message on the subject of its partition and returns its sequence in the stream as offset
+func (p *producer) SendMessage(msg *broker.Message) (partition int32, offset int64, err error) {
+	if _, err = p.streams.ensure(msg.Topic); err != nil {
+		return 0, 0, err
+	}
+
+	partition = Partition(msg.Key, p.partitions)
+	ack, err := p.js.PublishMsg(newNATSMessage(msg, partition))
+	if err != nil {
+		return 0, 0, errors.NATSConnectionError("failed to publish message to %s: %s", msg.Topic, err).SetComponent(component)
+	}
+
+	return partition, int64(ack.Sequence), nil
+}
+
+// SendMessages publishes the messages one after the other so that messages with the same key keep their order
+func (p *producer) SendMessages(msgs []*broker.Message) error {
+	var producerErrs broker.ProducerErrors
+	for _, msg := range msgs {
+		if _, _, err := p.SendMessage(msg); err != nil {
+			producerErrs = append(producerErrs, &broker.ProducerError{Msg: msg, Err: err})
+		}
+	}
+
+	if len(producerErrs) > 0 {
+		return producerErrs
+	}
+
+	return nil
+}
+
+// Close does nothing as the connection is shared with the consumer groups
+func (p *producer) Close() error {
+	return nil
+}
@@ -0,0 +1,247 @@
+package sarama
+
+import (
+	"context"
+
+	"github.com/Shopify/sarama"
+	"github.com/consensys/orchestrate/pkg/broker"
+	healthz "github.com/heptiolabs/healthcheck"
+)
+
+type saramaBroker struct {
+	hostnames []string
+	producer  broker.Producer
+	checker   healthz.Check
+}
+
+// NewBroker creates the Kafka implementation of the message broker. Consumer groups are created with a dedicated client
+func NewBroker(hostnames []string, producer sarama.SyncProducer, checker healthz.Check) broker.Broker {
+	return &saramaBroker{
+		hostnames: hostnames,
+		producer:  NewProducer(producer),
+		checker:   checker,
+	}
+}
+
+func (b *saramaBroker) Producer() broker.Producer {
+	return b.producer
+}
+
+func (b *saramaBroker) NewConsumerGroup(groupID string) (broker.ConsumerGroup, error) {
+	config, err := NewSaramaConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewClient(b.hostnames, config)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := NewConsumerGroupFromClient(groupID, client)
+	if err != nil {
+		_ = client.Close()
+		return nil, err
+	}
+
+	return &brokerConsumerGroup{g: group, client: client}, nil
+}
+
+func (b *saramaBroker) Checker() healthz.Check {
+	return b.checker
+}
+
+type brokerProducer struct {
+	p sarama.SyncProducer
+}
+
+// NewProducer exposes a sarama.SyncProducer as a broker.Producer
+func NewProducer(p sarama.SyncProducer) broker.Producer {
+	return &brokerProducer{p: p}
+}
+
+func (bp *brokerProducer) SendMessage(msg *broker.Message) (partition int32, offset int64, err error) {
+	return bp.p.SendMessage(NewProducerMessage(msg))
+}
+
+func (bp *brokerProducer) SendMessages(msgs []*broker.Message) error {
+	producerMsgs := make([]*sarama.ProducerMessage, len(msgs))
+	for idx, msg := range msgs {
+		producerMsgs[idx] = NewProducerMessage(msg)
+	}
+
+	err := bp.p.SendMessages(producerMsgs)
+	if producerErrs, ok := err.(sarama.ProducerErrors); ok {
+		brokerErrs := make(broker.ProducerErrors, len(producerErrs))
+		for idx, producerErr := range producerErrs {
+			brokerErrs[idx] = &broker.ProducerError{Msg: newProducedMessage(producerErr.Msg), Err: producerErr.Err}
+		}
+		return brokerErrs
+	}
+
+	return err
+}
+
+func (bp *brokerProducer) Close() error {
+	return bp.p.Close()
+}
+
+type brokerConsumerGroup struct {
+	g      sarama.ConsumerGroup
+	client sarama.Client
+}
+
+// NewConsumerGroup exposes a sarama.ConsumerGroup as a broker.ConsumerGroup
+func NewConsumerGroup(g sarama.ConsumerGroup) broker.ConsumerGroup {
+	return &brokerConsumerGroup{g: g}
+}
+
+func (bg *brokerConsumerGroup) Consume(ctx context.Context, topics []string, handler broker.ConsumerGroupHandler) error {
+	return bg.g.Consume(ctx, topics, NewConsumerGroupHandler(handler))
+}
+
+func (bg *brokerConsumerGroup) Close() error {
+	err := bg.g.Close()
+	if bg.client != nil {
+		_ = bg.client.Close()
+	}
+
+	return err
+}
+
+type brokerConsumerGroupHandler struct {
+	h broker.ConsumerGroupHandler
+}
+
+// NewConsumerGroupHandler exposes a broker.ConsumerGroupHandler as a sarama.ConsumerGroupHandler
+func NewConsumerGroupHandler(h broker.ConsumerGroupHandler) sarama.ConsumerGroupHandler {
+	return &brokerConsumerGroupHandler{h: h}
+}
+
+func (bh *brokerConsumerGroupHandler) Setup(s sarama.ConsumerGroupSession) error {
+	return bh.h.Setup(&brokerConsumerGroupSession{s: s})
+}
+
+func (bh *brokerConsumerGroupHandler) Cleanup(s sarama.ConsumerGroupSession) error {
+	return bh.h.Cleanup(&brokerConsumerGroupSession{s: s})
+}
+
+func (bh *brokerConsumerGroupHandler) ConsumeClaim(s sarama.ConsumerGroupSession, c sarama.ConsumerGroupClaim) error {
+	done := make(chan struct{})
+	defer close(done)
+
+	// Messages are converted one at a time so that no message is read ahead of the handler
+	msgs := make(chan *broker.Message)
+	go func() {
+		defer close(msgs)
+		for {
+			select {
+			case msg, ok := <-c.Messages():
+				if !ok {
+					return
+				}
+
+				select {
+				case msgs <- NewMessage(msg):
+				case <-done:
+					return
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return bh.h.ConsumeClaim(&brokerConsumerGroupSession{s: s}, &brokerConsumerGroupClaim{c: c, msgs: msgs})
+}
+
+type brokerConsumerGroupSession struct {
+	s sarama.ConsumerGroupSession
+}
+
+func (bs *brokerConsumerGroupSession) Context() context.Context { return bs.s.Context() }
+
+func (bs *brokerConsumerGroupSession) MemberID() string { return bs.s.MemberID() }
+
+func (bs *brokerConsumerGroupSession) Claims() map[string][]int32 { return bs.s.Claims() }
+
+func (bs *brokerConsumerGroupSession) MarkMessage(msg *broker.Message) {
+	bs.s.MarkOffset(msg.Topic, msg.Partition, msg.Offset+1, "")
+}
+
+func (bs *brokerConsumerGroupSession) Commit() { bs.s.Commit() }
+
+type brokerConsumerGroupClaim struct {
+	c    sarama.ConsumerGroupClaim
+	msgs <-chan *broker.Message
+}
+
+func (bc *brokerConsumerGroupClaim) Topic() string { return bc.c.Topic() }
+
+func (bc *brokerConsumerGroupClaim) Partition() int32 { return bc.c.Partition() }
+
+func (bc *brokerConsumerGroupClaim) Messages() <-chan *broker.Message { return bc.msgs }
+
+// NewMessage creates a broker.Message from a consumed sarama message
+func NewMessage(msg *sarama.ConsumerMessage) *broker.Message {
+	headers := make(map[string]string, len(msg.Headers))
+	for _, header := range msg.Headers {
+		if header != nil {
+			headers[string(header.Key)] = string(header.Value)
+		}
+	}
+
+	return &broker.Message{
+		Topic:     msg.Topic,
+		Key:       msg.Key,
+		Value:     msg.Value,
+		Headers:   headers,
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+		Timestamp: msg.Timestamp,
+	}
+}
+
+// NewProducerMessage creates the sarama message producing a broker.Message
+func NewProducerMessage(msg *broker.Message) *sarama.ProducerMessage {
+	producerMsg := &sarama.ProducerMessage{
+		Topic:    msg.Topic,
+		Value:    sarama.ByteEncoder(msg.Value),
+		Metadata: msg.Metadata,
+	}
+
+	if msg.Key != nil {
+		producerMsg.Key = sarama.ByteEncoder(msg.Key)
+	}
+
+	for key, value := range msg.Headers {
+		producerMsg.Headers = append(producerMsg.Headers, sarama.RecordHeader{Key: []byte(key), Value: []byte(value)})
+	}
+
+	return producerMsg
+}
+
+func newProducedMessage(msg *sarama.ProducerMessage) *broker.Message {
+	brokerMsg := &broker.Message{
+		Topic:     msg.Topic,
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+		Timestamp: msg.Timestamp,
+		Metadata:  msg.Metadata,
+	}
+
+	if msg.Key != nil {
+		brokerMsg.Key, _ = msg.Key.Encode()
+	}
+	if msg.Value != nil {
+		brokerMsg.Value, _ = msg.Value.Encode()
+	}
+	if len(msg.Headers) > 0 {
+		brokerMsg.Headers = make(map[string]string, len(msg.Headers))
+		for _, header := range msg.Headers {
+			brokerMsg.Headers[string(header.Key)] = string(header.Value)
+		}
+	}
+
+	return brokerMsg
+}
@@ -0,0 +1,87 @@
+// +build unit
+
+package sarama
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/Shopify/sarama/mocks"
+	"github.com/consensys/orchestrate/pkg/broker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessage(t *testing.T) {
+	t.Run("should convert a broker message to a producer message", func(t *testing.T) {
+		msg := &broker.Message{
+			Topic:    "topic",
+			Key:      []byte("key"),
+			Value:    []byte("value"),
+			Headers:  map[string]string{"header": "header-value"},
+			Metadata: int64(1),
+		}
+
+		producerMsg := NewProducerMessage(msg)
+		assert.Equal(t, "topic", producerMsg.Topic)
+		assert.Equal(t, sarama.ByteEncoder("key"), producerMsg.Key)
+		assert.Equal(t, sarama.ByteEncoder("value"), producerMsg.Value)
+		assert.Equal(t, []sarama.RecordHeader{{Key: []byte("header"), Value: []byte("header-value")}}, producerMsg.Headers)
+		assert.Equal(t, int64(1), producerMsg.Metadata)
+	})
+
+	t.Run("should not set the key of a producer message if the message has none", func(t *testing.T) {
+		producerMsg := NewProducerMessage(&broker.Message{Topic: "topic", Value: []byte("value")})
+		assert.Nil(t, producerMsg.Key)
+	})
+
+	t.Run("should convert a consumed message to a broker message", func(t *testing.T) {
+		msg := NewMessage(&sarama.ConsumerMessage{
+			Topic:     "topic",
+			Key:       []byte("key"),
+			Value:     []byte("value"),
+			Headers:   []*sarama.RecordHeader{{Key: []byte("header"), Value: []byte("header-value")}, nil},
+			Partition: 2,
+			Offset:    3,
+		})
+
+		assert.Equal(t, "topic", msg.Topic)
+		assert.Equal(t, []byte("key"), msg.Key)
+		assert.Equal(t, []byte("value"), msg.Value)
+		assert.Equal(t, map[string]string{"header": "header-value"}, msg.Headers)
+		assert.Equal(t, int32(2), msg.Partition)
+		assert.Equal(t, int64(3), msg.Offset)
+	})
+}
+
+func TestProducer(t *testing.T) {
+	t.Run("should send a message", func(t *testing.T) {
+		mockProducer := mocks.NewSyncProducer(t, nil)
+		mockProducer.ExpectSendMessageWithCheckerFunctionAndSucceed(func(val []byte) error {
+			if string(val) != "value" {
+				return fmt.Errorf("unexpected value %q", val)
+			}
+			return nil
+		})
+
+		_, _, err := NewProducer(mockProducer).SendMessage(&broker.Message{Topic: "topic", Value: []byte("value")})
+		assert.NoError(t, err)
+		assert.NoError(t, mockProducer.Close())
+	})
+
+	t.Run("should return the messages which failed to be sent", func(t *testing.T) {
+		mockProducer := mocks.NewSyncProducer(t, nil)
+		mockProducer.ExpectSendMessageAndFail(sarama.ProducerErrors{
+			{Msg: &sarama.ProducerMessage{Topic: "topic", Value: sarama.ByteEncoder("value"), Metadata: int64(2)}, Err: fmt.Errorf("error")},
+		})
+
+		err := NewProducer(mockProducer).SendMessages([]*broker.Message{{Topic: "topic", Value: []byte("value")}})
+		producerErrs, ok := err.(broker.ProducerErrors)
+		require.True(t, ok)
+		require.Len(t, producerErrs, 1)
+		assert.Equal(t, int64(2), producerErrs[0].Msg.Metadata)
+		assert.Equal(t, []byte("value"), producerErrs[0].Msg.Value)
+		assert.EqualError(t, producerErrs[0].Err, "error")
+	})
+}
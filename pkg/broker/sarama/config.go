@@ -21,6 +21,12 @@ func init() {
 	_ = viper.BindEnv(TxDecodedViperKey, txDecodedTopicEnv)
 	viper.SetDefault(TxRecoverViperKey, txRecoverTopicDefault)
 	_ = viper.BindEnv(TxRecoverViperKey, txRecoverTopicEnv)
+	viper.SetDefault(TxTokenTransfersViperKey, txTokenTransfersTopicDefault)
+	_ = viper.BindEnv(TxTokenTransfersViperKey, txTokenTransfersTopicEnv)
+	viper.SetDefault(TxRequestViperKey, txRequestTopicDefault)
+	_ = viper.BindEnv(TxRequestViperKey, txRequestTopicEnv)
+	viper.SetDefault(TxDeadLetterViperKey, txDeadLetterTopicDefault)
+	_ = viper.BindEnv(TxDeadLetterViperKey, txDeadLetterTopicEnv)
 
 	// Kafka consumer group for tx workflow
 	viper.SetDefault(ConsumerGroupNameViperKey, consumerGroupNameDefault)
@@ -121,12 +127,28 @@ const (
 	TxRecoverViperKey     = "topic.tx.recover"
 	txRecoverTopicEnv     = "TOPIC_TX_RECOVER"
 	txRecoverTopicDefault = "topic-tx-recover"
+
+	txTokenTransfersFlag         = "topic-tx-token-transfers"
+	TxTokenTransfersViperKey     = "topic.tx.token-transfers"
+	txTokenTransfersTopicEnv     = "TOPIC_TX_TOKEN_TRANSFERS"
+	txTokenTransfersTopicDefault = "topic-tx-token-transfers"
+
+	txRequestFlag         = "topic-tx-request"
+	TxRequestViperKey     = "topic.tx.request"
+	txRequestTopicEnv     = "TOPIC_TX_REQUEST"
+	txRequestTopicDefault = "topic-tx-request"
+
+	txDeadLetterFlag         = "topic-tx-dead-letter"
+	TxDeadLetterViperKey     = "topic.tx.dead-letter"
+	txDeadLetterTopicEnv     = "TOPIC_TX_DEAD_LETTER"
+	txDeadLetterTopicDefault = "topic-tx-dead-letter"
 )
 
 type KafkaTopicConfig struct {
 	Sender  string
 	Decoded string
 	Recover string
+	Request string
 }
 
 func NewKafkaTopicConfig(vipr *viper.Viper) *KafkaTopicConfig {
@@ -134,6 +156,7 @@ func NewKafkaTopicConfig(vipr *viper.Viper) *KafkaTopicConfig {
 		Sender:  vipr.GetString(TxSenderViperKey),
 		Decoded: vipr.GetString(TxDecodedViperKey),
 		Recover: vipr.GetString(TxRecoverViperKey),
+		Request: vipr.GetString(TxRequestViperKey),
 	}
 }
 
@@ -163,6 +186,30 @@ Environment variable: %q`, txDecodedTopicEnv)
 	_ = viper.BindPFlag(TxDecodedViperKey, f.Lookup(txDecodedFlag))
 }
 
+// KafkaTopicTxTokenTransfers register flag for Kafka topic
+func KafkaTopicTxTokenTransfers(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Topic for the ERC-20, ERC-721 and ERC-1155 token transfers of the mined transactions.
+Environment variable: %q`, txTokenTransfersTopicEnv)
+	f.String(txTokenTransfersFlag, txTokenTransfersTopicDefault, desc)
+	_ = viper.BindPFlag(TxTokenTransfersViperKey, f.Lookup(txTokenTransfersFlag))
+}
+
+// KafkaTopicTxRequest register flag for Kafka topic
+func KafkaTopicTxRequest(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Topic for transaction requests submitted to the API through Kafka.
+Environment variable: %q`, txRequestTopicEnv)
+	f.String(txRequestFlag, txRequestTopicDefault, desc)
+	_ = viper.BindPFlag(TxRequestViperKey, f.Lookup(txRequestFlag))
+}
+
+// KafkaTopicTxDeadLetter register flag for Kafka topic
+func KafkaTopicTxDeadLetter(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Topic for the messages which could not be processed by the Tx-Sender (empty to disable dead-lettering, required with NATS).
+Environment variable: %q`, txDeadLetterTopicEnv)
+	f.String(txDeadLetterFlag, txDeadLetterTopicDefault, desc)
+	_ = viper.BindPFlag(TxDeadLetterViperKey, f.Lookup(txDeadLetterFlag))
+}
+
 // Kafka Consumer group environment variables
 const (
 	consumerGroupNameFlag     = "consumer-group-name"
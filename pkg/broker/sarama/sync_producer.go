@@ -30,6 +30,10 @@ func (sp *syncProducer) SendMessage(msg *sarama.ProducerMessage) (partition int3
 // SendMessages produces a given set of messages
 func (sp *syncProducer) SendMessages(msgs []*sarama.ProducerMessage) error {
 	err := sp.p.SendMessages(msgs)
+	// Errors of the individual messages are kept so that callers know which messages have been delivered
+	if _, ok := err.(sarama.ProducerErrors); ok {
+		return err
+	}
 	if err != nil {
 		return errors.KafkaConnectionError(err.Error()).SetComponent(component)
 	}
@@ -25,6 +25,7 @@ const (
 	RedisConnection           = Connection + 5<<8 // Redis Connection error (subclass 085XX)
 	PostgresConnection        = Connection + 6<<8 // Postgres Connection error (subclass 086XX)
 	ServiceConnection         = Connection + 7<<8 // Service Connection error (subclass 087XX)
+	NATSConnection            = Connection + 8<<8 // NATS Connection error (subclass 088XX)
 
 	// Authentication Errors (class 09XXX)
 	InvalidAuthentication uint64 = 9 << 12
@@ -40,20 +41,22 @@ const (
 
 	// Message Errors (class 42XXX)
 	Data               uint64 = 4<<16 + 2<<12
-	Encoding                  = Data + 1<<8  // Invalid Encoding (subclass 421XX)
-	Solidity                  = Data + 2<<8  // Solidity Errors (subclass 422XX)
-	InvalidSignature          = Solidity + 1 // Invalid method/event signature (code 42201)
-	InvalidArgsCount          = Solidity + 2 // Invalid arguments count (code 42202)
-	InvalidArg                = Solidity + 3 // Invalid argument format (code 42203)
-	InvalidTopicsCount        = Solidity + 4 // Invalid count of topics in receipt (code 42204)
-	InvalidLog                = Solidity + 5 // Invalid event log (code 42205)
-	InvalidFormat             = Data + 3<<8  // Invalid format (subclass 423XX)
-	InvalidParameter          = Data + 4<<8  // Invalid parameter provided (subclass 424XX)
+	Encoding                  = Data + 1<<8          // Invalid Encoding (subclass 421XX)
+	Solidity                  = Data + 2<<8          // Solidity Errors (subclass 422XX)
+	InvalidSignature          = Solidity + 1         // Invalid method/event signature (code 42201)
+	InvalidArgsCount          = Solidity + 2         // Invalid arguments count (code 42202)
+	InvalidArg                = Solidity + 3         // Invalid argument format (code 42203)
+	InvalidTopicsCount        = Solidity + 4         // Invalid count of topics in receipt (code 42204)
+	InvalidLog                = Solidity + 5         // Invalid event log (code 42205)
+	InvalidFormat             = Data + 3<<8          // Invalid format (subclass 423XX)
+	InvalidParameter          = Data + 4<<8          // Invalid parameter provided (subclass 424XX)
+	PolicyViolation           = InvalidParameter + 1 // Transaction policy violated (code 42401)
 
 	// Ethereum error (class BEXXX)
 	Ethereum        uint64 = 11<<16 + 14<<12
 	NonceTooLow            = Ethereum + 1
 	InvalidNonceErr        = Ethereum + 2
+	Reverted               = Ethereum + 3 // Execution of the transaction reverted (code BE003)
 
 	// Cryptographic operation error (class C0XXX)
 	CryptoOperation               uint64 = 12 << 16
@@ -139,6 +142,11 @@ func IsKafkaConnectionError(err error) bool {
 	return isErrorClass(FromError(err).GetCode(), KafkaConnection)
 }
 
+// NATSConnectionError is raised when failing to connect to NATS
+func NATSConnectionError(format string, a ...interface{}) *ierror.Error {
+	return Errorf(NATSConnection, format, a...)
+}
+
 // HTTPConnectionError is raised when failing to connect over http
 func HTTPConnectionError(format string, a ...interface{}) *ierror.Error {
 	return Errorf(HTTPConnection, format, a...)
@@ -315,6 +323,16 @@ func IsInvalidParameterError(err error) bool {
 	return isErrorClass(FromError(err).GetCode(), InvalidParameter)
 }
 
+// PolicyViolationError is raised when a job breaks a transaction policy
+func PolicyViolationError(format string, a ...interface{}) *ierror.Error {
+	return Errorf(PolicyViolation, format, a...)
+}
+
+// IsPolicyViolationError indicate whether an error is a policy violation error
+func IsPolicyViolationError(err error) bool {
+	return FromError(err).GetCode() == PolicyViolation
+}
+
 // EthereumError is raised when JSON-RPC call returns an error (such as Nonce too Low)
 func EthereumError(format string, a ...interface{}) *ierror.Error {
 	return Errorf(Ethereum, format, a...)
@@ -330,6 +348,16 @@ func NonceTooLowError(format string, a ...interface{}) *ierror.Error {
 	return Errorf(NonceTooLow, format, a...)
 }
 
+// RevertedError is raised when the execution of a transaction reverts
+func RevertedError(format string, a ...interface{}) *ierror.Error {
+	return Errorf(Reverted, format, a...)
+}
+
+// IsRevertedError indicate whether an error is a reverted execution error
+func IsRevertedError(err error) bool {
+	return FromError(err).GetCode() == Reverted
+}
+
 // CryptoOperationError is raised when failing a cryptographic operation
 func CryptoOperationError(format string, a ...interface{}) *ierror.Error {
 	return Errorf(CryptoOperation, format, a...)
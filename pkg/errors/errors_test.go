@@ -59,6 +59,13 @@ func TestKafkaConnectionError(t *testing.T) {
 	assert.Equal(t, "08100", e.Hex(), "KafkaConnectionError Hex representation should be correct")
 }
 
+func TestNATSConnectionError(t *testing.T) {
+	e := NATSConnectionError("test")
+	assert.Equal(t, uint64(34816), e.GetCode(), "NATSConnectionError code should be correct")
+	assert.True(t, IsConnectionError(e), "NATSConnectionError should be a connection error")
+	assert.Equal(t, "08800", e.Hex(), "NATSConnectionError Hex representation should be correct")
+}
+
 func TestHTTPConnectionError(t *testing.T) {
 	e := HTTPConnectionError("test")
 	assert.Equal(t, uint64(33280), e.GetCode(), "HTTPConnectionError code should be correct")
@@ -200,6 +207,15 @@ func TestInvalidParameterError(t *testing.T) {
 	assert.Equal(t, "42400", e.Hex(), "Hex representation should be correct")
 }
 
+func TestPolicyViolationError(t *testing.T) {
+	e := PolicyViolationError("test")
+	assert.Equal(t, uint64(271361), e.GetCode(), "PolicyViolationError code should be correct")
+	assert.True(t, IsInvalidParameterError(e), "PolicyViolationError should be a InvalidParameterError")
+	assert.True(t, IsPolicyViolationError(e), "PolicyViolationError should be a PolicyViolationError")
+	assert.False(t, IsPolicyViolationError(InvalidParameterError("test")), "InvalidParameterError should not be a PolicyViolationError")
+	assert.Equal(t, "42401", e.Hex(), "Hex representation should be correct")
+}
+
 func TestEthereumError(t *testing.T) {
 	e := EthereumError("test")
 	assert.Equal(t, uint64(778240), e.GetCode(), "EthereumError code should be correct")
@@ -214,6 +230,15 @@ func TestNonceTooLowError(t *testing.T) {
 	assert.Equal(t, "BE001", e.Hex(), "Hex representation should be correct")
 }
 
+func TestRevertedError(t *testing.T) {
+	e := RevertedError("test")
+	assert.Equal(t, uint64(778243), e.GetCode(), "RevertedError code should be correct")
+	assert.True(t, IsEthereumError(e), "RevertedError should be a EthereumError")
+	assert.True(t, IsRevertedError(e), "RevertedError should be a RevertedError")
+	assert.False(t, IsRevertedError(EthereumError("test")), "EthereumError should not be a RevertedError")
+	assert.Equal(t, "BE003", e.Hex(), "Hex representation should be correct")
+}
+
 func TestCryptoOperationError(t *testing.T) {
 	e := CryptoOperationError("test")
 	assert.Equal(t, uint64(786432), e.GetCode(), "CryptoOperationError code should be correct")
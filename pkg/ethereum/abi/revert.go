@@ -0,0 +1,143 @@
+package abi
+
+import (
+	"bytes"
+	encoding "encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	errorSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+
+	stringType, _  = abi.NewType("string", "", nil)
+	uint256Type, _ = abi.NewType("uint256", "", nil)
+)
+
+// panicReasons are the codes of the Panic(uint256) errors raised by the Solidity compiler
+var panicReasons = map[uint64]string{
+	0x00: "generic compiler panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array encoding",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to a zero-initialized internal function",
+}
+
+// DecodeRevertReason decodes the data returned by a reverted call. Besides the `Error(string)` and `Panic(uint256)`
+// errors of Solidity, custom errors are decoded if they are declared in one of the given contract ABIs.
+// A revert without data returns an empty reason
+func DecodeRevertReason(data []byte, rawABIs ...string) (string, error) {
+	if len(data) == 0 {
+		return "", nil
+	}
+
+	if len(data) < 4 {
+		return "", errors.InvalidFormatError("invalid revert data %s", hexutil.Encode(data))
+	}
+
+	selector := data[:4]
+	switch {
+	case bytes.Equal(selector, errorSelector):
+		values, err := abi.Arguments{{Type: stringType}}.UnpackValues(data[4:])
+		if err != nil {
+			return "", errors.InvalidFormatError("invalid Error(string) revert data %s", hexutil.Encode(data))
+		}
+		return values[0].(string), nil
+	case bytes.Equal(selector, panicSelector):
+		values, err := abi.Arguments{{Type: uint256Type}}.UnpackValues(data[4:])
+		if err != nil {
+			return "", errors.InvalidFormatError("invalid Panic(uint256) revert data %s", hexutil.Encode(data))
+		}
+		return formatPanic(values[0].(*big.Int)), nil
+	}
+
+	for _, rawABI := range rawABIs {
+		customErrors, err := ParseErrors(rawABI)
+		if err != nil {
+			return "", err
+		}
+
+		for _, customErr := range customErrors {
+			if bytes.Equal(selector, customErr.ID()) {
+				return formatCustomError(customErr, data[4:])
+			}
+		}
+	}
+
+	return "", errors.NotFoundError("no error declared with selector %s", hexutil.Encode(selector))
+}
+
+// ParseErrors returns the custom errors declared in a contract ABI
+func ParseErrors(rawABI string) ([]*Error, error) {
+	var fields []struct {
+		Type   string
+		Name   string
+		Inputs []abi.ArgumentMarshaling
+	}
+	if err := encoding.Unmarshal([]byte(rawABI), &fields); err != nil {
+		return nil, errors.InvalidFormatError("invalid contract ABI")
+	}
+
+	var customErrors []*Error
+	for _, field := range fields {
+		if field.Type != "error" {
+			continue
+		}
+
+		customErr := &Error{Name: field.Name, Inputs: make(abi.Arguments, len(field.Inputs))}
+		for i, input := range field.Inputs {
+			t, err := abi.NewType(input.Type, input.InternalType, input.Components)
+			if err != nil {
+				return nil, errors.InvalidFormatError("invalid input %s of error %s", input.Name, field.Name)
+			}
+			customErr.Inputs[i] = abi.Argument{Name: input.Name, Type: t}
+		}
+
+		customErrors = append(customErrors, customErr)
+	}
+
+	return customErrors, nil
+}
+
+func formatPanic(code *big.Int) string {
+	reason, ok := panicReasons[code.Uint64()]
+	if !code.IsUint64() || !ok {
+		reason = "unknown panic"
+	}
+
+	return fmt.Sprintf("panic: %s (0x%x)", reason, code)
+}
+
+func formatCustomError(customErr *Error, data []byte) (string, error) {
+	values, err := customErr.Inputs.UnpackValues(data)
+	if err != nil {
+		return "", errors.InvalidFormatError("invalid %s revert data %s", customErr.Name, hexutil.Encode(data))
+	}
+
+	args := make([]string, len(values))
+	for i, value := range values {
+		arg, err := FormatNonIndexedArg(&customErr.Inputs[i].Type, value)
+		if err != nil {
+			return "", err
+		}
+
+		if customErr.Inputs[i].Name != "" {
+			arg = fmt.Sprintf("%s: %s", customErr.Inputs[i].Name, arg)
+		}
+		args[i] = arg
+	}
+
+	return fmt.Sprintf("%s(%s)", customErr.Name, strings.Join(args, ", ")), nil
+}
@@ -0,0 +1,112 @@
+// +build unit
+
+package abi
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const customErrorsABI = `[
+	{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[]},
+	{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]},
+	{"type":"error","name":"Unauthorized","inputs":[{"name":"","type":"address"}]}
+]`
+
+func packRevertData(t *testing.T, signature string, types []string, values ...interface{}) []byte {
+	args := make(abi.Arguments, len(types))
+	for i, typ := range types {
+		abiType, err := abi.NewType(typ, "", nil)
+		require.NoError(t, err)
+		args[i] = abi.Argument{Type: abiType}
+	}
+
+	packed, err := args.Pack(values...)
+	require.NoError(t, err)
+
+	return append(crypto.Keccak256([]byte(signature))[:4], packed...)
+}
+
+func TestDecodeRevertReason(t *testing.T) {
+	t.Run("should decode Error(string)", func(t *testing.T) {
+		data := packRevertData(t, "Error(string)", []string{"string"}, "insufficient balance")
+
+		reason, err := DecodeRevertReason(data)
+
+		require.NoError(t, err)
+		assert.Equal(t, "insufficient balance", reason)
+	})
+
+	t.Run("should decode Panic(uint256)", func(t *testing.T) {
+		data := packRevertData(t, "Panic(uint256)", []string{"uint256"}, big.NewInt(0x11))
+
+		reason, err := DecodeRevertReason(data)
+
+		require.NoError(t, err)
+		assert.Equal(t, "panic: arithmetic overflow or underflow (0x11)", reason)
+	})
+
+	t.Run("should decode Panic(uint256) with unknown code", func(t *testing.T) {
+		data := packRevertData(t, "Panic(uint256)", []string{"uint256"}, big.NewInt(0x99))
+
+		reason, err := DecodeRevertReason(data)
+
+		require.NoError(t, err)
+		assert.Equal(t, "panic: unknown panic (0x99)", reason)
+	})
+
+	t.Run("should decode custom errors declared in the contract ABI", func(t *testing.T) {
+		data := packRevertData(t, "InsufficientBalance(uint256,uint256)", []string{"uint256", "uint256"}, big.NewInt(10), big.NewInt(20))
+
+		reason, err := DecodeRevertReason(data, "[]", customErrorsABI)
+
+		require.NoError(t, err)
+		assert.Equal(t, "InsufficientBalance(available: 10, required: 20)", reason)
+	})
+
+	t.Run("should decode custom errors with unnamed inputs", func(t *testing.T) {
+		account := ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18")
+		data := packRevertData(t, "Unauthorized(address)", []string{"address"}, account)
+
+		reason, err := DecodeRevertReason(data, customErrorsABI)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Unauthorized(0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18)", reason)
+	})
+
+	t.Run("should return an empty reason if the revert has no data", func(t *testing.T) {
+		reason, err := DecodeRevertReason(nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, reason)
+	})
+
+	t.Run("should fail with NotFoundError if the error is not declared", func(t *testing.T) {
+		data := packRevertData(t, "InsufficientBalance(uint256,uint256)", []string{"uint256", "uint256"}, big.NewInt(10), big.NewInt(20))
+
+		_, err := DecodeRevertReason(data)
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should fail with InvalidFormatError if the revert data is malformed", func(t *testing.T) {
+		_, err := DecodeRevertReason(append(crypto.Keccak256([]byte("Error(string)"))[:4], 0x01))
+
+		assert.True(t, errors.IsInvalidFormatError(err))
+	})
+
+	t.Run("should fail with InvalidFormatError if the contract ABI is invalid", func(t *testing.T) {
+		data := packRevertData(t, "InsufficientBalance(uint256,uint256)", []string{"uint256", "uint256"}, big.NewInt(10), big.NewInt(20))
+
+		_, err := DecodeRevertReason(data, "invalid")
+
+		assert.True(t, errors.IsInvalidFormatError(err))
+	})
+}
@@ -49,3 +49,21 @@ func (e *Event) Sig() string {
 func (e *Event) ID() common.Hash {
 	return common.BytesToHash(crypto.Keccak256([]byte(e.Sig())))
 }
+
+// Error is a custom error declared in a contract ABI (e.g. `error InsufficientBalance(uint256 available)`)
+type Error struct {
+	Name   string
+	Inputs abi.Arguments
+}
+
+func (e *Error) Sig() string {
+	types := make([]string, len(e.Inputs))
+	for i, input := range e.Inputs {
+		types[i] = input.Type.String()
+	}
+	return fmt.Sprintf("%v(%v)", e.Name, strings.Join(types, ","))
+}
+
+func (e *Error) ID() []byte {
+	return crypto.Keccak256([]byte(e.Sig()))[:4]
+}
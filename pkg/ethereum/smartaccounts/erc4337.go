@@ -0,0 +1,183 @@
+package smartaccounts
+
+import (
+	"math/big"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Methods of the ERC-4337 bundler JSON-RPC API
+const (
+	SendUserOperationMethod        = "eth_sendUserOperation"
+	EstimateUserOperationGasMethod = "eth_estimateUserOperationGas"
+)
+
+// EntryPointABI is the subset of the ABI of the ERC-4337 EntryPoint (v0.6) used to send UserOperations
+const EntryPointABI = `[
+{"type":"function","name":"getNonce","stateMutability":"view","inputs":[{"name":"sender","type":"address"},{"name":"key","type":"uint192"}],"outputs":[{"name":"nonce","type":"uint256"}]},
+{"type":"event","name":"UserOperationEvent","anonymous":false,"inputs":[{"indexed":true,"name":"userOpHash","type":"bytes32"},{"indexed":true,"name":"sender","type":"address"},{"indexed":true,"name":"paymaster","type":"address"},{"indexed":false,"name":"nonce","type":"uint256"},{"indexed":false,"name":"success","type":"bool"},{"indexed":false,"name":"actualGasCost","type":"uint256"},{"indexed":false,"name":"actualGasUsed","type":"uint256"}]}
+]`
+
+// SimpleAccountABI is the subset of the ABI of the ERC-4337 reference account used to execute calls
+const SimpleAccountABI = `[
+{"type":"function","name":"execute","stateMutability":"nonpayable","inputs":[{"name":"dest","type":"address"},{"name":"value","type":"uint256"},{"name":"func","type":"bytes"}],"outputs":[]}
+]`
+
+// DummySignature is a well-formed signature used to estimate the gas of UserOperations before they are signed
+var DummySignature = hexutil.MustDecode("0xfffffffffffffffffffffffffffffff0000000000000000000000000000000007aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa1c")
+
+var UserOperationEventTopic = crypto.Keccak256Hash([]byte("UserOperationEvent(bytes32,address,address,uint256,bool,uint256,uint256)"))
+
+var (
+	entryPointABI    = mustParseABI(EntryPointABI)
+	simpleAccountABI = mustParseABI(SimpleAccountABI)
+	userOpArgs       = func() abi.Arguments {
+		addressType, _ := abi.NewType("address", "", nil)
+		uint256Type, _ := abi.NewType("uint256", "", nil)
+		bytes32Type, _ := abi.NewType("bytes32", "", nil)
+		return abi.Arguments{
+			{Type: addressType}, {Type: uint256Type}, {Type: bytes32Type}, {Type: bytes32Type}, {Type: uint256Type},
+			{Type: uint256Type}, {Type: uint256Type}, {Type: uint256Type}, {Type: uint256Type}, {Type: bytes32Type},
+		}
+	}()
+	userOpHashArgs = func() abi.Arguments {
+		addressType, _ := abi.NewType("address", "", nil)
+		uint256Type, _ := abi.NewType("uint256", "", nil)
+		bytes32Type, _ := abi.NewType("bytes32", "", nil)
+		return abi.Arguments{{Type: bytes32Type}, {Type: addressType}, {Type: uint256Type}}
+	}()
+	userOpEventArgs = func() abi.Arguments {
+		event := entryPointABI.Events["UserOperationEvent"]
+		return event.Inputs.NonIndexed()
+	}()
+)
+
+// UserOperation is an ERC-4337 (EntryPoint v0.6) UserOperation as sent to bundlers
+type UserOperation struct {
+	Sender               ethcommon.Address `json:"sender"`
+	Nonce                *hexutil.Big      `json:"nonce"`
+	InitCode             hexutil.Bytes     `json:"initCode"`
+	CallData             hexutil.Bytes     `json:"callData"`
+	CallGasLimit         *hexutil.Big      `json:"callGasLimit"`
+	VerificationGasLimit *hexutil.Big      `json:"verificationGasLimit"`
+	PreVerificationGas   *hexutil.Big      `json:"preVerificationGas"`
+	MaxFeePerGas         *hexutil.Big      `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big      `json:"maxPriorityFeePerGas"`
+	PaymasterAndData     hexutil.Bytes     `json:"paymasterAndData"`
+	Signature            hexutil.Bytes     `json:"signature"`
+}
+
+// UserOperationGasEstimate is the result of `eth_estimateUserOperationGas`
+type UserOperationGasEstimate struct {
+	PreVerificationGas   *hexutil.Big `json:"preVerificationGas"`
+	VerificationGasLimit *hexutil.Big `json:"verificationGasLimit"`
+	CallGasLimit         *hexutil.Big `json:"callGasLimit"`
+}
+
+// UserOperationEvent is the event emitted by the EntryPoint for every executed UserOperation
+type UserOperationEvent struct {
+	UserOpHash    ethcommon.Hash
+	Sender        ethcommon.Address
+	Paymaster     ethcommon.Address
+	Nonce         *big.Int
+	Success       bool
+	ActualGasCost *big.Int
+	ActualGasUsed *big.Int
+}
+
+// Hash returns the hash of the UserOperation signed by the owner of the account
+func (op *UserOperation) Hash(entryPoint ethcommon.Address, chainID *big.Int) (ethcommon.Hash, error) {
+	packed, err := userOpArgs.Pack(
+		op.Sender,
+		hexToBig(op.Nonce),
+		crypto.Keccak256Hash(op.InitCode),
+		crypto.Keccak256Hash(op.CallData),
+		hexToBig(op.CallGasLimit),
+		hexToBig(op.VerificationGasLimit),
+		hexToBig(op.PreVerificationGas),
+		hexToBig(op.MaxFeePerGas),
+		hexToBig(op.MaxPriorityFeePerGas),
+		crypto.Keccak256Hash(op.PaymasterAndData),
+	)
+	if err != nil {
+		return ethcommon.Hash{}, errors.InvalidParameterError("failed to encode UserOperation: %s", err.Error())
+	}
+
+	encoded, err := userOpHashArgs.Pack(crypto.Keccak256Hash(packed), entryPoint, chainID)
+	if err != nil {
+		return ethcommon.Hash{}, errors.InvalidParameterError("failed to encode UserOperation hash: %s", err.Error())
+	}
+
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// SetSignature sets the signature of the EIP-191 message of the UserOperation hash expected by the reference account
+func (op *UserOperation) SetSignature(signature []byte) error {
+	sig, err := normalizeSignature(signature)
+	if err != nil {
+		return err
+	}
+
+	op.Signature = sig
+	return nil
+}
+
+// PackEntryPointGetNonce encodes a call to the `getNonce` method of the EntryPoint for the default nonce key
+func PackEntryPointGetNonce(sender ethcommon.Address) []byte {
+	data, _ := entryPointABI.Pack("getNonce", sender, big.NewInt(0))
+	return data
+}
+
+// UnpackEntryPointNonce decodes the result of a call to the `getNonce` method of the EntryPoint
+func UnpackEntryPointNonce(result []byte) (*big.Int, error) {
+	values, err := entryPointABI.Unpack("getNonce", result)
+	if err != nil {
+		return nil, errors.InvalidFormatError("invalid EntryPoint nonce: %s", err.Error())
+	}
+
+	return values[0].(*big.Int), nil
+}
+
+// PackAccountExecute encodes the call data of a UserOperation executing a call from the account
+func PackAccountExecute(to ethcommon.Address, value *big.Int, data []byte) ([]byte, error) {
+	packed, err := simpleAccountABI.Pack("execute", to, bigOrZero(value), data)
+	if err != nil {
+		return nil, errors.InvalidParameterError("failed to encode UserOperation call data: %s", err.Error())
+	}
+
+	return packed, nil
+}
+
+// DecodeUserOperationEvent decodes a UserOperationEvent emitted by the EntryPoint, nil if the log is another event
+func DecodeUserOperationEvent(topics []ethcommon.Hash, data []byte) (*UserOperationEvent, error) {
+	if len(topics) != 4 || topics[0] != UserOperationEventTopic {
+		return nil, nil
+	}
+
+	values, err := userOpEventArgs.UnpackValues(data)
+	if err != nil {
+		return nil, errors.InvalidFormatError("invalid UserOperationEvent data: %s", err.Error())
+	}
+
+	return &UserOperationEvent{
+		UserOpHash:    topics[1],
+		Sender:        ethcommon.BytesToAddress(topics[2].Bytes()),
+		Paymaster:     ethcommon.BytesToAddress(topics[3].Bytes()),
+		Nonce:         values[0].(*big.Int),
+		Success:       values[1].(bool),
+		ActualGasCost: values[2].(*big.Int),
+		ActualGasUsed: values[3].(*big.Int),
+	}, nil
+}
+
+func hexToBig(value *hexutil.Big) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+
+	return value.ToInt()
+}
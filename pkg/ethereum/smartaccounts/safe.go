@@ -0,0 +1,118 @@
+package smartaccounts
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SafeABI is the subset of the ABI of the Safe contracts used to execute transactions
+const SafeABI = `[
+{"type":"function","name":"nonce","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+{"type":"function","name":"getTransactionHash","stateMutability":"view","inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"},{"name":"data","type":"bytes"},{"name":"operation","type":"uint8"},{"name":"safeTxGas","type":"uint256"},{"name":"baseGas","type":"uint256"},{"name":"gasPrice","type":"uint256"},{"name":"gasToken","type":"address"},{"name":"refundReceiver","type":"address"},{"name":"_nonce","type":"uint256"}],"outputs":[{"name":"","type":"bytes32"}]},
+{"type":"function","name":"execTransaction","stateMutability":"payable","inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"},{"name":"data","type":"bytes"},{"name":"operation","type":"uint8"},{"name":"safeTxGas","type":"uint256"},{"name":"baseGas","type":"uint256"},{"name":"gasPrice","type":"uint256"},{"name":"gasToken","type":"address"},{"name":"refundReceiver","type":"address"},{"name":"signatures","type":"bytes"}],"outputs":[{"name":"success","type":"bool"}]},
+{"type":"event","name":"ExecutionSuccess","anonymous":false,"inputs":[{"indexed":false,"name":"txHash","type":"bytes32"},{"indexed":false,"name":"payment","type":"uint256"}]},
+{"type":"event","name":"ExecutionFailure","anonymous":false,"inputs":[{"indexed":false,"name":"txHash","type":"bytes32"},{"indexed":false,"name":"payment","type":"uint256"}]}
+]`
+
+var (
+	SafeExecutionSuccessTopic = crypto.Keccak256Hash([]byte("ExecutionSuccess(bytes32,uint256)"))
+	SafeExecutionFailureTopic = crypto.Keccak256Hash([]byte("ExecutionFailure(bytes32,uint256)"))
+)
+
+var safeABI = mustParseABI(SafeABI)
+
+// PackSafeNonce encodes a call to the `nonce` method of a Safe
+func PackSafeNonce() []byte {
+	data, _ := safeABI.Pack("nonce")
+	return data
+}
+
+// UnpackSafeNonce decodes the result of a call to the `nonce` method of a Safe
+func UnpackSafeNonce(result []byte) (*big.Int, error) {
+	values, err := safeABI.Unpack("nonce", result)
+	if err != nil {
+		return nil, errors.InvalidFormatError("invalid Safe nonce: %s", err.Error())
+	}
+
+	return values[0].(*big.Int), nil
+}
+
+// PackSafeGetTransactionHash encodes a call to the `getTransactionHash` method of a Safe for a call without refund
+func PackSafeGetTransactionHash(to ethcommon.Address, value *big.Int, data []byte, nonce *big.Int) ([]byte, error) {
+	packed, err := safeABI.Pack("getTransactionHash", to, bigOrZero(value), data, uint8(0), big.NewInt(0), big.NewInt(0),
+		big.NewInt(0), ethcommon.Address{}, ethcommon.Address{}, nonce)
+	if err != nil {
+		return nil, errors.InvalidParameterError("failed to encode Safe transaction hash call: %s", err.Error())
+	}
+
+	return packed, nil
+}
+
+// UnpackSafeTransactionHash decodes the result of a call to the `getTransactionHash` method of a Safe
+func UnpackSafeTransactionHash(result []byte) (ethcommon.Hash, error) {
+	values, err := safeABI.Unpack("getTransactionHash", result)
+	if err != nil {
+		return ethcommon.Hash{}, errors.InvalidFormatError("invalid Safe transaction hash: %s", err.Error())
+	}
+
+	return values[0].([32]byte), nil
+}
+
+// PackSafeExecTransaction encodes a call to the `execTransaction` method of a Safe for a call without refund
+func PackSafeExecTransaction(to ethcommon.Address, value *big.Int, data, signatures []byte) ([]byte, error) {
+	packed, err := safeABI.Pack("execTransaction", to, bigOrZero(value), data, uint8(0), big.NewInt(0), big.NewInt(0),
+		big.NewInt(0), ethcommon.Address{}, ethcommon.Address{}, signatures)
+	if err != nil {
+		return nil, errors.InvalidParameterError("failed to encode Safe transaction: %s", err.Error())
+	}
+
+	return packed, nil
+}
+
+// SafeEthSignSignature converts a signature of the EIP-191 message of a Safe transaction hash to the `eth_sign` flavour
+// of Safe signatures, flagged by a recovery id increased by 4
+func SafeEthSignSignature(signature []byte) ([]byte, error) {
+	sig, err := normalizeSignature(signature)
+	if err != nil {
+		return nil, err
+	}
+
+	sig[64] += 4
+	return sig, nil
+}
+
+func normalizeSignature(signature []byte) ([]byte, error) {
+	if len(signature) != crypto.SignatureLength {
+		return nil, errors.InvalidFormatError("invalid signature length %d", len(signature))
+	}
+
+	sig := make([]byte, len(signature))
+	copy(sig, signature)
+	if sig[64] < 27 {
+		sig[64] += 27
+	}
+
+	return sig, nil
+}
+
+func bigOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+
+	return value
+}
+
+func mustParseABI(definition string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(definition))
+	if err != nil {
+		panic(err)
+	}
+
+	return parsed
+}
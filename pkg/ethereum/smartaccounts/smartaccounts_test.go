@@ -0,0 +1,151 @@
+// +build unit
+
+package smartaccounts
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	safe   = ethcommon.HexToAddress("0x1abae27a0cbfb02945720425d3b80c7e09728534")
+	target = ethcommon.HexToAddress("0xdbb881a51cd4023e4400cef3ef73046743f08da3")
+)
+
+func TestSafeEthSignSignature(t *testing.T) {
+	t.Run("should flag signatures with a recovery id of 0 or 1", func(t *testing.T) {
+		sig := make([]byte, 65)
+		sig[64] = 1
+
+		safeSig, err := SafeEthSignSignature(sig)
+
+		require.NoError(t, err)
+		assert.Equal(t, byte(32), safeSig[64])
+		assert.Equal(t, byte(1), sig[64])
+	})
+
+	t.Run("should flag signatures with a recovery id of 27 or 28", func(t *testing.T) {
+		sig := make([]byte, 65)
+		sig[64] = 27
+
+		safeSig, err := SafeEthSignSignature(sig)
+
+		require.NoError(t, err)
+		assert.Equal(t, byte(31), safeSig[64])
+	})
+
+	t.Run("should fail with InvalidFormatError if signature length is invalid", func(t *testing.T) {
+		_, err := SafeEthSignSignature(make([]byte, 64))
+
+		assert.True(t, errors.IsInvalidFormatError(err))
+	})
+}
+
+func TestSafeCalls(t *testing.T) {
+	t.Run("should encode the Safe transaction calls", func(t *testing.T) {
+		getHash, err := PackSafeGetTransactionHash(target, big.NewInt(10), []byte{0x01}, big.NewInt(3))
+		require.NoError(t, err)
+		assert.Equal(t, crypto.Keccak256([]byte("getTransactionHash(address,uint256,bytes,uint8,uint256,uint256,uint256,address,address,uint256)"))[:4], getHash[:4])
+
+		exec, err := PackSafeExecTransaction(target, nil, []byte{0x01}, make([]byte, 65))
+		require.NoError(t, err)
+		assert.Equal(t, crypto.Keccak256([]byte("execTransaction(address,uint256,bytes,uint8,uint256,uint256,uint256,address,address,bytes)"))[:4], exec[:4])
+	})
+
+	t.Run("should decode the Safe call results", func(t *testing.T) {
+		nonce, err := UnpackSafeNonce(ethcommon.BigToHash(big.NewInt(7)).Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), nonce.Int64())
+
+		hash := crypto.Keccak256Hash([]byte("safe-tx"))
+		txHash, err := UnpackSafeTransactionHash(hash.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, hash, txHash)
+	})
+
+	t.Run("should fail with InvalidFormatError if the result is invalid", func(t *testing.T) {
+		_, err := UnpackSafeNonce([]byte{0x01})
+
+		assert.True(t, errors.IsInvalidFormatError(err))
+	})
+}
+
+func TestUserOperation_Hash(t *testing.T) {
+	entryPoint := ethcommon.HexToAddress("0x5ff137d4b0fdcd49dca30c7cf57e578a026d2789")
+	newUserOp := func() *UserOperation {
+		return &UserOperation{
+			Sender:               safe,
+			Nonce:                (*hexutil.Big)(big.NewInt(1)),
+			CallData:             hexutil.MustDecode("0xb61d27f6"),
+			CallGasLimit:         (*hexutil.Big)(big.NewInt(50000)),
+			VerificationGasLimit: (*hexutil.Big)(big.NewInt(100000)),
+			PreVerificationGas:   (*hexutil.Big)(big.NewInt(21000)),
+			MaxFeePerGas:         (*hexutil.Big)(big.NewInt(1000000000)),
+			MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(1000000000)),
+		}
+	}
+
+	t.Run("should not depend on the signature", func(t *testing.T) {
+		userOp := newUserOp()
+		hash, err := userOp.Hash(entryPoint, big.NewInt(888))
+		require.NoError(t, err)
+
+		userOp.Signature = DummySignature
+		signedHash, err := userOp.Hash(entryPoint, big.NewInt(888))
+		require.NoError(t, err)
+
+		assert.Equal(t, hash, signedHash)
+	})
+
+	t.Run("should depend on the chain and the entry point", func(t *testing.T) {
+		userOp := newUserOp()
+		hash, err := userOp.Hash(entryPoint, big.NewInt(888))
+		require.NoError(t, err)
+
+		otherChainHash, err := userOp.Hash(entryPoint, big.NewInt(1))
+		require.NoError(t, err)
+		otherEntryPointHash, err := userOp.Hash(safe, big.NewInt(888))
+		require.NoError(t, err)
+
+		assert.NotEqual(t, hash, otherChainHash)
+		assert.NotEqual(t, hash, otherEntryPointHash)
+	})
+}
+
+func TestDecodeUserOperationEvent(t *testing.T) {
+	userOpHash := crypto.Keccak256Hash([]byte("user-op"))
+	topics := []ethcommon.Hash{UserOperationEventTopic, userOpHash, safe.Hash(), {}}
+
+	t.Run("should decode a UserOperationEvent", func(t *testing.T) {
+		data, err := userOpEventArgs.Pack(big.NewInt(1), false, big.NewInt(1000), big.NewInt(50000))
+		require.NoError(t, err)
+
+		event, err := DecodeUserOperationEvent(topics, data)
+
+		require.NoError(t, err)
+		assert.Equal(t, userOpHash, event.UserOpHash)
+		assert.Equal(t, safe, event.Sender)
+		assert.False(t, event.Success)
+		assert.Equal(t, int64(50000), event.ActualGasUsed.Int64())
+	})
+
+	t.Run("should ignore other events", func(t *testing.T) {
+		event, err := DecodeUserOperationEvent([]ethcommon.Hash{SafeExecutionSuccessTopic}, nil)
+
+		require.NoError(t, err)
+		assert.Nil(t, event)
+	})
+
+	t.Run("should fail with InvalidFormatError if data is invalid", func(t *testing.T) {
+		_, err := DecodeUserOperationEvent(topics, []byte{0x01})
+
+		assert.True(t, errors.IsInvalidFormatError(err))
+	})
+}
@@ -0,0 +1,103 @@
+package tokens
+
+import (
+	"math/big"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// TransferTopic is the topic of the Transfer events of ERC-20 and ERC-721 tokens, told apart by their number of
+	// indexed arguments
+	TransferTopic       = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	TransferSingleTopic = crypto.Keccak256Hash([]byte("TransferSingle(address,address,address,uint256,uint256)"))
+	TransferBatchTopic  = crypto.Keccak256Hash([]byte("TransferBatch(address,address,address,uint256[],uint256[])"))
+)
+
+var transferBatchArgs = func() abi.Arguments {
+	uint256Array, _ := abi.NewType("uint256[]", "", nil)
+	return abi.Arguments{{Name: "ids", Type: uint256Array}, {Name: "values", Type: uint256Array}}
+}()
+
+// DecodeTransfers returns the token transfers of a log emitted by the token contract, none if the log is not a
+// transfer event of a token standard
+func DecodeTransfers(token ethcommon.Address, topics []ethcommon.Hash, data []byte) ([]*entities.TokenTransfer, error) {
+	if len(topics) == 0 {
+		return nil, nil
+	}
+
+	switch {
+	case topics[0] == TransferTopic && len(topics) == 3:
+		if len(data) != 32 {
+			return nil, errors.InvalidFormatError("invalid ERC-20 Transfer event data")
+		}
+
+		return []*entities.TokenTransfer{{
+			Standard: entities.ERC20TokenStandard,
+			Token:    token,
+			From:     topicAddress(topics[1]),
+			To:       topicAddress(topics[2]),
+			Amount:   new(big.Int).SetBytes(data),
+		}}, nil
+	case topics[0] == TransferTopic && len(topics) == 4:
+		return []*entities.TokenTransfer{{
+			Standard: entities.ERC721TokenStandard,
+			Token:    token,
+			From:     topicAddress(topics[1]),
+			To:       topicAddress(topics[2]),
+			TokenID:  topics[3].Big(),
+			Amount:   big.NewInt(1),
+		}}, nil
+	case topics[0] == TransferSingleTopic && len(topics) == 4:
+		if len(data) != 64 {
+			return nil, errors.InvalidFormatError("invalid ERC-1155 TransferSingle event data")
+		}
+
+		operator := topicAddress(topics[1])
+		return []*entities.TokenTransfer{{
+			Standard: entities.ERC1155TokenStandard,
+			Token:    token,
+			Operator: &operator,
+			From:     topicAddress(topics[2]),
+			To:       topicAddress(topics[3]),
+			TokenID:  new(big.Int).SetBytes(data[:32]),
+			Amount:   new(big.Int).SetBytes(data[32:]),
+		}}, nil
+	case topics[0] == TransferBatchTopic && len(topics) == 4:
+		values, err := transferBatchArgs.UnpackValues(data)
+		if err != nil {
+			return nil, errors.InvalidFormatError("invalid ERC-1155 TransferBatch event data: %s", err.Error())
+		}
+
+		ids, amounts := values[0].([]*big.Int), values[1].([]*big.Int)
+		if len(ids) != len(amounts) {
+			return nil, errors.InvalidFormatError("invalid ERC-1155 TransferBatch event data: ids and values mismatch")
+		}
+
+		operator := topicAddress(topics[1])
+		transfers := make([]*entities.TokenTransfer, len(ids))
+		for i := range ids {
+			transfers[i] = &entities.TokenTransfer{
+				Standard: entities.ERC1155TokenStandard,
+				Token:    token,
+				Operator: &operator,
+				From:     topicAddress(topics[2]),
+				To:       topicAddress(topics[3]),
+				TokenID:  ids[i],
+				Amount:   amounts[i],
+			}
+		}
+
+		return transfers, nil
+	default:
+		return nil, nil
+	}
+}
+
+func topicAddress(topic ethcommon.Hash) ethcommon.Address {
+	return ethcommon.BytesToAddress(topic.Bytes())
+}
@@ -0,0 +1,102 @@
+// +build unit
+
+package tokens
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	token    = ethcommon.HexToAddress("0x1abae27a0cbfb02945720425d3b80c7e09728534")
+	from     = ethcommon.HexToAddress("0x2abae27a0cbfb02945720425d3b80c7e09728534")
+	to       = ethcommon.HexToAddress("0x3abae27a0cbfb02945720425d3b80c7e09728534")
+	operator = ethcommon.HexToAddress("0x4abae27a0cbfb02945720425d3b80c7e09728534")
+)
+
+func TestABI(t *testing.T) {
+	for _, standard := range []entities.TokenStandard{entities.ERC20TokenStandard, entities.ERC721TokenStandard, entities.ERC1155TokenStandard} {
+		rawABI, ok := ABI(standard)
+		require.True(t, ok)
+
+		_, err := abi.JSON(strings.NewReader(rawABI))
+		assert.NoError(t, err, "ABI of %s should be valid", standard)
+	}
+
+	_, ok := ABI("erc777")
+	assert.False(t, ok)
+}
+
+func TestDecodeTransfers(t *testing.T) {
+	t.Run("should decode ERC-20 transfer", func(t *testing.T) {
+		transfers, err := DecodeTransfers(token, []ethcommon.Hash{TransferTopic, from.Hash(), to.Hash()}, ethcommon.BigToHash(big.NewInt(1000)).Bytes())
+
+		require.NoError(t, err)
+		assert.Equal(t, []*entities.TokenTransfer{{
+			Standard: entities.ERC20TokenStandard,
+			Token:    token,
+			From:     from,
+			To:       to,
+			Amount:   big.NewInt(1000),
+		}}, transfers)
+	})
+
+	t.Run("should decode ERC-721 transfer", func(t *testing.T) {
+		transfers, err := DecodeTransfers(token, []ethcommon.Hash{TransferTopic, from.Hash(), to.Hash(), ethcommon.BigToHash(big.NewInt(7))}, nil)
+
+		require.NoError(t, err)
+		require.Len(t, transfers, 1)
+		assert.Equal(t, entities.ERC721TokenStandard, transfers[0].Standard)
+		assert.Equal(t, big.NewInt(7), transfers[0].TokenID)
+		assert.Equal(t, big.NewInt(1), transfers[0].Amount)
+	})
+
+	t.Run("should decode ERC-1155 single transfer", func(t *testing.T) {
+		data := append(ethcommon.BigToHash(big.NewInt(7)).Bytes(), ethcommon.BigToHash(big.NewInt(50)).Bytes()...)
+		transfers, err := DecodeTransfers(token, []ethcommon.Hash{TransferSingleTopic, operator.Hash(), from.Hash(), to.Hash()}, data)
+
+		require.NoError(t, err)
+		assert.Equal(t, []*entities.TokenTransfer{{
+			Standard: entities.ERC1155TokenStandard,
+			Token:    token,
+			Operator: &operator,
+			From:     from,
+			To:       to,
+			TokenID:  big.NewInt(7),
+			Amount:   big.NewInt(50),
+		}}, transfers)
+	})
+
+	t.Run("should decode ERC-1155 batch transfer", func(t *testing.T) {
+		data, err := transferBatchArgs.Pack([]*big.Int{big.NewInt(1), big.NewInt(2)}, []*big.Int{big.NewInt(10), big.NewInt(20)})
+		require.NoError(t, err)
+
+		transfers, err := DecodeTransfers(token, []ethcommon.Hash{TransferBatchTopic, operator.Hash(), from.Hash(), to.Hash()}, data)
+
+		require.NoError(t, err)
+		require.Len(t, transfers, 2)
+		assert.Equal(t, big.NewInt(2), transfers[1].TokenID)
+		assert.Equal(t, big.NewInt(20), transfers[1].Amount)
+	})
+
+	t.Run("should ignore other events", func(t *testing.T) {
+		transfers, err := DecodeTransfers(token, []ethcommon.Hash{ethcommon.HexToHash("0x01")}, nil)
+
+		assert.NoError(t, err)
+		assert.Empty(t, transfers)
+	})
+
+	t.Run("should fail with InvalidFormatError if ERC-20 transfer has no amount", func(t *testing.T) {
+		_, err := DecodeTransfers(token, []ethcommon.Hash{TransferTopic, from.Hash(), to.Hash()}, nil)
+
+		assert.True(t, errors.IsInvalidFormatError(err))
+	})
+}
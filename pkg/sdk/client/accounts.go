@@ -68,6 +68,23 @@ func (c *HTTPClient) ImportAccount(ctx context.Context, req *api.ImportAccountRe
 	return resp, nil
 }
 
+func (c *HTTPClient) RegisterSmartAccount(ctx context.Context, req *api.RegisterSmartAccountRequest) (*api.AccountResponse, error) {
+	reqURL := fmt.Sprintf("%v/accounts/smart", c.config.URL)
+	resp := &api.AccountResponse{}
+
+	response, err := clientutils.PostRequest(ctx, c.client, reqURL, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer clientutils.CloseResponse(response)
+	if err := httputil.ParseResponse(ctx, response, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 func (c *HTTPClient) UpdateAccount(ctx context.Context, address ethcommon.Address, req *api.UpdateAccountRequest) (*api.AccountResponse, error) {
 	reqURL := fmt.Sprintf("%v/accounts/%s", c.config.URL, address)
 	resp := &api.AccountResponse{}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	clientutils "github.com/consensys/orchestrate/pkg/toolkit/app/http/client-utils"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/http/httputil"
+	types "github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+)
+
+func (c *HTTPClient) CreateApprovalPolicy(ctx context.Context, request *types.CreateApprovalPolicyRequest) (*types.ApprovalPolicyResponse, error) {
+	reqURL := fmt.Sprintf("%v/approval-policies", c.config.URL)
+	resp := &types.ApprovalPolicyResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PostRequest(ctx, c.client, reqURL, request)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) GetApprovalPolicy(ctx context.Context, uuid string) (*types.ApprovalPolicyResponse, error) {
+	reqURL := fmt.Sprintf("%v/approval-policies/%s", c.config.URL, uuid)
+	resp := &types.ApprovalPolicyResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.GetRequest(ctx, c.client, reqURL)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) SearchApprovalPolicies(ctx context.Context, filters *entities.ApprovalPolicyFilters) ([]*types.ApprovalPolicyResponse, error) {
+	reqURL := fmt.Sprintf("%v/approval-policies", c.config.URL)
+	var resp []*types.ApprovalPolicyResponse
+
+	var qParams []string
+	if filters.ChainUUID != "" {
+		qParams = append(qParams, "chain_uuid="+filters.ChainUUID)
+	}
+
+	if len(qParams) > 0 {
+		reqURL = reqURL + "?" + strings.Join(qParams, "&")
+	}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.GetRequest(ctx, c.client, reqURL)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseResponse(ctx, response, &resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) DeleteApprovalPolicy(ctx context.Context, uuid string) error {
+	reqURL := fmt.Sprintf("%v/approval-policies/%v", c.config.URL, uuid)
+
+	response, err := clientutils.DeleteRequest(ctx, c.client, reqURL)
+	if err != nil {
+		return err
+	}
+
+	defer clientutils.CloseResponse(response)
+	return httputil.ParseEmptyBodyResponse(ctx, response)
+}
@@ -97,3 +97,19 @@ func (c *HTTPClient) DeleteChain(ctx context.Context, uuid string) error {
 	defer clientutils.CloseResponse(response)
 	return httputil.ParseEmptyBodyResponse(ctx, response)
 }
+
+func (c *HTTPClient) CallContract(ctx context.Context, chainUUID string, request *types.CallContractRequest) (*types.CallContractResponse, error) {
+	reqURL := fmt.Sprintf("%v/chains/%v/call", c.config.URL, chainUUID)
+	resp := &types.CallContractResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PostRequest(ctx, c.client, reqURL, request)
+		if err != nil {
+			return err
+		}
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
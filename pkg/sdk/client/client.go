@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"math/big"
 
 	utilstypes "github.com/consensys/quorum-key-manager/src/utils/api/types"
 
@@ -25,13 +26,19 @@ type OrchestrateClient interface {
 	FaucetClient
 	ChainClient
 	ContractClient
+	SubscriptionClient
+	ApprovalPolicyClient
+	TransactionPolicyClient
+	TokenClient
 }
 
 type TransactionClient interface {
 	SendContractTransaction(ctx context.Context, request *types.SendTransactionRequest) (*types.TransactionResponse, error)
+	SendContractTransactions(ctx context.Context, request *types.SendTransactionsRequest) (*types.SendTransactionsResponse, error)
 	SendDeployTransaction(ctx context.Context, request *types.DeployContractRequest) (*types.TransactionResponse, error)
 	SendRawTransaction(ctx context.Context, request *types.RawTransactionRequest) (*types.TransactionResponse, error)
 	SendTransferTransaction(ctx context.Context, request *types.TransferRequest) (*types.TransactionResponse, error)
+	SimulateTransaction(ctx context.Context, request *types.SimulateTransactionRequest) (*types.SimulateTransactionResponse, error)
 	GetTxRequest(ctx context.Context, txRequestUUID string) (*types.TransactionResponse, error)
 	CallOffTransaction(ctx context.Context, txRequestUUID string) (*types.TransactionResponse, error)
 	SpeedUpTransaction(ctx context.Context, txRequestUUID string, increment *float64) (*types.TransactionResponse, error)
@@ -41,7 +48,9 @@ type TransactionClient interface {
 type ScheduleClient interface {
 	GetSchedule(ctx context.Context, scheduleUUID string) (*types.ScheduleResponse, error)
 	GetSchedules(ctx context.Context) ([]*types.ScheduleResponse, error)
+	SearchSchedules(ctx context.Context, filters *entities.ScheduleFilters) (*types.ScheduleSearchResponse, error)
 	CreateSchedule(ctx context.Context, request *types.CreateScheduleRequest) (*types.ScheduleResponse, error)
+	CreateScheduleGraph(ctx context.Context, request *types.CreateScheduleGraphRequest) (*types.ScheduleResponse, error)
 }
 
 type JobClient interface {
@@ -52,6 +61,9 @@ type JobClient interface {
 	StartJob(ctx context.Context, jobUUID string) error
 	ResendJobTx(ctx context.Context, jobUUID string) error
 	SearchJob(ctx context.Context, filters *entities.JobFilters) ([]*types.JobResponse, error)
+	SearchJobPage(ctx context.Context, filters *entities.JobFilters) (*types.JobSearchResponse, error)
+	ApproveJob(ctx context.Context, jobUUID string) (*types.JobResponse, error)
+	RejectJob(ctx context.Context, jobUUID string) (*types.JobResponse, error)
 }
 
 type MetricClient interface {
@@ -64,6 +76,7 @@ type AccountClient interface {
 	SearchAccounts(ctx context.Context, filters *entities.AccountFilters) (*types.AccountSearchResponse, error)
 	GetAccount(ctx context.Context, address ethcommon.Address) (*types.AccountResponse, error)
 	ImportAccount(ctx context.Context, request *types.ImportAccountRequest) (*types.AccountResponse, error)
+	RegisterSmartAccount(ctx context.Context, request *types.RegisterSmartAccountRequest) (*types.AccountResponse, error)
 	UpdateAccount(ctx context.Context, address ethcommon.Address, request *types.UpdateAccountRequest) (*types.AccountResponse, error)
 	SignMessage(ctx context.Context, address ethcommon.Address, request *qkmtypes.SignMessageRequest) (string, error)
 	SignTypedData(ctx context.Context, address ethcommon.Address, request *qkmtypes.SignTypedDataRequest) (string, error)
@@ -71,6 +84,28 @@ type AccountClient interface {
 	VerifyTypedDataSignature(ctx context.Context, request *utilstypes.VerifyTypedDataRequest) error
 }
 
+type SubscriptionClient interface {
+	CreateSubscription(ctx context.Context, request *types.CreateSubscriptionRequest) (*types.SubscriptionResponse, error)
+	GetSubscription(ctx context.Context, uuid string) (*types.SubscriptionResponse, error)
+	SearchSubscriptions(ctx context.Context, filters *entities.SubscriptionFilters) ([]*types.SubscriptionResponse, error)
+	UpdateSubscription(ctx context.Context, uuid string, request *types.UpdateSubscriptionRequest) (*types.SubscriptionResponse, error)
+	DeleteSubscription(ctx context.Context, uuid string) error
+}
+
+type ApprovalPolicyClient interface {
+	CreateApprovalPolicy(ctx context.Context, request *types.CreateApprovalPolicyRequest) (*types.ApprovalPolicyResponse, error)
+	GetApprovalPolicy(ctx context.Context, uuid string) (*types.ApprovalPolicyResponse, error)
+	SearchApprovalPolicies(ctx context.Context, filters *entities.ApprovalPolicyFilters) ([]*types.ApprovalPolicyResponse, error)
+	DeleteApprovalPolicy(ctx context.Context, uuid string) error
+}
+
+type TransactionPolicyClient interface {
+	CreateTransactionPolicy(ctx context.Context, request *types.CreateTransactionPolicyRequest) (*types.TransactionPolicyResponse, error)
+	GetTransactionPolicy(ctx context.Context, uuid string) (*types.TransactionPolicyResponse, error)
+	SearchTransactionPolicies(ctx context.Context, filters *entities.TransactionPolicyFilters) ([]*types.TransactionPolicyResponse, error)
+	DeleteTransactionPolicy(ctx context.Context, uuid string) error
+}
+
 type FaucetClient interface {
 	RegisterFaucet(ctx context.Context, request *types.RegisterFaucetRequest) (*types.FaucetResponse, error)
 	UpdateFaucet(ctx context.Context, uuid string, request *types.UpdateFaucetRequest) (*types.FaucetResponse, error)
@@ -85,11 +120,13 @@ type ChainClient interface {
 	GetChain(ctx context.Context, uuid string) (*types.ChainResponse, error)
 	SearchChains(ctx context.Context, filters *entities.ChainFilters) ([]*types.ChainResponse, error)
 	DeleteChain(ctx context.Context, uuid string) error
+	CallContract(ctx context.Context, chainUUID string, request *types.CallContractRequest) (*types.CallContractResponse, error)
 }
 
 type ContractClient interface {
 	RegisterContract(ctx context.Context, req *types.RegisterContractRequest) (*types.ContractResponse, error)
 	DeregisterContract(ctx context.Context, name, tag string) error
+	SetContractTag(ctx context.Context, name, tag string, req *types.SetContractTagRequest) (*types.ContractResponse, error)
 	GetContract(ctx context.Context, name, tag string) (*types.ContractResponse, error)
 	SearchContract(ctx context.Context, req *types.SearchContractRequest) (*types.ContractResponse, error)
 	GetContractsCatalog(ctx context.Context) ([]string, error)
@@ -97,3 +134,10 @@ type ContractClient interface {
 	SetContractAddressCodeHash(ctx context.Context, address, chainID string, req *types.SetContractCodeHashRequest) error
 	GetContractEvents(ctx context.Context, address, chainID string, req *types.GetContractEventsRequest) (*types.GetContractEventsBySignHashResponse, error)
 }
+
+type TokenClient interface {
+	SendTokenTransaction(ctx context.Context, standard entities.TokenStandard, operation entities.TokenOperation, request *types.TokenTxRequest) (*types.TransactionResponse, error)
+	GetTokenBalance(ctx context.Context, chainUUID string, standard entities.TokenStandard, token, owner ethcommon.Address, tokenID *big.Int) (*types.TokenBalanceResponse, error)
+	GetTokenAllowance(ctx context.Context, chainUUID string, token, owner, spender ethcommon.Address) (*types.TokenAllowanceResponse, error)
+	GetTokenOwner(ctx context.Context, chainUUID string, token ethcommon.Address, tokenID *big.Int) (*types.TokenOwnerResponse, error)
+}
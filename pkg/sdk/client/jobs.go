@@ -3,6 +3,9 @@ package client
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -35,58 +38,96 @@ func (c *HTTPClient) GetJob(ctx context.Context, jobUUID string) (*types.JobResp
 }
 
 func (c *HTTPClient) GetJobs(ctx context.Context) ([]*types.JobResponse, error) {
-	reqURL := fmt.Sprintf("%v/jobs", c.config.URL)
-	var resp []*types.JobResponse
+	return c.SearchJob(ctx, &entities.JobFilters{})
+}
 
-	err := callWithBackOff(ctx, c.config.backOff, func() error {
-		response, err := clientutils.GetRequest(ctx, c.client, reqURL)
+// SearchJob returns all the jobs matching the filters, following the pages returned by the API
+func (c *HTTPClient) SearchJob(ctx context.Context, filters *entities.JobFilters) ([]*types.JobResponse, error) {
+	pageFilters := *filters
+	resp := []*types.JobResponse{}
+
+	for {
+		page, err := c.SearchJobPage(ctx, &pageFilters)
 		if err != nil {
-			errMessage := "error while getting jobs"
-			return errors.FromError(err).SetMessage(errMessage).AppendReason(err.Error()).ExtendComponent(component)
+			return nil, err
 		}
 
-		defer clientutils.CloseResponse(response)
-		return httputil.ParseResponse(ctx, response, &resp)
-	})
+		resp = append(resp, page.Jobs...)
+		if page.Next == "" {
+			return resp, nil
+		}
 
-	return resp, err
+		pageFilters.Pagination.Cursor = page.Next
+	}
 }
 
-func (c *HTTPClient) SearchJob(ctx context.Context, filters *entities.JobFilters) ([]*types.JobResponse, error) {
+// SearchJobPage returns a single page of the jobs matching the filters
+func (c *HTTPClient) SearchJobPage(ctx context.Context, filters *entities.JobFilters) (*types.JobSearchResponse, error) {
 	reqURL := fmt.Sprintf("%v/jobs", c.config.URL)
-	var resp []*types.JobResponse
+	resp := &types.JobSearchResponse{}
 
-	var qParams []string
+	qParams := url.Values{}
 	if len(filters.TxHashes) > 0 {
-		qParams = append(qParams, "tx_hashes="+strings.Join(filters.TxHashes, ","))
+		qParams.Set("tx_hashes", strings.Join(filters.TxHashes, ","))
 	}
 
 	if filters.ChainUUID != "" {
-		qParams = append(qParams, "chain_uuid="+filters.ChainUUID)
+		qParams.Set("chain_uuid", filters.ChainUUID)
 	}
 
 	if filters.Status != "" {
-		qParams = append(qParams, "status="+string(filters.Status))
+		qParams.Set("status", string(filters.Status))
+	}
+
+	if filters.Type != "" {
+		qParams.Set("type", string(filters.Type))
+	}
+
+	if len(filters.Labels) > 0 {
+		var labels []string
+		for key, value := range filters.Labels {
+			labels = append(labels, key+"="+value)
+		}
+		sort.Strings(labels)
+		qParams.Set("labels", strings.Join(labels, ","))
+	}
+
+	if filters.From != "" {
+		qParams.Set("from", filters.From)
+	}
+
+	if filters.To != "" {
+		qParams.Set("to", filters.To)
 	}
 
 	if !filters.UpdatedAfter.IsZero() {
-		qParams = append(qParams, "updated_after="+filters.UpdatedAfter.Format(time.RFC3339))
+		qParams.Set("updated_after", filters.UpdatedAfter.Format(time.RFC3339))
+	}
+
+	if !filters.CreatedAfter.IsZero() {
+		qParams.Set("created_after", filters.CreatedAfter.Format(time.RFC3339))
+	}
+
+	if !filters.CreatedBefore.IsZero() {
+		qParams.Set("created_before", filters.CreatedBefore.Format(time.RFC3339))
 	}
 
 	if filters.OnlyParents {
-		qParams = append(qParams, "only_parents=true")
+		qParams.Set("only_parents", "true")
 	}
 
 	if filters.ParentJobUUID != "" {
-		qParams = append(qParams, "parent_job_uuid="+filters.ParentJobUUID)
+		qParams.Set("parent_job_uuid", filters.ParentJobUUID)
 	}
 
 	if filters.WithLogs {
-		qParams = append(qParams, "with_logs=true")
+		qParams.Set("with_logs", "true")
 	}
 
+	setCursorPaginationParams(qParams, &filters.Pagination)
+
 	if len(qParams) > 0 {
-		reqURL = reqURL + "?" + strings.Join(qParams, "&")
+		reqURL = reqURL + "?" + qParams.Encode()
 	}
 
 	err := callWithBackOff(ctx, c.config.backOff, func() error {
@@ -96,10 +137,31 @@ func (c *HTTPClient) SearchJob(ctx context.Context, filters *entities.JobFilters
 			return errors.FromError(err).SetMessage(errMessage).AppendReason(err.Error()).ExtendComponent(component)
 		}
 		defer clientutils.CloseResponse(response)
-		return httputil.ParseResponse(ctx, response, &resp)
+		return httputil.ParseResponse(ctx, response, resp)
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return resp, err
+	return resp, nil
+}
+
+func setCursorPaginationParams(qParams url.Values, pagination *entities.CursorPagination) {
+	if pagination.Limit > 0 {
+		qParams.Set("limit", strconv.Itoa(pagination.Limit))
+	}
+
+	if pagination.Cursor != "" {
+		qParams.Set("cursor", pagination.Cursor)
+	}
+
+	if pagination.SortBy != "" {
+		qParams.Set("sort_by", pagination.SortBy)
+	}
+
+	if pagination.SortOrder != "" {
+		qParams.Set("order", pagination.SortOrder)
+	}
 }
 
 func (c *HTTPClient) CreateJob(ctx context.Context, request *types.CreateJobRequest) (*types.JobResponse, error) {
@@ -163,3 +225,37 @@ func (c *HTTPClient) ResendJobTx(ctx context.Context, jobUUID string) error {
 		return httputil.ParseEmptyBodyResponse(ctx, response)
 	})
 }
+
+func (c *HTTPClient) ApproveJob(ctx context.Context, jobUUID string) (*types.JobResponse, error) {
+	reqURL := fmt.Sprintf("%v/jobs/%s/approve", c.config.URL, jobUUID)
+	resp := &types.JobResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PutRequest(ctx, c.client, reqURL, nil)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) RejectJob(ctx context.Context, jobUUID string) (*types.JobResponse, error) {
+	reqURL := fmt.Sprintf("%v/jobs/%s/reject", c.config.URL, jobUUID)
+	resp := &types.JobResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PutRequest(ctx, c.client, reqURL, nil)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
@@ -14,6 +14,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	healthcheck "github.com/heptiolabs/healthcheck"
 	io_prometheus_client "github.com/prometheus/client_model/go"
+	big "math/big"
 	reflect "reflect"
 )
 
@@ -55,6 +56,21 @@ func (mr *MockOrchestrateClientMockRecorder) SendContractTransaction(ctx, reques
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendContractTransaction", reflect.TypeOf((*MockOrchestrateClient)(nil).SendContractTransaction), ctx, request)
 }
 
+// SendContractTransactions mocks base method
+func (m *MockOrchestrateClient) SendContractTransactions(ctx context.Context, request *api.SendTransactionsRequest) (*api.SendTransactionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendContractTransactions", ctx, request)
+	ret0, _ := ret[0].(*api.SendTransactionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendContractTransactions indicates an expected call of SendContractTransactions
+func (mr *MockOrchestrateClientMockRecorder) SendContractTransactions(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendContractTransactions", reflect.TypeOf((*MockOrchestrateClient)(nil).SendContractTransactions), ctx, request)
+}
+
 // SendDeployTransaction mocks base method
 func (m *MockOrchestrateClient) SendDeployTransaction(ctx context.Context, request *api.DeployContractRequest) (*api.TransactionResponse, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +116,21 @@ func (mr *MockOrchestrateClientMockRecorder) SendTransferTransaction(ctx, reques
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransferTransaction", reflect.TypeOf((*MockOrchestrateClient)(nil).SendTransferTransaction), ctx, request)
 }
 
+// SimulateTransaction mocks base method
+func (m *MockOrchestrateClient) SimulateTransaction(ctx context.Context, request *api.SimulateTransactionRequest) (*api.SimulateTransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulateTransaction", ctx, request)
+	ret0, _ := ret[0].(*api.SimulateTransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimulateTransaction indicates an expected call of SimulateTransaction
+func (mr *MockOrchestrateClientMockRecorder) SimulateTransaction(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateTransaction", reflect.TypeOf((*MockOrchestrateClient)(nil).SimulateTransaction), ctx, request)
+}
+
 // GetTxRequest mocks base method
 func (m *MockOrchestrateClient) GetTxRequest(ctx context.Context, txRequestUUID string) (*api.TransactionResponse, error) {
 	m.ctrl.T.Helper()
@@ -190,6 +221,21 @@ func (mr *MockOrchestrateClientMockRecorder) GetSchedules(ctx interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedules", reflect.TypeOf((*MockOrchestrateClient)(nil).GetSchedules), ctx)
 }
 
+// SearchSchedules mocks base method
+func (m *MockOrchestrateClient) SearchSchedules(ctx context.Context, filters *entities.ScheduleFilters) (*api.ScheduleSearchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchSchedules", ctx, filters)
+	ret0, _ := ret[0].(*api.ScheduleSearchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchSchedules indicates an expected call of SearchSchedules
+func (mr *MockOrchestrateClientMockRecorder) SearchSchedules(ctx, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSchedules", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchSchedules), ctx, filters)
+}
+
 // CreateSchedule mocks base method
 func (m *MockOrchestrateClient) CreateSchedule(ctx context.Context, request *api.CreateScheduleRequest) (*api.ScheduleResponse, error) {
 	m.ctrl.T.Helper()
@@ -205,6 +251,21 @@ func (mr *MockOrchestrateClientMockRecorder) CreateSchedule(ctx, request interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*MockOrchestrateClient)(nil).CreateSchedule), ctx, request)
 }
 
+// CreateScheduleGraph mocks base method
+func (m *MockOrchestrateClient) CreateScheduleGraph(ctx context.Context, request *api.CreateScheduleGraphRequest) (*api.ScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheduleGraph", ctx, request)
+	ret0, _ := ret[0].(*api.ScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScheduleGraph indicates an expected call of CreateScheduleGraph
+func (mr *MockOrchestrateClientMockRecorder) CreateScheduleGraph(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduleGraph", reflect.TypeOf((*MockOrchestrateClient)(nil).CreateScheduleGraph), ctx, request)
+}
+
 // GetJob mocks base method
 func (m *MockOrchestrateClient) GetJob(ctx context.Context, jobUUID string) (*api.JobResponse, error) {
 	m.ctrl.T.Helper()
@@ -308,6 +369,21 @@ func (mr *MockOrchestrateClientMockRecorder) SearchJob(ctx, filters interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchJob", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchJob), ctx, filters)
 }
 
+// SearchJobPage mocks base method
+func (m *MockOrchestrateClient) SearchJobPage(ctx context.Context, filters *entities.JobFilters) (*api.JobSearchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchJobPage", ctx, filters)
+	ret0, _ := ret[0].(*api.JobSearchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchJobPage indicates an expected call of SearchJobPage
+func (mr *MockOrchestrateClientMockRecorder) SearchJobPage(ctx, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchJobPage", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchJobPage), ctx, filters)
+}
+
 // Checker mocks base method
 func (m *MockOrchestrateClient) Checker() healthcheck.Check {
 	m.ctrl.T.Helper()
@@ -397,6 +473,21 @@ func (mr *MockOrchestrateClientMockRecorder) ImportAccount(ctx, request interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportAccount", reflect.TypeOf((*MockOrchestrateClient)(nil).ImportAccount), ctx, request)
 }
 
+// RegisterSmartAccount mocks base method
+func (m *MockOrchestrateClient) RegisterSmartAccount(ctx context.Context, request *api.RegisterSmartAccountRequest) (*api.AccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterSmartAccount", ctx, request)
+	ret0, _ := ret[0].(*api.AccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterSmartAccount indicates an expected call of RegisterSmartAccount
+func (mr *MockOrchestrateClientMockRecorder) RegisterSmartAccount(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSmartAccount", reflect.TypeOf((*MockOrchestrateClient)(nil).RegisterSmartAccount), ctx, request)
+}
+
 // UpdateAccount mocks base method
 func (m *MockOrchestrateClient) UpdateAccount(ctx context.Context, address common.Address, request *api.UpdateAccountRequest) (*api.AccountResponse, error) {
 	m.ctrl.T.Helper()
@@ -618,6 +709,21 @@ func (mr *MockOrchestrateClientMockRecorder) DeleteChain(ctx, uuid interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChain", reflect.TypeOf((*MockOrchestrateClient)(nil).DeleteChain), ctx, uuid)
 }
 
+// CallContract mocks base method
+func (m *MockOrchestrateClient) CallContract(ctx context.Context, chainUUID string, request *api.CallContractRequest) (*api.CallContractResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallContract", ctx, chainUUID, request)
+	ret0, _ := ret[0].(*api.CallContractResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CallContract indicates an expected call of CallContract
+func (mr *MockOrchestrateClientMockRecorder) CallContract(ctx, chainUUID, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallContract", reflect.TypeOf((*MockOrchestrateClient)(nil).CallContract), ctx, chainUUID, request)
+}
+
 // RegisterContract mocks base method
 func (m *MockOrchestrateClient) RegisterContract(ctx context.Context, req *api.RegisterContractRequest) (*api.ContractResponse, error) {
 	m.ctrl.T.Helper()
@@ -647,6 +753,21 @@ func (mr *MockOrchestrateClientMockRecorder) DeregisterContract(ctx, name, tag i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterContract", reflect.TypeOf((*MockOrchestrateClient)(nil).DeregisterContract), ctx, name, tag)
 }
 
+// SetContractTag mocks base method
+func (m *MockOrchestrateClient) SetContractTag(ctx context.Context, name, tag string, req *api.SetContractTagRequest) (*api.ContractResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetContractTag", ctx, name, tag, req)
+	ret0, _ := ret[0].(*api.ContractResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetContractTag indicates an expected call of SetContractTag
+func (mr *MockOrchestrateClientMockRecorder) SetContractTag(ctx, name, tag, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetContractTag", reflect.TypeOf((*MockOrchestrateClient)(nil).SetContractTag), ctx, name, tag, req)
+}
+
 // GetContract mocks base method
 func (m *MockOrchestrateClient) GetContract(ctx context.Context, name, tag string) (*api.ContractResponse, error) {
 	m.ctrl.T.Helper()
@@ -736,6 +857,288 @@ func (mr *MockOrchestrateClientMockRecorder) GetContractEvents(ctx, address, cha
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractEvents", reflect.TypeOf((*MockOrchestrateClient)(nil).GetContractEvents), ctx, address, chainID, req)
 }
 
+// CreateSubscription mocks base method
+func (m *MockOrchestrateClient) CreateSubscription(ctx context.Context, request *api.CreateSubscriptionRequest) (*api.SubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", ctx, request)
+	ret0, _ := ret[0].(*api.SubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription
+func (mr *MockOrchestrateClientMockRecorder) CreateSubscription(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockOrchestrateClient)(nil).CreateSubscription), ctx, request)
+}
+
+// GetSubscription mocks base method
+func (m *MockOrchestrateClient) GetSubscription(ctx context.Context, uuid string) (*api.SubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscription", ctx, uuid)
+	ret0, _ := ret[0].(*api.SubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscription indicates an expected call of GetSubscription
+func (mr *MockOrchestrateClientMockRecorder) GetSubscription(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockOrchestrateClient)(nil).GetSubscription), ctx, uuid)
+}
+
+// SearchSubscriptions mocks base method
+func (m *MockOrchestrateClient) SearchSubscriptions(ctx context.Context, filters *entities.SubscriptionFilters) ([]*api.SubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchSubscriptions", ctx, filters)
+	ret0, _ := ret[0].([]*api.SubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchSubscriptions indicates an expected call of SearchSubscriptions
+func (mr *MockOrchestrateClientMockRecorder) SearchSubscriptions(ctx, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSubscriptions", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchSubscriptions), ctx, filters)
+}
+
+// UpdateSubscription mocks base method
+func (m *MockOrchestrateClient) UpdateSubscription(ctx context.Context, uuid string, request *api.UpdateSubscriptionRequest) (*api.SubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscription", ctx, uuid, request)
+	ret0, _ := ret[0].(*api.SubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSubscription indicates an expected call of UpdateSubscription
+func (mr *MockOrchestrateClientMockRecorder) UpdateSubscription(ctx, uuid, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscription", reflect.TypeOf((*MockOrchestrateClient)(nil).UpdateSubscription), ctx, uuid, request)
+}
+
+// DeleteSubscription mocks base method
+func (m *MockOrchestrateClient) DeleteSubscription(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription
+func (mr *MockOrchestrateClientMockRecorder) DeleteSubscription(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockOrchestrateClient)(nil).DeleteSubscription), ctx, uuid)
+}
+
+// SendTokenTransaction mocks base method
+func (m *MockOrchestrateClient) SendTokenTransaction(ctx context.Context, standard entities.TokenStandard, operation entities.TokenOperation, request *api.TokenTxRequest) (*api.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTokenTransaction", ctx, standard, operation, request)
+	ret0, _ := ret[0].(*api.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendTokenTransaction indicates an expected call of SendTokenTransaction
+func (mr *MockOrchestrateClientMockRecorder) SendTokenTransaction(ctx, standard, operation, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTokenTransaction", reflect.TypeOf((*MockOrchestrateClient)(nil).SendTokenTransaction), ctx, standard, operation, request)
+}
+
+// GetTokenBalance mocks base method
+func (m *MockOrchestrateClient) GetTokenBalance(ctx context.Context, chainUUID string, standard entities.TokenStandard, token, owner common.Address, tokenID *big.Int) (*api.TokenBalanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTokenBalance", ctx, chainUUID, standard, token, owner, tokenID)
+	ret0, _ := ret[0].(*api.TokenBalanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTokenBalance indicates an expected call of GetTokenBalance
+func (mr *MockOrchestrateClientMockRecorder) GetTokenBalance(ctx, chainUUID, standard, token, owner, tokenID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenBalance", reflect.TypeOf((*MockOrchestrateClient)(nil).GetTokenBalance), ctx, chainUUID, standard, token, owner, tokenID)
+}
+
+// GetTokenAllowance mocks base method
+func (m *MockOrchestrateClient) GetTokenAllowance(ctx context.Context, chainUUID string, token, owner, spender common.Address) (*api.TokenAllowanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTokenAllowance", ctx, chainUUID, token, owner, spender)
+	ret0, _ := ret[0].(*api.TokenAllowanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTokenAllowance indicates an expected call of GetTokenAllowance
+func (mr *MockOrchestrateClientMockRecorder) GetTokenAllowance(ctx, chainUUID, token, owner, spender interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenAllowance", reflect.TypeOf((*MockOrchestrateClient)(nil).GetTokenAllowance), ctx, chainUUID, token, owner, spender)
+}
+
+// GetTokenOwner mocks base method
+func (m *MockOrchestrateClient) GetTokenOwner(ctx context.Context, chainUUID string, token common.Address, tokenID *big.Int) (*api.TokenOwnerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTokenOwner", ctx, chainUUID, token, tokenID)
+	ret0, _ := ret[0].(*api.TokenOwnerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTokenOwner indicates an expected call of GetTokenOwner
+func (mr *MockOrchestrateClientMockRecorder) GetTokenOwner(ctx, chainUUID, token, tokenID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenOwner", reflect.TypeOf((*MockOrchestrateClient)(nil).GetTokenOwner), ctx, chainUUID, token, tokenID)
+}
+
+// ApproveJob mocks base method
+func (m *MockOrchestrateClient) ApproveJob(ctx context.Context, jobUUID string) (*api.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveJob", ctx, jobUUID)
+	ret0, _ := ret[0].(*api.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveJob indicates an expected call of ApproveJob
+func (mr *MockOrchestrateClientMockRecorder) ApproveJob(ctx, jobUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveJob", reflect.TypeOf((*MockOrchestrateClient)(nil).ApproveJob), ctx, jobUUID)
+}
+
+// RejectJob mocks base method
+func (m *MockOrchestrateClient) RejectJob(ctx context.Context, jobUUID string) (*api.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectJob", ctx, jobUUID)
+	ret0, _ := ret[0].(*api.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectJob indicates an expected call of RejectJob
+func (mr *MockOrchestrateClientMockRecorder) RejectJob(ctx, jobUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectJob", reflect.TypeOf((*MockOrchestrateClient)(nil).RejectJob), ctx, jobUUID)
+}
+
+// CreateApprovalPolicy mocks base method
+func (m *MockOrchestrateClient) CreateApprovalPolicy(ctx context.Context, request *api.CreateApprovalPolicyRequest) (*api.ApprovalPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateApprovalPolicy", ctx, request)
+	ret0, _ := ret[0].(*api.ApprovalPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateApprovalPolicy indicates an expected call of CreateApprovalPolicy
+func (mr *MockOrchestrateClientMockRecorder) CreateApprovalPolicy(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApprovalPolicy", reflect.TypeOf((*MockOrchestrateClient)(nil).CreateApprovalPolicy), ctx, request)
+}
+
+// GetApprovalPolicy mocks base method
+func (m *MockOrchestrateClient) GetApprovalPolicy(ctx context.Context, uuid string) (*api.ApprovalPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApprovalPolicy", ctx, uuid)
+	ret0, _ := ret[0].(*api.ApprovalPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApprovalPolicy indicates an expected call of GetApprovalPolicy
+func (mr *MockOrchestrateClientMockRecorder) GetApprovalPolicy(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApprovalPolicy", reflect.TypeOf((*MockOrchestrateClient)(nil).GetApprovalPolicy), ctx, uuid)
+}
+
+// SearchApprovalPolicies mocks base method
+func (m *MockOrchestrateClient) SearchApprovalPolicies(ctx context.Context, filters *entities.ApprovalPolicyFilters) ([]*api.ApprovalPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchApprovalPolicies", ctx, filters)
+	ret0, _ := ret[0].([]*api.ApprovalPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchApprovalPolicies indicates an expected call of SearchApprovalPolicies
+func (mr *MockOrchestrateClientMockRecorder) SearchApprovalPolicies(ctx, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchApprovalPolicies", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchApprovalPolicies), ctx, filters)
+}
+
+// DeleteApprovalPolicy mocks base method
+func (m *MockOrchestrateClient) DeleteApprovalPolicy(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteApprovalPolicy", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteApprovalPolicy indicates an expected call of DeleteApprovalPolicy
+func (mr *MockOrchestrateClientMockRecorder) DeleteApprovalPolicy(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApprovalPolicy", reflect.TypeOf((*MockOrchestrateClient)(nil).DeleteApprovalPolicy), ctx, uuid)
+}
+
+// CreateTransactionPolicy mocks base method
+func (m *MockOrchestrateClient) CreateTransactionPolicy(ctx context.Context, request *api.CreateTransactionPolicyRequest) (*api.TransactionPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransactionPolicy", ctx, request)
+	ret0, _ := ret[0].(*api.TransactionPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransactionPolicy indicates an expected call of CreateTransactionPolicy
+func (mr *MockOrchestrateClientMockRecorder) CreateTransactionPolicy(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransactionPolicy", reflect.TypeOf((*MockOrchestrateClient)(nil).CreateTransactionPolicy), ctx, request)
+}
+
+// GetTransactionPolicy mocks base method
+func (m *MockOrchestrateClient) GetTransactionPolicy(ctx context.Context, uuid string) (*api.TransactionPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionPolicy", ctx, uuid)
+	ret0, _ := ret[0].(*api.TransactionPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionPolicy indicates an expected call of GetTransactionPolicy
+func (mr *MockOrchestrateClientMockRecorder) GetTransactionPolicy(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionPolicy", reflect.TypeOf((*MockOrchestrateClient)(nil).GetTransactionPolicy), ctx, uuid)
+}
+
+// SearchTransactionPolicies mocks base method
+func (m *MockOrchestrateClient) SearchTransactionPolicies(ctx context.Context, filters *entities.TransactionPolicyFilters) ([]*api.TransactionPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchTransactionPolicies", ctx, filters)
+	ret0, _ := ret[0].([]*api.TransactionPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchTransactionPolicies indicates an expected call of SearchTransactionPolicies
+func (mr *MockOrchestrateClientMockRecorder) SearchTransactionPolicies(ctx, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTransactionPolicies", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchTransactionPolicies), ctx, filters)
+}
+
+// DeleteTransactionPolicy mocks base method
+func (m *MockOrchestrateClient) DeleteTransactionPolicy(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransactionPolicy", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransactionPolicy indicates an expected call of DeleteTransactionPolicy
+func (mr *MockOrchestrateClientMockRecorder) DeleteTransactionPolicy(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransactionPolicy", reflect.TypeOf((*MockOrchestrateClient)(nil).DeleteTransactionPolicy), ctx, uuid)
+}
+
 // MockTransactionClient is a mock of TransactionClient interface
 type MockTransactionClient struct {
 	ctrl     *gomock.Controller
@@ -774,6 +1177,21 @@ func (mr *MockTransactionClientMockRecorder) SendContractTransaction(ctx, reques
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendContractTransaction", reflect.TypeOf((*MockTransactionClient)(nil).SendContractTransaction), ctx, request)
 }
 
+// SendContractTransactions mocks base method
+func (m *MockTransactionClient) SendContractTransactions(ctx context.Context, request *api.SendTransactionsRequest) (*api.SendTransactionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendContractTransactions", ctx, request)
+	ret0, _ := ret[0].(*api.SendTransactionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendContractTransactions indicates an expected call of SendContractTransactions
+func (mr *MockTransactionClientMockRecorder) SendContractTransactions(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendContractTransactions", reflect.TypeOf((*MockTransactionClient)(nil).SendContractTransactions), ctx, request)
+}
+
 // SendDeployTransaction mocks base method
 func (m *MockTransactionClient) SendDeployTransaction(ctx context.Context, request *api.DeployContractRequest) (*api.TransactionResponse, error) {
 	m.ctrl.T.Helper()
@@ -819,6 +1237,21 @@ func (mr *MockTransactionClientMockRecorder) SendTransferTransaction(ctx, reques
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransferTransaction", reflect.TypeOf((*MockTransactionClient)(nil).SendTransferTransaction), ctx, request)
 }
 
+// SimulateTransaction mocks base method
+func (m *MockTransactionClient) SimulateTransaction(ctx context.Context, request *api.SimulateTransactionRequest) (*api.SimulateTransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulateTransaction", ctx, request)
+	ret0, _ := ret[0].(*api.SimulateTransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimulateTransaction indicates an expected call of SimulateTransaction
+func (mr *MockTransactionClientMockRecorder) SimulateTransaction(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateTransaction", reflect.TypeOf((*MockTransactionClient)(nil).SimulateTransaction), ctx, request)
+}
+
 // GetTxRequest mocks base method
 func (m *MockTransactionClient) GetTxRequest(ctx context.Context, txRequestUUID string) (*api.TransactionResponse, error) {
 	m.ctrl.T.Helper()
@@ -932,6 +1365,21 @@ func (mr *MockScheduleClientMockRecorder) GetSchedules(ctx interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedules", reflect.TypeOf((*MockScheduleClient)(nil).GetSchedules), ctx)
 }
 
+// SearchSchedules mocks base method
+func (m *MockScheduleClient) SearchSchedules(ctx context.Context, filters *entities.ScheduleFilters) (*api.ScheduleSearchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchSchedules", ctx, filters)
+	ret0, _ := ret[0].(*api.ScheduleSearchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchSchedules indicates an expected call of SearchSchedules
+func (mr *MockScheduleClientMockRecorder) SearchSchedules(ctx, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSchedules", reflect.TypeOf((*MockScheduleClient)(nil).SearchSchedules), ctx, filters)
+}
+
 // CreateSchedule mocks base method
 func (m *MockScheduleClient) CreateSchedule(ctx context.Context, request *api.CreateScheduleRequest) (*api.ScheduleResponse, error) {
 	m.ctrl.T.Helper()
@@ -947,6 +1395,21 @@ func (mr *MockScheduleClientMockRecorder) CreateSchedule(ctx, request interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*MockScheduleClient)(nil).CreateSchedule), ctx, request)
 }
 
+// CreateScheduleGraph mocks base method
+func (m *MockScheduleClient) CreateScheduleGraph(ctx context.Context, request *api.CreateScheduleGraphRequest) (*api.ScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheduleGraph", ctx, request)
+	ret0, _ := ret[0].(*api.ScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScheduleGraph indicates an expected call of CreateScheduleGraph
+func (mr *MockScheduleClientMockRecorder) CreateScheduleGraph(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduleGraph", reflect.TypeOf((*MockScheduleClient)(nil).CreateScheduleGraph), ctx, request)
+}
+
 // MockJobClient is a mock of JobClient interface
 type MockJobClient struct {
 	ctrl     *gomock.Controller
@@ -1073,6 +1536,51 @@ func (mr *MockJobClientMockRecorder) SearchJob(ctx, filters interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchJob", reflect.TypeOf((*MockJobClient)(nil).SearchJob), ctx, filters)
 }
 
+// SearchJobPage mocks base method
+func (m *MockJobClient) SearchJobPage(ctx context.Context, filters *entities.JobFilters) (*api.JobSearchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchJobPage", ctx, filters)
+	ret0, _ := ret[0].(*api.JobSearchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchJobPage indicates an expected call of SearchJobPage
+func (mr *MockJobClientMockRecorder) SearchJobPage(ctx, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchJobPage", reflect.TypeOf((*MockJobClient)(nil).SearchJobPage), ctx, filters)
+}
+
+// ApproveJob mocks base method
+func (m *MockJobClient) ApproveJob(ctx context.Context, jobUUID string) (*api.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveJob", ctx, jobUUID)
+	ret0, _ := ret[0].(*api.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveJob indicates an expected call of ApproveJob
+func (mr *MockJobClientMockRecorder) ApproveJob(ctx, jobUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveJob", reflect.TypeOf((*MockJobClient)(nil).ApproveJob), ctx, jobUUID)
+}
+
+// RejectJob mocks base method
+func (m *MockJobClient) RejectJob(ctx context.Context, jobUUID string) (*api.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectJob", ctx, jobUUID)
+	ret0, _ := ret[0].(*api.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectJob indicates an expected call of RejectJob
+func (mr *MockJobClientMockRecorder) RejectJob(ctx, jobUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectJob", reflect.TypeOf((*MockJobClient)(nil).RejectJob), ctx, jobUUID)
+}
+
 // MockMetricClient is a mock of MetricClient interface
 type MockMetricClient struct {
 	ctrl     *gomock.Controller
@@ -1208,6 +1716,21 @@ func (mr *MockAccountClientMockRecorder) ImportAccount(ctx, request interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportAccount", reflect.TypeOf((*MockAccountClient)(nil).ImportAccount), ctx, request)
 }
 
+// RegisterSmartAccount mocks base method
+func (m *MockAccountClient) RegisterSmartAccount(ctx context.Context, request *api.RegisterSmartAccountRequest) (*api.AccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterSmartAccount", ctx, request)
+	ret0, _ := ret[0].(*api.AccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterSmartAccount indicates an expected call of RegisterSmartAccount
+func (mr *MockAccountClientMockRecorder) RegisterSmartAccount(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSmartAccount", reflect.TypeOf((*MockAccountClient)(nil).RegisterSmartAccount), ctx, request)
+}
+
 // UpdateAccount mocks base method
 func (m *MockAccountClient) UpdateAccount(ctx context.Context, address common.Address, request *api.UpdateAccountRequest) (*api.AccountResponse, error) {
 	m.ctrl.T.Helper()
@@ -1281,6 +1804,267 @@ func (mr *MockAccountClientMockRecorder) VerifyTypedDataSignature(ctx, request i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyTypedDataSignature", reflect.TypeOf((*MockAccountClient)(nil).VerifyTypedDataSignature), ctx, request)
 }
 
+// MockSubscriptionClient is a mock of SubscriptionClient interface
+type MockSubscriptionClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubscriptionClientMockRecorder
+}
+
+// MockSubscriptionClientMockRecorder is the mock recorder for MockSubscriptionClient
+type MockSubscriptionClientMockRecorder struct {
+	mock *MockSubscriptionClient
+}
+
+// NewMockSubscriptionClient creates a new mock instance
+func NewMockSubscriptionClient(ctrl *gomock.Controller) *MockSubscriptionClient {
+	mock := &MockSubscriptionClient{ctrl: ctrl}
+	mock.recorder = &MockSubscriptionClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSubscriptionClient) EXPECT() *MockSubscriptionClientMockRecorder {
+	return m.recorder
+}
+
+// CreateSubscription mocks base method
+func (m *MockSubscriptionClient) CreateSubscription(ctx context.Context, request *api.CreateSubscriptionRequest) (*api.SubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", ctx, request)
+	ret0, _ := ret[0].(*api.SubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription
+func (mr *MockSubscriptionClientMockRecorder) CreateSubscription(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockSubscriptionClient)(nil).CreateSubscription), ctx, request)
+}
+
+// DeleteSubscription mocks base method
+func (m *MockSubscriptionClient) DeleteSubscription(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription
+func (mr *MockSubscriptionClientMockRecorder) DeleteSubscription(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockSubscriptionClient)(nil).DeleteSubscription), ctx, uuid)
+}
+
+// GetSubscription mocks base method
+func (m *MockSubscriptionClient) GetSubscription(ctx context.Context, uuid string) (*api.SubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscription", ctx, uuid)
+	ret0, _ := ret[0].(*api.SubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscription indicates an expected call of GetSubscription
+func (mr *MockSubscriptionClientMockRecorder) GetSubscription(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockSubscriptionClient)(nil).GetSubscription), ctx, uuid)
+}
+
+// SearchSubscriptions mocks base method
+func (m *MockSubscriptionClient) SearchSubscriptions(ctx context.Context, filters *entities.SubscriptionFilters) ([]*api.SubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchSubscriptions", ctx, filters)
+	ret0, _ := ret[0].([]*api.SubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchSubscriptions indicates an expected call of SearchSubscriptions
+func (mr *MockSubscriptionClientMockRecorder) SearchSubscriptions(ctx, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSubscriptions", reflect.TypeOf((*MockSubscriptionClient)(nil).SearchSubscriptions), ctx, filters)
+}
+
+// UpdateSubscription mocks base method
+func (m *MockSubscriptionClient) UpdateSubscription(ctx context.Context, uuid string, request *api.UpdateSubscriptionRequest) (*api.SubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscription", ctx, uuid, request)
+	ret0, _ := ret[0].(*api.SubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSubscription indicates an expected call of UpdateSubscription
+func (mr *MockSubscriptionClientMockRecorder) UpdateSubscription(ctx, uuid, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscription", reflect.TypeOf((*MockSubscriptionClient)(nil).UpdateSubscription), ctx, uuid, request)
+}
+
+// MockApprovalPolicyClient is a mock of ApprovalPolicyClient interface
+type MockApprovalPolicyClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockApprovalPolicyClientMockRecorder
+}
+
+// MockApprovalPolicyClientMockRecorder is the mock recorder for MockApprovalPolicyClient
+type MockApprovalPolicyClientMockRecorder struct {
+	mock *MockApprovalPolicyClient
+}
+
+// NewMockApprovalPolicyClient creates a new mock instance
+func NewMockApprovalPolicyClient(ctrl *gomock.Controller) *MockApprovalPolicyClient {
+	mock := &MockApprovalPolicyClient{ctrl: ctrl}
+	mock.recorder = &MockApprovalPolicyClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockApprovalPolicyClient) EXPECT() *MockApprovalPolicyClientMockRecorder {
+	return m.recorder
+}
+
+// CreateApprovalPolicy mocks base method
+func (m *MockApprovalPolicyClient) CreateApprovalPolicy(ctx context.Context, request *api.CreateApprovalPolicyRequest) (*api.ApprovalPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateApprovalPolicy", ctx, request)
+	ret0, _ := ret[0].(*api.ApprovalPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateApprovalPolicy indicates an expected call of CreateApprovalPolicy
+func (mr *MockApprovalPolicyClientMockRecorder) CreateApprovalPolicy(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApprovalPolicy", reflect.TypeOf((*MockApprovalPolicyClient)(nil).CreateApprovalPolicy), ctx, request)
+}
+
+// GetApprovalPolicy mocks base method
+func (m *MockApprovalPolicyClient) GetApprovalPolicy(ctx context.Context, uuid string) (*api.ApprovalPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApprovalPolicy", ctx, uuid)
+	ret0, _ := ret[0].(*api.ApprovalPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApprovalPolicy indicates an expected call of GetApprovalPolicy
+func (mr *MockApprovalPolicyClientMockRecorder) GetApprovalPolicy(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApprovalPolicy", reflect.TypeOf((*MockApprovalPolicyClient)(nil).GetApprovalPolicy), ctx, uuid)
+}
+
+// SearchApprovalPolicies mocks base method
+func (m *MockApprovalPolicyClient) SearchApprovalPolicies(ctx context.Context, filters *entities.ApprovalPolicyFilters) ([]*api.ApprovalPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchApprovalPolicies", ctx, filters)
+	ret0, _ := ret[0].([]*api.ApprovalPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchApprovalPolicies indicates an expected call of SearchApprovalPolicies
+func (mr *MockApprovalPolicyClientMockRecorder) SearchApprovalPolicies(ctx, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchApprovalPolicies", reflect.TypeOf((*MockApprovalPolicyClient)(nil).SearchApprovalPolicies), ctx, filters)
+}
+
+// DeleteApprovalPolicy mocks base method
+func (m *MockApprovalPolicyClient) DeleteApprovalPolicy(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteApprovalPolicy", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteApprovalPolicy indicates an expected call of DeleteApprovalPolicy
+func (mr *MockApprovalPolicyClientMockRecorder) DeleteApprovalPolicy(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApprovalPolicy", reflect.TypeOf((*MockApprovalPolicyClient)(nil).DeleteApprovalPolicy), ctx, uuid)
+}
+
+// MockTransactionPolicyClient is a mock of TransactionPolicyClient interface
+type MockTransactionPolicyClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionPolicyClientMockRecorder
+}
+
+// MockTransactionPolicyClientMockRecorder is the mock recorder for MockTransactionPolicyClient
+type MockTransactionPolicyClientMockRecorder struct {
+	mock *MockTransactionPolicyClient
+}
+
+// NewMockTransactionPolicyClient creates a new mock instance
+func NewMockTransactionPolicyClient(ctrl *gomock.Controller) *MockTransactionPolicyClient {
+	mock := &MockTransactionPolicyClient{ctrl: ctrl}
+	mock.recorder = &MockTransactionPolicyClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTransactionPolicyClient) EXPECT() *MockTransactionPolicyClientMockRecorder {
+	return m.recorder
+}
+
+// CreateTransactionPolicy mocks base method
+func (m *MockTransactionPolicyClient) CreateTransactionPolicy(ctx context.Context, request *api.CreateTransactionPolicyRequest) (*api.TransactionPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransactionPolicy", ctx, request)
+	ret0, _ := ret[0].(*api.TransactionPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransactionPolicy indicates an expected call of CreateTransactionPolicy
+func (mr *MockTransactionPolicyClientMockRecorder) CreateTransactionPolicy(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransactionPolicy", reflect.TypeOf((*MockTransactionPolicyClient)(nil).CreateTransactionPolicy), ctx, request)
+}
+
+// GetTransactionPolicy mocks base method
+func (m *MockTransactionPolicyClient) GetTransactionPolicy(ctx context.Context, uuid string) (*api.TransactionPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionPolicy", ctx, uuid)
+	ret0, _ := ret[0].(*api.TransactionPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionPolicy indicates an expected call of GetTransactionPolicy
+func (mr *MockTransactionPolicyClientMockRecorder) GetTransactionPolicy(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionPolicy", reflect.TypeOf((*MockTransactionPolicyClient)(nil).GetTransactionPolicy), ctx, uuid)
+}
+
+// SearchTransactionPolicies mocks base method
+func (m *MockTransactionPolicyClient) SearchTransactionPolicies(ctx context.Context, filters *entities.TransactionPolicyFilters) ([]*api.TransactionPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchTransactionPolicies", ctx, filters)
+	ret0, _ := ret[0].([]*api.TransactionPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchTransactionPolicies indicates an expected call of SearchTransactionPolicies
+func (mr *MockTransactionPolicyClientMockRecorder) SearchTransactionPolicies(ctx, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTransactionPolicies", reflect.TypeOf((*MockTransactionPolicyClient)(nil).SearchTransactionPolicies), ctx, filters)
+}
+
+// DeleteTransactionPolicy mocks base method
+func (m *MockTransactionPolicyClient) DeleteTransactionPolicy(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransactionPolicy", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransactionPolicy indicates an expected call of DeleteTransactionPolicy
+func (mr *MockTransactionPolicyClientMockRecorder) DeleteTransactionPolicy(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransactionPolicy", reflect.TypeOf((*MockTransactionPolicyClient)(nil).DeleteTransactionPolicy), ctx, uuid)
+}
+
 // MockFaucetClient is a mock of FaucetClient interface
 type MockFaucetClient struct {
 	ctrl     *gomock.Controller
@@ -1475,6 +2259,21 @@ func (mr *MockChainClientMockRecorder) DeleteChain(ctx, uuid interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChain", reflect.TypeOf((*MockChainClient)(nil).DeleteChain), ctx, uuid)
 }
 
+// CallContract mocks base method
+func (m *MockChainClient) CallContract(ctx context.Context, chainUUID string, request *api.CallContractRequest) (*api.CallContractResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallContract", ctx, chainUUID, request)
+	ret0, _ := ret[0].(*api.CallContractResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CallContract indicates an expected call of CallContract
+func (mr *MockChainClientMockRecorder) CallContract(ctx, chainUUID, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallContract", reflect.TypeOf((*MockChainClient)(nil).CallContract), ctx, chainUUID, request)
+}
+
 // MockContractClient is a mock of ContractClient interface
 type MockContractClient struct {
 	ctrl     *gomock.Controller
@@ -1527,6 +2326,21 @@ func (mr *MockContractClientMockRecorder) DeregisterContract(ctx, name, tag inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterContract", reflect.TypeOf((*MockContractClient)(nil).DeregisterContract), ctx, name, tag)
 }
 
+// SetContractTag mocks base method
+func (m *MockContractClient) SetContractTag(ctx context.Context, name, tag string, req *api.SetContractTagRequest) (*api.ContractResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetContractTag", ctx, name, tag, req)
+	ret0, _ := ret[0].(*api.ContractResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetContractTag indicates an expected call of SetContractTag
+func (mr *MockContractClientMockRecorder) SetContractTag(ctx, name, tag, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetContractTag", reflect.TypeOf((*MockContractClient)(nil).SetContractTag), ctx, name, tag, req)
+}
+
 // GetContract mocks base method
 func (m *MockContractClient) GetContract(ctx context.Context, name, tag string) (*api.ContractResponse, error) {
 	m.ctrl.T.Helper()
@@ -1615,3 +2429,86 @@ func (mr *MockContractClientMockRecorder) GetContractEvents(ctx, address, chainI
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractEvents", reflect.TypeOf((*MockContractClient)(nil).GetContractEvents), ctx, address, chainID, req)
 }
+
+// MockTokenClient is a mock of TokenClient interface
+type MockTokenClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenClientMockRecorder
+}
+
+// MockTokenClientMockRecorder is the mock recorder for MockTokenClient
+type MockTokenClientMockRecorder struct {
+	mock *MockTokenClient
+}
+
+// NewMockTokenClient creates a new mock instance
+func NewMockTokenClient(ctrl *gomock.Controller) *MockTokenClient {
+	mock := &MockTokenClient{ctrl: ctrl}
+	mock.recorder = &MockTokenClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTokenClient) EXPECT() *MockTokenClientMockRecorder {
+	return m.recorder
+}
+
+// SendTokenTransaction mocks base method
+func (m *MockTokenClient) SendTokenTransaction(ctx context.Context, standard entities.TokenStandard, operation entities.TokenOperation, request *api.TokenTxRequest) (*api.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTokenTransaction", ctx, standard, operation, request)
+	ret0, _ := ret[0].(*api.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendTokenTransaction indicates an expected call of SendTokenTransaction
+func (mr *MockTokenClientMockRecorder) SendTokenTransaction(ctx, standard, operation, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTokenTransaction", reflect.TypeOf((*MockTokenClient)(nil).SendTokenTransaction), ctx, standard, operation, request)
+}
+
+// GetTokenBalance mocks base method
+func (m *MockTokenClient) GetTokenBalance(ctx context.Context, chainUUID string, standard entities.TokenStandard, token, owner common.Address, tokenID *big.Int) (*api.TokenBalanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTokenBalance", ctx, chainUUID, standard, token, owner, tokenID)
+	ret0, _ := ret[0].(*api.TokenBalanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTokenBalance indicates an expected call of GetTokenBalance
+func (mr *MockTokenClientMockRecorder) GetTokenBalance(ctx, chainUUID, standard, token, owner, tokenID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenBalance", reflect.TypeOf((*MockTokenClient)(nil).GetTokenBalance), ctx, chainUUID, standard, token, owner, tokenID)
+}
+
+// GetTokenAllowance mocks base method
+func (m *MockTokenClient) GetTokenAllowance(ctx context.Context, chainUUID string, token, owner, spender common.Address) (*api.TokenAllowanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTokenAllowance", ctx, chainUUID, token, owner, spender)
+	ret0, _ := ret[0].(*api.TokenAllowanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTokenAllowance indicates an expected call of GetTokenAllowance
+func (mr *MockTokenClientMockRecorder) GetTokenAllowance(ctx, chainUUID, token, owner, spender interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenAllowance", reflect.TypeOf((*MockTokenClient)(nil).GetTokenAllowance), ctx, chainUUID, token, owner, spender)
+}
+
+// GetTokenOwner mocks base method
+func (m *MockTokenClient) GetTokenOwner(ctx context.Context, chainUUID string, token common.Address, tokenID *big.Int) (*api.TokenOwnerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTokenOwner", ctx, chainUUID, token, tokenID)
+	ret0, _ := ret[0].(*api.TokenOwnerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTokenOwner indicates an expected call of GetTokenOwner
+func (mr *MockTokenClientMockRecorder) GetTokenOwner(ctx, chainUUID, token, tokenID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenOwner", reflect.TypeOf((*MockTokenClient)(nil).GetTokenOwner), ctx, chainUUID, token, tokenID)
+}
@@ -3,9 +3,12 @@ package client
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"time"
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/http/httputil"
 	types "github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
 
 	clientutils "github.com/consensys/orchestrate/pkg/toolkit/app/http/client-utils"
 )
@@ -27,6 +30,23 @@ func (c *HTTPClient) CreateSchedule(ctx context.Context, request *types.CreateSc
 	return resp, err
 }
 
+func (c *HTTPClient) CreateScheduleGraph(ctx context.Context, request *types.CreateScheduleGraphRequest) (*types.ScheduleResponse, error) {
+	reqURL := fmt.Sprintf("%v/schedules/graph", c.config.URL)
+	resp := &types.ScheduleResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PostRequest(ctx, c.client, reqURL, request)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
+
 func (c *HTTPClient) GetSchedule(ctx context.Context, scheduleUUID string) (*types.ScheduleResponse, error) {
 	reqURL := fmt.Sprintf("%v/schedules/%v", c.config.URL, scheduleUUID)
 	resp := &types.ScheduleResponse{}
@@ -44,9 +64,45 @@ func (c *HTTPClient) GetSchedule(ctx context.Context, scheduleUUID string) (*typ
 	return resp, err
 }
 
+// GetSchedules returns all the schedules, following the pages returned by the API
 func (c *HTTPClient) GetSchedules(ctx context.Context) ([]*types.ScheduleResponse, error) {
+	filters := &entities.ScheduleFilters{}
+	resp := []*types.ScheduleResponse{}
+
+	for {
+		page, err := c.SearchSchedules(ctx, filters)
+		if err != nil {
+			return nil, err
+		}
+
+		resp = append(resp, page.Schedules...)
+		if page.Next == "" {
+			return resp, nil
+		}
+
+		filters.Pagination.Cursor = page.Next
+	}
+}
+
+// SearchSchedules returns a single page of the schedules matching the filters
+func (c *HTTPClient) SearchSchedules(ctx context.Context, filters *entities.ScheduleFilters) (*types.ScheduleSearchResponse, error) {
 	reqURL := fmt.Sprintf("%v/schedules", c.config.URL)
-	var resp []*types.ScheduleResponse
+	resp := &types.ScheduleSearchResponse{}
+
+	qParams := url.Values{}
+	if !filters.CreatedAfter.IsZero() {
+		qParams.Set("created_after", filters.CreatedAfter.Format(time.RFC3339))
+	}
+
+	if !filters.CreatedBefore.IsZero() {
+		qParams.Set("created_before", filters.CreatedBefore.Format(time.RFC3339))
+	}
+
+	setCursorPaginationParams(qParams, &filters.Pagination)
+
+	if len(qParams) > 0 {
+		reqURL = reqURL + "?" + qParams.Encode()
+	}
 
 	err := callWithBackOff(ctx, c.config.backOff, func() error {
 		response, err := clientutils.GetRequest(ctx, c.client, reqURL)
@@ -55,8 +111,11 @@ func (c *HTTPClient) GetSchedules(ctx context.Context) ([]*types.ScheduleRespons
 		}
 
 		defer clientutils.CloseResponse(response)
-		return httputil.ParseResponse(ctx, response, &resp)
+		return httputil.ParseResponse(ctx, response, resp)
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return resp, err
+	return resp, nil
 }
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	clientutils "github.com/consensys/orchestrate/pkg/toolkit/app/http/client-utils"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/http/httputil"
+	types "github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+)
+
+func (c *HTTPClient) CreateSubscription(ctx context.Context, request *types.CreateSubscriptionRequest) (*types.SubscriptionResponse, error) {
+	reqURL := fmt.Sprintf("%v/subscriptions", c.config.URL)
+	resp := &types.SubscriptionResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PostRequest(ctx, c.client, reqURL, request)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) GetSubscription(ctx context.Context, uuid string) (*types.SubscriptionResponse, error) {
+	reqURL := fmt.Sprintf("%v/subscriptions/%s", c.config.URL, uuid)
+	resp := &types.SubscriptionResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.GetRequest(ctx, c.client, reqURL)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) SearchSubscriptions(ctx context.Context, filters *entities.SubscriptionFilters) ([]*types.SubscriptionResponse, error) {
+	reqURL := fmt.Sprintf("%v/subscriptions", c.config.URL)
+	var resp []*types.SubscriptionResponse
+
+	var qParams []string
+	if filters.ChainUUID != "" {
+		qParams = append(qParams, "chain_uuid="+filters.ChainUUID)
+	}
+
+	if filters.ContractAddress != "" {
+		qParams = append(qParams, "contract_address="+filters.ContractAddress)
+	}
+
+	if len(qParams) > 0 {
+		reqURL = reqURL + "?" + strings.Join(qParams, "&")
+	}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.GetRequest(ctx, c.client, reqURL)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseResponse(ctx, response, &resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) UpdateSubscription(ctx context.Context, uuid string, request *types.UpdateSubscriptionRequest) (*types.SubscriptionResponse, error) {
+	reqURL := fmt.Sprintf("%v/subscriptions/%v", c.config.URL, uuid)
+	resp := &types.SubscriptionResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PatchRequest(ctx, c.client, reqURL, request)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) DeleteSubscription(ctx context.Context, uuid string) error {
+	reqURL := fmt.Sprintf("%v/subscriptions/%v", c.config.URL, uuid)
+
+	response, err := clientutils.DeleteRequest(ctx, c.client, reqURL)
+	if err != nil {
+		return err
+	}
+
+	defer clientutils.CloseResponse(response)
+	return httputil.ParseEmptyBodyResponse(ctx, response)
+}
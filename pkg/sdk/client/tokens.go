@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/url"
+
+	clientutils "github.com/consensys/orchestrate/pkg/toolkit/app/http/client-utils"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/http/httputil"
+	types "github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func (c *HTTPClient) SendTokenTransaction(ctx context.Context, standard entities.TokenStandard, operation entities.TokenOperation, request *types.TokenTxRequest) (*types.TransactionResponse, error) {
+	reqURL := fmt.Sprintf("%v/tokens/%s/%s", c.config.URL, standard, operation)
+	resp := &types.TransactionResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PostRequest(ctx, c.client, reqURL, request)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) GetTokenBalance(ctx context.Context, chainUUID string, standard entities.TokenStandard, token, owner ethcommon.Address, tokenID *big.Int) (*types.TokenBalanceResponse, error) {
+	qV := url.Values{}
+	qV.Set("chain_uuid", chainUUID)
+	qV.Set("owner", owner.Hex())
+	if tokenID != nil {
+		qV.Set("tokenId", tokenID.String())
+	}
+
+	resp := &types.TokenBalanceResponse{}
+	err := c.getToken(ctx, fmt.Sprintf("%v/tokens/%s/%s/balanceOf?%v", c.config.URL, standard, token.Hex(), qV.Encode()), resp)
+	return resp, err
+}
+
+func (c *HTTPClient) GetTokenAllowance(ctx context.Context, chainUUID string, token, owner, spender ethcommon.Address) (*types.TokenAllowanceResponse, error) {
+	qV := url.Values{}
+	qV.Set("chain_uuid", chainUUID)
+	qV.Set("owner", owner.Hex())
+	qV.Set("spender", spender.Hex())
+
+	resp := &types.TokenAllowanceResponse{}
+	err := c.getToken(ctx, fmt.Sprintf("%v/tokens/%s/%s/allowance?%v", c.config.URL, entities.ERC20TokenStandard, token.Hex(), qV.Encode()), resp)
+	return resp, err
+}
+
+func (c *HTTPClient) GetTokenOwner(ctx context.Context, chainUUID string, token ethcommon.Address, tokenID *big.Int) (*types.TokenOwnerResponse, error) {
+	qV := url.Values{}
+	qV.Set("chain_uuid", chainUUID)
+	if tokenID != nil {
+		qV.Set("tokenId", tokenID.String())
+	}
+
+	resp := &types.TokenOwnerResponse{}
+	err := c.getToken(ctx, fmt.Sprintf("%v/tokens/%s/%s/ownerOf?%v", c.config.URL, entities.ERC721TokenStandard, token.Hex(), qV.Encode()), resp)
+	return resp, err
+}
+
+func (c *HTTPClient) getToken(ctx context.Context, reqURL string, resp interface{}) error {
+	return callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.GetRequest(ctx, c.client, reqURL)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseResponse(ctx, response, resp)
+	})
+}
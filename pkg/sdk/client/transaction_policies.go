@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	clientutils "github.com/consensys/orchestrate/pkg/toolkit/app/http/client-utils"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/http/httputil"
+	types "github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+)
+
+func (c *HTTPClient) CreateTransactionPolicy(ctx context.Context, request *types.CreateTransactionPolicyRequest) (*types.TransactionPolicyResponse, error) {
+	reqURL := fmt.Sprintf("%v/transaction-policies", c.config.URL)
+	resp := &types.TransactionPolicyResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PostRequest(ctx, c.client, reqURL, request)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) GetTransactionPolicy(ctx context.Context, uuid string) (*types.TransactionPolicyResponse, error) {
+	reqURL := fmt.Sprintf("%v/transaction-policies/%s", c.config.URL, uuid)
+	resp := &types.TransactionPolicyResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.GetRequest(ctx, c.client, reqURL)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) SearchTransactionPolicies(ctx context.Context, filters *entities.TransactionPolicyFilters) ([]*types.TransactionPolicyResponse, error) {
+	reqURL := fmt.Sprintf("%v/transaction-policies", c.config.URL)
+	var resp []*types.TransactionPolicyResponse
+
+	var qParams []string
+	if filters.Type != "" {
+		qParams = append(qParams, "type="+string(filters.Type))
+	}
+
+	if filters.ChainUUID != "" {
+		qParams = append(qParams, "chain_uuid="+filters.ChainUUID)
+	}
+
+	if len(qParams) > 0 {
+		reqURL = reqURL + "?" + strings.Join(qParams, "&")
+	}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.GetRequest(ctx, c.client, reqURL)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseResponse(ctx, response, &resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) DeleteTransactionPolicy(ctx context.Context, uuid string) error {
+	reqURL := fmt.Sprintf("%v/transaction-policies/%v", c.config.URL, uuid)
+
+	response, err := clientutils.DeleteRequest(ctx, c.client, reqURL)
+	if err != nil {
+		return err
+	}
+
+	defer clientutils.CloseResponse(response)
+	return httputil.ParseEmptyBodyResponse(ctx, response)
+}
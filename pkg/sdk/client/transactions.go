@@ -31,6 +31,23 @@ func (c *HTTPClient) SendContractTransaction(ctx context.Context, txRequest *typ
 	return resp, err
 }
 
+func (c *HTTPClient) SendContractTransactions(ctx context.Context, txsRequest *types.SendTransactionsRequest) (*types.SendTransactionsResponse, error) {
+	reqURL := fmt.Sprintf("%v/transactions/batch", c.config.URL)
+	resp := &types.SendTransactionsResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PostRequest(ctx, c.client, reqURL, txsRequest)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
+
 func (c *HTTPClient) SendDeployTransaction(ctx context.Context, txRequest *types.DeployContractRequest) (*types.TransactionResponse, error) {
 	reqURL := fmt.Sprintf("%v/transactions/deploy-contract", c.config.URL)
 	resp := &types.TransactionResponse{}
@@ -82,6 +99,23 @@ func (c *HTTPClient) SendTransferTransaction(ctx context.Context, txRequest *typ
 	return resp, err
 }
 
+func (c *HTTPClient) SimulateTransaction(ctx context.Context, request *types.SimulateTransactionRequest) (*types.SimulateTransactionResponse, error) {
+	reqURL := fmt.Sprintf("%v/transactions/simulate", c.config.URL)
+	resp := &types.SimulateTransactionResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PostRequest(ctx, c.client, reqURL, request)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
+
 func (c *HTTPClient) GetTxRequest(ctx context.Context, txRequestUUID string) (*types.TransactionResponse, error) {
 	reqURL := fmt.Sprintf("%v/transactions/%v", c.config.URL, txRequestUUID)
 	resp := &types.TransactionResponse{}
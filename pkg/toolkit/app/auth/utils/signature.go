@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+const SignatureHeader = "X-Signature"
+
+// SignMessage computes the hex encoded HMAC-SHA256 of a message produced on behalf of a tenant and a username
+func SignMessage(key []byte, tenantID, username string, payload []byte) string {
+	return hex.EncodeToString(messageMAC(key, tenantID, username, payload))
+}
+
+// VerifyMessageSignature checks in constant time the signature of a message produced on behalf of a tenant and a username
+func VerifyMessageSignature(key []byte, tenantID, username string, payload []byte, signature string) bool {
+	actual, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal(messageMAC(key, tenantID, username, payload), actual)
+}
+
+func messageMAC(key []byte, tenantID, username string, payload []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(tenantID + "\n" + username + "\n"))
+	_, _ = mac.Write(payload)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,22 @@
+// +build unit
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageSignature(t *testing.T) {
+	key := []byte("secret")
+	payload := []byte("payload")
+	signature := SignMessage(key, "tenantID", "username", payload)
+
+	assert.True(t, VerifyMessageSignature(key, "tenantID", "username", payload, signature))
+	assert.False(t, VerifyMessageSignature([]byte("other"), "tenantID", "username", payload, signature), "key should be checked")
+	assert.False(t, VerifyMessageSignature(key, "tenantID2", "username", payload, signature), "tenant should be signed")
+	assert.False(t, VerifyMessageSignature(key, "tenantID", "*", payload, signature), "username should be signed")
+	assert.False(t, VerifyMessageSignature(key, "tenantID", "username", []byte("other"), signature), "payload should be signed")
+	assert.False(t, VerifyMessageSignature(key, "tenantID", "username", payload, "not-hex"))
+}
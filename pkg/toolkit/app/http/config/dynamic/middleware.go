@@ -114,8 +114,9 @@ type CustomHeaders struct {
 
 // ServersLoadBalancer holds the ServersLoadBalancer configuration.
 type LoadBalancer struct {
-	Sticky  *Sticky   `json:"sticky,omitempty" toml:"sticky,omitempty" yaml:"sticky,omitempty" label:"allowEmpty"`
-	Servers []*Server `json:"servers,omitempty" toml:"servers,omitempty" yaml:"servers,omitempty" label-slice-as-struct:"server"`
+	Sticky   *Sticky   `json:"sticky,omitempty" toml:"sticky,omitempty" yaml:"sticky,omitempty" label:"allowEmpty"`
+	Servers  []*Server `json:"servers,omitempty" toml:"servers,omitempty" yaml:"servers,omitempty" label-slice-as-struct:"server"`
+	Strategy string    `json:"strategy,omitempty" toml:"strategy,omitempty" yaml:"strategy,omitempty"`
 }
 
 // +k8s:deepcopy-gen=true
@@ -17,6 +17,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/toolkit/app/http/config/dynamic"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/http/httputil"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/http/middleware/loadbalancer"
+	"github.com/consensys/orchestrate/pkg/toolkit/nodehealth"
 	"github.com/oxtoacart/bpool"
 	traefiktypes "github.com/traefik/paerser/types"
 	traefikstatic "github.com/traefik/traefik/v2/pkg/config/static"
@@ -36,7 +37,7 @@ type Builder struct {
 	lb *loadbalancer.Builder
 }
 
-func NewBuilder(transportCfg *traefikstatic.ServersTransport, pool gohttputil.BufferPool) (*Builder, error) {
+func NewBuilder(transportCfg *traefikstatic.ServersTransport, pool gohttputil.BufferPool, registry *nodehealth.Registry) (*Builder, error) {
 	t, err := NewTransport(transportCfg)
 	if err != nil {
 		return nil, err
@@ -49,7 +50,7 @@ func NewBuilder(transportCfg *traefikstatic.ServersTransport, pool gohttputil.Bu
 	return &Builder{
 		transport: t,
 		bpool:     pool,
-		lb:        loadbalancer.NewBuilder(),
+		lb:        loadbalancer.NewBuilder(registry),
 	}, nil
 }
 
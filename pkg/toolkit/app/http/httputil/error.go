@@ -11,8 +11,9 @@ var internalErrMsg = "Internal server error. Please ask an admin for help or try
 var internalDepErrMsg = "Failed dependency. Please ask an admin for help or try again later"
 
 type ErrorResponse struct {
-	Message string `json:"message" example:"error message"`
-	Code    uint64 `json:"code,omitempty" example:"24000"`
+	Message string            `json:"message" example:"error message"`
+	Code    uint64            `json:"code,omitempty" example:"24000"`
+	Extra   map[string]string `json:"extra,omitempty"` // Details of the error, such as the transaction policy violated.
 }
 
 // @deprecated: Migrate every usage of it to WriteHTTPErrorResponse
@@ -57,6 +58,7 @@ func writeErrorResponse(rw http.ResponseWriter, status int, err error) {
 	msg, e := json.Marshal(ErrorResponse{
 		Message: errors.FromError(err).SetComponent("").Error(),
 		Code:    errors.FromError(err).GetCode(),
+		Extra:   errors.FromError(err).GetExtra(),
 	})
 	if e != nil {
 		http.Error(rw, e.Error(), status)
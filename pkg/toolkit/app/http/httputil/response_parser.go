@@ -39,7 +39,9 @@ func ParseResponse(ctx context.Context, response *http.Response, resp interface{
 	if string(respMsg) != "" {
 		errResp := ErrorResponse{}
 		if err = json.Unmarshal(respMsg, &errResp); err == nil {
-			return errors.Errorf(errResp.Code, errResp.Message)
+			ierr := errors.Errorf(errResp.Code, errResp.Message)
+			ierr.Extra = errResp.Extra
+			return ierr
 		}
 	}
 
@@ -0,0 +1,76 @@
+package loadbalancer
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/nodehealth"
+)
+
+// healthBalancer routes each request to the node selected by the health registry,
+// and reports the outcome of the request back to the registry (passive health check)
+type healthBalancer struct {
+	next     http.Handler
+	registry *nodehealth.Registry
+	strategy string
+	urls     []*url.URL
+	servers  []string
+}
+
+func newHealthBalancer(next http.Handler, registry *nodehealth.Registry, strategy string, urls []*url.URL, servers []string) *healthBalancer {
+	return &healthBalancer{
+		next:     next,
+		registry: registry,
+		strategy: strategy,
+		urls:     urls,
+		servers:  servers,
+	}
+}
+
+func (b *healthBalancer) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	idx := b.registry.Select(b.servers, b.strategy)
+
+	u := *b.urls[idx]
+	newReq := *req
+	newReq.URL = &u
+
+	recorder := &statusRecorder{ResponseWriter: rw, status: http.StatusOK}
+	start := time.Now()
+	b.next.ServeHTTP(recorder, &newReq)
+
+	if recorder.status >= http.StatusInternalServerError {
+		b.registry.ObserveFailure(b.servers[idx], fmt.Errorf("%d %s", recorder.status, http.StatusText(recorder.status)))
+		return
+	}
+
+	b.registry.ObserveSuccess(b.servers[idx], time.Since(start))
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (r *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := r.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("%T is not a http.Hijacker", r.ResponseWriter)
+	}
+
+	return hijacker.Hijack()
+}
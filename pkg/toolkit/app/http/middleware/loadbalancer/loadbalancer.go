@@ -9,16 +9,20 @@ import (
 	"strings"
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/http/config/dynamic"
+	"github.com/consensys/orchestrate/pkg/toolkit/nodehealth"
 	"github.com/sirupsen/logrus"
 	"github.com/vulcand/oxy/roundrobin"
 )
 
 const cookieNameLength = 6
 
-type Builder struct{}
+type Builder struct {
+	registry *nodehealth.Registry
+}
 
-func NewBuilder() *Builder {
-	return &Builder{}
+// NewBuilder creates a load balancer builder, the registry is optional and only used by the health-aware strategies
+func NewBuilder(registry *nodehealth.Registry) *Builder {
+	return &Builder{registry: registry}
 }
 
 func (b *Builder) Build(ctx context.Context, name string, configuration interface{}) (mid func(http.Handler) http.Handler, respModifier func(resp *http.Response) error, err error) {
@@ -46,6 +50,16 @@ func (b *Builder) Build(ctx context.Context, name string, configuration interfac
 		urls = append(urls, u)
 	}
 
+	switch cfg.Strategy {
+	case "", nodehealth.RoundRobinStrategy:
+	case nodehealth.LatencyStrategy, nodehealth.HeadStrategy:
+		if b.registry != nil {
+			return b.buildHealthBalancer(cfg, urls)
+		}
+	default:
+		return nil, nil, fmt.Errorf("invalid load balancing strategy %q", cfg.Strategy)
+	}
+
 	var options []roundrobin.LBOption
 
 	// Append logger option (it is used to deactivate heavy roundrobin logs at Debug Level)
@@ -86,6 +100,17 @@ func (b *Builder) Build(ctx context.Context, name string, configuration interfac
 	}, nil, nil
 }
 
+func (b *Builder) buildHealthBalancer(cfg *dynamic.LoadBalancer, urls []*url.URL) (mid func(http.Handler) http.Handler, respModifier func(resp *http.Response) error, err error) {
+	servers := make([]string, len(cfg.Servers))
+	for i, srv := range cfg.Servers {
+		servers[i] = srv.URL
+	}
+
+	return func(h http.Handler) http.Handler {
+		return newHealthBalancer(h, b.registry, cfg.Strategy, urls, servers)
+	}, nil, nil
+}
+
 func (b *Builder) GenerateCookieName(name string) (string, error) {
 	data := []byte("_ORCHESTRATE_" + name)
 
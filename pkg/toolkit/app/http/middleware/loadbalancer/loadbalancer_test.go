@@ -3,12 +3,77 @@
 package loadbalancer
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/consensys/orchestrate/pkg/toolkit/app/http/config/dynamic"
+	"github.com/consensys/orchestrate/pkg/toolkit/nodehealth"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestBuilder(t *testing.T) {
-	b := NewBuilder()
+	b := NewBuilder(nil)
 	require.NotNil(t, b, "Builder should have been created")
 }
+
+func TestBuilder_HealthStrategies(t *testing.T) {
+	cfg := &dynamic.LoadBalancer{
+		Servers: []*dynamic.Server{
+			{URL: "http://node-1:8545"},
+			{URL: "http://node-2:8545"},
+		},
+		Strategy: nodehealth.HeadStrategy,
+	}
+
+	t.Run("should route requests to the node with the highest head", func(t *testing.T) {
+		registry := nodehealth.NewRegistry(nodehealth.NewDefaultConfig())
+		registry.SetHead("http://node-1:8545", 100, false)
+		registry.SetHead("http://node-2:8545", 101, false)
+
+		mid, _, err := NewBuilder(registry).Build(context.Background(), "chain", cfg)
+		require.NoError(t, err)
+
+		var host string
+		h := mid(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			host = req.URL.Host
+		}))
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", nil))
+
+		assert.Equal(t, "node-2:8545", host)
+	})
+
+	t.Run("should eject node which keeps failing", func(t *testing.T) {
+		registry := nodehealth.NewRegistry(nodehealth.NewDefaultConfig())
+		registry.SetHead("http://node-1:8545", 100, false)
+		registry.SetHead("http://node-2:8545", 101, false)
+
+		mid, _, err := NewBuilder(registry).Build(context.Background(), "chain", cfg)
+		require.NoError(t, err)
+
+		var hosts []string
+		h := mid(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			hosts = append(hosts, req.URL.Host)
+			if req.URL.Host == "node-2:8545" {
+				rw.WriteHeader(http.StatusBadGateway)
+			}
+		}))
+		for i := 0; i < 4; i++ {
+			h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", nil))
+		}
+
+		assert.Equal(t, []string{"node-2:8545", "node-2:8545", "node-2:8545", "node-1:8545"}, hosts)
+		assert.True(t, registry.Status([]string{"http://node-2:8545"})[0].Ejected)
+	})
+
+	t.Run("should fail if strategy is unknown", func(t *testing.T) {
+		_, _, err := NewBuilder(nil).Build(context.Background(), "chain", &dynamic.LoadBalancer{
+			Servers:  cfg.Servers,
+			Strategy: "unknown",
+		})
+
+		assert.Error(t, err)
+	})
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
 	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/go-pg/pg/v9"
 	"github.com/go-pg/pg/v9/orm"
@@ -119,6 +121,46 @@ func WhereAllowedOwner(query *orm.Query, field, ownerID string) *orm.Query {
 	return query
 }
 
+// CursorPaginate sorts the query by date and selects the page following the cursor, a zero limit selecting a page of
+// entities.DefaultPageSize items
+func CursorPaginate(query *orm.Query, table string, pagination *entities.CursorPagination) (*orm.Query, error) {
+	sortBy := pagination.SortBy
+	switch sortBy {
+	case "":
+		sortBy = entities.SortByCreatedAt
+	case entities.SortByCreatedAt, entities.SortByUpdatedAt:
+	default:
+		return nil, errors.InvalidParameterError("invalid sort field %q", sortBy)
+	}
+
+	order, comparator := "ASC", ">"
+	if pagination.SortOrder == entities.SortOrderDesc {
+		order, comparator = "DESC", "<"
+	}
+
+	limit := pagination.Limit
+	switch {
+	case limit == 0:
+		limit = entities.DefaultPageSize
+	case limit < 0 || limit > entities.MaxPageSize:
+		return nil, errors.InvalidParameterError("limit must be between 1 and %d", entities.MaxPageSize)
+	}
+
+	column := fmt.Sprintf("%s.%s", table, sortBy)
+	if pagination.Cursor != "" {
+		cursor, err := entities.ParseCursor(pagination.Cursor)
+		if err != nil {
+			return nil, errors.InvalidParameterError(err.Error())
+		}
+
+		query = query.Where(fmt.Sprintf("(%s, %s.uuid) %s (?, ?)", column, table, comparator), cursor.Time, cursor.UUID)
+	}
+
+	query = query.Order(fmt.Sprintf("%s %s", column, order), fmt.Sprintf("%s.uuid %s", table, order)).Limit(limit)
+
+	return query, nil
+}
+
 func Checker(db orm.DB) healthz.Check {
 	return func() error {
 		_, err := db.Exec("SELECT 1")
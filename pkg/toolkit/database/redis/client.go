@@ -1,6 +1,8 @@
 package redis
 
 import (
+	"time"
+
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/gomodule/redigo/redis"
@@ -146,3 +148,95 @@ func (nm *Client) Ping() error {
 
 	return nil
 }
+
+func (nm *Client) LoadBytes(key string) (value []byte, ok bool, err error) {
+	reply, ok, err := nm.Load(key)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	value, err = redis.Bytes(reply, nil)
+	if err != nil {
+		return nil, false, parseRedisError(err, "failed to load bytes value")
+	}
+
+	return value, true, nil
+}
+
+// SetWithTTL sets a value with a custom expiration instead of the configured one
+func (nm *Client) SetWithTTL(key string, value interface{}, ttl time.Duration) error {
+	conn := nm.pool.Get()
+	defer func() {
+		closeErr := conn.Close()
+		if closeErr != nil {
+			nm.logger.WithError(closeErr).Error(cannotCloseConnErr)
+		}
+	}()
+
+	_, err := conn.Do("PSETEX", key, ttl.Milliseconds(), value)
+	if err != nil {
+		return parseRedisError(err, "failed to set value")
+	}
+
+	return nil
+}
+
+// SetNX sets a value with a custom expiration only if the key does not exist. It returns false if the key already exists
+func (nm *Client) SetNX(key string, value interface{}, ttl time.Duration) (bool, error) {
+	conn := nm.pool.Get()
+	defer func() {
+		closeErr := conn.Close()
+		if closeErr != nil {
+			nm.logger.WithError(closeErr).Error(cannotCloseConnErr)
+		}
+	}()
+
+	reply, err := conn.Do("SET", key, value, "PX", ttl.Milliseconds(), "NX")
+	if err != nil {
+		return false, parseRedisError(err, "failed to set value")
+	}
+
+	return reply != nil, nil
+}
+
+func (nm *Client) AddToSet(key string, member interface{}) error {
+	return nm.do("failed to add set member", "SADD", key, member)
+}
+
+func (nm *Client) RemoveFromSet(key string, member interface{}) error {
+	return nm.do("failed to remove set member", "SREM", key, member)
+}
+
+func (nm *Client) LoadSet(key string) ([]string, error) {
+	conn := nm.pool.Get()
+	defer func() {
+		closeErr := conn.Close()
+		if closeErr != nil {
+			nm.logger.WithError(closeErr).Error(cannotCloseConnErr)
+		}
+	}()
+
+	members, err := redis.Strings(conn.Do("SMEMBERS", key))
+	if err != nil {
+		return nil, parseRedisError(err, "failed to load set members")
+	}
+
+	return members, nil
+}
+
+func (nm *Client) do(errMsg, cmd string, args ...interface{}) error {
+	conn := nm.pool.Get()
+	defer func() {
+		closeErr := conn.Close()
+		if closeErr != nil {
+			nm.logger.WithError(closeErr).Error(cannotCloseConnErr)
+		}
+	}()
+
+	_, err := conn.Do(cmd, args...)
+	if err != nil {
+		return parseRedisError(err, errMsg)
+	}
+
+	return nil
+}
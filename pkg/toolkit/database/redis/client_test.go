@@ -4,6 +4,7 @@ package redis
 
 import (
 	"testing"
+	"time"
 
 	"github.com/alicebob/miniredis"
 	"github.com/stretchr/testify/assert"
@@ -55,3 +56,74 @@ func TestRedisClient(t *testing.T) {
 	assert.False(t, ok)
 	assert.Equal(t, uint64(0), n)
 }
+
+func TestRedisClient_Lease(t *testing.T) {
+	mredis := NewRedisMock()
+	conf := &Config{
+		Host: mredis.Host(),
+		Port: mredis.Port(),
+	}
+
+	pool, _ := NewPool(conf)
+	client := NewClient(pool, conf)
+
+	acquired, err := client.AcquireLease(testKey, "owner-1", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+
+	acquired, err = client.AcquireLease(testKey, "owner-2", time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, acquired)
+
+	// Renew lease
+	acquired, err = client.AcquireLease(testKey, "owner-1", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+
+	err = client.ReleaseLease(testKey, "owner-2")
+	assert.NoError(t, err)
+	assert.True(t, mredis.Exists(testKey))
+
+	err = client.ReleaseLease(testKey, "owner-1")
+	assert.NoError(t, err)
+	assert.False(t, mredis.Exists(testKey))
+
+	// Expired lease can be taken by another owner
+	_, _ = client.AcquireLease(testKey, "owner-1", time.Second)
+	mredis.FastForward(2 * time.Second)
+	acquired, err = client.AcquireLease(testKey, "owner-2", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+}
+
+func TestRedisClient_Set(t *testing.T) {
+	mredis := NewRedisMock()
+	conf := &Config{
+		Host: mredis.Host(),
+		Port: mredis.Port(),
+	}
+
+	pool, _ := NewPool(conf)
+	client := NewClient(pool, conf)
+
+	assert.NoError(t, client.AddToSet(testKey, "a"))
+	assert.NoError(t, client.AddToSet(testKey, "b"))
+	assert.NoError(t, client.RemoveFromSet(testKey, "a"))
+
+	members, err := client.LoadSet(testKey)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"b"}, members)
+
+	assert.NoError(t, client.SetWithTTL("bytes-key", []byte("value"), time.Minute))
+	value, ok, err := client.LoadBytes("bytes-key")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("value"), value)
+
+	set, err := client.SetNX("bytes-key", []byte("other"), time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, set)
+	set, err = client.SetNX("nx-key", []byte("value"), time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, set)
+}
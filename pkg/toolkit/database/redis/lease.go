@@ -0,0 +1,65 @@
+package redis
+
+import (
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// acquireLeaseScript sets the lease if free, or extends it if already held by the same owner
+const acquireLeaseScript = `
+local owner = redis.call('GET', KEYS[1])
+if owner == false then
+	redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+	return 1
+end
+if owner == ARGV[1] then
+	redis.call('PEXPIRE', KEYS[1], ARGV[2])
+	return 1
+end
+return 0
+`
+
+// releaseLeaseScript deletes the lease only if held by the owner
+const releaseLeaseScript = `
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`
+
+// AcquireLease takes, or renews, the lease on the key for the owner. It returns false if the lease is held by another owner
+func (nm *Client) AcquireLease(key, owner string, ttl time.Duration) (bool, error) {
+	conn := nm.pool.Get()
+	defer func() {
+		closeErr := conn.Close()
+		if closeErr != nil {
+			nm.logger.WithError(closeErr).Error(cannotCloseConnErr)
+		}
+	}()
+
+	acquired, err := redis.Bool(conn.Do("EVAL", acquireLeaseScript, 1, key, owner, ttl.Milliseconds()))
+	if err != nil {
+		return false, parseRedisError(err, "failed to acquire lease")
+	}
+
+	return acquired, nil
+}
+
+// ReleaseLease releases the lease on the key if held by the owner
+func (nm *Client) ReleaseLease(key, owner string) error {
+	conn := nm.pool.Get()
+	defer func() {
+		closeErr := conn.Close()
+		if closeErr != nil {
+			nm.logger.WithError(closeErr).Error(cannotCloseConnErr)
+		}
+	}()
+
+	_, err := conn.Do("EVAL", releaseLeaseScript, 1, key, owner)
+	if err != nil {
+		return parseRedisError(err, "failed to release lease")
+	}
+
+	return nil
+}
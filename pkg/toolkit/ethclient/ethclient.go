@@ -67,6 +67,9 @@ type ChainLedgerReader interface {
 
 	// TransactionReceipt returns the receipt of a transaction by transaction hash.
 	TransactionReceipt(ctx context.Context, url string, txHash ethcommon.Hash) (*proto.Receipt, error)
+
+	// FilterLogs executes a filter query and returns the matching logs.
+	FilterLogs(ctx context.Context, url string, q eth.FilterQuery) ([]ethtypes.Log, error)
 }
 
 type EEAChainLedgerReader interface {
@@ -136,6 +139,9 @@ type ContractCaller interface {
 	// PendingCallContract executes a message call transaction using the EVM.
 	// The state seen by the contract call is the pending state.
 	PendingCallContract(ctx context.Context, url string, msg *eth.CallMsg) ([]byte, error)
+
+	// PrivCallContract executes a message call against the private state of a privacy group (EEA)
+	PrivCallContract(ctx context.Context, url string, msg *eth.CallMsg, privacyGroupID string, blockNumber *big.Int) ([]byte, error)
 }
 
 // GasEstimator is a service that can provide transaction gas price estimation
@@ -149,8 +155,8 @@ type GasPricer interface {
 	// SuggestGasPrice retrieves the currently suggested gas price
 	SuggestGasPrice(ctx context.Context, url string) (*big.Int, error)
 
-	// FeeHistory retrieve historical baseFeeData
-	FeeHistory(ctx context.Context, url string, blockCount int, newestBlock string) (*rpc.FeeHistory, error)
+	// FeeHistory retrieve historical baseFeeData, and priority fees at the given percentiles
+	FeeHistory(ctx context.Context, url string, blockCount int, newestBlock string, rewardPercentiles []float64) (*rpc.FeeHistory, error)
 }
 
 // ChainSyncReader is a service to access to the node's current sync status
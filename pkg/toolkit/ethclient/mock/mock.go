@@ -308,6 +308,21 @@ func (mr *MockChainLedgerReaderMockRecorder) TransactionReceipt(ctx, url, txHash
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionReceipt", reflect.TypeOf((*MockChainLedgerReader)(nil).TransactionReceipt), ctx, url, txHash)
 }
 
+// FilterLogs mocks base method
+func (m *MockChainLedgerReader) FilterLogs(ctx context.Context, url string, q ethereum0.FilterQuery) ([]types0.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterLogs", ctx, url, q)
+	ret0, _ := ret[0].([]types0.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterLogs indicates an expected call of FilterLogs
+func (mr *MockChainLedgerReaderMockRecorder) FilterLogs(ctx, url, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterLogs", reflect.TypeOf((*MockChainLedgerReader)(nil).FilterLogs), ctx, url, q)
+}
+
 // MockEEAChainLedgerReader is a mock of EEAChainLedgerReader interface
 type MockEEAChainLedgerReader struct {
 	ctrl     *gomock.Controller
@@ -678,6 +693,21 @@ func (mr *MockContractCallerMockRecorder) PendingCallContract(ctx, url, msg inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingCallContract", reflect.TypeOf((*MockContractCaller)(nil).PendingCallContract), ctx, url, msg)
 }
 
+// PrivCallContract mocks base method
+func (m *MockContractCaller) PrivCallContract(ctx context.Context, url string, msg *ethereum0.CallMsg, privacyGroupID string, blockNumber *big.Int) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrivCallContract", ctx, url, msg, privacyGroupID, blockNumber)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrivCallContract indicates an expected call of PrivCallContract
+func (mr *MockContractCallerMockRecorder) PrivCallContract(ctx, url, msg, privacyGroupID, blockNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivCallContract", reflect.TypeOf((*MockContractCaller)(nil).PrivCallContract), ctx, url, msg, privacyGroupID, blockNumber)
+}
+
 // MockGasEstimator is a mock of GasEstimator interface
 type MockGasEstimator struct {
 	ctrl     *gomock.Controller
@@ -755,18 +785,18 @@ func (mr *MockGasPricerMockRecorder) SuggestGasPrice(ctx, url interface{}) *gomo
 }
 
 // FeeHistory mocks base method
-func (m *MockGasPricer) FeeHistory(ctx context.Context, url string, blockCount int, newestBlock string) (*rpc.FeeHistory, error) {
+func (m *MockGasPricer) FeeHistory(ctx context.Context, url string, blockCount int, newestBlock string, rewardPercentiles []float64) (*rpc.FeeHistory, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FeeHistory", ctx, url, blockCount, newestBlock)
+	ret := m.ctrl.Call(m, "FeeHistory", ctx, url, blockCount, newestBlock, rewardPercentiles)
 	ret0, _ := ret[0].(*rpc.FeeHistory)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FeeHistory indicates an expected call of FeeHistory
-func (mr *MockGasPricerMockRecorder) FeeHistory(ctx, url, blockCount, newestBlock interface{}) *gomock.Call {
+func (mr *MockGasPricerMockRecorder) FeeHistory(ctx, url, blockCount, newestBlock, rewardPercentiles interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockGasPricer)(nil).FeeHistory), ctx, url, blockCount, newestBlock)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockGasPricer)(nil).FeeHistory), ctx, url, blockCount, newestBlock, rewardPercentiles)
 }
 
 // MockChainSyncReader is a mock of ChainSyncReader interface
@@ -985,6 +1015,21 @@ func (mr *MockMultiClientMockRecorder) TransactionReceipt(ctx, url, txHash inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionReceipt", reflect.TypeOf((*MockMultiClient)(nil).TransactionReceipt), ctx, url, txHash)
 }
 
+// FilterLogs mocks base method
+func (m *MockMultiClient) FilterLogs(ctx context.Context, url string, q ethereum0.FilterQuery) ([]types0.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterLogs", ctx, url, q)
+	ret0, _ := ret[0].([]types0.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterLogs indicates an expected call of FilterLogs
+func (mr *MockMultiClientMockRecorder) FilterLogs(ctx, url, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterLogs", reflect.TypeOf((*MockMultiClient)(nil).FilterLogs), ctx, url, q)
+}
+
 // BalanceAt mocks base method
 func (m *MockMultiClient) BalanceAt(ctx context.Context, url string, account common.Address, blockNumber *big.Int) (*big.Int, error) {
 	m.ctrl.T.Helper()
@@ -1135,6 +1180,21 @@ func (mr *MockMultiClientMockRecorder) PendingCallContract(ctx, url, msg interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingCallContract", reflect.TypeOf((*MockMultiClient)(nil).PendingCallContract), ctx, url, msg)
 }
 
+// PrivCallContract mocks base method
+func (m *MockMultiClient) PrivCallContract(ctx context.Context, url string, msg *ethereum0.CallMsg, privacyGroupID string, blockNumber *big.Int) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrivCallContract", ctx, url, msg, privacyGroupID, blockNumber)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrivCallContract indicates an expected call of PrivCallContract
+func (mr *MockMultiClientMockRecorder) PrivCallContract(ctx, url, msg, privacyGroupID, blockNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivCallContract", reflect.TypeOf((*MockMultiClient)(nil).PrivCallContract), ctx, url, msg, privacyGroupID, blockNumber)
+}
+
 // EstimateGas mocks base method
 func (m *MockMultiClient) EstimateGas(ctx context.Context, url string, msg *ethereum0.CallMsg) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -1166,18 +1226,18 @@ func (mr *MockMultiClientMockRecorder) SuggestGasPrice(ctx, url interface{}) *go
 }
 
 // FeeHistory mocks base method
-func (m *MockMultiClient) FeeHistory(ctx context.Context, url string, blockCount int, newestBlock string) (*rpc.FeeHistory, error) {
+func (m *MockMultiClient) FeeHistory(ctx context.Context, url string, blockCount int, newestBlock string, rewardPercentiles []float64) (*rpc.FeeHistory, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FeeHistory", ctx, url, blockCount, newestBlock)
+	ret := m.ctrl.Call(m, "FeeHistory", ctx, url, blockCount, newestBlock, rewardPercentiles)
 	ret0, _ := ret[0].(*rpc.FeeHistory)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FeeHistory indicates an expected call of FeeHistory
-func (mr *MockMultiClientMockRecorder) FeeHistory(ctx, url, blockCount, newestBlock interface{}) *gomock.Call {
+func (mr *MockMultiClientMockRecorder) FeeHistory(ctx, url, blockCount, newestBlock, rewardPercentiles interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockMultiClient)(nil).FeeHistory), ctx, url, blockCount, newestBlock)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockMultiClient)(nil).FeeHistory), ctx, url, blockCount, newestBlock, rewardPercentiles)
 }
 
 // Network mocks base method
@@ -1556,6 +1616,21 @@ func (mr *MockClientMockRecorder) TransactionReceipt(ctx, url, txHash interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionReceipt", reflect.TypeOf((*MockClient)(nil).TransactionReceipt), ctx, url, txHash)
 }
 
+// FilterLogs mocks base method
+func (m *MockClient) FilterLogs(ctx context.Context, url string, q ethereum0.FilterQuery) ([]types0.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterLogs", ctx, url, q)
+	ret0, _ := ret[0].([]types0.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterLogs indicates an expected call of FilterLogs
+func (mr *MockClientMockRecorder) FilterLogs(ctx, url, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterLogs", reflect.TypeOf((*MockClient)(nil).FilterLogs), ctx, url, q)
+}
+
 // BalanceAt mocks base method
 func (m *MockClient) BalanceAt(ctx context.Context, url string, account common.Address, blockNumber *big.Int) (*big.Int, error) {
 	m.ctrl.T.Helper()
@@ -1706,6 +1781,21 @@ func (mr *MockClientMockRecorder) PendingCallContract(ctx, url, msg interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingCallContract", reflect.TypeOf((*MockClient)(nil).PendingCallContract), ctx, url, msg)
 }
 
+// PrivCallContract mocks base method
+func (m *MockClient) PrivCallContract(ctx context.Context, url string, msg *ethereum0.CallMsg, privacyGroupID string, blockNumber *big.Int) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrivCallContract", ctx, url, msg, privacyGroupID, blockNumber)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrivCallContract indicates an expected call of PrivCallContract
+func (mr *MockClientMockRecorder) PrivCallContract(ctx, url, msg, privacyGroupID, blockNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivCallContract", reflect.TypeOf((*MockClient)(nil).PrivCallContract), ctx, url, msg, privacyGroupID, blockNumber)
+}
+
 // EstimateGas mocks base method
 func (m *MockClient) EstimateGas(ctx context.Context, url string, msg *ethereum0.CallMsg) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -1737,18 +1827,18 @@ func (mr *MockClientMockRecorder) SuggestGasPrice(ctx, url interface{}) *gomock.
 }
 
 // FeeHistory mocks base method
-func (m *MockClient) FeeHistory(ctx context.Context, url string, blockCount int, newestBlock string) (*rpc.FeeHistory, error) {
+func (m *MockClient) FeeHistory(ctx context.Context, url string, blockCount int, newestBlock string, rewardPercentiles []float64) (*rpc.FeeHistory, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FeeHistory", ctx, url, blockCount, newestBlock)
+	ret := m.ctrl.Call(m, "FeeHistory", ctx, url, blockCount, newestBlock, rewardPercentiles)
 	ret0, _ := ret[0].(*rpc.FeeHistory)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FeeHistory indicates an expected call of FeeHistory
-func (mr *MockClientMockRecorder) FeeHistory(ctx, url, blockCount, newestBlock interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) FeeHistory(ctx, url, blockCount, newestBlock, rewardPercentiles interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockClient)(nil).FeeHistory), ctx, url, blockCount, newestBlock)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockClient)(nil).FeeHistory), ctx, url, blockCount, newestBlock, rewardPercentiles)
 }
 
 // Network mocks base method
@@ -1853,6 +1943,21 @@ func (mr *MockEEAClientMockRecorder) PendingCallContract(ctx, url, msg interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingCallContract", reflect.TypeOf((*MockEEAClient)(nil).PendingCallContract), ctx, url, msg)
 }
 
+// PrivCallContract mocks base method
+func (m *MockEEAClient) PrivCallContract(ctx context.Context, url string, msg *ethereum0.CallMsg, privacyGroupID string, blockNumber *big.Int) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrivCallContract", ctx, url, msg, privacyGroupID, blockNumber)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrivCallContract indicates an expected call of PrivCallContract
+func (mr *MockEEAClientMockRecorder) PrivCallContract(ctx, url, msg, privacyGroupID, blockNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivCallContract", reflect.TypeOf((*MockEEAClient)(nil).PrivCallContract), ctx, url, msg, privacyGroupID, blockNumber)
+}
+
 // EstimateGas mocks base method
 func (m *MockEEAClient) EstimateGas(ctx context.Context, url string, msg *ethereum0.CallMsg) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -1884,18 +1989,18 @@ func (mr *MockEEAClientMockRecorder) SuggestGasPrice(ctx, url interface{}) *gomo
 }
 
 // FeeHistory mocks base method
-func (m *MockEEAClient) FeeHistory(ctx context.Context, url string, blockCount int, newestBlock string) (*rpc.FeeHistory, error) {
+func (m *MockEEAClient) FeeHistory(ctx context.Context, url string, blockCount int, newestBlock string, rewardPercentiles []float64) (*rpc.FeeHistory, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FeeHistory", ctx, url, blockCount, newestBlock)
+	ret := m.ctrl.Call(m, "FeeHistory", ctx, url, blockCount, newestBlock, rewardPercentiles)
 	ret0, _ := ret[0].(*rpc.FeeHistory)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FeeHistory indicates an expected call of FeeHistory
-func (mr *MockEEAClientMockRecorder) FeeHistory(ctx, url, blockCount, newestBlock interface{}) *gomock.Call {
+func (mr *MockEEAClientMockRecorder) FeeHistory(ctx, url, blockCount, newestBlock, rewardPercentiles interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockEEAClient)(nil).FeeHistory), ctx, url, blockCount, newestBlock)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockEEAClient)(nil).FeeHistory), ctx, url, blockCount, newestBlock, rewardPercentiles)
 }
 
 // Network mocks base method
@@ -2101,6 +2206,21 @@ func (mr *MockQuorumClientMockRecorder) PendingCallContract(ctx, url, msg interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingCallContract", reflect.TypeOf((*MockQuorumClient)(nil).PendingCallContract), ctx, url, msg)
 }
 
+// PrivCallContract mocks base method
+func (m *MockQuorumClient) PrivCallContract(ctx context.Context, url string, msg *ethereum0.CallMsg, privacyGroupID string, blockNumber *big.Int) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrivCallContract", ctx, url, msg, privacyGroupID, blockNumber)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrivCallContract indicates an expected call of PrivCallContract
+func (mr *MockQuorumClientMockRecorder) PrivCallContract(ctx, url, msg, privacyGroupID, blockNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivCallContract", reflect.TypeOf((*MockQuorumClient)(nil).PrivCallContract), ctx, url, msg, privacyGroupID, blockNumber)
+}
+
 // EstimateGas mocks base method
 func (m *MockQuorumClient) EstimateGas(ctx context.Context, url string, msg *ethereum0.CallMsg) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -2132,18 +2252,18 @@ func (mr *MockQuorumClientMockRecorder) SuggestGasPrice(ctx, url interface{}) *g
 }
 
 // FeeHistory mocks base method
-func (m *MockQuorumClient) FeeHistory(ctx context.Context, url string, blockCount int, newestBlock string) (*rpc.FeeHistory, error) {
+func (m *MockQuorumClient) FeeHistory(ctx context.Context, url string, blockCount int, newestBlock string, rewardPercentiles []float64) (*rpc.FeeHistory, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FeeHistory", ctx, url, blockCount, newestBlock)
+	ret := m.ctrl.Call(m, "FeeHistory", ctx, url, blockCount, newestBlock, rewardPercentiles)
 	ret0, _ := ret[0].(*rpc.FeeHistory)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FeeHistory indicates an expected call of FeeHistory
-func (mr *MockQuorumClientMockRecorder) FeeHistory(ctx, url, blockCount, newestBlock interface{}) *gomock.Call {
+func (mr *MockQuorumClientMockRecorder) FeeHistory(ctx, url, blockCount, newestBlock, rewardPercentiles interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockQuorumClient)(nil).FeeHistory), ctx, url, blockCount, newestBlock)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockQuorumClient)(nil).FeeHistory), ctx, url, blockCount, newestBlock, rewardPercentiles)
 }
 
 // Network mocks base method
@@ -2267,6 +2387,21 @@ func (mr *MockQuorumClientMockRecorder) TransactionReceipt(ctx, url, txHash inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionReceipt", reflect.TypeOf((*MockQuorumClient)(nil).TransactionReceipt), ctx, url, txHash)
 }
 
+// FilterLogs mocks base method
+func (m *MockQuorumClient) FilterLogs(ctx context.Context, url string, q ethereum0.FilterQuery) ([]types0.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterLogs", ctx, url, q)
+	ret0, _ := ret[0].([]types0.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterLogs indicates an expected call of FilterLogs
+func (mr *MockQuorumClientMockRecorder) FilterLogs(ctx, url, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterLogs", reflect.TypeOf((*MockQuorumClient)(nil).FilterLogs), ctx, url, q)
+}
+
 // SendQuorumRawPrivateTransaction mocks base method
 func (m *MockQuorumClient) SendQuorumRawPrivateTransaction(ctx context.Context, url string, raw hexutil.Bytes, privateFor, mandatoryFor []string, privacyFlag int) (common.Hash, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +196,14 @@ func (ec *Client) processEthError(err *utils.JSONError) error {
 	if strings.Contains(err.Message, "nonce too low") || strings.Contains(err.Message, "Nonce too low") || strings.Contains(err.Message, "Incorrect nonce") {
 		return errors.NonceTooLowError("code: %d - message: %s", err.Code, err.Message)
 	}
+	if strings.Contains(strings.ToLower(err.Message), "revert") {
+		rerr := errors.RevertedError("code: %d - message: %s", err.Code, err.Message)
+		// Data returned by the reverted execution (e.g. an encoded Error(string)), decoded by callers
+		if data, ok := err.Data.(string); ok && data != "" {
+			rerr.Extra = map[string]string{utils.RevertDataKey: data}
+		}
+		return rerr
+	}
 	return errors.EthereumError("code: %d - message: %s", err.Code, err.Message)
 }
 
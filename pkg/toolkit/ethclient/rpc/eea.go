@@ -8,6 +8,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/ethclient/utils"
 	proto "github.com/consensys/orchestrate/pkg/types/ethereum"
+	eth "github.com/ethereum/go-ethereum"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
@@ -88,6 +89,17 @@ func (ec *Client) PrivCodeAt(ctx context.Context, endpoint string, account ethco
 	return code, nil
 }
 
+// PrivCallContract executes a message call against the private state of a privacy group, which is directly executed
+// in the VM of the node but never mined into the blockchain
+func (ec *Client) PrivCallContract(ctx context.Context, endpoint string, msg *eth.CallMsg, privacyGroupID string, blockNumber *big.Int) ([]byte, error) {
+	var hex hexutil.Bytes
+	err := ec.Call(ctx, endpoint, utils.ProcessResult(&hex), "priv_call", privacyGroupID, toCallArg(msg), toBlockNumArg(blockNumber))
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(component)
+	}
+	return hex, nil
+}
+
 func (ec *Client) EEAPrivPrecompiledContractAddr(ctx context.Context, endpoint string) (ethcommon.Address, error) {
 	var hash string
 	err := ec.Call(ctx, endpoint, utils.ProcessResult(&hash), "priv_getPrivacyPrecompileAddress")
@@ -4,6 +4,7 @@ package rpc
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"testing"
 
@@ -11,7 +12,9 @@ import (
 	proto "github.com/consensys/orchestrate/pkg/types/ethereum"
 	pkgUtils "github.com/consensys/orchestrate/pkg/utils"
 	"github.com/cenkalti/backoff/v4"
+	eth "github.com/ethereum/go-ethereum"
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -105,3 +108,19 @@ func TestPrivateTransactionReceipt(t *testing.T) {
 	// 	assert.NotNil(t, receipt, "Public receipt should be there")
 	// })
 }
+
+func TestPrivCallContract(t *testing.T) {
+	ec := newEEAClient()
+
+	// Test 1 with Error
+	ctx := testutils.NewContext(fmt.Errorf("test-error"), 0, nil)
+	_, err := ec.PrivCallContract(ctx, "test-endpoint", &eth.CallMsg{}, "privacyGroupID", nil)
+	assert.Error(t, err, "#1 PrivCallContract should error")
+
+	// Test 2 without error
+	expectedResult := hexutil.MustDecode("0xabcd")
+	ctx = testutils.NewContext(nil, 200, testutils.MakeRespBody(hexutil.Bytes(expectedResult), ""))
+	result, err := ec.PrivCallContract(ctx, "test-endpoint", &eth.CallMsg{}, "privacyGroupID", nil)
+	assert.NoError(t, err, "#2 PrivCallContract should not error")
+	assert.Equal(t, hexutil.Encode(expectedResult), hexutil.Encode(result), "#2 PrivCallContract result should be correct")
+}
@@ -140,6 +140,46 @@ func (ec *Client) TransactionReceipt(ctx context.Context, endpoint string, txHas
 	return r, nil
 }
 
+// FilterLogs executes a filter query and returns the matching logs
+func (ec *Client) FilterLogs(ctx context.Context, endpoint string, q eth.FilterQuery) ([]ethtypes.Log, error) {
+	arg, err := toFilterArg(q)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(component)
+	}
+
+	var logs []ethtypes.Log
+	err = ec.Call(ctx, endpoint, utils.ProcessResult(&logs), "eth_getLogs", arg)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(component)
+	}
+
+	return logs, nil
+}
+
+func toFilterArg(q eth.FilterQuery) (interface{}, error) {
+	arg := map[string]interface{}{
+		"address": q.Addresses,
+		"topics":  q.Topics,
+	}
+
+	if q.BlockHash != nil {
+		if q.FromBlock != nil || q.ToBlock != nil {
+			return nil, errors.InvalidParameterError("cannot specify both block hash and block range")
+		}
+		arg["blockHash"] = *q.BlockHash
+		return arg, nil
+	}
+
+	if q.FromBlock == nil {
+		arg["fromBlock"] = "0x0"
+	} else {
+		arg["fromBlock"] = toBlockNumArg(q.FromBlock)
+	}
+	arg["toBlock"] = toBlockNumArg(q.ToBlock)
+
+	return arg, nil
+}
+
 func toBlockNumArg(number *big.Int) string {
 	if number == nil {
 		return "latest"
@@ -370,9 +410,13 @@ func parseFeeHistoryResult(feeHistory **FeeHistory) ParseResultFunc {
 	}
 }
 
-func (ec *Client) FeeHistory(ctx context.Context, endpoint string, blockCount int, newestBlock string) (*FeeHistory, error) {
+func (ec *Client) FeeHistory(ctx context.Context, endpoint string, blockCount int, newestBlock string, rewardPercentiles []float64) (*FeeHistory, error) {
+	if rewardPercentiles == nil {
+		rewardPercentiles = []float64{}
+	}
+
 	var feeHistory *FeeHistory
-	if err := ec.Call(ctx, endpoint, parseFeeHistoryResult(&feeHistory), "eth_feeHistory", blockCount, newestBlock, []interface{}{}); err != nil {
+	if err := ec.Call(ctx, endpoint, parseFeeHistoryResult(&feeHistory), "eth_feeHistory", blockCount, newestBlock, rewardPercentiles); err != nil {
 		return nil, errors.FromError(err).ExtendComponent(component)
 	}
 
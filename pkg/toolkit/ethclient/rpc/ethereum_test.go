@@ -40,6 +40,11 @@ func TestProcessEthError(t *testing.T) {
 	err := ec.processEthError(&utils.JSONError{Message: "json-rpc: nonce too low"})
 	assert.Equal(t, "BE001", errors.FromError(err).Hex(), "Error code should be correst")
 
+	// Reverted
+	err = ec.processEthError(&utils.JSONError{Code: 3, Message: "execution reverted: not allowed", Data: "0x08c379a0"})
+	assert.Equal(t, "BE003", errors.FromError(err).Hex(), "Error code should be correst")
+	assert.Equal(t, hexutil.MustDecode("0x08c379a0"), utils.RevertData(err), "Revert data should be correct")
+
 	// Default
 	err = ec.processEthError(&utils.JSONError{Message: "json-rpc: failed"})
 	assert.Equal(t, "BE000", errors.FromError(err).Hex(), "Error code should be correst")
@@ -2,8 +2,14 @@ package utils
 
 import (
 	"encoding/json"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
+// RevertDataKey is the key of the data returned by a reverted execution in the extra information of the error
+const RevertDataKey = "revertData"
+
 // Similar struct a https://github.com/ethereum/go-ethereum/blob/master/rpc/json.go
 type JSONRpcMessage struct {
 	Version string          `json:"jsonrpc,omitempty"`
@@ -19,3 +25,13 @@ type JSONError struct {
 	Message string      `json:"message"`
 	Data    interface{} `json:"data,omitempty"`
 }
+
+// RevertData returns the data returned by a reverted execution, or nil if the error is not a revert or has no data
+func RevertData(err error) []byte {
+	if !errors.IsRevertedError(err) {
+		return nil
+	}
+
+	data, _ := hexutil.Decode(errors.FromError(err).GetExtra()[RevertDataKey])
+	return data
+}
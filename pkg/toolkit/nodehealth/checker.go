@@ -0,0 +1,75 @@
+package nodehealth
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/ethclient/utils"
+	eth "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const checkerComponent = "nodehealth.checker"
+
+type Client interface {
+	SyncProgress(ctx context.Context, url string) (*eth.SyncProgress, error)
+	Call(ctx context.Context, endpoint string, processResult func(result json.RawMessage) error, method string, args ...interface{}) error
+}
+
+// Checker actively checks the head, the sync status and the latency of nodes
+type Checker struct {
+	ec       Client
+	registry *Registry
+	timeout  time.Duration
+	logger   *log.Logger
+}
+
+func NewChecker(ec Client, registry *Registry, timeout time.Duration) *Checker {
+	return &Checker{
+		ec:       ec,
+		registry: registry,
+		timeout:  timeout,
+		logger:   log.NewLogger().SetComponent(checkerComponent),
+	}
+}
+
+// Check concurrently checks the given nodes and records the results in the registry
+func (c *Checker) Check(ctx context.Context, urls []string) {
+	wg := &sync.WaitGroup{}
+	for _, url := range urls {
+		wg.Add(1)
+		go func(url string) {
+			defer wg.Done()
+			c.check(ctx, url)
+		}(url)
+	}
+	wg.Wait()
+}
+
+func (c *Checker) check(ctx context.Context, url string) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	var head hexutil.Uint64
+	err := c.ec.Call(ctx, url, utils.ProcessResult(&head), "eth_blockNumber")
+	if err != nil {
+		c.logger.WithContext(ctx).WithError(err).Warn("failed to fetch block number of node")
+		c.registry.ObserveFailure(url, err)
+		return
+	}
+	latency := time.Since(start)
+
+	progress, err := c.ec.SyncProgress(ctx, url)
+	if err != nil {
+		c.logger.WithContext(ctx).WithError(err).Warn("failed to fetch sync status of node")
+		c.registry.ObserveFailure(url, err)
+		return
+	}
+
+	c.registry.ObserveSuccess(url, latency)
+	c.registry.SetHead(url, uint64(head), progress != nil)
+}
@@ -0,0 +1,64 @@
+// +build unit
+
+package nodehealth
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/ethclient/mock"
+	eth "github.com/ethereum/go-ethereum"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChecker_Check(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ec := mock.NewMockClient(ctrl)
+	ctx := context.Background()
+
+	blockNumber := func(result string) func(context.Context, string, func(json.RawMessage) error, string, ...interface{}) error {
+		return func(_ context.Context, _ string, processResult func(json.RawMessage) error, _ string, _ ...interface{}) error {
+			return processResult(json.RawMessage(result))
+		}
+	}
+
+	t.Run("should record head, sync status and latency of the nodes", func(t *testing.T) {
+		registry := NewRegistry(NewDefaultConfig())
+		checker := NewChecker(ec, registry, time.Second)
+
+		ec.EXPECT().Call(gomock.Any(), node1, gomock.Any(), "eth_blockNumber").DoAndReturn(blockNumber(`"0x64"`))
+		ec.EXPECT().SyncProgress(gomock.Any(), node1).Return(nil, nil)
+		ec.EXPECT().Call(gomock.Any(), node2, gomock.Any(), "eth_blockNumber").DoAndReturn(blockNumber(`"0x60"`))
+		ec.EXPECT().SyncProgress(gomock.Any(), node2).Return(&eth.SyncProgress{CurrentBlock: 96, HighestBlock: 100}, nil)
+
+		checker.Check(ctx, []string{node1, node2})
+
+		statuses := registry.Status([]string{node1, node2})
+		assert.Equal(t, uint64(100), statuses[0].Head)
+		assert.True(t, statuses[0].Healthy)
+		assert.False(t, statuses[0].LastCheckedAt.IsZero())
+		assert.Equal(t, uint64(96), statuses[1].Head)
+		assert.Equal(t, uint64(4), statuses[1].BlockLag)
+		assert.True(t, statuses[1].Syncing)
+		assert.False(t, statuses[1].Healthy)
+	})
+
+	t.Run("should record failure if node is unreachable", func(t *testing.T) {
+		registry := NewRegistry(NewDefaultConfig())
+		checker := NewChecker(ec, registry, time.Second)
+
+		ec.EXPECT().Call(gomock.Any(), node1, gomock.Any(), "eth_blockNumber").Return(errors.EthConnectionError("connection refused"))
+
+		checker.Check(ctx, []string{node1})
+
+		status := registry.Status([]string{node1})[0]
+		assert.Greater(t, status.ErrorRate, float64(0))
+		assert.NotEmpty(t, status.LastError)
+	})
+}
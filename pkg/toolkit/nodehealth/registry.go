@@ -0,0 +1,231 @@
+package nodehealth
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// RoundRobinStrategy distributes requests evenly on all the nodes
+	RoundRobinStrategy = "roundrobin"
+	// LatencyStrategy routes requests to the healthy node with the lowest latency
+	LatencyStrategy = "latency"
+	// HeadStrategy routes requests to the healthy node with the highest head
+	HeadStrategy = "head"
+)
+
+// Smoothing factors of the moving averages of latency and error rate
+const (
+	latencyAlpha   = 0.3
+	errorRateAlpha = 0.2
+)
+
+type Config struct {
+	MaxBlockLag       uint64        // Maximum number of blocks a node can be behind its peers to be healthy
+	MaxErrorRate      float64       // Maximum error rate (between 0 and 1) of a node to be healthy
+	FailureThreshold  int           // Number of consecutive failures after which a node is ejected
+	EjectionDuration  time.Duration // Duration during which an ejected node does not receive traffic
+	CheckTimeout      time.Duration // Timeout of the active health check of a node
+	CheckInterval     time.Duration // Interval between two active health checks, 0 disables active checks
+	BalancingStrategy string        // Strategy used to select a node
+}
+
+func NewDefaultConfig() *Config {
+	return &Config{
+		MaxBlockLag:       5,
+		MaxErrorRate:      0.5,
+		FailureThreshold:  3,
+		EjectionDuration:  30 * time.Second,
+		CheckTimeout:      5 * time.Second,
+		CheckInterval:     10 * time.Second,
+		BalancingStrategy: LatencyStrategy,
+	}
+}
+
+// NodeStatus is the health of a node compared to the other nodes of the same chain
+type NodeStatus struct {
+	URL           string
+	Healthy       bool
+	Ejected       bool
+	Syncing       bool
+	Head          uint64
+	BlockLag      uint64
+	Latency       time.Duration
+	ErrorRate     float64
+	LastError     string
+	LastCheckedAt time.Time
+}
+
+type node struct {
+	head                uint64
+	syncing             bool
+	latency             time.Duration
+	errorRate           float64
+	consecutiveFailures int
+	ejectedUntil        time.Time
+	lastError           string
+	lastCheckedAt       time.Time
+}
+
+// Registry holds the health of the nodes, fed by active health checks and by the outcome of proxied requests
+type Registry struct {
+	cfg   *Config
+	mux   sync.RWMutex
+	nodes map[string]*node
+	now   func() time.Time
+}
+
+func NewRegistry(cfg *Config) *Registry {
+	return &Registry{
+		cfg:   cfg,
+		nodes: make(map[string]*node),
+		now:   time.Now,
+	}
+}
+
+// ObserveSuccess records a successful request to a node
+func (r *Registry) ObserveSuccess(url string, latency time.Duration) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	n := r.node(url)
+	if n.latency == 0 {
+		n.latency = latency
+	} else {
+		n.latency = time.Duration(latencyAlpha*float64(latency) + (1-latencyAlpha)*float64(n.latency))
+	}
+	n.errorRate = (1 - errorRateAlpha) * n.errorRate
+	n.consecutiveFailures = 0
+}
+
+// ObserveFailure records a failed request to a node and ejects it once the failure threshold is reached
+func (r *Registry) ObserveFailure(url string, err error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	n := r.node(url)
+	n.errorRate = errorRateAlpha + (1-errorRateAlpha)*n.errorRate
+	n.consecutiveFailures++
+	if err != nil {
+		n.lastError = err.Error()
+	}
+
+	if n.consecutiveFailures >= r.cfg.FailureThreshold {
+		n.ejectedUntil = r.now().Add(r.cfg.EjectionDuration)
+		n.consecutiveFailures = 0
+	}
+}
+
+// SetHead records the result of an active health check of a node
+func (r *Registry) SetHead(url string, head uint64, syncing bool) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	n := r.node(url)
+	n.head = head
+	n.syncing = syncing
+	n.lastCheckedAt = r.now()
+}
+
+// Status returns the health of the given nodes, the block lag being computed against the highest head of the set
+func (r *Registry) Status(urls []string) []*NodeStatus {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+
+	var highestHead uint64
+	for _, url := range urls {
+		if n, ok := r.nodes[url]; ok && n.head > highestHead {
+			highestHead = n.head
+		}
+	}
+
+	now := r.now()
+	statuses := make([]*NodeStatus, len(urls))
+	for i, url := range urls {
+		status := &NodeStatus{URL: url, Healthy: true}
+		if n, ok := r.nodes[url]; ok {
+			status.Ejected = now.Before(n.ejectedUntil)
+			status.Syncing = n.syncing
+			status.Head = n.head
+			status.BlockLag = highestHead - n.head
+			status.Latency = n.latency
+			status.ErrorRate = n.errorRate
+			status.LastError = n.lastError
+			status.LastCheckedAt = n.lastCheckedAt
+			status.Healthy = !status.Ejected && !status.Syncing &&
+				status.BlockLag <= r.cfg.MaxBlockLag && status.ErrorRate <= r.cfg.MaxErrorRate
+		}
+		statuses[i] = status
+	}
+
+	return statuses
+}
+
+// Select returns the index of the node to route a request to, following the given strategy.
+// If no node is healthy, the nodes which are not ejected are considered, then all of them.
+func (r *Registry) Select(urls []string, strategy string) int {
+	statuses := r.Status(urls)
+
+	candidates := filter(statuses, func(status *NodeStatus) bool { return status.Healthy })
+	if len(candidates) == 0 {
+		candidates = filter(statuses, func(status *NodeStatus) bool { return !status.Ejected })
+	}
+	if len(candidates) == 0 {
+		candidates = filter(statuses, func(*NodeStatus) bool { return true })
+	}
+
+	selected := candidates[0]
+	for _, idx := range candidates[1:] {
+		if better(statuses[idx], statuses[selected], strategy) {
+			selected = idx
+		}
+	}
+
+	return selected
+}
+
+// Retain forgets the nodes which are not part of the given set
+func (r *Registry) Retain(urls []string) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	retained := make(map[string]bool, len(urls))
+	for _, url := range urls {
+		retained[url] = true
+	}
+
+	for url := range r.nodes {
+		if !retained[url] {
+			delete(r.nodes, url)
+		}
+	}
+}
+
+func (r *Registry) node(url string) *node {
+	n, ok := r.nodes[url]
+	if !ok {
+		n = &node{}
+		r.nodes[url] = n
+	}
+
+	return n
+}
+
+func filter(statuses []*NodeStatus, keep func(*NodeStatus) bool) []int {
+	var indexes []int
+	for i, status := range statuses {
+		if keep(status) {
+			indexes = append(indexes, i)
+		}
+	}
+
+	return indexes
+}
+
+func better(candidate, selected *NodeStatus, strategy string) bool {
+	if strategy == HeadStrategy && candidate.Head != selected.Head {
+		return candidate.Head > selected.Head
+	}
+
+	return candidate.Latency < selected.Latency
+}
@@ -0,0 +1,155 @@
+// +build unit
+
+package nodehealth
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	node1 = "http://node-1:8545"
+	node2 = "http://node-2:8545"
+	node3 = "http://node-3:8545"
+)
+
+func TestRegistry_Status(t *testing.T) {
+	t.Run("should consider unknown nodes as healthy", func(t *testing.T) {
+		registry := NewRegistry(NewDefaultConfig())
+
+		statuses := registry.Status([]string{node1})
+
+		require.Len(t, statuses, 1)
+		assert.Equal(t, node1, statuses[0].URL)
+		assert.True(t, statuses[0].Healthy)
+	})
+
+	t.Run("should compute block lag against the highest head of the set", func(t *testing.T) {
+		registry := NewRegistry(NewDefaultConfig())
+		registry.SetHead(node1, 100, false)
+		registry.SetHead(node2, 90, false)
+		registry.SetHead(node3, 97, false)
+
+		statuses := registry.Status([]string{node1, node2, node3})
+
+		assert.Equal(t, uint64(0), statuses[0].BlockLag)
+		assert.True(t, statuses[0].Healthy)
+		assert.Equal(t, uint64(10), statuses[1].BlockLag)
+		assert.False(t, statuses[1].Healthy)
+		assert.Equal(t, uint64(3), statuses[2].BlockLag)
+		assert.True(t, statuses[2].Healthy)
+	})
+
+	t.Run("should consider syncing nodes as unhealthy", func(t *testing.T) {
+		registry := NewRegistry(NewDefaultConfig())
+		registry.SetHead(node1, 100, true)
+
+		statuses := registry.Status([]string{node1})
+
+		assert.True(t, statuses[0].Syncing)
+		assert.False(t, statuses[0].Healthy)
+	})
+
+	t.Run("should eject node after consecutive failures and restore it after the ejection duration", func(t *testing.T) {
+		cfg := NewDefaultConfig()
+		registry := NewRegistry(cfg)
+		now := time.Now()
+		registry.now = func() time.Time { return now }
+
+		for i := 0; i < cfg.FailureThreshold; i++ {
+			registry.ObserveFailure(node1, fmt.Errorf("503 Service Unavailable"))
+		}
+
+		status := registry.Status([]string{node1})[0]
+		assert.True(t, status.Ejected)
+		assert.False(t, status.Healthy)
+		assert.Equal(t, "503 Service Unavailable", status.LastError)
+
+		now = now.Add(cfg.EjectionDuration)
+		for i := 0; i < 10; i++ {
+			registry.ObserveSuccess(node1, time.Millisecond)
+		}
+
+		status = registry.Status([]string{node1})[0]
+		assert.False(t, status.Ejected)
+		assert.True(t, status.Healthy)
+	})
+
+	t.Run("should consider nodes with a high error rate as unhealthy", func(t *testing.T) {
+		cfg := NewDefaultConfig()
+		cfg.FailureThreshold = 100
+		registry := NewRegistry(cfg)
+
+		for i := 0; i < 5; i++ {
+			registry.ObserveFailure(node1, nil)
+		}
+
+		status := registry.Status([]string{node1})[0]
+		assert.False(t, status.Ejected)
+		assert.Greater(t, status.ErrorRate, cfg.MaxErrorRate)
+		assert.False(t, status.Healthy)
+	})
+}
+
+func TestRegistry_Select(t *testing.T) {
+	urls := []string{node1, node2, node3}
+
+	t.Run("should select healthy node with the lowest latency", func(t *testing.T) {
+		registry := NewRegistry(NewDefaultConfig())
+		registry.ObserveSuccess(node1, 30*time.Millisecond)
+		registry.ObserveSuccess(node2, 10*time.Millisecond)
+		registry.ObserveSuccess(node3, 20*time.Millisecond)
+
+		assert.Equal(t, 1, registry.Select(urls, LatencyStrategy))
+	})
+
+	t.Run("should select healthy node with the highest head", func(t *testing.T) {
+		registry := NewRegistry(NewDefaultConfig())
+		registry.SetHead(node1, 100, false)
+		registry.SetHead(node2, 101, false)
+		registry.SetHead(node3, 102, true)
+
+		assert.Equal(t, 1, registry.Select(urls, HeadStrategy))
+	})
+
+	t.Run("should skip lagging nodes", func(t *testing.T) {
+		registry := NewRegistry(NewDefaultConfig())
+		registry.SetHead(node1, 100, false)
+		registry.SetHead(node2, 50, false)
+		registry.SetHead(node3, 100, false)
+		registry.ObserveSuccess(node1, 30*time.Millisecond)
+		registry.ObserveSuccess(node2, 10*time.Millisecond)
+		registry.ObserveSuccess(node3, 20*time.Millisecond)
+
+		assert.Equal(t, 2, registry.Select(urls, LatencyStrategy))
+	})
+
+	t.Run("should fall back on non ejected nodes if no node is healthy", func(t *testing.T) {
+		cfg := NewDefaultConfig()
+		registry := NewRegistry(cfg)
+		registry.SetHead(node1, 100, true)
+		registry.SetHead(node2, 100, true)
+		registry.ObserveSuccess(node1, 30*time.Millisecond)
+		registry.ObserveSuccess(node2, 10*time.Millisecond)
+		for i := 0; i < cfg.FailureThreshold; i++ {
+			registry.ObserveFailure(node2, nil)
+		}
+
+		assert.Equal(t, 0, registry.Select([]string{node1, node2}, LatencyStrategy))
+	})
+}
+
+func TestRegistry_Retain(t *testing.T) {
+	registry := NewRegistry(NewDefaultConfig())
+	registry.SetHead(node1, 100, false)
+	registry.SetHead(node2, 100, false)
+
+	registry.Retain([]string{node1})
+
+	assert.Len(t, registry.nodes, 1)
+	assert.Contains(t, registry.nodes, node1)
+}
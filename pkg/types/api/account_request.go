@@ -1,7 +1,9 @@
 package api
 
 import (
+	"github.com/consensys/orchestrate/pkg/types/entities"
 	"github.com/consensys/quorum-key-manager/src/stores/api/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
@@ -20,6 +22,15 @@ type ImportAccountRequest struct {
 	Attributes map[string]string `json:"attributes,omitempty"`
 }
 
+type RegisterSmartAccountRequest struct {
+	Alias      string                    `json:"alias" validate:"omitempty" example:"safe-account"`
+	Type       entities.SmartAccountType `json:"type" validate:"required,isSmartAccountType" example:"Safe"`                                                                         // Currently supports `Safe` and `ERC4337`.
+	Address    ethcommon.Address         `json:"address" validate:"required" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"string"`                              // Address of the deployed smart account.
+	Signer     ethcommon.Address         `json:"signer" validate:"required" example:"0x5bd1b3b1d4bd1e0e7b8e8e27a1c5dd7e9b1bf8c3" swaggertype:"string"`                               // Existing account signing the transactions of the smart account.
+	EntryPoint *ethcommon.Address        `json:"entryPoint,omitempty" validate:"required_if=Type ERC4337" example:"0x5ff137d4b0fdcd49dca30c7cf57e578a026d2789" swaggertype:"string"` // `ERC4337` only. EntryPoint contract of the smart account.
+	Attributes map[string]string         `json:"attributes,omitempty"`
+}
+
 type UpdateAccountRequest struct {
 	Alias      string            `json:"alias" validate:"omitempty"  example:"personal-account"`
 	StoreID    string            `json:"storeID" validate:"omitempty" example:"qkmStoreID"`
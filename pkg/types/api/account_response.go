@@ -13,16 +13,17 @@ import (
 const DefaultAccountPageSize = 25
 
 type AccountResponse struct {
-	Alias               string            `json:"alias" example:"personal-account"`
-	Address             ethcommon.Address `json:"address" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"string"`
-	PublicKey           hexutil.Bytes     `json:"publicKey" example:"0x048e66b3e549818ea2cb354fb70749f6c8de8fa484f7530fc447d5fe80a1c424e4f5ae648d648c980ae7095d1efad87161d83886ca4b6c498ac22a93da5099014a" swaggertype:"string"`
-	CompressedPublicKey hexutil.Bytes     `json:"compressedPublicKey" example:"0x048e66b3e549818ea2cb354fb70749f6c8de8fa484f7530fc447" swaggertype:"string"`
-	TenantID            string            `json:"tenantID" example:"tenantFoo"`
-	OwnerID             string            `json:"ownerID,omitempty" example:"foo"`
-	StoreID             string            `json:"storeID,omitempty" example:"myQKMStoreID"`
-	Attributes          map[string]string `json:"attributes,omitempty"`
-	CreatedAt           time.Time         `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
-	UpdatedAt           time.Time         `json:"updatedAt,omitempty" example:"2020-07-09T12:35:42.115395Z"`
+	Alias               string                 `json:"alias" example:"personal-account"`
+	Address             ethcommon.Address      `json:"address" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"string"`
+	PublicKey           hexutil.Bytes          `json:"publicKey" example:"0x048e66b3e549818ea2cb354fb70749f6c8de8fa484f7530fc447d5fe80a1c424e4f5ae648d648c980ae7095d1efad87161d83886ca4b6c498ac22a93da5099014a" swaggertype:"string"`
+	CompressedPublicKey hexutil.Bytes          `json:"compressedPublicKey" example:"0x048e66b3e549818ea2cb354fb70749f6c8de8fa484f7530fc447" swaggertype:"string"`
+	TenantID            string                 `json:"tenantID" example:"tenantFoo"`
+	OwnerID             string                 `json:"ownerID,omitempty" example:"foo"`
+	StoreID             string                 `json:"storeID,omitempty" example:"myQKMStoreID"`
+	Attributes          map[string]string      `json:"attributes,omitempty"`
+	SmartAccount        *entities.SmartAccount `json:"smartAccount,omitempty"`
+	CreatedAt           time.Time              `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+	UpdatedAt           time.Time              `json:"updatedAt,omitempty" example:"2020-07-09T12:35:42.115395Z"`
 }
 
 type AccountSearchResponse struct {
@@ -31,16 +32,17 @@ type AccountSearchResponse struct {
 }
 
 type accountResponseJSON struct {
-	Alias               string            `json:"alias"`
-	Address             string            `json:"address"`
-	PublicKey           string            `json:"publicKey"`
-	CompressedPublicKey string            `json:"compressedPublicKey"`
-	TenantID            string            `json:"tenantID"`
-	OwnerID             string            `json:"ownerID,omitempty"`
-	StoreID             string            `json:"storeID,omitempty"`
-	Attributes          map[string]string `json:"attributes,omitempty"`
-	CreatedAt           time.Time         `json:"createdAt"`
-	UpdatedAt           time.Time         `json:"updatedAt,omitempty"`
+	Alias               string                 `json:"alias"`
+	Address             string                 `json:"address"`
+	PublicKey           string                 `json:"publicKey"`
+	CompressedPublicKey string                 `json:"compressedPublicKey"`
+	TenantID            string                 `json:"tenantID"`
+	OwnerID             string                 `json:"ownerID,omitempty"`
+	StoreID             string                 `json:"storeID,omitempty"`
+	Attributes          map[string]string      `json:"attributes,omitempty"`
+	SmartAccount        *entities.SmartAccount `json:"smartAccount,omitempty"`
+	CreatedAt           time.Time              `json:"createdAt"`
+	UpdatedAt           time.Time              `json:"updatedAt,omitempty"`
 }
 
 func (a *AccountResponse) MarshalJSON() ([]byte, error) {
@@ -53,6 +55,7 @@ func (a *AccountResponse) MarshalJSON() ([]byte, error) {
 		OwnerID:             a.OwnerID,
 		StoreID:             a.StoreID,
 		Attributes:          a.Attributes,
+		SmartAccount:        a.SmartAccount,
 		CreatedAt:           a.CreatedAt,
 		UpdatedAt:           a.UpdatedAt,
 	}
@@ -70,6 +73,7 @@ func NewAccountResponse(acc *entities.Account) *AccountResponse {
 		TenantID:            acc.TenantID,
 		OwnerID:             acc.OwnerID,
 		StoreID:             acc.StoreID,
+		SmartAccount:        acc.SmartAccount,
 		CreatedAt:           acc.CreatedAt,
 		UpdatedAt:           acc.UpdatedAt,
 	}
@@ -1,13 +1,22 @@
 package api
 
 import (
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/types/entities"
 	"github.com/consensys/orchestrate/pkg/utils"
 )
 
 type Annotations struct {
-	OneTimeKey     bool           `json:"oneTimeKey,omitempty" example:"true"`
-	HasBeenRetried bool           `json:"hasBeenRetried,omitempty" example:"false"`
-	GasPricePolicy GasPriceParams `json:"gasPricePolicy,omitempty"`
+	OneTimeKey     bool                   `json:"oneTimeKey,omitempty" example:"true"`
+	HasBeenRetried bool                   `json:"hasBeenRetried,omitempty" example:"false"`
+	GasPricePolicy GasPriceParams         `json:"gasPricePolicy,omitempty"`
+	NotBefore      *time.Time             `json:"notBefore,omitempty" example:"2022-01-01T00:00:00Z"`
+	NotBeforeBlock *uint64                `json:"notBeforeBlock,omitempty" example:"1000"`
+	Simulate       bool                   `json:"simulate,omitempty" example:"true"`
+	GraphJobID     string                 `json:"graphJobID,omitempty" example:"deployToken"`                           // Job of the schedule graph, set at creation of the graph.
+	DependsOn      []string               `json:"dependsOn,omitempty" example:"[b4374e6f-b28a-4bad-b4fe-bda36eaf849c]"` // Jobs of the schedule graph to be mined first, set at creation of the graph.
+	SmartAccount   *entities.SmartAccount `json:"smartAccount,omitempty"`                                               // Smart account executing the transaction, set from the `from` account.
 }
 
 func (g *Annotations) Validate() error {
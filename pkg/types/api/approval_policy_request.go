@@ -0,0 +1,16 @@
+package api
+
+import (
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type CreateApprovalPolicyRequest struct {
+	Name            string             `json:"name" validate:"required" example:"treasury-transfers"`                                        // Name of the policy.
+	ChainUUID       string             `json:"chainUUID,omitempty" validate:"omitempty,uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"` // Only matches the jobs of the chain.
+	MinValue        *hexutil.Big       `json:"minValue,omitempty" validate:"omitempty" example:"0xde0b6b3a7640000" swaggertype:"string"`     // Only matches the jobs transferring at least this amount of ether, in Wei.
+	To              *ethcommon.Address `json:"to,omitempty" example:"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18" swaggertype:"string"`       // Only matches the jobs sent to this address.
+	MethodSignature string             `json:"methodSignature,omitempty" example:"upgradeTo(address)"`                                       // Only matches the jobs calling this method.
+	Threshold       int                `json:"threshold" validate:"required,min=1" example:"2"`                                              // Number of approvals required.
+	Approvers       []string           `json:"approvers" validate:"required,min=1,unique,dive,required" example:"alice,bob,carol"`           // Usernames of the users allowed to approve the matching jobs.
+}
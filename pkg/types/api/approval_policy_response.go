@@ -0,0 +1,23 @@
+package api
+
+import (
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type ApprovalPolicyResponse struct {
+	UUID            string             `json:"uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`                                    // UUID of the policy.
+	Name            string             `json:"name" example:"treasury-transfers"`                                                      // Name of the policy.
+	ChainUUID       string             `json:"chainUUID,omitempty" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`                     // Only matches the jobs of the chain.
+	MinValue        *hexutil.Big       `json:"minValue,omitempty" example:"0xde0b6b3a7640000" swaggertype:"string"`                    // Only matches the jobs transferring at least this amount of ether, in Wei.
+	To              *ethcommon.Address `json:"to,omitempty" example:"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18" swaggertype:"string"` // Only matches the jobs sent to this address.
+	MethodSignature string             `json:"methodSignature,omitempty" example:"upgradeTo(address)"`                                 // Only matches the jobs calling this method.
+	Threshold       int                `json:"threshold" example:"2"`                                                                  // Number of approvals required.
+	Approvers       []string           `json:"approvers" example:"alice,bob,carol"`                                                    // Usernames of the users allowed to approve the matching jobs.
+	TenantID        string             `json:"tenantID" example:"tenant"`                                                              // ID of the tenant executing the API.
+	OwnerID         string             `json:"ownerID,omitempty" example:"foo"`                                                        // ID of the policy owner.
+	CreatedAt       time.Time          `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`                                        // Date and time at which the policy was created.
+	UpdatedAt       time.Time          `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`                                        // Date and time at which the policy was updated.
+}
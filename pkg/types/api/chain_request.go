@@ -1,7 +1,10 @@
 package api
 
 import (
+	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/types/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 type RegisterChainRequest struct {
@@ -9,6 +12,8 @@ type RegisterChainRequest struct {
 	URLs             []string                 `json:"urls" pg:"urls,array" validate:"required,min=1,unique,dive,url" example:"https://mainnet.infura.io/v3/a73136601e6f4924a0baa4ed880b535e"` // List of URLs of Ethereum nodes to connect to.
 	Listener         RegisterListenerRequest  `json:"listener,omitempty"`
 	PrivateTxManager *PrivateTxManagerRequest `json:"privateTxManager,omitempty"`
+	GasOracle        *GasOracleRequest        `json:"gasOracle,omitempty"`                                                                 // Strategy used to compute gas fees of transactions sent to the chain (default `Default`).
+	BundlerURL       string                   `json:"bundlerURL,omitempty" validate:"omitempty,url" example:"https://bundler.example.com"` // ERC-4337 bundler receiving the UserOperations of smart accounts.
 	Headers          map[string]string        `json:"headers,omitempty" validate:"omitempty"`
 	Labels           map[string]string        `json:"labels,omitempty"` // List of custom labels. Useful for adding custom information to the chain.
 }
@@ -24,6 +29,8 @@ type UpdateChainRequest struct {
 	Name             string                   `json:"name,omitempty" example:"mainnet"`
 	Listener         *UpdateListenerRequest   `json:"listener,omitempty"`
 	PrivateTxManager *PrivateTxManagerRequest `json:"privateTxManager,omitempty"`
+	GasOracle        *GasOracleRequest        `json:"gasOracle,omitempty"`
+	BundlerURL       string                   `json:"bundlerURL,omitempty" validate:"omitempty,url"`
 	Labels           map[string]string        `json:"labels,omitempty"`
 	Headers          map[string]string        `json:"headers,omitempty" validate:"omitempty"`
 }
@@ -39,3 +46,36 @@ type PrivateTxManagerRequest struct {
 	URL  string                        `json:"url" validate:"required,url" example:"http://tessera:3000"`         // Transaction manager endpoint.
 	Type entities.PrivateTxManagerType `json:"type" validate:"required,isPrivateTxManagerType" example:"Tessera"` // Currently supports `Tessera` and `EEA``.
 }
+
+type GasOracleRequest struct {
+	Type       entities.GasOracleType `json:"type" validate:"required,isGasOracleType" example:"FeeHistory"`                                         // Currently supports `Default`, `FeeHistory`, `Fixed` and `HTTP`.
+	Blocks     uint64                 `json:"blocks,omitempty" validate:"omitempty,max=1024" example:"20"`                                           // `FeeHistory` only. Number of blocks of fee history (default 20).
+	Percentile float64                `json:"percentile,omitempty" validate:"omitempty,gt=0,lte=100" example:"60"`                                   // `FeeHistory` only. Percentile of priority fees paid in the history, replaces the percentile derived from the priority.
+	GasPrice   *hexutil.Big           `json:"gasPrice,omitempty" validate:"required_if=Type Fixed" example:"0x3b9aca00" swaggertype:"string"`        // `Fixed` only. Gas price of legacy transactions.
+	GasTipCap  *hexutil.Big           `json:"maxPriorityFeePerGas,omitempty" validate:"omitempty" example:"0x3b9aca00" swaggertype:"string"`         // `Fixed` only. Priority fee of dynamic fee transactions (default `gasPrice`).
+	URL        string                 `json:"url,omitempty" validate:"required_if=Type HTTP,omitempty,url" example:"https://gas-oracle.example.com"` // `HTTP` only. URL of the gas oracle.
+}
+
+type CallContractRequest struct {
+	ContractName    string             `json:"contractName,omitempty" example:"MyContract"`                                                                   // Name of the registered contract. Required if `abi` is not set.
+	ContractTag     string             `json:"contractTag,omitempty" example:"v1.1.0"`                                                                        // Tag of the registered contract (default `latest`).
+	ABI             interface{}        `json:"abi,omitempty"`                                                                                                 // ABI of the contract, used instead of a registered contract.
+	From            *ethcommon.Address `json:"from,omitempty" validate:"omitempty" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"string"` // Address executing the call.
+	To              ethcommon.Address  `json:"to" validate:"required" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"string"`              // Address of the contract.
+	MethodSignature string             `json:"methodSignature" validate:"required" example:"balanceOf(address)"`                                              // Signature of the method to call.
+	Args            []interface{}      `json:"args,omitempty"`                                                                                                // Arguments of the method.
+	BlockNumber     *uint64            `json:"blockNumber,omitempty" example:"5000"`                                                                          // Block at which the call is executed (default latest block).
+	PrivacyGroupID  string             `json:"privacyGroupId,omitempty" validate:"omitempty,base64" example:"A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="`   // Executes a private call (EEA `priv_call`) against the state of the privacy group.
+}
+
+func (req *CallContractRequest) Validate() error {
+	if req.ContractName == "" && req.ABI == nil {
+		return errors.InvalidParameterError("one of fields 'contractName' and 'abi' is required")
+	}
+
+	if req.ContractName != "" && req.ABI != nil {
+		return errors.InvalidParameterError("fields 'contractName' and 'abi' are mutually exclusive")
+	}
+
+	return nil
+}
@@ -20,8 +20,28 @@ type ChainResponse struct {
 	ListenerBackOffDuration   string                     `json:"listenerBackOffDuration" example:"5s"`                                         // Time to wait before trying to fetch a new mined block.
 	ListenerExternalTxEnabled *bool                      `json:"listenerExternalTxEnabled" example:"false"`                                    // Whether the chain listens for external transactions not crafted by Orchestrate.
 	PrivateTxManager          *entities.PrivateTxManager `json:"privateTxManager,omitempty"`
-	Headers                   map[string]string          `json:"headers,omitempty" validate:"omitempty"`          // Set of HTTP headers attached to every request to the node
-	Labels                    map[string]string          `json:"labels,omitempty"`                                // List of custom labels.
-	CreatedAt                 time.Time                  `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"` // Date and time at which the chain was registered.
-	UpdatedAt                 time.Time                  `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"` // Date and time at which the chain details were updated.
+	GasOracle                 *entities.GasOracle        `json:"gasOracle,omitempty"`                                        // Strategy used to compute gas fees.
+	BundlerURL                string                     `json:"bundlerURL,omitempty" example:"https://bundler.example.com"` // ERC-4337 bundler receiving the UserOperations of smart accounts.
+	Headers                   map[string]string          `json:"headers,omitempty" validate:"omitempty"`                     // Set of HTTP headers attached to every request to the node
+	Labels                    map[string]string          `json:"labels,omitempty"`                                           // List of custom labels.
+	CreatedAt                 time.Time                  `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`            // Date and time at which the chain was registered.
+	UpdatedAt                 time.Time                  `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`            // Date and time at which the chain details were updated.
+	Nodes                     []*ChainNodeResponse       `json:"nodes,omitempty"`                                            // Health of the nodes of the chain.
+}
+
+type ChainNodeResponse struct {
+	URL           string     `json:"url" example:"https://mainnet.infura.io/v3/a73136601e6f4924a0baa4ed880b535e"` // URL of the node.
+	Healthy       bool       `json:"healthy" example:"true"`                                                      // Whether the node receives traffic from the chain proxy.
+	Ejected       bool       `json:"ejected" example:"false"`                                                     // Whether the node is temporarily ejected after consecutive failures.
+	Syncing       bool       `json:"syncing" example:"false"`                                                     // Whether the node is synchronizing.
+	Head          uint64     `json:"head" example:"5000"`                                                         // Latest block number of the node.
+	BlockLag      uint64     `json:"blockLag" example:"0"`                                                        // Number of blocks the node is behind the highest head of its peers.
+	Latency       string     `json:"latency" example:"25ms"`                                                      // Average latency of the requests to the node.
+	ErrorRate     float64    `json:"errorRate" example:"0.1"`                                                     // Average rate of failed requests to the node.
+	LastError     string     `json:"lastError,omitempty" example:"503 Service Unavailable"`                       // Last error returned by the node.
+	LastCheckedAt *time.Time `json:"lastCheckedAt,omitempty" example:"2020-07-09T12:35:42.115395Z"`               // Date and time of the last active health check of the node.
+}
+
+type CallContractResponse struct {
+	Outputs map[string]interface{} `json:"outputs"` // Decoded outputs of the method, by name or by position for unnamed outputs.
 }
@@ -1,17 +1,26 @@
 package api
 
 import (
+	"encoding/json"
+
 	"github.com/consensys/orchestrate/pkg/types/entities"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 type RegisterContractRequest struct {
-	ABI              interface{}   `json:"abi,omitempty" validate:"required"`
-	Bytecode         hexutil.Bytes `json:"bytecode,omitempty" validate:"omitempty" example:"0x6080604052348015600f57600080f" swaggertype:"string"`
-	DeployedBytecode hexutil.Bytes `json:"deployedBytecode,omitempty" validate:"omitempty" example:"0x6080604052348015600f57600080f" swaggertype:"string"`
-	Name             string        `json:"name" validate:"required" example:"ERC20"`
-	Tag              string        `json:"tag,omitempty" example:"v1.0.0"`
+	ABI              interface{}   `json:"abi,omitempty" validate:"required_without=Artifact,excluded_with=Artifact"`
+	Bytecode         hexutil.Bytes `json:"bytecode,omitempty" validate:"omitempty,excluded_with=Artifact" example:"0x6080604052348015600f57600080f" swaggertype:"string"`
+	DeployedBytecode hexutil.Bytes `json:"deployedBytecode,omitempty" validate:"omitempty,excluded_with=Artifact" example:"0x6080604052348015600f57600080f" swaggertype:"string"`
+	// Hardhat or Foundry artifact of the contract, used instead of the ABI and bytecodes
+	Artifact json.RawMessage `json:"artifact,omitempty" validate:"omitempty" swaggertype:"object"`
+	// Name of the contract, defaulting to the name in the artifact
+	Name string `json:"name,omitempty" validate:"required_without=Artifact" example:"ERC20"`
+	Tag  string `json:"tag,omitempty" example:"v1.0.0"`
+}
+
+type SetContractTagRequest struct {
+	Target string `json:"target" validate:"required" example:"v1.0.0"`
 }
 
 type ContractResponse struct {
@@ -21,6 +30,7 @@ type ContractResponse struct {
 	ABI              string                  `json:"abi" example:"[{anonymous: false, inputs: [{indexed: false, name: account, type: address}, name: MinterAdded, type: event}]}]"`
 	Bytecode         hexutil.Bytes           `json:"bytecode,omitempty" example:"0x6080604052348015600f57600080f..." swaggertype:"string"`
 	DeployedBytecode hexutil.Bytes           `json:"deployedBytecode,omitempty" example:"0x6080604052348015600f57600080f..." swaggertype:"string"`
+	LinkReferences   entities.LinkReferences `json:"linkReferences,omitempty"`
 	Constructor      entities.ABIComponent   `json:"constructor"`
 	Methods          []entities.ABIComponent `json:"methods"`
 	Events           []entities.ABIComponent `json:"events"`
@@ -1,6 +1,8 @@
 package api
 
 import (
+	"time"
+
 	"github.com/consensys/orchestrate/pkg/types/entities"
 	"github.com/consensys/orchestrate/pkg/utils"
 	ethcommon "github.com/ethereum/go-ethereum/common"
@@ -27,8 +29,12 @@ type DeployContractParams struct {
 	ContractName    string                        `json:"contractName" validate:"required" example:"MyContract"`
 	ContractTag     string                        `json:"contractTag,omitempty" example:"v1.1.0"`
 	Args            []interface{}                 `json:"args,omitempty"`
+	Libraries       map[string]ethcommon.Address  `json:"libraries,omitempty" swaggertype:"object,string" example:"SafeMath:0x1abae27a0cbfb02945720425d3b80c7e09728534"`
 	OneTimeKey      bool                          `json:"oneTimeKey,omitempty" example:"true"`
 	GasPricePolicy  GasPriceParams                `json:"gasPricePolicy,omitempty"`
+	NotBefore       *time.Time                    `json:"notBefore,omitempty" example:"2022-01-01T00:00:00Z"`
+	NotBeforeBlock  *uint64                       `json:"notBeforeBlock,omitempty" example:"1000"`
+	Simulate        bool                          `json:"simulate,omitempty" example:"true"`
 	Protocol        entities.PrivateTxManagerType `json:"protocol,omitempty" validate:"omitempty,isPrivateTxManagerType" example:"Tessera"`
 	PrivateFrom     string                        `json:"privateFrom,omitempty" validate:"omitempty,base64" example:"A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="`
 	PrivateFor      []string                      `json:"privateFor,omitempty" validate:"omitempty,min=1,unique,dive,base64" example:"[A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=,B1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=]"`
@@ -14,9 +14,11 @@ type CreateJobRequest struct {
 }
 
 type UpdateJobRequest struct {
-	Labels      map[string]string        `json:"labels,omitempty"`
-	Annotations *Annotations             `json:"annotations,omitempty"`
-	Transaction *entities.ETHTransaction `json:"transaction,omitempty"`
-	Status      entities.JobStatus       `json:"status,omitempty" validate:"isJobStatus" example:"MINED"`
-	Message     string                   `json:"message,omitempty" example:"Update message"`
+	Labels       map[string]string        `json:"labels,omitempty"`
+	Annotations  *Annotations             `json:"annotations,omitempty"`
+	Transaction  *entities.ETHTransaction `json:"transaction,omitempty"`
+	Status       entities.JobStatus       `json:"status,omitempty" validate:"isJobStatus" example:"MINED"`
+	Message      string                   `json:"message,omitempty" example:"Update message"`
+	RevertReason string                   `json:"revertReason,omitempty" example:"insufficient balance"` // Only set by internal services with the MINED status.
+	Events       []*entities.TxEvent      `json:"events,omitempty"`                                      // Only set by internal services with the MINED status.
 }
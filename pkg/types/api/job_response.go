@@ -6,6 +6,11 @@ import (
 	"github.com/consensys/orchestrate/pkg/types/entities"
 )
 
+const (
+	DefaultJobPageSize = entities.DefaultPageSize
+	MaxJobPageSize     = entities.MaxPageSize
+)
+
 type JobResponse struct {
 	UUID          string                  `json:"uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
 	ChainUUID     string                  `json:"chainUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
@@ -19,7 +24,14 @@ type JobResponse struct {
 	Labels        map[string]string       `json:"labels,omitempty"`
 	Annotations   Annotations             `json:"annotations,omitempty"`
 	Status        entities.JobStatus      `json:"status" example:"MINED"`
+	RevertReason  string                  `json:"revertReason,omitempty" example:"insufficient balance"` // Reason of the revert of a failed mined transaction.
+	Events        []*entities.TxEvent     `json:"events,omitempty"`                                      // Events emitted by the mined transaction.
 	Type          entities.JobType        `json:"type" example:"eth://ethereum/transaction"`
 	CreatedAt     time.Time               `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
 	UpdatedAt     time.Time               `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
 }
+
+type JobSearchResponse struct {
+	Jobs []*JobResponse `json:"jobs"`
+	Next string         `json:"next,omitempty" example:"MjAyMC0wNy0wOVQxMjozNTo0Mi4xMTUzOTVafGI0Mzc0ZTZm"` // Cursor of the next page, empty on the last page.
+}
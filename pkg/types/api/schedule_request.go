@@ -1,3 +1,69 @@
 package api
 
+import (
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/utils"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
 type CreateScheduleRequest struct{}
+
+// CreateScheduleGraphRequest is a schedule of contract transactions linked by dependencies (100 jobs max)
+type CreateScheduleGraphRequest struct {
+	ChainName string                     `json:"chain" validate:"required" example:"myChain"`
+	Labels    map[string]string          `json:"labels,omitempty"`
+	Jobs      []*ScheduleGraphJobRequest `json:"jobs" validate:"required,min=1,max=100,dive,required"`
+}
+
+type ScheduleGraphJobRequest struct {
+	ID        string                 `json:"id" validate:"required" example:"deployToken"`
+	DependsOn []string               `json:"dependsOn,omitempty" validate:"omitempty,unique" example:"[deployRegistry]"`
+	Params    ScheduleGraphJobParams `json:"params" validate:"required"`
+}
+
+// ScheduleGraphJobParams are the params of a contract deployment, when 'to' is empty, or of a contract transaction.
+// 'to' and 'args' can reference a receipt field of a job it depends on, such as ${deployRegistry.receipt.contractAddress}
+type ScheduleGraphJobParams struct {
+	Value           *hexutil.Big       `json:"value,omitempty" validate:"omitempty" example:"0x59682f00" swaggertype:"string"`
+	Gas             *uint64            `json:"gas,omitempty" example:"300000"`
+	GasPrice        *hexutil.Big       `json:"gasPrice,omitempty" validate:"omitempty" example:"0x5208" swaggertype:"string"`
+	GasFeeCap       *hexutil.Big       `json:"maxFeePerGas,omitempty" example:"0x4c4b40" swaggertype:"string"`
+	GasTipCap       *hexutil.Big       `json:"maxPriorityFeePerGas,omitempty" example:"0x59682f00" swaggertype:"string"`
+	AccessList      types.AccessList   `json:"accessList,omitempty" swaggertype:"array,object"`
+	TransactionType string             `json:"transactionType,omitempty" validate:"omitempty,isTransactionType" example:"dynamic_fee" enums:"legacy,dynamic_fee"`
+	From            *ethcommon.Address `json:"from" validate:"required" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"string"`
+	To              string             `json:"to,omitempty" example:"${deployRegistry.receipt.contractAddress}"`
+	MethodSignature string             `json:"methodSignature,omitempty" example:"register(address)"`
+	Args            []interface{}      `json:"args,omitempty"`
+	ContractName    string             `json:"contractName" validate:"required" example:"MyContract"`
+	ContractTag     string             `json:"contractTag,omitempty" example:"v1.1.0"`
+	GasPricePolicy  GasPriceParams     `json:"gasPricePolicy,omitempty"`
+}
+
+func (req *CreateScheduleGraphRequest) Validate() error {
+	for _, job := range req.Jobs {
+		if err := job.Params.Validate(); err != nil {
+			return errors.InvalidParameterError("job %s: %s", job.ID, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (params *ScheduleGraphJobParams) Validate() error {
+	if err := utils.GetValidator().Struct(params); err != nil {
+		return err
+	}
+
+	if params.To != "" && params.MethodSignature == "" {
+		return errors.InvalidParameterError("field 'methodSignature' is required when 'to' is set")
+	}
+
+	if params.To == "" && params.MethodSignature != "" {
+		return errors.InvalidParameterError("field 'to' is required when 'methodSignature' is set")
+	}
+
+	return params.GasPricePolicy.RetryPolicy.Validate()
+}
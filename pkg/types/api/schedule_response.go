@@ -1,6 +1,15 @@
 package api
 
-import "time"
+import (
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/types/entities"
+)
+
+const (
+	DefaultSchedulePageSize = entities.DefaultPageSize
+	MaxSchedulePageSize     = entities.MaxPageSize
+)
 
 type ScheduleResponse struct {
 	UUID      string         `json:"uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
@@ -9,3 +18,8 @@ type ScheduleResponse struct {
 	Jobs      []*JobResponse `json:"jobs"`
 	CreatedAt time.Time      `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
 }
+
+type ScheduleSearchResponse struct {
+	Schedules []*ScheduleResponse `json:"schedules"`
+	Next      string              `json:"next,omitempty" example:"MjAyMC0wNy0wOVQxMjozNTo0Mi4xMTUzOTVafGI0Mzc0ZTZm"` // Cursor of the next page, empty on the last page.
+}
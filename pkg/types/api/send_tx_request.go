@@ -1,6 +1,8 @@
 package api
 
 import (
+	"time"
+
 	"github.com/consensys/orchestrate/pkg/types/entities"
 	"github.com/consensys/orchestrate/pkg/utils"
 	ethcommon "github.com/ethereum/go-ethereum/common"
@@ -31,6 +33,9 @@ type TransactionParams struct {
 	Args            []interface{}                 `json:"args,omitempty"`
 	OneTimeKey      bool                          `json:"oneTimeKey,omitempty" example:"true"`
 	GasPricePolicy  GasPriceParams                `json:"gasPricePolicy,omitempty"`
+	NotBefore       *time.Time                    `json:"notBefore,omitempty" example:"2022-01-01T00:00:00Z"`
+	NotBeforeBlock  *uint64                       `json:"notBeforeBlock,omitempty" example:"1000"`
+	Simulate        bool                          `json:"simulate,omitempty" example:"true"`
 	Protocol        entities.PrivateTxManagerType `json:"protocol,omitempty" validate:"omitempty,isPrivateTxManagerType" example:"Tessera"`
 	PrivateFrom     string                        `json:"privateFrom,omitempty" validate:"omitempty,base64" example:"A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="`
 	PrivateFor      []string                      `json:"privateFor,omitempty" validate:"omitempty,min=1,unique,dive,base64" example:"[A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=,B1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=]"`
@@ -0,0 +1,25 @@
+package api
+
+import (
+	"github.com/consensys/orchestrate/pkg/errors"
+)
+
+// SendTransactionsRequest is a batch of contract transactions created atomically (1000 transactions max)
+type SendTransactionsRequest struct {
+	Transactions []*SendTransactionsItem `json:"transactions" validate:"required,min=1,max=1000,dive,required"`
+}
+
+type SendTransactionsItem struct {
+	IdempotencyKey string `json:"idempotencyKey,omitempty" example:"myIdempotencyKey"`
+	SendTransactionRequest
+}
+
+func (req *SendTransactionsRequest) Validate() error {
+	for idx, item := range req.Transactions {
+		if err := item.Params.Validate(); err != nil {
+			return errors.InvalidParameterError("transaction %d: %s", idx, err.Error())
+		}
+	}
+
+	return nil
+}
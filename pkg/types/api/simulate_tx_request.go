@@ -0,0 +1,35 @@
+package api
+
+import (
+	"github.com/consensys/orchestrate/pkg/utils"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type SimulateTransactionRequest struct {
+	ChainName string                    `json:"chain" validate:"required" example:"myChain"`
+	Params    SimulateTransactionParams `json:"params" validate:"required"`
+}
+
+type SimulateTransactionParams struct {
+	Value           *hexutil.Big       `json:"value,omitempty" validate:"omitempty" example:"0x44300E0" swaggertype:"string"`
+	Gas             *uint64            `json:"gas,omitempty" example:"50000"`
+	GasPrice        *hexutil.Big       `json:"gasPrice,omitempty" validate:"omitempty" example:"0xAB208" swaggertype:"string"`
+	From            *ethcommon.Address `json:"from,omitempty" validate:"omitempty" example:"0x1abae27a0cbfb02945720425d3b80c7e097285534" swaggertype:"string"`
+	To              *ethcommon.Address `json:"to" validate:"required" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"string"`
+	MethodSignature string             `json:"methodSignature" validate:"required" example:"transfer(address,uint256)"`
+	Args            []interface{}      `json:"args,omitempty"`
+	ContractName    string             `json:"contractName" validate:"required" example:"MyContract"`
+	ContractTag     string             `json:"contractTag,omitempty" example:"v1.1.0"`
+}
+
+func (params *SimulateTransactionParams) Validate() error {
+	return utils.GetValidator().Struct(params)
+}
+
+type SimulateTransactionResponse struct {
+	Reverted     bool          `json:"reverted" example:"true"`
+	Output       hexutil.Bytes `json:"output,omitempty" example:"0x0000000000000000000000000000000000000000000000000000000000000001" swaggertype:"string"` // Data returned by the call if it did not revert.
+	RevertReason string        `json:"revertReason,omitempty" example:"InsufficientBalance(available: 10, required: 20)"`                                   // Reason decoded from `Error(string)`, `Panic(uint256)` or a custom error declared in the contract ABI.
+	RevertData   hexutil.Bytes `json:"revertData,omitempty" example:"0xcf479181" swaggertype:"string"`                                                      // Raw data returned by the reverted call.
+}
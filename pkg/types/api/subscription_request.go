@@ -0,0 +1,27 @@
+package api
+
+import (
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+type CreateSubscriptionRequest struct {
+	ChainUUID       string                     `json:"chainUUID" validate:"required,uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`                             // UUID of the chain to listen to.
+	ContractAddress ethcommon.Address          `json:"contractAddress" validate:"required" example:"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18" swaggertype:"string"` // Address of the contract emitting the event.
+	EventSignature  string                     `json:"eventSignature" validate:"required" example:"Transfer(address,address,uint256)"`                                // Signature of the event.
+	TopicFilters    [][]ethcommon.Hash         `json:"topicFilters,omitempty" validate:"omitempty,max=3" swaggertype:"array,string"`                                  // Accepted values of each indexed argument, an empty list accepts any value.
+	Target          *SubscriptionTargetRequest `json:"target" validate:"required"`                                                                                    // Destination of the events.
+}
+
+type UpdateSubscriptionRequest struct {
+	TopicFilters [][]ethcommon.Hash         `json:"topicFilters,omitempty" validate:"omitempty,max=3" swaggertype:"array,string"`
+	Target       *SubscriptionTargetRequest `json:"target,omitempty"`
+}
+
+type SubscriptionTargetRequest struct {
+	Type    entities.SubscriptionTargetType `json:"type" validate:"required,isSubscriptionTargetType" example:"Webhook"`                           // Currently supports `Kafka` and `Webhook`.
+	Topic   string                          `json:"topic,omitempty" validate:"required_if=Type Kafka" example:"topic-events"`                      // `Kafka` only. Topic the events are produced to.
+	URL     string                          `json:"url,omitempty" validate:"required_if=Type Webhook,omitempty,url" example:"https://example.com"` // `Webhook` only. URL the events are posted to.
+	Secret  string                          `json:"secret,omitempty" example:"my-secret"`                                                          // `Webhook` only. Key used to sign the events with HMAC-SHA256, sent in the `X-Orchestrate-Signature` header.
+	Headers map[string]string               `json:"headers,omitempty"`                                                                             // `Webhook` only. HTTP headers attached to every request.
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type SubscriptionResponse struct {
+	UUID            string                       `json:"uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`                                       // UUID of the subscription.
+	ChainUUID       string                       `json:"chainUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`                                  // UUID of the chain listened to.
+	ContractAddress ethcommon.Address            `json:"contractAddress" example:"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18" swaggertype:"string"` // Address of the contract emitting the event.
+	EventSignature  string                       `json:"eventSignature" example:"Transfer(address,address,uint256)"`                                // Signature of the event.
+	TopicFilters    [][]ethcommon.Hash           `json:"topicFilters,omitempty" swaggertype:"array,string"`                                         // Accepted values of each indexed argument.
+	Target          *entities.SubscriptionTarget `json:"target"`                                                                                    // Destination of the events.
+	TenantID        string                       `json:"tenantID" example:"tenant"`                                                                 // ID of the tenant executing the API.
+	OwnerID         string                       `json:"ownerID,omitempty" example:"foo"`                                                           // ID of the subscription owner.
+	CreatedAt       time.Time                    `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`                                           // Date and time at which the subscription was created.
+	UpdatedAt       time.Time                    `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`                                           // Date and time at which the subscription was updated.
+}
+
+// SubscriptionEvent is the message delivered to the target of a subscription for every matching log
+type SubscriptionEvent struct {
+	SubscriptionUUID string            `json:"subscriptionUUID"`
+	ChainUUID        string            `json:"chainUUID"`
+	Event            string            `json:"event,omitempty"`
+	DecodedData      map[string]string `json:"decodedData,omitempty"`
+	Address          ethcommon.Address `json:"address"`
+	Topics           []ethcommon.Hash  `json:"topics"`
+	Data             hexutil.Bytes     `json:"data"`
+	BlockNumber      uint64            `json:"blockNumber"`
+	BlockHash        ethcommon.Hash    `json:"blockHash"`
+	TxHash           ethcommon.Hash    `json:"txHash"`
+	TxIndex          uint              `json:"txIndex"`
+	LogIndex         uint              `json:"logIndex"`
+}
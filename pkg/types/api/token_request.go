@@ -0,0 +1,45 @@
+package api
+
+import (
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/utils"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+type TokenTxRequest struct {
+	ChainName string            `json:"chain" validate:"required" example:"myChain"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	Params    TokenTxParams     `json:"params" validate:"required"`
+}
+
+type TokenTxParams struct {
+	Token           ethcommon.Address `json:"token" validate:"required" example:"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18" swaggertype:"string"` // Address of the token contract.
+	From            ethcommon.Address `json:"from" validate:"required" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"string"`  // Account sending the transaction, holder of the transferred tokens.
+	To              ethcommon.Address `json:"to" validate:"required" example:"0x2abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"string"`    // Recipient of `transfer` and `mint`, spender or operator of `approve`.
+	Amount          *hexutil.Big      `json:"amount,omitempty" example:"0x3e8" swaggertype:"string"`                                               // ERC-20 and ERC-1155 only. Amount of tokens.
+	TokenID         *hexutil.Big      `json:"tokenId,omitempty" example:"0x1" swaggertype:"string"`                                                // ERC-721 and ERC-1155 only. Identifier of the token.
+	Approved        *bool             `json:"approved,omitempty" example:"true"`                                                                   // ERC-1155 `approve` only. Grants (default) or revokes the operator.
+	Data            hexutil.Bytes     `json:"data,omitempty" example:"0x" swaggertype:"string"`                                                    // ERC-1155 only. Data passed to the receiver of the tokens.
+	Nonce           *uint64           `json:"nonce,omitempty" example:"1"`
+	Gas             *uint64           `json:"gas,omitempty" example:"300000"`
+	GasPrice        *hexutil.Big      `json:"gasPrice,omitempty" validate:"omitempty" example:"0x5208" swaggertype:"string"`
+	GasFeeCap       *hexutil.Big      `json:"maxFeePerGas,omitempty" example:"0x4c4b40" swaggertype:"string"`
+	GasTipCap       *hexutil.Big      `json:"maxPriorityFeePerGas,omitempty" example:"0x59682f00" swaggertype:"string"`
+	AccessList      types.AccessList  `json:"accessList,omitempty" swaggertype:"array,object"`
+	TransactionType string            `json:"transactionType,omitempty" validate:"omitempty,isTransactionType" example:"dynamic_fee" enums:"legacy,dynamic_fee"`
+	GasPricePolicy  GasPriceParams    `json:"gasPricePolicy,omitempty"`
+	NotBefore       *time.Time        `json:"notBefore,omitempty" example:"2022-01-01T00:00:00Z"`
+	NotBeforeBlock  *uint64           `json:"notBeforeBlock,omitempty" example:"1000"`
+	Simulate        bool              `json:"simulate,omitempty" example:"true"`
+}
+
+func (params *TokenTxParams) Validate() error {
+	if err := utils.GetValidator().Struct(params); err != nil {
+		return err
+	}
+
+	return params.GasPricePolicy.RetryPolicy.Validate()
+}
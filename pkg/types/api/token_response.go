@@ -0,0 +1,37 @@
+package api
+
+import (
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type TokenBalanceResponse struct {
+	Balance *hexutil.Big `json:"balance" example:"0x3e8" swaggertype:"string"` // Amount of tokens held by the account.
+}
+
+type TokenAllowanceResponse struct {
+	Allowance *hexutil.Big `json:"allowance" example:"0x3e8" swaggertype:"string"` // Amount of tokens the spender is allowed to transfer.
+}
+
+type TokenOwnerResponse struct {
+	Owner ethcommon.Address `json:"owner" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"string"` // Owner of the token.
+}
+
+// TokenTransferMessage is the message produced by the tx-listener for every token transfer of the mined transactions
+type TokenTransferMessage struct {
+	ChainUUID   string                 `json:"chainUUID"`
+	ChainName   string                 `json:"chain"`
+	Standard    entities.TokenStandard `json:"standard"`
+	Token       ethcommon.Address      `json:"token"`
+	Operator    *ethcommon.Address     `json:"operator,omitempty"`
+	From        ethcommon.Address      `json:"from"`
+	To          ethcommon.Address      `json:"to"`
+	TokenID     *hexutil.Big           `json:"tokenId,omitempty"`
+	Amount      *hexutil.Big           `json:"amount"`
+	JobUUID     string                 `json:"jobUUID,omitempty"`
+	BlockNumber uint64                 `json:"blockNumber"`
+	BlockHash   ethcommon.Hash         `json:"blockHash"`
+	TxHash      ethcommon.Hash         `json:"txHash"`
+	LogIndex    uint64                 `json:"logIndex"`
+}
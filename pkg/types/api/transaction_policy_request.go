@@ -0,0 +1,28 @@
+package api
+
+import (
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type CreateTransactionPolicyRequest struct {
+	Name             string                         `json:"name" validate:"required" example:"daily-cap"`                                                                                            // Name of the policy.
+	Type             entities.TransactionPolicyType `json:"type" validate:"required,isTransactionPolicyType" example:"DailyValueCap"`                                                                // One of `DailyValueCap`, `AllowedRecipients`, `DeniedRecipients`, `AllowedMethods` and `MaxGasPrice`.
+	ChainUUID        string                         `json:"chainUUID,omitempty" validate:"omitempty,uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`                                            // Only applies to the jobs of the chain.
+	Account          *ethcommon.Address             `json:"account,omitempty" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"string"`                                             // Only applies to the jobs sent by the account, otherwise applies to every account of the tenant.
+	MaxDailyValue    *hexutil.Big                   `json:"maxDailyValue,omitempty" validate:"required_if=Type DailyValueCap" example:"0xde0b6b3a7640000" swaggertype:"string"`                      // `DailyValueCap` only. Amount of ether, in Wei, that can be transferred per day (UTC).
+	Addresses        []ethcommon.Address            `json:"addresses,omitempty" example:"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18" swaggertype:"array,string"`                                     // `AllowedRecipients` and `DeniedRecipients` only. Recipients allowed or denied.
+	Contract         *ethcommon.Address             `json:"contract,omitempty" validate:"required_if=Type AllowedMethods" example:"0x6230592812dE2E256D1512504c3E8A3C49975f07" swaggertype:"string"` // `AllowedMethods` only. Contract whose methods are restricted.
+	MethodSignatures []string                       `json:"methodSignatures,omitempty" validate:"required_if=Type AllowedMethods,omitempty,dive,required" example:"transfer(address,uint256)"`       // `AllowedMethods` only. Methods that can be called on the contract.
+	MaxGasPrice      *hexutil.Big                   `json:"maxGasPrice,omitempty" validate:"required_if=Type MaxGasPrice" example:"0x174876e800" swaggertype:"string"`                               // `MaxGasPrice` only. Highest gas price, or max fee per gas, of the jobs.
+}
+
+func (req *CreateTransactionPolicyRequest) Validate() error {
+	if (req.Type == entities.AllowedRecipientsPolicyType || req.Type == entities.DeniedRecipientsPolicyType) && len(req.Addresses) == 0 {
+		return errors.InvalidParameterError("field 'addresses' is required for %s policies", req.Type)
+	}
+
+	return nil
+}
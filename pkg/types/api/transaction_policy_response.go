@@ -0,0 +1,26 @@
+package api
+
+import (
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type TransactionPolicyResponse struct {
+	UUID             string                         `json:"uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`                                                 // UUID of the policy.
+	Name             string                         `json:"name" example:"daily-cap"`                                                                            // Name of the policy.
+	Type             entities.TransactionPolicyType `json:"type" example:"DailyValueCap"`                                                                        // Type of the policy.
+	ChainUUID        string                         `json:"chainUUID,omitempty" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`                                  // Only applies to the jobs of the chain.
+	Account          *ethcommon.Address             `json:"account,omitempty" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"string"`         // Only applies to the jobs sent by the account.
+	MaxDailyValue    *hexutil.Big                   `json:"maxDailyValue,omitempty" example:"0xde0b6b3a7640000" swaggertype:"string"`                            // `DailyValueCap` only. Amount of ether, in Wei, that can be transferred per day (UTC).
+	Addresses        []ethcommon.Address            `json:"addresses,omitempty" example:"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18" swaggertype:"array,string"` // `AllowedRecipients` and `DeniedRecipients` only. Recipients allowed or denied.
+	Contract         *ethcommon.Address             `json:"contract,omitempty" example:"0x6230592812dE2E256D1512504c3E8A3C49975f07" swaggertype:"string"`        // `AllowedMethods` only. Contract whose methods are restricted.
+	MethodSignatures []string                       `json:"methodSignatures,omitempty" example:"transfer(address,uint256)"`                                      // `AllowedMethods` only. Methods that can be called on the contract.
+	MaxGasPrice      *hexutil.Big                   `json:"maxGasPrice,omitempty" example:"0x174876e800" swaggertype:"string"`                                   // `MaxGasPrice` only. Highest gas price, or max fee per gas, of the jobs.
+	TenantID         string                         `json:"tenantID" example:"tenant"`                                                                           // ID of the tenant executing the API.
+	OwnerID          string                         `json:"ownerID,omitempty" example:"foo"`                                                                     // ID of the policy owner.
+	CreatedAt        time.Time                      `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`                                                     // Date and time at which the policy was created.
+	UpdatedAt        time.Time                      `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`                                                     // Date and time at which the policy was updated.
+}
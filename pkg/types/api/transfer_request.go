@@ -1,6 +1,8 @@
 package api
 
 import (
+	"time"
+
 	"github.com/consensys/orchestrate/pkg/utils"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -25,6 +27,9 @@ type TransferParams struct {
 	From            ethcommon.Address `json:"from" validate:"required" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"string"`
 	To              ethcommon.Address `json:"to" validate:"required" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"string"`
 	GasPricePolicy  GasPriceParams    `json:"gasPricePolicy,omitempty"`
+	NotBefore       *time.Time        `json:"notBefore,omitempty" example:"2022-01-01T00:00:00Z"`
+	NotBeforeBlock  *uint64           `json:"notBeforeBlock,omitempty" example:"1000"`
+	Simulate        bool              `json:"simulate,omitempty" example:"true"`
 }
 
 func (params *TransferParams) Validate() error {
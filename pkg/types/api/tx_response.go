@@ -21,3 +21,16 @@ type TransactionSearchResponse struct {
 	Transactions []*TransactionResponse `json:"transactions"`
 	HasMore      bool                   `json:"hasMore"`
 }
+
+// SendTransactionsResponse holds the result of every transaction of a batch, in the order of the request
+type SendTransactionsResponse struct {
+	Transactions []*SendTransactionsItemResponse `json:"transactions"`
+}
+
+// SendTransactionsItemResponse is the result of one transaction of a batch, either the transaction or the error preventing its creation
+type SendTransactionsItemResponse struct {
+	IdempotencyKey string               `json:"idempotencyKey,omitempty" example:"myIdempotencyKey"`
+	Transaction    *TransactionResponse `json:"transaction,omitempty"`
+	Error          string               `json:"error,omitempty" example:"transaction request with the same idempotency key and different params already exists"`
+	Code           uint64               `json:"code,omitempty" example:"24000"`
+}
@@ -16,6 +16,7 @@ type Account struct {
 	OwnerID             string
 	StoreID             string
 	Attributes          map[string]string
+	SmartAccount        *SmartAccount
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
 }
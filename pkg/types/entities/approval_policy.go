@@ -0,0 +1,123 @@
+package entities
+
+import (
+	"bytes"
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ApprovalPolicy requires the jobs of a tenant matching all its conditions to be approved by `Threshold` of its
+// `Approvers` before being sent. Unset conditions match any job
+type ApprovalPolicy struct {
+	UUID            string
+	Name            string
+	ChainUUID       string
+	MinValue        *hexutil.Big
+	To              *ethcommon.Address
+	MethodSignature string
+	Threshold       int
+	Approvers       []string
+	TenantID        string
+	OwnerID         string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// ApprovalRequirement is the snapshot of an approval policy matched by a job, so that updating or deleting the policy
+// does not change the approvals expected by the jobs already awaiting approval
+type ApprovalRequirement struct {
+	PolicyUUID string   `json:"policyUUID"`
+	PolicyName string   `json:"policyName,omitempty"`
+	Threshold  int      `json:"threshold"`
+	Approvers  []string `json:"approvers"`
+}
+
+// Matches indicates whether a job satisfies all the conditions of the policy
+func (p *ApprovalPolicy) Matches(job *Job) bool {
+	if p.ChainUUID != "" && p.ChainUUID != job.ChainUUID {
+		return false
+	}
+
+	tx := job.Transaction
+	if tx == nil {
+		return false
+	}
+
+	if p.MinValue != nil && (tx.Value == nil || tx.Value.ToInt().Cmp(p.MinValue.ToInt()) < 0) {
+		return false
+	}
+
+	if p.To != nil && (tx.To == nil || *tx.To != *p.To) {
+		return false
+	}
+
+	if p.MethodSignature != "" {
+		selector := crypto.Keccak256([]byte(p.MethodSignature))[:4]
+		if len(tx.Data) < 4 || !bytes.Equal(tx.Data[:4], selector) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Requirement returns the approval requirement of the policy
+func (p *ApprovalPolicy) Requirement() *ApprovalRequirement {
+	return &ApprovalRequirement{
+		PolicyUUID: p.UUID,
+		PolicyName: p.Name,
+		Threshold:  p.Threshold,
+		Approvers:  p.Approvers,
+	}
+}
+
+// IsApprover indicates whether a user is one of the approvers of the requirement
+func (r *ApprovalRequirement) IsApprover(username string) bool {
+	for _, approver := range r.Approvers {
+		if approver == username {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsSatisfied indicates whether enough approvers of the requirement approved the job
+func (r *ApprovalRequirement) IsSatisfied(approvals []string) bool {
+	count := 0
+	for _, username := range approvals {
+		if r.IsApprover(username) {
+			count++
+		}
+	}
+
+	return count >= r.Threshold
+}
+
+// IsApproved indicates whether all the approval requirements of a job are satisfied
+func IsApproved(job *Job) bool {
+	if job.InternalData == nil {
+		return true
+	}
+
+	for _, requirement := range job.InternalData.ApprovalRequirements {
+		if !requirement.IsSatisfied(job.InternalData.Approvals) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsApprovable indicates whether approval policies apply to a job. Children jobs, which can only resend the transaction of
+// their parent, and marking transactions are sent on behalf of a parent job that was already approved
+func IsApprovable(job *Job) bool {
+	if job.Type == EEAMarkingTransaction || job.Type == TesseraMarkingTransaction {
+		return false
+	}
+
+	return job.InternalData == nil || job.InternalData.ParentJobUUID == "" || job.InternalData.ParentJobUUID == job.UUID
+}
@@ -18,6 +18,8 @@ type Chain struct {
 	ListenerBackOffDuration   string
 	ListenerExternalTxEnabled *bool
 	PrivateTxManager          *PrivateTxManager
+	GasOracle                 *GasOracle
+	BundlerURL                string
 	Headers                   map[string]string
 	Labels                    map[string]string
 	CreatedAt                 time.Time
@@ -2,8 +2,10 @@ package entities
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
@@ -18,9 +20,20 @@ type Contract struct {
 	RawABI           string
 	Bytecode         hexutil.Bytes
 	DeployedBytecode hexutil.Bytes
-	Constructor      ABIComponent
-	Methods          []ABIComponent
-	Events           []ABIComponent
+	// Positions of the unlinked libraries in the bytecode, zeroed until linked at deployment
+	LinkReferences LinkReferences
+	Constructor    ABIComponent
+	Methods        []ABIComponent
+	Events         []ABIComponent
+}
+
+// LinkReferences are the positions of the library addresses in a bytecode indexed by source file and library name, as
+// output by the Solidity compiler
+type LinkReferences map[string]map[string][]BytecodeOffset
+
+type BytecodeOffset struct {
+	Start  int `json:"start" example:"212"`
+	Length int `json:"length" example:"20"`
 }
 
 type ABIComponent struct {
@@ -43,6 +56,22 @@ type RawABI struct {
 	Outputs   []Arguments
 }
 
+// ContractCall is a read-only call of a contract method, executed by a node but never mined
+type ContractCall struct {
+	ContractName string
+	ContractTag  string
+	// ABI used instead of the contract registry when set
+	RawABI          string
+	From            *ethcommon.Address
+	To              ethcommon.Address
+	MethodSignature string
+	Args            []interface{}
+	// Block at which the call is executed, latest block if nil
+	BlockNumber *big.Int
+	// Private state the call is executed against (EEA)
+	PrivacyGroupID string
+}
+
 func (c *Contract) String() string {
 	tag := DefaultTagValue
 	if c.Tag != "" {
@@ -0,0 +1,51 @@
+package entities
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	SortByCreatedAt = "created_at"
+	SortByUpdatedAt = "updated_at"
+	SortOrderAsc    = "asc"
+	SortOrderDesc   = "desc"
+
+	// DefaultPageSize is the number of items of a page when no limit is given
+	DefaultPageSize = 100
+	// MaxPageSize is the maximum number of items of a page
+	MaxPageSize = 1000
+)
+
+// Cursor is the position of an item in a list sorted by date, the UUID breaking the ties
+type Cursor struct {
+	Time time.Time
+	UUID string
+}
+
+// String encodes the cursor as an opaque string
+func (c *Cursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(c.Time.UTC().Format(time.RFC3339Nano) + "|" + c.UUID))
+}
+
+// ParseCursor decodes a cursor encoded by Cursor.String
+func ParseCursor(cursor string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor encoding")
+	}
+
+	parts := strings.SplitN(string(b), "|", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, fmt.Errorf("invalid cursor format")
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor time")
+	}
+
+	return &Cursor{Time: t, UUID: parts[1]}, nil
+}
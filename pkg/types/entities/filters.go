@@ -3,13 +3,26 @@ package entities
 import "time"
 
 type JobFilters struct {
-	TxHashes      []string  `validate:"omitempty,unique,dive,isHash"`
-	ChainUUID     string    `validate:"omitempty,uuid"`
-	Status        JobStatus `validate:"omitempty,isJobStatus"`
-	UpdatedAfter  time.Time `validate:"omitempty"`
-	ParentJobUUID string    `validate:"omitempty"`
-	OnlyParents   bool      `validate:"omitempty"`
-	WithLogs      bool      `validate:"omitempty"`
+	TxHashes      []string          `validate:"omitempty,unique,dive,isHash"`
+	ChainUUID     string            `validate:"omitempty,uuid"`
+	Status        JobStatus         `validate:"omitempty,isJobStatus"`
+	Type          JobType           `validate:"omitempty,isJobType"`
+	Labels        map[string]string `validate:"omitempty"`
+	From          string            `validate:"omitempty,isHexAddress"`
+	To            string            `validate:"omitempty,isHexAddress"`
+	UpdatedAfter  time.Time         `validate:"omitempty"`
+	CreatedAfter  time.Time         `validate:"omitempty"`
+	CreatedBefore time.Time         `validate:"omitempty"`
+	ParentJobUUID string            `validate:"omitempty"`
+	OnlyParents   bool              `validate:"omitempty"`
+	WithLogs      bool              `validate:"omitempty"`
+	Pagination    CursorPagination  `validate:"omitempty"`
+}
+
+type ScheduleFilters struct {
+	CreatedAfter  time.Time        `validate:"omitempty"`
+	CreatedBefore time.Time        `validate:"omitempty"`
+	Pagination    CursorPagination `validate:"omitempty"`
 }
 
 type PaginationFilters struct {
@@ -17,6 +30,14 @@ type PaginationFilters struct {
 	Page  int `validate:"omitempty"`
 }
 
+// CursorPagination is a keyset pagination, the cursor being the position of the last item of the previous page
+type CursorPagination struct {
+	Limit     int    `validate:"omitempty,min=0,max=1000"`
+	Cursor    string `validate:"omitempty"`
+	SortBy    string `validate:"omitempty,oneof=created_at updated_at"`
+	SortOrder string `validate:"omitempty,oneof=asc desc"`
+}
+
 type TransactionRequestFilters struct {
 	IdempotencyKeys []string          `validate:"omitempty,unique"`
 	Pagination      PaginationFilters `validate:"omitempty"`
@@ -38,3 +59,20 @@ type ChainFilters struct {
 	Names    []string `validate:"omitempty,unique"`
 	TenantID string   `validate:"omitempty"`
 }
+
+type SubscriptionFilters struct {
+	ChainUUID       string `validate:"omitempty,uuid"`
+	ContractAddress string `validate:"omitempty,isHexAddress"`
+	TenantID        string `validate:"omitempty"`
+}
+
+type ApprovalPolicyFilters struct {
+	ChainUUID string `validate:"omitempty,uuid"`
+	TenantID  string `validate:"omitempty"`
+}
+
+type TransactionPolicyFilters struct {
+	Type      TransactionPolicyType `validate:"omitempty,isTransactionPolicyType"`
+	ChainUUID string                `validate:"omitempty,uuid"`
+	TenantID  string                `validate:"omitempty"`
+}
@@ -0,0 +1,21 @@
+package entities
+
+import "github.com/ethereum/go-ethereum/common/hexutil"
+
+type GasOracleType string
+
+const (
+	DefaultGasOracleType    GasOracleType = "Default"
+	FeeHistoryGasOracleType GasOracleType = "FeeHistory"
+	FixedGasOracleType      GasOracleType = "Fixed"
+	HTTPGasOracleType       GasOracleType = "HTTP"
+)
+
+type GasOracle struct {
+	Type       GasOracleType `json:"type"`                                                // Strategy used to compute gas fees. One of `Default`, `FeeHistory`, `Fixed` and `HTTP`.
+	Blocks     uint64        `json:"blocks,omitempty"`                                    // `FeeHistory` only. Number of blocks of fee history.
+	Percentile float64       `json:"percentile,omitempty"`                                // `FeeHistory` only. Percentile of priority fees used, defaults to a percentile per priority.
+	GasPrice   *hexutil.Big  `json:"gasPrice,omitempty" swaggertype:"string"`             // `Fixed` only. Gas price of legacy transactions.
+	GasTipCap  *hexutil.Big  `json:"maxPriorityFeePerGas,omitempty" swaggertype:"string"` // `Fixed` only. Priority fee of dynamic fee transactions, defaults to gasPrice.
+	URL        string        `json:"url,omitempty"`                                       // `HTTP` only. URL of the gas oracle.
+}
@@ -6,14 +6,24 @@ import (
 )
 
 type InternalData struct {
-	OneTimeKey        bool          `json:"oneTimeKey,omitempty"`
-	HasBeenRetried    bool          `json:"hasBeenRetried,omitempty"`
-	ChainID           *big.Int      `json:"chainID"`
-	Priority          string        `json:"priority"`
-	ParentJobUUID     string        `json:"parentJobUUID,omitempty"`
-	GasPriceIncrement float64       `json:"gasPriceIncrement,omitempty"`
-	GasPriceLimit     float64       `json:"gasPriceLimit,omitempty"`
-	RetryInterval     time.Duration `json:"retryInterval"`
-	ExpectedNonce     string        `json:"expectedNonce,omitempty"` // Using string because 0 is a valid
-	StoreID           string        `json:"storeID,omitempty"`
+	OneTimeKey           bool                   `json:"oneTimeKey,omitempty"`
+	HasBeenRetried       bool                   `json:"hasBeenRetried,omitempty"`
+	ChainID              *big.Int               `json:"chainID"`
+	Priority             string                 `json:"priority"`
+	ParentJobUUID        string                 `json:"parentJobUUID,omitempty"`
+	GasPriceIncrement    float64                `json:"gasPriceIncrement,omitempty"`
+	GasPriceLimit        float64                `json:"gasPriceLimit,omitempty"`
+	RetryInterval        time.Duration          `json:"retryInterval"`
+	ExpectedNonce        string                 `json:"expectedNonce,omitempty"` // Using string because 0 is a valid
+	StoreID              string                 `json:"storeID,omitempty"`
+	NotBefore            *time.Time             `json:"notBefore,omitempty"`
+	NotBeforeBlock       *uint64                `json:"notBeforeBlock,omitempty"`
+	GraphJobID           string                 `json:"graphJobID,omitempty"`
+	DependsOn            []string               `json:"dependsOn,omitempty"`
+	TxTemplate           *TxTemplate            `json:"txTemplate,omitempty"`
+	SmartAccount         *SmartAccount          `json:"smartAccount,omitempty"`
+	ApprovalRequirements []*ApprovalRequirement `json:"approvalRequirements,omitempty"`
+	Approvals            []string               `json:"approvals,omitempty"` // Usernames of the users who approved the job
+	Simulate             bool                   `json:"simulate,omitempty"`  // Executes the transaction with eth_call before sending it
+	Faucet               bool                   `json:"faucet,omitempty"`    // Set by the API on the jobs crediting accounts from a faucet
 }
@@ -29,6 +29,10 @@ const (
 	StatusFailed     JobStatus = "FAILED"
 	StatusMined      JobStatus = "MINED"
 	StatusNeverMined JobStatus = "NEVER_MINED"
+	StatusReorged    JobStatus = "REORGED"
+	StatusScheduled  JobStatus = "SCHEDULED"
+	// StatusAwaitingApproval is the status of jobs waiting for the approvals required by the approval policies of the tenant
+	StatusAwaitingApproval JobStatus = "AWAITING_APPROVAL"
 )
 
 type Job struct {
@@ -44,6 +48,8 @@ type Job struct {
 	InternalData *InternalData
 	Transaction  *ETHTransaction
 	Receipt      *ethereum.Receipt
+	RevertReason string
+	Events       []*TxEvent
 	Logs         []*Log
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
@@ -55,3 +61,22 @@ func IsFinalJobStatus(status JobStatus) bool {
 		status == StatusStored ||
 		status == StatusNeverMined
 }
+
+// IsScheduledJob indicates whether a job must wait for its execution conditions (notBefore, notBeforeBlock) instead of
+// being started right away. Block conditions always wait as they can only be evaluated against the chain.
+// Children jobs are never scheduled as their parent job already met the conditions
+func IsScheduledJob(job *Job) bool {
+	if job.InternalData == nil {
+		return false
+	}
+
+	if job.InternalData.ParentJobUUID != "" && job.InternalData.ParentJobUUID != job.UUID {
+		return false
+	}
+
+	if job.InternalData.NotBeforeBlock != nil {
+		return true
+	}
+
+	return job.InternalData.NotBefore != nil && time.Now().Before(*job.InternalData.NotBefore)
+}
@@ -0,0 +1,38 @@
+package entities
+
+import ethcommon "github.com/ethereum/go-ethereum/common"
+
+// Fields of a mined job receipt that can be referenced by the jobs depending on it
+const (
+	ReceiptContractAddress = "contractAddress"
+	ReceiptTxHash          = "txHash"
+	ReceiptBlockHash       = "blockHash"
+	ReceiptBlockNumber     = "blockNumber"
+)
+
+// ScheduleGraph is a schedule submitted as a directed acyclic graph of jobs
+type ScheduleGraph struct {
+	ChainName string
+	Labels    map[string]string
+	Jobs      []*GraphJob
+}
+
+// GraphJob is a job of a schedule graph, started once every job it depends on is mined.
+// To is either an address, a reference to a receipt field of a parent job or empty for contract deployments
+type GraphJob struct {
+	ID           string
+	DependsOn    []string
+	To           string
+	Params       *ETHTransactionParams
+	InternalData *InternalData
+}
+
+// TxTemplate holds the transaction fields referencing the receipts of parent jobs, the transaction is crafted from it
+// once all the parent jobs are mined
+type TxTemplate struct {
+	To              string        `json:"to,omitempty"`
+	MethodSignature string        `json:"methodSignature,omitempty"`
+	Args            []interface{} `json:"args,omitempty"`
+	// Libraries linked in the bytecode of a contract deployment
+	Libraries map[string]ethcommon.Address `json:"libraries,omitempty"`
+}
@@ -0,0 +1,22 @@
+package entities
+
+import (
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+type SmartAccountType string
+
+const (
+	SafeSmartAccountType    SmartAccountType = "Safe"
+	ERC4337SmartAccountType SmartAccountType = "ERC4337"
+)
+
+// SmartAccount is a smart contract wallet which executes the transactions of its accounts signed by an existing signer
+// account. Safe transactions are executed with `execTransaction`, ERC-4337 accounts send UserOperations to the bundler
+// of the chain and the hash of the transaction of their jobs is the hash of the UserOperation
+type SmartAccount struct {
+	Type       SmartAccountType   `json:"type"`                                      // Type of the smart account, one of `Safe` and `ERC4337`.
+	Address    ethcommon.Address  `json:"address" swaggertype:"string"`              // Address of the smart account.
+	Signer     ethcommon.Address  `json:"signer" swaggertype:"string"`               // Account signing the transactions of the smart account.
+	EntryPoint *ethcommon.Address `json:"entryPoint,omitempty" swaggertype:"string"` // `ERC4337` only. EntryPoint contract of the smart account.
+}
@@ -0,0 +1,71 @@
+package entities
+
+import (
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+type SubscriptionTargetType string
+
+const (
+	KafkaSubscriptionTargetType   SubscriptionTargetType = "Kafka"
+	WebhookSubscriptionTargetType SubscriptionTargetType = "Webhook"
+)
+
+type Subscription struct {
+	UUID            string
+	ChainUUID       string
+	ContractAddress ethcommon.Address
+	EventSignature  string
+	// Accepted values of each indexed argument, an empty list of values matches any value
+	TopicFilters [][]ethcommon.Hash
+	Target       *SubscriptionTarget
+	TenantID     string
+	OwnerID      string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type SubscriptionTarget struct {
+	Type    SubscriptionTargetType `json:"type"`              // Destination of the events. One of `Kafka` and `Webhook`.
+	Topic   string                 `json:"topic,omitempty"`   // `Kafka` only. Topic the events are produced to.
+	URL     string                 `json:"url,omitempty"`     // `Webhook` only. URL the events are posted to.
+	Secret  string                 `json:"secret,omitempty"`  // `Webhook` only. Key used to sign the events with HMAC-SHA256.
+	Headers map[string]string      `json:"headers,omitempty"` // `Webhook` only. HTTP headers attached to every request.
+}
+
+// EventTopic returns the hash of the event signature, which is the first topic of the logs of the event
+func (s *Subscription) EventTopic() ethcommon.Hash {
+	return crypto.Keccak256Hash([]byte(s.EventSignature))
+}
+
+// Matches indicates whether a log was emitted by the subscribed event and satisfies the topic filters
+func (s *Subscription) Matches(log *ethtypes.Log) bool {
+	if log.Address != s.ContractAddress || len(log.Topics) == 0 || log.Topics[0] != s.EventTopic() {
+		return false
+	}
+
+	for idx, values := range s.TopicFilters {
+		if len(values) == 0 {
+			continue
+		}
+		if idx+1 >= len(log.Topics) || !containsHash(values, log.Topics[idx+1]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsHash(hashes []ethcommon.Hash, hash ethcommon.Hash) bool {
+	for _, h := range hashes {
+		if h == hash {
+			return true
+		}
+	}
+
+	return false
+}
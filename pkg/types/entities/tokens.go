@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"math/big"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+type TokenStandard string
+
+const (
+	ERC20TokenStandard   TokenStandard = "erc20"
+	ERC721TokenStandard  TokenStandard = "erc721"
+	ERC1155TokenStandard TokenStandard = "erc1155"
+)
+
+type TokenOperation string
+
+const (
+	TransferTokenOperation TokenOperation = "transfer"
+	ApproveTokenOperation  TokenOperation = "approve"
+	MintTokenOperation     TokenOperation = "mint"
+)
+
+// TokenTransfer is a movement of tokens decoded from a Transfer event (ERC-20, ERC-721) or from a TransferSingle or
+// TransferBatch event (ERC-1155). Mints are transfers from the zero address and burns transfers to the zero address
+type TokenTransfer struct {
+	Standard TokenStandard
+	Token    ethcommon.Address
+	// Address which executed the transfer, ERC-1155 only
+	Operator *ethcommon.Address
+	From     ethcommon.Address
+	To       ethcommon.Address
+	// Identifier of the transferred token, nil for ERC-20 tokens
+	TokenID *big.Int
+	Amount  *big.Int
+}
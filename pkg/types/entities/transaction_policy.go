@@ -0,0 +1,121 @@
+package entities
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+type TransactionPolicyType string
+
+const (
+	DailyValueCapPolicyType     TransactionPolicyType = "DailyValueCap"
+	AllowedRecipientsPolicyType TransactionPolicyType = "AllowedRecipients"
+	DeniedRecipientsPolicyType  TransactionPolicyType = "DeniedRecipients"
+	AllowedMethodsPolicyType    TransactionPolicyType = "AllowedMethods"
+	MaxGasPricePolicyType       TransactionPolicyType = "MaxGasPrice"
+)
+
+// TransactionPolicy is a rule enforced on every job of a tenant before it is created. Policies scoped to an account
+// only apply to the jobs sent by this account, otherwise they apply to every job of the tenant
+type TransactionPolicy struct {
+	UUID             string
+	Name             string
+	Type             TransactionPolicyType
+	ChainUUID        string
+	Account          *ethcommon.Address
+	MaxDailyValue    *hexutil.Big
+	Addresses        []ethcommon.Address
+	Contract         *ethcommon.Address
+	MethodSignatures []string
+	MaxGasPrice      *hexutil.Big
+	TenantID         string
+	OwnerID          string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// Matches indicates whether the policy applies to a job
+func (p *TransactionPolicy) Matches(job *Job) bool {
+	if p.ChainUUID != "" && p.ChainUUID != job.ChainUUID {
+		return false
+	}
+
+	if job.Transaction == nil {
+		return false
+	}
+
+	return p.Account == nil || (job.Transaction.From != nil && *job.Transaction.From == *p.Account)
+}
+
+// Violation returns the reason why a job breaks the policy, or an empty string if it complies. Daily value caps depend
+// on the spendings of the day and are therefore not evaluated
+func (p *TransactionPolicy) Violation(job *Job) string {
+	tx := job.Transaction
+	switch p.Type {
+	case AllowedRecipientsPolicyType:
+		if tx.To == nil {
+			return "contract deployments are not allowed"
+		}
+		if !containsAddress(p.Addresses, *tx.To) {
+			return fmt.Sprintf("recipient %s is not allowed", tx.To.Hex())
+		}
+	case DeniedRecipientsPolicyType:
+		if tx.To != nil && containsAddress(p.Addresses, *tx.To) {
+			return fmt.Sprintf("recipient %s is denied", tx.To.Hex())
+		}
+	case AllowedMethodsPolicyType:
+		if p.Contract != nil && tx.To != nil && *tx.To == *p.Contract && !p.isAllowedMethod(tx.Data) {
+			return fmt.Sprintf("method is not allowed on contract %s", p.Contract.Hex())
+		}
+	case MaxGasPricePolicyType:
+		if exceeds(tx.GasPrice, p.MaxGasPrice) || exceeds(tx.GasFeeCap, p.MaxGasPrice) {
+			return fmt.Sprintf("gas price exceeds %s wei", p.MaxGasPrice.ToInt().String())
+		}
+	}
+
+	return ""
+}
+
+func (p *TransactionPolicy) isAllowedMethod(data hexutil.Bytes) bool {
+	if len(data) < 4 {
+		return false
+	}
+
+	for _, signature := range p.MethodSignatures {
+		if bytes.Equal(data[:4], crypto.Keccak256([]byte(signature))[:4]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsPolicyEnforced indicates whether transaction policies apply to a job. As for approval policies, children jobs and
+// marking transactions are sent on behalf of a parent job that already complied with the policies. Faucet credits are
+// sent by Orchestrate itself and are not subject to the policies of the tenant
+func IsPolicyEnforced(job *Job) bool {
+	if job.InternalData != nil && job.InternalData.Faucet {
+		return false
+	}
+
+	return IsApprovable(job)
+}
+
+func containsAddress(addresses []ethcommon.Address, address ethcommon.Address) bool {
+	for _, addr := range addresses {
+		if addr == address {
+			return true
+		}
+	}
+
+	return false
+}
+
+func exceeds(value, ceiling *hexutil.Big) bool {
+	return value != nil && ceiling != nil && value.ToInt().Cmp(ceiling.ToInt()) > 0
+}
@@ -0,0 +1,11 @@
+package entities
+
+import ethcommon "github.com/ethereum/go-ethereum/common"
+
+// TxEvent is an event emitted by a mined transaction, decoded with the contract ABIs known by the registry
+type TxEvent struct {
+	Address     ethcommon.Address `json:"address" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"string"`
+	Signature   string            `json:"signature" example:"Transfer(address,address,uint256)"`
+	DecodedData map[string]string `json:"decodedData,omitempty"`
+	LogIndex    uint64            `json:"logIndex" example:"0"`
+}
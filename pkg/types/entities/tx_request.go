@@ -13,3 +13,9 @@ type TxRequest struct {
 	InternalData   *InternalData
 	CreatedAt      time.Time
 }
+
+// TxRequestResult is the outcome of a transaction request sent in a batch
+type TxRequestResult struct {
+	TxRequest *TxRequest
+	Err       error
+}
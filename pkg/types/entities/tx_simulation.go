@@ -0,0 +1,15 @@
+package entities
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// TransactionSimulation is the result of the execution of a transaction with eth_call at pending state
+type TransactionSimulation struct {
+	Reverted bool
+	// Data returned by the execution if it succeeded
+	Output hexutil.Bytes
+	// Reason decoded from the revert data, empty if the execution reverted without reason
+	RevertReason string
+	RevertData   hexutil.Bytes
+}
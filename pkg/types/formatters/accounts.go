@@ -38,6 +38,20 @@ func FormatImportAccountRequest(req *api.ImportAccountRequest, defaultStoreID st
 	return acc
 }
 
+func FormatRegisterSmartAccountRequest(req *api.RegisterSmartAccountRequest) *entities.Account {
+	return &entities.Account{
+		Alias:      req.Alias,
+		Address:    req.Address,
+		Attributes: req.Attributes,
+		SmartAccount: &entities.SmartAccount{
+			Type:       req.Type,
+			Address:    req.Address,
+			Signer:     req.Signer,
+			EntryPoint: req.EntryPoint,
+		},
+	}
+}
+
 func FormatUpdateAccountRequest(req *api.UpdateAccountRequest) *entities.Account {
 	return &entities.Account{
 		Alias:      req.Alias,
@@ -56,6 +70,7 @@ func FormatAccountResponse(iden *entities.Account) *api.AccountResponse {
 		TenantID:            iden.TenantID,
 		OwnerID:             iden.OwnerID,
 		StoreID:             iden.StoreID,
+		SmartAccount:        iden.SmartAccount,
 		CreatedAt:           iden.CreatedAt,
 		UpdatedAt:           iden.UpdatedAt,
 	}
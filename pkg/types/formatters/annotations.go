@@ -14,6 +14,10 @@ func FormatAnnotationsToInternalData(annotations api.Annotations) *entities.Inte
 		GasPriceIncrement: annotations.GasPricePolicy.RetryPolicy.Increment,
 		GasPriceLimit:     annotations.GasPricePolicy.RetryPolicy.Limit,
 		HasBeenRetried:    annotations.HasBeenRetried,
+		NotBefore:         annotations.NotBefore,
+		NotBeforeBlock:    annotations.NotBeforeBlock,
+		Simulate:          annotations.Simulate,
+		SmartAccount:      annotations.SmartAccount,
 	}
 
 	if annotations.GasPricePolicy.RetryPolicy.Interval != "" {
@@ -41,5 +45,11 @@ func FormatInternalDataToAnnotations(data *entities.InternalData) api.Annotation
 		OneTimeKey:     data.OneTimeKey,
 		GasPricePolicy: gasPricePolicy,
 		HasBeenRetried: data.HasBeenRetried,
+		NotBefore:      data.NotBefore,
+		NotBeforeBlock: data.NotBeforeBlock,
+		Simulate:       data.Simulate,
+		GraphJobID:     data.GraphJobID,
+		DependsOn:      data.DependsOn,
+		SmartAccount:   data.SmartAccount,
 	}
 }
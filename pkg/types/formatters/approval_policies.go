@@ -0,0 +1,50 @@
+package formatters
+
+import (
+	"net/http"
+
+	types "github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/utils"
+)
+
+func FormatCreateApprovalPolicyRequest(request *types.CreateApprovalPolicyRequest) *entities.ApprovalPolicy {
+	return &entities.ApprovalPolicy{
+		Name:            request.Name,
+		ChainUUID:       request.ChainUUID,
+		MinValue:        request.MinValue,
+		To:              request.To,
+		MethodSignature: request.MethodSignature,
+		Threshold:       request.Threshold,
+		Approvers:       request.Approvers,
+	}
+}
+
+func FormatApprovalPolicyResponse(policy *entities.ApprovalPolicy) *types.ApprovalPolicyResponse {
+	return &types.ApprovalPolicyResponse{
+		UUID:            policy.UUID,
+		Name:            policy.Name,
+		ChainUUID:       policy.ChainUUID,
+		MinValue:        policy.MinValue,
+		To:              policy.To,
+		MethodSignature: policy.MethodSignature,
+		Threshold:       policy.Threshold,
+		Approvers:       policy.Approvers,
+		TenantID:        policy.TenantID,
+		OwnerID:         policy.OwnerID,
+		CreatedAt:       policy.CreatedAt,
+		UpdatedAt:       policy.UpdatedAt,
+	}
+}
+
+func FormatApprovalPolicyFiltersRequest(req *http.Request) (*entities.ApprovalPolicyFilters, error) {
+	filters := &entities.ApprovalPolicyFilters{
+		ChainUUID: req.URL.Query().Get("chain_uuid"),
+	}
+
+	if err := utils.GetValidator().Struct(filters); err != nil {
+		return nil, err
+	}
+
+	return filters, nil
+}
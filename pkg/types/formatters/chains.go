@@ -1,11 +1,14 @@
 package formatters
 
 import (
+	"encoding/json"
+	"math/big"
 	"net/http"
 	"strconv"
 	"strings"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/nodehealth"
 	log "github.com/sirupsen/logrus"
 
 	types "github.com/consensys/orchestrate/pkg/types/api"
@@ -27,6 +30,8 @@ func FormatChainResponse(chain *entities.Chain) *types.ChainResponse {
 		ListenerBackOffDuration:   chain.ListenerBackOffDuration,
 		ListenerExternalTxEnabled: chain.ListenerExternalTxEnabled,
 		PrivateTxManager:          chain.PrivateTxManager,
+		GasOracle:                 chain.GasOracle,
+		BundlerURL:                chain.BundlerURL,
 		Labels:                    chain.Labels,
 		Headers:                   chain.Headers,
 		CreatedAt:                 chain.CreatedAt,
@@ -34,6 +39,30 @@ func FormatChainResponse(chain *entities.Chain) *types.ChainResponse {
 	}
 }
 
+func FormatChainNodesResponse(statuses []*nodehealth.NodeStatus) []*types.ChainNodeResponse {
+	nodes := make([]*types.ChainNodeResponse, len(statuses))
+	for i, status := range statuses {
+		nodes[i] = &types.ChainNodeResponse{
+			URL:       status.URL,
+			Healthy:   status.Healthy,
+			Ejected:   status.Ejected,
+			Syncing:   status.Syncing,
+			Head:      status.Head,
+			BlockLag:  status.BlockLag,
+			Latency:   status.Latency.String(),
+			ErrorRate: status.ErrorRate,
+			LastError: status.LastError,
+		}
+
+		if !status.LastCheckedAt.IsZero() {
+			lastCheckedAt := status.LastCheckedAt
+			nodes[i].LastCheckedAt = &lastCheckedAt
+		}
+	}
+
+	return nodes
+}
+
 func FormatRegisterChainRequest(request *types.RegisterChainRequest, fromLatest bool) (*entities.Chain, error) {
 	chain := &entities.Chain{
 		Name:                      request.Name,
@@ -41,6 +70,7 @@ func FormatRegisterChainRequest(request *types.RegisterChainRequest, fromLatest
 		ListenerDepth:             request.Listener.Depth,
 		ListenerBackOffDuration:   request.Listener.BackOffDuration,
 		ListenerExternalTxEnabled: request.Listener.ExternalTxEnabled,
+		BundlerURL:                request.BundlerURL,
 		Labels:                    request.Labels,
 		Headers:                   request.Headers,
 	}
@@ -67,15 +97,20 @@ func FormatRegisterChainRequest(request *types.RegisterChainRequest, fromLatest
 		}
 	}
 
+	if request.GasOracle != nil {
+		chain.GasOracle = FormatGasOracleRequest(request.GasOracle)
+	}
+
 	return chain, nil
 }
 
 func FormatUpdateChainRequest(request *types.UpdateChainRequest, uuid string) *entities.Chain {
 	chain := &entities.Chain{
-		UUID:    uuid,
-		Name:    request.Name,
-		Labels:  request.Labels,
-		Headers: request.Headers,
+		UUID:       uuid,
+		Name:       request.Name,
+		BundlerURL: request.BundlerURL,
+		Labels:     request.Labels,
+		Headers:    request.Headers,
 	}
 
 	if request.Listener != nil {
@@ -92,9 +127,24 @@ func FormatUpdateChainRequest(request *types.UpdateChainRequest, uuid string) *e
 		}
 	}
 
+	if request.GasOracle != nil {
+		chain.GasOracle = FormatGasOracleRequest(request.GasOracle)
+	}
+
 	return chain
 }
 
+func FormatGasOracleRequest(request *types.GasOracleRequest) *entities.GasOracle {
+	return &entities.GasOracle{
+		Type:       request.Type,
+		Blocks:     request.Blocks,
+		Percentile: request.Percentile,
+		GasPrice:   request.GasPrice,
+		GasTipCap:  request.GasTipCap,
+		URL:        request.URL,
+	}
+}
+
 func FormatChainFiltersRequest(req *http.Request) (*entities.ChainFilters, error) {
 	filters := &entities.ChainFilters{}
 
@@ -109,3 +159,35 @@ func FormatChainFiltersRequest(req *http.Request) (*entities.ChainFilters, error
 
 	return filters, nil
 }
+
+func FormatCallContractRequest(request *types.CallContractRequest) (*entities.ContractCall, error) {
+	call := &entities.ContractCall{
+		ContractName:    request.ContractName,
+		ContractTag:     request.ContractTag,
+		From:            request.From,
+		To:              request.To,
+		MethodSignature: request.MethodSignature,
+		Args:            request.Args,
+		PrivacyGroupID:  request.PrivacyGroupID,
+	}
+
+	if request.ABI != nil {
+		rawABI, err := json.Marshal(request.ABI)
+		if err != nil {
+			return nil, errors.InvalidFormatError("failed to marshal ABI")
+		}
+		call.RawABI = string(rawABI)
+	}
+
+	if request.BlockNumber != nil {
+		call.BlockNumber = new(big.Int).SetUint64(*request.BlockNumber)
+	}
+
+	return call, nil
+}
+
+func FormatCallContractResponse(outputs map[string]interface{}) *types.CallContractResponse {
+	return &types.CallContractResponse{
+		Outputs: outputs,
+	}
+}
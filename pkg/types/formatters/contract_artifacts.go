@@ -0,0 +1,157 @@
+package formatters
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// contractArtifact holds the fields of Hardhat (artifacts/*.json) and Foundry (out/*.json) artifacts
+type contractArtifact struct {
+	ContractName           string                  `json:"contractName"`
+	ABI                    json.RawMessage         `json:"abi"`
+	Bytecode               artifactBytecode        `json:"bytecode"`
+	DeployedBytecode       artifactBytecode        `json:"deployedBytecode"`
+	LinkReferences         entities.LinkReferences `json:"linkReferences"`
+	DeployedLinkReferences entities.LinkReferences `json:"deployedLinkReferences"`
+	Metadata               json.RawMessage         `json:"metadata"`
+	RawMetadata            string                  `json:"rawMetadata"`
+}
+
+// artifactBytecode is a hex string in Hardhat artifacts and an object holding the hex string and its link references
+// in Foundry artifacts
+type artifactBytecode struct {
+	Object         string                  `json:"object"`
+	LinkReferences entities.LinkReferences `json:"linkReferences"`
+}
+
+// solidityMetadata holds the fields of the metadata output by the Solidity compiler
+type solidityMetadata struct {
+	Output struct {
+		ABI json.RawMessage `json:"abi"`
+	} `json:"output"`
+	Settings struct {
+		CompilationTarget map[string]string `json:"compilationTarget"`
+	} `json:"settings"`
+}
+
+func (b *artifactBytecode) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		return json.Unmarshal(data, &b.Object)
+	}
+
+	type bytecode artifactBytecode
+	return json.Unmarshal(data, (*bytecode)(b))
+}
+
+// formatContractArtifact fills the contract with the bytecodes and name of the artifact and returns its ABI
+func formatContractArtifact(rawArtifact json.RawMessage, contract *entities.Contract) (json.RawMessage, error) {
+	artifact := &contractArtifact{}
+	if err := json.Unmarshal(rawArtifact, artifact); err != nil {
+		return nil, errors.InvalidParameterError("invalid contract artifact: %s", err.Error())
+	}
+
+	metadata, err := artifact.metadata()
+	if err != nil {
+		return nil, err
+	}
+
+	contract.Bytecode, contract.LinkReferences, err = artifact.Bytecode.decode(artifact.LinkReferences)
+	if err != nil {
+		return nil, err
+	}
+
+	// Libraries are only linked in the bytecode, the deployed bytecode is registered with zeroed addresses
+	contract.DeployedBytecode, _, err = artifact.DeployedBytecode.decode(artifact.DeployedLinkReferences)
+	if err != nil {
+		return nil, err
+	}
+
+	if contract.Name == "" {
+		contract.Name = artifact.ContractName
+	}
+	if contract.Name == "" && metadata != nil {
+		for _, name := range metadata.Settings.CompilationTarget {
+			contract.Name = name
+		}
+	}
+	if contract.Name == "" {
+		return nil, errors.InvalidParameterError("contract name is missing and not found in the artifact")
+	}
+
+	switch {
+	case len(artifact.ABI) > 0:
+		return artifact.ABI, nil
+	case metadata != nil && len(metadata.Output.ABI) > 0:
+		return metadata.Output.ABI, nil
+	default:
+		return nil, errors.InvalidParameterError("contract ABI not found in the artifact")
+	}
+}
+
+// metadata returns the Solidity metadata of the artifact, given either as an object or as a JSON string
+func (a *contractArtifact) metadata() (*solidityMetadata, error) {
+	rawMetadata := []byte(a.RawMetadata)
+	if len(a.Metadata) > 0 && a.Metadata[0] == '"' {
+		var s string
+		if err := json.Unmarshal(a.Metadata, &s); err != nil {
+			return nil, errors.InvalidParameterError("invalid contract artifact metadata")
+		}
+		rawMetadata = []byte(s)
+	} else if len(a.Metadata) > 0 && string(a.Metadata) != "null" {
+		rawMetadata = a.Metadata
+	}
+
+	if len(rawMetadata) == 0 {
+		return nil, nil
+	}
+
+	metadata := &solidityMetadata{}
+	if err := json.Unmarshal(rawMetadata, metadata); err != nil {
+		return nil, errors.InvalidParameterError("invalid contract artifact metadata")
+	}
+
+	return metadata, nil
+}
+
+// decode returns the bytecode with zeroed library placeholders and the link references locating them
+func (b *artifactBytecode) decode(linkReferences entities.LinkReferences) (hexutil.Bytes, entities.LinkReferences, error) {
+	if len(b.LinkReferences) > 0 {
+		linkReferences = b.LinkReferences
+	}
+
+	code := []byte(strings.TrimPrefix(b.Object, "0x"))
+	for _, libraries := range linkReferences {
+		for name, offsets := range libraries {
+			for _, offset := range offsets {
+				start, end := 2*offset.Start, 2*(offset.Start+offset.Length)
+				if offset.Start < 0 || offset.Length <= 0 || end > len(code) {
+					return nil, nil, errors.InvalidParameterError("link reference of library %s is out of the bytecode", name)
+				}
+				copy(code[start:end], strings.Repeat("0", end-start))
+			}
+		}
+	}
+
+	if len(code) == 0 {
+		return nil, nil, nil
+	}
+
+	bytecode, err := hex.DecodeString(string(code))
+	if err != nil {
+		if strings.Contains(string(code), "__") {
+			return nil, nil, errors.InvalidParameterError("bytecode contains library placeholders without link references")
+		}
+		return nil, nil, errors.InvalidParameterError("bytecode is not a valid hex string")
+	}
+
+	if len(linkReferences) == 0 {
+		linkReferences = nil
+	}
+
+	return bytecode, linkReferences, nil
+}
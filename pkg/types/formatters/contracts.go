@@ -17,29 +17,37 @@ import (
 )
 
 func FormatRegisterContractRequest(req *types.RegisterContractRequest) (*entities.Contract, error) {
-	rawABI, err := json.Marshal(req.ABI)
-	if err != nil {
-		return nil, err
-	}
-
 	tag := req.Tag
 	if tag == "" {
 		tag = entities.DefaultTagValue
 	}
 
+	contract := &entities.Contract{
+		Name:             req.Name,
+		Tag:              tag,
+		Bytecode:         req.Bytecode,
+		DeployedBytecode: req.DeployedBytecode,
+	}
+
+	var rawABI []byte
+	var err error
+	if req.Artifact != nil {
+		rawABI, err = formatContractArtifact(req.Artifact, contract)
+	} else {
+		rawABI, err = json.Marshal(req.ABI)
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	parsedABI, err := abi.JSON(strings.NewReader(string(rawABI)))
 	if err != nil {
 		return nil, err
 	}
 
-	return &entities.Contract{
-		Name:             req.Name,
-		Tag:              tag,
-		Bytecode:         req.Bytecode,
-		DeployedBytecode: req.DeployedBytecode,
-		RawABI:           string(rawABI),
-		ABI:              parsedABI,
-	}, nil
+	contract.RawABI = string(rawABI)
+	contract.ABI = parsedABI
+	return contract, nil
 }
 
 func FormatSearchContractRequest(req *http.Request) (*types.SearchContractRequest, error) {
@@ -101,6 +109,7 @@ func FormatContractResponse(contract *entities.Contract) *types.ContractResponse
 		ABI:              contract.RawABI,
 		Bytecode:         contract.Bytecode,
 		DeployedBytecode: contract.DeployedBytecode,
+		LinkReferences:   contract.LinkReferences,
 		Constructor:      contract.Constructor,
 		Methods:          contract.Methods,
 		Events:           contract.Events,
@@ -0,0 +1,103 @@
+// +build unit
+
+package formatters
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	types "github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	artifactABI      = `[{"inputs":[],"name":"total","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`
+	libraryHolder    = "__$6c0d3d4e5c1d8b5e0c1d2b2c6a4d5e7f81$__"
+	unlinkedBytecode = "0x6080604052" + libraryHolder + "6000"
+	deployedBytecode = "0x60806040"
+	linkReferences   = `{"contracts/SafeMath.sol":{"SafeMath":[{"start":5,"length":20}]}}`
+)
+
+func TestFormatRegisterContractRequest(t *testing.T) {
+	expectedBytecode := hexutil.MustDecode("0x6080604052" + "0000000000000000000000000000000000000000" + "6000")
+	expectedLinkReferences := entities.LinkReferences{
+		"contracts/SafeMath.sol": {"SafeMath": {{Start: 5, Length: 20}}},
+	}
+
+	t.Run("should format Hardhat artifact", func(t *testing.T) {
+		artifact := `{
+			"_format": "hh-sol-artifact-1",
+			"contractName": "Counter",
+			"sourceName": "contracts/Counter.sol",
+			"abi": ` + artifactABI + `,
+			"bytecode": "` + unlinkedBytecode + `",
+			"deployedBytecode": "` + deployedBytecode + `",
+			"linkReferences": ` + linkReferences + `,
+			"deployedLinkReferences": {}
+		}`
+
+		contract, err := FormatRegisterContractRequest(&types.RegisterContractRequest{Artifact: json.RawMessage(artifact)})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Counter", contract.Name)
+		assert.Equal(t, entities.DefaultTagValue, contract.Tag)
+		assert.JSONEq(t, artifactABI, contract.RawABI)
+		assert.Equal(t, hexutil.Bytes(expectedBytecode), contract.Bytecode)
+		assert.Equal(t, hexutil.Bytes(hexutil.MustDecode(deployedBytecode)), contract.DeployedBytecode)
+		assert.Equal(t, expectedLinkReferences, contract.LinkReferences)
+		assert.NotNil(t, contract.ABI.Methods["total"])
+	})
+
+	t.Run("should format Foundry artifact and take the name from its metadata", func(t *testing.T) {
+		artifact := `{
+			"abi": ` + artifactABI + `,
+			"bytecode": {"object": "` + unlinkedBytecode + `", "sourceMap": "", "linkReferences": ` + linkReferences + `},
+			"deployedBytecode": {"object": "` + deployedBytecode + `", "sourceMap": "", "linkReferences": {}},
+			"metadata": {"settings": {"compilationTarget": {"src/Counter.sol": "Counter"}}}
+		}`
+
+		contract, err := FormatRegisterContractRequest(&types.RegisterContractRequest{
+			Artifact: json.RawMessage(artifact),
+			Tag:      "v1.0.0",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Counter", contract.Name)
+		assert.Equal(t, "v1.0.0", contract.Tag)
+		assert.Equal(t, hexutil.Bytes(expectedBytecode), contract.Bytecode)
+		assert.Equal(t, expectedLinkReferences, contract.LinkReferences)
+	})
+
+	t.Run("should use the name of the request over the name of the artifact", func(t *testing.T) {
+		artifact := `{"contractName": "Counter", "abi": ` + artifactABI + `, "bytecode": "` + deployedBytecode + `"}`
+
+		contract, err := FormatRegisterContractRequest(&types.RegisterContractRequest{
+			Artifact: json.RawMessage(artifact),
+			Name:     "MyCounter",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "MyCounter", contract.Name)
+		assert.Nil(t, contract.LinkReferences)
+	})
+
+	t.Run("should fail with InvalidParameterError if placeholders have no link references", func(t *testing.T) {
+		artifact := `{"contractName": "Counter", "abi": ` + artifactABI + `, "bytecode": "` + unlinkedBytecode + `"}`
+
+		_, err := FormatRegisterContractRequest(&types.RegisterContractRequest{Artifact: json.RawMessage(artifact)})
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if name is not found", func(t *testing.T) {
+		artifact := `{"abi": ` + artifactABI + `, "bytecode": "` + deployedBytecode + `"}`
+
+		_, err := FormatRegisterContractRequest(&types.RegisterContractRequest{Artifact: json.RawMessage(artifact)})
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
@@ -1,6 +1,7 @@
 package formatters
 
 import (
+	"fmt"
 	"net/http"
 	"strings"
 	"time"
@@ -29,6 +30,8 @@ func FormatJobResponse(job *entities.Job) *types.JobResponse {
 		Annotations:   FormatInternalDataToAnnotations(job.InternalData),
 		Type:          job.Type,
 		Status:        job.Status,
+		RevertReason:  job.RevertReason,
+		Events:        job.Events,
 		ParentJobUUID: job.InternalData.ParentJobUUID,
 		CreatedAt:     job.CreatedAt,
 		UpdatedAt:     job.UpdatedAt,
@@ -59,8 +62,10 @@ func FormatJobCreateRequest(request *types.CreateJobRequest) *entities.Job {
 
 func FormatJobUpdateRequest(request *types.UpdateJobRequest) *entities.Job {
 	job := &entities.Job{
-		Labels:      request.Labels,
-		Transaction: request.Transaction,
+		Labels:       request.Labels,
+		Transaction:  request.Transaction,
+		RevertReason: request.RevertReason,
+		Events:       request.Events,
 	}
 
 	if request.Annotations != nil {
@@ -93,18 +98,48 @@ func FormatJobFilterRequest(req *http.Request) (*entities.JobFilters, error) {
 		filters.ParentJobUUID = qParentJobUUID
 	}
 
-	qUpdatedAfter := req.URL.Query().Get("updated_after")
-	if qUpdatedAfter != "" {
-		updatedAfter, err := time.Parse(time.RFC3339, qUpdatedAfter)
-		if err != nil {
-			errMessage := "failed to parse updated_after as time"
-			log.WithError(err).WithField("updated_after", qUpdatedAfter).Error(errMessage)
-			return nil, errors.InvalidParameterError(errMessage)
+	var err error
+	filters.UpdatedAfter, err = parseTimeFilter(req, "updated_after")
+	if err != nil {
+		return nil, err
+	}
+
+	qType := req.URL.Query().Get("type")
+	if qType != "" {
+		filters.Type = entities.JobType(qType)
+	}
+
+	qLabels := req.URL.Query().Get("labels")
+	if qLabels != "" {
+		filters.Labels = make(map[string]string)
+		for _, label := range strings.Split(qLabels, ",") {
+			kv := strings.SplitN(label, "=", 2)
+			if len(kv) != 2 || kv[0] == "" {
+				return nil, errors.InvalidParameterError("labels must be a list of key=value pairs")
+			}
+			filters.Labels[kv[0]] = kv[1]
 		}
+	}
+
+	filters.From = req.URL.Query().Get("from")
+	filters.To = req.URL.Query().Get("to")
 
-		filters.UpdatedAfter = updatedAfter
+	filters.CreatedAfter, err = parseTimeFilter(req, "created_after")
+	if err != nil {
+		return nil, err
 	}
 
+	filters.CreatedBefore, err = parseTimeFilter(req, "created_before")
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := utils.FilterCursorPagination(req)
+	if err != nil {
+		return nil, err
+	}
+	filters.Pagination = *pagination
+
 	qOnlyParents := req.URL.Query().Get("only_parents")
 	if qOnlyParents == "true" {
 		filters.OnlyParents = true
@@ -122,6 +157,22 @@ func FormatJobFilterRequest(req *http.Request) (*entities.JobFilters, error) {
 	return filters, nil
 }
 
+func parseTimeFilter(req *http.Request, key string) (time.Time, error) {
+	qTime := req.URL.Query().Get(key)
+	if qTime == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, qTime)
+	if err != nil {
+		errMessage := fmt.Sprintf("failed to parse %s as time", key)
+		log.WithError(err).WithField(key, qTime).Error(errMessage)
+		return time.Time{}, errors.InvalidParameterError(errMessage)
+	}
+
+	return t, nil
+}
+
 func JobResponseToEntity(jobResponse *types.JobResponse) *entities.Job {
 	// Cannot fail as the duration coming from a response is expected to be valid
 	return &entities.Job{
@@ -134,6 +185,8 @@ func JobResponseToEntity(jobResponse *types.JobResponse) *entities.Job {
 		OwnerID:      jobResponse.OwnerID,
 		InternalData: FormatAnnotationsToInternalData(jobResponse.Annotations),
 		Transaction:  &jobResponse.Transaction,
+		RevertReason: jobResponse.RevertReason,
+		Events:       jobResponse.Events,
 		Logs:         jobResponse.Logs,
 		CreatedAt:    jobResponse.CreatedAt,
 		UpdatedAt:    jobResponse.UpdatedAt,
@@ -1,8 +1,12 @@
 package formatters
 
 import (
+	"net/http"
+
+	"github.com/consensys/orchestrate/pkg/errors"
 	types "github.com/consensys/orchestrate/pkg/types/api"
 	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/utils"
 )
 
 func FormatScheduleResponse(schedule *entities.Schedule) *types.ScheduleResponse {
@@ -20,3 +24,71 @@ func FormatScheduleResponse(schedule *entities.Schedule) *types.ScheduleResponse
 
 	return scheduleResponse
 }
+
+func FormatScheduleFilterRequest(req *http.Request) (*entities.ScheduleFilters, error) {
+	filters := &entities.ScheduleFilters{}
+
+	var err error
+	filters.CreatedAfter, err = parseTimeFilter(req, "created_after")
+	if err != nil {
+		return nil, err
+	}
+
+	filters.CreatedBefore, err = parseTimeFilter(req, "created_before")
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := utils.FilterCursorPagination(req)
+	if err != nil {
+		return nil, err
+	}
+	filters.Pagination = *pagination
+
+	if filters.Pagination.SortBy != "" && filters.Pagination.SortBy != entities.SortByCreatedAt {
+		return nil, errors.InvalidParameterError("schedules can only be sorted by %s", entities.SortByCreatedAt)
+	}
+
+	if err := utils.GetValidator().Struct(filters); err != nil {
+		return nil, errors.InvalidFormatError(err.Error())
+	}
+
+	return filters, nil
+}
+
+func FormatCreateScheduleGraphRequest(request *types.CreateScheduleGraphRequest) *entities.ScheduleGraph {
+	graph := &entities.ScheduleGraph{
+		ChainName: request.ChainName,
+		Labels:    request.Labels,
+		Jobs:      make([]*entities.GraphJob, len(request.Jobs)),
+	}
+
+	for idx, job := range request.Jobs {
+		if job.Params.ContractTag == "" {
+			job.Params.ContractTag = entities.DefaultTagValue
+		}
+
+		graph.Jobs[idx] = &entities.GraphJob{
+			ID:        job.ID,
+			DependsOn: job.DependsOn,
+			To:        job.Params.To,
+			Params: &entities.ETHTransactionParams{
+				From:            job.Params.From,
+				Value:           job.Params.Value,
+				Gas:             job.Params.Gas,
+				GasPrice:        job.Params.GasPrice,
+				GasFeeCap:       job.Params.GasFeeCap,
+				GasTipCap:       job.Params.GasTipCap,
+				AccessList:      job.Params.AccessList,
+				TransactionType: job.Params.TransactionType,
+				MethodSignature: job.Params.MethodSignature,
+				Args:            job.Params.Args,
+				ContractName:    job.Params.ContractName,
+				ContractTag:     job.Params.ContractTag,
+			},
+			InternalData: buildInternalData(false, &job.Params.GasPricePolicy, nil, nil, false),
+		}
+	}
+
+	return graph
+}
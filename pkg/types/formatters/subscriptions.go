@@ -0,0 +1,81 @@
+package formatters
+
+import (
+	"net/http"
+
+	types "github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/utils"
+)
+
+func FormatCreateSubscriptionRequest(request *types.CreateSubscriptionRequest) *entities.Subscription {
+	return &entities.Subscription{
+		ChainUUID:       request.ChainUUID,
+		ContractAddress: request.ContractAddress,
+		EventSignature:  request.EventSignature,
+		TopicFilters:    request.TopicFilters,
+		Target:          FormatSubscriptionTargetRequest(request.Target),
+	}
+}
+
+func FormatUpdateSubscriptionRequest(request *types.UpdateSubscriptionRequest, uuid string) *entities.Subscription {
+	subscription := &entities.Subscription{
+		UUID:         uuid,
+		TopicFilters: request.TopicFilters,
+	}
+
+	if request.Target != nil {
+		subscription.Target = FormatSubscriptionTargetRequest(request.Target)
+	}
+
+	return subscription
+}
+
+func FormatSubscriptionTargetRequest(request *types.SubscriptionTargetRequest) *entities.SubscriptionTarget {
+	return &entities.SubscriptionTarget{
+		Type:    request.Type,
+		Topic:   request.Topic,
+		URL:     request.URL,
+		Secret:  request.Secret,
+		Headers: request.Headers,
+	}
+}
+
+// FormatSubscriptionResponse formats a subscription, the secret and the headers of webhook targets are only returned
+// when withSecrets is set
+func FormatSubscriptionResponse(subscription *entities.Subscription, withSecrets bool) *types.SubscriptionResponse {
+	target := subscription.Target
+	if !withSecrets && target != nil {
+		target = &entities.SubscriptionTarget{
+			Type:  target.Type,
+			Topic: target.Topic,
+			URL:   target.URL,
+		}
+	}
+
+	return &types.SubscriptionResponse{
+		UUID:            subscription.UUID,
+		ChainUUID:       subscription.ChainUUID,
+		ContractAddress: subscription.ContractAddress,
+		EventSignature:  subscription.EventSignature,
+		TopicFilters:    subscription.TopicFilters,
+		Target:          target,
+		TenantID:        subscription.TenantID,
+		OwnerID:         subscription.OwnerID,
+		CreatedAt:       subscription.CreatedAt,
+		UpdatedAt:       subscription.UpdatedAt,
+	}
+}
+
+func FormatSubscriptionFiltersRequest(req *http.Request) (*entities.SubscriptionFilters, error) {
+	filters := &entities.SubscriptionFilters{
+		ChainUUID:       req.URL.Query().Get("chain_uuid"),
+		ContractAddress: req.URL.Query().Get("contract_address"),
+	}
+
+	if err := utils.GetValidator().Struct(filters); err != nil {
+		return nil, err
+	}
+
+	return filters, nil
+}
@@ -0,0 +1,231 @@
+package formatters
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/tokens"
+	types "github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Read-only methods of the tokens exposed by the API
+const (
+	TokenBalanceOfMethod = "balanceOf"
+	TokenAllowanceMethod = "allowance"
+	TokenOwnerOfMethod   = "ownerOf"
+)
+
+// FormatTokenTxRequest formats a token operation to a transaction calling the method of the token standard
+func FormatTokenTxRequest(standard entities.TokenStandard, operation entities.TokenOperation, req *types.TokenTxRequest, idempotencyKey string) (*entities.TxRequest, error) {
+	methodSignature, args, err := tokenTxMethod(standard, operation, &req.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	from := req.Params.From
+	token := req.Params.Token
+	return &entities.TxRequest{
+		IdempotencyKey: idempotencyKey,
+		ChainName:      req.ChainName,
+		Labels:         req.Labels,
+		Params: &entities.ETHTransactionParams{
+			From:            &from,
+			To:              &token,
+			Nonce:           req.Params.Nonce,
+			GasPrice:        req.Params.GasPrice,
+			Gas:             req.Params.Gas,
+			GasFeeCap:       req.Params.GasFeeCap,
+			GasTipCap:       req.Params.GasTipCap,
+			AccessList:      req.Params.AccessList,
+			TransactionType: req.Params.TransactionType,
+			MethodSignature: methodSignature,
+			Args:            args,
+		},
+		InternalData: buildInternalData(false, &req.Params.GasPricePolicy, req.Params.NotBefore, req.Params.NotBeforeBlock, req.Params.Simulate),
+	}, nil
+}
+
+func tokenTxMethod(standard entities.TokenStandard, operation entities.TokenOperation, params *types.TokenTxParams) (string, []interface{}, error) {
+	to := params.To.Hex()
+	switch standard {
+	case entities.ERC20TokenStandard:
+		if params.Amount == nil {
+			return "", nil, errors.InvalidParameterError("field 'amount' is required for %s tokens", standard)
+		}
+
+		switch operation {
+		case entities.TransferTokenOperation:
+			return tokens.ERC20Transfer, []interface{}{to, params.Amount.String()}, nil
+		case entities.ApproveTokenOperation:
+			return tokens.ERC20Approve, []interface{}{to, params.Amount.String()}, nil
+		case entities.MintTokenOperation:
+			return tokens.ERC20Mint, []interface{}{to, params.Amount.String()}, nil
+		}
+	case entities.ERC721TokenStandard:
+		if params.TokenID == nil {
+			return "", nil, errors.InvalidParameterError("field 'tokenId' is required for %s tokens", standard)
+		}
+
+		switch operation {
+		case entities.TransferTokenOperation:
+			return tokens.ERC721Transfer, []interface{}{params.From.Hex(), to, params.TokenID.String()}, nil
+		case entities.ApproveTokenOperation:
+			return tokens.ERC721Approve, []interface{}{to, params.TokenID.String()}, nil
+		case entities.MintTokenOperation:
+			return tokens.ERC721Mint, []interface{}{to, params.TokenID.String()}, nil
+		}
+	case entities.ERC1155TokenStandard:
+		if operation == entities.ApproveTokenOperation {
+			approved := params.Approved == nil || *params.Approved
+			return tokens.ERC1155Approve, []interface{}{to, approved}, nil
+		}
+
+		if params.TokenID == nil || params.Amount == nil {
+			return "", nil, errors.InvalidParameterError("fields 'tokenId' and 'amount' are required for %s tokens", standard)
+		}
+
+		switch operation {
+		case entities.TransferTokenOperation:
+			return tokens.ERC1155Transfer, []interface{}{params.From.Hex(), to, params.TokenID.String(), params.Amount.String(), params.Data.String()}, nil
+		case entities.MintTokenOperation:
+			return tokens.ERC1155Mint, []interface{}{to, params.TokenID.String(), params.Amount.String(), params.Data.String()}, nil
+		}
+	default:
+		return "", nil, errors.InvalidParameterError("token standard %s is not supported", standard)
+	}
+
+	return "", nil, errors.InvalidParameterError("operation %s is not supported by %s tokens", operation, standard)
+}
+
+// FormatTokenCallRequest formats a read-only method of a token to a contract call, the arguments being read from the
+// query parameters
+func FormatTokenCallRequest(standard entities.TokenStandard, method string, token ethcommon.Address, req *http.Request) (*entities.ContractCall, error) {
+	call := &entities.ContractCall{To: token}
+
+	qBlock := req.URL.Query().Get("block")
+	if qBlock != "" {
+		block, err := strconv.ParseUint(qBlock, 10, 64)
+		if err != nil {
+			return nil, errors.InvalidFormatError("block must be a positive number")
+		}
+		call.BlockNumber = new(big.Int).SetUint64(block)
+	}
+
+	switch {
+	case method == TokenBalanceOfMethod && standard == entities.ERC1155TokenStandard:
+		owner, err := queryAddress(req, "owner")
+		if err != nil {
+			return nil, err
+		}
+		tokenID, err := queryBig(req, "tokenId")
+		if err != nil {
+			return nil, err
+		}
+		call.MethodSignature, call.Args = tokens.ERC1155BalanceOf, []interface{}{owner.Hex(), hexutil.EncodeBig(tokenID)}
+	case method == TokenBalanceOfMethod && (standard == entities.ERC20TokenStandard || standard == entities.ERC721TokenStandard):
+		owner, err := queryAddress(req, "owner")
+		if err != nil {
+			return nil, err
+		}
+		call.MethodSignature, call.Args = tokens.ERC20BalanceOf, []interface{}{owner.Hex()}
+	case method == TokenAllowanceMethod && standard == entities.ERC20TokenStandard:
+		owner, err := queryAddress(req, "owner")
+		if err != nil {
+			return nil, err
+		}
+		spender, err := queryAddress(req, "spender")
+		if err != nil {
+			return nil, err
+		}
+		call.MethodSignature, call.Args = tokens.ERC20Allowance, []interface{}{owner.Hex(), spender.Hex()}
+	case method == TokenOwnerOfMethod && standard == entities.ERC721TokenStandard:
+		tokenID, err := queryBig(req, "tokenId")
+		if err != nil {
+			return nil, err
+		}
+		call.MethodSignature, call.Args = tokens.ERC721OwnerOf, []interface{}{hexutil.EncodeBig(tokenID)}
+	default:
+		return nil, errors.InvalidParameterError("method %s is not supported by %s tokens", method, standard)
+	}
+
+	return call, nil
+}
+
+func FormatTokenBalanceResponse(outputs map[string]interface{}) (*types.TokenBalanceResponse, error) {
+	balance, err := outputBig(outputs, "balance")
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TokenBalanceResponse{Balance: (*hexutil.Big)(balance)}, nil
+}
+
+func FormatTokenAllowanceResponse(outputs map[string]interface{}) (*types.TokenAllowanceResponse, error) {
+	allowance, err := outputBig(outputs, "allowance")
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TokenAllowanceResponse{Allowance: (*hexutil.Big)(allowance)}, nil
+}
+
+func FormatTokenOwnerResponse(outputs map[string]interface{}) (*types.TokenOwnerResponse, error) {
+	// Addresses are decoded with their own type which is printed as an hexadecimal string
+	owner, ok := outputs["owner"].(fmt.Stringer)
+	if !ok || !ethcommon.IsHexAddress(owner.String()) {
+		return nil, errors.DataCorruptedError("unexpected output 'owner' of token call")
+	}
+
+	return &types.TokenOwnerResponse{Owner: ethcommon.HexToAddress(owner.String())}, nil
+}
+
+// FormatTokenTransferMessage formats a token transfer to the message produced by the tx-listener
+func FormatTokenTransferMessage(transfer *entities.TokenTransfer) *types.TokenTransferMessage {
+	msg := &types.TokenTransferMessage{
+		Standard: transfer.Standard,
+		Token:    transfer.Token,
+		Operator: transfer.Operator,
+		From:     transfer.From,
+		To:       transfer.To,
+		Amount:   (*hexutil.Big)(transfer.Amount),
+	}
+
+	if transfer.TokenID != nil {
+		msg.TokenID = (*hexutil.Big)(transfer.TokenID)
+	}
+
+	return msg
+}
+
+func outputBig(outputs map[string]interface{}, name string) (*big.Int, error) {
+	value, ok := outputs[name].(*big.Int)
+	if !ok {
+		return nil, errors.DataCorruptedError("unexpected output '%s' of token call", name)
+	}
+
+	return value, nil
+}
+
+func queryAddress(req *http.Request, name string) (ethcommon.Address, error) {
+	value := req.URL.Query().Get(name)
+	if !ethcommon.IsHexAddress(value) {
+		return ethcommon.Address{}, errors.InvalidFormatError("%s must be an address", name)
+	}
+
+	return ethcommon.HexToAddress(value), nil
+}
+
+func queryBig(req *http.Request, name string) (*big.Int, error) {
+	value, ok := new(big.Int).SetString(req.URL.Query().Get(name), 0)
+	if !ok || value.Sign() < 0 {
+		return nil, errors.InvalidFormatError("%s must be a positive number", name)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,142 @@
+// +build unit
+
+package formatters
+
+import (
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/tokens"
+	types "github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatTokenTxRequest(t *testing.T) {
+	token := ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18")
+	from := ethcommon.HexToAddress("0x1abae27a0cbfb02945720425d3b80c7e09728534")
+	to := ethcommon.HexToAddress("0x2abae27a0cbfb02945720425d3b80c7e09728534")
+
+	newRequest := func() *types.TokenTxRequest {
+		return &types.TokenTxRequest{
+			ChainName: "chain",
+			Params: types.TokenTxParams{
+				Token:   token,
+				From:    from,
+				To:      to,
+				Amount:  (*hexutil.Big)(big.NewInt(1000)),
+				TokenID: (*hexutil.Big)(big.NewInt(1)),
+			},
+		}
+	}
+
+	t.Run("should format ERC-20 transfer to a transaction to the token", func(t *testing.T) {
+		txRequest, err := FormatTokenTxRequest(entities.ERC20TokenStandard, entities.TransferTokenOperation, newRequest(), "idempotencyKey")
+
+		require.NoError(t, err)
+		assert.Equal(t, "idempotencyKey", txRequest.IdempotencyKey)
+		assert.Equal(t, "chain", txRequest.ChainName)
+		assert.Equal(t, &from, txRequest.Params.From)
+		assert.Equal(t, &token, txRequest.Params.To)
+		assert.Equal(t, tokens.ERC20Transfer, txRequest.Params.MethodSignature)
+		assert.Equal(t, []interface{}{to.Hex(), "0x3e8"}, txRequest.Params.Args)
+	})
+
+	t.Run("should format ERC-721 transfer to a safe transfer from the sender", func(t *testing.T) {
+		txRequest, err := FormatTokenTxRequest(entities.ERC721TokenStandard, entities.TransferTokenOperation, newRequest(), "")
+
+		require.NoError(t, err)
+		assert.Equal(t, tokens.ERC721Transfer, txRequest.Params.MethodSignature)
+		assert.Equal(t, []interface{}{from.Hex(), to.Hex(), "0x1"}, txRequest.Params.Args)
+	})
+
+	t.Run("should format ERC-1155 approve to an operator approval", func(t *testing.T) {
+		req := newRequest()
+		approved := false
+		req.Params.Approved = &approved
+
+		txRequest, err := FormatTokenTxRequest(entities.ERC1155TokenStandard, entities.ApproveTokenOperation, req, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, tokens.ERC1155Approve, txRequest.Params.MethodSignature)
+		assert.Equal(t, []interface{}{to.Hex(), false}, txRequest.Params.Args)
+	})
+
+	t.Run("should fail with InvalidParameterError if amount is missing for ERC-20 tokens", func(t *testing.T) {
+		req := newRequest()
+		req.Params.Amount = nil
+
+		_, err := FormatTokenTxRequest(entities.ERC20TokenStandard, entities.MintTokenOperation, req, "")
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if token standard is not supported", func(t *testing.T) {
+		_, err := FormatTokenTxRequest("erc777", entities.TransferTokenOperation, newRequest(), "")
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
+
+func TestFormatTokenCallRequest(t *testing.T) {
+	token := ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18")
+	owner := "0x1abae27a0cbfb02945720425d3b80c7e09728534"
+
+	t.Run("should format ERC-1155 balance with the token identifier", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/tokens/erc1155/"+token.Hex()+"/balanceOf?owner="+owner+"&tokenId=5&block=10", nil)
+
+		call, err := FormatTokenCallRequest(entities.ERC1155TokenStandard, TokenBalanceOfMethod, token, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, token, call.To)
+		assert.Equal(t, tokens.ERC1155BalanceOf, call.MethodSignature)
+		assert.Equal(t, []interface{}{ethcommon.HexToAddress(owner).Hex(), "0x5"}, call.Args)
+		assert.Equal(t, big.NewInt(10), call.BlockNumber)
+	})
+
+	t.Run("should fail with InvalidFormatError if owner is not an address", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/tokens/erc20/"+token.Hex()+"/balanceOf?owner=invalid", nil)
+
+		_, err := FormatTokenCallRequest(entities.ERC20TokenStandard, TokenBalanceOfMethod, token, req)
+
+		assert.True(t, errors.IsInvalidFormatError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if method is not supported by the standard", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/tokens/erc20/"+token.Hex()+"/ownerOf?tokenId=1", nil)
+
+		_, err := FormatTokenCallRequest(entities.ERC20TokenStandard, TokenOwnerOfMethod, token, req)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
+
+func TestFormatTokenCallResponses(t *testing.T) {
+	t.Run("should format balance", func(t *testing.T) {
+		response, err := FormatTokenBalanceResponse(map[string]interface{}{"balance": big.NewInt(1000)})
+
+		require.NoError(t, err)
+		assert.Equal(t, "0x3e8", response.Balance.String())
+	})
+
+	t.Run("should format owner", func(t *testing.T) {
+		owner := ethcommon.HexToAddress("0x1abae27a0cbfb02945720425d3b80c7e09728534")
+
+		response, err := FormatTokenOwnerResponse(map[string]interface{}{"owner": owner})
+
+		require.NoError(t, err)
+		assert.Equal(t, owner, response.Owner)
+	})
+
+	t.Run("should fail with DataCorruptedError if output is missing", func(t *testing.T) {
+		_, err := FormatTokenAllowanceResponse(map[string]interface{}{})
+
+		assert.True(t, errors.IsDataCorruptedError(err))
+	})
+}
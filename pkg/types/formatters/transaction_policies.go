@@ -0,0 +1,55 @@
+package formatters
+
+import (
+	"net/http"
+
+	types "github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/utils"
+)
+
+func FormatCreateTransactionPolicyRequest(request *types.CreateTransactionPolicyRequest) *entities.TransactionPolicy {
+	return &entities.TransactionPolicy{
+		Name:             request.Name,
+		Type:             request.Type,
+		ChainUUID:        request.ChainUUID,
+		Account:          request.Account,
+		MaxDailyValue:    request.MaxDailyValue,
+		Addresses:        request.Addresses,
+		Contract:         request.Contract,
+		MethodSignatures: request.MethodSignatures,
+		MaxGasPrice:      request.MaxGasPrice,
+	}
+}
+
+func FormatTransactionPolicyResponse(policy *entities.TransactionPolicy) *types.TransactionPolicyResponse {
+	return &types.TransactionPolicyResponse{
+		UUID:             policy.UUID,
+		Name:             policy.Name,
+		Type:             policy.Type,
+		ChainUUID:        policy.ChainUUID,
+		Account:          policy.Account,
+		MaxDailyValue:    policy.MaxDailyValue,
+		Addresses:        policy.Addresses,
+		Contract:         policy.Contract,
+		MethodSignatures: policy.MethodSignatures,
+		MaxGasPrice:      policy.MaxGasPrice,
+		TenantID:         policy.TenantID,
+		OwnerID:          policy.OwnerID,
+		CreatedAt:        policy.CreatedAt,
+		UpdatedAt:        policy.UpdatedAt,
+	}
+}
+
+func FormatTransactionPolicyFiltersRequest(req *http.Request) (*entities.TransactionPolicyFilters, error) {
+	filters := &entities.TransactionPolicyFilters{
+		Type:      entities.TransactionPolicyType(req.URL.Query().Get("type")),
+		ChainUUID: req.URL.Query().Get("chain_uuid"),
+	}
+
+	if err := utils.GetValidator().Struct(filters); err != nil {
+		return nil, err
+	}
+
+	return filters, nil
+}
@@ -47,12 +47,24 @@ func FormatSendTxRequest(sendTxRequest *types.SendTransactionRequest, idempotenc
 		InternalData: buildInternalData(
 			sendTxRequest.Params.OneTimeKey,
 			&sendTxRequest.Params.GasPricePolicy,
+			sendTxRequest.Params.NotBefore,
+			sendTxRequest.Params.NotBeforeBlock,
+			sendTxRequest.Params.Simulate,
 		),
 	}
 
 	return txRequest
 }
 
+func FormatSendTxsRequest(sendTxsRequest *types.SendTransactionsRequest) []*entities.TxRequest {
+	txRequests := make([]*entities.TxRequest, len(sendTxsRequest.Transactions))
+	for idx, item := range sendTxsRequest.Transactions {
+		txRequests[idx] = FormatSendTxRequest(&item.SendTransactionRequest, item.IdempotencyKey)
+	}
+
+	return txRequests
+}
+
 func FormatDeployContractRequest(deployRequest *types.DeployContractRequest, idempotencyKey string) *entities.TxRequest {
 	if deployRequest.Params.ContractTag == "" {
 		deployRequest.Params.ContractTag = entities.DefaultTagValue
@@ -73,6 +85,7 @@ func FormatDeployContractRequest(deployRequest *types.DeployContractRequest, ide
 			AccessList:      deployRequest.Params.AccessList,
 			TransactionType: deployRequest.Params.TransactionType,
 			Args:            deployRequest.Params.Args,
+			Libraries:       deployRequest.Params.Libraries,
 			ContractName:    deployRequest.Params.ContractName,
 			ContractTag:     deployRequest.Params.ContractTag,
 			Protocol:        deployRequest.Params.Protocol,
@@ -85,6 +98,9 @@ func FormatDeployContractRequest(deployRequest *types.DeployContractRequest, ide
 		InternalData: buildInternalData(
 			deployRequest.Params.OneTimeKey,
 			&deployRequest.Params.GasPricePolicy,
+			deployRequest.Params.NotBefore,
+			deployRequest.Params.NotBeforeBlock,
+			deployRequest.Params.Simulate,
 		),
 	}
 
@@ -106,7 +122,7 @@ func FormatSendRawRequest(rawTxRequest *types.RawTransactionRequest, idempotency
 		Params: &entities.ETHTransactionParams{
 			Raw: rawTxRequest.Params.Raw,
 		},
-		InternalData: buildInternalData(false, gasPricePolicy),
+		InternalData: buildInternalData(false, gasPricePolicy, nil, nil, false),
 	}
 }
 
@@ -130,10 +146,43 @@ func FormatTransferRequest(transferRequest *types.TransferRequest, idempotencyKe
 		InternalData: buildInternalData(
 			false,
 			&transferRequest.Params.GasPricePolicy,
+			transferRequest.Params.NotBefore,
+			transferRequest.Params.NotBeforeBlock,
+			transferRequest.Params.Simulate,
 		),
 	}
 }
 
+func FormatSimulateTxRequest(simulateRequest *types.SimulateTransactionRequest) *entities.TxRequest {
+	if simulateRequest.Params.ContractTag == "" {
+		simulateRequest.Params.ContractTag = entities.DefaultTagValue
+	}
+
+	return &entities.TxRequest{
+		ChainName: simulateRequest.ChainName,
+		Params: &entities.ETHTransactionParams{
+			From:            simulateRequest.Params.From,
+			To:              simulateRequest.Params.To,
+			Value:           simulateRequest.Params.Value,
+			GasPrice:        simulateRequest.Params.GasPrice,
+			Gas:             simulateRequest.Params.Gas,
+			MethodSignature: simulateRequest.Params.MethodSignature,
+			Args:            simulateRequest.Params.Args,
+			ContractName:    simulateRequest.Params.ContractName,
+			ContractTag:     simulateRequest.Params.ContractTag,
+		},
+	}
+}
+
+func FormatSimulateTxResponse(simulation *entities.TransactionSimulation) *types.SimulateTransactionResponse {
+	return &types.SimulateTransactionResponse{
+		Reverted:     simulation.Reverted,
+		Output:       simulation.Output,
+		RevertReason: simulation.RevertReason,
+		RevertData:   simulation.RevertData,
+	}
+}
+
 func FormatTxResponse(txRequest *entities.TxRequest) *types.TransactionResponse {
 	scheduleRes := FormatScheduleResponse(txRequest.Schedule)
 
@@ -147,6 +196,26 @@ func FormatTxResponse(txRequest *entities.TxRequest) *types.TransactionResponse
 	}
 }
 
+func FormatSendTxsResponse(results []*entities.TxRequestResult) *types.SendTransactionsResponse {
+	response := &types.SendTransactionsResponse{
+		Transactions: make([]*types.SendTransactionsItemResponse, len(results)),
+	}
+
+	for idx, result := range results {
+		item := &types.SendTransactionsItemResponse{IdempotencyKey: result.TxRequest.IdempotencyKey}
+		if result.Err != nil {
+			item.Error = errors.FromError(result.Err).GetMessage()
+			item.Code = errors.FromError(result.Err).GetCode()
+		} else {
+			item.Transaction = FormatTxResponse(result.TxRequest)
+		}
+
+		response.Transactions[idx] = item
+	}
+
+	return response
+}
+
 func FormatTransactionsFilterRequest(req *http.Request) (*entities.TransactionRequestFilters, error) {
 	filters := &entities.TransactionRequestFilters{}
 
@@ -169,12 +238,15 @@ func FormatTransactionsFilterRequest(req *http.Request) (*entities.TransactionRe
 	return filters, nil
 }
 
-func buildInternalData(oneTimeKey bool, gasPricePolicy *types.GasPriceParams) *entities.InternalData {
+func buildInternalData(oneTimeKey bool, gasPricePolicy *types.GasPriceParams, notBefore *time.Time, notBeforeBlock *uint64, simulate bool) *entities.InternalData {
 	internalData := &entities.InternalData{
 		OneTimeKey:        oneTimeKey,
 		Priority:          gasPricePolicy.Priority,
 		GasPriceIncrement: gasPricePolicy.RetryPolicy.Increment,
 		GasPriceLimit:     gasPricePolicy.RetryPolicy.Limit,
+		NotBefore:         notBefore,
+		NotBeforeBlock:    notBeforeBlock,
+		Simulate:          simulate,
 	}
 
 	if gasPricePolicy.RetryPolicy.Interval != "" {
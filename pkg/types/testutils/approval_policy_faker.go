@@ -0,0 +1,33 @@
+package testutils
+
+import (
+	"math/big"
+
+	"github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gofrs/uuid"
+)
+
+func FakeApprovalPolicy() *entities.ApprovalPolicy {
+	return &entities.ApprovalPolicy{
+		UUID:      uuid.Must(uuid.NewV4()).String(),
+		Name:      "treasury-transfers",
+		MinValue:  (*hexutil.Big)(big.NewInt(1000)),
+		Threshold: 2,
+		Approvers: []string{"alice", "bob", "carol"},
+		TenantID:  "_",
+	}
+}
+
+func FakeCreateApprovalPolicyRequest() *api.CreateApprovalPolicyRequest {
+	to := ethcommon.HexToAddress("0x6230592812dE2E256D1512504c3E8A3C49975f07")
+	return &api.CreateApprovalPolicyRequest{
+		Name:            "contract-upgrades",
+		To:              &to,
+		MethodSignature: "upgradeTo(address)",
+		Threshold:       1,
+		Approvers:       []string{"alice", "bob"},
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/types/entities"
 	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/quorum-key-manager/pkg/common"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/gofrs/uuid"
 )
 
@@ -43,6 +44,16 @@ func FakeUpdateChainRequest() *api.UpdateChainRequest {
 	}
 }
 
+func FakeCallContractRequest() *api.CallContractRequest {
+	return &api.CallContractRequest{
+		ContractName:    "contract-" + common.RandString(5),
+		ContractTag:     "v1.0.0",
+		To:              ethcommon.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610"),
+		MethodSignature: "balanceOf(address)",
+		Args:            []interface{}{"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"},
+	}
+}
+
 func FakeChainResponse() *api.ChainResponse {
 	return &api.ChainResponse{
 		UUID:                      uuid.Must(uuid.NewV4()).String(),
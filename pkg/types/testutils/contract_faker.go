@@ -6,6 +6,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/types/entities"
 	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
@@ -371,6 +372,16 @@ func FakeContract() *entities.Contract {
 	}
 }
 
+func FakeContractCall() *entities.ContractCall {
+	return &entities.ContractCall{
+		ContractName:    utils.RandString(5),
+		ContractTag:     "v1.0.0",
+		To:              ethcommon.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610"),
+		MethodSignature: "balanceOf(address)",
+		Args:            []interface{}{"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"},
+	}
+}
+
 func FakeEventABI() *abi.Event {
 	return &abi.Event{
 		Name:      "event" + utils.RandString(5),
@@ -0,0 +1,50 @@
+package testutils
+
+import (
+	"github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/gofrs/uuid"
+)
+
+func FakeSubscription() *entities.Subscription {
+	return &entities.Subscription{
+		UUID:            uuid.Must(uuid.NewV4()).String(),
+		ChainUUID:       uuid.Must(uuid.NewV4()).String(),
+		ContractAddress: ethcommon.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610"),
+		EventSignature:  "Transfer(address,address,uint256)",
+		TopicFilters: [][]ethcommon.Hash{
+			{},
+			{ethcommon.HexToHash("0x0000000000000000000000006230592812de2e256d1512504c3e8a3c49975f07")},
+		},
+		Target: &entities.SubscriptionTarget{
+			Type:    entities.WebhookSubscriptionTargetType,
+			URL:     "https://example.com/events",
+			Secret:  "secret",
+			Headers: map[string]string{"X-Custom": "value"},
+		},
+		TenantID: "_",
+	}
+}
+
+func FakeCreateSubscriptionRequest() *api.CreateSubscriptionRequest {
+	return &api.CreateSubscriptionRequest{
+		ChainUUID:       uuid.Must(uuid.NewV4()).String(),
+		ContractAddress: ethcommon.HexToAddress("0x6230592812dE2E256D1512504c3E8A3C49975f07"),
+		EventSignature:  "Transfer(address,address,uint256)",
+		Target: &api.SubscriptionTargetRequest{
+			Type:  entities.KafkaSubscriptionTargetType,
+			Topic: "topic-events",
+		},
+	}
+}
+
+func FakeUpdateSubscriptionRequest() *api.UpdateSubscriptionRequest {
+	return &api.UpdateSubscriptionRequest{
+		Target: &api.SubscriptionTargetRequest{
+			Type:   entities.WebhookSubscriptionTargetType,
+			URL:    "https://example.com/events",
+			Secret: "secret",
+		},
+	}
+}
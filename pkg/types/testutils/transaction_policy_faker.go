@@ -0,0 +1,29 @@
+package testutils
+
+import (
+	"math/big"
+
+	"github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gofrs/uuid"
+)
+
+func FakeTransactionPolicy() *entities.TransactionPolicy {
+	return &entities.TransactionPolicy{
+		UUID:          uuid.Must(uuid.NewV4()).String(),
+		Name:          "daily-cap",
+		Type:          entities.DailyValueCapPolicyType,
+		MaxDailyValue: (*hexutil.Big)(big.NewInt(1000)),
+		TenantID:      "_",
+	}
+}
+
+func FakeCreateTransactionPolicyRequest() *api.CreateTransactionPolicyRequest {
+	return &api.CreateTransactionPolicyRequest{
+		Name:      "allowed-recipients",
+		Type:      entities.AllowedRecipientsPolicyType,
+		Addresses: []ethcommon.Address{ethcommon.HexToAddress("0x6230592812dE2E256D1512504c3E8A3C49975f07")},
+	}
+}
@@ -90,6 +90,34 @@ func FakeCreateScheduleRequest() *types.CreateScheduleRequest {
 	return &types.CreateScheduleRequest{}
 }
 
+func FakeCreateScheduleGraphRequest() *types.CreateScheduleGraphRequest {
+	return &types.CreateScheduleGraphRequest{
+		ChainName: "ganache",
+		Jobs: []*types.ScheduleGraphJobRequest{
+			{
+				ID: "deployRegistry",
+				Params: types.ScheduleGraphJobParams{
+					From:         &FromAddress,
+					ContractName: "Registry",
+					ContractTag:  "v1.0.0",
+				},
+			},
+			{
+				ID:        "register",
+				DependsOn: []string{"deployRegistry"},
+				Params: types.ScheduleGraphJobParams{
+					From:            &FromAddress,
+					To:              "${deployRegistry.receipt.contractAddress}",
+					MethodSignature: "register(address)",
+					Args:            []interface{}{FromAddress.String()},
+					ContractName:    "Registry",
+					ContractTag:     "v1.0.0",
+				},
+			},
+		},
+	}
+}
+
 func FakeCreateJobRequest() *types.CreateJobRequest {
 	return &types.CreateJobRequest{
 		ScheduleUUID: uuid.Must(uuid.NewV4()).String(),
@@ -2,6 +2,7 @@ package tx
 
 import (
 	"crypto/md5"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"sort"
@@ -149,6 +150,10 @@ func (e *Envelope) IsOneTimeKeySignature() bool {
 	return false
 }
 
+func (e *Envelope) IsSimulationEnabled() bool {
+	return e.InternalLabels[SimulateLabel] == "true"
+}
+
 func (e *Envelope) IsParentJob() bool {
 	return e.GetParentJobUUID() == ""
 }
@@ -957,6 +962,26 @@ func (e *Envelope) SetStoreID(storeID string) *Envelope {
 	return e
 }
 
+func (e *Envelope) GetSmartAccount() *entities.SmartAccount {
+	value, ok := e.InternalLabels[SmartAccountLabel]
+	if !ok || value == "" {
+		return nil
+	}
+
+	smartAccount := &entities.SmartAccount{}
+	if err := json.Unmarshal([]byte(value), smartAccount); err != nil {
+		return nil
+	}
+
+	return smartAccount
+}
+
+func (e *Envelope) SetSmartAccount(smartAccount *entities.SmartAccount) *Envelope {
+	b, _ := json.Marshal(smartAccount)
+	e.InternalLabels[SmartAccountLabel] = string(b)
+	return e
+}
+
 func (e *Envelope) SetPriority(priority string) *Envelope {
 	e.InternalLabels[PriorityLabel] = priority
 	return e
@@ -10,10 +10,16 @@ const (
 	StoreIDLabel      = "storeID"
 	ScheduleUUIDLabel = "scheduleUUID"
 	JobUUIDLabel      = "jobUUID"
+	SmartAccountLabel = "smartAccount"
+	SimulateLabel     = "simulate"
 
 	TxFromLabel      = "txFrom"
 	TxFromOneTimeKey = "one-time-key"
 
 	ParentJobUUIDLabel = "parentJobUUID"
 	ExpectedNonceLabel = "nonce.recovering.expected"
+
+	EventHeader         = "event"
+	ReorgedEventValue   = "reorged"
+	AncestorBlockHeader = "reorg.ancestorBlock"
 )
@@ -1,6 +1,7 @@
 package tx
 
 import (
+	"encoding/json"
 	"math/big"
 	"regexp"
 
@@ -181,6 +182,12 @@ func (m *TxEnvelope) SetScheduleUUID(uuid string) *TxEnvelope {
 	return m
 }
 
+func (m *TxEnvelope) SetSmartAccount(smartAccount *entities.SmartAccount) *TxEnvelope {
+	b, _ := json.Marshal(smartAccount)
+	m.InternalLabels[SmartAccountLabel] = string(b)
+	return m
+}
+
 func (m *TxEnvelope) GetJobUUID() string {
 	return m.InternalLabels[JobUUIDLabel]
 }
@@ -210,6 +217,11 @@ func (m *TxEnvelope) EnableTxFromOneTimeKey() *TxEnvelope {
 	return m
 }
 
+func (m *TxEnvelope) EnableSimulation() *TxEnvelope {
+	m.InternalLabels[SimulateLabel] = "true"
+	return m
+}
+
 func (m *TxEnvelope) GetChainUUID() string {
 	return m.InternalLabels[ChainUUIDLabel]
 }
@@ -2,6 +2,8 @@ package envelope
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 
 	authutils "github.com/consensys/orchestrate/pkg/toolkit/app/auth/utils"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
@@ -77,6 +79,14 @@ func NewEnvelopeFromJob(job *entities.Job, headers map[string]string) *tx.TxEnve
 		txEnvelope.SetStoreID(job.InternalData.StoreID)
 	}
 
+	if job.InternalData.SmartAccount != nil {
+		txEnvelope.SetSmartAccount(job.InternalData.SmartAccount)
+	}
+
+	if job.InternalData.Simulate {
+		txEnvelope.EnableSimulation()
+	}
+
 	if job.Transaction.Hash != nil {
 		txEnvelope.SetTxHash(job.Transaction.Hash.String())
 	}
@@ -104,6 +114,8 @@ func NewJobFromEnvelope(envelope *tx.Envelope) *entities.Job {
 			ParentJobUUID: envelope.GetParentJobUUID(),
 			Priority:      envelope.GetPriority(),
 			StoreID:       envelope.GetStoreID(),
+			SmartAccount:  envelope.GetSmartAccount(),
+			Simulate:      envelope.IsSimulationEnabled(),
 		},
 		TenantID: envelope.GetHeadersValue(authutils.TenantIDHeader),
 		OwnerID:  envelope.GetHeadersValue(authutils.UsernameHeader),
@@ -165,3 +177,84 @@ func ConvertToAccessList(accessList []*ethereum.AccessTuple) types.AccessList {
 
 	return result
 }
+
+// NewTxRequestFromEnvelope formats a transaction request received as a message into a transaction request entity.
+// The envelope ID is used as idempotency key so that a message delivered several times creates a single transaction
+func NewTxRequestFromEnvelope(envelope *tx.Envelope) *entities.TxRequest {
+	contractTag := envelope.ContractTag
+	if envelope.ContractName != "" && contractTag == "" {
+		contractTag = entities.DefaultTagValue
+	}
+
+	priority := envelope.GetPriority()
+	if priority == "" {
+		priority = utils.PriorityMedium
+	}
+
+	return &entities.TxRequest{
+		IdempotencyKey: envelope.GetID(),
+		ChainName:      envelope.GetChainName(),
+		Labels:         envelope.GetContextLabels(),
+		Params: &entities.ETHTransactionParams{
+			From:            envelope.GetFrom(),
+			To:              envelope.GetTo(),
+			Value:           envelope.GetValue(),
+			Gas:             envelope.GetGas(),
+			GasPrice:        envelope.GetGasPrice(),
+			GasFeeCap:       envelope.GetGasFeeCap(),
+			GasTipCap:       envelope.GetGasTipCap(),
+			AccessList:      ConvertToAccessList(envelope.GetAccessList()),
+			TransactionType: envelope.GetTransactionType(),
+			MethodSignature: envelope.GetMethodSignature(),
+			Args:            parseArgs(envelope.GetArgs()),
+			Raw:             envelope.GetRaw(),
+			ContractName:    envelope.ContractName,
+			ContractTag:     contractTag,
+			Nonce:           envelope.GetNonce(),
+			Protocol:        privateTxManagerType(envelope),
+			PrivateFrom:     envelope.GetPrivateFrom(),
+			PrivateFor:      envelope.GetPrivateFor(),
+			MandatoryFor:    envelope.GetMandatoryFor(),
+			PrivacyGroupID:  envelope.GetPrivacyGroupID(),
+			PrivacyFlag:     envelope.GetPrivacyFlag(),
+		},
+		InternalData: &entities.InternalData{
+			OneTimeKey: envelope.IsOneTimeKeySignature(),
+			Priority:   priority,
+			Simulate:   envelope.IsSimulationEnabled(),
+		},
+	}
+}
+
+func privateTxManagerType(envelope *tx.Envelope) entities.PrivateTxManagerType {
+	switch {
+	case envelope.IsEthSendTesseraPrivateTransaction(), envelope.Method == tx.Method_ETH_SENDPRIVATETRANSACTION:
+		return entities.TesseraChainType
+	case envelope.IsEeaSendPrivateTransaction(), envelope.Method == tx.Method_EEA_SENDPRIVATETRANSACTION:
+		return entities.EEAChainType
+	default:
+		return ""
+	}
+}
+
+// parseArgs converts message arguments into contract arguments.
+// Arrays, tuples and booleans are expected as JSON values, any other argument is passed as is to the ABI encoder
+func parseArgs(args []string) []interface{} {
+	if len(args) == 0 {
+		return nil
+	}
+
+	res := make([]interface{}, len(args))
+	for idx, arg := range args {
+		res[idx] = arg
+
+		if strings.HasPrefix(arg, "[") || strings.HasPrefix(arg, "{") || arg == "true" || arg == "false" {
+			var value interface{}
+			if err := json.Unmarshal([]byte(arg), &value); err == nil {
+				res[idx] = value
+			}
+		}
+	}
+
+	return res
+}
@@ -3,15 +3,49 @@ package envelope
 import (
 	"encoding/json"
 
-	"github.com/Shopify/sarama"
-	encoding "github.com/consensys/orchestrate/pkg/encoding/sarama"
+	"github.com/consensys/orchestrate/pkg/broker"
+	encoding "github.com/consensys/orchestrate/pkg/encoding/proto"
 	"github.com/consensys/orchestrate/pkg/errors"
 	authutils "github.com/consensys/orchestrate/pkg/toolkit/app/auth/utils"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/types/entities"
 )
 
-func SendJobMessage(job *entities.Job, kafkaProducer sarama.SyncProducer, topic string, userInfo *multitenancy.UserInfo) (partition int32, offset int64, err error) {
+func SendJobMessage(job *entities.Job, producer broker.Producer, topic string, userInfo *multitenancy.UserInfo) (partition int32, offset int64, err error) {
+	msg, err := NewJobMessage(job, topic, userInfo)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Send message
+	partition, offset, err = producer.SendMessage(msg)
+	if err != nil {
+		return 0, 0, errors.KafkaConnectionError("could not produce kafka message")
+	}
+
+	return partition, offset, err
+}
+
+// SendJobMessages sends the messages of several jobs in a single batch
+func SendJobMessages(jobs []*entities.Job, producer broker.Producer, topic string, userInfo *multitenancy.UserInfo) error {
+	msgs := make([]*broker.Message, len(jobs))
+	for idx, job := range jobs {
+		msg, err := NewJobMessage(job, topic, userInfo)
+		if err != nil {
+			return err
+		}
+		msgs[idx] = msg
+	}
+
+	err := producer.SendMessages(msgs)
+	if err != nil {
+		return errors.KafkaConnectionError("could not produce kafka messages")
+	}
+
+	return nil
+}
+
+func NewJobMessage(job *entities.Job, topic string, userInfo *multitenancy.UserInfo) (*broker.Message, error) {
 	bUserInfo, _ := json.Marshal(userInfo)
 	txEnvelope := NewEnvelopeFromJob(job, map[string]string{
 		authutils.UserInfoHeader: string(bUserInfo),
@@ -19,27 +53,21 @@ func SendJobMessage(job *entities.Job, kafkaProducer sarama.SyncProducer, topic
 
 	evlp, err := txEnvelope.Envelope()
 	if err != nil {
-		return 0, 0, errors.InvalidParameterError("failed to craft envelope (%s)", err.Error())
+		return nil, errors.InvalidParameterError("failed to craft envelope (%s)", err.Error())
 	}
 
-	msg := &sarama.ProducerMessage{
+	msg := &broker.Message{
 		Topic: topic,
 	}
 
 	if partitionKey := evlp.PartitionKey(); partitionKey != "" {
-		msg.Key = sarama.StringEncoder(partitionKey)
+		msg.Key = []byte(partitionKey)
 	}
 
-	err = encoding.Marshal(txEnvelope, msg)
+	msg.Value, err = encoding.Marshal(txEnvelope)
 	if err != nil {
-		return 0, 0, errors.InvalidParameterError("failed to encode envelope")
+		return nil, errors.InvalidParameterError("failed to encode envelope")
 	}
 
-	// Send message
-	partition, offset, err = kafkaProducer.SendMessage(msg)
-	if err != nil {
-		return 0, 0, errors.KafkaConnectionError("could not produce kafka message")
-	}
-
-	return partition, offset, err
+	return msg, nil
 }
@@ -28,3 +28,28 @@ func FilterIntegerValueWithKey(req *http.Request) (*entities.PaginationFilters,
 	}
 	return filters, nil
 }
+
+func FilterCursorPagination(req *http.Request) (*entities.CursorPagination, error) {
+	pagination := &entities.CursorPagination{
+		Cursor:    req.URL.Query().Get("cursor"),
+		SortBy:    req.URL.Query().Get("sort_by"),
+		SortOrder: req.URL.Query().Get("order"),
+	}
+
+	qLimit := req.URL.Query().Get("limit")
+	if qLimit != "" {
+		var err error
+		pagination.Limit, err = strconv.Atoi(qLimit)
+		if err != nil || pagination.Limit < 0 {
+			return pagination, errors.InvalidFormatError("limit format is invalid, must be positive integer")
+		}
+	}
+
+	if pagination.Cursor != "" {
+		if _, err := entities.ParseCursor(pagination.Cursor); err != nil {
+			return pagination, errors.InvalidFormatError(err.Error())
+		}
+	}
+
+	return pagination, nil
+}
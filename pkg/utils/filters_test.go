@@ -0,0 +1,53 @@
+// +build unit
+
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterCursorPagination(t *testing.T) {
+	t.Run("should parse cursor pagination", func(t *testing.T) {
+		cursor := &entities.Cursor{Time: time.Now().UTC(), UUID: "uuid"}
+		req := httptest.NewRequest(http.MethodGet, "/jobs?limit=10&sort_by=updated_at&order=desc&cursor="+cursor.String(), nil)
+
+		pagination, err := FilterCursorPagination(req)
+
+		require.NoError(t, err)
+		assert.Equal(t, &entities.CursorPagination{
+			Limit:     10,
+			Cursor:    cursor.String(),
+			SortBy:    entities.SortByUpdatedAt,
+			SortOrder: entities.SortOrderDesc,
+		}, pagination)
+
+		parsed, err := entities.ParseCursor(pagination.Cursor)
+		require.NoError(t, err)
+		assert.True(t, cursor.Time.Equal(parsed.Time))
+		assert.Equal(t, cursor.UUID, parsed.UUID)
+	})
+
+	t.Run("should fail with InvalidFormatError if limit is negative", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/jobs?limit=-1", nil)
+
+		_, err := FilterCursorPagination(req)
+
+		assert.True(t, errors.IsInvalidFormatError(err))
+	})
+
+	t.Run("should fail with InvalidFormatError if cursor is invalid", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/jobs?cursor=invalid", nil)
+
+		_, err := FilterCursorPagination(req)
+
+		assert.True(t, errors.IsInvalidFormatError(err))
+	})
+}
@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"net"
+	"net/url"
+	"strings"
+)
+
+// nonPublicNetworks are the loopback, private, shared and link-local networks which must not be reached on behalf of
+// API users
+var nonPublicNetworks = parseCIDRs(
+	"0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "127.0.0.0/8", "169.254.0.0/16", "172.16.0.0/12", "192.168.0.0/16",
+	"::/128", "::1/128", "fc00::/7", "fe80::/10",
+)
+
+// IsWebSocketURL indicates whether the URL of a node uses the WebSocket protocol
+func IsWebSocketURL(rawURL string) bool {
+	lowerURL := strings.ToLower(rawURL)
+	return strings.HasPrefix(lowerURL, "ws://") || strings.HasPrefix(lowerURL, "wss://")
+}
+
+// HTTPURLs returns the node URLs reachable with HTTP JSON-RPC requests
+func HTTPURLs(urls []string) []string {
+	var httpURLs []string
+	for _, u := range urls {
+		if !IsWebSocketURL(u) {
+			httpURLs = append(httpURLs, u)
+		}
+	}
+
+	return httpURLs
+}
+
+// WebSocketURL returns the first WebSocket URL of a list of node URLs, or an empty string if there is none
+func WebSocketURL(urls []string) string {
+	for _, u := range urls {
+		if IsWebSocketURL(u) {
+			return u
+		}
+	}
+
+	return ""
+}
+
+// IsPublicIP indicates whether an IP address is a unicast address reachable on the internet
+func IsPublicIP(ip net.IP) bool {
+	if ip == nil || ip.IsMulticast() {
+		return false
+	}
+
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsPublicURL indicates whether the host of a URL can be a public destination. Host names are only resolved when
+// connecting, so they must be checked again at that time
+func IsPublicURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Hostname() == "" {
+		return false
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return IsPublicIP(ip)
+	}
+
+	return true
+}
+
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, len(cidrs))
+	for idx, cidr := range cidrs {
+		_, networks[idx], _ = net.ParseCIDR(cidr)
+	}
+
+	return networks
+}
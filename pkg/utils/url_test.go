@@ -0,0 +1,50 @@
+// +build unit
+
+package utils
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsWebSocketURL(t *testing.T) {
+	assert.True(t, IsWebSocketURL("ws://localhost:8546"))
+	assert.True(t, IsWebSocketURL("WSS://mainnet.infura.io/ws/v3/key"))
+	assert.False(t, IsWebSocketURL("http://localhost:8545"))
+	assert.False(t, IsWebSocketURL("https://mainnet.infura.io/v3/key"))
+}
+
+func TestNodeURLs(t *testing.T) {
+	urls := []string{"http://localhost:8545", "ws://localhost:8546", "https://node:8545", "wss://node:8546"}
+
+	assert.Equal(t, []string{"http://localhost:8545", "https://node:8545"}, HTTPURLs(urls))
+	assert.Equal(t, "ws://localhost:8546", WebSocketURL(urls))
+	assert.Empty(t, WebSocketURL(HTTPURLs(urls)))
+}
+
+func TestIsPublicIP(t *testing.T) {
+	assert.True(t, IsPublicIP(net.ParseIP("8.8.8.8")))
+	assert.True(t, IsPublicIP(net.ParseIP("2001:4860:4860::8888")))
+	assert.False(t, IsPublicIP(net.ParseIP("127.0.0.1")))
+	assert.False(t, IsPublicIP(net.ParseIP("10.1.2.3")))
+	assert.False(t, IsPublicIP(net.ParseIP("172.20.0.1")))
+	assert.False(t, IsPublicIP(net.ParseIP("192.168.1.1")))
+	assert.False(t, IsPublicIP(net.ParseIP("169.254.169.254")))
+	assert.False(t, IsPublicIP(net.ParseIP("::1")))
+	assert.False(t, IsPublicIP(net.ParseIP("::ffff:127.0.0.1")))
+	assert.False(t, IsPublicIP(net.ParseIP("fe80::1")))
+	assert.False(t, IsPublicIP(net.ParseIP("fd00::1")))
+	assert.False(t, IsPublicIP(net.ParseIP("224.0.0.1")))
+}
+
+func TestIsPublicURL(t *testing.T) {
+	assert.True(t, IsPublicURL("https://example.com/events"))
+	assert.True(t, IsPublicURL("http://8.8.8.8:8080"))
+	assert.False(t, IsPublicURL("http://localhost:8080"))
+	assert.False(t, IsPublicURL("http://api.localhost"))
+	assert.False(t, IsPublicURL("http://169.254.169.254/latest/meta-data"))
+	assert.False(t, IsPublicURL("http://[::1]:8080"))
+	assert.False(t, IsPublicURL("not a url"))
+}
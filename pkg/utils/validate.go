@@ -140,6 +140,61 @@ func isPrivateTxManagerType(fl validator.FieldLevel) bool {
 	return true
 }
 
+func isGasOracleType(fl validator.FieldLevel) bool {
+	if fl.Field().String() != "" {
+		switch fl.Field().String() {
+		case string(entities.DefaultGasOracleType), string(entities.FeeHistoryGasOracleType),
+			string(entities.FixedGasOracleType), string(entities.HTTPGasOracleType):
+			return true
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func isSmartAccountType(fl validator.FieldLevel) bool {
+	if fl.Field().String() != "" {
+		switch fl.Field().String() {
+		case string(entities.SafeSmartAccountType), string(entities.ERC4337SmartAccountType):
+			return true
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func isTransactionPolicyType(fl validator.FieldLevel) bool {
+	if fl.Field().String() != "" {
+		switch fl.Field().String() {
+		case string(entities.DailyValueCapPolicyType), string(entities.AllowedRecipientsPolicyType),
+			string(entities.DeniedRecipientsPolicyType), string(entities.AllowedMethodsPolicyType),
+			string(entities.MaxGasPricePolicyType):
+			return true
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func isSubscriptionTargetType(fl validator.FieldLevel) bool {
+	if fl.Field().String() != "" {
+		switch fl.Field().String() {
+		case string(entities.KafkaSubscriptionTargetType), string(entities.WebhookSubscriptionTargetType):
+			return true
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
 func isPriority(fl validator.FieldLevel) bool {
 	if fl.Field().String() != "" {
 		switch fl.Field().String() {
@@ -184,7 +239,10 @@ func isJobStatus(fl validator.FieldLevel) bool {
 			entities.StatusMined,
 			entities.StatusFailed,
 			entities.StatusStored,
-			entities.StatusResending:
+			entities.StatusResending,
+			entities.StatusReorged,
+			entities.StatusScheduled,
+			entities.StatusAwaitingApproval:
 			return true
 		default:
 			return false
@@ -233,6 +291,10 @@ func init() {
 	_ = validate.RegisterValidation("isDuration", isDuration)
 	_ = validate.RegisterValidation("minDuration", minDuration)
 	_ = validate.RegisterValidation("isPrivateTxManagerType", isPrivateTxManagerType)
+	_ = validate.RegisterValidation("isGasOracleType", isGasOracleType)
+	_ = validate.RegisterValidation("isSmartAccountType", isSmartAccountType)
+	_ = validate.RegisterValidation("isSubscriptionTargetType", isSubscriptionTargetType)
+	_ = validate.RegisterValidation("isTransactionPolicyType", isTransactionPolicyType)
 	_ = validate.RegisterValidation("isPriority", isPriority)
 	_ = validate.RegisterValidation("isJobType", isJobType)
 	_ = validate.RegisterValidation("isJobStatus", isJobStatus)
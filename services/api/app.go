@@ -12,16 +12,18 @@ import (
 	"github.com/dgraph-io/ristretto"
 
 	"github.com/consensys/orchestrate/pkg/toolkit/ethclient"
+	"github.com/consensys/orchestrate/pkg/toolkit/nodehealth"
 
 	qkmclient "github.com/consensys/quorum-key-manager/pkg/client"
 
-	"github.com/Shopify/sarama"
+	"github.com/consensys/orchestrate/pkg/broker"
 	pkgsarama "github.com/consensys/orchestrate/pkg/broker/sarama"
 	pkgproxy "github.com/consensys/orchestrate/pkg/toolkit/app/http/handler/proxy"
 	"github.com/consensys/orchestrate/pkg/toolkit/database"
 	"github.com/consensys/orchestrate/services/api/business/builder"
 	"github.com/consensys/orchestrate/services/api/metrics"
 	"github.com/go-pg/pg/v9/orm"
+	healthz "github.com/heptiolabs/healthcheck"
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/auth"
@@ -38,7 +40,7 @@ func NewAPI(
 	keyManagerClient qkmclient.KeyManagerClient,
 	qkmStoreID string,
 	ec ethclient.Client,
-	syncProducer sarama.SyncProducer,
+	msgBroker broker.Broker,
 	topicCfg *pkgsarama.KafkaTopicConfig,
 ) (*app.App, error) {
 	db, err := multi.Build(context.Background(), cfg.Store, pgmngr)
@@ -53,13 +55,21 @@ func NewAPI(
 		appMetrics = metrics.NewTransactionSchedulerNopMetrics()
 	}
 
-	ucs := builder.NewUseCases(db, appMetrics, keyManagerClient, qkmStoreID, ec, syncProducer, topicCfg)
+	nodeHealth := nodehealth.NewRegistry(cfg.Proxy.NodeHealth)
+
+	subscriptionTenantTopics, err := parseTenantEntries(cfg.SubscriptionTenantTopics, "topic")
+	if err != nil {
+		return nil, err
+	}
+
+	ucs := builder.NewUseCases(db, appMetrics, keyManagerClient, qkmStoreID, ec, msgBroker.Producer(), topicCfg,
+		cfg.OutboxRelay.BatchSize, subscriptionTenantTopics)
 
 	// Option of the API
-	apiHandlerOpt := app.HandlerOpt(reflect.TypeOf(&dynamic.API{}), controllers.NewBuilder(ucs, keyManagerClient, qkmStoreID))
+	apiHandlerOpt := app.HandlerOpt(reflect.TypeOf(&dynamic.API{}), controllers.NewBuilder(ucs, keyManagerClient, qkmStoreID, nodeHealth))
 
 	// ReverseProxy Handler
-	proxyBuilder, err := pkgproxy.NewBuilder(cfg.Proxy.ServersTransport, nil)
+	proxyBuilder, err := pkgproxy.NewBuilder(cfg.Proxy.ServersTransport, nil, nodeHealth)
 	if err != nil {
 		return nil, err
 	}
@@ -96,21 +106,25 @@ func NewAPI(
 	return app.New(
 		cfg.App,
 		app.MultiTenancyOpt("auth", jwt, key, cfg.Multitenancy),
-		ReadinessOpt(db),
+		ReadinessOpt(db, msgBroker.Checker()),
 		app.MetricsOpt(appMetrics),
 		accessLogMid,
 		rateLimitOpt,
 		apiHandlerOpt,
 		httpCacheOpt,
 		reverseProxyOpt,
-		app.ProviderOpt(NewProvider(ucs.SearchChains(), time.Second, cfg.Proxy.ProxyCacheTTL, cfg.App.HTTP.AccessLog)),
+		app.ProviderOpt(NewProvider(ucs.SearchChains(), time.Second, cfg.Proxy.ProxyCacheTTL, cfg.App.HTTP.AccessLog, cfg.Proxy.NodeHealth.BalancingStrategy)),
+		DispatcherOpt(ucs.DispatchScheduledJobs(), cfg.DispatcherInterval),
+		OutboxRelayOpt(ucs.RelayOutboxMessages(), cfg.OutboxRelay.Interval),
+		TxRequestIngressOpt(cfg.TxRequestIngress, ucs, ucs.EnqueueMessage(), cfg.Multitenancy, msgBroker, topicCfg),
+		NodeMonitorOpt(ucs.SearchChains(), ec, nodeHealth, appMetrics, cfg.Proxy.NodeHealth),
 	)
 }
 
-func ReadinessOpt(db database.DB) app.Option {
+func ReadinessOpt(db database.DB, brokerChecker healthz.Check) app.Option {
 	return func(ap *app.App) error {
 		ap.AddReadinessCheck("database", postgres.Checker(db.(orm.DB)))
-		ap.AddReadinessCheck("kafka", pkgsarama.GlobalClientChecker())
+		ap.AddReadinessCheck("broker", brokerChecker)
 		return nil
 	}
 }
@@ -25,6 +25,7 @@ func TestApp(t *testing.T) {
 
 	cfg := NewConfig(viper.New())
 	cfg.Store.Type = "postgres"
+	cfg.OutboxRelay.Interval = outboxRelayIntervalDefault
 
 	kCfg := sarama.NewKafkaTopicConfig(viper.New())
 	_, err := NewAPI(
@@ -34,7 +35,7 @@ func TestApp(t *testing.T) {
 		mocks2.NewMockKeyManagerClient(ctrl),
 		"defaultStoreID",
 		ethclientmock.NewMockClient(ctrl),
-		mocks.NewSyncProducer(t, nil),
+		sarama.NewBroker(nil, mocks.NewSyncProducer(t, nil), nil),
 		kCfg,
 	)
 	assert.NoError(t, err, "Creating App should not error")
@@ -8,11 +8,12 @@ import (
 )
 
 type accountUseCases struct {
-	createAccountUC  usecases.CreateAccountUseCase
-	getAccountUC     usecases.GetAccountUseCase
-	deleteAccountUC  usecases.DeleteAccountUseCase
-	searchAccountsUC usecases.SearchAccountsUseCase
-	updateAccountUC  usecases.UpdateAccountUseCase
+	createAccountUC        usecases.CreateAccountUseCase
+	registerSmartAccountUC usecases.RegisterSmartAccountUseCase
+	getAccountUC           usecases.GetAccountUseCase
+	deleteAccountUC        usecases.DeleteAccountUseCase
+	searchAccountsUC       usecases.SearchAccountsUseCase
+	updateAccountUC        usecases.UpdateAccountUseCase
 }
 
 func newAccountUseCases(
@@ -26,11 +27,12 @@ func newAccountUseCases(
 	fundAccountUC := accounts.NewFundAccountUseCase(searchChainsUC, sendTxUC, getFaucetCandidateUC)
 
 	return &accountUseCases{
-		createAccountUC:  accounts.NewCreateAccountUseCase(db, searchAccountsUC, fundAccountUC, keyManagerClient),
-		deleteAccountUC:  accounts.NewDeleteAccountUseCase(db, keyManagerClient),
-		getAccountUC:     accounts.NewGetAccountUseCase(db),
-		searchAccountsUC: searchAccountsUC,
-		updateAccountUC:  accounts.NewUpdateAccountUseCase(db),
+		createAccountUC:        accounts.NewCreateAccountUseCase(db, searchAccountsUC, fundAccountUC, keyManagerClient),
+		registerSmartAccountUC: accounts.NewRegisterSmartAccountUseCase(db, searchAccountsUC),
+		deleteAccountUC:        accounts.NewDeleteAccountUseCase(db, keyManagerClient),
+		getAccountUC:           accounts.NewGetAccountUseCase(db),
+		searchAccountsUC:       searchAccountsUC,
+		updateAccountUC:        accounts.NewUpdateAccountUseCase(db),
 	}
 }
 
@@ -46,6 +48,10 @@ func (u *accountUseCases) CreateAccount() usecases.CreateAccountUseCase {
 	return u.createAccountUC
 }
 
+func (u *accountUseCases) RegisterSmartAccount() usecases.RegisterSmartAccountUseCase {
+	return u.registerSmartAccountUC
+}
+
 func (u *accountUseCases) UpdateAccount() usecases.UpdateAccountUseCase {
 	return u.updateAccountUC
 }
@@ -0,0 +1,39 @@
+package builder
+
+import (
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/business/use-cases/approvals"
+	"github.com/consensys/orchestrate/services/api/store"
+)
+
+type approvalPolicyUseCases struct {
+	createApprovalPolicyUC   usecases.CreateApprovalPolicyUseCase
+	getApprovalPolicyUC      usecases.GetApprovalPolicyUseCase
+	searchApprovalPoliciesUC usecases.SearchApprovalPoliciesUseCase
+	deleteApprovalPolicyUC   usecases.DeleteApprovalPolicyUseCase
+}
+
+func newApprovalPolicyUseCases(db store.DB) *approvalPolicyUseCases {
+	return &approvalPolicyUseCases{
+		createApprovalPolicyUC:   approvals.NewCreateApprovalPolicyUseCase(db),
+		getApprovalPolicyUC:      approvals.NewGetApprovalPolicyUseCase(db),
+		searchApprovalPoliciesUC: approvals.NewSearchApprovalPoliciesUseCase(db),
+		deleteApprovalPolicyUC:   approvals.NewDeleteApprovalPolicyUseCase(db),
+	}
+}
+
+func (u *approvalPolicyUseCases) CreateApprovalPolicy() usecases.CreateApprovalPolicyUseCase {
+	return u.createApprovalPolicyUC
+}
+
+func (u *approvalPolicyUseCases) GetApprovalPolicy() usecases.GetApprovalPolicyUseCase {
+	return u.getApprovalPolicyUC
+}
+
+func (u *approvalPolicyUseCases) SearchApprovalPolicies() usecases.SearchApprovalPoliciesUseCase {
+	return u.searchApprovalPoliciesUC
+}
+
+func (u *approvalPolicyUseCases) DeleteApprovalPolicy() usecases.DeleteApprovalPolicyUseCase {
+	return u.deleteApprovalPolicyUC
+}
@@ -13,9 +13,10 @@ type chainUseCases struct {
 	getChainUC      usecases.GetChainUseCase
 	searchChainsUC  usecases.SearchChainsUseCase
 	deleteChainUC   usecases.DeleteChainUseCase
+	callContractUC  usecases.CallContractUseCase
 }
 
-func newChainUseCases(db store.DB, ec ethclient.Client) *chainUseCases {
+func newChainUseCases(db store.DB, ec ethclient.Client, getContractUC usecases.GetContractUseCase) *chainUseCases {
 	searchChainsUC := chains.NewSearchChainsUseCase(db)
 	getChainUC := chains.NewGetChainUseCase(db)
 
@@ -25,6 +26,7 @@ func newChainUseCases(db store.DB, ec ethclient.Client) *chainUseCases {
 		getChainUC:      getChainUC,
 		searchChainsUC:  searchChainsUC,
 		deleteChainUC:   chains.NewDeleteChainUseCase(db, getChainUC),
+		callContractUC:  chains.NewCallContractUseCase(getChainUC, getContractUC, ec),
 	}
 }
 
@@ -47,3 +49,7 @@ func (u *chainUseCases) SearchChains() usecases.SearchChainsUseCase {
 func (u *chainUseCases) DeleteChain() usecases.DeleteChainUseCase {
 	return u.deleteChainUC
 }
+
+func (u *chainUseCases) CallContract() usecases.CallContractUseCase {
+	return u.callContractUC
+}
@@ -1,8 +1,9 @@
 package builder
 
 import (
-	"github.com/Shopify/sarama"
+	"github.com/consensys/orchestrate/pkg/broker"
 	pkgsarama "github.com/consensys/orchestrate/pkg/broker/sarama"
+	"github.com/consensys/orchestrate/pkg/toolkit/ethclient"
 	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
 	"github.com/consensys/orchestrate/services/api/business/use-cases/jobs"
 	"github.com/consensys/orchestrate/services/api/metrics"
@@ -10,36 +11,54 @@ import (
 )
 
 type jobUseCases struct {
-	createJob   usecases.CreateJobUseCase
-	getJob      usecases.GetJobUseCase
-	startJob    usecases.StartJobUseCase
-	resendJobTx usecases.ResendJobTxUseCase
-	retryTx     usecases.RetryJobTxUseCase
-	updateJob   usecases.UpdateJobUseCase
-	searchJobs  usecases.SearchJobsUseCase
+	createJob    usecases.CreateJobUseCase
+	getJob       usecases.GetJobUseCase
+	startJob     usecases.StartJobUseCase
+	startJobs    usecases.StartJobsUseCase
+	resendJobTx  usecases.ResendJobTxUseCase
+	retryTx      usecases.RetryJobTxUseCase
+	updateJob    usecases.UpdateJobUseCase
+	searchJobs   usecases.SearchJobsUseCase
+	dispatchJobs usecases.DispatchScheduledJobsUseCase
+	relayOutbox  usecases.RelayOutboxMessagesUseCase
+	enqueueMsg   usecases.EnqueueMessageUseCase
+	approveJob   usecases.ApproveJobUseCase
+	rejectJob    usecases.RejectJobUseCase
 }
 
 func newJobUseCases(
 	db store.DB,
 	appMetrics metrics.TransactionSchedulerMetrics,
-	producer sarama.SyncProducer,
+	producer broker.Producer,
 	topicsCfg *pkgsarama.KafkaTopicConfig,
 	getChainUC usecases.GetChainUseCase,
+	getContractUC usecases.GetContractUseCase,
+	enforceTxPoliciesUC usecases.EnforceTransactionPoliciesUseCase,
 	qkmStoreID string,
+	ec ethclient.Client,
+	outboxBatchSize int,
 ) *jobUseCases {
-	startJobUC := jobs.NewStartJobUseCase(db, producer, topicsCfg, appMetrics)
+	startJobUC := jobs.NewStartJobUseCase(db, topicsCfg, appMetrics)
 	updateChildrenUC := jobs.NewUpdateChildrenUseCase(db)
 	startNextJobUC := jobs.NewStartNextJobUseCase(db, startJobUC)
-	createJobUC := jobs.NewCreateJobUseCase(db, getChainUC, qkmStoreID)
+	createJobUC := jobs.NewCreateJobUseCase(db, getChainUC, enforceTxPoliciesUC, qkmStoreID)
+	updateDependentJobsUC := jobs.NewUpdateDependentJobsUseCase(db, getChainUC, getContractUC, startJobUC, enforceTxPoliciesUC, ec)
+	updateJobUC := jobs.NewUpdateJobUseCase(db, updateChildrenUC, startNextJobUC, updateDependentJobsUC, enforceTxPoliciesUC, appMetrics)
 
 	return &jobUseCases{
-		createJob:   createJobUC,
-		getJob:      jobs.NewGetJobUseCase(db),
-		searchJobs:  jobs.NewSearchJobsUseCase(db),
-		updateJob:   jobs.NewUpdateJobUseCase(db, updateChildrenUC, startNextJobUC, appMetrics),
-		startJob:    startJobUC,
-		resendJobTx: jobs.NewResendJobTxUseCase(db, producer, topicsCfg),
-		retryTx:     jobs.NewRetryJobTxUseCase(db, createJobUC, startJobUC),
+		createJob:    createJobUC,
+		getJob:       jobs.NewGetJobUseCase(db),
+		searchJobs:   jobs.NewSearchJobsUseCase(db),
+		updateJob:    updateJobUC,
+		startJob:     startJobUC,
+		startJobs:    jobs.NewStartJobsUseCase(db, topicsCfg, appMetrics),
+		resendJobTx:  jobs.NewResendJobTxUseCase(db, topicsCfg),
+		retryTx:      jobs.NewRetryJobTxUseCase(db, createJobUC, startJobUC),
+		dispatchJobs: jobs.NewDispatchScheduledJobsUseCase(db, getChainUC, startJobUC, ec),
+		relayOutbox:  jobs.NewRelayOutboxMessagesUseCase(db, producer, outboxBatchSize, appMetrics),
+		enqueueMsg:   jobs.NewEnqueueMessageUseCase(db),
+		approveJob:   jobs.NewApproveJobUseCase(db, startJobUC),
+		rejectJob:    jobs.NewRejectJobUseCase(db, updateJobUC),
 	}
 }
 
@@ -70,3 +89,23 @@ func (u *jobUseCases) UpdateJob() usecases.UpdateJobUseCase {
 func (u *jobUseCases) RetryTx() usecases.RetryJobTxUseCase {
 	return u.retryTx
 }
+
+func (u *jobUseCases) DispatchScheduledJobs() usecases.DispatchScheduledJobsUseCase {
+	return u.dispatchJobs
+}
+
+func (u *jobUseCases) RelayOutboxMessages() usecases.RelayOutboxMessagesUseCase {
+	return u.relayOutbox
+}
+
+func (u *jobUseCases) EnqueueMessage() usecases.EnqueueMessageUseCase {
+	return u.enqueueMsg
+}
+
+func (u *jobUseCases) ApproveJob() usecases.ApproveJobUseCase {
+	return u.approveJob
+}
+
+func (u *jobUseCases) RejectJob() usecases.RejectJobUseCase {
+	return u.rejectJob
+}
@@ -0,0 +1,45 @@
+package builder
+
+import (
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/business/use-cases/policies"
+	"github.com/consensys/orchestrate/services/api/store"
+)
+
+type transactionPolicyUseCases struct {
+	createTransactionPolicyUC    usecases.CreateTransactionPolicyUseCase
+	getTransactionPolicyUC       usecases.GetTransactionPolicyUseCase
+	searchTransactionPoliciesUC  usecases.SearchTransactionPoliciesUseCase
+	deleteTransactionPolicyUC    usecases.DeleteTransactionPolicyUseCase
+	enforceTransactionPoliciesUC usecases.EnforceTransactionPoliciesUseCase
+}
+
+func newTransactionPolicyUseCases(db store.DB) *transactionPolicyUseCases {
+	return &transactionPolicyUseCases{
+		createTransactionPolicyUC:    policies.NewCreateTransactionPolicyUseCase(db),
+		getTransactionPolicyUC:       policies.NewGetTransactionPolicyUseCase(db),
+		searchTransactionPoliciesUC:  policies.NewSearchTransactionPoliciesUseCase(db),
+		deleteTransactionPolicyUC:    policies.NewDeleteTransactionPolicyUseCase(db),
+		enforceTransactionPoliciesUC: policies.NewEnforceTransactionPoliciesUseCase(db),
+	}
+}
+
+func (u *transactionPolicyUseCases) CreateTransactionPolicy() usecases.CreateTransactionPolicyUseCase {
+	return u.createTransactionPolicyUC
+}
+
+func (u *transactionPolicyUseCases) GetTransactionPolicy() usecases.GetTransactionPolicyUseCase {
+	return u.getTransactionPolicyUC
+}
+
+func (u *transactionPolicyUseCases) SearchTransactionPolicies() usecases.SearchTransactionPoliciesUseCase {
+	return u.searchTransactionPoliciesUC
+}
+
+func (u *transactionPolicyUseCases) DeleteTransactionPolicy() usecases.DeleteTransactionPolicyUseCase {
+	return u.deleteTransactionPolicyUC
+}
+
+func (u *transactionPolicyUseCases) EnforceTransactionPolicies() usecases.EnforceTransactionPoliciesUseCase {
+	return u.enforceTransactionPoliciesUC
+}
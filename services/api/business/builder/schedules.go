@@ -7,14 +7,24 @@ import (
 )
 
 type scheduleUseCases struct {
-	createSchedule  usecases.CreateScheduleUseCase
-	getSchedule     usecases.GetScheduleUseCase
-	searchSchedules usecases.SearchSchedulesUseCase
+	createSchedule      usecases.CreateScheduleUseCase
+	createScheduleGraph usecases.CreateScheduleGraphUseCase
+	getSchedule         usecases.GetScheduleUseCase
+	searchSchedules     usecases.SearchSchedulesUseCase
 }
 
-func newScheduleUseCases(db store.DB) *scheduleUseCases {
+func newScheduleUseCases(
+	db store.DB,
+	searchChainsUC usecases.SearchChainsUseCase,
+	getContractUC usecases.GetContractUseCase,
+	jobUCs *jobUseCases,
+) *scheduleUseCases {
+	createScheduleUC := schedules.NewCreateScheduleUseCase(db)
+
 	return &scheduleUseCases{
-		createSchedule:  schedules.NewCreateScheduleUseCase(db),
+		createSchedule: createScheduleUC,
+		createScheduleGraph: schedules.NewCreateScheduleGraphUseCase(db, searchChainsUC, getContractUC, createScheduleUC,
+			jobUCs.CreateJob(), jobUCs.startJobs),
 		getSchedule:     schedules.NewGetScheduleUseCase(db),
 		searchSchedules: schedules.NewSearchSchedulesUseCase(db),
 	}
@@ -24,6 +34,10 @@ func (u *scheduleUseCases) CreateSchedule() usecases.CreateScheduleUseCase {
 	return u.createSchedule
 }
 
+func (u *scheduleUseCases) CreateScheduleGraph() usecases.CreateScheduleGraphUseCase {
+	return u.createScheduleGraph
+}
+
 func (u *scheduleUseCases) GetSchedule() usecases.GetScheduleUseCase {
 	return u.getSchedule
 }
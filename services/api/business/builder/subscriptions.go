@@ -0,0 +1,45 @@
+package builder
+
+import (
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/business/use-cases/subscriptions"
+	"github.com/consensys/orchestrate/services/api/store"
+)
+
+type subscriptionUseCases struct {
+	createSubscriptionUC  usecases.CreateSubscriptionUseCase
+	getSubscriptionUC     usecases.GetSubscriptionUseCase
+	searchSubscriptionsUC usecases.SearchSubscriptionsUseCase
+	updateSubscriptionUC  usecases.UpdateSubscriptionUseCase
+	deleteSubscriptionUC  usecases.DeleteSubscriptionUseCase
+}
+
+func newSubscriptionUseCases(db store.DB, tenantTopics map[string]string) *subscriptionUseCases {
+	return &subscriptionUseCases{
+		createSubscriptionUC:  subscriptions.NewCreateSubscriptionUseCase(db, tenantTopics),
+		getSubscriptionUC:     subscriptions.NewGetSubscriptionUseCase(db),
+		searchSubscriptionsUC: subscriptions.NewSearchSubscriptionsUseCase(db),
+		updateSubscriptionUC:  subscriptions.NewUpdateSubscriptionUseCase(db, tenantTopics),
+		deleteSubscriptionUC:  subscriptions.NewDeleteSubscriptionUseCase(db),
+	}
+}
+
+func (u *subscriptionUseCases) CreateSubscription() usecases.CreateSubscriptionUseCase {
+	return u.createSubscriptionUC
+}
+
+func (u *subscriptionUseCases) GetSubscription() usecases.GetSubscriptionUseCase {
+	return u.getSubscriptionUC
+}
+
+func (u *subscriptionUseCases) SearchSubscriptions() usecases.SearchSubscriptionsUseCase {
+	return u.searchSubscriptionsUC
+}
+
+func (u *subscriptionUseCases) UpdateSubscription() usecases.UpdateSubscriptionUseCase {
+	return u.updateSubscriptionUC
+}
+
+func (u *subscriptionUseCases) DeleteSubscription() usecases.DeleteSubscriptionUseCase {
+	return u.deleteSubscriptionUC
+}
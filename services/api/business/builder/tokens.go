@@ -0,0 +1,26 @@
+package builder
+
+import (
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/business/use-cases/tokens"
+)
+
+type tokenUseCases struct {
+	sendTokenTxUC usecases.SendTokenTxUseCase
+	callTokenUC   usecases.CallTokenUseCase
+}
+
+func newTokenUseCases(sendTxUC usecases.SendTxUseCase, callContractUC usecases.CallContractUseCase) *tokenUseCases {
+	return &tokenUseCases{
+		sendTokenTxUC: tokens.NewSendTokenTxUseCase(sendTxUC),
+		callTokenUC:   tokens.NewCallTokenUseCase(callContractUC),
+	}
+}
+
+func (u *tokenUseCases) SendTokenTransaction() usecases.SendTokenTxUseCase {
+	return u.sendTokenTxUC
+}
+
+func (u *tokenUseCases) CallToken() usecases.CallTokenUseCase {
+	return u.callTokenUC
+}
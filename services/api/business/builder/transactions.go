@@ -1,19 +1,22 @@
 package builder
 
 import (
+	"github.com/consensys/orchestrate/pkg/toolkit/ethclient"
 	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
 	"github.com/consensys/orchestrate/services/api/business/use-cases/transactions"
 	"github.com/consensys/orchestrate/services/api/store"
 )
 
 type transactionUseCases struct {
-	sendContractTransaction usecases.SendContractTxUseCase
-	sendDeployTransaction   usecases.SendDeployTxUseCase
-	sendTransaction         usecases.SendTxUseCase
-	getTransaction          usecases.GetTxUseCase
-	searchTransactions      usecases.SearchTransactionsUseCase
-	speedUp                 usecases.SpeedUpTxUseCase
-	callOff                 usecases.CallOffTxUseCase
+	sendContractTransaction  usecases.SendContractTxUseCase
+	sendContractTransactions usecases.SendContractTxsUseCase
+	sendDeployTransaction    usecases.SendDeployTxUseCase
+	sendTransaction          usecases.SendTxUseCase
+	getTransaction           usecases.GetTxUseCase
+	searchTransactions       usecases.SearchTransactionsUseCase
+	speedUp                  usecases.SpeedUpTxUseCase
+	callOff                  usecases.CallOffTxUseCase
+	simulateTransaction      usecases.SimulateTxUseCase
 }
 
 func newTransactionUseCases(
@@ -23,18 +26,22 @@ func newTransactionUseCases(
 	schedulesUCs *scheduleUseCases,
 	jobUCs *jobUseCases,
 	getContractUC usecases.GetContractUseCase,
+	ec ethclient.ContractCaller,
 ) *transactionUseCases {
 	getTransactionUC := transactions.NewGetTxUseCase(db, schedulesUCs.GetSchedule())
 	sendTxUC := transactions.NewSendTxUseCase(db, searchChainsUC, jobUCs.StartJob(), jobUCs.CreateJob(), getTransactionUC, getFaucetCandidateUC)
 
 	return &transactionUseCases{
 		sendContractTransaction: transactions.NewSendContractTxUseCase(sendTxUC, getContractUC),
-		sendDeployTransaction:   transactions.NewSendDeployTxUseCase(sendTxUC, getContractUC),
-		sendTransaction:         sendTxUC,
-		getTransaction:          getTransactionUC,
-		searchTransactions:      transactions.NewSearchTransactionsUseCase(db, getTransactionUC),
-		speedUp:                 transactions.NewSpeedUpTxUseCase(getTransactionUC, jobUCs.RetryTx()),
-		callOff:                 transactions.NewCallOffTxUseCase(getTransactionUC, jobUCs.RetryTx()),
+		sendContractTransactions: transactions.NewSendContractTxsUseCase(db, searchChainsUC, getContractUC, jobUCs.CreateJob(),
+			jobUCs.startJobs, getTransactionUC, getFaucetCandidateUC),
+		sendDeployTransaction: transactions.NewSendDeployTxUseCase(sendTxUC, getContractUC),
+		sendTransaction:       sendTxUC,
+		getTransaction:        getTransactionUC,
+		searchTransactions:    transactions.NewSearchTransactionsUseCase(db, getTransactionUC),
+		speedUp:               transactions.NewSpeedUpTxUseCase(getTransactionUC, jobUCs.RetryTx()),
+		callOff:               transactions.NewCallOffTxUseCase(getTransactionUC, jobUCs.RetryTx(), jobUCs.UpdateJob()),
+		simulateTransaction:   transactions.NewSimulateTxUseCase(searchChainsUC, getContractUC, ec),
 	}
 }
 
@@ -42,6 +49,10 @@ func (u *transactionUseCases) SendContractTransaction() usecases.SendContractTxU
 	return u.sendContractTransaction
 }
 
+func (u *transactionUseCases) SendContractTransactions() usecases.SendContractTxsUseCase {
+	return u.sendContractTransactions
+}
+
 func (u *transactionUseCases) SendDeployTransaction() usecases.SendDeployTxUseCase {
 	return u.sendDeployTransaction
 }
@@ -65,3 +76,7 @@ func (u *transactionUseCases) SpeedUp() usecases.SpeedUpTxUseCase {
 func (u *transactionUseCases) CallOff() usecases.CallOffTxUseCase {
 	return u.callOff
 }
+
+func (u *transactionUseCases) SimulateTransaction() usecases.SimulateTxUseCase {
+	return u.simulateTransaction
+}
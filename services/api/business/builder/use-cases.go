@@ -1,6 +1,7 @@
 package builder
 
 import (
+	"github.com/consensys/orchestrate/pkg/broker"
 	pkgsarama "github.com/consensys/orchestrate/pkg/broker/sarama"
 	"github.com/consensys/orchestrate/pkg/toolkit/ethclient"
 	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
@@ -8,7 +9,6 @@ import (
 	"github.com/consensys/orchestrate/services/api/metrics"
 	"github.com/consensys/orchestrate/services/api/store"
 	qkmclient "github.com/consensys/quorum-key-manager/pkg/client"
-	"github.com/Shopify/sarama"
 )
 
 type useCases struct {
@@ -19,6 +19,10 @@ type useCases struct {
 	*chainUseCases
 	*contractUseCases
 	*accountUseCases
+	*subscriptionUseCases
+	*tokenUseCases
+	*approvalPolicyUseCases
+	*transactionPolicyUseCases
 }
 
 func NewUseCases(
@@ -27,28 +31,36 @@ func NewUseCases(
 	keyManagerClient qkmclient.EthClient,
 	qkmStoreID string,
 	ec ethclient.Client,
-	producer sarama.SyncProducer,
+	producer broker.Producer,
 	topicsCfg *pkgsarama.KafkaTopicConfig,
+	outboxBatchSize int,
+	subscriptionTenantTopics map[string]string,
 ) usecases.UseCases {
 
-	chainUseCases := newChainUseCases(db, ec)
 	contractUseCases := newContractUseCases(db)
+	chainUseCases := newChainUseCases(db, ec, contractUseCases.GetContract())
 	faucetUseCases := newFaucetUseCases(db)
 	getFaucetCandidateUC := faucets.NewGetFaucetCandidateUseCase(faucetUseCases.SearchFaucets(), ec)
-	scheduleUseCases := newScheduleUseCases(db)
-	jobUseCases := newJobUseCases(db, appMetrics, producer, topicsCfg, chainUseCases.GetChain(), qkmStoreID)
-	transactionUseCases := newTransactionUseCases(db, chainUseCases.SearchChains(), getFaucetCandidateUC, 
-		scheduleUseCases, jobUseCases, contractUseCases.GetContract())
-	accountUseCases := newAccountUseCases(db, keyManagerClient, chainUseCases.SearchChains(), 
+	txPolicyUseCases := newTransactionPolicyUseCases(db)
+	jobUseCases := newJobUseCases(db, appMetrics, producer, topicsCfg, chainUseCases.GetChain(), contractUseCases.GetContract(),
+		txPolicyUseCases.EnforceTransactionPolicies(), qkmStoreID, ec, outboxBatchSize)
+	scheduleUseCases := newScheduleUseCases(db, chainUseCases.SearchChains(), contractUseCases.GetContract(), jobUseCases)
+	transactionUseCases := newTransactionUseCases(db, chainUseCases.SearchChains(), getFaucetCandidateUC,
+		scheduleUseCases, jobUseCases, contractUseCases.GetContract(), ec)
+	accountUseCases := newAccountUseCases(db, keyManagerClient, chainUseCases.SearchChains(),
 		transactionUseCases.SendTransaction(), getFaucetCandidateUC)
 
 	return &useCases{
-		jobUseCases:         jobUseCases,
-		scheduleUseCases:    scheduleUseCases,
-		transactionUseCases: transactionUseCases,
-		faucetUseCases:      faucetUseCases,
-		chainUseCases:       chainUseCases,
-		contractUseCases:    contractUseCases,
-		accountUseCases:     accountUseCases,
+		jobUseCases:               jobUseCases,
+		scheduleUseCases:          scheduleUseCases,
+		transactionUseCases:       transactionUseCases,
+		faucetUseCases:            faucetUseCases,
+		chainUseCases:             chainUseCases,
+		contractUseCases:          contractUseCases,
+		accountUseCases:           accountUseCases,
+		subscriptionUseCases:      newSubscriptionUseCases(db, subscriptionTenantTopics),
+		tokenUseCases:             newTokenUseCases(transactionUseCases.SendTransaction(), chainUseCases.CallContract()),
+		approvalPolicyUseCases:    newApprovalPolicyUseCases(db),
+		transactionPolicyUseCases: txPolicyUseCases,
 	}
 }
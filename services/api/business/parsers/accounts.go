@@ -17,6 +17,7 @@ func NewAccountModelFromEntities(account *entities.Account) *models.Account {
 		OwnerID:             account.OwnerID,
 		StoreID:             account.StoreID,
 		Attributes:          account.Attributes,
+		SmartAccount:        account.SmartAccount,
 		CreatedAt:           account.CreatedAt,
 		UpdatedAt:           account.UpdatedAt,
 	}
@@ -32,6 +33,7 @@ func NewAccountEntityFromModels(account *models.Account) *entities.Account {
 		OwnerID:             account.OwnerID,
 		StoreID:             account.StoreID,
 		Attributes:          account.Attributes,
+		SmartAccount:        account.SmartAccount,
 		CreatedAt:           account.CreatedAt,
 		UpdatedAt:           account.UpdatedAt,
 	}
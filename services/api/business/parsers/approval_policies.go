@@ -0,0 +1,53 @@
+package parsers
+
+import (
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func NewApprovalPolicyFromModel(policy *models.ApprovalPolicy) *entities.ApprovalPolicy {
+	policyEntity := &entities.ApprovalPolicy{
+		UUID:            policy.UUID,
+		Name:            policy.Name,
+		ChainUUID:       policy.ChainUUID,
+		MinValue:        utils.BigIntStringToHex(policy.MinValue),
+		MethodSignature: policy.MethodSignature,
+		Threshold:       policy.Threshold,
+		Approvers:       policy.Approvers,
+		TenantID:        policy.TenantID,
+		OwnerID:         policy.OwnerID,
+		CreatedAt:       policy.CreatedAt,
+		UpdatedAt:       policy.UpdatedAt,
+	}
+
+	if policy.Recipient != "" {
+		to := ethcommon.HexToAddress(policy.Recipient)
+		policyEntity.To = &to
+	}
+
+	return policyEntity
+}
+
+func NewApprovalPolicyModelFromEntity(policy *entities.ApprovalPolicy) *models.ApprovalPolicy {
+	policyModel := &models.ApprovalPolicy{
+		UUID:            policy.UUID,
+		Name:            policy.Name,
+		ChainUUID:       policy.ChainUUID,
+		MinValue:        utils.HexToBigIntString(policy.MinValue),
+		MethodSignature: policy.MethodSignature,
+		Threshold:       policy.Threshold,
+		Approvers:       policy.Approvers,
+		TenantID:        policy.TenantID,
+		OwnerID:         policy.OwnerID,
+		CreatedAt:       policy.CreatedAt,
+		UpdatedAt:       policy.UpdatedAt,
+	}
+
+	if policy.To != nil {
+		policyModel.Recipient = policy.To.Hex()
+	}
+
+	return policyModel
+}
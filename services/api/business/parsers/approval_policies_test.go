@@ -0,0 +1,21 @@
+// +build unit
+
+package parsers
+
+import (
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApprovalPoliciesParser(t *testing.T) {
+	policy := testutils.FakeApprovalPolicy()
+	to := ethcommon.HexToAddress("0x6230592812dE2E256D1512504c3E8A3C49975f07")
+	policy.To = &to
+	policyModel := NewApprovalPolicyModelFromEntity(policy)
+	finalPolicy := NewApprovalPolicyFromModel(policyModel)
+
+	assert.Equal(t, policy, finalPolicy)
+}
@@ -21,6 +21,8 @@ func NewChainFromModel(chainModel *models.Chain) *entities.Chain {
 		ListenerStartingBlock:     chainModel.ListenerStartingBlock,
 		ListenerBackOffDuration:   chainModel.ListenerBackOffDuration,
 		ListenerExternalTxEnabled: chainModel.ListenerExternalTxEnabled,
+		GasOracle:                 chainModel.GasOracle,
+		BundlerURL:                chainModel.BundlerURL,
 		Labels:                    chainModel.Labels,
 		Headers:                   chainModel.Headers,
 		CreatedAt:                 chainModel.CreatedAt,
@@ -56,6 +58,8 @@ func NewChainModelFromEntity(chain *entities.Chain) *models.Chain {
 		ListenerStartingBlock:     chain.ListenerStartingBlock,
 		ListenerBackOffDuration:   chain.ListenerBackOffDuration,
 		ListenerExternalTxEnabled: chain.ListenerExternalTxEnabled,
+		GasOracle:                 chain.GasOracle,
+		BundlerURL:                chain.BundlerURL,
 		Labels:                    chain.Labels,
 		Headers:                   chain.Headers,
 		CreatedAt:                 chain.CreatedAt,
@@ -1,10 +1,17 @@
 package parsers
 
 import (
+	"encoding"
 	"encoding/json"
+	"fmt"
+	"reflect"
 
+	"github.com/consensys/orchestrate/pkg/errors"
 	ethabi "github.com/consensys/orchestrate/pkg/ethereum/abi"
 	"github.com/consensys/orchestrate/pkg/types/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/umbracle/go-web3/abi"
 )
 
 // TODO: Remove this function as parsing the events from the ABI should not be done on Orchestrate as we do not have control on how the events are represented in the ABI
@@ -50,3 +57,156 @@ func ParseEvents(data string) (map[string]string, error) {
 
 	return events, nil
 }
+
+// TODO: We restrict the usage of web3-go to only generate the txData but ideally we should use it as much as possible and change the ABI type everywhere in the codebase
+
+// EncodeContractCall returns the data of a transaction calling the given method of a contract
+func EncodeContractCall(contract *entities.Contract, methodSignature string, args []interface{}) (hexutil.Bytes, error) {
+	web3ABI, err := abi.NewABI(contract.RawABI)
+	if err != nil {
+		return nil, errors.DataCorruptedError("failed to parse contract ABI for contract transaction")
+	}
+
+	method := web3ABI.GetMethodBySignature(methodSignature)
+	if method == nil {
+		return nil, errors.InvalidParameterError("method not found")
+	}
+
+	txData, err := method.Encode(args)
+	if err != nil {
+		return nil, errors.InvalidParameterError(err.Error())
+	}
+
+	return txData, nil
+}
+
+// DecodeContractCallResult returns the outputs of the given method of a contract, decoded from the result of a call
+func DecodeContractCallResult(contract *entities.Contract, methodSignature string, result []byte) (map[string]interface{}, error) {
+	web3ABI, err := abi.NewABI(contract.RawABI)
+	if err != nil {
+		return nil, errors.DataCorruptedError("failed to parse contract ABI for contract call")
+	}
+
+	method := web3ABI.GetMethodBySignature(methodSignature)
+	if method == nil {
+		return nil, errors.InvalidParameterError("method not found")
+	}
+
+	outputs := map[string]interface{}{}
+	if method.Outputs == nil || len(method.Outputs.TupleElems()) == 0 {
+		return outputs, nil
+	}
+
+	decoded, err := method.Decode(result)
+	if err != nil {
+		return nil, errors.EncodingError("failed to decode call result: %s", err.Error())
+	}
+
+	for key, value := range decoded {
+		outputs[key] = formatDecodedValue(reflect.ValueOf(value))
+	}
+
+	return outputs, nil
+}
+
+var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+
+// formatDecodedValue converts decoded ABI values to values with a readable JSON representation (byte arrays as hex
+// strings)
+func formatDecodedValue(value reflect.Value) interface{} {
+	if !value.IsValid() {
+		return nil
+	}
+
+	if value.Kind() == reflect.Interface {
+		return formatDecodedValue(value.Elem())
+	}
+
+	// Addresses, hashes and big integers already have a JSON representation
+	if value.Type().Implements(textMarshalerType) {
+		return value.Interface()
+	}
+
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, value.Len())
+			reflect.Copy(reflect.ValueOf(b), value)
+			return hexutil.Bytes(b)
+		}
+
+		values := make([]interface{}, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			values[i] = formatDecodedValue(value.Index(i))
+		}
+		return values
+	case reflect.Map:
+		values := make(map[string]interface{}, value.Len())
+		for _, key := range value.MapKeys() {
+			values[fmt.Sprintf("%v", key.Interface())] = formatDecodedValue(value.MapIndex(key))
+		}
+		return values
+	default:
+		return value.Interface()
+	}
+}
+
+// EncodeContractDeployment returns the data of a transaction deploying a contract, its bytecode linked to the given
+// libraries followed by the constructor arguments
+func EncodeContractDeployment(contract *entities.Contract, args []interface{}, libraries map[string]ethcommon.Address) (hexutil.Bytes, error) {
+	if len(contract.Bytecode) == 0 {
+		return nil, errors.DataCorruptedError("contract has no bytecode")
+	}
+
+	bytecode, err := linkLibraries(contract, libraries)
+	if err != nil {
+		return nil, err
+	}
+
+	web3ABI, err := abi.NewABI(contract.RawABI)
+	if err != nil {
+		return nil, errors.DataCorruptedError("failed to parse contract ABI")
+	}
+
+	var arguments []byte
+	if web3ABI.Constructor != nil { // It is possible to create a smart contract without constructor
+		arguments, err = abi.Encode(args, web3ABI.Constructor.Inputs)
+		if err != nil {
+			return nil, errors.InvalidParameterError(err.Error())
+		}
+	}
+
+	txData := make(hexutil.Bytes, 0, len(bytecode)+len(arguments))
+	return append(append(txData, bytecode...), arguments...), nil
+}
+
+// linkLibraries returns the bytecode of the contract with the addresses of its libraries, indexed either by library
+// name or by fully qualified name ("contracts/SafeMath.sol:SafeMath")
+func linkLibraries(contract *entities.Contract, libraries map[string]ethcommon.Address) (hexutil.Bytes, error) {
+	if len(contract.LinkReferences) == 0 {
+		return contract.Bytecode, nil
+	}
+
+	bytecode := make(hexutil.Bytes, len(contract.Bytecode))
+	copy(bytecode, contract.Bytecode)
+	for source, references := range contract.LinkReferences {
+		for name, offsets := range references {
+			address, ok := libraries[source+":"+name]
+			if !ok {
+				address, ok = libraries[name]
+			}
+			if !ok {
+				return nil, errors.InvalidParameterError("missing address of library %s", name)
+			}
+
+			for _, offset := range offsets {
+				if offset.Length != ethcommon.AddressLength || offset.Start < 0 || offset.Start+offset.Length > len(bytecode) {
+					return nil, errors.DataCorruptedError("invalid link reference of library %s", name)
+				}
+				copy(bytecode[offset.Start:], address.Bytes())
+			}
+		}
+	}
+
+	return bytecode, nil
+}
@@ -0,0 +1,104 @@
+// +build unit
+
+package parsers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const callABI = `[
+	{"constant":true,"inputs":[{"name":"account","type":"address"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"type":"function"},
+	{"constant":true,"inputs":[],"name":"info","outputs":[{"name":"owner","type":"address"},{"name":"id","type":"bytes32"},{"name":"active","type":"bool"}],"type":"function"},
+	{"constant":false,"inputs":[],"name":"reset","outputs":[],"type":"function"}
+]`
+
+func TestDecodeContractCallResult(t *testing.T) {
+	contract := &entities.Contract{RawABI: callABI}
+
+	t.Run("should decode unnamed outputs by position", func(t *testing.T) {
+		result := hexutil.MustDecode("0x00000000000000000000000000000000000000000000000000000000000003e8")
+
+		outputs, err := DecodeContractCallResult(contract, "balanceOf(address)", result)
+
+		require.NoError(t, err)
+		bOutputs, _ := json.Marshal(outputs)
+		assert.JSONEq(t, `{"0":1000}`, string(bOutputs))
+	})
+
+	t.Run("should decode named outputs", func(t *testing.T) {
+		result := hexutil.MustDecode("0x" +
+			"0000000000000000000000001abae27a0cbfb02945720425d3b80c7e09728534" +
+			"0102030000000000000000000000000000000000000000000000000000000000" +
+			"0000000000000000000000000000000000000000000000000000000000000001")
+
+		outputs, err := DecodeContractCallResult(contract, "info()", result)
+
+		require.NoError(t, err)
+		bOutputs, _ := json.Marshal(outputs)
+		assert.JSONEq(t, `{
+			"owner":"0x1aBAe27a0cBfb02945720425D3B80C7E09728534",
+			"id":"0x0102030000000000000000000000000000000000000000000000000000000000",
+			"active":true
+		}`, string(bOutputs))
+	})
+
+	t.Run("should return empty outputs if method has no outputs", func(t *testing.T) {
+		outputs, err := DecodeContractCallResult(contract, "reset()", nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, outputs)
+	})
+
+	t.Run("should fail with InvalidParameterError if method is not found", func(t *testing.T) {
+		_, err := DecodeContractCallResult(contract, "unknown()", nil)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail if result cannot be decoded", func(t *testing.T) {
+		_, err := DecodeContractCallResult(contract, "balanceOf(address)", hexutil.MustDecode("0x01"))
+
+		assert.Error(t, err)
+	})
+}
+
+func TestEncodeContractDeployment(t *testing.T) {
+	library := ethcommon.HexToAddress("0x1abae27a0cbfb02945720425d3b80c7e09728534")
+	contract := &entities.Contract{
+		RawABI:   `[{"inputs":[],"stateMutability":"nonpayable","type":"constructor"}]`,
+		Bytecode: hexutil.MustDecode("0x6080604052" + "0000000000000000000000000000000000000000" + "6000"),
+		LinkReferences: entities.LinkReferences{
+			"contracts/SafeMath.sol": {"SafeMath": {{Start: 5, Length: 20}}},
+		},
+	}
+	expectedTxData := hexutil.MustDecode("0x6080604052" + "1abae27a0cbfb02945720425d3b80c7e09728534" + "6000")
+
+	t.Run("should link libraries by name", func(t *testing.T) {
+		txData, err := EncodeContractDeployment(contract, nil, map[string]ethcommon.Address{"SafeMath": library})
+
+		require.NoError(t, err)
+		assert.Equal(t, hexutil.Bytes(expectedTxData), txData)
+		assert.NotEqual(t, contract.Bytecode, txData, "registered bytecode should not be modified")
+	})
+
+	t.Run("should link libraries by fully qualified name", func(t *testing.T) {
+		txData, err := EncodeContractDeployment(contract, nil, map[string]ethcommon.Address{"contracts/SafeMath.sol:SafeMath": library})
+
+		require.NoError(t, err)
+		assert.Equal(t, hexutil.Bytes(expectedTxData), txData)
+	})
+
+	t.Run("should fail with InvalidParameterError if a library is missing", func(t *testing.T) {
+		_, err := EncodeContractDeployment(contract, nil, nil)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
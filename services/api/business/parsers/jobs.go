@@ -15,6 +15,8 @@ func NewJobModelFromEntities(job *entities.Job, scheduleID *int) *models.Job {
 		InternalData: job.InternalData,
 		ScheduleID:   scheduleID,
 		Status:       job.Status,
+		RevertReason: job.RevertReason,
+		Events:       job.Events,
 		Schedule: &models.Schedule{
 			UUID:     job.ScheduleUUID,
 			TenantID: job.TenantID,
@@ -59,6 +61,8 @@ func NewJobEntityFromModels(jobModel *models.Job) *entities.Job {
 		CreatedAt:    jobModel.CreatedAt,
 		UpdatedAt:    jobModel.UpdatedAt,
 		Status:       jobModel.Status,
+		RevertReason: jobModel.RevertReason,
+		Events:       jobModel.Events,
 	}
 
 	if jobModel.Schedule != nil {
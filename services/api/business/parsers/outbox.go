@@ -0,0 +1,24 @@
+package parsers
+
+import (
+	"github.com/consensys/orchestrate/pkg/broker"
+	"github.com/consensys/orchestrate/services/api/store/models"
+)
+
+func NewOutboxMessageModel(msg *broker.Message, jobUUID string) *models.OutboxMessage {
+	return &models.OutboxMessage{
+		Topic:   msg.Topic,
+		JobUUID: jobUUID,
+		Key:     msg.Key,
+		Value:   msg.Value,
+	}
+}
+
+func NewBrokerMessageFromOutboxModel(outboxMsg *models.OutboxMessage) *broker.Message {
+	return &broker.Message{
+		Topic:    outboxMsg.Topic,
+		Key:      outboxMsg.Key,
+		Value:    outboxMsg.Value,
+		Metadata: outboxMsg.ID,
+	}
+}
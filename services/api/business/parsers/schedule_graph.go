@@ -0,0 +1,78 @@
+package parsers
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/ethereum"
+)
+
+// Matches references to receipt fields of other jobs, such as ${deployRegistry.receipt.contractAddress}
+var jobReferenceRegexp = regexp.MustCompile(`\$\{([A-Za-z0-9_-]+)\.receipt\.([A-Za-z]+)\}`)
+
+// NewJobReference returns the reference to a receipt field of a job
+func NewJobReference(jobID, field string) string {
+	return fmt.Sprintf("${%s.receipt.%s}", jobID, field)
+}
+
+// ReplaceJobReferences replaces every reference to a receipt field of a job in a string, or recursively in the items
+// of an array or an object, by the value returned by replace
+func ReplaceJobReferences(value interface{}, replace func(jobID, field string) (string, error)) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		var err error
+		replaced := jobReferenceRegexp.ReplaceAllStringFunc(v, func(reference string) string {
+			matches := jobReferenceRegexp.FindStringSubmatch(reference)
+			result, der := replace(matches[1], matches[2])
+			if der != nil && err == nil {
+				err = der
+			}
+			return result
+		})
+		if err != nil {
+			return nil, err
+		}
+		return replaced, nil
+	case []interface{}:
+		items := make([]interface{}, len(v))
+		for idx, item := range v {
+			replaced, err := ReplaceJobReferences(item, replace)
+			if err != nil {
+				return nil, err
+			}
+			items[idx] = replaced
+		}
+		return items, nil
+	case map[string]interface{}:
+		fields := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			replaced, err := ReplaceJobReferences(item, replace)
+			if err != nil {
+				return nil, err
+			}
+			fields[key] = replaced
+		}
+		return fields, nil
+	default:
+		return value, nil
+	}
+}
+
+// GetReceiptField returns the value of a receipt field that can be referenced by other jobs
+func GetReceiptField(receipt *ethereum.Receipt, field string) (string, error) {
+	switch field {
+	case entities.ReceiptContractAddress:
+		return receipt.ContractAddress, nil
+	case entities.ReceiptTxHash:
+		return receipt.TxHash, nil
+	case entities.ReceiptBlockHash:
+		return receipt.BlockHash, nil
+	case entities.ReceiptBlockNumber:
+		return strconv.FormatUint(receipt.BlockNumber, 10), nil
+	default:
+		return "", errors.InvalidParameterError("receipt field '%s' cannot be referenced", field)
+	}
+}
@@ -0,0 +1,62 @@
+// +build unit
+
+package parsers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/ethereum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplaceJobReferences(t *testing.T) {
+	replace := func(jobID, field string) (string, error) {
+		return fmt.Sprintf("%s-%s", jobID, field), nil
+	}
+
+	t.Run("should replace references in nested values", func(t *testing.T) {
+		value := []interface{}{
+			"${deploy.receipt.contractAddress}",
+			"prefix ${deploy.receipt.txHash}",
+			map[string]interface{}{"key": []interface{}{"${other-job.receipt.blockNumber}", 10}},
+		}
+
+		result, err := ReplaceJobReferences(value, replace)
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{
+			"deploy-contractAddress",
+			"prefix deploy-txHash",
+			map[string]interface{}{"key": []interface{}{"other-job-blockNumber", 10}},
+		}, result)
+	})
+
+	t.Run("should fail with same error if replace fails", func(t *testing.T) {
+		expectedErr := fmt.Errorf("error")
+		_, err := ReplaceJobReferences("${deploy.receipt.contractAddress}", func(string, string) (string, error) {
+			return "", expectedErr
+		})
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestGetReceiptField(t *testing.T) {
+	receipt := &ethereum.Receipt{
+		ContractAddress: "0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18",
+		TxHash:          "0x0a0cafa26ca3f411e6629e9e02c53f23713b0033d7a72e534136104b5447a210",
+		BlockNumber:     10,
+	}
+
+	value, err := GetReceiptField(receipt, entities.ReceiptContractAddress)
+	require.NoError(t, err)
+	assert.Equal(t, receipt.ContractAddress, value)
+
+	value, err = GetReceiptField(receipt, entities.ReceiptBlockNumber)
+	require.NoError(t, err)
+	assert.Equal(t, "10", value)
+
+	_, err = GetReceiptField(receipt, "logs")
+	assert.Error(t, err)
+}
@@ -0,0 +1,60 @@
+package parsers
+
+import (
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func NewSubscriptionFromModel(subscription *models.Subscription) *entities.Subscription {
+	var topicFilters [][]ethcommon.Hash
+	for _, values := range subscription.TopicFilters {
+		hashes := make([]ethcommon.Hash, len(values))
+		for idx, value := range values {
+			hashes[idx] = ethcommon.HexToHash(value)
+		}
+		topicFilters = append(topicFilters, hashes)
+	}
+
+	return &entities.Subscription{
+		UUID:            subscription.UUID,
+		ChainUUID:       subscription.ChainUUID,
+		ContractAddress: ethcommon.HexToAddress(subscription.ContractAddress),
+		EventSignature:  subscription.EventSignature,
+		TopicFilters:    topicFilters,
+		Target:          subscription.Target,
+		TenantID:        subscription.TenantID,
+		OwnerID:         subscription.OwnerID,
+		CreatedAt:       subscription.CreatedAt,
+		UpdatedAt:       subscription.UpdatedAt,
+	}
+}
+
+func NewSubscriptionModelFromEntity(subscription *entities.Subscription) *models.Subscription {
+	var topicFilters [][]string
+	for _, hashes := range subscription.TopicFilters {
+		values := make([]string, len(hashes))
+		for idx, hash := range hashes {
+			values[idx] = hash.Hex()
+		}
+		topicFilters = append(topicFilters, values)
+	}
+
+	subscriptionModel := &models.Subscription{
+		UUID:           subscription.UUID,
+		ChainUUID:      subscription.ChainUUID,
+		EventSignature: subscription.EventSignature,
+		TopicFilters:   topicFilters,
+		Target:         subscription.Target,
+		TenantID:       subscription.TenantID,
+		OwnerID:        subscription.OwnerID,
+		CreatedAt:      subscription.CreatedAt,
+		UpdatedAt:      subscription.UpdatedAt,
+	}
+
+	if subscription.ContractAddress != (ethcommon.Address{}) {
+		subscriptionModel.ContractAddress = subscription.ContractAddress.Hex()
+	}
+
+	return subscriptionModel
+}
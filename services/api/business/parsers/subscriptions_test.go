@@ -0,0 +1,18 @@
+// +build unit
+
+package parsers
+
+import (
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscriptionsParser(t *testing.T) {
+	subscription := testutils.FakeSubscription()
+	subscriptionModel := NewSubscriptionModelFromEntity(subscription)
+	finalSubscription := NewSubscriptionFromModel(subscriptionModel)
+
+	assert.Equal(t, subscription, finalSubscription)
+}
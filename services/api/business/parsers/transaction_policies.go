@@ -0,0 +1,70 @@
+package parsers
+
+import (
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func NewTransactionPolicyFromModel(policy *models.TransactionPolicy) *entities.TransactionPolicy {
+	policyEntity := &entities.TransactionPolicy{
+		UUID:             policy.UUID,
+		Name:             policy.Name,
+		Type:             entities.TransactionPolicyType(policy.Type),
+		ChainUUID:        policy.ChainUUID,
+		MaxDailyValue:    utils.BigIntStringToHex(policy.MaxDailyValue),
+		MethodSignatures: policy.MethodSignatures,
+		MaxGasPrice:      utils.BigIntStringToHex(policy.MaxGasPrice),
+		TenantID:         policy.TenantID,
+		OwnerID:          policy.OwnerID,
+		CreatedAt:        policy.CreatedAt,
+		UpdatedAt:        policy.UpdatedAt,
+	}
+
+	if policy.Account != "" {
+		account := ethcommon.HexToAddress(policy.Account)
+		policyEntity.Account = &account
+	}
+
+	if policy.Contract != "" {
+		contract := ethcommon.HexToAddress(policy.Contract)
+		policyEntity.Contract = &contract
+	}
+
+	for _, address := range policy.Addresses {
+		policyEntity.Addresses = append(policyEntity.Addresses, ethcommon.HexToAddress(address))
+	}
+
+	return policyEntity
+}
+
+func NewTransactionPolicyModelFromEntity(policy *entities.TransactionPolicy) *models.TransactionPolicy {
+	policyModel := &models.TransactionPolicy{
+		UUID:             policy.UUID,
+		Name:             policy.Name,
+		Type:             string(policy.Type),
+		ChainUUID:        policy.ChainUUID,
+		MaxDailyValue:    utils.HexToBigIntString(policy.MaxDailyValue),
+		MethodSignatures: policy.MethodSignatures,
+		MaxGasPrice:      utils.HexToBigIntString(policy.MaxGasPrice),
+		TenantID:         policy.TenantID,
+		OwnerID:          policy.OwnerID,
+		CreatedAt:        policy.CreatedAt,
+		UpdatedAt:        policy.UpdatedAt,
+	}
+
+	if policy.Account != nil {
+		policyModel.Account = policy.Account.Hex()
+	}
+
+	if policy.Contract != nil {
+		policyModel.Contract = policy.Contract.Hex()
+	}
+
+	for _, address := range policy.Addresses {
+		policyModel.Addresses = append(policyModel.Addresses, address.Hex())
+	}
+
+	return policyModel
+}
@@ -0,0 +1,41 @@
+// +build unit
+
+package parsers
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionPoliciesParser(t *testing.T) {
+	t.Run("should parse daily value cap policies", func(t *testing.T) {
+		policy := testutils.FakeTransactionPolicy()
+		account := ethcommon.HexToAddress("0x6230592812dE2E256D1512504c3E8A3C49975f07")
+		policy.Account = &account
+		policyModel := NewTransactionPolicyModelFromEntity(policy)
+		finalPolicy := NewTransactionPolicyFromModel(policyModel)
+
+		assert.Equal(t, policy, finalPolicy)
+	})
+
+	t.Run("should parse allowed methods policies", func(t *testing.T) {
+		policy := testutils.FakeTransactionPolicy()
+		contract := ethcommon.HexToAddress("0x6230592812dE2E256D1512504c3E8A3C49975f07")
+		policy.Type = entities.AllowedMethodsPolicyType
+		policy.MaxDailyValue = nil
+		policy.Contract = &contract
+		policy.MethodSignatures = []string{"transfer(address,uint256)"}
+		policy.Addresses = []ethcommon.Address{ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18")}
+		policy.MaxGasPrice = (*hexutil.Big)(big.NewInt(100))
+		policyModel := NewTransactionPolicyModelFromEntity(policy)
+		finalPolicy := NewTransactionPolicyFromModel(policyModel)
+
+		assert.Equal(t, policy, finalPolicy)
+	})
+}
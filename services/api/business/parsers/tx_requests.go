@@ -28,6 +28,7 @@ func NewJobEntitiesFromTxRequest(txRequest *entities.TxRequest, chainUUID string
 		privTxJob := newJobEntityFromTxRequest(txRequest, newEthTransactionFromParams(txRequest.Params, txData, entities.LegacyTxType), entities.EEAPrivateTransaction, chainUUID)
 		markingTxJob := newJobEntityFromTxRequest(txRequest, &entities.ETHTransaction{}, entities.EEAMarkingTransaction, chainUUID)
 		markingTxJob.InternalData.OneTimeKey = true
+		clearExecutionConditions(markingTxJob)
 		jobs = append(jobs, privTxJob, markingTxJob)
 	case txRequest.Params.Protocol == entities.TesseraChainType:
 		privTxJob := newJobEntityFromTxRequest(txRequest, newEthTransactionFromParams(txRequest.Params, txData, entities.LegacyTxType),
@@ -43,6 +44,7 @@ func NewJobEntitiesFromTxRequest(txRequest *entities.TxRequest, chainUUID string
 			markingTx.From = txRequest.Params.From
 		}
 		markingTxJob := newJobEntityFromTxRequest(txRequest, markingTx, entities.TesseraMarkingTransaction, chainUUID)
+		clearExecutionConditions(markingTxJob)
 		jobs = append(jobs, privTxJob, markingTxJob)
 	case txRequest.Params.Raw != nil:
 		rawTx, err := newTransactionFromRaw(txRequest.Params.Raw)
@@ -103,6 +105,13 @@ func newJobEntityFromTxRequest(txRequest *entities.TxRequest, ethTx *entities.ET
 	}
 }
 
+// Only the first job of a transaction request waits for the execution conditions, next jobs start as soon as the
+// previous one is done
+func clearExecutionConditions(job *entities.Job) {
+	job.InternalData.NotBefore = nil
+	job.InternalData.NotBeforeBlock = nil
+}
+
 func newTransactionFromRaw(raw hexutil.Bytes) (*entities.ETHTransaction, error) {
 	tx := &types.Transaction{}
 
@@ -81,6 +81,19 @@ func TestParsersTxRequest_NewEEAJobEntityFromSendTx(t *testing.T) {
 	assert.True(t, markingJob.InternalData.OneTimeKey)
 }
 
+func TestParsersTxRequest_NewScheduledJobEntitiesFromSendTx(t *testing.T) {
+	txReqEntity := testutils.FakeTxRequest()
+	txReqEntity.Params.Protocol = entities.EEAChainType
+	notBeforeBlock := uint64(100)
+	txReqEntity.InternalData.NotBeforeBlock = &notBeforeBlock
+	jobs, _ := NewJobEntitiesFromTxRequest(txReqEntity, "chainUUID", hexutil.MustDecode("0x0ABC"))
+	assert.Len(t, jobs, 2)
+
+	assert.Equal(t, &notBeforeBlock, jobs[0].InternalData.NotBeforeBlock)
+	assert.Nil(t, jobs[1].InternalData.NotBeforeBlock)
+	assert.Equal(t, &notBeforeBlock, txReqEntity.InternalData.NotBeforeBlock)
+}
+
 func TestParsersTxRequest_NewTesseraJobEntityFromSendTx(t *testing.T) {
 	txReqEntity := testutils.FakeTxRequest()
 	txReqEntity.Params.Protocol = entities.TesseraChainType
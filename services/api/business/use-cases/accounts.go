@@ -15,6 +15,7 @@ type AccountUseCases interface {
 	GetAccount() GetAccountUseCase
 	DeleteAccount() DeleteAccountUseCase
 	CreateAccount() CreateAccountUseCase
+	RegisterSmartAccount() RegisterSmartAccountUseCase
 	UpdateAccount() UpdateAccountUseCase
 	SearchAccounts() SearchAccountsUseCase
 }
@@ -31,6 +32,10 @@ type CreateAccountUseCase interface {
 	Execute(ctx context.Context, identity *entities.Account, privateKey hexutil.Bytes, chainName string, userInfo *multitenancy.UserInfo) (*entities.Account, error)
 }
 
+type RegisterSmartAccountUseCase interface {
+	Execute(ctx context.Context, acc *entities.Account, userInfo *multitenancy.UserInfo) (*entities.Account, error)
+}
+
 type SearchAccountsUseCase interface {
 	Execute(ctx context.Context, filters *entities.AccountFilters, userInfo *multitenancy.UserInfo) ([]*entities.Account, error)
 }
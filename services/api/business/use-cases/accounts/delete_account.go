@@ -43,6 +43,12 @@ func (uc *deleteAccountUseCase) Execute(ctx context.Context, address ethcommon.A
 		return errors.FromError(err).ExtendComponent(deleteAccountComponent)
 	}
 
+	// Smart accounts have no key in the key manager, the key of their signer is kept
+	if model.SmartAccount != nil {
+		logger.Info("smart account deleted successfully")
+		return nil
+	}
+
 	// First, we soft delete the account
 	err = uc.keyManagerClient.DeleteEthAccount(ctx, model.StoreID, model.Address)
 	if err != nil {
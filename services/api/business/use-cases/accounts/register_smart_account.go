@@ -0,0 +1,95 @@
+package accounts
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+)
+
+const registerSmartAccountComponent = "use-cases.register-smart-account"
+
+type registerSmartAccountUseCase struct {
+	db       store.DB
+	searchUC usecases.SearchAccountsUseCase
+	logger   *log.Logger
+}
+
+func NewRegisterSmartAccountUseCase(db store.DB, searchUC usecases.SearchAccountsUseCase) usecases.RegisterSmartAccountUseCase {
+	return &registerSmartAccountUseCase{
+		db:       db,
+		searchUC: searchUC,
+		logger:   log.NewLogger().SetComponent(registerSmartAccountComponent),
+	}
+}
+
+// Execute registers a deployed smart account, its transactions are signed by an existing account of the tenant
+func (uc *registerSmartAccountUseCase) Execute(ctx context.Context, acc *entities.Account, userInfo *multitenancy.UserInfo) (*entities.Account, error) {
+	ctx = log.WithFields(ctx, log.Field("alias", acc.Alias), log.Field("address", acc.Address))
+	logger := uc.logger.WithContext(ctx)
+
+	logger.Debug("registering smart account")
+
+	if acc.Alias != "" {
+		accounts, err := uc.searchUC.Execute(ctx,
+			&entities.AccountFilters{Aliases: []string{acc.Alias}, TenantID: userInfo.TenantID},
+			userInfo)
+		if err != nil {
+			return nil, errors.FromError(err).ExtendComponent(registerSmartAccountComponent)
+		}
+
+		if len(accounts) > 0 {
+			errMsg := "alias already exists"
+			logger.Error(errMsg)
+			return nil, errors.AlreadyExistsError(errMsg).ExtendComponent(registerSmartAccountComponent)
+		}
+	}
+
+	existingAcc, err := uc.db.Account().FindOneByAddress(ctx, acc.Address.Hex(), userInfo.AllowedTenants, userInfo.Username)
+	if err != nil && !errors.IsNotFoundError(err) {
+		errMsg := "failed to get account"
+		logger.WithError(err).Error(errMsg)
+		return nil, errors.FromError(err).ExtendComponent(registerSmartAccountComponent)
+	}
+
+	if existingAcc != nil {
+		errMsg := "account already exists"
+		logger.Error(errMsg)
+		return nil, errors.AlreadyExistsError(errMsg).ExtendComponent(registerSmartAccountComponent)
+	}
+
+	signer, err := uc.db.Account().FindOneByAddress(ctx, acc.SmartAccount.Signer.Hex(), userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			errMsg := "signer account does not exist"
+			logger.WithField("signer", acc.SmartAccount.Signer).Error(errMsg)
+			return nil, errors.InvalidParameterError(errMsg).ExtendComponent(registerSmartAccountComponent)
+		}
+
+		return nil, errors.FromError(err).ExtendComponent(registerSmartAccountComponent)
+	}
+
+	if signer.SmartAccount != nil {
+		errMsg := "signer cannot be a smart account"
+		logger.WithField("signer", acc.SmartAccount.Signer).Error(errMsg)
+		return nil, errors.InvalidParameterError(errMsg).ExtendComponent(registerSmartAccountComponent)
+	}
+
+	acc.StoreID = signer.StoreID
+	acc.TenantID = userInfo.TenantID
+	acc.OwnerID = userInfo.Username
+
+	accountModel := parsers.NewAccountModelFromEntities(acc)
+	err = uc.db.Account().Insert(ctx, accountModel)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(registerSmartAccountComponent)
+	}
+
+	logger.WithField("type", acc.SmartAccount.Type).Info("smart account registered successfully")
+	return parsers.NewAccountEntityFromModels(accountModel), nil
+}
@@ -0,0 +1,142 @@
+// +build unit
+
+package accounts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	mocks2 "github.com/consensys/orchestrate/services/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	testutils2 "github.com/consensys/orchestrate/services/api/store/models/testutils"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterSmartAccount_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	accountAgent := mocks.NewMockAccountAgent(ctrl)
+	mockSearchUC := mocks2.NewMockSearchAccountsUseCase(ctrl)
+
+	mockDB.EXPECT().Account().Return(accountAgent).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewRegisterSmartAccountUseCase(mockDB, mockSearchUC)
+
+	signer := ethcommon.HexToAddress("0x5bd1b3b1d4bd1e0e7b8e8e27a1c5dd7e9b1bf8c3")
+	newSmartAccount := func() *entities.Account {
+		accEntity := testutils.FakeAccount()
+		accEntity.StoreID = ""
+		accEntity.PublicKey = nil
+		accEntity.CompressedPublicKey = nil
+		accEntity.SmartAccount = &entities.SmartAccount{
+			Type:    entities.SafeSmartAccountType,
+			Address: accEntity.Address,
+			Signer:  signer,
+		}
+
+		return accEntity
+	}
+	signerModel := testutils2.FakeAccountModel()
+	signerModel.Address = signer.Hex()
+	signerModel.StoreID = "signer-store-id"
+
+	t.Run("should register a smart account successfully", func(t *testing.T) {
+		accEntity := newSmartAccount()
+
+		mockSearchUC.EXPECT().Execute(gomock.Any(), &entities.AccountFilters{Aliases: []string{accEntity.Alias}, TenantID: userInfo.TenantID}, userInfo)
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), accEntity.Address.Hex(), userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, errors.NotFoundError("not found"))
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), signer.Hex(), userInfo.AllowedTenants, userInfo.Username).Return(signerModel, nil)
+		accountAgent.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, model *models.Account) error {
+			assert.Equal(t, signerModel.StoreID, model.StoreID)
+			assert.Equal(t, userInfo.TenantID, model.TenantID)
+			assert.Equal(t, userInfo.Username, model.OwnerID)
+			return nil
+		})
+
+		resp, err := usecase.Execute(ctx, accEntity, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, accEntity.Address, resp.Address)
+		assert.Equal(t, accEntity.SmartAccount, resp.SmartAccount)
+		assert.Equal(t, signerModel.StoreID, resp.StoreID)
+	})
+
+	t.Run("should fail with AlreadyExistsError if the alias already exists", func(t *testing.T) {
+		accEntity := newSmartAccount()
+
+		mockSearchUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Account{accEntity}, nil)
+
+		_, err := usecase.Execute(ctx, accEntity, userInfo)
+
+		assert.True(t, errors.IsAlreadyExistsError(err))
+	})
+
+	t.Run("should fail with AlreadyExistsError if the address is already registered", func(t *testing.T) {
+		accEntity := newSmartAccount()
+
+		mockSearchUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo)
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), accEntity.Address.Hex(), userInfo.AllowedTenants, userInfo.Username).
+			Return(testutils2.FakeAccountModel(), nil)
+
+		_, err := usecase.Execute(ctx, accEntity, userInfo)
+
+		assert.True(t, errors.IsAlreadyExistsError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if the signer does not exist", func(t *testing.T) {
+		accEntity := newSmartAccount()
+
+		mockSearchUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo)
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), accEntity.Address.Hex(), userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, errors.NotFoundError("not found"))
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), signer.Hex(), userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, errors.NotFoundError("not found"))
+
+		_, err := usecase.Execute(ctx, accEntity, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if the signer is a smart account", func(t *testing.T) {
+		accEntity := newSmartAccount()
+		smartSigner := testutils2.FakeAccountModel()
+		smartSigner.SmartAccount = &entities.SmartAccount{Type: entities.SafeSmartAccountType}
+
+		mockSearchUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo)
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), accEntity.Address.Hex(), userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, errors.NotFoundError("not found"))
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), signer.Hex(), userInfo.AllowedTenants, userInfo.Username).Return(smartSigner, nil)
+
+		_, err := usecase.Execute(ctx, accEntity, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with same error if Insert fails", func(t *testing.T) {
+		accEntity := newSmartAccount()
+		expectedErr := errors.PostgresConnectionError("error")
+
+		mockSearchUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo)
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), accEntity.Address.Hex(), userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, errors.NotFoundError("not found"))
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), signer.Hex(), userInfo.AllowedTenants, userInfo.Username).Return(signerModel, nil)
+		accountAgent.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(expectedErr)
+
+		_, err := usecase.Execute(ctx, accEntity, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(registerSmartAccountComponent), err)
+	})
+}
@@ -0,0 +1,33 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+)
+
+//go:generate mockgen -source=approvals.go -destination=mocks/approvals.go -package=mocks
+
+type ApprovalPolicyUseCases interface {
+	CreateApprovalPolicy() CreateApprovalPolicyUseCase
+	GetApprovalPolicy() GetApprovalPolicyUseCase
+	SearchApprovalPolicies() SearchApprovalPoliciesUseCase
+	DeleteApprovalPolicy() DeleteApprovalPolicyUseCase
+}
+
+type CreateApprovalPolicyUseCase interface {
+	Execute(ctx context.Context, policy *entities.ApprovalPolicy, userInfo *multitenancy.UserInfo) (*entities.ApprovalPolicy, error)
+}
+
+type GetApprovalPolicyUseCase interface {
+	Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) (*entities.ApprovalPolicy, error)
+}
+
+type SearchApprovalPoliciesUseCase interface {
+	Execute(ctx context.Context, filters *entities.ApprovalPolicyFilters, userInfo *multitenancy.UserInfo) ([]*entities.ApprovalPolicy, error)
+}
+
+type DeleteApprovalPolicyUseCase interface {
+	Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) error
+}
@@ -0,0 +1,62 @@
+package approvals
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+)
+
+const createApprovalPolicyComponent = "use-cases.create-approval-policy"
+
+// createApprovalPolicyUseCase is a use case to create an approval policy
+type createApprovalPolicyUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+// NewCreateApprovalPolicyUseCase creates a new CreateApprovalPolicyUseCase
+func NewCreateApprovalPolicyUseCase(db store.DB) usecases.CreateApprovalPolicyUseCase {
+	return &createApprovalPolicyUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(createApprovalPolicyComponent),
+	}
+}
+
+// Execute creates a new approval policy
+func (uc *createApprovalPolicyUseCase) Execute(ctx context.Context, policy *entities.ApprovalPolicy, userInfo *multitenancy.UserInfo) (*entities.ApprovalPolicy, error) {
+	ctx = log.WithFields(ctx, log.Field("name", policy.Name))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("creating new approval policy")
+
+	if policy.Threshold > len(policy.Approvers) {
+		errMsg := "threshold cannot be greater than the number of approvers"
+		logger.WithField("threshold", policy.Threshold).Error(errMsg)
+		return nil, errors.InvalidParameterError(errMsg).ExtendComponent(createApprovalPolicyComponent)
+	}
+
+	if policy.ChainUUID != "" {
+		_, err := uc.db.Chain().FindOneByUUID(ctx, policy.ChainUUID, userInfo.AllowedTenants, userInfo.Username)
+		if errors.IsNotFoundError(err) {
+			return nil, errors.InvalidParameterError("cannot find linked chain").ExtendComponent(createApprovalPolicyComponent)
+		} else if err != nil {
+			return nil, errors.FromError(err).ExtendComponent(createApprovalPolicyComponent)
+		}
+	}
+
+	policyModel := parsers.NewApprovalPolicyModelFromEntity(policy)
+	policyModel.TenantID = userInfo.TenantID
+	policyModel.OwnerID = userInfo.Username
+	err := uc.db.ApprovalPolicy().Insert(ctx, policyModel)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(createApprovalPolicyComponent)
+	}
+
+	logger.WithField("approval_policy", policyModel.UUID).Info("approval policy created successfully")
+	return parsers.NewApprovalPolicyFromModel(policyModel), nil
+}
@@ -0,0 +1,100 @@
+// +build unit
+
+package approvals
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	testutils2 "github.com/consensys/orchestrate/services/api/store/models/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateApprovalPolicy_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	chainAgent := mocks.NewMockChainAgent(ctrl)
+	approvalPolicyAgent := mocks.NewMockApprovalPolicyAgent(ctrl)
+	mockDB.EXPECT().Chain().Return(chainAgent).AnyTimes()
+	mockDB.EXPECT().ApprovalPolicy().Return(approvalPolicyAgent).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewCreateApprovalPolicyUseCase(mockDB)
+
+	t.Run("should execute use case successfully", func(t *testing.T) {
+		policy := testutils.FakeApprovalPolicy()
+
+		approvalPolicyAgent.EXPECT().Insert(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, policyModel *models.ApprovalPolicy) error {
+				assert.Equal(t, userInfo.TenantID, policyModel.TenantID)
+				assert.Equal(t, userInfo.Username, policyModel.OwnerID)
+				return nil
+			})
+
+		resp, err := usecase.Execute(ctx, policy, userInfo)
+		require.NoError(t, err)
+
+		assert.Equal(t, policy.MinValue, resp.MinValue)
+		assert.Equal(t, policy.Approvers, resp.Approvers)
+		assert.Equal(t, userInfo.TenantID, resp.TenantID)
+	})
+
+	t.Run("should execute use case successfully with a linked chain", func(t *testing.T) {
+		policy := testutils.FakeApprovalPolicy()
+		policy.ChainUUID = "chainUUID"
+
+		chainAgent.EXPECT().FindOneByUUID(gomock.Any(), policy.ChainUUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(testutils2.FakeChainModel(), nil)
+		approvalPolicyAgent.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+
+		resp, err := usecase.Execute(ctx, policy, userInfo)
+		require.NoError(t, err)
+
+		assert.Equal(t, policy.ChainUUID, resp.ChainUUID)
+	})
+
+	t.Run("should fail with InvalidParameterError if threshold is greater than the number of approvers", func(t *testing.T) {
+		policy := testutils.FakeApprovalPolicy()
+		policy.Threshold = len(policy.Approvers) + 1
+
+		resp, err := usecase.Execute(ctx, policy, userInfo)
+
+		assert.Nil(t, resp)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if chain is not found", func(t *testing.T) {
+		policy := testutils.FakeApprovalPolicy()
+		policy.ChainUUID = "chainUUID"
+
+		chainAgent.EXPECT().FindOneByUUID(gomock.Any(), policy.ChainUUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, errors.NotFoundError("error"))
+
+		resp, err := usecase.Execute(ctx, policy, userInfo)
+
+		assert.Nil(t, resp)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with same error if insert approval policy fails", func(t *testing.T) {
+		policy := testutils.FakeApprovalPolicy()
+		expectedErr := errors.PostgresConnectionError("error")
+
+		approvalPolicyAgent.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(expectedErr)
+
+		resp, err := usecase.Execute(ctx, policy, userInfo)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(createApprovalPolicyComponent), err)
+	})
+}
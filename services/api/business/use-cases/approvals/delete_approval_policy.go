@@ -0,0 +1,47 @@
+package approvals
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+)
+
+const deleteApprovalPolicyComponent = "use-cases.delete-approval-policy"
+
+// deleteApprovalPolicyUseCase is a use case to delete an approval policy
+type deleteApprovalPolicyUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+// NewDeleteApprovalPolicyUseCase creates a new DeleteApprovalPolicyUseCase
+func NewDeleteApprovalPolicyUseCase(db store.DB) usecases.DeleteApprovalPolicyUseCase {
+	return &deleteApprovalPolicyUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(deleteApprovalPolicyComponent),
+	}
+}
+
+// Execute deletes an approval policy, the jobs already awaiting its approvals keep waiting for them
+func (uc *deleteApprovalPolicyUseCase) Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) error {
+	ctx = log.WithFields(ctx, log.Field("approval_policy", uuid))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("deleting approval policy")
+
+	policyModel, err := uc.db.ApprovalPolicy().FindOneByUUID(ctx, uuid, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(deleteApprovalPolicyComponent)
+	}
+
+	err = uc.db.ApprovalPolicy().Delete(ctx, policyModel, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(deleteApprovalPolicyComponent)
+	}
+
+	logger.Info("approval policy deleted successfully")
+	return nil
+}
@@ -0,0 +1,53 @@
+// +build unit
+
+package approvals
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteApprovalPolicy_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	approvalPolicyAgent := mocks.NewMockApprovalPolicyAgent(ctrl)
+	mockDB.EXPECT().ApprovalPolicy().Return(approvalPolicyAgent).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewDeleteApprovalPolicyUseCase(mockDB)
+
+	t.Run("should execute use case successfully", func(t *testing.T) {
+		policyModel := testutils.FakeApprovalPolicyModel()
+
+		approvalPolicyAgent.EXPECT().FindOneByUUID(gomock.Any(), policyModel.UUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(policyModel, nil)
+		approvalPolicyAgent.EXPECT().Delete(gomock.Any(), policyModel, userInfo.AllowedTenants, userInfo.Username).Return(nil)
+
+		err := usecase.Execute(ctx, policyModel.UUID, userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with same error if delete approval policy fails", func(t *testing.T) {
+		policyModel := testutils.FakeApprovalPolicyModel()
+		expectedErr := errors.PostgresConnectionError("error")
+
+		approvalPolicyAgent.EXPECT().FindOneByUUID(gomock.Any(), policyModel.UUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(policyModel, nil)
+		approvalPolicyAgent.EXPECT().Delete(gomock.Any(), policyModel, userInfo.AllowedTenants, userInfo.Username).Return(expectedErr)
+
+		err := usecase.Execute(ctx, policyModel.UUID, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(deleteApprovalPolicyComponent), err)
+	})
+}
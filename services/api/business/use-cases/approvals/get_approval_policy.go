@@ -0,0 +1,43 @@
+package approvals
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+)
+
+const getApprovalPolicyComponent = "use-cases.get-approval-policy"
+
+// getApprovalPolicyUseCase is a use case to get an approval policy
+type getApprovalPolicyUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+// NewGetApprovalPolicyUseCase creates a new GetApprovalPolicyUseCase
+func NewGetApprovalPolicyUseCase(db store.DB) usecases.GetApprovalPolicyUseCase {
+	return &getApprovalPolicyUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(getApprovalPolicyComponent),
+	}
+}
+
+// Execute gets an approval policy
+func (uc *getApprovalPolicyUseCase) Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) (*entities.ApprovalPolicy, error) {
+	ctx = log.WithFields(ctx, log.Field("approval_policy", uuid))
+	logger := uc.logger.WithContext(ctx)
+
+	policyModel, err := uc.db.ApprovalPolicy().FindOneByUUID(ctx, uuid, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(getApprovalPolicyComponent)
+	}
+
+	logger.Debug("approval policy found successfully")
+	return parsers.NewApprovalPolicyFromModel(policyModel), nil
+}
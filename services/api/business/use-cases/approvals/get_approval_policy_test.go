@@ -0,0 +1,53 @@
+// +build unit
+
+package approvals
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	"github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetApprovalPolicy_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	approvalPolicyAgent := mocks.NewMockApprovalPolicyAgent(ctrl)
+	mockDB.EXPECT().ApprovalPolicy().Return(approvalPolicyAgent).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewGetApprovalPolicyUseCase(mockDB)
+
+	t.Run("should execute use case successfully", func(t *testing.T) {
+		policyModel := testutils.FakeApprovalPolicyModel()
+
+		approvalPolicyAgent.EXPECT().FindOneByUUID(gomock.Any(), policyModel.UUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(policyModel, nil)
+
+		resp, err := usecase.Execute(ctx, policyModel.UUID, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, parsers.NewApprovalPolicyFromModel(policyModel), resp)
+	})
+
+	t.Run("should fail with same error if find approval policy fails", func(t *testing.T) {
+		expectedErr := errors.NotFoundError("error")
+
+		approvalPolicyAgent.EXPECT().FindOneByUUID(gomock.Any(), "uuid", userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, expectedErr)
+
+		resp, err := usecase.Execute(ctx, "uuid", userInfo)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(getApprovalPolicyComponent), err)
+	})
+}
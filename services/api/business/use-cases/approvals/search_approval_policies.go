@@ -0,0 +1,45 @@
+package approvals
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+)
+
+const searchApprovalPoliciesComponent = "use-cases.search-approval-policies"
+
+// searchApprovalPoliciesUseCase is a use case to search approval policies
+type searchApprovalPoliciesUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+// NewSearchApprovalPoliciesUseCase creates a new SearchApprovalPoliciesUseCase
+func NewSearchApprovalPoliciesUseCase(db store.DB) usecases.SearchApprovalPoliciesUseCase {
+	return &searchApprovalPoliciesUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(searchApprovalPoliciesComponent),
+	}
+}
+
+// Execute searches approval policies
+func (uc *searchApprovalPoliciesUseCase) Execute(ctx context.Context, filters *entities.ApprovalPolicyFilters, userInfo *multitenancy.UserInfo) ([]*entities.ApprovalPolicy, error) {
+	policyModels, err := uc.db.ApprovalPolicy().Search(ctx, filters, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(searchApprovalPoliciesComponent)
+	}
+
+	var policies []*entities.ApprovalPolicy
+	for _, policyModel := range policyModels {
+		policies = append(policies, parsers.NewApprovalPolicyFromModel(policyModel))
+	}
+
+	uc.logger.WithContext(ctx).Debug("approval policies found successfully")
+	return policies, nil
+}
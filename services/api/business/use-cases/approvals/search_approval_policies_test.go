@@ -0,0 +1,55 @@
+// +build unit
+
+package approvals
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	"github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	"github.com/consensys/orchestrate/services/api/store/models/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchApprovalPolicies_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	approvalPolicyAgent := mocks.NewMockApprovalPolicyAgent(ctrl)
+	mockDB.EXPECT().ApprovalPolicy().Return(approvalPolicyAgent).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewSearchApprovalPoliciesUseCase(mockDB)
+
+	t.Run("should execute use case successfully", func(t *testing.T) {
+		policyModel := testutils.FakeApprovalPolicyModel()
+		filters := &entities.ApprovalPolicyFilters{ChainUUID: "chainUUID"}
+		approvalPolicyAgent.EXPECT().Search(gomock.Any(), filters, userInfo.AllowedTenants, userInfo.Username).
+			Return([]*models.ApprovalPolicy{policyModel}, nil)
+
+		resp, err := usecase.Execute(ctx, filters, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*entities.ApprovalPolicy{parsers.NewApprovalPolicyFromModel(policyModel)}, resp)
+	})
+
+	t.Run("should fail with same error if search approval policies fails", func(t *testing.T) {
+		filters := &entities.ApprovalPolicyFilters{}
+		expectedErr := errors.PostgresConnectionError("error")
+		approvalPolicyAgent.EXPECT().Search(gomock.Any(), filters, userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, expectedErr)
+
+		resp, err := usecase.Execute(ctx, filters, userInfo)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(searchApprovalPoliciesComponent), err)
+	})
+}
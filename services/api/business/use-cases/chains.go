@@ -15,6 +15,7 @@ type ChainUseCases interface {
 	SearchChains() SearchChainsUseCase
 	UpdateChain() UpdateChainUseCase
 	DeleteChain() DeleteChainUseCase
+	CallContract() CallContractUseCase
 }
 
 type RegisterChainUseCase interface {
@@ -36,3 +37,7 @@ type UpdateChainUseCase interface {
 type DeleteChainUseCase interface {
 	Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) error
 }
+
+type CallContractUseCase interface {
+	Execute(ctx context.Context, chainUUID string, call *entities.ContractCall, userInfo *multitenancy.UserInfo) (map[string]interface{}, error)
+}
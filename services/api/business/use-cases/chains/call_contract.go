@@ -0,0 +1,104 @@
+package chains
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/ethclient"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	eth "github.com/ethereum/go-ethereum"
+)
+
+const callContractComponent = "use-cases.call-contract"
+
+// callContractUseCase is a use case to execute a read-only call of a contract method
+type callContractUseCase struct {
+	getChainUC    usecases.GetChainUseCase
+	getContractUC usecases.GetContractUseCase
+	ec            ethclient.ContractCaller
+	logger        *log.Logger
+}
+
+// NewCallContractUseCase creates a new CallContractUseCase
+func NewCallContractUseCase(getChainUC usecases.GetChainUseCase, getContractUC usecases.GetContractUseCase, ec ethclient.ContractCaller) usecases.CallContractUseCase {
+	return &callContractUseCase{
+		getChainUC:    getChainUC,
+		getContractUC: getContractUC,
+		ec:            ec,
+		logger:        log.NewLogger().SetComponent(callContractComponent),
+	}
+}
+
+// Execute encodes the call of a contract method, executes it on the chain and decodes its outputs
+func (uc *callContractUseCase) Execute(ctx context.Context, chainUUID string, call *entities.ContractCall, userInfo *multitenancy.UserInfo) (map[string]interface{}, error) {
+	ctx = log.WithFields(ctx, log.Field("chain", chainUUID), log.Field("method", call.MethodSignature))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("calling contract")
+
+	chain, err := uc.getChainUC.Execute(ctx, chainUUID, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(callContractComponent)
+	}
+
+	contract := &entities.Contract{RawABI: call.RawABI}
+	if call.RawABI == "" {
+		contract, err = uc.getContractUC.Execute(ctx, call.ContractName, call.ContractTag, userInfo)
+		if errors.IsNotFoundError(err) {
+			return nil, errors.InvalidParameterError("contract not found")
+		}
+		if err != nil {
+			return nil, errors.FromError(err).ExtendComponent(callContractComponent)
+		}
+	}
+
+	data, err := parsers.EncodeContractCall(contract, call.MethodSignature, call.Args)
+	if err != nil {
+		logger.WithError(err).Error("failed to compute call data from method signature and arguments")
+		return nil, errors.FromError(err).ExtendComponent(callContractComponent)
+	}
+
+	msg := &eth.CallMsg{To: &call.To, Data: data}
+	if call.From != nil {
+		msg.From = *call.From
+	}
+
+	result, err := uc.call(ctx, chain.URLs, msg, call)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(callContractComponent)
+	}
+
+	outputs, err := parsers.DecodeContractCallResult(contract, call.MethodSignature, result)
+	if err != nil {
+		logger.WithError(err).Error("failed to decode call result")
+		return nil, errors.FromError(err).ExtendComponent(callContractComponent)
+	}
+
+	logger.Debug("contract called successfully")
+	return outputs, nil
+}
+
+// call executes the call on the first reachable chain URL
+func (uc *callContractUseCase) call(ctx context.Context, uris []string, msg *eth.CallMsg, call *entities.ContractCall) ([]byte, error) {
+	for _, uri := range uris {
+		var result []byte
+		var err error
+		if call.PrivacyGroupID != "" {
+			result, err = uc.ec.PrivCallContract(ctx, uri, msg, call.PrivacyGroupID, call.BlockNumber)
+		} else {
+			result, err = uc.ec.CallContract(ctx, uri, msg, call.BlockNumber)
+		}
+
+		if err != nil && errors.IsConnectionError(err) {
+			uc.logger.WithContext(ctx).WithField("url", uri).WithError(err).Warn("failed to call contract")
+			continue
+		}
+
+		return result, err
+	}
+
+	return nil, errors.EthConnectionError("failed to call contract for all urls")
+}
@@ -0,0 +1,134 @@
+// +build unit
+
+package chains
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/ethclient/mock"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/services/api/business/use-cases/mocks"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallContract_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGetChainUC := mocks.NewMockGetChainUseCase(ctrl)
+	mockGetContractUC := mocks.NewMockGetContractUseCase(ctrl)
+	mockEthClient := mock.NewMockContractCaller(ctrl)
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewCallContractUseCase(mockGetChainUC, mockGetContractUC, mockEthClient)
+
+	result := hexutil.MustDecode("0x00000000000000000000000000000000000000000000000000000000000003e8")
+
+	t.Run("should execute use case successfully", func(t *testing.T) {
+		chain := testutils.FakeChain()
+		call := testutils.FakeContractCall()
+		call.BlockNumber = big.NewInt(10)
+		contract := testutils.FakeContract()
+
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), chain.UUID, userInfo).Return(chain, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), call.ContractName, call.ContractTag, userInfo).Return(contract, nil)
+		mockEthClient.EXPECT().CallContract(gomock.Any(), chain.URLs[0], gomock.Any(), call.BlockNumber).Return(result, nil)
+
+		outputs, err := usecase.Execute(ctx, chain.UUID, call, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1000", outputs["0"].(*big.Int).String())
+	})
+
+	t.Run("should execute private call successfully with the given ABI", func(t *testing.T) {
+		chain := testutils.FakeChain()
+		call := testutils.FakeContractCall()
+		call.ContractName = ""
+		call.RawABI = testutils.FakeContract().RawABI
+		call.PrivacyGroupID = "A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="
+
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), chain.UUID, userInfo).Return(chain, nil)
+		mockEthClient.EXPECT().PrivCallContract(gomock.Any(), chain.URLs[0], gomock.Any(), call.PrivacyGroupID, nil).Return(result, nil)
+
+		outputs, err := usecase.Execute(ctx, chain.UUID, call, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1000", outputs["0"].(*big.Int).String())
+	})
+
+	t.Run("should try next url on connection error", func(t *testing.T) {
+		chain := testutils.FakeChain()
+		chain.URLs = []string{"http://node-1:8545", "http://node-2:8545"}
+		call := testutils.FakeContractCall()
+
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), chain.UUID, userInfo).Return(chain, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), call.ContractName, call.ContractTag, userInfo).Return(testutils.FakeContract(), nil)
+		mockEthClient.EXPECT().CallContract(gomock.Any(), chain.URLs[0], gomock.Any(), nil).Return(nil, errors.EthConnectionError("error"))
+		mockEthClient.EXPECT().CallContract(gomock.Any(), chain.URLs[1], gomock.Any(), nil).Return(result, nil)
+
+		outputs, err := usecase.Execute(ctx, chain.UUID, call, userInfo)
+
+		assert.NoError(t, err)
+		assert.Len(t, outputs, 1)
+	})
+
+	t.Run("should fail with same error if get chain fails", func(t *testing.T) {
+		expectedErr := errors.NotFoundError("error")
+
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), "uuid", userInfo).Return(nil, expectedErr)
+
+		outputs, err := usecase.Execute(ctx, "uuid", testutils.FakeContractCall(), userInfo)
+
+		assert.Nil(t, outputs)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(callContractComponent), err)
+	})
+
+	t.Run("should fail with InvalidParameterError if contract is not found", func(t *testing.T) {
+		chain := testutils.FakeChain()
+		call := testutils.FakeContractCall()
+
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), chain.UUID, userInfo).Return(chain, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), call.ContractName, call.ContractTag, userInfo).Return(nil, errors.NotFoundError("error"))
+
+		outputs, err := usecase.Execute(ctx, chain.UUID, call, userInfo)
+
+		assert.Nil(t, outputs)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if method is not found", func(t *testing.T) {
+		chain := testutils.FakeChain()
+		call := testutils.FakeContractCall()
+		call.MethodSignature = "unknown()"
+
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), chain.UUID, userInfo).Return(chain, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), call.ContractName, call.ContractTag, userInfo).Return(testutils.FakeContract(), nil)
+
+		outputs, err := usecase.Execute(ctx, chain.UUID, call, userInfo)
+
+		assert.Nil(t, outputs)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with same error if call fails", func(t *testing.T) {
+		chain := testutils.FakeChain()
+		call := testutils.FakeContractCall()
+		expectedErr := errors.InvalidParameterError("execution reverted")
+
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), chain.UUID, userInfo).Return(chain, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), call.ContractName, call.ContractTag, userInfo).Return(testutils.FakeContract(), nil)
+		mockEthClient.EXPECT().CallContract(gomock.Any(), chain.URLs[0], gomock.Any(), nil).Return(nil, expectedErr)
+
+		outputs, err := usecase.Execute(ctx, chain.UUID, call, userInfo)
+
+		assert.Nil(t, outputs)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(callContractComponent), err)
+	})
+}
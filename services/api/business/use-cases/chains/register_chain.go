@@ -11,6 +11,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/toolkit/database"
 	"github.com/consensys/orchestrate/pkg/toolkit/ethclient"
 	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/orchestrate/services/api/business/parsers"
 	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
 	"github.com/consensys/orchestrate/services/api/store"
@@ -55,14 +56,22 @@ func (uc *registerChainUseCase) Execute(ctx context.Context, chain *entities.Cha
 		return nil, errors.AlreadyExistsError(errMessage).ExtendComponent(registerChainComponent)
 	}
 
-	chainID, err := uc.getChainID(ctx, chain.URLs, chain.Headers)
+	// WebSocket URLs are only used by the tx-listener to subscribe to new blocks
+	httpURLs := utils.HTTPURLs(chain.URLs)
+	if len(httpURLs) == 0 {
+		errMessage := "at least one HTTP URL is required"
+		logger.Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage).ExtendComponent(registerChainComponent)
+	}
+
+	chainID, err := uc.getChainID(ctx, httpURLs, chain.Headers)
 	if err != nil {
 		return nil, errors.FromError(err).ExtendComponent(registerChainComponent)
 	}
 	chain.ChainID = chainID
 
 	if fromLatest {
-		chainTip, der := uc.getChainTip(ctx, chain.URLs)
+		chainTip, der := uc.getChainTip(ctx, httpURLs)
 		if der != nil {
 			return nil, errors.FromError(der).ExtendComponent(registerChainComponent)
 		}
@@ -3,6 +3,7 @@ package usecases
 import (
 	"context"
 
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/types/entities"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -18,18 +19,21 @@ type ContractUseCases interface {
 	SetContractCodeHash() SetContractCodeHashUseCase
 	RegisterContract() RegisterContractUseCase
 	SearchContract() SearchContractUseCase
+	DeleteContract() DeleteContractUseCase
+	DeleteContractTag() DeleteContractTagUseCase
+	SetContractTag() SetContractTagUseCase
 }
 
 type GetContractsCatalogUseCase interface {
-	Execute(ctx context.Context) ([]string, error)
+	Execute(ctx context.Context, userInfo *multitenancy.UserInfo) ([]string, error)
 }
 
 type GetContractUseCase interface {
-	Execute(ctx context.Context, name, tag string) (*entities.Contract, error)
+	Execute(ctx context.Context, name, tag string, userInfo *multitenancy.UserInfo) (*entities.Contract, error)
 }
 
 type SearchContractUseCase interface {
-	Execute(ctx context.Context, codehash hexutil.Bytes, address *ethcommon.Address) (*entities.Contract, error)
+	Execute(ctx context.Context, codehash hexutil.Bytes, address *ethcommon.Address, userInfo *multitenancy.UserInfo) (*entities.Contract, error)
 }
 
 type GetContractEventsUseCase interface {
@@ -37,11 +41,23 @@ type GetContractEventsUseCase interface {
 }
 
 type GetContractTagsUseCase interface {
-	Execute(ctx context.Context, name string) ([]string, error)
+	Execute(ctx context.Context, name string, userInfo *multitenancy.UserInfo) ([]string, error)
 }
 
 type RegisterContractUseCase interface {
-	Execute(ctx context.Context, contract *entities.Contract) error
+	Execute(ctx context.Context, contract *entities.Contract, userInfo *multitenancy.UserInfo) error
+}
+
+type DeleteContractUseCase interface {
+	Execute(ctx context.Context, name string, userInfo *multitenancy.UserInfo) error
+}
+
+type DeleteContractTagUseCase interface {
+	Execute(ctx context.Context, name, tag string, userInfo *multitenancy.UserInfo) error
+}
+
+type SetContractTagUseCase interface {
+	Execute(ctx context.Context, name, tag, target string, userInfo *multitenancy.UserInfo) error
 }
 
 type SetContractCodeHashUseCase interface {
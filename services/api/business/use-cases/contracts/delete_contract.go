@@ -0,0 +1,54 @@
+package contracts
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/database"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+)
+
+const deleteContractComponent = "use-cases.delete-contract"
+
+type deleteContractUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+func NewDeleteContractUseCase(db store.DB) usecases.DeleteContractUseCase {
+	return &deleteContractUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(deleteContractComponent),
+	}
+}
+
+// Execute deregisters a contract and all its tags. Artifacts are kept so that the accounts deployed with them are
+// still decoded
+func (uc *deleteContractUseCase) Execute(ctx context.Context, name string, userInfo *multitenancy.UserInfo) error {
+	ctx = log.WithFields(ctx, log.Field("contract_name", name))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("deleting contract")
+
+	err := database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
+		dbtx := tx.(store.Tx)
+		repository, der := dbtx.Repository().FindOne(ctx, name, getOwnedTenants(userInfo))
+		if der != nil {
+			return der
+		}
+
+		if der = dbtx.Tag().DeleteAllByRepositoryID(ctx, repository.ID); der != nil {
+			return der
+		}
+
+		return dbtx.Repository().Delete(ctx, repository)
+	})
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(deleteContractComponent)
+	}
+
+	logger.Info("contract deleted successfully")
+	return nil
+}
@@ -0,0 +1,54 @@
+// +build unit
+
+package contracts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteContract_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockDBTX := mocks.NewMockTx(ctrl)
+	repositoryAgent := mocks.NewMockRepositoryAgent(ctrl)
+	tagAgent := mocks.NewMockTagAgent(ctrl)
+
+	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
+	mockDBTX.EXPECT().Repository().Return(repositoryAgent).AnyTimes()
+	mockDBTX.EXPECT().Tag().Return(tagAgent).AnyTimes()
+
+	usecase := NewDeleteContractUseCase(mockDB)
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+
+	t.Run("should delete the contract and its tags successfully", func(t *testing.T) {
+		repository := &models.RepositoryModel{ID: 1, Name: "ERC20", TenantID: "tenantOne"}
+		repositoryAgent.EXPECT().FindOne(gomock.Any(), "ERC20", []string{"tenantOne"}).Return(repository, nil)
+		tagAgent.EXPECT().DeleteAllByRepositoryID(gomock.Any(), 1).Return(nil)
+		repositoryAgent.EXPECT().Delete(gomock.Any(), repository).Return(nil)
+		mockDBTX.EXPECT().Commit().Return(nil)
+
+		err := usecase.Execute(ctx, "ERC20", userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with NotFoundError if contract is not owned by the tenant", func(t *testing.T) {
+		repositoryAgent.EXPECT().FindOne(gomock.Any(), "ERC20", []string{"tenantOne"}).Return(nil, errors.NotFoundError("error"))
+		mockDBTX.EXPECT().Rollback().Return(nil)
+
+		err := usecase.Execute(ctx, "ERC20", userInfo)
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
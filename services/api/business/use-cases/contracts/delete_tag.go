@@ -0,0 +1,68 @@
+package contracts
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/database"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+)
+
+const deleteTagComponent = "use-cases.delete-tag"
+
+type deleteTagUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+func NewDeleteTagUseCase(db store.DB) usecases.DeleteContractTagUseCase {
+	return &deleteTagUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(deleteTagComponent),
+	}
+}
+
+// Execute deregisters a tag of a contract, the contract being deregistered with its last tag
+func (uc *deleteTagUseCase) Execute(ctx context.Context, name, tag string, userInfo *multitenancy.UserInfo) error {
+	ctx = log.WithFields(ctx, log.Field("contract_name", name), log.Field("contract_tag", tag))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("deleting contract tag")
+
+	tenants := getOwnedTenants(userInfo)
+	err := database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
+		dbtx := tx.(store.Tx)
+		repository, der := dbtx.Repository().FindOne(ctx, name, tenants)
+		if der != nil {
+			return der
+		}
+
+		tagModel, der := dbtx.Tag().FindOneByName(ctx, repository.ID, tag)
+		if der != nil {
+			return der
+		}
+
+		if der = dbtx.Tag().Delete(ctx, tagModel); der != nil {
+			return der
+		}
+
+		tags, der := dbtx.Tag().FindAllByName(ctx, name, tenants)
+		if der != nil {
+			return der
+		}
+
+		if len(tags) == 0 {
+			return dbtx.Repository().Delete(ctx, repository)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(deleteTagComponent)
+	}
+
+	logger.Info("contract tag deleted successfully")
+	return nil
+}
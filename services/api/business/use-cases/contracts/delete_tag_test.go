@@ -0,0 +1,85 @@
+// +build unit
+
+package contracts
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteTag_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockDBTX := mocks.NewMockTx(ctrl)
+	repositoryAgent := mocks.NewMockRepositoryAgent(ctrl)
+	tagAgent := mocks.NewMockTagAgent(ctrl)
+
+	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
+	mockDBTX.EXPECT().Repository().Return(repositoryAgent).AnyTimes()
+	mockDBTX.EXPECT().Tag().Return(tagAgent).AnyTimes()
+
+	usecase := NewDeleteTagUseCase(mockDB)
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	tenants := []string{"tenantOne"}
+	repository := &models.RepositoryModel{ID: 1, Name: "ERC20", TenantID: "tenantOne"}
+	tag := &models.TagModel{ID: 2, Name: "v1.0.0", RepositoryID: 1, ArtifactID: 3}
+
+	t.Run("should delete the tag successfully", func(t *testing.T) {
+		repositoryAgent.EXPECT().FindOne(gomock.Any(), "ERC20", tenants).Return(repository, nil)
+		tagAgent.EXPECT().FindOneByName(gomock.Any(), 1, "v1.0.0").Return(tag, nil)
+		tagAgent.EXPECT().Delete(gomock.Any(), tag).Return(nil)
+		tagAgent.EXPECT().FindAllByName(gomock.Any(), "ERC20", tenants).Return([]string{"latest"}, nil)
+		mockDBTX.EXPECT().Commit().Return(nil)
+
+		err := usecase.Execute(ctx, "ERC20", "v1.0.0", userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should delete the contract with its last tag", func(t *testing.T) {
+		repositoryAgent.EXPECT().FindOne(gomock.Any(), "ERC20", tenants).Return(repository, nil)
+		tagAgent.EXPECT().FindOneByName(gomock.Any(), 1, "v1.0.0").Return(tag, nil)
+		tagAgent.EXPECT().Delete(gomock.Any(), tag).Return(nil)
+		tagAgent.EXPECT().FindAllByName(gomock.Any(), "ERC20", tenants).Return([]string{}, nil)
+		repositoryAgent.EXPECT().Delete(gomock.Any(), repository).Return(nil)
+		mockDBTX.EXPECT().Commit().Return(nil)
+
+		err := usecase.Execute(ctx, "ERC20", "v1.0.0", userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with same error if tag is not found", func(t *testing.T) {
+		expectedErr := errors.NotFoundError("error")
+		repositoryAgent.EXPECT().FindOne(gomock.Any(), "ERC20", tenants).Return(repository, nil)
+		tagAgent.EXPECT().FindOneByName(gomock.Any(), 1, "v1.0.0").Return(nil, expectedErr)
+		mockDBTX.EXPECT().Rollback().Return(nil)
+
+		err := usecase.Execute(ctx, "ERC20", "v1.0.0", userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(deleteTagComponent), err)
+	})
+
+	t.Run("should fail with same error if delete fails", func(t *testing.T) {
+		expectedErr := fmt.Errorf("error")
+		repositoryAgent.EXPECT().FindOne(gomock.Any(), "ERC20", tenants).Return(repository, nil)
+		tagAgent.EXPECT().FindOneByName(gomock.Any(), 1, "v1.0.0").Return(tag, nil)
+		tagAgent.EXPECT().Delete(gomock.Any(), tag).Return(expectedErr)
+		mockDBTX.EXPECT().Rollback().Return(nil)
+
+		err := usecase.Execute(ctx, "ERC20", "v1.0.0", userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(deleteTagComponent), err)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/services/api/store/mocks"
 )
 
@@ -19,12 +20,13 @@ func TestGetCatalog_Execute(t *testing.T) {
 
 	repositoryAgent := mocks.NewMockRepositoryAgent(ctrl)
 	usecase := NewGetCatalogUseCase(repositoryAgent)
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
 
 	t.Run("should execute use case successfully", func(t *testing.T) {
 		names := []string{"Contract0", "Contract1"}
-		repositoryAgent.EXPECT().FindAll(gomock.Any()).Return(names, nil)
+		repositoryAgent.EXPECT().FindAll(gomock.Any(), userInfo.AllowedTenants).Return(names, nil)
 
-		response, err := usecase.Execute(context.Background())
+		response, err := usecase.Execute(context.Background(), userInfo)
 
 		assert.Equal(t, response, names)
 		assert.NoError(t, err)
@@ -32,9 +34,9 @@ func TestGetCatalog_Execute(t *testing.T) {
 
 	t.Run("should fail if data agent fails", func(t *testing.T) {
 		dataAgentError := fmt.Errorf("error")
-		repositoryAgent.EXPECT().FindAll(gomock.Any()).Return(nil, dataAgentError)
+		repositoryAgent.EXPECT().FindAll(gomock.Any(), userInfo.AllowedTenants).Return(nil, dataAgentError)
 
-		response, err := usecase.Execute(context.Background())
+		response, err := usecase.Execute(context.Background(), userInfo)
 
 		assert.Nil(t, response)
 		assert.Equal(t, errors.FromError(dataAgentError).ExtendComponent(getCatalogComponent), err)
@@ -11,6 +11,7 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/types/entities"
 	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
 )
@@ -30,11 +31,11 @@ func NewGetContractUseCase(agent store.ArtifactAgent) usecases.GetContractUseCas
 }
 
 // Execute gets a contract from DB
-func (uc *getContractUseCase) Execute(ctx context.Context, name, tag string) (*entities.Contract, error) {
+func (uc *getContractUseCase) Execute(ctx context.Context, name, tag string, userInfo *multitenancy.UserInfo) (*entities.Contract, error) {
 	ctx = log.WithFields(ctx, log.Field("contract_name", name), log.Field("contract_tag", name))
 	logger := uc.logger.WithContext(ctx)
 
-	artifact, err := uc.agent.FindOneByNameAndTag(ctx, name, tag)
+	artifact, err := uc.agent.FindOneByNameAndTag(ctx, name, tag, userInfo.AllowedTenants)
 	if err != nil {
 		return nil, errors.FromError(err).ExtendComponent(getContractComponent)
 	}
@@ -53,6 +54,7 @@ func (uc *getContractUseCase) Execute(ctx context.Context, name, tag string) (*e
 		ABI:              parsedABI,
 		Bytecode:         hexutil.MustDecode(artifact.Bytecode),
 		DeployedBytecode: hexutil.MustDecode(artifact.DeployedBytecode),
+		LinkReferences:   artifact.LinkReferences,
 	}
 
 	// nolint
@@ -10,6 +10,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/services/api/store/mocks"
 )
 
@@ -21,12 +22,13 @@ func TestGetTags_Execute(t *testing.T) {
 	contractName := "myContract"
 	tagAgent := mocks.NewMockTagAgent(ctrl)
 	usecase := NewGetTagsUseCase(tagAgent)
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
 
 	t.Run("should execute use case successfully", func(t *testing.T) {
 		tags := []string{"latest", "v1.0.0"}
-		tagAgent.EXPECT().FindAllByName(gomock.Any(), contractName).Return(tags, nil)
+		tagAgent.EXPECT().FindAllByName(gomock.Any(), contractName, userInfo.AllowedTenants).Return(tags, nil)
 
-		response, err := usecase.Execute(ctx, contractName)
+		response, err := usecase.Execute(ctx, contractName, userInfo)
 
 		assert.Equal(t, response, tags)
 		assert.NoError(t, err)
@@ -34,9 +36,9 @@ func TestGetTags_Execute(t *testing.T) {
 
 	t.Run("should fail if data agent fails", func(t *testing.T) {
 		dataAgentError := fmt.Errorf("error")
-		tagAgent.EXPECT().FindAllByName(gomock.Any(), contractName).Return(nil, dataAgentError)
+		tagAgent.EXPECT().FindAllByName(gomock.Any(), contractName, userInfo.AllowedTenants).Return(nil, dataAgentError)
 
-		response, err := usecase.Execute(ctx, contractName)
+		response, err := usecase.Execute(ctx, contractName, userInfo)
 
 		assert.Nil(t, response)
 		assert.Equal(t, errors.FromError(dataAgentError).ExtendComponent(getTagsComponent), err)
@@ -9,6 +9,7 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/toolkit/database"
 	"github.com/consensys/orchestrate/pkg/types/entities"
 	"github.com/consensys/orchestrate/services/api/business/parsers"
@@ -34,7 +35,7 @@ func NewRegisterContractUseCase(agent store.DB) usecases.RegisterContractUseCase
 	}
 }
 
-func (uc *registerContractUseCase) Execute(ctx context.Context, contract *entities.Contract) error {
+func (uc *registerContractUseCase) Execute(ctx context.Context, contract *entities.Contract, userInfo *multitenancy.UserInfo) error {
 	ctx = log.WithFields(ctx, log.Field("contract_id", contract))
 	logger := uc.logger.WithContext(ctx)
 	logger.Debug("registering contract starting...")
@@ -51,13 +52,15 @@ func (uc *registerContractUseCase) Execute(ctx context.Context, contract *entiti
 	}
 
 	repository := &models.RepositoryModel{
-		Name: contract.Name,
+		Name:     contract.Name,
+		TenantID: userInfo.TenantID,
 	}
 	artifact := &models.ArtifactModel{
 		ABI:              abiRaw,
 		Bytecode:         contract.Bytecode.String(),
 		DeployedBytecode: contract.DeployedBytecode.String(),
 		Codehash:         hexutil.Encode(crypto.Keccak256(contract.DeployedBytecode)),
+		LinkReferences:   contract.LinkReferences,
 	}
 
 	err = database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
@@ -6,6 +6,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/types/testutils"
 	"github.com/consensys/orchestrate/services/api/store/mocks"
 	"github.com/consensys/orchestrate/services/api/store/models"
@@ -32,16 +33,17 @@ func TestRegisterContract_Execute(t *testing.T) {
 	mockDBTX.EXPECT().Tag().Return(tagAgent).AnyTimes()
 
 	usecase := NewRegisterContractUseCase(mockDB)
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
 
 	//@TODO Add more advance test flows
 	t.Run("should execute use case successfully", func(t *testing.T) {
 		contract := testutils.FakeContract()
-		repositoryAgent.EXPECT().SelectOrInsert(gomock.Any(), gomock.AssignableToTypeOf(&models.RepositoryModel{})).Return(nil)
+		repositoryAgent.EXPECT().SelectOrInsert(gomock.Any(), &models.RepositoryModel{Name: contract.Name, TenantID: userInfo.TenantID}).Return(nil)
 		artifactAgent.EXPECT().SelectOrInsert(gomock.Any(), gomock.AssignableToTypeOf(&models.ArtifactModel{})).Return(nil)
 		tagAgent.EXPECT().Insert(gomock.Any(), gomock.AssignableToTypeOf(&models.TagModel{}))
 		eventAgent.EXPECT().InsertMultiple(gomock.Any(), gomock.AssignableToTypeOf([]*models.EventModel{}))
 		mockDBTX.EXPECT().Commit().Return(nil)
-		err := usecase.Execute(ctx, contract)
+		err := usecase.Execute(ctx, contract, userInfo)
 
 		assert.NoError(t, err)
 	})
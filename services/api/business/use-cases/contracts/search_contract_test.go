@@ -6,6 +6,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/types/testutils"
 	"github.com/consensys/orchestrate/services/api/store/mocks"
 	"github.com/golang/mock/gomock"
@@ -21,13 +22,14 @@ func TestSearchContract_Execute(t *testing.T) {
 	address := testutils.FakeAddress()
 	contractAgent := mocks.NewMockContractAgent(ctrl)
 	usecase := NewSearchContractUseCase(contractAgent)
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
 
 	t.Run("should execute use case by address successfully", func(t *testing.T) {
 		contractAgent.EXPECT().
-			FindOneByAddress(gomock.Any(), address.String()).
+			FindOneByAddress(gomock.Any(), address.String(), userInfo.AllowedTenants).
 			Return(contract, nil)
 
-		response, err := usecase.Execute(ctx, nil, address)
+		response, err := usecase.Execute(ctx, nil, address, userInfo)
 
 		assert.NoError(t, err)
 		assert.Equal(t, contract.ABI, response.ABI)
@@ -35,10 +37,10 @@ func TestSearchContract_Execute(t *testing.T) {
 
 	t.Run("should execute use case by code_hash successfully", func(t *testing.T) {
 		contractAgent.EXPECT().
-			FindOneByCodeHash(gomock.Any(), contract.Bytecode.String()).
+			FindOneByCodeHash(gomock.Any(), contract.Bytecode.String(), userInfo.AllowedTenants).
 			Return(contract, nil)
 
-		response, err := usecase.Execute(ctx, contract.Bytecode, nil)
+		response, err := usecase.Execute(ctx, contract.Bytecode, nil, userInfo)
 
 		assert.NoError(t, err)
 		assert.Equal(t, contract.ABI, response.ABI)
@@ -0,0 +1,67 @@
+package contracts
+
+import (
+	"context"
+	"strings"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/database"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+	"github.com/consensys/orchestrate/services/api/store/models"
+)
+
+const setTagComponent = "use-cases.set-tag"
+
+type setTagUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+func NewSetTagUseCase(db store.DB) usecases.SetContractTagUseCase {
+	return &setTagUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(setTagComponent),
+	}
+}
+
+// Execute points the tag of a contract to the artifact of the target tag, creating the tag if it does not exist
+func (uc *setTagUseCase) Execute(ctx context.Context, name, tag, target string, userInfo *multitenancy.UserInfo) error {
+	ctx = log.WithFields(ctx, log.Field("contract_name", name), log.Field("contract_tag", tag))
+	logger := uc.logger.WithContext(ctx).WithField("target", target)
+	logger.Debug("setting contract tag")
+
+	if strings.EqualFold(tag, target) {
+		errMessage := "tag cannot target itself"
+		logger.Error(errMessage)
+		return errors.InvalidParameterError(errMessage).ExtendComponent(setTagComponent)
+	}
+
+	tenants := getOwnedTenants(userInfo)
+	err := database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
+		dbtx := tx.(store.Tx)
+		repository, der := dbtx.Repository().FindOne(ctx, name, tenants)
+		if der != nil {
+			return der
+		}
+
+		artifact, der := dbtx.Artifact().FindOneByNameAndTag(ctx, name, target, tenants)
+		if der != nil {
+			return der
+		}
+
+		return dbtx.Tag().Insert(ctx, &models.TagModel{
+			Name:         tag,
+			RepositoryID: repository.ID,
+			ArtifactID:   artifact.ID,
+		})
+	})
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(setTagComponent)
+	}
+
+	logger.Info("contract tag set successfully")
+	return nil
+}
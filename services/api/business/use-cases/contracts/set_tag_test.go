@@ -0,0 +1,67 @@
+// +build unit
+
+package contracts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetTag_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockDBTX := mocks.NewMockTx(ctrl)
+	artifactAgent := mocks.NewMockArtifactAgent(ctrl)
+	repositoryAgent := mocks.NewMockRepositoryAgent(ctrl)
+	tagAgent := mocks.NewMockTagAgent(ctrl)
+
+	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
+	mockDBTX.EXPECT().Artifact().Return(artifactAgent).AnyTimes()
+	mockDBTX.EXPECT().Repository().Return(repositoryAgent).AnyTimes()
+	mockDBTX.EXPECT().Tag().Return(tagAgent).AnyTimes()
+
+	usecase := NewSetTagUseCase(mockDB)
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	tenants := []string{"tenantOne"}
+
+	t.Run("should point the tag to the artifact of the target tag", func(t *testing.T) {
+		repositoryAgent.EXPECT().FindOne(gomock.Any(), "ERC20", tenants).
+			Return(&models.RepositoryModel{ID: 1, Name: "ERC20", TenantID: "tenantOne"}, nil)
+		artifactAgent.EXPECT().FindOneByNameAndTag(gomock.Any(), "ERC20", "v1.0.0", tenants).
+			Return(&models.ArtifactModel{ID: 3}, nil)
+		tagAgent.EXPECT().Insert(gomock.Any(), &models.TagModel{Name: "latest", RepositoryID: 1, ArtifactID: 3}).Return(nil)
+		mockDBTX.EXPECT().Commit().Return(nil)
+
+		err := usecase.Execute(ctx, "ERC20", "latest", "v1.0.0", userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with InvalidParameterError if tag targets itself", func(t *testing.T) {
+		err := usecase.Execute(ctx, "ERC20", "latest", "Latest", userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with NotFoundError if target tag does not exist", func(t *testing.T) {
+		repositoryAgent.EXPECT().FindOne(gomock.Any(), "ERC20", tenants).
+			Return(&models.RepositoryModel{ID: 1, Name: "ERC20", TenantID: "tenantOne"}, nil)
+		artifactAgent.EXPECT().FindOneByNameAndTag(gomock.Any(), "ERC20", "v1.0.0", tenants).
+			Return(nil, errors.NotFoundError("error"))
+		mockDBTX.EXPECT().Rollback().Return(nil)
+
+		err := usecase.Execute(ctx, "ERC20", "latest", "v1.0.0", userInfo)
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
@@ -1,6 +1,7 @@
 package contracts
 
 import (
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 )
 
@@ -14,3 +15,13 @@ func getIndexedCount(event *abi.Event) (indexedInputCount uint) {
 
 	return indexedInputCount
 }
+
+// getOwnedTenants returns the tenants whose contracts can be modified by the user, the contracts registered by the
+// parent tenants and the default tenant being read-only
+func getOwnedTenants(userInfo *multitenancy.UserInfo) []string {
+	if userInfo.Wildcard {
+		return userInfo.AllowedTenants
+	}
+
+	return []string{userInfo.TenantID}
+}
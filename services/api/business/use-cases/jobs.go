@@ -3,6 +3,7 @@ package usecases
 import (
 	"context"
 
+	"github.com/consensys/orchestrate/pkg/broker"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/types/entities"
 	"github.com/consensys/orchestrate/services/api/store"
@@ -22,6 +23,11 @@ type JobUseCases interface {
 	UpdateJob() UpdateJobUseCase
 	RetryTx() RetryJobTxUseCase
 	SearchJobs() SearchJobsUseCase
+	DispatchScheduledJobs() DispatchScheduledJobsUseCase
+	RelayOutboxMessages() RelayOutboxMessagesUseCase
+	EnqueueMessage() EnqueueMessageUseCase
+	ApproveJob() ApproveJobUseCase
+	RejectJob() RejectJobUseCase
 }
 
 type CreateJobUseCase interface {
@@ -41,6 +47,30 @@ type StartJobUseCase interface {
 	Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) error
 }
 
+type ApproveJobUseCase interface {
+	Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) (*entities.Job, error)
+}
+
+type RejectJobUseCase interface {
+	Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) (*entities.Job, error)
+}
+
+type StartJobsUseCase interface {
+	Execute(ctx context.Context, jobUUIDs []string, userInfo *multitenancy.UserInfo) error
+}
+
+type DispatchScheduledJobsUseCase interface {
+	Execute(ctx context.Context) error
+}
+
+type RelayOutboxMessagesUseCase interface {
+	Execute(ctx context.Context) error
+}
+
+type EnqueueMessageUseCase interface {
+	Execute(ctx context.Context, msg *broker.Message, jobUUID string) error
+}
+
 type StartNextJobUseCase interface {
 	Execute(ctx context.Context, prevJobUUID string, userInfo *multitenancy.UserInfo) error
 }
@@ -54,6 +84,10 @@ type UpdateChildrenUseCase interface {
 	WithDBTransaction(dbtx store.Tx) UpdateChildrenUseCase
 }
 
+type UpdateDependentJobsUseCase interface {
+	Execute(ctx context.Context, job *entities.Job, userInfo *multitenancy.UserInfo) error
+}
+
 type ResendJobTxUseCase interface {
 	Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) error
 }
@@ -0,0 +1,72 @@
+package jobs
+
+import (
+	"context"
+	"strings"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	"github.com/consensys/orchestrate/services/api/store"
+	"github.com/consensys/orchestrate/services/api/store/models"
+)
+
+const awaitingApprovalMessage = "waiting for approval"
+
+// approvalRequirements returns the requirements of the approval policies of the job tenant matched by the job
+func approvalRequirements(ctx context.Context, db store.DB, job *entities.Job) ([]*entities.ApprovalRequirement, error) {
+	if !entities.IsApprovable(job) {
+		return nil, nil
+	}
+
+	policyModels, err := db.ApprovalPolicy().Search(ctx, &entities.ApprovalPolicyFilters{}, []string{job.TenantID}, multitenancy.WildcardOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	var requirements []*entities.ApprovalRequirement
+	for _, policyModel := range policyModels {
+		policy := parsers.NewApprovalPolicyFromModel(policyModel)
+		if policy.Matches(job) {
+			requirements = append(requirements, policy.Requirement())
+		}
+	}
+
+	return requirements, nil
+}
+
+func awaitingApprovalLogMessage(requirements []*entities.ApprovalRequirement) string {
+	var policies []string
+	for _, requirement := range requirements {
+		policies = append(policies, requirement.PolicyName)
+	}
+
+	return awaitingApprovalMessage + ": " + strings.Join(policies, ", ")
+}
+
+// findJobAwaitingApproval finds a job of the tenants of the user awaiting its approval. Approvers do not need to own
+// the job but must be named by one of its approval requirements
+func findJobAwaitingApproval(ctx context.Context, db store.DB, jobUUID string, userInfo *multitenancy.UserInfo) (*models.Job, error) {
+	// Usernames of API key requests are chosen by the caller, approvers must be authenticated with their own token
+	if userInfo.Username == "" || userInfo.AuthMode != multitenancy.AuthMethodJWT {
+		return nil, errors.UnauthorizedError("approvers must be authenticated users")
+	}
+
+	jobModel, err := db.Job().FindOneByUUID(ctx, jobUUID, userInfo.AllowedTenants, multitenancy.WildcardOwner, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if jobModel.Status != entities.StatusAwaitingApproval || jobModel.InternalData == nil {
+		return nil, errors.InvalidStateError("job is not awaiting approval")
+	}
+
+	for _, requirement := range jobModel.InternalData.ApprovalRequirements {
+		if requirement.IsApprover(userInfo.Username) {
+			return jobModel, nil
+		}
+	}
+
+	return nil, errors.UnauthorizedError("user is not an approver of the job")
+}
@@ -0,0 +1,106 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/database"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+	"github.com/consensys/orchestrate/services/api/store/models"
+)
+
+const approveJobComponent = "use-cases.approve-job"
+
+// approveJobUseCase is a use case to approve a job awaiting approval
+type approveJobUseCase struct {
+	db         store.DB
+	startJobUC usecases.StartJobUseCase
+	logger     *log.Logger
+}
+
+// NewApproveJobUseCase creates a new ApproveJobUseCase
+func NewApproveJobUseCase(db store.DB, startJobUC usecases.StartJobUseCase) usecases.ApproveJobUseCase {
+	return &approveJobUseCase{
+		db:         db,
+		startJobUC: startJobUC,
+		logger:     log.NewLogger().SetComponent(approveJobComponent),
+	}
+}
+
+// Execute records the approval of the user in the job logs and starts the job once all its approval requirements are
+// satisfied
+func (uc *approveJobUseCase) Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) (*entities.Job, error) {
+	ctx = log.WithFields(ctx, log.Field("job", jobUUID), log.Field("approver", userInfo.Username))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("approving job")
+
+	_, err := findJobAwaitingApproval(ctx, uc.db, jobUUID, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(approveJobComponent)
+	}
+
+	var jobModel *models.Job
+	err = database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
+		// Approvals are appended to the job under its row lock, so concurrent approvals cannot overwrite each other
+		if der := tx.(store.Tx).Job().LockOneByUUID(ctx, jobUUID); der != nil {
+			return der
+		}
+
+		var der error
+		jobModel, der = tx.(store.Tx).Job().FindOneByUUID(ctx, jobUUID, userInfo.AllowedTenants, multitenancy.WildcardOwner, false)
+		if der != nil {
+			return der
+		}
+
+		if jobModel.Status != entities.StatusAwaitingApproval {
+			return errors.InvalidStateError("job is not awaiting approval")
+		}
+
+		for _, approver := range jobModel.InternalData.Approvals {
+			if approver == userInfo.Username {
+				return errors.AlreadyExistsError("job already approved by user")
+			}
+		}
+
+		jobModel.InternalData.Approvals = append(jobModel.InternalData.Approvals, userInfo.Username)
+		if der = tx.(store.Tx).Job().Update(ctx, jobModel); der != nil {
+			return der
+		}
+
+		return tx.(store.Tx).Log().Insert(ctx, &models.Log{
+			JobID:   &jobModel.ID,
+			Status:  entities.StatusAwaitingApproval,
+			Message: fmt.Sprintf("approved by %s", userInfo.Username),
+		})
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to approve job")
+		return nil, errors.FromError(err).ExtendComponent(approveJobComponent)
+	}
+
+	jobEntity := parsers.NewJobEntityFromModels(jobModel)
+	if !entities.IsApproved(jobEntity) {
+		logger.Info("job approved successfully, waiting for other approvals")
+		return jobEntity, nil
+	}
+
+	// The last approvals can be recorded concurrently, only one of them starts the job
+	err = uc.startJobUC.Execute(ctx, jobEntity.UUID, multitenancy.NewUserInfo(jobEntity.TenantID, jobEntity.OwnerID))
+	if err != nil && !errors.IsInvalidStateError(err) {
+		return nil, errors.FromError(err).ExtendComponent(approveJobComponent)
+	}
+
+	jobModel, err = uc.db.Job().FindOneByUUID(ctx, jobUUID, userInfo.AllowedTenants, multitenancy.WildcardOwner, false)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(approveJobComponent)
+	}
+
+	logger.Info("job approved and started successfully")
+	return parsers.NewJobEntityFromModels(jobModel), nil
+}
@@ -0,0 +1,183 @@
+// +build unit
+
+package jobs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	mocks2 "github.com/consensys/orchestrate/services/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	"github.com/consensys/orchestrate/services/api/store/models/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApproveJob_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockDBTX := mocks.NewMockTx(ctrl)
+	mockJobDA := mocks.NewMockJobAgent(ctrl)
+	mockLogDA := mocks.NewMockLogAgent(ctrl)
+	mockStartJobUC := mocks2.NewMockStartJobUseCase(ctrl)
+
+	mockDB.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
+	mockDBTX.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDBTX.EXPECT().Log().Return(mockLogDA).AnyTimes()
+	mockDBTX.EXPECT().Commit().Return(nil).AnyTimes()
+	mockDBTX.EXPECT().Rollback().Return(nil).AnyTimes()
+	mockDBTX.EXPECT().Close().Return(nil).AnyTimes()
+
+	newApprover := func(username string) *multitenancy.UserInfo {
+		approver := multitenancy.NewUserInfo("tenantOne", username)
+		approver.AuthMode = multitenancy.AuthMethodJWT
+		return approver
+	}
+	userInfo := newApprover("alice")
+	usecase := NewApproveJobUseCase(mockDB, mockStartJobUC)
+
+	fakeJobAwaitingApproval := func(threshold int, approvals ...string) *models.Job {
+		job := testutils.FakeJobModel(1)
+		job.Status = entities.StatusAwaitingApproval
+		job.Schedule.OwnerID = "owner"
+		job.InternalData.ApprovalRequirements = []*entities.ApprovalRequirement{
+			{PolicyUUID: "policyUUID", PolicyName: "treasury-transfers", Threshold: threshold, Approvers: []string{"alice", "bob"}},
+		}
+		job.InternalData.Approvals = approvals
+		return job
+	}
+
+	t.Run("should record the approval and wait for other approvals", func(t *testing.T) {
+		job := fakeJobAwaitingApproval(2)
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, multitenancy.WildcardOwner, false).
+			Return(job, nil).Times(2)
+		mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), job.UUID).Return(nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), job).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, jobLog *models.Log) error {
+			assert.Equal(t, entities.StatusAwaitingApproval, jobLog.Status)
+			assert.Equal(t, "approved by alice", jobLog.Message)
+			return nil
+		})
+
+		resp, err := usecase.Execute(ctx, job.UUID, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.StatusAwaitingApproval, resp.Status)
+		assert.Equal(t, []string{"alice"}, resp.InternalData.Approvals)
+	})
+
+	t.Run("should start the job on behalf of its owner once approved", func(t *testing.T) {
+		job := fakeJobAwaitingApproval(2, "bob")
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, multitenancy.WildcardOwner, false).
+			Return(job, nil).Times(2)
+		mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), job.UUID).Return(nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), job).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockStartJobUC.EXPECT().Execute(gomock.Any(), job.UUID, multitenancy.NewUserInfo(job.Schedule.TenantID, job.Schedule.OwnerID)).
+			DoAndReturn(func(_ context.Context, _ string, _ *multitenancy.UserInfo) error {
+				job.Status = entities.StatusStarted
+				return nil
+			})
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, multitenancy.WildcardOwner, false).Return(job, nil)
+
+		resp, err := usecase.Execute(ctx, job.UUID, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.StatusStarted, resp.Status)
+		assert.Equal(t, []string{"bob", "alice"}, resp.InternalData.Approvals)
+	})
+
+	t.Run("should record the approval on top of the ones recorded concurrently", func(t *testing.T) {
+		job := fakeJobAwaitingApproval(2)
+		lockedJob := fakeJobAwaitingApproval(2, "bob")
+		lockedJob.UUID = job.UUID
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, multitenancy.WildcardOwner, false).Return(job, nil)
+		mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), job.UUID).Return(nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, multitenancy.WildcardOwner, false).Return(lockedJob, nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), lockedJob).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockStartJobUC.EXPECT().Execute(gomock.Any(), job.UUID, gomock.Any()).Return(errors.InvalidStateError("error"))
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, multitenancy.WildcardOwner, false).Return(lockedJob, nil)
+
+		resp, err := usecase.Execute(ctx, job.UUID, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"bob", "alice"}, resp.InternalData.Approvals)
+	})
+
+	t.Run("should fail with AlreadyExistsError if the user already approved the job", func(t *testing.T) {
+		job := fakeJobAwaitingApproval(2, "alice")
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, multitenancy.WildcardOwner, false).
+			Return(job, nil).Times(2)
+		mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), job.UUID).Return(nil)
+
+		_, err := usecase.Execute(ctx, job.UUID, userInfo)
+
+		assert.True(t, errors.IsAlreadyExistsError(err))
+	})
+
+	t.Run("should fail with UnauthorizedError if the user is not an approver of the job", func(t *testing.T) {
+		job := fakeJobAwaitingApproval(1)
+		carol := newApprover("carol")
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, carol.AllowedTenants, multitenancy.WildcardOwner, false).Return(job, nil)
+
+		_, err := usecase.Execute(ctx, job.UUID, carol)
+
+		assert.True(t, errors.IsUnauthorizedError(err))
+	})
+
+	t.Run("should fail with UnauthorizedError if the user is not authenticated", func(t *testing.T) {
+		_, err := usecase.Execute(ctx, "jobUUID", newApprover(""))
+
+		assert.True(t, errors.IsUnauthorizedError(err))
+	})
+
+	t.Run("should fail with UnauthorizedError if the username is impersonated with an API key", func(t *testing.T) {
+		apiKeyUser := multitenancy.NewAPIKeyUserInfo("apiKey")
+		_ = apiKeyUser.ImpersonateUsername("alice")
+
+		_, err := usecase.Execute(ctx, "jobUUID", apiKeyUser)
+
+		assert.True(t, errors.IsUnauthorizedError(err))
+	})
+
+	t.Run("should fail with InvalidStateError if the job is not awaiting approval", func(t *testing.T) {
+		job := fakeJobAwaitingApproval(1)
+		job.Status = entities.StatusStarted
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, multitenancy.WildcardOwner, false).Return(job, nil)
+
+		_, err := usecase.Execute(ctx, job.UUID, userInfo)
+
+		assert.True(t, errors.IsInvalidStateError(err))
+	})
+
+	t.Run("should fail with same error if insert log fails", func(t *testing.T) {
+		job := fakeJobAwaitingApproval(2)
+		expectedErr := errors.PostgresConnectionError("error")
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, multitenancy.WildcardOwner, false).
+			Return(job, nil).Times(2)
+		mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), job.UUID).Return(nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), job).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(expectedErr)
+
+		_, err := usecase.Execute(ctx, job.UUID, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(approveJobComponent), err)
+	})
+}
@@ -1,6 +1,7 @@
 package jobs
 
 import (
+	"bytes"
 	"context"
 	"math/big"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/types/entities"
 	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
@@ -21,19 +23,26 @@ const createJobComponent = "use-cases.create-job"
 
 // createJobUseCase is a use case to create a new transaction job
 type createJobUseCase struct {
-	db             store.DB
-	getChainUC     usecases.GetChainUseCase
-	logger         *log.Logger
-	defaultStoreID string
+	db                  store.DB
+	getChainUC          usecases.GetChainUseCase
+	enforceTxPoliciesUC usecases.EnforceTransactionPoliciesUseCase
+	logger              *log.Logger
+	defaultStoreID      string
 }
 
 // NewCreateJobUseCase creates a new CreateJobUseCase
-func NewCreateJobUseCase(db store.DB, getChainUC usecases.GetChainUseCase, qkmStoreID string) usecases.CreateJobUseCase {
+func NewCreateJobUseCase(
+	db store.DB,
+	getChainUC usecases.GetChainUseCase,
+	enforceTxPoliciesUC usecases.EnforceTransactionPoliciesUseCase,
+	qkmStoreID string,
+) usecases.CreateJobUseCase {
 	return &createJobUseCase{
-		db:             db,
-		getChainUC:     getChainUC,
-		logger:         log.NewLogger().SetComponent(createJobComponent),
-		defaultStoreID: qkmStoreID,
+		db:                  db,
+		getChainUC:          getChainUC,
+		enforceTxPoliciesUC: enforceTxPoliciesUC,
+		logger:              log.NewLogger().SetComponent(createJobComponent),
+		defaultStoreID:      qkmStoreID,
 	}
 }
 
@@ -54,10 +63,22 @@ func (uc *createJobUseCase) Execute(ctx context.Context, job *entities.Job, user
 	job.InternalData.ChainID = chainID
 
 	if job.Transaction.From != nil && job.Type != entities.EthereumRawTransaction {
-		job.InternalData.StoreID, err = uc.getAccountStoreID(ctx, job.Transaction.From, userInfo)
-		if err != nil {
-			return nil, errors.FromError(err).ExtendComponent(createJobComponent)
+		acc, der := uc.getAccount(ctx, job.Transaction.From, userInfo)
+		if der != nil {
+			return nil, errors.FromError(der).ExtendComponent(createJobComponent)
 		}
+
+		job.InternalData.StoreID = acc.StoreID
+		if job.InternalData.StoreID == "" {
+			job.InternalData.StoreID = uc.defaultStoreID
+		}
+
+		if der = setSmartAccount(job, acc.SmartAccount); der != nil {
+			logger.WithError(der).Error("invalid smart account transaction")
+			return nil, errors.FromError(der).ExtendComponent(createJobComponent)
+		}
+	} else {
+		job.InternalData.SmartAccount = nil
 	}
 
 	schedule, err := uc.db.Schedule().FindOneByUUID(ctx, job.ScheduleUUID, userInfo.AllowedTenants, userInfo.Username)
@@ -74,11 +95,11 @@ func (uc *createJobUseCase) Execute(ctx context.Context, job *entities.Job, user
 	})
 	jobModel.Schedule = schedule
 
-	if err = uc.db.Transaction().Insert(ctx, jobModel.Transaction); err != nil {
-		return nil, errors.FromError(err).ExtendComponent(createJobComponent)
-	}
-
 	err = database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
+		if der := tx.(store.Tx).Transaction().Insert(ctx, jobModel.Transaction); der != nil {
+			return der
+		}
+
 		// If it's a child job, only create it if parent status is PENDING
 		if jobModel.InternalData.ParentJobUUID != "" {
 			parentJobUUID := jobModel.InternalData.ParentJobUUID
@@ -98,6 +119,21 @@ func (uc *createJobUseCase) Execute(ctx context.Context, job *entities.Job, user
 					WithField("parent_status", parentJobModel.Status).Error(errMessage)
 				return errors.InvalidStateError(errMessage)
 			}
+
+			// Children are exempted from approval and transaction policies as they resend the transaction of their parent
+			if !isResendOf(job, parsers.NewJobEntityFromModels(parentJobModel)) {
+				errMessage := "child job must send the transaction of its parent"
+				logger.WithField("parent_job", parentJobUUID).Error(errMessage)
+				return errors.InvalidParameterError(errMessage)
+			}
+		}
+
+		// Jobs referencing receipts of other jobs are checked once their transaction is crafted
+		if job.InternalData.TxTemplate == nil {
+			der := uc.enforceTxPoliciesUC.WithDBTransaction(tx.(store.Tx)).Execute(ctx, job, schedule.TenantID)
+			if der != nil {
+				return der
+			}
 		}
 
 		if der := tx.(store.Tx).Job().Insert(ctx, jobModel); der != nil {
@@ -121,21 +157,76 @@ func (uc *createJobUseCase) Execute(ctx context.Context, job *entities.Job, user
 	return parsers.NewJobEntityFromModels(jobModel), nil
 }
 
-// nolint
-func (uc *createJobUseCase) getAccountStoreID(ctx context.Context, address *ethcommon.Address, userInfo *multitenancy.UserInfo) (string, error) {
+func (uc *createJobUseCase) getAccount(ctx context.Context, address *ethcommon.Address, userInfo *multitenancy.UserInfo) (*models.Account, error) {
 	acc, err := uc.db.Account().FindOneByAddress(ctx, address.String(), userInfo.AllowedTenants, userInfo.Username)
 	if errors.IsNotFoundError(err) {
-		return "", errors.InvalidParameterError("failed to get account")
+		return nil, errors.InvalidParameterError("failed to get account")
 	}
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	
-	if acc.StoreID == "" {
-		return uc.defaultStoreID, nil
+
+	return acc, nil
+}
+
+// setSmartAccount attaches the smart account of the sender to the job. Jobs sent by a signer only keep their smart
+// account if they are children of a smart account job, as retries send the wrapped transaction of their parent
+func setSmartAccount(job *entities.Job, smartAccount *entities.SmartAccount) error {
+	if smartAccount == nil {
+		if job.InternalData.SmartAccount != nil &&
+			(job.InternalData.ParentJobUUID == "" || job.InternalData.SmartAccount.Signer != *job.Transaction.From) {
+			job.InternalData.SmartAccount = nil
+		}
+
+		return nil
+	}
+
+	if job.Type != entities.EthereumTransaction {
+		return errors.InvalidParameterError("smart accounts only support public transactions")
+	}
+
+	if job.Transaction.To == nil {
+		return errors.InvalidParameterError("smart accounts cannot deploy contracts")
+	}
+
+	if smartAccount.Type == entities.ERC4337SmartAccountType && job.InternalData.RetryInterval != 0 {
+		return errors.InvalidParameterError("gas price retries are not supported by ERC-4337 smart accounts")
+	}
+
+	job.InternalData.SmartAccount = smartAccount
+	return nil
+}
+
+// isResendOf indicates whether a job sends the same transaction as its parent, only its gas fees and nonce may differ
+func isResendOf(job, parent *entities.Job) bool {
+	if job.ChainUUID != parent.ChainUUID || job.Type != parent.Type {
+		return false
+	}
+
+	if job.Type == entities.EthereumRawTransaction {
+		return bytes.Equal(job.Transaction.Raw, parent.Transaction.Raw)
+	}
+
+	return equalAddresses(job.Transaction.From, parent.Transaction.From) &&
+		equalAddresses(job.Transaction.To, parent.Transaction.To) &&
+		bigOrZero(job.Transaction.Value).Cmp(bigOrZero(parent.Transaction.Value)) == 0 &&
+		bytes.Equal(job.Transaction.Data, parent.Transaction.Data)
+}
+
+func equalAddresses(a, b *ethcommon.Address) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+func bigOrZero(value *hexutil.Big) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
 	}
 
-	return acc.StoreID, nil
+	return value.ToInt()
 }
 
 func (uc *createJobUseCase) getChainID(ctx context.Context, chainUUID string, userInfo *multitenancy.UserInfo) (*big.Int, error) {
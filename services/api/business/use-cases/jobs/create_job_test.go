@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/types/entities"
@@ -33,6 +34,7 @@ func TestCreateJob_Execute(t *testing.T) {
 	mockJobDA := mocks.NewMockJobAgent(ctrl)
 	mockLogDA := mocks.NewMockLogAgent(ctrl)
 	mockGetChainUC := mocks2.NewMockGetChainUseCase(ctrl)
+	mockEnforceTxPoliciesUC := mocks2.NewMockEnforceTransactionPoliciesUseCase(ctrl)
 
 	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
 	mockDB.EXPECT().Schedule().Return(mockScheduleDA).AnyTimes()
@@ -44,10 +46,12 @@ func TestCreateJob_Execute(t *testing.T) {
 	mockDBTX.EXPECT().Commit().Return(nil).AnyTimes()
 	mockDBTX.EXPECT().Rollback().Return(nil).AnyTimes()
 	mockDBTX.EXPECT().Close().Return(nil).AnyTimes()
+	mockEnforceTxPoliciesUC.EXPECT().WithDBTransaction(mockDBTX).Return(mockEnforceTxPoliciesUC).AnyTimes()
+	mockEnforceTxPoliciesUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	qkmStoreID := "qkm-store-id"
 	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
-	usecase := NewCreateJobUseCase(mockDB, mockGetChainUC, qkmStoreID)
+	usecase := NewCreateJobUseCase(mockDB, mockGetChainUC, mockEnforceTxPoliciesUC, qkmStoreID)
 	fakeChain := testutils3.FakeChain()
 	fakaAccountE := testutils3.FakeAccount()
 	fakeAccount := &models.Account{
@@ -87,6 +91,9 @@ func TestCreateJob_Execute(t *testing.T) {
 		parentJobModel := testutils2.FakeJobModel(fakeSchedule.ID)
 		parentJobModel.Status = entities.StatusPending
 		parentJobModel.Logs[0].Status = entities.StatusPending
+		parentJobModel.ChainUUID = jobEntity.ChainUUID
+		parentJobModel.Type = jobEntity.Type
+		parentJobModel.Transaction = parsers.NewTransactionModelFromEntities(jobEntity.Transaction)
 
 		mockGetChainUC.EXPECT().Execute(gomock.Any(), jobEntity.ChainUUID, userInfo).Return(fakeChain, nil)
 		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), jobEntity.Transaction.From.String(), userInfo.AllowedTenants, userInfo.Username).
@@ -105,6 +112,113 @@ func TestCreateJob_Execute(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("should fail with InvalidParameterError if a child job does not send the transaction of its parent", func(t *testing.T) {
+		jobEntity := testutils3.FakeJob()
+		jobEntity.InternalData.ParentJobUUID = "myParentJobUUID"
+		fakeSchedule := testutils2.FakeSchedule(userInfo.TenantID, userInfo.Username)
+		fakeSchedule.ID = 1
+		fakeSchedule.UUID = jobEntity.ScheduleUUID
+		parentJobModel := testutils2.FakeJobModel(fakeSchedule.ID)
+		parentJobModel.Status = entities.StatusPending
+		parentJobModel.ChainUUID = jobEntity.ChainUUID
+		parentJobModel.Type = jobEntity.Type
+		parentJobModel.Transaction = parsers.NewTransactionModelFromEntities(jobEntity.Transaction)
+		parentJobModel.Transaction.Data = "0x"
+
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), jobEntity.ChainUUID, userInfo).Return(fakeChain, nil)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), jobEntity.Transaction.From.String(), userInfo.AllowedTenants, userInfo.Username).
+			Return(fakeAccount, nil)
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.ScheduleUUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(fakeSchedule, nil)
+		mockTransactionDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), jobEntity.InternalData.ParentJobUUID).Return(nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.InternalData.ParentJobUUID, userInfo.AllowedTenants,
+			userInfo.Username, false).Return(parentJobModel, nil)
+
+		_, err := usecase.Execute(context.Background(), jobEntity, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should attach the smart account of the sender to the job", func(t *testing.T) {
+		jobEntity := testutils3.FakeJob()
+		fakeSchedule := testutils2.FakeSchedule(userInfo.TenantID, userInfo.Username)
+		fakeSchedule.ID = 1
+		fakeSchedule.UUID = jobEntity.ScheduleUUID
+		smartAccount := &models.Account{
+			StoreID:  fakeAccount.StoreID,
+			Address:  fakeAccount.Address,
+			TenantID: fakeAccount.TenantID,
+			SmartAccount: &entities.SmartAccount{
+				Type:    entities.SafeSmartAccountType,
+				Address: *jobEntity.Transaction.From,
+				Signer:  *jobEntity.Transaction.To,
+			},
+		}
+
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), jobEntity.ChainUUID, userInfo).Return(fakeChain, nil)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), jobEntity.Transaction.From.String(), userInfo.AllowedTenants, userInfo.Username).
+			Return(smartAccount, nil)
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.ScheduleUUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(fakeSchedule, nil)
+		mockTransactionDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockJobDA.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, job *models.Job) error {
+			assert.Equal(t, smartAccount.SmartAccount, job.InternalData.SmartAccount)
+			return nil
+		})
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+
+		_, err := usecase.Execute(context.Background(), jobEntity, userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with InvalidParameterError if an ERC-4337 job has a gas price retry policy", func(t *testing.T) {
+		jobEntity := testutils3.FakeJob()
+		jobEntity.InternalData.RetryInterval = time.Minute
+		smartAccount := &models.Account{
+			Address: fakeAccount.Address,
+			SmartAccount: &entities.SmartAccount{
+				Type:       entities.ERC4337SmartAccountType,
+				Address:    *jobEntity.Transaction.From,
+				Signer:     *jobEntity.Transaction.To,
+				EntryPoint: jobEntity.Transaction.To,
+			},
+		}
+
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), jobEntity.ChainUUID, userInfo).Return(fakeChain, nil)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), jobEntity.Transaction.From.String(), userInfo.AllowedTenants, userInfo.Username).
+			Return(smartAccount, nil)
+
+		_, err := usecase.Execute(context.Background(), jobEntity, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should not let jobs sent by an EOA carry a smart account", func(t *testing.T) {
+		jobEntity := testutils3.FakeJob()
+		jobEntity.InternalData.SmartAccount = &entities.SmartAccount{Type: entities.SafeSmartAccountType, Signer: *jobEntity.Transaction.From}
+		fakeSchedule := testutils2.FakeSchedule(userInfo.TenantID, userInfo.Username)
+		fakeSchedule.ID = 1
+		fakeSchedule.UUID = jobEntity.ScheduleUUID
+
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), jobEntity.ChainUUID, userInfo).Return(fakeChain, nil)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), jobEntity.Transaction.From.String(), userInfo.AllowedTenants, userInfo.Username).
+			Return(fakeAccount, nil)
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.ScheduleUUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(fakeSchedule, nil)
+		mockTransactionDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockJobDA.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, job *models.Job) error {
+			assert.Nil(t, job.InternalData.SmartAccount)
+			return nil
+		})
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+
+		_, err := usecase.Execute(context.Background(), jobEntity, userInfo)
+
+		assert.NoError(t, err)
+	})
+
 	t.Run("should fail with InvalidParameterError if chain is not found", func(t *testing.T) {
 		jobEntity := testutils3.FakeJob()
 
@@ -253,3 +367,95 @@ func TestCreateJob_Execute(t *testing.T) {
 		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(createJobComponent), err)
 	})
 }
+
+func TestCreateJob_ExecuteWithTransactionPolicies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockDBTX := mocks.NewMockTx(ctrl)
+	mockScheduleDA := mocks.NewMockScheduleAgent(ctrl)
+	mockAccountDA := mocks.NewMockAccountAgent(ctrl)
+	mockTransactionDA := mocks.NewMockTransactionAgent(ctrl)
+	mockJobDA := mocks.NewMockJobAgent(ctrl)
+	mockLogDA := mocks.NewMockLogAgent(ctrl)
+	mockGetChainUC := mocks2.NewMockGetChainUseCase(ctrl)
+	mockEnforceTxPoliciesUC := mocks2.NewMockEnforceTransactionPoliciesUseCase(ctrl)
+
+	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
+	mockDB.EXPECT().Schedule().Return(mockScheduleDA).AnyTimes()
+	mockDB.EXPECT().Account().Return(mockAccountDA).AnyTimes()
+	mockDBTX.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDBTX.EXPECT().Log().Return(mockLogDA).AnyTimes()
+	mockDBTX.EXPECT().Transaction().Return(mockTransactionDA).AnyTimes()
+	mockDBTX.EXPECT().Commit().Return(nil).AnyTimes()
+	mockDBTX.EXPECT().Rollback().Return(nil).AnyTimes()
+	mockDBTX.EXPECT().Close().Return(nil).AnyTimes()
+	mockEnforceTxPoliciesUC.EXPECT().WithDBTransaction(mockDBTX).Return(mockEnforceTxPoliciesUC).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewCreateJobUseCase(mockDB, mockGetChainUC, mockEnforceTxPoliciesUC, "qkm-store-id")
+	fakeChain := testutils3.FakeChain()
+	fakeAccount := testutils2.FakeAccountModel()
+
+	t.Run("should fail with PolicyViolationError if the job breaks a transaction policy", func(t *testing.T) {
+		expectedErr := errors.PolicyViolationError("transaction policy 'denied' violated")
+		jobEntity := testutils3.FakeJob()
+		fakeSchedule := testutils2.FakeSchedule(userInfo.TenantID, userInfo.Username)
+		fakeSchedule.UUID = jobEntity.ScheduleUUID
+
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), jobEntity.ChainUUID, userInfo).Return(fakeChain, nil)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), jobEntity.Transaction.From.String(), userInfo.AllowedTenants, userInfo.Username).
+			Return(fakeAccount, nil)
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.ScheduleUUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(fakeSchedule, nil)
+		mockTransactionDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockEnforceTxPoliciesUC.EXPECT().Execute(gomock.Any(), jobEntity, fakeSchedule.TenantID).Return(expectedErr)
+
+		_, err := usecase.Execute(context.Background(), jobEntity, userInfo)
+
+		assert.True(t, errors.IsPolicyViolationError(err))
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(createJobComponent), err)
+	})
+
+	t.Run("should enforce transaction policies on jobs of API key users impersonating the wildcard owner", func(t *testing.T) {
+		expectedErr := errors.PolicyViolationError("transaction policy 'denied' violated")
+		apiKeyUser := multitenancy.NewAPIKeyUserInfo("apiKey")
+		_ = apiKeyUser.ImpersonateUsername(multitenancy.WildcardOwner)
+		jobEntity := testutils3.FakeJob()
+		fakeSchedule := testutils2.FakeSchedule(userInfo.TenantID, userInfo.Username)
+		fakeSchedule.UUID = jobEntity.ScheduleUUID
+
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), jobEntity.ChainUUID, apiKeyUser).Return(fakeChain, nil)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), jobEntity.Transaction.From.String(), apiKeyUser.AllowedTenants, apiKeyUser.Username).
+			Return(fakeAccount, nil)
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.ScheduleUUID, apiKeyUser.AllowedTenants, apiKeyUser.Username).
+			Return(fakeSchedule, nil)
+		mockTransactionDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockEnforceTxPoliciesUC.EXPECT().Execute(gomock.Any(), jobEntity, fakeSchedule.TenantID).Return(expectedErr)
+
+		_, err := usecase.Execute(context.Background(), jobEntity, apiKeyUser)
+
+		assert.True(t, errors.IsPolicyViolationError(err))
+	})
+
+	t.Run("should not enforce transaction policies on jobs whose transaction is not crafted yet", func(t *testing.T) {
+		jobEntity := testutils3.FakeJob()
+		jobEntity.InternalData.TxTemplate = &entities.TxTemplate{MethodSignature: "transfer(address,uint256)"}
+		fakeSchedule := testutils2.FakeSchedule(userInfo.TenantID, userInfo.Username)
+		fakeSchedule.UUID = jobEntity.ScheduleUUID
+
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), jobEntity.ChainUUID, userInfo).Return(fakeChain, nil)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), jobEntity.Transaction.From.String(), userInfo.AllowedTenants, userInfo.Username).
+			Return(fakeAccount, nil)
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.ScheduleUUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(fakeSchedule, nil)
+		mockTransactionDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockJobDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+
+		_, err := usecase.Execute(context.Background(), jobEntity, userInfo)
+
+		assert.NoError(t, err)
+	})
+}
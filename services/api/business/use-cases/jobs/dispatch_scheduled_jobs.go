@@ -0,0 +1,159 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/database"
+	"github.com/consensys/orchestrate/pkg/toolkit/ethclient"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+)
+
+const dispatchScheduledJobsComponent = "use-cases.dispatch-scheduled-jobs"
+
+// dispatchScheduledJobsLock is the lock electing the API replica dispatching scheduled jobs
+const dispatchScheduledJobsLock = "dispatch-scheduled-jobs"
+
+// dispatchScheduledJobsUseCase is a use case to start the scheduled jobs whose execution conditions are met
+type dispatchScheduledJobsUseCase struct {
+	db         store.DB
+	getChainUC usecases.GetChainUseCase
+	startJobUC usecases.StartJobUseCase
+	ethClient  ethclient.Client
+	logger     *log.Logger
+}
+
+// NewDispatchScheduledJobsUseCase creates a new DispatchScheduledJobsUseCase
+func NewDispatchScheduledJobsUseCase(
+	db store.DB,
+	getChainUC usecases.GetChainUseCase,
+	startJobUC usecases.StartJobUseCase,
+	ec ethclient.Client,
+) usecases.DispatchScheduledJobsUseCase {
+	return &dispatchScheduledJobsUseCase{
+		db:         db,
+		getChainUC: getChainUC,
+		startJobUC: startJobUC,
+		ethClient:  ec,
+		logger:     log.NewLogger().SetComponent(dispatchScheduledJobsComponent),
+	}
+}
+
+// Execute starts every scheduled job whose notBefore and notBeforeBlock conditions are met.
+// A job failing to start does not prevent the other ones from being started, it is retried on next execution.
+// Jobs are only dispatched by the API replica holding the dispatch lock, other replicas skip the execution
+func (uc *dispatchScheduledJobsUseCase) Execute(ctx context.Context) error {
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("dispatching scheduled jobs")
+
+	err := database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
+		locked, der := tx.(store.Tx).Lock().TryLock(ctx, dispatchScheduledJobsLock)
+		if der != nil {
+			return der
+		}
+
+		if !locked {
+			logger.Debug("scheduled jobs are dispatched by another replica")
+			return nil
+		}
+
+		return uc.dispatch(ctx)
+	})
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(dispatchScheduledJobsComponent)
+	}
+
+	return nil
+}
+
+func (uc *dispatchScheduledJobsUseCase) dispatch(ctx context.Context) error {
+	logger := uc.logger.WithContext(ctx)
+
+	adminUser := multitenancy.NewInternalAdminUser()
+	filters := &entities.JobFilters{
+		Status:     entities.StatusScheduled,
+		Pagination: entities.CursorPagination{Limit: entities.MaxPageSize},
+	}
+
+	now := time.Now()
+	chainTips := make(map[string]uint64)
+	started := 0
+	for {
+		jobModels, err := uc.db.Job().Search(ctx, filters, adminUser.AllowedTenants, adminUser.Username)
+		if err != nil {
+			return err
+		}
+
+		for _, jobModel := range jobModels {
+			job := parsers.NewJobEntityFromModels(jobModel)
+			jobLogger := logger.WithField("job", job.UUID)
+
+			if job.InternalData.NotBefore != nil && now.Before(*job.InternalData.NotBefore) {
+				continue
+			}
+
+			if job.InternalData.NotBeforeBlock != nil {
+				chainTip, ok := chainTips[job.ChainUUID]
+				if !ok {
+					chainTip, err = uc.getChainTip(ctx, job.ChainUUID, adminUser)
+					if err != nil {
+						jobLogger.WithError(err).WithField("chain", job.ChainUUID).Warn("failed to fetch chain tip, job is not dispatched")
+						continue
+					}
+					chainTips[job.ChainUUID] = chainTip
+				}
+
+				if chainTip < *job.InternalData.NotBeforeBlock {
+					continue
+				}
+			}
+
+			err = uc.startJobUC.Execute(ctx, job.UUID, multitenancy.NewUserInfo(job.TenantID, job.OwnerID))
+			if err != nil {
+				jobLogger.WithError(err).Error("failed to start scheduled job")
+				continue
+			}
+
+			started++
+		}
+
+		// Jobs which are not due yet stay scheduled, so the following pages are reached with the cursor
+		if len(jobModels) < filters.Pagination.Limit {
+			break
+		}
+
+		last := jobModels[len(jobModels)-1]
+		filters.Pagination.Cursor = (&entities.Cursor{Time: last.CreatedAt, UUID: last.UUID}).String()
+	}
+
+	if started > 0 {
+		logger.WithField("started", started).Info("scheduled jobs dispatched successfully")
+	}
+
+	return nil
+}
+
+func (uc *dispatchScheduledJobsUseCase) getChainTip(ctx context.Context, chainUUID string, userInfo *multitenancy.UserInfo) (uint64, error) {
+	chain, err := uc.getChainUC.Execute(ctx, chainUUID, userInfo)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, uri := range chain.URLs {
+		header, der := uc.ethClient.HeaderByNumber(ctx, uri, nil)
+		if der != nil {
+			uc.logger.WithContext(ctx).WithField("url", uri).WithError(der).Warn("failed to fetch chain tip")
+			continue
+		}
+
+		return header.Number.Uint64(), nil
+	}
+
+	return 0, errors.EthConnectionError("failed to fetch chain tip for all urls")
+}
@@ -0,0 +1,175 @@
+// +build unit
+
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/ethclient/mock"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	testutils2 "github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/pkg/utils"
+	mocks2 "github.com/consensys/orchestrate/services/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	"github.com/consensys/orchestrate/services/api/store/models/testutils"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDispatchScheduledJobs_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockJobDA := mocks.NewMockJobAgent(ctrl)
+	mockGetChainUC := mocks2.NewMockGetChainUseCase(ctrl)
+	mockStartJobUC := mocks2.NewMockStartJobUseCase(ctrl)
+	mockEthClient := mock.NewMockClient(ctrl)
+
+	mockDBTX := mocks.NewMockTx(ctrl)
+	mockLockDA := mocks.NewMockLockAgent(ctrl)
+
+	mockDB.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
+	mockDBTX.EXPECT().Lock().Return(mockLockDA).AnyTimes()
+	mockDBTX.EXPECT().Commit().Return(nil).AnyTimes()
+	mockDBTX.EXPECT().Rollback().Return(nil).AnyTimes()
+	mockDBTX.EXPECT().Close().Return(nil).AnyTimes()
+
+	adminUser := multitenancy.NewInternalAdminUser()
+	usecase := NewDispatchScheduledJobsUseCase(mockDB, mockGetChainUC, mockStartJobUC, mockEthClient)
+
+	scheduledJob := func(notBefore *time.Time, notBeforeBlock *uint64) *models.Job {
+		job := testutils.FakeJobModel(1)
+		job.UUID = utils.RandString(10)
+		job.Status = entities.StatusScheduled
+		job.Schedule = testutils.FakeSchedule("tenantOne", "username")
+		job.InternalData = &entities.InternalData{
+			NotBefore:      notBefore,
+			NotBeforeBlock: notBeforeBlock,
+		}
+		return job
+	}
+	filters := &entities.JobFilters{
+		Status:     entities.StatusScheduled,
+		Pagination: entities.CursorPagination{Limit: entities.MaxPageSize},
+	}
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+
+	t.Run("should not dispatch jobs if another replica holds the dispatch lock", func(t *testing.T) {
+		mockLockDA.EXPECT().TryLock(gomock.Any(), dispatchScheduledJobsLock).Return(false, nil)
+
+		err := usecase.Execute(ctx)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should start jobs whose notBefore is reached", func(t *testing.T) {
+		past := time.Now().Add(-time.Minute)
+		future := time.Now().Add(time.Hour)
+		readyJob := scheduledJob(&past, nil)
+		waitingJob := scheduledJob(&future, nil)
+
+		mockJobDA.EXPECT().Search(gomock.Any(), filters, adminUser.AllowedTenants, adminUser.Username).
+			Return([]*models.Job{readyJob, waitingJob}, nil)
+		mockStartJobUC.EXPECT().Execute(gomock.Any(), readyJob.UUID, userInfo).Return(nil)
+
+		mockLockDA.EXPECT().TryLock(gomock.Any(), dispatchScheduledJobsLock).Return(true, nil)
+		err := usecase.Execute(ctx)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should start jobs whose notBeforeBlock is reached fetching the chain tip once per chain", func(t *testing.T) {
+		readyJob := scheduledJob(nil, utils.ToPtr(uint64(10)).(*uint64))
+		waitingJob := scheduledJob(nil, utils.ToPtr(uint64(11)).(*uint64))
+		waitingJob.ChainUUID = readyJob.ChainUUID
+		chain := testutils2.FakeChain()
+
+		mockJobDA.EXPECT().Search(gomock.Any(), filters, adminUser.AllowedTenants, adminUser.Username).
+			Return([]*models.Job{readyJob, waitingJob}, nil)
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), readyJob.ChainUUID, adminUser).Return(chain, nil)
+		mockEthClient.EXPECT().HeaderByNumber(gomock.Any(), chain.URLs[0], nil).Return(&types.Header{Number: big.NewInt(10)}, nil)
+		mockStartJobUC.EXPECT().Execute(gomock.Any(), readyJob.UUID, userInfo).Return(nil)
+
+		mockLockDA.EXPECT().TryLock(gomock.Any(), dispatchScheduledJobsLock).Return(true, nil)
+		err := usecase.Execute(ctx)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not start jobs if chain tip cannot be fetched", func(t *testing.T) {
+		job := scheduledJob(nil, utils.ToPtr(uint64(10)).(*uint64))
+		chain := testutils2.FakeChain()
+
+		mockJobDA.EXPECT().Search(gomock.Any(), filters, adminUser.AllowedTenants, adminUser.Username).
+			Return([]*models.Job{job}, nil)
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), job.ChainUUID, adminUser).Return(chain, nil)
+		mockEthClient.EXPECT().HeaderByNumber(gomock.Any(), gomock.Any(), nil).
+			Return(nil, fmt.Errorf("error")).Times(len(chain.URLs))
+
+		mockLockDA.EXPECT().TryLock(gomock.Any(), dispatchScheduledJobsLock).Return(true, nil)
+		err := usecase.Execute(ctx)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should dispatch jobs of the following pages", func(t *testing.T) {
+		past := time.Now().Add(-time.Minute)
+		future := time.Now().Add(time.Hour)
+		var waitingJobs []*models.Job
+		for i := 0; i < entities.MaxPageSize; i++ {
+			waitingJobs = append(waitingJobs, scheduledJob(&future, nil))
+		}
+		readyJob := scheduledJob(&past, nil)
+		last := waitingJobs[len(waitingJobs)-1]
+		nextFilters := *filters
+		nextFilters.Pagination.Cursor = (&entities.Cursor{Time: last.CreatedAt, UUID: last.UUID}).String()
+
+		mockJobDA.EXPECT().Search(gomock.Any(), filters, adminUser.AllowedTenants, adminUser.Username).
+			Return(waitingJobs, nil)
+		mockJobDA.EXPECT().Search(gomock.Any(), &nextFilters, adminUser.AllowedTenants, adminUser.Username).
+			Return([]*models.Job{readyJob}, nil)
+		mockStartJobUC.EXPECT().Execute(gomock.Any(), readyJob.UUID, userInfo).Return(nil)
+
+		mockLockDA.EXPECT().TryLock(gomock.Any(), dispatchScheduledJobsLock).Return(true, nil)
+		err := usecase.Execute(ctx)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should keep dispatching if a job fails to start", func(t *testing.T) {
+		past := time.Now().Add(-time.Minute)
+		failingJob := scheduledJob(&past, nil)
+		job := scheduledJob(&past, nil)
+
+		mockJobDA.EXPECT().Search(gomock.Any(), filters, adminUser.AllowedTenants, adminUser.Username).
+			Return([]*models.Job{failingJob, job}, nil)
+		mockStartJobUC.EXPECT().Execute(gomock.Any(), failingJob.UUID, userInfo).Return(fmt.Errorf("error"))
+		mockStartJobUC.EXPECT().Execute(gomock.Any(), job.UUID, userInfo).Return(nil)
+
+		mockLockDA.EXPECT().TryLock(gomock.Any(), dispatchScheduledJobsLock).Return(true, nil)
+		err := usecase.Execute(ctx)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with same error if search jobs fails", func(t *testing.T) {
+		expectedErr := fmt.Errorf("error")
+		mockJobDA.EXPECT().Search(gomock.Any(), filters, adminUser.AllowedTenants, adminUser.Username).
+			Return(nil, expectedErr)
+
+		mockLockDA.EXPECT().TryLock(gomock.Any(), dispatchScheduledJobsLock).Return(true, nil)
+		err := usecase.Execute(ctx)
+
+		assert.Error(t, err)
+	})
+}
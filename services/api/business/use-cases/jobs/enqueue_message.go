@@ -0,0 +1,44 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/broker"
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+	"github.com/consensys/orchestrate/services/api/store/models"
+)
+
+const enqueueMessageComponent = "use-cases.enqueue-message"
+
+// enqueueMessageUseCase is a use case to write a message which is not related to a job status update to the outbox
+type enqueueMessageUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+// NewEnqueueMessageUseCase creates a new EnqueueMessageUseCase
+func NewEnqueueMessageUseCase(db store.DB) usecases.EnqueueMessageUseCase {
+	return &enqueueMessageUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(enqueueMessageComponent),
+	}
+}
+
+// Execute writes the message to the outbox, it is then published by the outbox relay in order with the other messages
+// sharing its partition key
+func (uc *enqueueMessageUseCase) Execute(ctx context.Context, msg *broker.Message, jobUUID string) error {
+	logger := uc.logger.WithContext(ctx).WithField("topic", msg.Topic)
+
+	err := uc.db.Outbox().InsertMultiple(ctx, []*models.OutboxMessage{parsers.NewOutboxMessageModel(msg, jobUUID)})
+	if err != nil {
+		logger.WithError(err).Error("failed to write message to the outbox")
+		return errors.FromError(err).ExtendComponent(enqueueMessageComponent)
+	}
+
+	logger.Debug("message written to the outbox")
+	return nil
+}
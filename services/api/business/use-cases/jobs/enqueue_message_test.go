@@ -0,0 +1,49 @@
+// +build unit
+
+package jobs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/broker"
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnqueueMessage_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOutboxDA := mocks.NewMockOutboxAgent(ctrl)
+	mockDB := mocks.NewMockDB(ctrl)
+	mockDB.EXPECT().Outbox().Return(mockOutboxDA).AnyTimes()
+
+	usecase := NewEnqueueMessageUseCase(mockDB)
+	msg := &broker.Message{Topic: "topic-tx-recover", Key: []byte("key"), Value: []byte("value")}
+
+	t.Run("should execute use case successfully", func(t *testing.T) {
+		mockOutboxDA.EXPECT().InsertMultiple(gomock.Any(), []*models.OutboxMessage{{
+			Topic: msg.Topic,
+			Key:   msg.Key,
+			Value: msg.Value,
+		}}).Return(nil)
+
+		err := usecase.Execute(ctx, msg, "")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with same error if the message cannot be written to the outbox", func(t *testing.T) {
+		expectedErr := errors.PostgresConnectionError("error")
+		mockOutboxDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any()).Return(expectedErr)
+
+		err := usecase.Execute(ctx, msg, "")
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(enqueueMessageComponent), err)
+	})
+}
@@ -0,0 +1,29 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/store"
+)
+
+// lockJobStatus locks the row of the job until the end of the DB transaction and checks the job still has the status
+// it was loaded with, so a job cannot be transitioned twice by concurrent requests
+func lockJobStatus(ctx context.Context, tx store.Tx, jobUUID string, status entities.JobStatus) error {
+	if err := tx.Job().LockOneByUUID(ctx, jobUUID); err != nil {
+		return err
+	}
+
+	jobModel, err := tx.Job().FindOneByUUID(ctx, jobUUID, []string{multitenancy.WildcardTenant}, multitenancy.WildcardOwner, false)
+	if err != nil {
+		return err
+	}
+
+	if jobModel.Status != status {
+		return errors.InvalidStateError("job status was updated to %s since it was loaded", jobModel.Status)
+	}
+
+	return nil
+}
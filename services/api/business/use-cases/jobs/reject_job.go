@@ -0,0 +1,54 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+)
+
+const rejectJobComponent = "use-cases.reject-job"
+
+// rejectJobUseCase is a use case to reject a job awaiting approval
+type rejectJobUseCase struct {
+	db          store.DB
+	updateJobUC usecases.UpdateJobUseCase
+	logger      *log.Logger
+}
+
+// NewRejectJobUseCase creates a new RejectJobUseCase
+func NewRejectJobUseCase(db store.DB, updateJobUC usecases.UpdateJobUseCase) usecases.RejectJobUseCase {
+	return &rejectJobUseCase{
+		db:          db,
+		updateJobUC: updateJobUC,
+		logger:      log.NewLogger().SetComponent(rejectJobComponent),
+	}
+}
+
+// Execute fails the job, recording the user who rejected it in the job logs
+func (uc *rejectJobUseCase) Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) (*entities.Job, error) {
+	ctx = log.WithFields(ctx, log.Field("job", jobUUID), log.Field("approver", userInfo.Username))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("rejecting job")
+
+	jobModel, err := findJobAwaitingApproval(ctx, uc.db, jobUUID, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(rejectJobComponent)
+	}
+
+	jobEntity := parsers.NewJobEntityFromModels(jobModel)
+	job, err := uc.updateJobUC.Execute(ctx, &entities.Job{UUID: jobUUID}, entities.StatusFailed,
+		fmt.Sprintf("rejected by %s", userInfo.Username), multitenancy.NewUserInfo(jobEntity.TenantID, jobEntity.OwnerID))
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(rejectJobComponent)
+	}
+
+	logger.Info("job rejected successfully")
+	return job, nil
+}
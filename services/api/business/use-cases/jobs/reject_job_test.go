@@ -0,0 +1,89 @@
+// +build unit
+
+package jobs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	mocks2 "github.com/consensys/orchestrate/services/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/services/api/store/mocks"
+	testutils2 "github.com/consensys/orchestrate/services/api/store/models/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRejectJob_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockJobDA := mocks.NewMockJobAgent(ctrl)
+	mockUpdateJobUC := mocks2.NewMockUpdateJobUseCase(ctrl)
+
+	mockDB.EXPECT().Job().Return(mockJobDA).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "bob")
+	userInfo.AuthMode = multitenancy.AuthMethodJWT
+	usecase := NewRejectJobUseCase(mockDB, mockUpdateJobUC)
+
+	t.Run("should fail the job on behalf of its owner", func(t *testing.T) {
+		jobModel := testutils2.FakeJobModel(1)
+		jobModel.Status = entities.StatusAwaitingApproval
+		jobModel.Schedule.OwnerID = "owner"
+		jobModel.InternalData.ApprovalRequirements = []*entities.ApprovalRequirement{
+			{PolicyUUID: "policyUUID", Threshold: 1, Approvers: []string{"alice", "bob"}},
+		}
+		failedJob := testutils.FakeJob()
+		failedJob.Status = entities.StatusFailed
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobModel.UUID, userInfo.AllowedTenants, multitenancy.WildcardOwner, false).
+			Return(jobModel, nil)
+		mockUpdateJobUC.EXPECT().Execute(gomock.Any(), &entities.Job{UUID: jobModel.UUID}, entities.StatusFailed, "rejected by bob",
+			multitenancy.NewUserInfo(jobModel.Schedule.TenantID, jobModel.Schedule.OwnerID)).Return(failedJob, nil)
+
+		resp, err := usecase.Execute(ctx, jobModel.UUID, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, failedJob, resp)
+	})
+
+	t.Run("should fail with UnauthorizedError if the user is not an approver of the job", func(t *testing.T) {
+		jobModel := testutils2.FakeJobModel(1)
+		jobModel.Status = entities.StatusAwaitingApproval
+		jobModel.InternalData.ApprovalRequirements = []*entities.ApprovalRequirement{
+			{PolicyUUID: "policyUUID", Threshold: 1, Approvers: []string{"alice"}},
+		}
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobModel.UUID, userInfo.AllowedTenants, multitenancy.WildcardOwner, false).
+			Return(jobModel, nil)
+
+		_, err := usecase.Execute(ctx, jobModel.UUID, userInfo)
+
+		assert.True(t, errors.IsUnauthorizedError(err))
+	})
+
+	t.Run("should fail with same error if update job fails", func(t *testing.T) {
+		jobModel := testutils2.FakeJobModel(1)
+		jobModel.Status = entities.StatusAwaitingApproval
+		jobModel.InternalData.ApprovalRequirements = []*entities.ApprovalRequirement{
+			{PolicyUUID: "policyUUID", Threshold: 1, Approvers: []string{"bob"}},
+		}
+		expectedErr := errors.PostgresConnectionError("error")
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobModel.UUID, userInfo.AllowedTenants, multitenancy.WildcardOwner, false).
+			Return(jobModel, nil)
+		mockUpdateJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), entities.StatusFailed, gomock.Any(), gomock.Any()).
+			Return(nil, expectedErr)
+
+		_, err := usecase.Execute(ctx, jobModel.UUID, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(rejectJobComponent), err)
+	})
+}
@@ -0,0 +1,186 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/broker"
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/database"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/metrics"
+	"github.com/consensys/orchestrate/services/api/store"
+	"github.com/consensys/orchestrate/services/api/store/models"
+)
+
+const relayOutboxMessagesComponent = "use-cases.relay-outbox-messages"
+
+// relayOutboxMessagesLock is the lock electing the API replica relaying the outbox, so messages sharing a partition key
+// are published in order
+const relayOutboxMessagesLock = "relay-outbox-messages"
+
+// relayOutboxMessagesUseCase is a use case to publish the messages of the outbox to the message broker
+type relayOutboxMessagesUseCase struct {
+	db        store.DB
+	producer  broker.Producer
+	batchSize int
+	metrics   metrics.TransactionSchedulerMetrics
+	logger    *log.Logger
+}
+
+// NewRelayOutboxMessagesUseCase creates a new RelayOutboxMessagesUseCase
+func NewRelayOutboxMessagesUseCase(
+	db store.DB,
+	producer broker.Producer,
+	batchSize int,
+	m metrics.TransactionSchedulerMetrics,
+) usecases.RelayOutboxMessagesUseCase {
+	return &relayOutboxMessagesUseCase{
+		db:        db,
+		producer:  producer,
+		batchSize: batchSize,
+		metrics:   m,
+		logger:    log.NewLogger().SetComponent(relayOutboxMessagesComponent),
+	}
+}
+
+// Execute publishes the messages of the outbox by batches until the outbox is empty. Messages are deleted once
+// acknowledged by the message broker, in the DB transaction holding their lock, so a message is published at least once.
+// Messages are only relayed by the API replica holding the relay lock, other replicas skip the execution
+func (uc *relayOutboxMessagesUseCase) Execute(ctx context.Context) error {
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("relaying outbox messages")
+
+	published := 0
+	for {
+		count, err := uc.relayBatch(ctx)
+		published += count
+		if err != nil {
+			uc.updateLagMetrics(ctx)
+			return errors.FromError(err).ExtendComponent(relayOutboxMessagesComponent)
+		}
+
+		if count < uc.batchSize {
+			break
+		}
+	}
+
+	uc.updateLagMetrics(ctx)
+
+	if published > 0 {
+		logger.WithField("messages", published).Debug("outbox messages relayed successfully")
+	}
+
+	return nil
+}
+
+func (uc *relayOutboxMessagesUseCase) relayBatch(ctx context.Context) (int, error) {
+	var publishedMsgs []*models.OutboxMessage
+	var sendErr error
+	err := database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
+		locked, der := tx.(store.Tx).Lock().TryLock(ctx, relayOutboxMessagesLock)
+		if der != nil {
+			return der
+		}
+		if !locked {
+			uc.logger.WithContext(ctx).Debug("outbox messages are relayed by another replica")
+			return nil
+		}
+
+		outboxMsgs, der := tx.(store.Tx).Outbox().LockOldest(ctx, uc.batchSize)
+		if der != nil {
+			return der
+		}
+		if len(outboxMsgs) == 0 {
+			return nil
+		}
+
+		msgs := make([]*broker.Message, len(outboxMsgs))
+		for idx, outboxMsg := range outboxMsgs {
+			msgs[idx] = parsers.NewBrokerMessageFromOutboxModel(outboxMsg)
+		}
+
+		sendErr = uc.producer.SendMessages(msgs)
+		publishedMsgs = filterPublishedMessages(outboxMsgs, sendErr)
+		if len(publishedMsgs) == 0 {
+			return nil
+		}
+
+		ids := make([]int64, len(publishedMsgs))
+		for idx, outboxMsg := range publishedMsgs {
+			ids[idx] = outboxMsg.ID
+		}
+
+		return tx.(store.Tx).Outbox().Delete(ctx, ids)
+	})
+	if err != nil {
+		// Messages sent to the message broker before the DB transaction failed are published again on next execution
+		uc.logger.WithContext(ctx).WithError(err).Error("failed to relay outbox messages")
+		return 0, err
+	}
+
+	for _, outboxMsg := range publishedMsgs {
+		uc.metrics.OutboxPublishLatencyHistogram().With("topic", outboxMsg.Topic).
+			Observe(time.Since(outboxMsg.CreatedAt).Seconds())
+	}
+
+	if sendErr != nil {
+		uc.logger.WithContext(ctx).WithError(sendErr).Error("failed to publish outbox messages")
+		return len(publishedMsgs), errors.KafkaConnectionError("could not produce kafka messages")
+	}
+
+	return len(publishedMsgs), nil
+}
+
+func (uc *relayOutboxMessagesUseCase) updateLagMetrics(ctx context.Context) {
+	logger := uc.logger.WithContext(ctx)
+
+	count, err := uc.db.Outbox().Count(ctx)
+	if err != nil {
+		logger.WithError(err).Warn("failed to count outbox messages")
+		return
+	}
+	uc.metrics.OutboxPendingGauge().Set(float64(count))
+
+	if count == 0 {
+		uc.metrics.OutboxLagGauge().Set(0)
+		return
+	}
+
+	oldestMsg, err := uc.db.Outbox().FindOldest(ctx)
+	if err != nil {
+		logger.WithError(err).Warn("failed to find oldest outbox message")
+		return
+	}
+	uc.metrics.OutboxLagGauge().Set(time.Since(oldestMsg.CreatedAt).Seconds())
+}
+
+// filterPublishedMessages returns the messages acknowledged by the message broker
+func filterPublishedMessages(outboxMsgs []*models.OutboxMessage, sendErr error) []*models.OutboxMessage {
+	if sendErr == nil {
+		return outboxMsgs
+	}
+
+	producerErrs, ok := sendErr.(broker.ProducerErrors)
+	if !ok {
+		return nil
+	}
+
+	failedIDs := make(map[int64]bool, len(producerErrs))
+	for _, producerErr := range producerErrs {
+		if id, ok := producerErr.Msg.Metadata.(int64); ok {
+			failedIDs[id] = true
+		}
+	}
+
+	var publishedMsgs []*models.OutboxMessage
+	for _, outboxMsg := range outboxMsgs {
+		if !failedIDs[outboxMsg.ID] {
+			publishedMsgs = append(publishedMsgs, outboxMsg)
+		}
+	}
+
+	return publishedMsgs
+}
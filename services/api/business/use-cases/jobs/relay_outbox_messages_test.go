@@ -0,0 +1,166 @@
+// +build unit
+
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	mocks2 "github.com/Shopify/sarama/mocks"
+	pkgsarama "github.com/consensys/orchestrate/pkg/broker/sarama"
+	"github.com/consensys/orchestrate/pkg/errors"
+	mock2 "github.com/consensys/orchestrate/pkg/toolkit/app/metrics/mock"
+	"github.com/consensys/orchestrate/services/api/metrics/mock"
+	"github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelayOutboxMessages_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOutboxDA := mocks.NewMockOutboxAgent(ctrl)
+	mockDBTX := mocks.NewMockTx(ctrl)
+	mockMetrics := mock.NewMockTransactionSchedulerMetrics(ctrl)
+
+	publishLatencyHistogram := mock2.NewMockHistogram(ctrl)
+	publishLatencyHistogram.EXPECT().With(gomock.Any()).AnyTimes().Return(publishLatencyHistogram)
+	mockMetrics.EXPECT().OutboxPublishLatencyHistogram().AnyTimes().Return(publishLatencyHistogram)
+	lagGauge := mock2.NewMockGauge(ctrl)
+	mockMetrics.EXPECT().OutboxLagGauge().AnyTimes().Return(lagGauge)
+	pendingGauge := mock2.NewMockGauge(ctrl)
+	mockMetrics.EXPECT().OutboxPendingGauge().AnyTimes().Return(pendingGauge)
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
+	mockDBTX.EXPECT().Close().Return(nil).AnyTimes()
+	mockDB.EXPECT().Outbox().Return(mockOutboxDA).AnyTimes()
+	mockDBTX.EXPECT().Outbox().Return(mockOutboxDA).AnyTimes()
+	mockLockDA := mocks.NewMockLockAgent(ctrl)
+	mockDBTX.EXPECT().Lock().Return(mockLockDA).AnyTimes()
+
+	fakeOutboxMessages := func(ids ...int64) []*models.OutboxMessage {
+		var outboxMsgs []*models.OutboxMessage
+		for _, id := range ids {
+			outboxMsgs = append(outboxMsgs, &models.OutboxMessage{
+				ID:        id,
+				Topic:     "topic-tx-sender",
+				Key:       []byte("key"),
+				Value:     []byte(fmt.Sprintf("value-%d", id)),
+				CreatedAt: time.Now(),
+			})
+		}
+		return outboxMsgs
+	}
+
+	t.Run("should publish and delete outbox messages by batches until the outbox is empty", func(t *testing.T) {
+		mockKafkaProducer := mocks2.NewSyncProducer(t, nil)
+		usecase := NewRelayOutboxMessagesUseCase(mockDB, pkgsarama.NewProducer(mockKafkaProducer), 2, mockMetrics)
+
+		mockLockDA.EXPECT().TryLock(gomock.Any(), relayOutboxMessagesLock).Return(true, nil).Times(2)
+		gomock.InOrder(
+			mockOutboxDA.EXPECT().LockOldest(gomock.Any(), 2).Return(fakeOutboxMessages(1, 2), nil),
+			mockOutboxDA.EXPECT().Delete(gomock.Any(), []int64{1, 2}).Return(nil),
+			mockOutboxDA.EXPECT().LockOldest(gomock.Any(), 2).Return(fakeOutboxMessages(3), nil),
+			mockOutboxDA.EXPECT().Delete(gomock.Any(), []int64{3}).Return(nil),
+		)
+		for _, id := range []int{1, 2, 3} {
+			expectedValue := fmt.Sprintf("value-%d", id)
+			mockKafkaProducer.ExpectSendMessageWithCheckerFunctionAndSucceed(func(val []byte) error {
+				assert.Equal(t, expectedValue, string(val))
+				return nil
+			})
+		}
+		mockDBTX.EXPECT().Commit().Return(nil).Times(2)
+		publishLatencyHistogram.EXPECT().Observe(gomock.Any()).Times(3)
+		mockOutboxDA.EXPECT().Count(gomock.Any()).Return(0, nil)
+		pendingGauge.EXPECT().Set(float64(0))
+		lagGauge.EXPECT().Set(float64(0))
+
+		err := usecase.Execute(ctx)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mockKafkaProducer.Close())
+	})
+
+	t.Run("should not delete outbox messages if they cannot be published", func(t *testing.T) {
+		mockKafkaProducer := mocks2.NewSyncProducer(t, nil)
+		usecase := NewRelayOutboxMessagesUseCase(mockDB, pkgsarama.NewProducer(mockKafkaProducer), 2, mockMetrics)
+		outboxMsgs := fakeOutboxMessages(1, 2)
+
+		mockLockDA.EXPECT().TryLock(gomock.Any(), relayOutboxMessagesLock).Return(true, nil)
+		mockOutboxDA.EXPECT().LockOldest(gomock.Any(), 2).Return(outboxMsgs, nil)
+		mockKafkaProducer.ExpectSendMessageAndFail(fmt.Errorf("error"))
+		mockKafkaProducer.ExpectSendMessageAndSucceed()
+		mockDBTX.EXPECT().Commit().Return(nil)
+		mockOutboxDA.EXPECT().Count(gomock.Any()).Return(2, nil)
+		mockOutboxDA.EXPECT().FindOldest(gomock.Any()).Return(outboxMsgs[0], nil)
+		pendingGauge.EXPECT().Set(float64(2))
+		lagGauge.EXPECT().Set(gomock.Any())
+
+		err := usecase.Execute(ctx)
+
+		assert.True(t, errors.IsKafkaConnectionError(err))
+	})
+
+	t.Run("should delete the outbox messages published before a failure", func(t *testing.T) {
+		mockKafkaProducer := mocks2.NewSyncProducer(t, nil)
+		usecase := NewRelayOutboxMessagesUseCase(mockDB, pkgsarama.NewProducer(mockKafkaProducer), 2, mockMetrics)
+		outboxMsgs := fakeOutboxMessages(1, 2)
+
+		mockLockDA.EXPECT().TryLock(gomock.Any(), relayOutboxMessagesLock).Return(true, nil)
+		mockOutboxDA.EXPECT().LockOldest(gomock.Any(), 2).Return(outboxMsgs, nil)
+		mockKafkaProducer.ExpectSendMessageAndFail(sarama.ProducerErrors{
+			{Msg: &sarama.ProducerMessage{Metadata: int64(2)}, Err: fmt.Errorf("error")},
+		})
+		mockKafkaProducer.ExpectSendMessageAndSucceed()
+		mockOutboxDA.EXPECT().Delete(gomock.Any(), []int64{1}).Return(nil)
+		mockDBTX.EXPECT().Commit().Return(nil)
+		publishLatencyHistogram.EXPECT().Observe(gomock.Any())
+		mockOutboxDA.EXPECT().Count(gomock.Any()).Return(1, nil)
+		mockOutboxDA.EXPECT().FindOldest(gomock.Any()).Return(outboxMsgs[1], nil)
+		pendingGauge.EXPECT().Set(float64(1))
+		lagGauge.EXPECT().Set(gomock.Any())
+
+		err := usecase.Execute(ctx)
+
+		assert.True(t, errors.IsKafkaConnectionError(err))
+	})
+
+	t.Run("should not relay outbox messages if another replica holds the relay lock", func(t *testing.T) {
+		mockKafkaProducer := mocks2.NewSyncProducer(t, nil)
+		usecase := NewRelayOutboxMessagesUseCase(mockDB, pkgsarama.NewProducer(mockKafkaProducer), 2, mockMetrics)
+
+		mockLockDA.EXPECT().TryLock(gomock.Any(), relayOutboxMessagesLock).Return(false, nil)
+		mockDBTX.EXPECT().Commit().Return(nil)
+		mockOutboxDA.EXPECT().Count(gomock.Any()).Return(0, nil)
+		pendingGauge.EXPECT().Set(float64(0))
+		lagGauge.EXPECT().Set(float64(0))
+
+		err := usecase.Execute(ctx)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mockKafkaProducer.Close())
+	})
+
+	t.Run("should fail with same error if outbox messages cannot be locked", func(t *testing.T) {
+		mockKafkaProducer := mocks2.NewSyncProducer(t, nil)
+		usecase := NewRelayOutboxMessagesUseCase(mockDB, pkgsarama.NewProducer(mockKafkaProducer), 2, mockMetrics)
+		expectedErr := errors.PostgresConnectionError("error")
+
+		mockLockDA.EXPECT().TryLock(gomock.Any(), relayOutboxMessagesLock).Return(true, nil)
+		mockOutboxDA.EXPECT().LockOldest(gomock.Any(), 2).Return(nil, expectedErr)
+		mockDBTX.EXPECT().Rollback().Return(nil)
+		mockOutboxDA.EXPECT().Count(gomock.Any()).Return(0, expectedErr)
+
+		err := usecase.Execute(ctx)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(relayOutboxMessagesComponent), err)
+	})
+}
@@ -8,33 +8,32 @@ import (
 	"github.com/consensys/orchestrate/pkg/utils/envelope"
 	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
 
-	"github.com/Shopify/sarama"
 	pkgsarama "github.com/consensys/orchestrate/pkg/broker/sarama"
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/services/api/business/parsers"
 	"github.com/consensys/orchestrate/services/api/store"
+	"github.com/consensys/orchestrate/services/api/store/models"
 )
 
 const resendJobTxComponent = "use-cases.resend-job-tx"
 
 type resendJobTxUseCase struct {
-	db            store.DB
-	kafkaProducer sarama.SyncProducer
-	topicsCfg     *pkgsarama.KafkaTopicConfig
-	logger        *log.Logger
+	db        store.DB
+	topicsCfg *pkgsarama.KafkaTopicConfig
+	logger    *log.Logger
 }
 
-func NewResendJobTxUseCase(db store.DB, kafkaProducer sarama.SyncProducer, topicsCfg *pkgsarama.KafkaTopicConfig) usecases.ResendJobTxUseCase {
+func NewResendJobTxUseCase(db store.DB, topicsCfg *pkgsarama.KafkaTopicConfig) usecases.ResendJobTxUseCase {
 	return &resendJobTxUseCase{
-		db:            db,
-		kafkaProducer: kafkaProducer,
-		topicsCfg:     topicsCfg,
-		logger:        log.NewLogger().SetComponent(resendJobTxComponent),
+		db:        db,
+		topicsCfg: topicsCfg,
+		logger:    log.NewLogger().SetComponent(resendJobTxComponent),
 	}
 }
 
-// Execute sends a job to the Kafka topic
+// Execute writes the job message to the outbox, the message is then published to the tx-sender topic by the outbox
+// relay in order with the other messages of the job
 func (uc *resendJobTxUseCase) Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) error {
 	ctx = log.WithFields(ctx, log.Field("job", jobUUID))
 	logger := uc.logger.WithContext(ctx)
@@ -53,12 +52,18 @@ func (uc *resendJobTxUseCase) Execute(ctx context.Context, jobUUID string, userI
 		return errors.InvalidStateError(errMessage)
 	}
 
-	partition, offset, err := envelope.SendJobMessage(jobEntity, uc.kafkaProducer, uc.topicsCfg.Sender, userInfo)
+	msg, err := envelope.NewJobMessage(jobEntity, uc.topicsCfg.Sender, userInfo)
 	if err != nil {
-		logger.WithError(err).Error("failed to send job message")
+		logger.WithError(err).Error("failed to craft job message")
 		return errors.FromError(err).ExtendComponent(resendJobTxComponent)
 	}
 
-	logger.WithField("partition", partition).WithField("offset", offset).Info("job resend successfully")
+	err = uc.db.Outbox().InsertMultiple(ctx, []*models.OutboxMessage{parsers.NewOutboxMessageModel(msg, jobUUID)})
+	if err != nil {
+		logger.WithError(err).Error("failed to write job message to the outbox")
+		return errors.FromError(err).ExtendComponent(resendJobTxComponent)
+	}
+
+	logger.Info("job resend successfully")
 	return nil
 }
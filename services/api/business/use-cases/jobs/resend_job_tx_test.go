@@ -4,11 +4,9 @@ package jobs
 
 import (
 	"context"
-	"fmt"
 	"testing"
 	"time"
 
-	mocks2 "github.com/Shopify/sarama/mocks"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/golang/mock/gomock"
 	"github.com/spf13/viper"
@@ -29,12 +27,13 @@ func TestResendJobTx_Execute(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockJobDA := mocks.NewMockJobAgent(ctrl)
-	mockKafkaProducer := mocks2.NewSyncProducer(t, nil)
+	mockOutboxDA := mocks.NewMockOutboxAgent(ctrl)
 	mockDB := mocks.NewMockDB(ctrl)
 	mockDB.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDB.EXPECT().Outbox().Return(mockOutboxDA).AnyTimes()
 
 	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
-	usecase := NewResendJobTxUseCase(mockDB, mockKafkaProducer, sarama.NewKafkaTopicConfig(viper.GetViper()))
+	usecase := NewResendJobTxUseCase(mockDB, sarama.NewKafkaTopicConfig(viper.GetViper()))
 
 	t.Run("should execute use case successfully", func(t *testing.T) {
 		job := testutils.FakeJobModel(1)
@@ -50,21 +49,25 @@ func TestResendJobTx_Execute(t *testing.T) {
 		})
 
 		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
-		mockKafkaProducer.ExpectSendMessageWithCheckerFunctionAndSucceed(func(val []byte) error {
-			txEnvelope := &tx.TxEnvelope{}
-			err := encoding.Unmarshal(val, txEnvelope)
-			if err != nil {
-				return err
-			}
-			envelope, err := txEnvelope.Envelope()
-			if err != nil {
-				return err
-			}
-
-			assert.Equal(t, envelope.GetJobUUID(), job.UUID)
-			assert.Equal(t, envelope.GetParentJobUUID(), job.UUID)
-			return nil
-		})
+		mockOutboxDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, outboxMsgs []*models.OutboxMessage) error {
+				assert.Len(t, outboxMsgs, 1)
+				assert.Equal(t, job.UUID, outboxMsgs[0].JobUUID)
+
+				txEnvelope := &tx.TxEnvelope{}
+				err := encoding.Unmarshal(outboxMsgs[0].Value, txEnvelope)
+				if err != nil {
+					return err
+				}
+				envelope, err := txEnvelope.Envelope()
+				if err != nil {
+					return err
+				}
+
+				assert.Equal(t, envelope.GetJobUUID(), job.UUID)
+				assert.Equal(t, envelope.GetParentJobUUID(), job.UUID)
+				return nil
+			})
 
 		err := usecase.Execute(ctx, job.UUID, userInfo)
 		assert.NoError(t, err)
@@ -81,7 +84,7 @@ func TestResendJobTx_Execute(t *testing.T) {
 		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(resendJobTxComponent), err)
 	})
 
-	t.Run("should fail with KafkaConnectionError if Produce fails", func(t *testing.T) {
+	t.Run("should fail with same error if the job message cannot be written to the outbox", func(t *testing.T) {
 		job := testutils.FakeJobModel(1)
 		job.UUID = "6380e2b6-b828-43ee-abdc-de0f8d57dc5f"
 		job.Transaction.Sender = "0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"
@@ -94,8 +97,10 @@ func TestResendJobTx_Execute(t *testing.T) {
 		})
 
 		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
-		mockKafkaProducer.ExpectSendMessageAndFail(fmt.Errorf("error"))
+		expectedErr := errors.PostgresConnectionError("error")
+		mockOutboxDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any()).Return(expectedErr)
+
 		err := usecase.Execute(ctx, job.UUID, userInfo)
-		assert.True(t, errors.IsKafkaConnectionError(err))
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(resendJobTxComponent), err)
 	})
 }
@@ -11,7 +11,6 @@ import (
 	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
 	"github.com/consensys/orchestrate/services/api/metrics"
 
-	"github.com/Shopify/sarama"
 	pkgsarama "github.com/consensys/orchestrate/pkg/broker/sarama"
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
@@ -21,33 +20,33 @@ import (
 )
 
 const startJobComponent = "use-cases.start-job"
+const scheduledJobMessage = "waiting for execution conditions"
 
 // startJobUseCase is a use case to start a transaction job
 type startJobUseCase struct {
-	db            store.DB
-	kafkaProducer sarama.SyncProducer
-	topicsCfg     *pkgsarama.KafkaTopicConfig
-	metrics       metrics.TransactionSchedulerMetrics
-	logger        *log.Logger
+	db        store.DB
+	topicsCfg *pkgsarama.KafkaTopicConfig
+	metrics   metrics.TransactionSchedulerMetrics
+	logger    *log.Logger
 }
 
 // NewStartJobUseCase creates a new StartJobUseCase
 func NewStartJobUseCase(
 	db store.DB,
-	kafkaProducer sarama.SyncProducer,
 	topicsCfg *pkgsarama.KafkaTopicConfig,
 	m metrics.TransactionSchedulerMetrics,
 ) usecases.StartJobUseCase {
 	return &startJobUseCase{
-		db:            db,
-		kafkaProducer: kafkaProducer,
-		topicsCfg:     topicsCfg,
-		metrics:       m,
-		logger:        log.NewLogger().SetComponent(startJobComponent),
+		db:        db,
+		topicsCfg: topicsCfg,
+		metrics:   m,
+		logger:    log.NewLogger().SetComponent(startJobComponent),
 	}
 }
 
-// Execute sends a job to the Kafka topic
+// Execute writes the job message to the outbox in the same DB transaction as the job status update, the message is
+// then published to the Kafka topic by the outbox relay. Jobs matching approval policies of their tenant await approval
+// and jobs whose execution conditions are not met yet are scheduled
 func (uc *startJobUseCase) Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) error {
 	logger := uc.logger.WithContext(ctx).WithField("job", jobUUID)
 	logger.Debug("starting job")
@@ -64,29 +63,66 @@ func (uc *startJobUseCase) Execute(ctx context.Context, jobUUID string, userInfo
 		return errors.InvalidStateError(errMessage)
 	}
 
-	err = uc.updateStatus(ctx, jobModel, entities.StatusStarted, "")
+	switch jobEntity.Status {
+	case entities.StatusCreated:
+		requirements, der := approvalRequirements(ctx, uc.db, jobEntity)
+		if der != nil {
+			return errors.FromError(der).ExtendComponent(startJobComponent)
+		}
+
+		if len(requirements) > 0 {
+			if jobModel.InternalData == nil {
+				jobModel.InternalData = &entities.InternalData{}
+			}
+			jobModel.InternalData.ApprovalRequirements = requirements
+
+			err = uc.updateStatus(ctx, jobModel, entities.StatusAwaitingApproval, awaitingApprovalLogMessage(requirements), nil)
+			if err != nil {
+				return errors.FromError(err).ExtendComponent(startJobComponent)
+			}
+
+			logger.Info("job awaiting approval")
+			return nil
+		}
+	case entities.StatusAwaitingApproval:
+		if !entities.IsApproved(jobEntity) {
+			errMessage := "cannot start job before it is approved"
+			logger.Error(errMessage)
+			return errors.InvalidStateError(errMessage)
+		}
+	}
+
+	if (jobEntity.Status == entities.StatusCreated || jobEntity.Status == entities.StatusAwaitingApproval) &&
+		entities.IsScheduledJob(jobEntity) {
+		err = uc.updateStatus(ctx, jobModel, entities.StatusScheduled, scheduledJobMessage, nil)
+		if err != nil {
+			return errors.FromError(err).ExtendComponent(startJobComponent)
+		}
+
+		logger.Info("job scheduled successfully")
+		return nil
+	}
+
+	msg, err := envelope.NewJobMessage(jobEntity, uc.topicsCfg.Sender, userInfo)
 	if err != nil {
+		logger.WithError(err).Error("failed to craft job message")
 		return errors.FromError(err).ExtendComponent(startJobComponent)
 	}
 
-	partition, offset, err := envelope.SendJobMessage(jobEntity, uc.kafkaProducer, uc.topicsCfg.Sender, userInfo)
+	err = uc.updateStatus(ctx, jobModel, entities.StatusStarted, "", parsers.NewOutboxMessageModel(msg, jobEntity.UUID))
 	if err != nil {
-		errMsg := "failed to send job message"
-		_ = uc.updateStatus(ctx, jobModel, entities.StatusFailed, errMsg)
-		logger.WithError(err).Error(errMsg)
 		return errors.FromError(err).ExtendComponent(startJobComponent)
 	}
 
-	logger.WithField("partition", partition).WithField("offset", offset).Info("job started successfully")
+	logger.Info("job started successfully")
 
 	return nil
 }
 
-func (uc *startJobUseCase) updateStatus(ctx context.Context, job *models.Job, status entities.JobStatus, msg string) error {
+func (uc *startJobUseCase) updateStatus(ctx context.Context, job *models.Job, status entities.JobStatus, msg string, outboxMsg *models.OutboxMessage) error {
 	prevUpdatedAt := job.UpdatedAt
 	prevStatus := job.Status
 
-	job.Status = status
 	jobLog := &models.Log{
 		JobID:   &job.ID,
 		Status:  status,
@@ -94,6 +130,11 @@ func (uc *startJobUseCase) updateStatus(ctx context.Context, job *models.Job, st
 	}
 
 	err := database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
+		if err := lockJobStatus(ctx, tx.(store.Tx), job.UUID, prevStatus); err != nil {
+			return err
+		}
+
+		job.Status = status
 		if err := tx.(store.Tx).Job().Update(ctx, job); err != nil {
 			return err
 		}
@@ -102,6 +143,10 @@ func (uc *startJobUseCase) updateStatus(ctx context.Context, job *models.Job, st
 			return errors.FromError(err).ExtendComponent(startJobComponent)
 		}
 
+		if outboxMsg != nil {
+			return tx.(store.Tx).Outbox().InsertMultiple(ctx, []*models.OutboxMessage{outboxMsg})
+		}
+
 		return nil
 	})
 
@@ -4,8 +4,8 @@ package jobs
 
 import (
 	"context"
-	"fmt"
 	"testing"
+	"time"
 
 	mock2 "github.com/consensys/orchestrate/pkg/toolkit/app/metrics/mock"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
@@ -16,14 +16,23 @@ import (
 	encoding "github.com/consensys/orchestrate/pkg/encoding/proto"
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/types/tx"
+	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models"
 	"github.com/consensys/orchestrate/services/api/store/models/testutils"
-	mocks2 "github.com/Shopify/sarama/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+// expectJobLock expects the row of the job to be locked and the job to be reloaded with its current status
+func expectJobLock(mockJobDA *mocks.MockJobAgent, job *models.Job) {
+	mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), job.UUID).Return(nil)
+	mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, []string{multitenancy.WildcardTenant}, multitenancy.WildcardOwner, false).
+		Return(&models.Job{UUID: job.UUID, Status: job.Status}, nil)
+}
+
 func TestStartJob_Execute(t *testing.T) {
 	ctx := context.Background()
 	ctrl := gomock.NewController(t)
@@ -32,7 +41,8 @@ func TestStartJob_Execute(t *testing.T) {
 	mockJobDA := mocks.NewMockJobAgent(ctrl)
 	mockLogDA := mocks.NewMockLogAgent(ctrl)
 	mockDBTX := mocks.NewMockTx(ctrl)
-	mockKafkaProducer := mocks2.NewSyncProducer(t, nil)
+	mockOutboxDA := mocks.NewMockOutboxAgent(ctrl)
+	mockApprovalPolicyDA := mocks.NewMockApprovalPolicyAgent(ctrl)
 	mockMetrics := mock.NewMockTransactionSchedulerMetrics(ctrl)
 
 	jobsLatencyHistogram := mock2.NewMockHistogram(ctrl)
@@ -48,9 +58,12 @@ func TestStartJob_Execute(t *testing.T) {
 	mockDB.EXPECT().Job().Return(mockJobDA).AnyTimes()
 	mockDBTX.EXPECT().Log().Return(mockLogDA).AnyTimes()
 	mockDBTX.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDBTX.EXPECT().Outbox().Return(mockOutboxDA).AnyTimes()
+	mockDB.EXPECT().ApprovalPolicy().Return(mockApprovalPolicyDA).AnyTimes()
+	mockApprovalPolicyDA.EXPECT().Search(gomock.Any(), gomock.Any(), gomock.Any(), multitenancy.WildcardOwner).Return(nil, nil).AnyTimes()
 
 	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
-	usecase := NewStartJobUseCase(mockDB, mockKafkaProducer, sarama.NewKafkaTopicConfig(viper.GetViper()), mockMetrics)
+	usecase := NewStartJobUseCase(mockDB, sarama.NewKafkaTopicConfig(viper.GetViper()), mockMetrics)
 
 	t.Run("should execute use case successfully", func(t *testing.T) {
 		job := testutils.FakeJobModel(1)
@@ -60,24 +73,22 @@ func TestStartJob_Execute(t *testing.T) {
 		job.Schedule = testutils.FakeSchedule("", "")
 
 		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
-		mockKafkaProducer.ExpectSendMessageWithCheckerFunctionAndSucceed(func(val []byte) error {
-			txEnvelope := &tx.TxEnvelope{}
-			err := encoding.Unmarshal(val, txEnvelope)
-			if err != nil {
-				return err
-			}
-			envelope, err := txEnvelope.Envelope()
-			if err != nil {
-				return err
-			}
-
-			assert.Equal(t, envelope.GetJobUUID(), job.UUID)
-			assert.False(t, envelope.IsOneTimeKeySignature())
-			return nil
-		})
-
+		expectJobLock(mockJobDA, job)
 		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
 		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockOutboxDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, outboxMsgs []*models.OutboxMessage) error {
+				txEnvelope := &tx.TxEnvelope{}
+				err := encoding.Unmarshal(outboxMsgs[0].Value, txEnvelope)
+				assert.NoError(t, err)
+				envelope, err := txEnvelope.Envelope()
+				assert.NoError(t, err)
+
+				assert.Equal(t, job.UUID, outboxMsgs[0].JobUUID)
+				assert.Equal(t, envelope.GetJobUUID(), job.UUID)
+				assert.False(t, envelope.IsOneTimeKeySignature())
+				return nil
+			})
 		mockDBTX.EXPECT().Commit().Return(nil)
 		err := usecase.Execute(ctx, job.UUID, userInfo)
 
@@ -95,28 +106,90 @@ func TestStartJob_Execute(t *testing.T) {
 		}
 
 		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
-		mockKafkaProducer.ExpectSendMessageWithCheckerFunctionAndSucceed(func(val []byte) error {
-			txEnvelope := &tx.TxEnvelope{}
-			err := encoding.Unmarshal(val, txEnvelope)
-			if err != nil {
-				return err
-			}
-			envelope, err := txEnvelope.Envelope()
-			if err != nil {
-				return err
-			}
-
-			assert.Equal(t, envelope.GetJobUUID(), job.UUID)
-			assert.True(t, envelope.IsOneTimeKeySignature())
+		expectJobLock(mockJobDA, job)
+		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockOutboxDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, outboxMsgs []*models.OutboxMessage) error {
+				txEnvelope := &tx.TxEnvelope{}
+				err := encoding.Unmarshal(outboxMsgs[0].Value, txEnvelope)
+				assert.NoError(t, err)
+				envelope, err := txEnvelope.Envelope()
+				assert.NoError(t, err)
+
+				assert.Equal(t, job.UUID, outboxMsgs[0].JobUUID)
+				assert.Equal(t, envelope.GetJobUUID(), job.UUID)
+				assert.True(t, envelope.IsOneTimeKeySignature())
+				return nil
+			})
+		mockDBTX.EXPECT().Commit().Return(nil)
+		err := usecase.Execute(ctx, job.UUID, userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should schedule job successfully if execution conditions are not met", func(t *testing.T) {
+		job := testutils.FakeJobModel(1)
+		job.ID = 1
+		job.UUID = "6380e2b6-b828-43ee-abdc-de0f8d57dc5f"
+		job.Schedule = testutils.FakeSchedule("", "")
+		notBefore := time.Now().Add(time.Hour)
+		job.InternalData = &entities.InternalData{
+			NotBefore: &notBefore,
+		}
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+		expectJobLock(mockJobDA, job)
+		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, jobLog *models.Log) error {
+			assert.Equal(t, entities.StatusScheduled, jobLog.Status)
 			return nil
 		})
+		mockDBTX.EXPECT().Commit().Return(nil)
+		err := usecase.Execute(ctx, job.UUID, userInfo)
 
+		assert.NoError(t, err)
+		assert.Equal(t, entities.StatusScheduled, job.Status)
+	})
+
+	t.Run("should start scheduled job successfully", func(t *testing.T) {
+		job := testutils.FakeJobModel(1)
+		job.ID = 1
+		job.UUID = "6380e2b6-b828-43ee-abdc-de0f8d57dc5f"
+		job.Transaction.Sender = "0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"
+		job.Schedule = testutils.FakeSchedule("", "")
+		job.Status = entities.StatusScheduled
+		job.InternalData = &entities.InternalData{
+			NotBeforeBlock: utils.ToPtr(uint64(100)).(*uint64),
+		}
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+		expectJobLock(mockJobDA, job)
 		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
 		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockOutboxDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any()).Return(nil)
 		mockDBTX.EXPECT().Commit().Return(nil)
 		err := usecase.Execute(ctx, job.UUID, userInfo)
 
 		assert.NoError(t, err)
+		assert.Equal(t, entities.StatusStarted, job.Status)
+	})
+
+	t.Run("should fail with InvalidStateError if the job was updated concurrently", func(t *testing.T) {
+		job := testutils.FakeJobModel(1)
+		job.ID = 1
+		job.UUID = "6380e2b6-b828-43ee-abdc-de0f8d57dc5f"
+		job.Schedule = testutils.FakeSchedule("", "")
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+		mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), job.UUID).Return(nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, []string{multitenancy.WildcardTenant}, multitenancy.WildcardOwner, false).
+			Return(&models.Job{UUID: job.UUID, Status: entities.StatusStarted}, nil)
+		mockDBTX.EXPECT().Rollback().Return(nil)
+
+		err := usecase.Execute(ctx, job.UUID, userInfo)
+
+		assert.True(t, errors.IsInvalidStateError(err))
 	})
 
 	t.Run("should fail with same error if FindOne fails", func(t *testing.T) {
@@ -141,6 +214,7 @@ func TestStartJob_Execute(t *testing.T) {
 		expectedErr := errors.PostgresConnectionError("error")
 
 		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+		expectJobLock(mockJobDA, job)
 		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
 		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(expectedErr)
 		mockDBTX.EXPECT().Rollback().Return(nil)
@@ -148,18 +222,153 @@ func TestStartJob_Execute(t *testing.T) {
 		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(startJobComponent), err)
 	})
 
-	t.Run("should fail with KafkaConnectionError if Produce fails", func(t *testing.T) {
+	t.Run("should fail with same error if Insert outbox message fails", func(t *testing.T) {
 		job := testutils.FakeJobModel(1)
 		job.UUID = "6380e2b6-b828-43ee-abdc-de0f8d57dc5f"
 		job.Transaction.Sender = "0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"
 		job.Schedule = testutils.FakeSchedule("", "")
+		expectedErr := errors.PostgresConnectionError("error")
 
 		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
-		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(2)
-		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil).Times(2)
-		mockDBTX.EXPECT().Commit().Return(nil).Times(2)
-		mockKafkaProducer.ExpectSendMessageAndFail(fmt.Errorf("error"))
+		expectJobLock(mockJobDA, job)
+		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockOutboxDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any()).Return(expectedErr)
+		mockDBTX.EXPECT().Rollback().Return(nil)
 		err := usecase.Execute(ctx, job.UUID, userInfo)
-		assert.True(t, errors.IsKafkaConnectionError(err))
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(startJobComponent), err)
+	})
+}
+
+func TestStartJob_ExecuteWithApprovalPolicies(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockJobDA := mocks.NewMockJobAgent(ctrl)
+	mockLogDA := mocks.NewMockLogAgent(ctrl)
+	mockDBTX := mocks.NewMockTx(ctrl)
+	mockOutboxDA := mocks.NewMockOutboxAgent(ctrl)
+	mockApprovalPolicyDA := mocks.NewMockApprovalPolicyAgent(ctrl)
+	mockMetrics := mock.NewMockTransactionSchedulerMetrics(ctrl)
+
+	jobsLatencyHistogram := mock2.NewMockHistogram(ctrl)
+	jobsLatencyHistogram.EXPECT().With(gomock.Any()).AnyTimes().Return(jobsLatencyHistogram)
+	jobsLatencyHistogram.EXPECT().Observe(gomock.Any()).AnyTimes()
+	mockMetrics.EXPECT().JobsLatencyHistogram().AnyTimes().Return(jobsLatencyHistogram)
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
+	mockDBTX.EXPECT().Close().Return(nil).AnyTimes()
+
+	mockDB.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDB.EXPECT().ApprovalPolicy().Return(mockApprovalPolicyDA).AnyTimes()
+	mockDBTX.EXPECT().Log().Return(mockLogDA).AnyTimes()
+	mockDBTX.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDBTX.EXPECT().Outbox().Return(mockOutboxDA).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewStartJobUseCase(mockDB, sarama.NewKafkaTopicConfig(viper.GetViper()), mockMetrics)
+
+	fakeJob := func(value string) *models.Job {
+		job := testutils.FakeJobModel(1)
+		job.ID = 1
+		job.UUID = "6380e2b6-b828-43ee-abdc-de0f8d57dc5f"
+		job.Transaction.Sender = "0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"
+		job.Transaction.Value = value
+		job.Schedule = testutils.FakeSchedule("tenantOne", "username")
+		return job
+	}
+
+	t.Run("should wait for approval if the job matches an approval policy", func(t *testing.T) {
+		job := fakeJob("2000")
+		policy := testutils.FakeApprovalPolicyModel()
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+		mockApprovalPolicyDA.EXPECT().Search(gomock.Any(), gomock.Any(), []string{"tenantOne"}, multitenancy.WildcardOwner).
+			Return([]*models.ApprovalPolicy{policy}, nil)
+		expectJobLock(mockJobDA, job)
+		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, jobLog *models.Log) error {
+			assert.Equal(t, entities.StatusAwaitingApproval, jobLog.Status)
+			assert.Equal(t, "waiting for approval: "+policy.Name, jobLog.Message)
+			return nil
+		})
+		mockDBTX.EXPECT().Commit().Return(nil)
+
+		err := usecase.Execute(ctx, job.UUID, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.StatusAwaitingApproval, job.Status)
+		require.Len(t, job.InternalData.ApprovalRequirements, 1)
+		assert.Equal(t, policy.UUID, job.InternalData.ApprovalRequirements[0].PolicyUUID)
+		assert.Equal(t, policy.Threshold, job.InternalData.ApprovalRequirements[0].Threshold)
+	})
+
+	t.Run("should start the job if it does not match any approval policy", func(t *testing.T) {
+		job := fakeJob("10")
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+		mockApprovalPolicyDA.EXPECT().Search(gomock.Any(), gomock.Any(), []string{"tenantOne"}, multitenancy.WildcardOwner).
+			Return([]*models.ApprovalPolicy{testutils.FakeApprovalPolicyModel()}, nil)
+		expectJobLock(mockJobDA, job)
+		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockOutboxDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any()).Return(nil)
+		mockDBTX.EXPECT().Commit().Return(nil)
+
+		err := usecase.Execute(ctx, job.UUID, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.StatusStarted, job.Status)
+	})
+
+	t.Run("should start the job once approved", func(t *testing.T) {
+		job := fakeJob("2000")
+		job.Status = entities.StatusAwaitingApproval
+		job.InternalData.ApprovalRequirements = []*entities.ApprovalRequirement{
+			{PolicyUUID: "policyUUID", Threshold: 1, Approvers: []string{"alice", "bob"}},
+		}
+		job.InternalData.Approvals = []string{"bob"}
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+		expectJobLock(mockJobDA, job)
+		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockOutboxDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any()).Return(nil)
+		mockDBTX.EXPECT().Commit().Return(nil)
+
+		err := usecase.Execute(ctx, job.UUID, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.StatusStarted, job.Status)
+	})
+
+	t.Run("should fail with InvalidStateError if the job is not approved yet", func(t *testing.T) {
+		job := fakeJob("2000")
+		job.Status = entities.StatusAwaitingApproval
+		job.InternalData.ApprovalRequirements = []*entities.ApprovalRequirement{
+			{PolicyUUID: "policyUUID", Threshold: 2, Approvers: []string{"alice", "bob"}},
+		}
+		job.InternalData.Approvals = []string{"bob"}
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+
+		err := usecase.Execute(ctx, job.UUID, userInfo)
+
+		assert.True(t, errors.IsInvalidStateError(err))
+	})
+
+	t.Run("should fail with same error if search approval policies fails", func(t *testing.T) {
+		job := fakeJob("2000")
+		expectedErr := errors.PostgresConnectionError("error")
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+		mockApprovalPolicyDA.EXPECT().Search(gomock.Any(), gomock.Any(), []string{"tenantOne"}, multitenancy.WildcardOwner).
+			Return(nil, expectedErr)
+
+		err := usecase.Execute(ctx, job.UUID, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(startJobComponent), err)
 	})
 }
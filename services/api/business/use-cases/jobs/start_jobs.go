@@ -0,0 +1,179 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	pkgsarama "github.com/consensys/orchestrate/pkg/broker/sarama"
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/database"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/utils/envelope"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/metrics"
+	"github.com/consensys/orchestrate/services/api/store"
+	"github.com/consensys/orchestrate/services/api/store/models"
+)
+
+const startJobsComponent = "use-cases.start-jobs"
+
+// startJobsUseCase is a use case to start several transaction jobs at once
+type startJobsUseCase struct {
+	db        store.DB
+	topicsCfg *pkgsarama.KafkaTopicConfig
+	metrics   metrics.TransactionSchedulerMetrics
+	logger    *log.Logger
+}
+
+// NewStartJobsUseCase creates a new StartJobsUseCase
+func NewStartJobsUseCase(
+	db store.DB,
+	topicsCfg *pkgsarama.KafkaTopicConfig,
+	m metrics.TransactionSchedulerMetrics,
+) usecases.StartJobsUseCase {
+	return &startJobsUseCase{
+		db:        db,
+		topicsCfg: topicsCfg,
+		metrics:   m,
+		logger:    log.NewLogger().SetComponent(startJobsComponent),
+	}
+}
+
+// Execute updates the jobs status and writes their messages to the outbox in a single DB transaction, the messages
+// are then published to the Kafka topic by the outbox relay. Jobs matching approval policies of their tenant await
+// approval and jobs whose execution conditions are not met yet are scheduled instead of being sent
+func (uc *startJobsUseCase) Execute(ctx context.Context, jobUUIDs []string, userInfo *multitenancy.UserInfo) error {
+	logger := uc.logger.WithContext(ctx).WithField("jobs", len(jobUUIDs))
+	logger.Debug("starting jobs")
+
+	if len(jobUUIDs) == 0 {
+		return nil
+	}
+
+	var jobModels, scheduledJobModels, awaitingJobModels []*models.Job
+	var outboxMsgs []*models.OutboxMessage
+	for _, jobUUID := range jobUUIDs {
+		jobModel, err := uc.db.Job().FindOneByUUID(ctx, jobUUID, userInfo.AllowedTenants, userInfo.Username, false)
+		if err != nil {
+			return errors.FromError(err).ExtendComponent(startJobsComponent)
+		}
+
+		jobEntity := parsers.NewJobEntityFromModels(jobModel)
+		if !canUpdateStatus(entities.StatusStarted, jobEntity.Status) {
+			errMessage := "cannot start job at the current status"
+			logger.WithField("job", jobUUID).WithField("status", jobEntity.Status).
+				WithField("next_status", entities.StatusStarted).Error(errMessage)
+			return errors.InvalidStateError(errMessage)
+		}
+
+		if jobEntity.Status == entities.StatusCreated {
+			requirements, der := approvalRequirements(ctx, uc.db, jobEntity)
+			if der != nil {
+				return errors.FromError(der).ExtendComponent(startJobsComponent)
+			}
+
+			if len(requirements) > 0 {
+				if jobModel.InternalData == nil {
+					jobModel.InternalData = &entities.InternalData{}
+				}
+				jobModel.InternalData.ApprovalRequirements = requirements
+				awaitingJobModels = append(awaitingJobModels, jobModel)
+				continue
+			}
+
+			if entities.IsScheduledJob(jobEntity) {
+				scheduledJobModels = append(scheduledJobModels, jobModel)
+				continue
+			}
+		}
+
+		msg, err := envelope.NewJobMessage(jobEntity, uc.topicsCfg.Sender, userInfo)
+		if err != nil {
+			logger.WithField("job", jobUUID).WithError(err).Error("failed to craft job message")
+			return errors.FromError(err).ExtendComponent(startJobsComponent)
+		}
+
+		jobModels = append(jobModels, jobModel)
+		outboxMsgs = append(outboxMsgs, parsers.NewOutboxMessageModel(msg, jobEntity.UUID))
+	}
+
+	err := uc.updateStatus(ctx, awaitingJobModels, entities.StatusAwaitingApproval, awaitingApprovalMessage, nil)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(startJobsComponent)
+	}
+
+	err = uc.updateStatus(ctx, scheduledJobModels, entities.StatusScheduled, scheduledJobMessage, nil)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(startJobsComponent)
+	}
+
+	if len(jobModels) == 0 {
+		logger.Info("jobs scheduled successfully")
+		return nil
+	}
+
+	err = uc.updateStatus(ctx, jobModels, entities.StatusStarted, "", outboxMsgs)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(startJobsComponent)
+	}
+
+	logger.Info("jobs started successfully")
+	return nil
+}
+
+func (uc *startJobsUseCase) updateStatus(ctx context.Context, jobs []*models.Job, status entities.JobStatus, msg string, outboxMsgs []*models.OutboxMessage) error {
+	if len(jobs) == 0 {
+		return nil
+	}
+
+	prevUpdatedAt := make([]time.Time, len(jobs))
+	prevStatus := make([]entities.JobStatus, len(jobs))
+
+	err := database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
+		for idx, job := range jobs {
+			prevUpdatedAt[idx] = job.UpdatedAt
+			prevStatus[idx] = job.Status
+
+			if err := lockJobStatus(ctx, tx.(store.Tx), job.UUID, prevStatus[idx]); err != nil {
+				return err
+			}
+
+			job.Status = status
+			if err := tx.(store.Tx).Job().Update(ctx, job); err != nil {
+				return err
+			}
+
+			jobLog := &models.Log{
+				JobID:   &job.ID,
+				Status:  status,
+				Message: msg,
+			}
+			if err := tx.(store.Tx).Log().Insert(ctx, jobLog); err != nil {
+				return err
+			}
+		}
+
+		if len(outboxMsgs) > 0 {
+			return tx.(store.Tx).Outbox().InsertMultiple(ctx, outboxMsgs)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for idx, job := range jobs {
+		uc.metrics.JobsLatencyHistogram().With(
+			"chain_uuid", job.ChainUUID,
+			"prev_status", string(prevStatus[idx]),
+			"status", string(status),
+		).Observe(job.UpdatedAt.Sub(prevUpdatedAt[idx]).Seconds())
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+// +build unit
+
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/broker/sarama"
+	encoding "github.com/consensys/orchestrate/pkg/encoding/proto"
+	"github.com/consensys/orchestrate/pkg/errors"
+	mock2 "github.com/consensys/orchestrate/pkg/toolkit/app/metrics/mock"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/tx"
+	"github.com/consensys/orchestrate/services/api/metrics/mock"
+	"github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	"github.com/consensys/orchestrate/services/api/store/models/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartJobs_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockJobDA := mocks.NewMockJobAgent(ctrl)
+	mockLogDA := mocks.NewMockLogAgent(ctrl)
+	mockDBTX := mocks.NewMockTx(ctrl)
+	mockOutboxDA := mocks.NewMockOutboxAgent(ctrl)
+	mockApprovalPolicyDA := mocks.NewMockApprovalPolicyAgent(ctrl)
+	mockMetrics := mock.NewMockTransactionSchedulerMetrics(ctrl)
+
+	jobsLatencyHistogram := mock2.NewMockHistogram(ctrl)
+	jobsLatencyHistogram.EXPECT().With(gomock.Any()).AnyTimes().Return(jobsLatencyHistogram)
+	jobsLatencyHistogram.EXPECT().Observe(gomock.Any()).AnyTimes()
+	mockMetrics.EXPECT().JobsLatencyHistogram().AnyTimes().Return(jobsLatencyHistogram)
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
+	mockDBTX.EXPECT().Close().Return(nil).AnyTimes()
+
+	mockDB.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDBTX.EXPECT().Log().Return(mockLogDA).AnyTimes()
+	mockDBTX.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDBTX.EXPECT().Outbox().Return(mockOutboxDA).AnyTimes()
+	mockDB.EXPECT().ApprovalPolicy().Return(mockApprovalPolicyDA).AnyTimes()
+	mockApprovalPolicyDA.EXPECT().Search(gomock.Any(), gomock.Any(), gomock.Any(), multitenancy.WildcardOwner).Return(nil, nil).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewStartJobsUseCase(mockDB, sarama.NewKafkaTopicConfig(viper.GetViper()), mockMetrics)
+
+	fakeJobs := func() []*models.Job {
+		jobs := []*models.Job{testutils.FakeJobModel(1), testutils.FakeJobModel(1)}
+		for idx, job := range jobs {
+			job.ID = idx + 1
+			job.UUID = fmt.Sprintf("6380e2b6-b828-43ee-abdc-de0f8d57dc5%d", idx)
+			job.Transaction.Sender = "0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"
+			job.Schedule = testutils.FakeSchedule("", "")
+		}
+		return jobs
+	}
+
+	t.Run("should start every job and write their messages to the outbox in a single transaction", func(t *testing.T) {
+		jobs := fakeJobs()
+
+		for _, job := range jobs {
+			mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+			expectJobLock(mockJobDA, job)
+		}
+
+		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		mockOutboxDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, outboxMsgs []*models.OutboxMessage) error {
+				assert.Len(t, outboxMsgs, 2)
+				for idx, outboxMsg := range outboxMsgs {
+					txEnvelope := &tx.TxEnvelope{}
+					err := encoding.Unmarshal(outboxMsg.Value, txEnvelope)
+					assert.NoError(t, err)
+					envelope, err := txEnvelope.Envelope()
+					assert.NoError(t, err)
+
+					assert.Equal(t, jobs[idx].UUID, outboxMsg.JobUUID)
+					assert.Equal(t, jobs[idx].UUID, envelope.GetJobUUID())
+				}
+				return nil
+			})
+		mockDBTX.EXPECT().Commit().Return(nil)
+
+		err := usecase.Execute(ctx, []string{jobs[0].UUID, jobs[1].UUID}, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.StatusStarted, jobs[0].Status)
+		assert.Equal(t, entities.StatusStarted, jobs[1].Status)
+	})
+
+	t.Run("should schedule jobs whose execution conditions are not met and start the other ones", func(t *testing.T) {
+		jobs := fakeJobs()
+		notBefore := time.Now().Add(time.Hour)
+		jobs[1].InternalData.NotBefore = &notBefore
+
+		for _, job := range jobs {
+			mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+			expectJobLock(mockJobDA, job)
+		}
+
+		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		mockOutboxDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, outboxMsgs []*models.OutboxMessage) error {
+				assert.Len(t, outboxMsgs, 1)
+				assert.Equal(t, jobs[0].UUID, outboxMsgs[0].JobUUID)
+				return nil
+			})
+		mockDBTX.EXPECT().Commit().Return(nil).Times(2)
+
+		err := usecase.Execute(ctx, []string{jobs[0].UUID, jobs[1].UUID}, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.StatusStarted, jobs[0].Status)
+		assert.Equal(t, entities.StatusScheduled, jobs[1].Status)
+	})
+
+	t.Run("should do nothing if there is no job to start", func(t *testing.T) {
+		err := usecase.Execute(ctx, []string{}, userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not start any job if one of them cannot be started", func(t *testing.T) {
+		jobs := fakeJobs()
+		jobs[1].Status = entities.StatusMined
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobs[0].UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(jobs[0], nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobs[1].UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(jobs[1], nil)
+
+		err := usecase.Execute(ctx, []string{jobs[0].UUID, jobs[1].UUID}, userInfo)
+
+		assert.True(t, errors.IsInvalidStateError(err))
+	})
+
+	t.Run("should fail with same error if outbox messages cannot be inserted", func(t *testing.T) {
+		jobs := fakeJobs()
+		expectedErr := errors.PostgresConnectionError("error")
+
+		for _, job := range jobs {
+			mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+			expectJobLock(mockJobDA, job)
+		}
+
+		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		mockOutboxDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any()).Return(expectedErr)
+		mockDBTX.EXPECT().Rollback().Return(nil)
+
+		err := usecase.Execute(ctx, []string{jobs[0].UUID, jobs[1].UUID}, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(startJobsComponent), err)
+	})
+}
@@ -51,11 +51,7 @@ func (uc *updateChildrenUseCase) Execute(ctx context.Context, jobUUID, parentJob
 		return err
 	}
 
-	jobsToUpdate, err := uc.db.Job().Search(ctx, &entities.JobFilters{
-		ParentJobUUID: parentJobUUID,
-		Status:        entities.StatusPending,
-	}, userInfo.AllowedTenants, userInfo.Username)
-
+	jobsToUpdate, err := uc.searchPendingJobs(ctx, parentJobUUID, userInfo)
 	if err != nil {
 		return errors.FromError(err).ExtendComponent(updateChildrenComponent)
 	}
@@ -88,3 +84,29 @@ func (uc *updateChildrenUseCase) Execute(ctx context.Context, jobUUID, parentJob
 	logger.WithField("status", nextStatus).Info("children (and/or parent) jobs updated successfully")
 	return nil
 }
+
+// searchPendingJobs fetches all the pending children of a job, following the cursor across pages
+func (uc *updateChildrenUseCase) searchPendingJobs(ctx context.Context, parentJobUUID string,
+	userInfo *multitenancy.UserInfo) ([]*models.Job, error) {
+	filters := &entities.JobFilters{
+		ParentJobUUID: parentJobUUID,
+		Status:        entities.StatusPending,
+		Pagination:    entities.CursorPagination{Limit: entities.MaxPageSize},
+	}
+
+	var jobModels []*models.Job
+	for {
+		page, err := uc.db.Job().Search(ctx, filters, userInfo.AllowedTenants, userInfo.Username)
+		if err != nil {
+			return nil, err
+		}
+
+		jobModels = append(jobModels, page...)
+		if len(page) < filters.Pagination.Limit {
+			return jobModels, nil
+		}
+
+		last := page[len(page)-1]
+		filters.Pagination.Cursor = (&entities.Cursor{Time: last.CreatedAt, UUID: last.UUID}).String()
+	}
+}
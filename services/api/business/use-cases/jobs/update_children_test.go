@@ -42,7 +42,8 @@ func TestUpdateChildren_Execute(t *testing.T) {
 		jobsToUpdate[0].Status = entities.StatusPending
 		jobsToUpdate[1].Status = entities.StatusPending
 
-		mockJobDA.EXPECT().Search(gomock.Any(), &entities.JobFilters{ParentJobUUID: parentJobUUID, Status: entities.StatusPending},
+		mockJobDA.EXPECT().Search(gomock.Any(), &entities.JobFilters{ParentJobUUID: parentJobUUID, Status: entities.StatusPending,
+			Pagination: entities.CursorPagination{Limit: entities.MaxPageSize}},
 			userInfo.AllowedTenants, userInfo.Username).Return(jobsToUpdate, nil)
 		mockJobDA.EXPECT().Update(gomock.Any(), jobsToUpdate[0]).Return(nil)
 		mockJobDA.EXPECT().Update(gomock.Any(), jobsToUpdate[1]).Return(nil)
@@ -72,7 +73,8 @@ func TestUpdateChildren_Execute(t *testing.T) {
 		jobsToUpdate[0].Status = entities.StatusPending
 		jobsToUpdate[1].Status = entities.StatusPending
 
-		mockJobDA.EXPECT().Search(gomock.Any(), &entities.JobFilters{ParentJobUUID: parentJobUUID, Status: entities.StatusPending},
+		mockJobDA.EXPECT().Search(gomock.Any(), &entities.JobFilters{ParentJobUUID: parentJobUUID, Status: entities.StatusPending,
+			Pagination: entities.CursorPagination{Limit: entities.MaxPageSize}},
 			userInfo.AllowedTenants, userInfo.Username).Return(jobsToUpdate, nil)
 		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
 		mockLogDA.EXPECT().Insert(gomock.Any(), &models.Log{
@@ -86,6 +88,36 @@ func TestUpdateChildren_Execute(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("should update the children jobs of all the pages", func(t *testing.T) {
+		firstPage := make([]*models.Job, entities.MaxPageSize)
+		for idx := range firstPage {
+			firstPage[idx] = testutils.FakeJobModel(1)
+		}
+		secondPage := []*models.Job{testutils.FakeJobModel(1)}
+		lastOfFirstPage := firstPage[len(firstPage)-1]
+		expectedCursor := (&entities.Cursor{Time: lastOfFirstPage.CreatedAt, UUID: lastOfFirstPage.UUID}).String()
+
+		gomock.InOrder(
+			mockJobDA.EXPECT().Search(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username).
+				DoAndReturn(func(ctx context.Context, filters *entities.JobFilters, tenants []string, ownerID string) ([]*models.Job, error) {
+					assert.Empty(t, filters.Pagination.Cursor)
+					return firstPage, nil
+				}),
+			mockJobDA.EXPECT().Search(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username).
+				DoAndReturn(func(ctx context.Context, filters *entities.JobFilters, tenants []string, ownerID string) ([]*models.Job, error) {
+					assert.Equal(t, expectedCursor, filters.Pagination.Cursor)
+					return secondPage, nil
+				}),
+		)
+		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(entities.MaxPageSize + 1)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil).Times(entities.MaxPageSize + 1)
+
+		err := usecase.Execute(ctx, "jobUUID", "parentJobUUID", status, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, status, secondPage[0].Status)
+	})
+
 	t.Run("should fail with same error if Search fails", func(t *testing.T) {
 		expectedErr := fmt.Errorf("error")
 
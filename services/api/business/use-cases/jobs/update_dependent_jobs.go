@@ -0,0 +1,334 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/database"
+	"github.com/consensys/orchestrate/pkg/toolkit/ethclient"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/ethereum"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const updateDependentJobsComponent = "use-cases.update-dependent-jobs"
+
+// updateDependentJobsUseCase is a use case to start or fail the jobs of a schedule graph depending on a finalized job
+type updateDependentJobsUseCase struct {
+	db                  store.DB
+	getChainUC          usecases.GetChainUseCase
+	getContractUC       usecases.GetContractUseCase
+	startJobUC          usecases.StartJobUseCase
+	enforceTxPoliciesUC usecases.EnforceTransactionPoliciesUseCase
+	ethClient           ethclient.Client
+	logger              *log.Logger
+}
+
+// NewUpdateDependentJobsUseCase creates a new UpdateDependentJobsUseCase
+func NewUpdateDependentJobsUseCase(
+	db store.DB,
+	getChainUC usecases.GetChainUseCase,
+	getContractUC usecases.GetContractUseCase,
+	startJobUC usecases.StartJobUseCase,
+	enforceTxPoliciesUC usecases.EnforceTransactionPoliciesUseCase,
+	ec ethclient.Client,
+) usecases.UpdateDependentJobsUseCase {
+	return &updateDependentJobsUseCase{
+		db:                  db,
+		getChainUC:          getChainUC,
+		getContractUC:       getContractUC,
+		startJobUC:          startJobUC,
+		enforceTxPoliciesUC: enforceTxPoliciesUC,
+		ethClient:           ec,
+		logger:              log.NewLogger().SetComponent(updateDependentJobsComponent),
+	}
+}
+
+// Execute starts the jobs depending on a mined job once all the jobs they depend on are mined, crafting their
+// transaction from the referenced receipts. When a job fails, all the jobs depending on it, directly or not, are failed
+func (uc *updateDependentJobsUseCase) Execute(ctx context.Context, job *entities.Job, userInfo *multitenancy.UserInfo) error {
+	// Retried jobs are children of the job of the graph
+	jobUUID := job.UUID
+	if job.InternalData.ParentJobUUID != "" {
+		jobUUID = job.InternalData.ParentJobUUID
+	}
+
+	ctx = log.WithFields(ctx, log.Field("job", jobUUID), log.Field("schedule", job.ScheduleUUID))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("updating dependent jobs")
+
+	scheduleModel, err := uc.db.Schedule().FindOneByUUID(ctx, job.ScheduleUUID, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(updateDependentJobsComponent)
+	}
+
+	switch job.Status {
+	case entities.StatusMined:
+		err = uc.startDependentJobs(ctx, jobUUID, scheduleModel.Jobs, userInfo)
+	case entities.StatusFailed:
+		err = uc.failDependentJobs(ctx, []string{jobUUID}, scheduleModel.Jobs, fmt.Sprintf("dependency %s failed", jobUUID), userInfo)
+	}
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(updateDependentJobsComponent)
+	}
+
+	return nil
+}
+
+func (uc *updateDependentJobsUseCase) startDependentJobs(ctx context.Context, minedJobUUID string, jobs []*models.Job, userInfo *multitenancy.UserInfo) error {
+	logger := uc.logger.WithContext(ctx)
+
+	for _, jobModel := range jobs {
+		if jobModel.Status != entities.StatusCreated || !utils.ContainsString(jobModel.InternalData.DependsOn, minedJobUUID) {
+			continue
+		}
+
+		if !areJobsMined(jobModel.InternalData.DependsOn, jobs) {
+			logger.WithField("dependent_job", jobModel.UUID).Debug("dependent job is waiting for other jobs to be mined")
+			continue
+		}
+
+		err := uc.craftTransaction(ctx, jobModel.UUID, jobs, userInfo)
+		// Job was crafted and started by the update of another job it depends on
+		if errors.IsInvalidStateError(err) {
+			continue
+		}
+		if err != nil {
+			logger.WithError(err).WithField("dependent_job", jobModel.UUID).Error("failed to craft transaction of dependent job")
+			der := uc.failDependentJobs(ctx, []string{jobModel.UUID}, jobs, fmt.Sprintf("failed to craft transaction: %s", err.Error()), userInfo)
+			if der != nil {
+				return der
+			}
+			continue
+		}
+
+		err = uc.startJobUC.Execute(ctx, jobModel.UUID, userInfo)
+		// Job was started by the update of another job it depends on
+		if errors.IsInvalidStateError(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		logger.WithField("dependent_job", jobModel.UUID).Info("dependent job started")
+	}
+
+	return nil
+}
+
+// craftTransaction computes the recipient and the data of a transaction referencing the receipts of the jobs it depends on.
+// The crafted transaction is checked against the transaction policies of the tenant and saved only if the job is still
+// waiting for its dependencies
+func (uc *updateDependentJobsUseCase) craftTransaction(ctx context.Context, jobUUID string, jobs []*models.Job, userInfo *multitenancy.UserInfo) error {
+	jobModel, err := uc.db.Job().FindOneByUUID(ctx, jobUUID, userInfo.AllowedTenants, userInfo.Username, false)
+	if err != nil {
+		return err
+	}
+
+	template := jobModel.InternalData.TxTemplate
+	if template == nil {
+		return nil
+	}
+
+	receipts := make(map[string]*ethereum.Receipt)
+	resolveReference := func(refJobUUID, field string) (string, error) {
+		receipt, ok := receipts[refJobUUID]
+		if !ok {
+			var der error
+			receipt, der = uc.fetchReceipt(ctx, refJobUUID, jobModel.ChainUUID, jobs, userInfo)
+			if der != nil {
+				return "", der
+			}
+			receipts[refJobUUID] = receipt
+		}
+
+		value, der := parsers.GetReceiptField(receipt, field)
+		if der != nil {
+			return "", der
+		}
+		if value == "" {
+			return "", errors.DataError("receipt field '%s' of job %s is empty", field, refJobUUID)
+		}
+
+		return value, nil
+	}
+
+	to, err := parsers.ReplaceJobReferences(template.To, resolveReference)
+	if err != nil {
+		return err
+	}
+	args, err := parsers.ReplaceJobReferences(template.Args, resolveReference)
+	if err != nil {
+		return err
+	}
+
+	// Resolve the contract as the tenant of the job, the update being possibly triggered by an internal service
+	contract, err := uc.getContractUC.Execute(ctx, jobModel.Transaction.ContractName, jobModel.Transaction.ContractTag,
+		multitenancy.NewUserInfo(jobModel.Schedule.TenantID, jobModel.Schedule.OwnerID))
+	if err != nil {
+		return err
+	}
+
+	var txData hexutil.Bytes
+	if to == "" {
+		txData, err = parsers.EncodeContractDeployment(contract, args.([]interface{}), template.Libraries)
+	} else {
+		if !ethcommon.IsHexAddress(to.(string)) {
+			return errors.InvalidParameterError("recipient '%s' is not an address", to)
+		}
+
+		jobModel.Transaction.Recipient = ethcommon.HexToAddress(to.(string)).String()
+		txData, err = parsers.EncodeContractCall(contract, template.MethodSignature, args.([]interface{}))
+	}
+	if err != nil {
+		return err
+	}
+
+	jobModel.Transaction.Data = txData.String()
+
+	return database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
+		if der := lockJobStatus(ctx, tx.(store.Tx), jobModel.UUID, entities.StatusCreated); der != nil {
+			return der
+		}
+
+		der := uc.enforceTxPoliciesUC.WithDBTransaction(tx.(store.Tx)).
+			Execute(ctx, parsers.NewJobEntityFromModels(jobModel), jobModel.Schedule.TenantID)
+		if der != nil {
+			return der
+		}
+
+		return tx.(store.Tx).Transaction().Update(ctx, jobModel.Transaction)
+	})
+}
+
+func (uc *updateDependentJobsUseCase) fetchReceipt(ctx context.Context, jobUUID, chainUUID string, jobs []*models.Job, userInfo *multitenancy.UserInfo) (*ethereum.Receipt, error) {
+	minedJob := findMinedJob(jobUUID, jobs)
+	if minedJob == nil {
+		return nil, errors.DataError("job %s is not mined", jobUUID)
+	}
+
+	minedJobModel, err := uc.db.Job().FindOneByUUID(ctx, minedJob.UUID, userInfo.AllowedTenants, userInfo.Username, false)
+	if err != nil {
+		return nil, err
+	}
+
+	chain, err := uc.getChainUC.Execute(ctx, chainUUID, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, uri := range chain.URLs {
+		receipt, der := uc.ethClient.TransactionReceipt(ctx, uri, ethcommon.HexToHash(minedJobModel.Transaction.Hash))
+		if der != nil {
+			uc.logger.WithContext(ctx).WithField("url", uri).WithError(der).Warn("failed to fetch transaction receipt")
+			continue
+		}
+
+		return receipt, nil
+	}
+
+	return nil, errors.EthConnectionError("failed to fetch transaction receipt for all urls")
+}
+
+// failDependentJobs fails the jobs waiting for the given jobs, and all the jobs depending on them
+func (uc *updateDependentJobsUseCase) failDependentJobs(ctx context.Context, jobUUIDs []string, jobs []*models.Job, message string, userInfo *multitenancy.UserInfo) error {
+	var jobsToFail []string
+	queue := jobUUIDs
+	for len(queue) > 0 {
+		jobUUID := queue[0]
+		queue = queue[1:]
+
+		for _, jobModel := range jobs {
+			if jobModel.Status == entities.StatusCreated && utils.ContainsString(jobModel.InternalData.DependsOn, jobUUID) &&
+				!utils.ContainsString(jobsToFail, jobModel.UUID) {
+				jobsToFail = append(jobsToFail, jobModel.UUID)
+				queue = append(queue, jobModel.UUID)
+			}
+		}
+	}
+
+	// The first job is failed as well when it is not mined
+	if job := findJob(jobUUIDs[0], jobs); job != nil && job.Status == entities.StatusCreated {
+		jobsToFail = append([]string{job.UUID}, jobsToFail...)
+	}
+
+	if len(jobsToFail) == 0 {
+		return nil
+	}
+
+	// Jobs waiting for their dependencies were never started, so their status is updated regardless of the state machine
+	err := database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
+		for _, jobUUID := range jobsToFail {
+			if der := tx.(store.Tx).Job().LockOneByUUID(ctx, jobUUID); der != nil {
+				return der
+			}
+
+			jobModel, der := tx.(store.Tx).Job().FindOneByUUID(ctx, jobUUID, userInfo.AllowedTenants, userInfo.Username, false)
+			if der != nil {
+				return der
+			}
+			if jobModel.Status != entities.StatusCreated {
+				continue
+			}
+
+			jobLogModel := &models.Log{JobID: &jobModel.ID, Status: entities.StatusFailed, Message: message}
+			if der = tx.(store.Tx).Log().Insert(ctx, jobLogModel); der != nil {
+				return der
+			}
+
+			jobModel.Status = entities.StatusFailed
+			if der = tx.(store.Tx).Job().Update(ctx, jobModel); der != nil {
+				return der
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	uc.logger.WithContext(ctx).WithField("jobs", jobsToFail).Info("dependent jobs failed")
+	return nil
+}
+
+// areJobsMined checks that every job, or one of its retries, is mined
+func areJobsMined(jobUUIDs []string, jobs []*models.Job) bool {
+	for _, jobUUID := range jobUUIDs {
+		if findMinedJob(jobUUID, jobs) == nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+func findMinedJob(jobUUID string, jobs []*models.Job) *models.Job {
+	for _, jobModel := range jobs {
+		if jobModel.Status == entities.StatusMined && (jobModel.UUID == jobUUID || jobModel.InternalData.ParentJobUUID == jobUUID) {
+			return jobModel
+		}
+	}
+
+	return nil
+}
+
+func findJob(jobUUID string, jobs []*models.Job) *models.Job {
+	for _, jobModel := range jobs {
+		if jobModel.UUID == jobUUID {
+			return jobModel
+		}
+	}
+
+	return nil
+}
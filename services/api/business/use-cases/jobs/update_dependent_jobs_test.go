@@ -0,0 +1,328 @@
+// +build unit
+
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/ethclient/mock"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/ethereum"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	mocks2 "github.com/consensys/orchestrate/services/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	testutils2 "github.com/consensys/orchestrate/services/api/store/models/testutils"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateDependentJobs_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockDBTX := mocks.NewMockTx(ctrl)
+	mockScheduleDA := mocks.NewMockScheduleAgent(ctrl)
+	mockJobDA := mocks.NewMockJobAgent(ctrl)
+	mockLogDA := mocks.NewMockLogAgent(ctrl)
+	mockTransactionDA := mocks.NewMockTransactionAgent(ctrl)
+	mockGetChainUC := mocks2.NewMockGetChainUseCase(ctrl)
+	mockGetContractUC := mocks2.NewMockGetContractUseCase(ctrl)
+	mockStartJobUC := mocks2.NewMockStartJobUseCase(ctrl)
+	mockEnforceTxPoliciesUC := mocks2.NewMockEnforceTransactionPoliciesUseCase(ctrl)
+	mockEthClient := mock.NewMockClient(ctrl)
+
+	mockDB.EXPECT().Schedule().Return(mockScheduleDA).AnyTimes()
+	mockDB.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDB.EXPECT().Transaction().Return(mockTransactionDA).AnyTimes()
+	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
+	mockDBTX.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDBTX.EXPECT().Log().Return(mockLogDA).AnyTimes()
+	mockDBTX.EXPECT().Transaction().Return(mockTransactionDA).AnyTimes()
+	mockDBTX.EXPECT().Commit().Return(nil).AnyTimes()
+	mockDBTX.EXPECT().Rollback().Return(nil).AnyTimes()
+	mockDBTX.EXPECT().Close().Return(nil).AnyTimes()
+	mockEnforceTxPoliciesUC.EXPECT().WithDBTransaction(mockDBTX).Return(mockEnforceTxPoliciesUC).AnyTimes()
+
+	ctx := context.Background()
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	chain := testutils.FakeChain()
+	contract := testutils.FakeContract()
+	contractAddress := "0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"
+
+	usecase := NewUpdateDependentJobsUseCase(mockDB, mockGetChainUC, mockGetContractUC, mockStartJobUC, mockEnforceTxPoliciesUC,
+		mockEthClient)
+
+	newJobModel := func(status entities.JobStatus, dependsOn ...string) *models.Job {
+		jobModel := testutils2.FakeJobModel(1)
+		jobModel.Status = status
+		jobModel.ChainUUID = chain.UUID
+		jobModel.InternalData.GraphJobID = jobModel.UUID
+		jobModel.InternalData.DependsOn = dependsOn
+		return jobModel
+	}
+
+	t.Run("should craft and start dependent job when all its dependencies are mined", func(t *testing.T) {
+		deployJob := newJobModel(entities.StatusMined)
+		otherJob := newJobModel(entities.StatusNeverMined)
+		otherJobRetry := newJobModel(entities.StatusMined)
+		otherJobRetry.InternalData.ParentJobUUID = otherJob.UUID
+		dependentJob := newJobModel(entities.StatusCreated, deployJob.UUID, otherJob.UUID)
+		reference := parsers.NewJobReference(deployJob.UUID, entities.ReceiptContractAddress)
+		dependentJob.InternalData.TxTemplate = &entities.TxTemplate{
+			To:              reference,
+			MethodSignature: "transfer(address,uint256)",
+			Args:            []interface{}{reference, "10"},
+		}
+		dependentJob.Transaction.ContractName = contract.Name
+		dependentJob.Transaction.ContractTag = contract.Tag
+		schedule := &models.Schedule{Jobs: []*models.Job{deployJob, otherJob, otherJobRetry, dependentJob}}
+
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), "scheduleUUID", userInfo.AllowedTenants, userInfo.Username).
+			Return(schedule, nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), dependentJob.UUID, userInfo.AllowedTenants, userInfo.Username, false).
+			Return(dependentJob, nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), deployJob.UUID, userInfo.AllowedTenants, userInfo.Username, false).
+			Return(deployJob, nil)
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), chain.UUID, userInfo).Return(chain, nil)
+		mockEthClient.EXPECT().TransactionReceipt(gomock.Any(), chain.URLs[0], ethcommon.HexToHash(deployJob.Transaction.Hash)).
+			Return(&ethereum.Receipt{ContractAddress: contractAddress}, nil)
+		mockGetContractUC.EXPECT().
+			Execute(gomock.Any(), contract.Name, contract.Tag, multitenancy.NewUserInfo(dependentJob.Schedule.TenantID, dependentJob.Schedule.OwnerID)).
+			Return(contract, nil)
+		expectJobLock(mockJobDA, dependentJob)
+		mockEnforceTxPoliciesUC.EXPECT().Execute(gomock.Any(), gomock.Any(), dependentJob.Schedule.TenantID).
+			DoAndReturn(func(_ context.Context, job *entities.Job, _ string) error {
+				assert.Equal(t, contractAddress, job.Transaction.To.String())
+				return nil
+			})
+		mockTransactionDA.EXPECT().Update(gomock.Any(), dependentJob.Transaction).
+			DoAndReturn(func(_ context.Context, tx *models.Transaction) error {
+				expectedData, _ := parsers.EncodeContractCall(contract, "transfer(address,uint256)", []interface{}{contractAddress, "10"})
+				assert.Equal(t, contractAddress, tx.Recipient)
+				assert.Equal(t, expectedData.String(), tx.Data)
+				return nil
+			})
+		mockStartJobUC.EXPECT().Execute(gomock.Any(), dependentJob.UUID, userInfo).Return(nil)
+
+		err := usecase.Execute(ctx, &entities.Job{
+			UUID:         deployJob.UUID,
+			ScheduleUUID: "scheduleUUID",
+			Status:       entities.StatusMined,
+			InternalData: deployJob.InternalData,
+		}, userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not start dependent job if one of its dependencies is not mined", func(t *testing.T) {
+		deployJob := newJobModel(entities.StatusMined)
+		otherJob := newJobModel(entities.StatusPending)
+		dependentJob := newJobModel(entities.StatusCreated, deployJob.UUID, otherJob.UUID)
+		schedule := &models.Schedule{Jobs: []*models.Job{deployJob, otherJob, dependentJob}}
+
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), "scheduleUUID", userInfo.AllowedTenants, userInfo.Username).
+			Return(schedule, nil)
+
+		err := usecase.Execute(ctx, &entities.Job{
+			UUID:         deployJob.UUID,
+			ScheduleUUID: "scheduleUUID",
+			Status:       entities.StatusMined,
+			InternalData: deployJob.InternalData,
+		}, userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should ignore dependent job already started", func(t *testing.T) {
+		deployJob := newJobModel(entities.StatusMined)
+		dependentJob := newJobModel(entities.StatusCreated, deployJob.UUID)
+		schedule := &models.Schedule{Jobs: []*models.Job{deployJob, dependentJob}}
+
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), "scheduleUUID", userInfo.AllowedTenants, userInfo.Username).
+			Return(schedule, nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), dependentJob.UUID, userInfo.AllowedTenants, userInfo.Username, false).
+			Return(dependentJob, nil)
+		mockStartJobUC.EXPECT().Execute(gomock.Any(), dependentJob.UUID, userInfo).Return(errors.InvalidStateError("error"))
+
+		err := usecase.Execute(ctx, &entities.Job{
+			UUID:         deployJob.UUID,
+			ScheduleUUID: "scheduleUUID",
+			Status:       entities.StatusMined,
+			InternalData: deployJob.InternalData,
+		}, userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail dependent job if its crafted transaction violates a transaction policy", func(t *testing.T) {
+		deployJob := newJobModel(entities.StatusMined)
+		dependentJob := newJobModel(entities.StatusCreated, deployJob.UUID)
+		dependentJob.InternalData.TxTemplate = &entities.TxTemplate{
+			To:              parsers.NewJobReference(deployJob.UUID, entities.ReceiptContractAddress),
+			MethodSignature: "transfer(address,uint256)",
+			Args:            []interface{}{contractAddress, "10"},
+		}
+		schedule := &models.Schedule{Jobs: []*models.Job{deployJob, dependentJob}}
+
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), "scheduleUUID", userInfo.AllowedTenants, userInfo.Username).
+			Return(schedule, nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), dependentJob.UUID, userInfo.AllowedTenants, userInfo.Username, false).
+			Return(dependentJob, nil).Times(2)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), deployJob.UUID, userInfo.AllowedTenants, userInfo.Username, false).
+			Return(deployJob, nil)
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), chain.UUID, userInfo).Return(chain, nil)
+		mockEthClient.EXPECT().TransactionReceipt(gomock.Any(), chain.URLs[0], gomock.Any()).
+			Return(&ethereum.Receipt{ContractAddress: contractAddress}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(contract, nil)
+		expectJobLock(mockJobDA, dependentJob)
+		mockEnforceTxPoliciesUC.EXPECT().Execute(gomock.Any(), gomock.Any(), dependentJob.Schedule.TenantID).
+			Return(errors.PolicyViolationError("error"))
+		mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), dependentJob.UUID).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), dependentJob).Return(nil)
+
+		err := usecase.Execute(ctx, &entities.Job{
+			UUID:         deployJob.UUID,
+			ScheduleUUID: "scheduleUUID",
+			Status:       entities.StatusMined,
+			InternalData: deployJob.InternalData,
+		}, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.StatusFailed, dependentJob.Status)
+	})
+
+	t.Run("should ignore dependent job crafted and started concurrently", func(t *testing.T) {
+		deployJob := newJobModel(entities.StatusMined)
+		dependentJob := newJobModel(entities.StatusCreated, deployJob.UUID)
+		dependentJob.InternalData.TxTemplate = &entities.TxTemplate{
+			To:              parsers.NewJobReference(deployJob.UUID, entities.ReceiptContractAddress),
+			MethodSignature: "transfer(address,uint256)",
+			Args:            []interface{}{contractAddress, "10"},
+		}
+		schedule := &models.Schedule{Jobs: []*models.Job{deployJob, dependentJob}}
+
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), "scheduleUUID", userInfo.AllowedTenants, userInfo.Username).
+			Return(schedule, nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), dependentJob.UUID, userInfo.AllowedTenants, userInfo.Username, false).
+			Return(dependentJob, nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), deployJob.UUID, userInfo.AllowedTenants, userInfo.Username, false).
+			Return(deployJob, nil)
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), chain.UUID, userInfo).Return(chain, nil)
+		mockEthClient.EXPECT().TransactionReceipt(gomock.Any(), chain.URLs[0], gomock.Any()).
+			Return(&ethereum.Receipt{ContractAddress: contractAddress}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(contract, nil)
+		expectJobLock(mockJobDA, &models.Job{UUID: dependentJob.UUID, Status: entities.StatusStarted})
+
+		err := usecase.Execute(ctx, &entities.Job{
+			UUID:         deployJob.UUID,
+			ScheduleUUID: "scheduleUUID",
+			Status:       entities.StatusMined,
+			InternalData: deployJob.InternalData,
+		}, userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail dependent job and its descendants if its transaction cannot be crafted", func(t *testing.T) {
+		deployJob := newJobModel(entities.StatusMined)
+		dependentJob := newJobModel(entities.StatusCreated, deployJob.UUID)
+		dependentJob.InternalData.TxTemplate = &entities.TxTemplate{
+			To:              parsers.NewJobReference(deployJob.UUID, entities.ReceiptContractAddress),
+			MethodSignature: "transfer(address,uint256)",
+		}
+		descendantJob := newJobModel(entities.StatusCreated, dependentJob.UUID)
+		schedule := &models.Schedule{Jobs: []*models.Job{deployJob, dependentJob, descendantJob}}
+
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), "scheduleUUID", userInfo.AllowedTenants, userInfo.Username).
+			Return(schedule, nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), dependentJob.UUID, userInfo.AllowedTenants, userInfo.Username, false).
+			Return(dependentJob, nil).Times(2)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), deployJob.UUID, userInfo.AllowedTenants, userInfo.Username, false).
+			Return(deployJob, nil)
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), chain.UUID, userInfo).Return(chain, nil)
+		mockEthClient.EXPECT().TransactionReceipt(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, fmt.Errorf("error")).Times(len(chain.URLs))
+		mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), dependentJob.UUID).Return(nil)
+		mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), descendantJob.UUID).Return(nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), descendantJob.UUID, userInfo.AllowedTenants, userInfo.Username, false).
+			Return(descendantJob, nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, log *models.Log) error {
+				assert.Equal(t, entities.StatusFailed, log.Status)
+				return nil
+			}).Times(2)
+		mockJobDA.EXPECT().Update(gomock.Any(), dependentJob).Return(nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), descendantJob).Return(nil)
+
+		err := usecase.Execute(ctx, &entities.Job{
+			UUID:         deployJob.UUID,
+			ScheduleUUID: "scheduleUUID",
+			Status:       entities.StatusMined,
+			InternalData: deployJob.InternalData,
+		}, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.StatusFailed, dependentJob.Status)
+		assert.Equal(t, entities.StatusFailed, descendantJob.Status)
+	})
+
+	t.Run("should fail all descendants of a failed job", func(t *testing.T) {
+		failedJob := newJobModel(entities.StatusFailed)
+		dependentJob := newJobModel(entities.StatusCreated, failedJob.UUID)
+		descendantJob := newJobModel(entities.StatusCreated, dependentJob.UUID)
+		independentJob := newJobModel(entities.StatusCreated)
+		schedule := &models.Schedule{Jobs: []*models.Job{failedJob, dependentJob, descendantJob, independentJob}}
+
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), "scheduleUUID", userInfo.AllowedTenants, userInfo.Username).
+			Return(schedule, nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), dependentJob.UUID, userInfo.AllowedTenants, userInfo.Username, false).
+			Return(dependentJob, nil)
+		mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), dependentJob.UUID).Return(nil)
+		mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), descendantJob.UUID).Return(nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), descendantJob.UUID, userInfo.AllowedTenants, userInfo.Username, false).
+			Return(descendantJob, nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, log *models.Log) error {
+				assert.Equal(t, entities.StatusFailed, log.Status)
+				assert.Equal(t, fmt.Sprintf("dependency %s failed", failedJob.UUID), log.Message)
+				return nil
+			}).Times(2)
+		mockJobDA.EXPECT().Update(gomock.Any(), dependentJob).Return(nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), descendantJob).Return(nil)
+
+		err := usecase.Execute(ctx, &entities.Job{
+			UUID:         failedJob.UUID,
+			ScheduleUUID: "scheduleUUID",
+			Status:       entities.StatusFailed,
+			InternalData: failedJob.InternalData,
+		}, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.StatusCreated, independentJob.Status)
+	})
+
+	t.Run("should fail with same error if schedule cannot be found", func(t *testing.T) {
+		expectedErr := errors.NotFoundError("error")
+
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), "scheduleUUID", userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, expectedErr)
+
+		err := usecase.Execute(ctx, &entities.Job{
+			UUID:         "jobUUID",
+			ScheduleUUID: "scheduleUUID",
+			Status:       entities.StatusMined,
+			InternalData: &entities.InternalData{},
+		}, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(updateDependentJobsComponent), err)
+	})
+}
@@ -24,17 +24,22 @@ type updateJobUseCase struct {
 	db                    store.DB
 	updateChildrenUseCase usecases.UpdateChildrenUseCase
 	startNextJobUseCase   usecases.StartNextJobUseCase
+	updateDependentJobsUC usecases.UpdateDependentJobsUseCase
+	enforceTxPoliciesUC   usecases.EnforceTransactionPoliciesUseCase
 	metrics               metrics.TransactionSchedulerMetrics
 	logger                *log.Logger
 }
 
 // NewUpdateJobUseCase creates a new UpdateJobUseCase
 func NewUpdateJobUseCase(db store.DB, updateChildrenUseCase usecases.UpdateChildrenUseCase,
-	startJobUC usecases.StartNextJobUseCase, m metrics.TransactionSchedulerMetrics) usecases.UpdateJobUseCase {
+	startJobUC usecases.StartNextJobUseCase, updateDependentJobsUC usecases.UpdateDependentJobsUseCase,
+	enforceTxPoliciesUC usecases.EnforceTransactionPoliciesUseCase, m metrics.TransactionSchedulerMetrics) usecases.UpdateJobUseCase {
 	return &updateJobUseCase{
 		db:                    db,
 		updateChildrenUseCase: updateChildrenUseCase,
 		startNextJobUseCase:   startJobUC,
+		updateDependentJobsUC: updateDependentJobsUC,
+		enforceTxPoliciesUC:   enforceTxPoliciesUC,
 		metrics:               m,
 		logger:                log.NewLogger().SetComponent(updateJobComponent),
 	}
@@ -52,16 +57,39 @@ func (uc *updateJobUseCase) Execute(ctx context.Context, job *entities.Job, next
 		return nil, err
 	}
 
-	if entities.IsFinalJobStatus(jobModel.Status) {
+	// A reorged job is the only case where a final status can be reverted
+	if entities.IsFinalJobStatus(jobModel.Status) && !isReorgedJob(nextStatus, jobModel.Status) {
 		errMessage := fmt.Sprintf("job status %s is final, cannot be updated", jobModel.Status)
 		logger.WithField("status", jobModel.Status).Error(errMessage)
 		return nil, errors.InvalidParameterError(errMessage).ExtendComponent(updateJobComponent)
 	}
 
+	// The transaction approved by the approvers of a job cannot be updated
+	if job.Transaction != nil && jobModel.Status == entities.StatusAwaitingApproval {
+		errMessage := "cannot update the transaction of a job awaiting approval"
+		logger.WithField("status", jobModel.Status).Error(errMessage)
+		return nil, errors.InvalidStateError(errMessage).ExtendComponent(updateJobComponent)
+	}
+
+	// The revert reason and events of a job are decoded from its receipt by the tx-listener once it is mined
+	if job.RevertReason != "" || job.Events != nil {
+		if nextStatus != entities.StatusMined {
+			errMessage := "revert reason and events can only be set when the job is mined"
+			logger.Error(errMessage)
+			return nil, errors.InvalidParameterError(errMessage).ExtendComponent(updateJobComponent)
+		}
+
+		if !isInternalUser(userInfo) {
+			errMessage := "revert reason and events can only be set by internal services"
+			logger.Error(errMessage)
+			return nil, errors.PermissionDeniedError(errMessage).ExtendComponent(updateJobComponent)
+		}
+	}
+
 	// We are not forced to update the transaction
 	if job.Transaction != nil {
 		parsers.UpdateTransactionModelFromEntities(jobModel.Transaction, job.Transaction)
-		if err = uc.db.Transaction().Update(ctx, jobModel.Transaction); err != nil {
+		if err = uc.updateTransaction(ctx, jobModel); err != nil {
 			return nil, errors.FromError(err).ExtendComponent(updateJobComponent)
 		}
 	}
@@ -69,7 +97,24 @@ func (uc *updateJobUseCase) Execute(ctx context.Context, job *entities.Job, next
 	if len(job.Labels) > 0 {
 		jobModel.Labels = job.Labels
 	}
+	if job.RevertReason != "" {
+		jobModel.RevertReason = job.RevertReason
+	}
+	if job.Events != nil {
+		jobModel.Events = job.Events
+	}
 	if job.InternalData != nil {
+		// The smart account of a job is resolved from its sender at creation and cannot be updated
+		job.InternalData.SmartAccount = jobModel.InternalData.SmartAccount
+		// Approvals are only recorded by the approve and reject use cases
+		job.InternalData.ApprovalRequirements = jobModel.InternalData.ApprovalRequirements
+		job.InternalData.Approvals = jobModel.InternalData.Approvals
+		job.InternalData.Faucet = jobModel.InternalData.Faucet
+		// Retries and schedule graphs are bound at creation and cannot be updated
+		job.InternalData.ParentJobUUID = jobModel.InternalData.ParentJobUUID
+		job.InternalData.TxTemplate = jobModel.InternalData.TxTemplate
+		job.InternalData.GraphJobID = jobModel.InternalData.GraphJobID
+		job.InternalData.DependsOn = jobModel.InternalData.DependsOn
 		jobModel.InternalData = job.InternalData
 	}
 
@@ -100,10 +145,35 @@ func (uc *updateJobUseCase) Execute(ctx context.Context, job *entities.Job, next
 		}
 	}
 
+	if shouldUpdateDependentJobs(jobModel, nextStatus) {
+		err = uc.updateDependentJobsUC.Execute(ctx, parsers.NewJobEntityFromModels(jobModel), userInfo)
+		if err != nil {
+			return nil, errors.FromError(err).ExtendComponent(updateJobComponent)
+		}
+	}
+
 	logger.Info("job updated successfully")
 	return parsers.NewJobEntityFromModels(jobModel), nil
 }
 
+// updateTransaction checks again the transaction of a job not started yet against the transaction policies of its
+// tenant, in the same DB transaction as its update, as it is started without being checked
+func (uc *updateJobUseCase) updateTransaction(ctx context.Context, jobModel *models.Job) error {
+	return database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
+		// Jobs referencing receipts of other jobs are checked once their transaction is crafted
+		notStarted := jobModel.Status == entities.StatusCreated || jobModel.Status == entities.StatusScheduled
+		if notStarted && jobModel.InternalData.TxTemplate == nil {
+			der := uc.enforceTxPoliciesUC.WithDBTransaction(tx.(store.Tx)).
+				Execute(ctx, parsers.NewJobEntityFromModels(jobModel), jobModel.Schedule.TenantID)
+			if der != nil {
+				return der
+			}
+		}
+
+		return tx.(store.Tx).Transaction().Update(ctx, jobModel.Transaction)
+	})
+}
+
 func (uc *updateJobUseCase) updateJob(ctx context.Context, jobModel *models.Job, jobLogModel *models.Log, userInfo *multitenancy.UserInfo) error {
 	logger := uc.logger.WithContext(ctx)
 
@@ -144,7 +214,12 @@ func (uc *updateJobUseCase) updateJob(ctx context.Context, jobModel *models.Job,
 			}
 
 			jobModel.Logs = append(jobModel.Logs, jobLogModel)
-			if updateNextJobStatus(prevLogModel.Status, jobLogModel.Status) {
+			if jobLogModel.Status == entities.StatusReorged {
+				// Transaction was removed from the canonical chain, so the job waits again for it to be mined
+				jobModel.Status = entities.StatusPending
+				jobModel.RevertReason = ""
+				jobModel.Events = nil
+			} else if updateNextJobStatus(prevLogModel.Status, jobLogModel.Status) {
 				jobModel.Status = jobLogModel.Status
 			}
 		}
@@ -192,12 +267,41 @@ func updateNextJobStatus(prevStatus, nextStatus entities.JobStatus) bool {
 	return true
 }
 
+// Jobs of a schedule graph depending on a job are updated when it is mined, or when it fails and is not a retry
+func shouldUpdateDependentJobs(jobModel *models.Job, nextStatus entities.JobStatus) bool {
+	if jobModel.InternalData.GraphJobID == "" || jobModel.Status != nextStatus {
+		return false
+	}
+
+	switch nextStatus {
+	case entities.StatusMined:
+		return true
+	case entities.StatusFailed:
+		return jobModel.InternalData.ParentJobUUID == "" || jobModel.InternalData.ParentJobUUID == jobModel.UUID
+	default:
+		return false
+	}
+}
+
+// Internal services are authenticated with the API key, users authenticated with a JWT are scoped to a tenant
+func isInternalUser(userInfo *multitenancy.UserInfo) bool {
+	return userInfo.AuthMode != multitenancy.AuthMethodJWT || userInfo.Wildcard
+}
+
+func isReorgedJob(nextStatus, status entities.JobStatus) bool {
+	return nextStatus == entities.StatusReorged && status == entities.StatusMined
+}
+
 func canUpdateStatus(nextStatus, status entities.JobStatus) bool {
 	switch nextStatus {
 	case entities.StatusCreated:
 		return false
+	case entities.StatusAwaitingApproval:
+		return false
+	case entities.StatusScheduled:
+		return status == entities.StatusCreated || status == entities.StatusAwaitingApproval
 	case entities.StatusStarted:
-		return status == entities.StatusCreated
+		return status == entities.StatusCreated || status == entities.StatusScheduled || status == entities.StatusAwaitingApproval
 	case entities.StatusPending:
 		return status == entities.StatusStarted || status == entities.StatusRecovering
 	case entities.StatusResending:
@@ -206,10 +310,13 @@ func canUpdateStatus(nextStatus, status entities.JobStatus) bool {
 		return status == entities.StatusStarted || status == entities.StatusRecovering || status == entities.StatusPending
 	case entities.StatusMined, entities.StatusNeverMined:
 		return status == entities.StatusPending
+	case entities.StatusReorged:
+		return status == entities.StatusMined
 	case entities.StatusStored:
 		return status == entities.StatusStarted || status == entities.StatusRecovering
 	case entities.StatusFailed:
-		return status == entities.StatusStarted || status == entities.StatusRecovering || status == entities.StatusPending || status == entities.StatusWarning || status == entities.StatusResending
+		return status == entities.StatusStarted || status == entities.StatusRecovering || status == entities.StatusPending || status == entities.StatusWarning || status == entities.StatusResending || status == entities.StatusScheduled ||
+			status == entities.StatusAwaitingApproval
 	default: // For warning, they can be added at any time
 		return true
 	}
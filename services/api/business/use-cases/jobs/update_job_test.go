@@ -34,6 +34,8 @@ func TestUpdateJob_Execute(t *testing.T) {
 	mockLogDA := mocks.NewMockLogAgent(ctrl)
 	mockUpdateChilrenUC := mocks2.NewMockUpdateChildrenUseCase(ctrl)
 	mockStartNextJobUC := mocks2.NewMockStartNextJobUseCase(ctrl)
+	mockUpdateDependentJobsUC := mocks2.NewMockUpdateDependentJobsUseCase(ctrl)
+	mockEnforceTxPoliciesUC := mocks2.NewMockEnforceTransactionPoliciesUseCase(ctrl)
 	mockMetrics := mock.NewMockTransactionSchedulerMetrics(ctrl)
 
 	jobsLatencyHistogram := mock2.NewMockHistogram(ctrl)
@@ -56,9 +58,11 @@ func TestUpdateJob_Execute(t *testing.T) {
 	mockDBTX.EXPECT().Rollback().Return(nil).AnyTimes()
 	mockDBTX.EXPECT().Close().Return(nil).AnyTimes()
 	mockUpdateChilrenUC.EXPECT().WithDBTransaction(mockDBTX).Return(mockUpdateChilrenUC).AnyTimes()
+	mockEnforceTxPoliciesUC.EXPECT().WithDBTransaction(mockDBTX).Return(mockEnforceTxPoliciesUC).AnyTimes()
 
 	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
-	usecase := NewUpdateJobUseCase(mockDB, mockUpdateChilrenUC, mockStartNextJobUC, mockMetrics)
+	usecase := NewUpdateJobUseCase(mockDB, mockUpdateChilrenUC, mockStartNextJobUC, mockUpdateDependentJobsUC, mockEnforceTxPoliciesUC,
+		mockMetrics)
 
 	nextStatus := entities.StatusStarted
 	logMessage := "message"
@@ -71,6 +75,7 @@ func TestUpdateJob_Execute(t *testing.T) {
 
 		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.UUID, userInfo.AllowedTenants, userInfo.Username, true).
 			Return(jobModel, nil)
+		mockEnforceTxPoliciesUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo.TenantID).Return(nil)
 		mockTransactionDA.EXPECT().Update(gomock.Any(), jobModel.Transaction).Return(nil)
 		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, jobModelUpdate *models.Job) error {
 			assert.Equal(t, jobModelUpdate.InternalData, jobEntity.InternalData)
@@ -121,6 +126,7 @@ func TestUpdateJob_Execute(t *testing.T) {
 
 		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.UUID, userInfo.AllowedTenants, userInfo.Username, true).
 			Return(jobModel, nil)
+		mockEnforceTxPoliciesUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo.TenantID).Return(nil)
 		mockTransactionDA.EXPECT().Update(gomock.Any(), jobModel.Transaction).Return(nil)
 		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, jobModelUpdate *models.Job) error {
 			assert.Equal(t, jobModelUpdate.InternalData, jobEntity.InternalData)
@@ -149,6 +155,8 @@ func TestUpdateJob_Execute(t *testing.T) {
 		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, jobModelUpdate *models.Job) error {
 			assert.Equal(t, jobModelUpdate.InternalData, jobEntity.InternalData)
 			assert.Equal(t, jobModelUpdate.Labels, jobEntity.Labels)
+			assert.Equal(t, jobEntity.RevertReason, jobModelUpdate.RevertReason)
+			assert.Equal(t, jobEntity.Events, jobModelUpdate.Events)
 			jobModel.ID = 1
 			jobModel.Logs[0].Status = status
 			return nil
@@ -167,6 +175,8 @@ func TestUpdateJob_Execute(t *testing.T) {
 		jobEntity := testutils3.FakeJob()
 		jobEntity.Transaction = nil
 		jobEntity.Status = entities.StatusPending
+		jobEntity.RevertReason = "insufficient balance"
+		jobEntity.Events = []*entities.TxEvent{{Signature: "Transfer(address,address,uint256)"}}
 		status := entities.StatusMined
 		jobModel := testutils2.FakeJobModel(0)
 		jobModel.Schedule.TenantID = userInfo.TenantID
@@ -192,7 +202,38 @@ func TestUpdateJob_Execute(t *testing.T) {
 		_, err := usecase.Execute(ctx, jobEntity, status, logMessage, userInfo)
 		assert.NoError(t, err)
 	})
-	
+
+	t.Run("should fail with InvalidParameterError if revert reason is set when status is not MINED", func(t *testing.T) {
+		jobEntity := testutils3.FakeJob()
+		jobEntity.Transaction = nil
+		jobEntity.RevertReason = "insufficient balance"
+		jobModel := testutils2.FakeJobModel(0)
+		jobModel.Schedule.TenantID = userInfo.TenantID
+		jobModel.Status = entities.StatusPending
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.UUID, userInfo.AllowedTenants, userInfo.Username, true).
+			Return(jobModel, nil)
+
+		_, err := usecase.Execute(ctx, jobEntity, entities.StatusFailed, logMessage, userInfo)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with PermissionDeniedError if events are set by a user of a tenant", func(t *testing.T) {
+		tenantUser := multitenancy.NewJWTUserInfo(&entities.UserClaims{TenantID: userInfo.TenantID, Username: "username"}, "token")
+		jobEntity := testutils3.FakeJob()
+		jobEntity.Transaction = nil
+		jobEntity.Events = []*entities.TxEvent{{Signature: "Transfer(address,address,uint256)"}}
+		jobModel := testutils2.FakeJobModel(0)
+		jobModel.Schedule.TenantID = userInfo.TenantID
+		jobModel.Status = entities.StatusPending
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.UUID, tenantUser.AllowedTenants, tenantUser.Username, true).
+			Return(jobModel, nil)
+
+		_, err := usecase.Execute(ctx, jobEntity, entities.StatusMined, logMessage, tenantUser)
+		assert.Equal(t, uint64(errors.PermissionDenied), errors.FromError(err).GetCode())
+	})
+
 	t.Run("should execute use case successfully if status is MINED and update all the children jobs", func(t *testing.T) {
 		jobParentEntity := testutils3.FakeJob()
 		jobEntity := testutils3.FakeJob()
@@ -232,6 +273,50 @@ func TestUpdateJob_Execute(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("should move job back to PENDING if status is REORGED", func(t *testing.T) {
+		jobEntity := testutils3.FakeJob()
+		jobEntity.Transaction = nil
+		status := entities.StatusReorged
+		jobModel := testutils2.FakeJobModel(0)
+		jobModel.Schedule.TenantID = userInfo.TenantID
+		jobModel.Status = entities.StatusMined
+		jobModel.RevertReason = "insufficient balance"
+		jobModel.Logs = append(jobModel.Logs, &models.Log{Status: entities.StatusPending})
+		jobModel.Logs = append(jobModel.Logs, &models.Log{Status: entities.StatusMined})
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.UUID, userInfo.AllowedTenants, userInfo.Username, true).
+			Return(jobModel, nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, jobModelUpdate *models.Job) error {
+			assert.Equal(t, entities.StatusPending, jobModelUpdate.Status)
+			assert.Empty(t, jobModelUpdate.RevertReason)
+			jobModel.ID = 1
+			return nil
+		})
+		mockLogDA.EXPECT().Insert(gomock.Any(), &models.Log{
+			JobID:   &jobModel.ID,
+			Status:  status,
+			Message: logMessage,
+		}).Return(nil)
+
+		job, err := usecase.Execute(ctx, jobEntity, status, logMessage, userInfo)
+		assert.NoError(t, err)
+		assert.Equal(t, entities.StatusPending, job.Status)
+	})
+
+	t.Run("should fail with InvalidStateError if status is REORGED and job is not MINED", func(t *testing.T) {
+		jobEntity := testutils3.FakeJob()
+		jobModel := testutils2.FakeJobModel(0)
+		jobModel.Status = entities.StatusPending
+		jobModel.Schedule.TenantID = userInfo.TenantID
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.UUID, userInfo.AllowedTenants, userInfo.Username, true).
+			Return(jobModel, nil)
+		mockTransactionDA.EXPECT().Update(gomock.Any(), jobModel.Transaction).Return(nil)
+
+		_, err := usecase.Execute(ctx, jobEntity, entities.StatusReorged, logMessage, userInfo)
+		assert.True(t, errors.IsInvalidStateError(err))
+	})
+
 	t.Run("should fail with InvalidParameterError if status is MINED", func(t *testing.T) {
 		jobEntity := testutils3.FakeJob()
 		jobModel := testutils2.FakeJobModel(0)
@@ -254,12 +339,32 @@ func TestUpdateJob_Execute(t *testing.T) {
 
 		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username, true).
 			Return(jobModel, nil)
+		mockEnforceTxPoliciesUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo.TenantID).Return(nil)
 		mockTransactionDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(expectedErr)
 
 		_, err := usecase.Execute(ctx, jobEntity, nextStatus, logMessage, userInfo)
 		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(updateJobComponent), err)
 	})
 
+	t.Run("should not start a job with an updated transaction violating the transaction policies", func(t *testing.T) {
+		expectedErr := errors.PolicyViolationError("error")
+		jobEntity := testutils3.FakeJob()
+		jobModel := testutils2.FakeJobModel(0)
+		jobModel.Schedule.TenantID = userInfo.TenantID
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username, true).
+			Return(jobModel, nil)
+		mockEnforceTxPoliciesUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo.TenantID).
+			DoAndReturn(func(ctx context.Context, job *entities.Job, tenantID string) error {
+				assert.Equal(t, jobEntity.Transaction.To, job.Transaction.To)
+				assert.Equal(t, jobEntity.Transaction.Value, job.Transaction.Value)
+				return expectedErr
+			})
+
+		_, err := usecase.Execute(ctx, jobEntity, nextStatus, logMessage, userInfo)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(updateJobComponent), err)
+	})
+
 	t.Run("should fail with the same error if update job fails", func(t *testing.T) {
 		expectedErr := errors.NotFoundError("error")
 		jobEntity := testutils3.FakeJob()
@@ -268,6 +373,7 @@ func TestUpdateJob_Execute(t *testing.T) {
 
 		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username, true).Return(jobModel, nil)
 		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockEnforceTxPoliciesUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo.TenantID).Return(nil)
 		mockTransactionDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
 		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(expectedErr)
 
@@ -327,6 +433,30 @@ func TestUpdateJob_Execute(t *testing.T) {
 		assert.True(t, errors.IsInvalidStateError(err))
 	})
 	
+	t.Run("should fail with InvalidStateError if status is AWAITING_APPROVAL", func(t *testing.T) {
+		jobEntity := testutils3.FakeJob()
+		jobEntity.Transaction = nil
+		jobModel := testutils2.FakeJobModel(0)
+		jobModel.Schedule.TenantID = userInfo.TenantID
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username, true).Return(jobModel, nil)
+
+		_, err := usecase.Execute(ctx, jobEntity, entities.StatusAwaitingApproval, logMessage, userInfo)
+		assert.True(t, errors.IsInvalidStateError(err))
+	})
+
+	t.Run("should fail with InvalidStateError if the transaction of a job awaiting approval is updated", func(t *testing.T) {
+		jobEntity := testutils3.FakeJob()
+		jobModel := testutils2.FakeJobModel(0)
+		jobModel.Schedule.TenantID = userInfo.TenantID
+		jobModel.Status = entities.StatusAwaitingApproval
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username, true).Return(jobModel, nil)
+
+		_, err := usecase.Execute(ctx, jobEntity, "", logMessage, userInfo)
+		assert.True(t, errors.IsInvalidStateError(err))
+	})
+
 	t.Run("should allow transition of job from RESENDING to RESENDING", func(t *testing.T) {
 		jobEntity := testutils3.FakeJob()
 		jobEntity.Transaction = nil
@@ -386,4 +516,95 @@ func TestUpdateJob_Execute(t *testing.T) {
 
 		assert.NoError(t, err)
 	})
+
+	t.Run("should update dependent jobs if job of a schedule graph is MINED", func(t *testing.T) {
+		jobModel := testutils2.FakeJobModel(0)
+		jobModel.Status = entities.StatusPending
+		jobModel.Logs[0].Status = entities.StatusPending
+		jobModel.Schedule.TenantID = userInfo.TenantID
+		jobModel.InternalData.GraphJobID = "deployToken"
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobModel.UUID, userInfo.AllowedTenants, userInfo.Username, true).Return(jobModel, nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockUpdateDependentJobsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).
+			DoAndReturn(func(ctx context.Context, job *entities.Job, userInfo *multitenancy.UserInfo) error {
+				assert.Equal(t, jobModel.UUID, job.UUID)
+				assert.Equal(t, entities.StatusMined, job.Status)
+				return nil
+			})
+
+		jobEntity := parsers.NewJobEntityFromModels(jobModel)
+		jobEntity.Transaction = nil
+		_, err := usecase.Execute(ctx, jobEntity, entities.StatusMined, "", userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should keep the schedule graph and retry of a job when updating its annotations", func(t *testing.T) {
+		jobModel := testutils2.FakeJobModel(0)
+		jobModel.Schedule.TenantID = userInfo.TenantID
+		jobModel.InternalData.GraphJobID = "deployToken"
+		jobModel.InternalData.DependsOn = []string{"deployRegistryUUID"}
+		jobModel.InternalData.TxTemplate = &entities.TxTemplate{To: "${deployRegistry.receipt.contractAddress}"}
+
+		jobEntity := parsers.NewJobEntityFromModels(jobModel)
+		jobEntity.Transaction = nil
+		jobEntity.InternalData = &entities.InternalData{HasBeenRetried: true, ParentJobUUID: jobModel.UUID}
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobModel.UUID, userInfo.AllowedTenants, userInfo.Username, true).Return(jobModel, nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, jobModelUpdate *models.Job) error {
+			assert.True(t, jobModelUpdate.InternalData.HasBeenRetried)
+			assert.Empty(t, jobModelUpdate.InternalData.ParentJobUUID)
+			assert.Equal(t, "deployToken", jobModelUpdate.InternalData.GraphJobID)
+			assert.Equal(t, []string{"deployRegistryUUID"}, jobModelUpdate.InternalData.DependsOn)
+			assert.NotNil(t, jobModelUpdate.InternalData.TxTemplate)
+			return nil
+		})
+
+		_, err := usecase.Execute(ctx, jobEntity, "", "", userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not update dependent jobs if retry of a job of a schedule graph is FAILED", func(t *testing.T) {
+		jobModel := testutils2.FakeJobModel(0)
+		jobModel.Status = entities.StatusPending
+		jobModel.Logs[0].Status = entities.StatusPending
+		jobModel.Schedule.TenantID = userInfo.TenantID
+		jobModel.InternalData.GraphJobID = "deployToken"
+		jobModel.InternalData.ParentJobUUID = "parentJobUUID"
+
+		mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), "parentJobUUID").Return(nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobModel.UUID, userInfo.AllowedTenants, userInfo.Username, true).Return(jobModel, nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobModel.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(jobModel, nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+
+		jobEntity := parsers.NewJobEntityFromModels(jobModel)
+		jobEntity.Transaction = nil
+		_, err := usecase.Execute(ctx, jobEntity, entities.StatusFailed, "", userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with the same error if update of dependent jobs fails", func(t *testing.T) {
+		expectedErr := errors.NotFoundError("error")
+		jobModel := testutils2.FakeJobModel(0)
+		jobModel.Status = entities.StatusPending
+		jobModel.Logs[0].Status = entities.StatusPending
+		jobModel.Schedule.TenantID = userInfo.TenantID
+		jobModel.InternalData.GraphJobID = "deployToken"
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobModel.UUID, userInfo.AllowedTenants, userInfo.Username, true).Return(jobModel, nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockUpdateDependentJobsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return(expectedErr)
+
+		jobEntity := parsers.NewJobEntityFromModels(jobModel)
+		jobEntity.Transaction = nil
+		_, err := usecase.Execute(ctx, jobEntity, entities.StatusFailed, "", userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(updateJobComponent), err)
+	})
 }
@@ -80,6 +80,20 @@ func (mr *MockAccountUseCasesMockRecorder) CreateAccount() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockAccountUseCases)(nil).CreateAccount))
 }
 
+// RegisterSmartAccount mocks base method
+func (m *MockAccountUseCases) RegisterSmartAccount() usecases.RegisterSmartAccountUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterSmartAccount")
+	ret0, _ := ret[0].(usecases.RegisterSmartAccountUseCase)
+	return ret0
+}
+
+// RegisterSmartAccount indicates an expected call of RegisterSmartAccount
+func (mr *MockAccountUseCasesMockRecorder) RegisterSmartAccount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSmartAccount", reflect.TypeOf((*MockAccountUseCases)(nil).RegisterSmartAccount))
+}
+
 // UpdateAccount mocks base method
 func (m *MockAccountUseCases) UpdateAccount() usecases.UpdateAccountUseCase {
 	m.ctrl.T.Helper()
@@ -221,6 +235,44 @@ func (mr *MockCreateAccountUseCaseMockRecorder) Execute(ctx, identity, privateKe
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateAccountUseCase)(nil).Execute), ctx, identity, privateKey, chainName, userInfo)
 }
 
+// MockRegisterSmartAccountUseCase is a mock of RegisterSmartAccountUseCase interface
+type MockRegisterSmartAccountUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegisterSmartAccountUseCaseMockRecorder
+}
+
+// MockRegisterSmartAccountUseCaseMockRecorder is the mock recorder for MockRegisterSmartAccountUseCase
+type MockRegisterSmartAccountUseCaseMockRecorder struct {
+	mock *MockRegisterSmartAccountUseCase
+}
+
+// NewMockRegisterSmartAccountUseCase creates a new mock instance
+func NewMockRegisterSmartAccountUseCase(ctrl *gomock.Controller) *MockRegisterSmartAccountUseCase {
+	mock := &MockRegisterSmartAccountUseCase{ctrl: ctrl}
+	mock.recorder = &MockRegisterSmartAccountUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRegisterSmartAccountUseCase) EXPECT() *MockRegisterSmartAccountUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockRegisterSmartAccountUseCase) Execute(ctx context.Context, acc *entities.Account, userInfo *multitenancy.UserInfo) (*entities.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, acc, userInfo)
+	ret0, _ := ret[0].(*entities.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockRegisterSmartAccountUseCaseMockRecorder) Execute(ctx, acc, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRegisterSmartAccountUseCase)(nil).Execute), ctx, acc, userInfo)
+}
+
 // MockSearchAccountsUseCase is a mock of SearchAccountsUseCase interface
 type MockSearchAccountsUseCase struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,244 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: approvals.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	multitenancy "github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	entities "github.com/consensys/orchestrate/pkg/types/entities"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockApprovalPolicyUseCases is a mock of ApprovalPolicyUseCases interface
+type MockApprovalPolicyUseCases struct {
+	ctrl     *gomock.Controller
+	recorder *MockApprovalPolicyUseCasesMockRecorder
+}
+
+// MockApprovalPolicyUseCasesMockRecorder is the mock recorder for MockApprovalPolicyUseCases
+type MockApprovalPolicyUseCasesMockRecorder struct {
+	mock *MockApprovalPolicyUseCases
+}
+
+// NewMockApprovalPolicyUseCases creates a new mock instance
+func NewMockApprovalPolicyUseCases(ctrl *gomock.Controller) *MockApprovalPolicyUseCases {
+	mock := &MockApprovalPolicyUseCases{ctrl: ctrl}
+	mock.recorder = &MockApprovalPolicyUseCasesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockApprovalPolicyUseCases) EXPECT() *MockApprovalPolicyUseCasesMockRecorder {
+	return m.recorder
+}
+
+// CreateApprovalPolicy mocks base method
+func (m *MockApprovalPolicyUseCases) CreateApprovalPolicy() usecases.CreateApprovalPolicyUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateApprovalPolicy")
+	ret0, _ := ret[0].(usecases.CreateApprovalPolicyUseCase)
+	return ret0
+}
+
+// CreateApprovalPolicy indicates an expected call of CreateApprovalPolicy
+func (mr *MockApprovalPolicyUseCasesMockRecorder) CreateApprovalPolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApprovalPolicy", reflect.TypeOf((*MockApprovalPolicyUseCases)(nil).CreateApprovalPolicy))
+}
+
+// GetApprovalPolicy mocks base method
+func (m *MockApprovalPolicyUseCases) GetApprovalPolicy() usecases.GetApprovalPolicyUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApprovalPolicy")
+	ret0, _ := ret[0].(usecases.GetApprovalPolicyUseCase)
+	return ret0
+}
+
+// GetApprovalPolicy indicates an expected call of GetApprovalPolicy
+func (mr *MockApprovalPolicyUseCasesMockRecorder) GetApprovalPolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApprovalPolicy", reflect.TypeOf((*MockApprovalPolicyUseCases)(nil).GetApprovalPolicy))
+}
+
+// SearchApprovalPolicies mocks base method
+func (m *MockApprovalPolicyUseCases) SearchApprovalPolicies() usecases.SearchApprovalPoliciesUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchApprovalPolicies")
+	ret0, _ := ret[0].(usecases.SearchApprovalPoliciesUseCase)
+	return ret0
+}
+
+// SearchApprovalPolicies indicates an expected call of SearchApprovalPolicies
+func (mr *MockApprovalPolicyUseCasesMockRecorder) SearchApprovalPolicies() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchApprovalPolicies", reflect.TypeOf((*MockApprovalPolicyUseCases)(nil).SearchApprovalPolicies))
+}
+
+// DeleteApprovalPolicy mocks base method
+func (m *MockApprovalPolicyUseCases) DeleteApprovalPolicy() usecases.DeleteApprovalPolicyUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteApprovalPolicy")
+	ret0, _ := ret[0].(usecases.DeleteApprovalPolicyUseCase)
+	return ret0
+}
+
+// DeleteApprovalPolicy indicates an expected call of DeleteApprovalPolicy
+func (mr *MockApprovalPolicyUseCasesMockRecorder) DeleteApprovalPolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApprovalPolicy", reflect.TypeOf((*MockApprovalPolicyUseCases)(nil).DeleteApprovalPolicy))
+}
+
+// MockCreateApprovalPolicyUseCase is a mock of CreateApprovalPolicyUseCase interface
+type MockCreateApprovalPolicyUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateApprovalPolicyUseCaseMockRecorder
+}
+
+// MockCreateApprovalPolicyUseCaseMockRecorder is the mock recorder for MockCreateApprovalPolicyUseCase
+type MockCreateApprovalPolicyUseCaseMockRecorder struct {
+	mock *MockCreateApprovalPolicyUseCase
+}
+
+// NewMockCreateApprovalPolicyUseCase creates a new mock instance
+func NewMockCreateApprovalPolicyUseCase(ctrl *gomock.Controller) *MockCreateApprovalPolicyUseCase {
+	mock := &MockCreateApprovalPolicyUseCase{ctrl: ctrl}
+	mock.recorder = &MockCreateApprovalPolicyUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCreateApprovalPolicyUseCase) EXPECT() *MockCreateApprovalPolicyUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockCreateApprovalPolicyUseCase) Execute(ctx context.Context, policy *entities.ApprovalPolicy, userInfo *multitenancy.UserInfo) (*entities.ApprovalPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, policy, userInfo)
+	ret0, _ := ret[0].(*entities.ApprovalPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockCreateApprovalPolicyUseCaseMockRecorder) Execute(ctx, policy, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateApprovalPolicyUseCase)(nil).Execute), ctx, policy, userInfo)
+}
+
+// MockGetApprovalPolicyUseCase is a mock of GetApprovalPolicyUseCase interface
+type MockGetApprovalPolicyUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetApprovalPolicyUseCaseMockRecorder
+}
+
+// MockGetApprovalPolicyUseCaseMockRecorder is the mock recorder for MockGetApprovalPolicyUseCase
+type MockGetApprovalPolicyUseCaseMockRecorder struct {
+	mock *MockGetApprovalPolicyUseCase
+}
+
+// NewMockGetApprovalPolicyUseCase creates a new mock instance
+func NewMockGetApprovalPolicyUseCase(ctrl *gomock.Controller) *MockGetApprovalPolicyUseCase {
+	mock := &MockGetApprovalPolicyUseCase{ctrl: ctrl}
+	mock.recorder = &MockGetApprovalPolicyUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockGetApprovalPolicyUseCase) EXPECT() *MockGetApprovalPolicyUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockGetApprovalPolicyUseCase) Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) (*entities.ApprovalPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, uuid, userInfo)
+	ret0, _ := ret[0].(*entities.ApprovalPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockGetApprovalPolicyUseCaseMockRecorder) Execute(ctx, uuid, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetApprovalPolicyUseCase)(nil).Execute), ctx, uuid, userInfo)
+}
+
+// MockSearchApprovalPoliciesUseCase is a mock of SearchApprovalPoliciesUseCase interface
+type MockSearchApprovalPoliciesUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchApprovalPoliciesUseCaseMockRecorder
+}
+
+// MockSearchApprovalPoliciesUseCaseMockRecorder is the mock recorder for MockSearchApprovalPoliciesUseCase
+type MockSearchApprovalPoliciesUseCaseMockRecorder struct {
+	mock *MockSearchApprovalPoliciesUseCase
+}
+
+// NewMockSearchApprovalPoliciesUseCase creates a new mock instance
+func NewMockSearchApprovalPoliciesUseCase(ctrl *gomock.Controller) *MockSearchApprovalPoliciesUseCase {
+	mock := &MockSearchApprovalPoliciesUseCase{ctrl: ctrl}
+	mock.recorder = &MockSearchApprovalPoliciesUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSearchApprovalPoliciesUseCase) EXPECT() *MockSearchApprovalPoliciesUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockSearchApprovalPoliciesUseCase) Execute(ctx context.Context, filters *entities.ApprovalPolicyFilters, userInfo *multitenancy.UserInfo) ([]*entities.ApprovalPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, filters, userInfo)
+	ret0, _ := ret[0].([]*entities.ApprovalPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockSearchApprovalPoliciesUseCaseMockRecorder) Execute(ctx, filters, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchApprovalPoliciesUseCase)(nil).Execute), ctx, filters, userInfo)
+}
+
+// MockDeleteApprovalPolicyUseCase is a mock of DeleteApprovalPolicyUseCase interface
+type MockDeleteApprovalPolicyUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteApprovalPolicyUseCaseMockRecorder
+}
+
+// MockDeleteApprovalPolicyUseCaseMockRecorder is the mock recorder for MockDeleteApprovalPolicyUseCase
+type MockDeleteApprovalPolicyUseCaseMockRecorder struct {
+	mock *MockDeleteApprovalPolicyUseCase
+}
+
+// NewMockDeleteApprovalPolicyUseCase creates a new mock instance
+func NewMockDeleteApprovalPolicyUseCase(ctrl *gomock.Controller) *MockDeleteApprovalPolicyUseCase {
+	mock := &MockDeleteApprovalPolicyUseCase{ctrl: ctrl}
+	mock.recorder = &MockDeleteApprovalPolicyUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDeleteApprovalPolicyUseCase) EXPECT() *MockDeleteApprovalPolicyUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockDeleteApprovalPolicyUseCase) Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, uuid, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockDeleteApprovalPolicyUseCaseMockRecorder) Execute(ctx, uuid, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDeleteApprovalPolicyUseCase)(nil).Execute), ctx, uuid, userInfo)
+}
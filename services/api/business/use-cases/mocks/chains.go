@@ -106,6 +106,20 @@ func (mr *MockChainUseCasesMockRecorder) DeleteChain() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChain", reflect.TypeOf((*MockChainUseCases)(nil).DeleteChain))
 }
 
+// CallContract mocks base method
+func (m *MockChainUseCases) CallContract() usecases.CallContractUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallContract")
+	ret0, _ := ret[0].(usecases.CallContractUseCase)
+	return ret0
+}
+
+// CallContract indicates an expected call of CallContract
+func (mr *MockChainUseCasesMockRecorder) CallContract() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallContract", reflect.TypeOf((*MockChainUseCases)(nil).CallContract))
+}
+
 // MockRegisterChainUseCase is a mock of RegisterChainUseCase interface
 type MockRegisterChainUseCase struct {
 	ctrl     *gomock.Controller
@@ -294,3 +308,41 @@ func (mr *MockDeleteChainUseCaseMockRecorder) Execute(ctx, uuid, userInfo interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDeleteChainUseCase)(nil).Execute), ctx, uuid, userInfo)
 }
+
+// MockCallContractUseCase is a mock of CallContractUseCase interface
+type MockCallContractUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCallContractUseCaseMockRecorder
+}
+
+// MockCallContractUseCaseMockRecorder is the mock recorder for MockCallContractUseCase
+type MockCallContractUseCaseMockRecorder struct {
+	mock *MockCallContractUseCase
+}
+
+// NewMockCallContractUseCase creates a new mock instance
+func NewMockCallContractUseCase(ctrl *gomock.Controller) *MockCallContractUseCase {
+	mock := &MockCallContractUseCase{ctrl: ctrl}
+	mock.recorder = &MockCallContractUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCallContractUseCase) EXPECT() *MockCallContractUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockCallContractUseCase) Execute(ctx context.Context, chainUUID string, call *entities.ContractCall, userInfo *multitenancy.UserInfo) (map[string]interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, chainUUID, call, userInfo)
+	ret0, _ := ret[0].(map[string]interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockCallContractUseCaseMockRecorder) Execute(ctx, chainUUID, call, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCallContractUseCase)(nil).Execute), ctx, chainUUID, call, userInfo)
+}
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	context "context"
+	multitenancy "github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	entities "github.com/consensys/orchestrate/pkg/types/entities"
 	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
 	common "github.com/ethereum/go-ethereum/common"
@@ -135,6 +136,48 @@ func (mr *MockContractUseCasesMockRecorder) SearchContract() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchContract", reflect.TypeOf((*MockContractUseCases)(nil).SearchContract))
 }
 
+// DeleteContract mocks base method
+func (m *MockContractUseCases) DeleteContract() usecases.DeleteContractUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteContract")
+	ret0, _ := ret[0].(usecases.DeleteContractUseCase)
+	return ret0
+}
+
+// DeleteContract indicates an expected call of DeleteContract
+func (mr *MockContractUseCasesMockRecorder) DeleteContract() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContract", reflect.TypeOf((*MockContractUseCases)(nil).DeleteContract))
+}
+
+// DeleteContractTag mocks base method
+func (m *MockContractUseCases) DeleteContractTag() usecases.DeleteContractTagUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteContractTag")
+	ret0, _ := ret[0].(usecases.DeleteContractTagUseCase)
+	return ret0
+}
+
+// DeleteContractTag indicates an expected call of DeleteContractTag
+func (mr *MockContractUseCasesMockRecorder) DeleteContractTag() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContractTag", reflect.TypeOf((*MockContractUseCases)(nil).DeleteContractTag))
+}
+
+// SetContractTag mocks base method
+func (m *MockContractUseCases) SetContractTag() usecases.SetContractTagUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetContractTag")
+	ret0, _ := ret[0].(usecases.SetContractTagUseCase)
+	return ret0
+}
+
+// SetContractTag indicates an expected call of SetContractTag
+func (mr *MockContractUseCasesMockRecorder) SetContractTag() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetContractTag", reflect.TypeOf((*MockContractUseCases)(nil).SetContractTag))
+}
+
 // MockGetContractsCatalogUseCase is a mock of GetContractsCatalogUseCase interface
 type MockGetContractsCatalogUseCase struct {
 	ctrl     *gomock.Controller
@@ -159,18 +202,18 @@ func (m *MockGetContractsCatalogUseCase) EXPECT() *MockGetContractsCatalogUseCas
 }
 
 // Execute mocks base method
-func (m *MockGetContractsCatalogUseCase) Execute(ctx context.Context) ([]string, error) {
+func (m *MockGetContractsCatalogUseCase) Execute(ctx context.Context, userInfo *multitenancy.UserInfo) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", ctx)
+	ret := m.ctrl.Call(m, "Execute", ctx, userInfo)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockGetContractsCatalogUseCaseMockRecorder) Execute(ctx interface{}) *gomock.Call {
+func (mr *MockGetContractsCatalogUseCaseMockRecorder) Execute(ctx, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetContractsCatalogUseCase)(nil).Execute), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetContractsCatalogUseCase)(nil).Execute), ctx, userInfo)
 }
 
 // MockGetContractUseCase is a mock of GetContractUseCase interface
@@ -197,18 +240,18 @@ func (m *MockGetContractUseCase) EXPECT() *MockGetContractUseCaseMockRecorder {
 }
 
 // Execute mocks base method
-func (m *MockGetContractUseCase) Execute(ctx context.Context, name, tag string) (*entities.Contract, error) {
+func (m *MockGetContractUseCase) Execute(ctx context.Context, name, tag string, userInfo *multitenancy.UserInfo) (*entities.Contract, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", ctx, name, tag)
+	ret := m.ctrl.Call(m, "Execute", ctx, name, tag, userInfo)
 	ret0, _ := ret[0].(*entities.Contract)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockGetContractUseCaseMockRecorder) Execute(ctx, name, tag interface{}) *gomock.Call {
+func (mr *MockGetContractUseCaseMockRecorder) Execute(ctx, name, tag, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetContractUseCase)(nil).Execute), ctx, name, tag)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetContractUseCase)(nil).Execute), ctx, name, tag, userInfo)
 }
 
 // MockSearchContractUseCase is a mock of SearchContractUseCase interface
@@ -235,18 +278,18 @@ func (m *MockSearchContractUseCase) EXPECT() *MockSearchContractUseCaseMockRecor
 }
 
 // Execute mocks base method
-func (m *MockSearchContractUseCase) Execute(ctx context.Context, codehash hexutil.Bytes, address *common.Address) (*entities.Contract, error) {
+func (m *MockSearchContractUseCase) Execute(ctx context.Context, codehash hexutil.Bytes, address *common.Address, userInfo *multitenancy.UserInfo) (*entities.Contract, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", ctx, codehash, address)
+	ret := m.ctrl.Call(m, "Execute", ctx, codehash, address, userInfo)
 	ret0, _ := ret[0].(*entities.Contract)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockSearchContractUseCaseMockRecorder) Execute(ctx, codehash, address interface{}) *gomock.Call {
+func (mr *MockSearchContractUseCaseMockRecorder) Execute(ctx, codehash, address, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchContractUseCase)(nil).Execute), ctx, codehash, address)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchContractUseCase)(nil).Execute), ctx, codehash, address, userInfo)
 }
 
 // MockGetContractEventsUseCase is a mock of GetContractEventsUseCase interface
@@ -312,18 +355,18 @@ func (m *MockGetContractTagsUseCase) EXPECT() *MockGetContractTagsUseCaseMockRec
 }
 
 // Execute mocks base method
-func (m *MockGetContractTagsUseCase) Execute(ctx context.Context, name string) ([]string, error) {
+func (m *MockGetContractTagsUseCase) Execute(ctx context.Context, name string, userInfo *multitenancy.UserInfo) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", ctx, name)
+	ret := m.ctrl.Call(m, "Execute", ctx, name, userInfo)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockGetContractTagsUseCaseMockRecorder) Execute(ctx, name interface{}) *gomock.Call {
+func (mr *MockGetContractTagsUseCaseMockRecorder) Execute(ctx, name, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetContractTagsUseCase)(nil).Execute), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetContractTagsUseCase)(nil).Execute), ctx, name, userInfo)
 }
 
 // MockRegisterContractUseCase is a mock of RegisterContractUseCase interface
@@ -350,17 +393,128 @@ func (m *MockRegisterContractUseCase) EXPECT() *MockRegisterContractUseCaseMockR
 }
 
 // Execute mocks base method
-func (m *MockRegisterContractUseCase) Execute(ctx context.Context, contract *entities.Contract) error {
+func (m *MockRegisterContractUseCase) Execute(ctx context.Context, contract *entities.Contract, userInfo *multitenancy.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, contract, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockRegisterContractUseCaseMockRecorder) Execute(ctx, contract, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRegisterContractUseCase)(nil).Execute), ctx, contract, userInfo)
+}
+
+// MockDeleteContractUseCase is a mock of DeleteContractUseCase interface
+type MockDeleteContractUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteContractUseCaseMockRecorder
+}
+
+// MockDeleteContractUseCaseMockRecorder is the mock recorder for MockDeleteContractUseCase
+type MockDeleteContractUseCaseMockRecorder struct {
+	mock *MockDeleteContractUseCase
+}
+
+// NewMockDeleteContractUseCase creates a new mock instance
+func NewMockDeleteContractUseCase(ctrl *gomock.Controller) *MockDeleteContractUseCase {
+	mock := &MockDeleteContractUseCase{ctrl: ctrl}
+	mock.recorder = &MockDeleteContractUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDeleteContractUseCase) EXPECT() *MockDeleteContractUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockDeleteContractUseCase) Execute(ctx context.Context, name string, userInfo *multitenancy.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, name, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockDeleteContractUseCaseMockRecorder) Execute(ctx, name, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDeleteContractUseCase)(nil).Execute), ctx, name, userInfo)
+}
+
+// MockDeleteContractTagUseCase is a mock of DeleteContractTagUseCase interface
+type MockDeleteContractTagUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteContractTagUseCaseMockRecorder
+}
+
+// MockDeleteContractTagUseCaseMockRecorder is the mock recorder for MockDeleteContractTagUseCase
+type MockDeleteContractTagUseCaseMockRecorder struct {
+	mock *MockDeleteContractTagUseCase
+}
+
+// NewMockDeleteContractTagUseCase creates a new mock instance
+func NewMockDeleteContractTagUseCase(ctrl *gomock.Controller) *MockDeleteContractTagUseCase {
+	mock := &MockDeleteContractTagUseCase{ctrl: ctrl}
+	mock.recorder = &MockDeleteContractTagUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDeleteContractTagUseCase) EXPECT() *MockDeleteContractTagUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockDeleteContractTagUseCase) Execute(ctx context.Context, name, tag string, userInfo *multitenancy.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, name, tag, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockDeleteContractTagUseCaseMockRecorder) Execute(ctx, name, tag, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDeleteContractTagUseCase)(nil).Execute), ctx, name, tag, userInfo)
+}
+
+// MockSetContractTagUseCase is a mock of SetContractTagUseCase interface
+type MockSetContractTagUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetContractTagUseCaseMockRecorder
+}
+
+// MockSetContractTagUseCaseMockRecorder is the mock recorder for MockSetContractTagUseCase
+type MockSetContractTagUseCaseMockRecorder struct {
+	mock *MockSetContractTagUseCase
+}
+
+// NewMockSetContractTagUseCase creates a new mock instance
+func NewMockSetContractTagUseCase(ctrl *gomock.Controller) *MockSetContractTagUseCase {
+	mock := &MockSetContractTagUseCase{ctrl: ctrl}
+	mock.recorder = &MockSetContractTagUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSetContractTagUseCase) EXPECT() *MockSetContractTagUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockSetContractTagUseCase) Execute(ctx context.Context, name, tag, target string, userInfo *multitenancy.UserInfo) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", ctx, contract)
+	ret := m.ctrl.Call(m, "Execute", ctx, name, tag, target, userInfo)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockRegisterContractUseCaseMockRecorder) Execute(ctx, contract interface{}) *gomock.Call {
+func (mr *MockSetContractTagUseCaseMockRecorder) Execute(ctx, name, tag, target, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRegisterContractUseCase)(nil).Execute), ctx, contract)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSetContractTagUseCase)(nil).Execute), ctx, name, tag, target, userInfo)
 }
 
 // MockSetContractCodeHashUseCase is a mock of SetContractCodeHashUseCase interface
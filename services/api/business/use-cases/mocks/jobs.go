@@ -6,39 +6,55 @@ package mocks
 
 import (
 	context "context"
+	reflect "reflect"
+
+	broker "github.com/consensys/orchestrate/pkg/broker"
 	multitenancy "github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	entities "github.com/consensys/orchestrate/pkg/types/entities"
 	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
 	store "github.com/consensys/orchestrate/services/api/store"
 	hexutil "github.com/ethereum/go-ethereum/common/hexutil"
 	gomock "github.com/golang/mock/gomock"
-	reflect "reflect"
 )
 
-// MockJobUseCases is a mock of JobUseCases interface
+// MockJobUseCases is a mock of JobUseCases interface.
 type MockJobUseCases struct {
 	ctrl     *gomock.Controller
 	recorder *MockJobUseCasesMockRecorder
 }
 
-// MockJobUseCasesMockRecorder is the mock recorder for MockJobUseCases
+// MockJobUseCasesMockRecorder is the mock recorder for MockJobUseCases.
 type MockJobUseCasesMockRecorder struct {
 	mock *MockJobUseCases
 }
 
-// NewMockJobUseCases creates a new mock instance
+// NewMockJobUseCases creates a new mock instance.
 func NewMockJobUseCases(ctrl *gomock.Controller) *MockJobUseCases {
 	mock := &MockJobUseCases{ctrl: ctrl}
 	mock.recorder = &MockJobUseCasesMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockJobUseCases) EXPECT() *MockJobUseCasesMockRecorder {
 	return m.recorder
 }
 
-// CreateJob mocks base method
+// ApproveJob mocks base method.
+func (m *MockJobUseCases) ApproveJob() usecases.ApproveJobUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveJob")
+	ret0, _ := ret[0].(usecases.ApproveJobUseCase)
+	return ret0
+}
+
+// ApproveJob indicates an expected call of ApproveJob.
+func (mr *MockJobUseCasesMockRecorder) ApproveJob() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveJob", reflect.TypeOf((*MockJobUseCases)(nil).ApproveJob))
+}
+
+// CreateJob mocks base method.
 func (m *MockJobUseCases) CreateJob() usecases.CreateJobUseCase {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateJob")
@@ -46,13 +62,41 @@ func (m *MockJobUseCases) CreateJob() usecases.CreateJobUseCase {
 	return ret0
 }
 
-// CreateJob indicates an expected call of CreateJob
+// CreateJob indicates an expected call of CreateJob.
 func (mr *MockJobUseCasesMockRecorder) CreateJob() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJob", reflect.TypeOf((*MockJobUseCases)(nil).CreateJob))
 }
 
-// GetJob mocks base method
+// DispatchScheduledJobs mocks base method.
+func (m *MockJobUseCases) DispatchScheduledJobs() usecases.DispatchScheduledJobsUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispatchScheduledJobs")
+	ret0, _ := ret[0].(usecases.DispatchScheduledJobsUseCase)
+	return ret0
+}
+
+// DispatchScheduledJobs indicates an expected call of DispatchScheduledJobs.
+func (mr *MockJobUseCasesMockRecorder) DispatchScheduledJobs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispatchScheduledJobs", reflect.TypeOf((*MockJobUseCases)(nil).DispatchScheduledJobs))
+}
+
+// EnqueueMessage mocks base method.
+func (m *MockJobUseCases) EnqueueMessage() usecases.EnqueueMessageUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueMessage")
+	ret0, _ := ret[0].(usecases.EnqueueMessageUseCase)
+	return ret0
+}
+
+// EnqueueMessage indicates an expected call of EnqueueMessage.
+func (mr *MockJobUseCasesMockRecorder) EnqueueMessage() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueMessage", reflect.TypeOf((*MockJobUseCases)(nil).EnqueueMessage))
+}
+
+// GetJob mocks base method.
 func (m *MockJobUseCases) GetJob() usecases.GetJobUseCase {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetJob")
@@ -60,55 +104,55 @@ func (m *MockJobUseCases) GetJob() usecases.GetJobUseCase {
 	return ret0
 }
 
-// GetJob indicates an expected call of GetJob
+// GetJob indicates an expected call of GetJob.
 func (mr *MockJobUseCasesMockRecorder) GetJob() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockJobUseCases)(nil).GetJob))
 }
 
-// StartJob mocks base method
-func (m *MockJobUseCases) StartJob() usecases.StartJobUseCase {
+// RejectJob mocks base method.
+func (m *MockJobUseCases) RejectJob() usecases.RejectJobUseCase {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StartJob")
-	ret0, _ := ret[0].(usecases.StartJobUseCase)
+	ret := m.ctrl.Call(m, "RejectJob")
+	ret0, _ := ret[0].(usecases.RejectJobUseCase)
 	return ret0
 }
 
-// StartJob indicates an expected call of StartJob
-func (mr *MockJobUseCasesMockRecorder) StartJob() *gomock.Call {
+// RejectJob indicates an expected call of RejectJob.
+func (mr *MockJobUseCasesMockRecorder) RejectJob() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartJob", reflect.TypeOf((*MockJobUseCases)(nil).StartJob))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectJob", reflect.TypeOf((*MockJobUseCases)(nil).RejectJob))
 }
 
-// ResendJobTx mocks base method
-func (m *MockJobUseCases) ResendJobTx() usecases.ResendJobTxUseCase {
+// RelayOutboxMessages mocks base method.
+func (m *MockJobUseCases) RelayOutboxMessages() usecases.RelayOutboxMessagesUseCase {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ResendJobTx")
-	ret0, _ := ret[0].(usecases.ResendJobTxUseCase)
+	ret := m.ctrl.Call(m, "RelayOutboxMessages")
+	ret0, _ := ret[0].(usecases.RelayOutboxMessagesUseCase)
 	return ret0
 }
 
-// ResendJobTx indicates an expected call of ResendJobTx
-func (mr *MockJobUseCasesMockRecorder) ResendJobTx() *gomock.Call {
+// RelayOutboxMessages indicates an expected call of RelayOutboxMessages.
+func (mr *MockJobUseCasesMockRecorder) RelayOutboxMessages() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendJobTx", reflect.TypeOf((*MockJobUseCases)(nil).ResendJobTx))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayOutboxMessages", reflect.TypeOf((*MockJobUseCases)(nil).RelayOutboxMessages))
 }
 
-// UpdateJob mocks base method
-func (m *MockJobUseCases) UpdateJob() usecases.UpdateJobUseCase {
+// ResendJobTx mocks base method.
+func (m *MockJobUseCases) ResendJobTx() usecases.ResendJobTxUseCase {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateJob")
-	ret0, _ := ret[0].(usecases.UpdateJobUseCase)
+	ret := m.ctrl.Call(m, "ResendJobTx")
+	ret0, _ := ret[0].(usecases.ResendJobTxUseCase)
 	return ret0
 }
 
-// UpdateJob indicates an expected call of UpdateJob
-func (mr *MockJobUseCasesMockRecorder) UpdateJob() *gomock.Call {
+// ResendJobTx indicates an expected call of ResendJobTx.
+func (mr *MockJobUseCasesMockRecorder) ResendJobTx() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJob", reflect.TypeOf((*MockJobUseCases)(nil).UpdateJob))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendJobTx", reflect.TypeOf((*MockJobUseCases)(nil).ResendJobTx))
 }
 
-// RetryTx mocks base method
+// RetryTx mocks base method.
 func (m *MockJobUseCases) RetryTx() usecases.RetryJobTxUseCase {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RetryTx")
@@ -116,13 +160,13 @@ func (m *MockJobUseCases) RetryTx() usecases.RetryJobTxUseCase {
 	return ret0
 }
 
-// RetryTx indicates an expected call of RetryTx
+// RetryTx indicates an expected call of RetryTx.
 func (mr *MockJobUseCasesMockRecorder) RetryTx() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryTx", reflect.TypeOf((*MockJobUseCases)(nil).RetryTx))
 }
 
-// SearchJobs mocks base method
+// SearchJobs mocks base method.
 func (m *MockJobUseCases) SearchJobs() usecases.SearchJobsUseCase {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SearchJobs")
@@ -130,36 +174,64 @@ func (m *MockJobUseCases) SearchJobs() usecases.SearchJobsUseCase {
 	return ret0
 }
 
-// SearchJobs indicates an expected call of SearchJobs
+// SearchJobs indicates an expected call of SearchJobs.
 func (mr *MockJobUseCasesMockRecorder) SearchJobs() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchJobs", reflect.TypeOf((*MockJobUseCases)(nil).SearchJobs))
 }
 
-// MockCreateJobUseCase is a mock of CreateJobUseCase interface
+// StartJob mocks base method.
+func (m *MockJobUseCases) StartJob() usecases.StartJobUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartJob")
+	ret0, _ := ret[0].(usecases.StartJobUseCase)
+	return ret0
+}
+
+// StartJob indicates an expected call of StartJob.
+func (mr *MockJobUseCasesMockRecorder) StartJob() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartJob", reflect.TypeOf((*MockJobUseCases)(nil).StartJob))
+}
+
+// UpdateJob mocks base method.
+func (m *MockJobUseCases) UpdateJob() usecases.UpdateJobUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateJob")
+	ret0, _ := ret[0].(usecases.UpdateJobUseCase)
+	return ret0
+}
+
+// UpdateJob indicates an expected call of UpdateJob.
+func (mr *MockJobUseCasesMockRecorder) UpdateJob() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJob", reflect.TypeOf((*MockJobUseCases)(nil).UpdateJob))
+}
+
+// MockCreateJobUseCase is a mock of CreateJobUseCase interface.
 type MockCreateJobUseCase struct {
 	ctrl     *gomock.Controller
 	recorder *MockCreateJobUseCaseMockRecorder
 }
 
-// MockCreateJobUseCaseMockRecorder is the mock recorder for MockCreateJobUseCase
+// MockCreateJobUseCaseMockRecorder is the mock recorder for MockCreateJobUseCase.
 type MockCreateJobUseCaseMockRecorder struct {
 	mock *MockCreateJobUseCase
 }
 
-// NewMockCreateJobUseCase creates a new mock instance
+// NewMockCreateJobUseCase creates a new mock instance.
 func NewMockCreateJobUseCase(ctrl *gomock.Controller) *MockCreateJobUseCase {
 	mock := &MockCreateJobUseCase{ctrl: ctrl}
 	mock.recorder = &MockCreateJobUseCaseMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockCreateJobUseCase) EXPECT() *MockCreateJobUseCaseMockRecorder {
 	return m.recorder
 }
 
-// Execute mocks base method
+// Execute mocks base method.
 func (m *MockCreateJobUseCase) Execute(ctx context.Context, job *entities.Job, userInfo *multitenancy.UserInfo) (*entities.Job, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Execute", ctx, job, userInfo)
@@ -168,13 +240,13 @@ func (m *MockCreateJobUseCase) Execute(ctx context.Context, job *entities.Job, u
 	return ret0, ret1
 }
 
-// Execute indicates an expected call of Execute
+// Execute indicates an expected call of Execute.
 func (mr *MockCreateJobUseCaseMockRecorder) Execute(ctx, job, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateJobUseCase)(nil).Execute), ctx, job, userInfo)
 }
 
-// WithDBTransaction mocks base method
+// WithDBTransaction mocks base method.
 func (m *MockCreateJobUseCase) WithDBTransaction(dbtx store.Tx) usecases.CreateJobUseCase {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "WithDBTransaction", dbtx)
@@ -182,36 +254,36 @@ func (m *MockCreateJobUseCase) WithDBTransaction(dbtx store.Tx) usecases.CreateJ
 	return ret0
 }
 
-// WithDBTransaction indicates an expected call of WithDBTransaction
+// WithDBTransaction indicates an expected call of WithDBTransaction.
 func (mr *MockCreateJobUseCaseMockRecorder) WithDBTransaction(dbtx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithDBTransaction", reflect.TypeOf((*MockCreateJobUseCase)(nil).WithDBTransaction), dbtx)
 }
 
-// MockGetJobUseCase is a mock of GetJobUseCase interface
+// MockGetJobUseCase is a mock of GetJobUseCase interface.
 type MockGetJobUseCase struct {
 	ctrl     *gomock.Controller
 	recorder *MockGetJobUseCaseMockRecorder
 }
 
-// MockGetJobUseCaseMockRecorder is the mock recorder for MockGetJobUseCase
+// MockGetJobUseCaseMockRecorder is the mock recorder for MockGetJobUseCase.
 type MockGetJobUseCaseMockRecorder struct {
 	mock *MockGetJobUseCase
 }
 
-// NewMockGetJobUseCase creates a new mock instance
+// NewMockGetJobUseCase creates a new mock instance.
 func NewMockGetJobUseCase(ctrl *gomock.Controller) *MockGetJobUseCase {
 	mock := &MockGetJobUseCase{ctrl: ctrl}
 	mock.recorder = &MockGetJobUseCaseMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockGetJobUseCase) EXPECT() *MockGetJobUseCaseMockRecorder {
 	return m.recorder
 }
 
-// Execute mocks base method
+// Execute mocks base method.
 func (m *MockGetJobUseCase) Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) (*entities.Job, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Execute", ctx, jobUUID, userInfo)
@@ -220,36 +292,36 @@ func (m *MockGetJobUseCase) Execute(ctx context.Context, jobUUID string, userInf
 	return ret0, ret1
 }
 
-// Execute indicates an expected call of Execute
+// Execute indicates an expected call of Execute.
 func (mr *MockGetJobUseCaseMockRecorder) Execute(ctx, jobUUID, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetJobUseCase)(nil).Execute), ctx, jobUUID, userInfo)
 }
 
-// MockSearchJobsUseCase is a mock of SearchJobsUseCase interface
+// MockSearchJobsUseCase is a mock of SearchJobsUseCase interface.
 type MockSearchJobsUseCase struct {
 	ctrl     *gomock.Controller
 	recorder *MockSearchJobsUseCaseMockRecorder
 }
 
-// MockSearchJobsUseCaseMockRecorder is the mock recorder for MockSearchJobsUseCase
+// MockSearchJobsUseCaseMockRecorder is the mock recorder for MockSearchJobsUseCase.
 type MockSearchJobsUseCaseMockRecorder struct {
 	mock *MockSearchJobsUseCase
 }
 
-// NewMockSearchJobsUseCase creates a new mock instance
+// NewMockSearchJobsUseCase creates a new mock instance.
 func NewMockSearchJobsUseCase(ctrl *gomock.Controller) *MockSearchJobsUseCase {
 	mock := &MockSearchJobsUseCase{ctrl: ctrl}
 	mock.recorder = &MockSearchJobsUseCaseMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockSearchJobsUseCase) EXPECT() *MockSearchJobsUseCaseMockRecorder {
 	return m.recorder
 }
 
-// Execute mocks base method
+// Execute mocks base method.
 func (m *MockSearchJobsUseCase) Execute(ctx context.Context, filters *entities.JobFilters, userInfo *multitenancy.UserInfo) ([]*entities.Job, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Execute", ctx, filters, userInfo)
@@ -258,36 +330,36 @@ func (m *MockSearchJobsUseCase) Execute(ctx context.Context, filters *entities.J
 	return ret0, ret1
 }
 
-// Execute indicates an expected call of Execute
+// Execute indicates an expected call of Execute.
 func (mr *MockSearchJobsUseCaseMockRecorder) Execute(ctx, filters, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchJobsUseCase)(nil).Execute), ctx, filters, userInfo)
 }
 
-// MockStartJobUseCase is a mock of StartJobUseCase interface
+// MockStartJobUseCase is a mock of StartJobUseCase interface.
 type MockStartJobUseCase struct {
 	ctrl     *gomock.Controller
 	recorder *MockStartJobUseCaseMockRecorder
 }
 
-// MockStartJobUseCaseMockRecorder is the mock recorder for MockStartJobUseCase
+// MockStartJobUseCaseMockRecorder is the mock recorder for MockStartJobUseCase.
 type MockStartJobUseCaseMockRecorder struct {
 	mock *MockStartJobUseCase
 }
 
-// NewMockStartJobUseCase creates a new mock instance
+// NewMockStartJobUseCase creates a new mock instance.
 func NewMockStartJobUseCase(ctrl *gomock.Controller) *MockStartJobUseCase {
 	mock := &MockStartJobUseCase{ctrl: ctrl}
 	mock.recorder = &MockStartJobUseCaseMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockStartJobUseCase) EXPECT() *MockStartJobUseCaseMockRecorder {
 	return m.recorder
 }
 
-// Execute mocks base method
+// Execute mocks base method.
 func (m *MockStartJobUseCase) Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Execute", ctx, jobUUID, userInfo)
@@ -295,36 +367,260 @@ func (m *MockStartJobUseCase) Execute(ctx context.Context, jobUUID string, userI
 	return ret0
 }
 
-// Execute indicates an expected call of Execute
+// Execute indicates an expected call of Execute.
 func (mr *MockStartJobUseCaseMockRecorder) Execute(ctx, jobUUID, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockStartJobUseCase)(nil).Execute), ctx, jobUUID, userInfo)
 }
 
-// MockStartNextJobUseCase is a mock of StartNextJobUseCase interface
+// MockApproveJobUseCase is a mock of ApproveJobUseCase interface.
+type MockApproveJobUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockApproveJobUseCaseMockRecorder
+}
+
+// MockApproveJobUseCaseMockRecorder is the mock recorder for MockApproveJobUseCase.
+type MockApproveJobUseCaseMockRecorder struct {
+	mock *MockApproveJobUseCase
+}
+
+// NewMockApproveJobUseCase creates a new mock instance.
+func NewMockApproveJobUseCase(ctrl *gomock.Controller) *MockApproveJobUseCase {
+	mock := &MockApproveJobUseCase{ctrl: ctrl}
+	mock.recorder = &MockApproveJobUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApproveJobUseCase) EXPECT() *MockApproveJobUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockApproveJobUseCase) Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) (*entities.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, jobUUID, userInfo)
+	ret0, _ := ret[0].(*entities.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockApproveJobUseCaseMockRecorder) Execute(ctx, jobUUID, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockApproveJobUseCase)(nil).Execute), ctx, jobUUID, userInfo)
+}
+
+// MockRejectJobUseCase is a mock of RejectJobUseCase interface.
+type MockRejectJobUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRejectJobUseCaseMockRecorder
+}
+
+// MockRejectJobUseCaseMockRecorder is the mock recorder for MockRejectJobUseCase.
+type MockRejectJobUseCaseMockRecorder struct {
+	mock *MockRejectJobUseCase
+}
+
+// NewMockRejectJobUseCase creates a new mock instance.
+func NewMockRejectJobUseCase(ctrl *gomock.Controller) *MockRejectJobUseCase {
+	mock := &MockRejectJobUseCase{ctrl: ctrl}
+	mock.recorder = &MockRejectJobUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRejectJobUseCase) EXPECT() *MockRejectJobUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRejectJobUseCase) Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) (*entities.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, jobUUID, userInfo)
+	ret0, _ := ret[0].(*entities.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRejectJobUseCaseMockRecorder) Execute(ctx, jobUUID, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRejectJobUseCase)(nil).Execute), ctx, jobUUID, userInfo)
+}
+
+// MockStartJobsUseCase is a mock of StartJobsUseCase interface.
+type MockStartJobsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockStartJobsUseCaseMockRecorder
+}
+
+// MockStartJobsUseCaseMockRecorder is the mock recorder for MockStartJobsUseCase.
+type MockStartJobsUseCaseMockRecorder struct {
+	mock *MockStartJobsUseCase
+}
+
+// NewMockStartJobsUseCase creates a new mock instance.
+func NewMockStartJobsUseCase(ctrl *gomock.Controller) *MockStartJobsUseCase {
+	mock := &MockStartJobsUseCase{ctrl: ctrl}
+	mock.recorder = &MockStartJobsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStartJobsUseCase) EXPECT() *MockStartJobsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockStartJobsUseCase) Execute(ctx context.Context, jobUUIDs []string, userInfo *multitenancy.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, jobUUIDs, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockStartJobsUseCaseMockRecorder) Execute(ctx, jobUUIDs, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockStartJobsUseCase)(nil).Execute), ctx, jobUUIDs, userInfo)
+}
+
+// MockDispatchScheduledJobsUseCase is a mock of DispatchScheduledJobsUseCase interface.
+type MockDispatchScheduledJobsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDispatchScheduledJobsUseCaseMockRecorder
+}
+
+// MockDispatchScheduledJobsUseCaseMockRecorder is the mock recorder for MockDispatchScheduledJobsUseCase.
+type MockDispatchScheduledJobsUseCaseMockRecorder struct {
+	mock *MockDispatchScheduledJobsUseCase
+}
+
+// NewMockDispatchScheduledJobsUseCase creates a new mock instance.
+func NewMockDispatchScheduledJobsUseCase(ctrl *gomock.Controller) *MockDispatchScheduledJobsUseCase {
+	mock := &MockDispatchScheduledJobsUseCase{ctrl: ctrl}
+	mock.recorder = &MockDispatchScheduledJobsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDispatchScheduledJobsUseCase) EXPECT() *MockDispatchScheduledJobsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockDispatchScheduledJobsUseCase) Execute(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockDispatchScheduledJobsUseCaseMockRecorder) Execute(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDispatchScheduledJobsUseCase)(nil).Execute), ctx)
+}
+
+// MockRelayOutboxMessagesUseCase is a mock of RelayOutboxMessagesUseCase interface.
+type MockRelayOutboxMessagesUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelayOutboxMessagesUseCaseMockRecorder
+}
+
+// MockRelayOutboxMessagesUseCaseMockRecorder is the mock recorder for MockRelayOutboxMessagesUseCase.
+type MockRelayOutboxMessagesUseCaseMockRecorder struct {
+	mock *MockRelayOutboxMessagesUseCase
+}
+
+// NewMockRelayOutboxMessagesUseCase creates a new mock instance.
+func NewMockRelayOutboxMessagesUseCase(ctrl *gomock.Controller) *MockRelayOutboxMessagesUseCase {
+	mock := &MockRelayOutboxMessagesUseCase{ctrl: ctrl}
+	mock.recorder = &MockRelayOutboxMessagesUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelayOutboxMessagesUseCase) EXPECT() *MockRelayOutboxMessagesUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRelayOutboxMessagesUseCase) Execute(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRelayOutboxMessagesUseCaseMockRecorder) Execute(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRelayOutboxMessagesUseCase)(nil).Execute), ctx)
+}
+
+// MockEnqueueMessageUseCase is a mock of EnqueueMessageUseCase interface.
+type MockEnqueueMessageUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnqueueMessageUseCaseMockRecorder
+}
+
+// MockEnqueueMessageUseCaseMockRecorder is the mock recorder for MockEnqueueMessageUseCase.
+type MockEnqueueMessageUseCaseMockRecorder struct {
+	mock *MockEnqueueMessageUseCase
+}
+
+// NewMockEnqueueMessageUseCase creates a new mock instance.
+func NewMockEnqueueMessageUseCase(ctrl *gomock.Controller) *MockEnqueueMessageUseCase {
+	mock := &MockEnqueueMessageUseCase{ctrl: ctrl}
+	mock.recorder = &MockEnqueueMessageUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnqueueMessageUseCase) EXPECT() *MockEnqueueMessageUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockEnqueueMessageUseCase) Execute(ctx context.Context, msg *broker.Message, jobUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, msg, jobUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockEnqueueMessageUseCaseMockRecorder) Execute(ctx, msg, jobUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockEnqueueMessageUseCase)(nil).Execute), ctx, msg, jobUUID)
+}
+
+// MockStartNextJobUseCase is a mock of StartNextJobUseCase interface.
 type MockStartNextJobUseCase struct {
 	ctrl     *gomock.Controller
 	recorder *MockStartNextJobUseCaseMockRecorder
 }
 
-// MockStartNextJobUseCaseMockRecorder is the mock recorder for MockStartNextJobUseCase
+// MockStartNextJobUseCaseMockRecorder is the mock recorder for MockStartNextJobUseCase.
 type MockStartNextJobUseCaseMockRecorder struct {
 	mock *MockStartNextJobUseCase
 }
 
-// NewMockStartNextJobUseCase creates a new mock instance
+// NewMockStartNextJobUseCase creates a new mock instance.
 func NewMockStartNextJobUseCase(ctrl *gomock.Controller) *MockStartNextJobUseCase {
 	mock := &MockStartNextJobUseCase{ctrl: ctrl}
 	mock.recorder = &MockStartNextJobUseCaseMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockStartNextJobUseCase) EXPECT() *MockStartNextJobUseCaseMockRecorder {
 	return m.recorder
 }
 
-// Execute mocks base method
+// Execute mocks base method.
 func (m *MockStartNextJobUseCase) Execute(ctx context.Context, prevJobUUID string, userInfo *multitenancy.UserInfo) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Execute", ctx, prevJobUUID, userInfo)
@@ -332,36 +628,36 @@ func (m *MockStartNextJobUseCase) Execute(ctx context.Context, prevJobUUID strin
 	return ret0
 }
 
-// Execute indicates an expected call of Execute
+// Execute indicates an expected call of Execute.
 func (mr *MockStartNextJobUseCaseMockRecorder) Execute(ctx, prevJobUUID, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockStartNextJobUseCase)(nil).Execute), ctx, prevJobUUID, userInfo)
 }
 
-// MockUpdateJobUseCase is a mock of UpdateJobUseCase interface
+// MockUpdateJobUseCase is a mock of UpdateJobUseCase interface.
 type MockUpdateJobUseCase struct {
 	ctrl     *gomock.Controller
 	recorder *MockUpdateJobUseCaseMockRecorder
 }
 
-// MockUpdateJobUseCaseMockRecorder is the mock recorder for MockUpdateJobUseCase
+// MockUpdateJobUseCaseMockRecorder is the mock recorder for MockUpdateJobUseCase.
 type MockUpdateJobUseCaseMockRecorder struct {
 	mock *MockUpdateJobUseCase
 }
 
-// NewMockUpdateJobUseCase creates a new mock instance
+// NewMockUpdateJobUseCase creates a new mock instance.
 func NewMockUpdateJobUseCase(ctrl *gomock.Controller) *MockUpdateJobUseCase {
 	mock := &MockUpdateJobUseCase{ctrl: ctrl}
 	mock.recorder = &MockUpdateJobUseCaseMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockUpdateJobUseCase) EXPECT() *MockUpdateJobUseCaseMockRecorder {
 	return m.recorder
 }
 
-// Execute mocks base method
+// Execute mocks base method.
 func (m *MockUpdateJobUseCase) Execute(ctx context.Context, jobEntity *entities.Job, nextStatus entities.JobStatus, logMessage string, userInfo *multitenancy.UserInfo) (*entities.Job, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Execute", ctx, jobEntity, nextStatus, logMessage, userInfo)
@@ -370,36 +666,36 @@ func (m *MockUpdateJobUseCase) Execute(ctx context.Context, jobEntity *entities.
 	return ret0, ret1
 }
 
-// Execute indicates an expected call of Execute
+// Execute indicates an expected call of Execute.
 func (mr *MockUpdateJobUseCaseMockRecorder) Execute(ctx, jobEntity, nextStatus, logMessage, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUpdateJobUseCase)(nil).Execute), ctx, jobEntity, nextStatus, logMessage, userInfo)
 }
 
-// MockUpdateChildrenUseCase is a mock of UpdateChildrenUseCase interface
+// MockUpdateChildrenUseCase is a mock of UpdateChildrenUseCase interface.
 type MockUpdateChildrenUseCase struct {
 	ctrl     *gomock.Controller
 	recorder *MockUpdateChildrenUseCaseMockRecorder
 }
 
-// MockUpdateChildrenUseCaseMockRecorder is the mock recorder for MockUpdateChildrenUseCase
+// MockUpdateChildrenUseCaseMockRecorder is the mock recorder for MockUpdateChildrenUseCase.
 type MockUpdateChildrenUseCaseMockRecorder struct {
 	mock *MockUpdateChildrenUseCase
 }
 
-// NewMockUpdateChildrenUseCase creates a new mock instance
+// NewMockUpdateChildrenUseCase creates a new mock instance.
 func NewMockUpdateChildrenUseCase(ctrl *gomock.Controller) *MockUpdateChildrenUseCase {
 	mock := &MockUpdateChildrenUseCase{ctrl: ctrl}
 	mock.recorder = &MockUpdateChildrenUseCaseMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockUpdateChildrenUseCase) EXPECT() *MockUpdateChildrenUseCaseMockRecorder {
 	return m.recorder
 }
 
-// Execute mocks base method
+// Execute mocks base method.
 func (m *MockUpdateChildrenUseCase) Execute(ctx context.Context, jobUUID, parentJobUUID string, nextStatus entities.JobStatus, userInfo *multitenancy.UserInfo) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Execute", ctx, jobUUID, parentJobUUID, nextStatus, userInfo)
@@ -407,13 +703,13 @@ func (m *MockUpdateChildrenUseCase) Execute(ctx context.Context, jobUUID, parent
 	return ret0
 }
 
-// Execute indicates an expected call of Execute
+// Execute indicates an expected call of Execute.
 func (mr *MockUpdateChildrenUseCaseMockRecorder) Execute(ctx, jobUUID, parentJobUUID, nextStatus, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUpdateChildrenUseCase)(nil).Execute), ctx, jobUUID, parentJobUUID, nextStatus, userInfo)
 }
 
-// WithDBTransaction mocks base method
+// WithDBTransaction mocks base method.
 func (m *MockUpdateChildrenUseCase) WithDBTransaction(dbtx store.Tx) usecases.UpdateChildrenUseCase {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "WithDBTransaction", dbtx)
@@ -421,36 +717,73 @@ func (m *MockUpdateChildrenUseCase) WithDBTransaction(dbtx store.Tx) usecases.Up
 	return ret0
 }
 
-// WithDBTransaction indicates an expected call of WithDBTransaction
+// WithDBTransaction indicates an expected call of WithDBTransaction.
 func (mr *MockUpdateChildrenUseCaseMockRecorder) WithDBTransaction(dbtx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithDBTransaction", reflect.TypeOf((*MockUpdateChildrenUseCase)(nil).WithDBTransaction), dbtx)
 }
 
-// MockResendJobTxUseCase is a mock of ResendJobTxUseCase interface
+// MockUpdateDependentJobsUseCase is a mock of UpdateDependentJobsUseCase interface.
+type MockUpdateDependentJobsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateDependentJobsUseCaseMockRecorder
+}
+
+// MockUpdateDependentJobsUseCaseMockRecorder is the mock recorder for MockUpdateDependentJobsUseCase.
+type MockUpdateDependentJobsUseCaseMockRecorder struct {
+	mock *MockUpdateDependentJobsUseCase
+}
+
+// NewMockUpdateDependentJobsUseCase creates a new mock instance.
+func NewMockUpdateDependentJobsUseCase(ctrl *gomock.Controller) *MockUpdateDependentJobsUseCase {
+	mock := &MockUpdateDependentJobsUseCase{ctrl: ctrl}
+	mock.recorder = &MockUpdateDependentJobsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateDependentJobsUseCase) EXPECT() *MockUpdateDependentJobsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockUpdateDependentJobsUseCase) Execute(ctx context.Context, job *entities.Job, userInfo *multitenancy.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, job, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockUpdateDependentJobsUseCaseMockRecorder) Execute(ctx, job, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUpdateDependentJobsUseCase)(nil).Execute), ctx, job, userInfo)
+}
+
+// MockResendJobTxUseCase is a mock of ResendJobTxUseCase interface.
 type MockResendJobTxUseCase struct {
 	ctrl     *gomock.Controller
 	recorder *MockResendJobTxUseCaseMockRecorder
 }
 
-// MockResendJobTxUseCaseMockRecorder is the mock recorder for MockResendJobTxUseCase
+// MockResendJobTxUseCaseMockRecorder is the mock recorder for MockResendJobTxUseCase.
 type MockResendJobTxUseCaseMockRecorder struct {
 	mock *MockResendJobTxUseCase
 }
 
-// NewMockResendJobTxUseCase creates a new mock instance
+// NewMockResendJobTxUseCase creates a new mock instance.
 func NewMockResendJobTxUseCase(ctrl *gomock.Controller) *MockResendJobTxUseCase {
 	mock := &MockResendJobTxUseCase{ctrl: ctrl}
 	mock.recorder = &MockResendJobTxUseCaseMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockResendJobTxUseCase) EXPECT() *MockResendJobTxUseCaseMockRecorder {
 	return m.recorder
 }
 
-// Execute mocks base method
+// Execute mocks base method.
 func (m *MockResendJobTxUseCase) Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Execute", ctx, jobUUID, userInfo)
@@ -458,36 +791,36 @@ func (m *MockResendJobTxUseCase) Execute(ctx context.Context, jobUUID string, us
 	return ret0
 }
 
-// Execute indicates an expected call of Execute
+// Execute indicates an expected call of Execute.
 func (mr *MockResendJobTxUseCaseMockRecorder) Execute(ctx, jobUUID, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockResendJobTxUseCase)(nil).Execute), ctx, jobUUID, userInfo)
 }
 
-// MockRetryJobTxUseCase is a mock of RetryJobTxUseCase interface
+// MockRetryJobTxUseCase is a mock of RetryJobTxUseCase interface.
 type MockRetryJobTxUseCase struct {
 	ctrl     *gomock.Controller
 	recorder *MockRetryJobTxUseCaseMockRecorder
 }
 
-// MockRetryJobTxUseCaseMockRecorder is the mock recorder for MockRetryJobTxUseCase
+// MockRetryJobTxUseCaseMockRecorder is the mock recorder for MockRetryJobTxUseCase.
 type MockRetryJobTxUseCaseMockRecorder struct {
 	mock *MockRetryJobTxUseCase
 }
 
-// NewMockRetryJobTxUseCase creates a new mock instance
+// NewMockRetryJobTxUseCase creates a new mock instance.
 func NewMockRetryJobTxUseCase(ctrl *gomock.Controller) *MockRetryJobTxUseCase {
 	mock := &MockRetryJobTxUseCase{ctrl: ctrl}
 	mock.recorder = &MockRetryJobTxUseCaseMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockRetryJobTxUseCase) EXPECT() *MockRetryJobTxUseCaseMockRecorder {
 	return m.recorder
 }
 
-// Execute mocks base method
+// Execute mocks base method.
 func (m *MockRetryJobTxUseCase) Execute(ctx context.Context, jobUUID string, gasIncrement float64, data hexutil.Bytes, userInfo *multitenancy.UserInfo) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Execute", ctx, jobUUID, gasIncrement, data, userInfo)
@@ -495,7 +828,7 @@ func (m *MockRetryJobTxUseCase) Execute(ctx context.Context, jobUUID string, gas
 	return ret0
 }
 
-// Execute indicates an expected call of Execute
+// Execute indicates an expected call of Execute.
 func (mr *MockRetryJobTxUseCaseMockRecorder) Execute(ctx, jobUUID, gasIncrement, data, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRetryJobTxUseCase)(nil).Execute), ctx, jobUUID, gasIncrement, data, userInfo)
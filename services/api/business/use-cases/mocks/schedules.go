@@ -51,6 +51,20 @@ func (mr *MockScheduleUseCasesMockRecorder) CreateSchedule() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*MockScheduleUseCases)(nil).CreateSchedule))
 }
 
+// CreateScheduleGraph mocks base method
+func (m *MockScheduleUseCases) CreateScheduleGraph() usecases.CreateScheduleGraphUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheduleGraph")
+	ret0, _ := ret[0].(usecases.CreateScheduleGraphUseCase)
+	return ret0
+}
+
+// CreateScheduleGraph indicates an expected call of CreateScheduleGraph
+func (mr *MockScheduleUseCasesMockRecorder) CreateScheduleGraph() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduleGraph", reflect.TypeOf((*MockScheduleUseCases)(nil).CreateScheduleGraph))
+}
+
 // GetSchedule mocks base method
 func (m *MockScheduleUseCases) GetSchedule() usecases.GetScheduleUseCase {
 	m.ctrl.T.Helper()
@@ -131,6 +145,44 @@ func (mr *MockCreateScheduleUseCaseMockRecorder) WithDBTransaction(dbtx interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithDBTransaction", reflect.TypeOf((*MockCreateScheduleUseCase)(nil).WithDBTransaction), dbtx)
 }
 
+// MockCreateScheduleGraphUseCase is a mock of CreateScheduleGraphUseCase interface
+type MockCreateScheduleGraphUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateScheduleGraphUseCaseMockRecorder
+}
+
+// MockCreateScheduleGraphUseCaseMockRecorder is the mock recorder for MockCreateScheduleGraphUseCase
+type MockCreateScheduleGraphUseCaseMockRecorder struct {
+	mock *MockCreateScheduleGraphUseCase
+}
+
+// NewMockCreateScheduleGraphUseCase creates a new mock instance
+func NewMockCreateScheduleGraphUseCase(ctrl *gomock.Controller) *MockCreateScheduleGraphUseCase {
+	mock := &MockCreateScheduleGraphUseCase{ctrl: ctrl}
+	mock.recorder = &MockCreateScheduleGraphUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCreateScheduleGraphUseCase) EXPECT() *MockCreateScheduleGraphUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockCreateScheduleGraphUseCase) Execute(ctx context.Context, graph *entities.ScheduleGraph, userInfo *multitenancy.UserInfo) (*entities.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, graph, userInfo)
+	ret0, _ := ret[0].(*entities.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockCreateScheduleGraphUseCaseMockRecorder) Execute(ctx, graph, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateScheduleGraphUseCase)(nil).Execute), ctx, graph, userInfo)
+}
+
 // MockGetScheduleUseCase is a mock of GetScheduleUseCase interface
 type MockGetScheduleUseCase struct {
 	ctrl     *gomock.Controller
@@ -193,16 +245,16 @@ func (m *MockSearchSchedulesUseCase) EXPECT() *MockSearchSchedulesUseCaseMockRec
 }
 
 // Execute mocks base method
-func (m *MockSearchSchedulesUseCase) Execute(ctx context.Context, userInfo *multitenancy.UserInfo) ([]*entities.Schedule, error) {
+func (m *MockSearchSchedulesUseCase) Execute(ctx context.Context, filters *entities.ScheduleFilters, userInfo *multitenancy.UserInfo) ([]*entities.Schedule, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", ctx, userInfo)
+	ret := m.ctrl.Call(m, "Execute", ctx, filters, userInfo)
 	ret0, _ := ret[0].([]*entities.Schedule)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockSearchSchedulesUseCaseMockRecorder) Execute(ctx, userInfo interface{}) *gomock.Call {
+func (mr *MockSearchSchedulesUseCaseMockRecorder) Execute(ctx, filters, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchSchedulesUseCase)(nil).Execute), ctx, userInfo)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchSchedulesUseCase)(nil).Execute), ctx, filters, userInfo)
 }
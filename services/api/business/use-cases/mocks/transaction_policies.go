@@ -0,0 +1,310 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: transaction_policies.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	multitenancy "github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	entities "github.com/consensys/orchestrate/pkg/types/entities"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	store "github.com/consensys/orchestrate/services/api/store"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockTransactionPolicyUseCases is a mock of TransactionPolicyUseCases interface
+type MockTransactionPolicyUseCases struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionPolicyUseCasesMockRecorder
+}
+
+// MockTransactionPolicyUseCasesMockRecorder is the mock recorder for MockTransactionPolicyUseCases
+type MockTransactionPolicyUseCasesMockRecorder struct {
+	mock *MockTransactionPolicyUseCases
+}
+
+// NewMockTransactionPolicyUseCases creates a new mock instance
+func NewMockTransactionPolicyUseCases(ctrl *gomock.Controller) *MockTransactionPolicyUseCases {
+	mock := &MockTransactionPolicyUseCases{ctrl: ctrl}
+	mock.recorder = &MockTransactionPolicyUseCasesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTransactionPolicyUseCases) EXPECT() *MockTransactionPolicyUseCasesMockRecorder {
+	return m.recorder
+}
+
+// CreateTransactionPolicy mocks base method
+func (m *MockTransactionPolicyUseCases) CreateTransactionPolicy() usecases.CreateTransactionPolicyUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransactionPolicy")
+	ret0, _ := ret[0].(usecases.CreateTransactionPolicyUseCase)
+	return ret0
+}
+
+// CreateTransactionPolicy indicates an expected call of CreateTransactionPolicy
+func (mr *MockTransactionPolicyUseCasesMockRecorder) CreateTransactionPolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransactionPolicy", reflect.TypeOf((*MockTransactionPolicyUseCases)(nil).CreateTransactionPolicy))
+}
+
+// GetTransactionPolicy mocks base method
+func (m *MockTransactionPolicyUseCases) GetTransactionPolicy() usecases.GetTransactionPolicyUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionPolicy")
+	ret0, _ := ret[0].(usecases.GetTransactionPolicyUseCase)
+	return ret0
+}
+
+// GetTransactionPolicy indicates an expected call of GetTransactionPolicy
+func (mr *MockTransactionPolicyUseCasesMockRecorder) GetTransactionPolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionPolicy", reflect.TypeOf((*MockTransactionPolicyUseCases)(nil).GetTransactionPolicy))
+}
+
+// SearchTransactionPolicies mocks base method
+func (m *MockTransactionPolicyUseCases) SearchTransactionPolicies() usecases.SearchTransactionPoliciesUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchTransactionPolicies")
+	ret0, _ := ret[0].(usecases.SearchTransactionPoliciesUseCase)
+	return ret0
+}
+
+// SearchTransactionPolicies indicates an expected call of SearchTransactionPolicies
+func (mr *MockTransactionPolicyUseCasesMockRecorder) SearchTransactionPolicies() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTransactionPolicies", reflect.TypeOf((*MockTransactionPolicyUseCases)(nil).SearchTransactionPolicies))
+}
+
+// DeleteTransactionPolicy mocks base method
+func (m *MockTransactionPolicyUseCases) DeleteTransactionPolicy() usecases.DeleteTransactionPolicyUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransactionPolicy")
+	ret0, _ := ret[0].(usecases.DeleteTransactionPolicyUseCase)
+	return ret0
+}
+
+// DeleteTransactionPolicy indicates an expected call of DeleteTransactionPolicy
+func (mr *MockTransactionPolicyUseCasesMockRecorder) DeleteTransactionPolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransactionPolicy", reflect.TypeOf((*MockTransactionPolicyUseCases)(nil).DeleteTransactionPolicy))
+}
+
+// EnforceTransactionPolicies mocks base method
+func (m *MockTransactionPolicyUseCases) EnforceTransactionPolicies() usecases.EnforceTransactionPoliciesUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnforceTransactionPolicies")
+	ret0, _ := ret[0].(usecases.EnforceTransactionPoliciesUseCase)
+	return ret0
+}
+
+// EnforceTransactionPolicies indicates an expected call of EnforceTransactionPolicies
+func (mr *MockTransactionPolicyUseCasesMockRecorder) EnforceTransactionPolicies() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnforceTransactionPolicies", reflect.TypeOf((*MockTransactionPolicyUseCases)(nil).EnforceTransactionPolicies))
+}
+
+// MockCreateTransactionPolicyUseCase is a mock of CreateTransactionPolicyUseCase interface
+type MockCreateTransactionPolicyUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateTransactionPolicyUseCaseMockRecorder
+}
+
+// MockCreateTransactionPolicyUseCaseMockRecorder is the mock recorder for MockCreateTransactionPolicyUseCase
+type MockCreateTransactionPolicyUseCaseMockRecorder struct {
+	mock *MockCreateTransactionPolicyUseCase
+}
+
+// NewMockCreateTransactionPolicyUseCase creates a new mock instance
+func NewMockCreateTransactionPolicyUseCase(ctrl *gomock.Controller) *MockCreateTransactionPolicyUseCase {
+	mock := &MockCreateTransactionPolicyUseCase{ctrl: ctrl}
+	mock.recorder = &MockCreateTransactionPolicyUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCreateTransactionPolicyUseCase) EXPECT() *MockCreateTransactionPolicyUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockCreateTransactionPolicyUseCase) Execute(ctx context.Context, policy *entities.TransactionPolicy, userInfo *multitenancy.UserInfo) (*entities.TransactionPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, policy, userInfo)
+	ret0, _ := ret[0].(*entities.TransactionPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockCreateTransactionPolicyUseCaseMockRecorder) Execute(ctx, policy, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateTransactionPolicyUseCase)(nil).Execute), ctx, policy, userInfo)
+}
+
+// MockGetTransactionPolicyUseCase is a mock of GetTransactionPolicyUseCase interface
+type MockGetTransactionPolicyUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetTransactionPolicyUseCaseMockRecorder
+}
+
+// MockGetTransactionPolicyUseCaseMockRecorder is the mock recorder for MockGetTransactionPolicyUseCase
+type MockGetTransactionPolicyUseCaseMockRecorder struct {
+	mock *MockGetTransactionPolicyUseCase
+}
+
+// NewMockGetTransactionPolicyUseCase creates a new mock instance
+func NewMockGetTransactionPolicyUseCase(ctrl *gomock.Controller) *MockGetTransactionPolicyUseCase {
+	mock := &MockGetTransactionPolicyUseCase{ctrl: ctrl}
+	mock.recorder = &MockGetTransactionPolicyUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockGetTransactionPolicyUseCase) EXPECT() *MockGetTransactionPolicyUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockGetTransactionPolicyUseCase) Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) (*entities.TransactionPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, uuid, userInfo)
+	ret0, _ := ret[0].(*entities.TransactionPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockGetTransactionPolicyUseCaseMockRecorder) Execute(ctx, uuid, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetTransactionPolicyUseCase)(nil).Execute), ctx, uuid, userInfo)
+}
+
+// MockSearchTransactionPoliciesUseCase is a mock of SearchTransactionPoliciesUseCase interface
+type MockSearchTransactionPoliciesUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchTransactionPoliciesUseCaseMockRecorder
+}
+
+// MockSearchTransactionPoliciesUseCaseMockRecorder is the mock recorder for MockSearchTransactionPoliciesUseCase
+type MockSearchTransactionPoliciesUseCaseMockRecorder struct {
+	mock *MockSearchTransactionPoliciesUseCase
+}
+
+// NewMockSearchTransactionPoliciesUseCase creates a new mock instance
+func NewMockSearchTransactionPoliciesUseCase(ctrl *gomock.Controller) *MockSearchTransactionPoliciesUseCase {
+	mock := &MockSearchTransactionPoliciesUseCase{ctrl: ctrl}
+	mock.recorder = &MockSearchTransactionPoliciesUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSearchTransactionPoliciesUseCase) EXPECT() *MockSearchTransactionPoliciesUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockSearchTransactionPoliciesUseCase) Execute(ctx context.Context, filters *entities.TransactionPolicyFilters, userInfo *multitenancy.UserInfo) ([]*entities.TransactionPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, filters, userInfo)
+	ret0, _ := ret[0].([]*entities.TransactionPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockSearchTransactionPoliciesUseCaseMockRecorder) Execute(ctx, filters, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchTransactionPoliciesUseCase)(nil).Execute), ctx, filters, userInfo)
+}
+
+// MockDeleteTransactionPolicyUseCase is a mock of DeleteTransactionPolicyUseCase interface
+type MockDeleteTransactionPolicyUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteTransactionPolicyUseCaseMockRecorder
+}
+
+// MockDeleteTransactionPolicyUseCaseMockRecorder is the mock recorder for MockDeleteTransactionPolicyUseCase
+type MockDeleteTransactionPolicyUseCaseMockRecorder struct {
+	mock *MockDeleteTransactionPolicyUseCase
+}
+
+// NewMockDeleteTransactionPolicyUseCase creates a new mock instance
+func NewMockDeleteTransactionPolicyUseCase(ctrl *gomock.Controller) *MockDeleteTransactionPolicyUseCase {
+	mock := &MockDeleteTransactionPolicyUseCase{ctrl: ctrl}
+	mock.recorder = &MockDeleteTransactionPolicyUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDeleteTransactionPolicyUseCase) EXPECT() *MockDeleteTransactionPolicyUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockDeleteTransactionPolicyUseCase) Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, uuid, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockDeleteTransactionPolicyUseCaseMockRecorder) Execute(ctx, uuid, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDeleteTransactionPolicyUseCase)(nil).Execute), ctx, uuid, userInfo)
+}
+
+// MockEnforceTransactionPoliciesUseCase is a mock of EnforceTransactionPoliciesUseCase interface
+type MockEnforceTransactionPoliciesUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnforceTransactionPoliciesUseCaseMockRecorder
+}
+
+// MockEnforceTransactionPoliciesUseCaseMockRecorder is the mock recorder for MockEnforceTransactionPoliciesUseCase
+type MockEnforceTransactionPoliciesUseCaseMockRecorder struct {
+	mock *MockEnforceTransactionPoliciesUseCase
+}
+
+// NewMockEnforceTransactionPoliciesUseCase creates a new mock instance
+func NewMockEnforceTransactionPoliciesUseCase(ctrl *gomock.Controller) *MockEnforceTransactionPoliciesUseCase {
+	mock := &MockEnforceTransactionPoliciesUseCase{ctrl: ctrl}
+	mock.recorder = &MockEnforceTransactionPoliciesUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockEnforceTransactionPoliciesUseCase) EXPECT() *MockEnforceTransactionPoliciesUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockEnforceTransactionPoliciesUseCase) Execute(ctx context.Context, job *entities.Job, tenantID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, job, tenantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockEnforceTransactionPoliciesUseCaseMockRecorder) Execute(ctx, job, tenantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockEnforceTransactionPoliciesUseCase)(nil).Execute), ctx, job, tenantID)
+}
+
+// WithDBTransaction mocks base method
+func (m *MockEnforceTransactionPoliciesUseCase) WithDBTransaction(dbtx store.Tx) usecases.EnforceTransactionPoliciesUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithDBTransaction", dbtx)
+	ret0, _ := ret[0].(usecases.EnforceTransactionPoliciesUseCase)
+	return ret0
+}
+
+// WithDBTransaction indicates an expected call of WithDBTransaction
+func (mr *MockEnforceTransactionPoliciesUseCaseMockRecorder) WithDBTransaction(dbtx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithDBTransaction", reflect.TypeOf((*MockEnforceTransactionPoliciesUseCase)(nil).WithDBTransaction), dbtx)
+}
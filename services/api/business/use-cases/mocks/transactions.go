@@ -51,6 +51,20 @@ func (mr *MockTransactionUseCasesMockRecorder) SendContractTransaction() *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendContractTransaction", reflect.TypeOf((*MockTransactionUseCases)(nil).SendContractTransaction))
 }
 
+// SendContractTransactions mocks base method
+func (m *MockTransactionUseCases) SendContractTransactions() usecases.SendContractTxsUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendContractTransactions")
+	ret0, _ := ret[0].(usecases.SendContractTxsUseCase)
+	return ret0
+}
+
+// SendContractTransactions indicates an expected call of SendContractTransactions
+func (mr *MockTransactionUseCasesMockRecorder) SendContractTransactions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendContractTransactions", reflect.TypeOf((*MockTransactionUseCases)(nil).SendContractTransactions))
+}
+
 // SendDeployTransaction mocks base method
 func (m *MockTransactionUseCases) SendDeployTransaction() usecases.SendDeployTxUseCase {
 	m.ctrl.T.Helper()
@@ -135,6 +149,20 @@ func (mr *MockTransactionUseCasesMockRecorder) CallOff() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallOff", reflect.TypeOf((*MockTransactionUseCases)(nil).CallOff))
 }
 
+// SimulateTransaction mocks base method
+func (m *MockTransactionUseCases) SimulateTransaction() usecases.SimulateTxUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulateTransaction")
+	ret0, _ := ret[0].(usecases.SimulateTxUseCase)
+	return ret0
+}
+
+// SimulateTransaction indicates an expected call of SimulateTransaction
+func (mr *MockTransactionUseCasesMockRecorder) SimulateTransaction() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateTransaction", reflect.TypeOf((*MockTransactionUseCases)(nil).SimulateTransaction))
+}
+
 // MockGetTxUseCase is a mock of GetTxUseCase interface
 type MockGetTxUseCase struct {
 	ctrl     *gomock.Controller
@@ -287,6 +315,44 @@ func (mr *MockSendContractTxUseCaseMockRecorder) Execute(ctx, txRequest, userInf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSendContractTxUseCase)(nil).Execute), ctx, txRequest, userInfo)
 }
 
+// MockSendContractTxsUseCase is a mock of SendContractTxsUseCase interface
+type MockSendContractTxsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSendContractTxsUseCaseMockRecorder
+}
+
+// MockSendContractTxsUseCaseMockRecorder is the mock recorder for MockSendContractTxsUseCase
+type MockSendContractTxsUseCaseMockRecorder struct {
+	mock *MockSendContractTxsUseCase
+}
+
+// NewMockSendContractTxsUseCase creates a new mock instance
+func NewMockSendContractTxsUseCase(ctrl *gomock.Controller) *MockSendContractTxsUseCase {
+	mock := &MockSendContractTxsUseCase{ctrl: ctrl}
+	mock.recorder = &MockSendContractTxsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSendContractTxsUseCase) EXPECT() *MockSendContractTxsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockSendContractTxsUseCase) Execute(ctx context.Context, txRequests []*entities.TxRequest, userInfo *multitenancy.UserInfo) ([]*entities.TxRequestResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, txRequests, userInfo)
+	ret0, _ := ret[0].([]*entities.TxRequestResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockSendContractTxsUseCaseMockRecorder) Execute(ctx, txRequests, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSendContractTxsUseCase)(nil).Execute), ctx, txRequests, userInfo)
+}
+
 // MockSendTxUseCase is a mock of SendTxUseCase interface
 type MockSendTxUseCase struct {
 	ctrl     *gomock.Controller
@@ -400,3 +466,41 @@ func (mr *MockCallOffTxUseCaseMockRecorder) Execute(ctx, scheduleUUID, userInfo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCallOffTxUseCase)(nil).Execute), ctx, scheduleUUID, userInfo)
 }
+
+// MockSimulateTxUseCase is a mock of SimulateTxUseCase interface
+type MockSimulateTxUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSimulateTxUseCaseMockRecorder
+}
+
+// MockSimulateTxUseCaseMockRecorder is the mock recorder for MockSimulateTxUseCase
+type MockSimulateTxUseCaseMockRecorder struct {
+	mock *MockSimulateTxUseCase
+}
+
+// NewMockSimulateTxUseCase creates a new mock instance
+func NewMockSimulateTxUseCase(ctrl *gomock.Controller) *MockSimulateTxUseCase {
+	mock := &MockSimulateTxUseCase{ctrl: ctrl}
+	mock.recorder = &MockSimulateTxUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSimulateTxUseCase) EXPECT() *MockSimulateTxUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockSimulateTxUseCase) Execute(ctx context.Context, txRequest *entities.TxRequest, userInfo *multitenancy.UserInfo) (*entities.TransactionSimulation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, txRequest, userInfo)
+	ret0, _ := ret[0].(*entities.TransactionSimulation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockSimulateTxUseCaseMockRecorder) Execute(ctx, txRequest, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSimulateTxUseCase)(nil).Execute), ctx, txRequest, userInfo)
+}
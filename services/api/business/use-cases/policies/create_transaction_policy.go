@@ -0,0 +1,65 @@
+package policies
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+)
+
+const createTransactionPolicyComponent = "use-cases.create-transaction-policy"
+
+// createTransactionPolicyUseCase is a use case to create a transaction policy
+type createTransactionPolicyUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+// NewCreateTransactionPolicyUseCase creates a new CreateTransactionPolicyUseCase
+func NewCreateTransactionPolicyUseCase(db store.DB) usecases.CreateTransactionPolicyUseCase {
+	return &createTransactionPolicyUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(createTransactionPolicyComponent),
+	}
+}
+
+// Execute creates a new transaction policy
+func (uc *createTransactionPolicyUseCase) Execute(ctx context.Context, policy *entities.TransactionPolicy, userInfo *multitenancy.UserInfo) (*entities.TransactionPolicy, error) {
+	ctx = log.WithFields(ctx, log.Field("name", policy.Name), log.Field("type", policy.Type))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("creating new transaction policy")
+
+	if policy.Account != nil {
+		_, err := uc.db.Account().FindOneByAddress(ctx, policy.Account.Hex(), userInfo.AllowedTenants, userInfo.Username)
+		if errors.IsNotFoundError(err) {
+			return nil, errors.InvalidParameterError("cannot find linked account").ExtendComponent(createTransactionPolicyComponent)
+		} else if err != nil {
+			return nil, errors.FromError(err).ExtendComponent(createTransactionPolicyComponent)
+		}
+	}
+
+	if policy.ChainUUID != "" {
+		_, err := uc.db.Chain().FindOneByUUID(ctx, policy.ChainUUID, userInfo.AllowedTenants, userInfo.Username)
+		if errors.IsNotFoundError(err) {
+			return nil, errors.InvalidParameterError("cannot find linked chain").ExtendComponent(createTransactionPolicyComponent)
+		} else if err != nil {
+			return nil, errors.FromError(err).ExtendComponent(createTransactionPolicyComponent)
+		}
+	}
+
+	policyModel := parsers.NewTransactionPolicyModelFromEntity(policy)
+	policyModel.TenantID = userInfo.TenantID
+	policyModel.OwnerID = userInfo.Username
+	err := uc.db.TransactionPolicy().Insert(ctx, policyModel)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(createTransactionPolicyComponent)
+	}
+
+	logger.WithField("transaction_policy", policyModel.UUID).Info("transaction policy created successfully")
+	return parsers.NewTransactionPolicyFromModel(policyModel), nil
+}
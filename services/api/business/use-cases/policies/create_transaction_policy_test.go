@@ -0,0 +1,122 @@
+// +build unit
+
+package policies
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	testutils2 "github.com/consensys/orchestrate/services/api/store/models/testutils"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTransactionPolicy_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	chainAgent := mocks.NewMockChainAgent(ctrl)
+	accountAgent := mocks.NewMockAccountAgent(ctrl)
+	txPolicyAgent := mocks.NewMockTransactionPolicyAgent(ctrl)
+	mockDB.EXPECT().Chain().Return(chainAgent).AnyTimes()
+	mockDB.EXPECT().Account().Return(accountAgent).AnyTimes()
+	mockDB.EXPECT().TransactionPolicy().Return(txPolicyAgent).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewCreateTransactionPolicyUseCase(mockDB)
+
+	t.Run("should execute use case successfully", func(t *testing.T) {
+		policy := testutils.FakeTransactionPolicy()
+
+		txPolicyAgent.EXPECT().Insert(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, policyModel *models.TransactionPolicy) error {
+				assert.Equal(t, userInfo.TenantID, policyModel.TenantID)
+				assert.Equal(t, userInfo.Username, policyModel.OwnerID)
+				return nil
+			})
+
+		resp, err := usecase.Execute(ctx, policy, userInfo)
+		require.NoError(t, err)
+
+		assert.Equal(t, policy.Type, resp.Type)
+		assert.Equal(t, policy.MaxDailyValue, resp.MaxDailyValue)
+		assert.Equal(t, userInfo.TenantID, resp.TenantID)
+	})
+
+	t.Run("should execute use case successfully with a linked chain", func(t *testing.T) {
+		policy := testutils.FakeTransactionPolicy()
+		policy.ChainUUID = "chainUUID"
+
+		chainAgent.EXPECT().FindOneByUUID(gomock.Any(), policy.ChainUUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(testutils2.FakeChainModel(), nil)
+		txPolicyAgent.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+
+		resp, err := usecase.Execute(ctx, policy, userInfo)
+		require.NoError(t, err)
+
+		assert.Equal(t, policy.ChainUUID, resp.ChainUUID)
+	})
+
+	t.Run("should execute use case successfully with a linked account", func(t *testing.T) {
+		policy := testutils.FakeTransactionPolicy()
+		account := ethcommon.HexToAddress("0x6230592812dE2E256D1512504c3E8A3C49975f07")
+		policy.Account = &account
+
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), account.Hex(), userInfo.AllowedTenants, userInfo.Username).
+			Return(testutils2.FakeAccountModel(), nil)
+		txPolicyAgent.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+
+		resp, err := usecase.Execute(ctx, policy, userInfo)
+		require.NoError(t, err)
+
+		assert.Equal(t, policy.Account, resp.Account)
+	})
+
+	t.Run("should fail with InvalidParameterError if account is not found", func(t *testing.T) {
+		policy := testutils.FakeTransactionPolicy()
+		account := ethcommon.HexToAddress("0x6230592812dE2E256D1512504c3E8A3C49975f07")
+		policy.Account = &account
+
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), account.Hex(), userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, errors.NotFoundError("error"))
+
+		resp, err := usecase.Execute(ctx, policy, userInfo)
+
+		assert.Nil(t, resp)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if chain is not found", func(t *testing.T) {
+		policy := testutils.FakeTransactionPolicy()
+		policy.ChainUUID = "chainUUID"
+
+		chainAgent.EXPECT().FindOneByUUID(gomock.Any(), policy.ChainUUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, errors.NotFoundError("error"))
+
+		resp, err := usecase.Execute(ctx, policy, userInfo)
+
+		assert.Nil(t, resp)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with same error if insert transaction policy fails", func(t *testing.T) {
+		policy := testutils.FakeTransactionPolicy()
+		expectedErr := errors.PostgresConnectionError("error")
+
+		txPolicyAgent.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(expectedErr)
+
+		resp, err := usecase.Execute(ctx, policy, userInfo)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(createTransactionPolicyComponent), err)
+	})
+}
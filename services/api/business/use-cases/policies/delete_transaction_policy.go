@@ -0,0 +1,47 @@
+package policies
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+)
+
+const deleteTransactionPolicyComponent = "use-cases.delete-transaction-policy"
+
+// deleteTransactionPolicyUseCase is a use case to delete a transaction policy
+type deleteTransactionPolicyUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+// NewDeleteTransactionPolicyUseCase creates a new DeleteTransactionPolicyUseCase
+func NewDeleteTransactionPolicyUseCase(db store.DB) usecases.DeleteTransactionPolicyUseCase {
+	return &deleteTransactionPolicyUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(deleteTransactionPolicyComponent),
+	}
+}
+
+// Execute deletes a transaction policy and its spendings
+func (uc *deleteTransactionPolicyUseCase) Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) error {
+	ctx = log.WithFields(ctx, log.Field("transaction_policy", uuid))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("deleting transaction policy")
+
+	policyModel, err := uc.db.TransactionPolicy().FindOneByUUID(ctx, uuid, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(deleteTransactionPolicyComponent)
+	}
+
+	err = uc.db.TransactionPolicy().Delete(ctx, policyModel, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(deleteTransactionPolicyComponent)
+	}
+
+	logger.Info("transaction policy deleted successfully")
+	return nil
+}
@@ -0,0 +1,53 @@
+// +build unit
+
+package policies
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteTransactionPolicy_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	txPolicyAgent := mocks.NewMockTransactionPolicyAgent(ctrl)
+	mockDB.EXPECT().TransactionPolicy().Return(txPolicyAgent).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewDeleteTransactionPolicyUseCase(mockDB)
+
+	t.Run("should execute use case successfully", func(t *testing.T) {
+		policyModel := testutils.FakeTransactionPolicyModel()
+
+		txPolicyAgent.EXPECT().FindOneByUUID(gomock.Any(), policyModel.UUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(policyModel, nil)
+		txPolicyAgent.EXPECT().Delete(gomock.Any(), policyModel, userInfo.AllowedTenants, userInfo.Username).Return(nil)
+
+		err := usecase.Execute(ctx, policyModel.UUID, userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with same error if delete transaction policy fails", func(t *testing.T) {
+		policyModel := testutils.FakeTransactionPolicyModel()
+		expectedErr := errors.PostgresConnectionError("error")
+
+		txPolicyAgent.EXPECT().FindOneByUUID(gomock.Any(), policyModel.UUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(policyModel, nil)
+		txPolicyAgent.EXPECT().Delete(gomock.Any(), policyModel, userInfo.AllowedTenants, userInfo.Username).Return(expectedErr)
+
+		err := usecase.Execute(ctx, policyModel.UUID, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(deleteTransactionPolicyComponent), err)
+	})
+}
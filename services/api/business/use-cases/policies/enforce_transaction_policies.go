@@ -0,0 +1,119 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	ierror "github.com/consensys/orchestrate/pkg/types/error"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+	"github.com/consensys/orchestrate/services/api/store/models"
+)
+
+const enforceTxPoliciesComponent = "use-cases.enforce-transaction-policies"
+
+// enforceTransactionPoliciesUseCase is a use case to check a job against the transaction policies of its tenant
+type enforceTransactionPoliciesUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+// NewEnforceTransactionPoliciesUseCase creates a new EnforceTransactionPoliciesUseCase
+func NewEnforceTransactionPoliciesUseCase(db store.DB) usecases.EnforceTransactionPoliciesUseCase {
+	return &enforceTransactionPoliciesUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(enforceTxPoliciesComponent),
+	}
+}
+
+func (uc enforceTransactionPoliciesUseCase) WithDBTransaction(dbtx store.Tx) usecases.EnforceTransactionPoliciesUseCase {
+	uc.db = dbtx
+	return &uc
+}
+
+// Execute checks a job against the transaction policies of the tenant and adds its value to the spendings of the daily
+// value caps it matches. It must be executed in the DB transaction inserting the job, so that the spendings of a
+// rejected job are rolled back and concurrent jobs are serialized on the spendings they share
+func (uc *enforceTransactionPoliciesUseCase) Execute(ctx context.Context, job *entities.Job, tenantID string) error {
+	if !entities.IsPolicyEnforced(job) {
+		return nil
+	}
+
+	logger := uc.logger.WithContext(ctx)
+
+	filters := &entities.TransactionPolicyFilters{TenantID: tenantID}
+	policyModels, err := uc.db.TransactionPolicy().Search(ctx, filters, []string{tenantID}, multitenancy.WildcardOwner)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(enforceTxPoliciesComponent)
+	}
+
+	day := time.Now().UTC().Format("2006-01-02")
+	for _, policyModel := range policyModels {
+		policy := parsers.NewTransactionPolicyFromModel(policyModel)
+		if !policy.Matches(job) {
+			continue
+		}
+
+		reason := policy.Violation(job)
+		if policy.Type == entities.DailyValueCapPolicyType {
+			reason, err = uc.addSpending(ctx, policy, job, day)
+			if err != nil {
+				return errors.FromError(err).ExtendComponent(enforceTxPoliciesComponent)
+			}
+		}
+
+		if reason != "" {
+			logger.WithField("transaction_policy", policy.UUID).WithField("reason", reason).
+				Warn("job rejected by transaction policy")
+			return policyViolationError(policy, reason).ExtendComponent(enforceTxPoliciesComponent)
+		}
+	}
+
+	return nil
+}
+
+func (uc *enforceTransactionPoliciesUseCase) addSpending(ctx context.Context, policy *entities.TransactionPolicy, job *entities.Job, day string) (string, error) {
+	value := job.Transaction.Value
+	if value == nil || value.ToInt().Sign() == 0 {
+		return "", nil
+	}
+
+	spending := &models.TransactionPolicySpending{
+		PolicyUUID: policy.UUID,
+		Day:        day,
+		Amount:     value.ToInt().String(),
+	}
+	if err := uc.db.TransactionPolicy().AddSpending(ctx, spending); err != nil {
+		return "", err
+	}
+
+	total := utils.BigIntStringToHex(spending.Amount)
+	if total == nil {
+		return "", errors.DataCorruptedError("invalid spending amount %s", spending.Amount)
+	}
+
+	if total.ToInt().Cmp(policy.MaxDailyValue.ToInt()) > 0 {
+		return fmt.Sprintf("daily value cap of %s wei exceeded", policy.MaxDailyValue.ToInt().String()), nil
+	}
+
+	return "", nil
+}
+
+func policyViolationError(policy *entities.TransactionPolicy, reason string) *ierror.Error {
+	err := errors.PolicyViolationError("transaction policy '%s' violated: %s", policy.Name, reason)
+	err.Extra = map[string]string{
+		"policyUUID": policy.UUID,
+		"policyName": policy.Name,
+		"policyType": string(policy.Type),
+		"reason":     reason,
+	}
+
+	return err
+}
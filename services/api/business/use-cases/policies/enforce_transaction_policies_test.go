@@ -0,0 +1,219 @@
+// +build unit
+
+package policies
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	testutils3 "github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	"github.com/consensys/orchestrate/services/api/store/models/testutils"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnforceTransactionPolicies_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockDBTX := mocks.NewMockTx(ctrl)
+	txPolicyAgent := mocks.NewMockTransactionPolicyAgent(ctrl)
+	mockDBTX.EXPECT().TransactionPolicy().Return(txPolicyAgent).AnyTimes()
+
+	tenantID := "tenantOne"
+	filters := &entities.TransactionPolicyFilters{TenantID: tenantID}
+	usecase := NewEnforceTransactionPoliciesUseCase(mockDB).WithDBTransaction(mockDBTX)
+
+	t.Run("should execute use case successfully if the job complies with every policy", func(t *testing.T) {
+		job := testutils3.FakeJob()
+		deniedPolicy := testutils.FakeTransactionPolicyModel()
+		deniedPolicy.Type = string(entities.DeniedRecipientsPolicyType)
+		deniedPolicy.Addresses = []string{"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"}
+		capPolicy := testutils.FakeTransactionPolicyModel()
+		capPolicy.MaxDailyValue = "100000"
+
+		txPolicyAgent.EXPECT().Search(gomock.Any(), filters, []string{tenantID}, multitenancy.WildcardOwner).
+			Return([]*models.TransactionPolicy{deniedPolicy, capPolicy}, nil)
+		txPolicyAgent.EXPECT().AddSpending(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, spending *models.TransactionPolicySpending) error {
+				assert.Equal(t, capPolicy.UUID, spending.PolicyUUID)
+				assert.Equal(t, job.Transaction.Value.ToInt().String(), spending.Amount)
+				assert.NotEmpty(t, spending.Day)
+				spending.Amount = "60000"
+				return nil
+			})
+
+		err := usecase.Execute(ctx, job, tenantID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with PolicyViolationError if the daily value cap is exceeded", func(t *testing.T) {
+		job := testutils3.FakeJob()
+		capPolicy := testutils.FakeTransactionPolicyModel()
+		capPolicy.MaxDailyValue = "100000"
+
+		txPolicyAgent.EXPECT().Search(gomock.Any(), filters, []string{tenantID}, multitenancy.WildcardOwner).
+			Return([]*models.TransactionPolicy{capPolicy}, nil)
+		txPolicyAgent.EXPECT().AddSpending(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, spending *models.TransactionPolicySpending) error {
+				spending.Amount = "100001"
+				return nil
+			})
+
+		err := usecase.Execute(ctx, job, tenantID)
+
+		require.True(t, errors.IsPolicyViolationError(err))
+		assert.Equal(t, capPolicy.UUID, errors.FromError(err).GetExtra()["policyUUID"])
+		assert.Equal(t, capPolicy.Name, errors.FromError(err).GetExtra()["policyName"])
+		assert.Equal(t, capPolicy.Type, errors.FromError(err).GetExtra()["policyType"])
+	})
+
+	t.Run("should fail with PolicyViolationError if the recipient is not allowed", func(t *testing.T) {
+		job := testutils3.FakeJob()
+		policy := testutils.FakeTransactionPolicyModel()
+		policy.Type = string(entities.AllowedRecipientsPolicyType)
+		policy.Addresses = []string{"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"}
+
+		txPolicyAgent.EXPECT().Search(gomock.Any(), filters, []string{tenantID}, multitenancy.WildcardOwner).
+			Return([]*models.TransactionPolicy{policy}, nil)
+
+		err := usecase.Execute(ctx, job, tenantID)
+
+		require.True(t, errors.IsPolicyViolationError(err))
+		assert.Equal(t, policy.UUID, errors.FromError(err).GetExtra()["policyUUID"])
+	})
+
+	t.Run("should fail with PolicyViolationError if the recipient is denied", func(t *testing.T) {
+		job := testutils3.FakeJob()
+		policy := testutils.FakeTransactionPolicyModel()
+		policy.Type = string(entities.DeniedRecipientsPolicyType)
+		policy.Addresses = []string{job.Transaction.To.Hex()}
+
+		txPolicyAgent.EXPECT().Search(gomock.Any(), filters, []string{tenantID}, multitenancy.WildcardOwner).
+			Return([]*models.TransactionPolicy{policy}, nil)
+
+		err := usecase.Execute(ctx, job, tenantID)
+
+		assert.True(t, errors.IsPolicyViolationError(err))
+	})
+
+	t.Run("should fail with PolicyViolationError if the method is not allowed on the contract", func(t *testing.T) {
+		job := testutils3.FakeJob()
+		policy := testutils.FakeTransactionPolicyModel()
+		policy.Type = string(entities.AllowedMethodsPolicyType)
+		policy.Contract = job.Transaction.To.Hex()
+		policy.MethodSignatures = []string{"transfer(address,uint256)"}
+
+		txPolicyAgent.EXPECT().Search(gomock.Any(), filters, []string{tenantID}, multitenancy.WildcardOwner).
+			Return([]*models.TransactionPolicy{policy}, nil)
+
+		err := usecase.Execute(ctx, job, tenantID)
+
+		assert.True(t, errors.IsPolicyViolationError(err))
+	})
+
+	t.Run("should execute use case successfully if the method is allowed on the contract", func(t *testing.T) {
+		job := testutils3.FakeJob()
+		job.Transaction.Data = hexutil.MustDecode("0xa9059cbb")
+		policy := testutils.FakeTransactionPolicyModel()
+		policy.Type = string(entities.AllowedMethodsPolicyType)
+		policy.Contract = job.Transaction.To.Hex()
+		policy.MethodSignatures = []string{"transfer(address,uint256)"}
+
+		txPolicyAgent.EXPECT().Search(gomock.Any(), filters, []string{tenantID}, multitenancy.WildcardOwner).
+			Return([]*models.TransactionPolicy{policy}, nil)
+
+		err := usecase.Execute(ctx, job, tenantID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with PolicyViolationError if the gas price exceeds the ceiling", func(t *testing.T) {
+		job := testutils3.FakeJob()
+		job.Transaction.GasPrice = (*hexutil.Big)(big.NewInt(200))
+		policy := testutils.FakeTransactionPolicyModel()
+		policy.Type = string(entities.MaxGasPricePolicyType)
+		policy.MaxGasPrice = "100"
+
+		txPolicyAgent.EXPECT().Search(gomock.Any(), filters, []string{tenantID}, multitenancy.WildcardOwner).
+			Return([]*models.TransactionPolicy{policy}, nil)
+
+		err := usecase.Execute(ctx, job, tenantID)
+
+		assert.True(t, errors.IsPolicyViolationError(err))
+	})
+
+	t.Run("should ignore policies of other accounts and chains", func(t *testing.T) {
+		job := testutils3.FakeJob()
+		accountPolicy := testutils.FakeTransactionPolicyModel()
+		accountPolicy.Type = string(entities.DeniedRecipientsPolicyType)
+		accountPolicy.Account = ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18").Hex()
+		accountPolicy.Addresses = []string{job.Transaction.To.Hex()}
+		chainPolicy := testutils.FakeTransactionPolicyModel()
+		chainPolicy.Type = string(entities.DeniedRecipientsPolicyType)
+		chainPolicy.ChainUUID = "b6fe7a2a-1a4d-49ca-99d8-8a34aa495ef0"
+		chainPolicy.Addresses = []string{job.Transaction.To.Hex()}
+
+		txPolicyAgent.EXPECT().Search(gomock.Any(), filters, []string{tenantID}, multitenancy.WildcardOwner).
+			Return([]*models.TransactionPolicy{accountPolicy, chainPolicy}, nil)
+
+		err := usecase.Execute(ctx, job, tenantID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not enforce policies on children jobs", func(t *testing.T) {
+		job := testutils3.FakeJob()
+		job.InternalData.ParentJobUUID = "parentJobUUID"
+
+		err := usecase.Execute(ctx, job, tenantID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not enforce policies on faucet credits", func(t *testing.T) {
+		job := testutils3.FakeJob()
+		job.InternalData.Faucet = true
+
+		err := usecase.Execute(ctx, job, tenantID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with same error if search transaction policies fails", func(t *testing.T) {
+		job := testutils3.FakeJob()
+		expectedErr := errors.PostgresConnectionError("error")
+
+		txPolicyAgent.EXPECT().Search(gomock.Any(), filters, []string{tenantID}, multitenancy.WildcardOwner).
+			Return(nil, expectedErr)
+
+		err := usecase.Execute(ctx, job, tenantID)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(enforceTxPoliciesComponent), err)
+	})
+
+	t.Run("should fail with same error if add spending fails", func(t *testing.T) {
+		job := testutils3.FakeJob()
+		expectedErr := errors.PostgresConnectionError("error")
+
+		txPolicyAgent.EXPECT().Search(gomock.Any(), filters, []string{tenantID}, multitenancy.WildcardOwner).
+			Return([]*models.TransactionPolicy{testutils.FakeTransactionPolicyModel()}, nil)
+		txPolicyAgent.EXPECT().AddSpending(gomock.Any(), gomock.Any()).Return(expectedErr)
+
+		err := usecase.Execute(ctx, job, tenantID)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(enforceTxPoliciesComponent), err)
+	})
+}
@@ -0,0 +1,43 @@
+package policies
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+)
+
+const getTransactionPolicyComponent = "use-cases.get-transaction-policy"
+
+// getTransactionPolicyUseCase is a use case to get a transaction policy
+type getTransactionPolicyUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+// NewGetTransactionPolicyUseCase creates a new GetTransactionPolicyUseCase
+func NewGetTransactionPolicyUseCase(db store.DB) usecases.GetTransactionPolicyUseCase {
+	return &getTransactionPolicyUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(getTransactionPolicyComponent),
+	}
+}
+
+// Execute gets a transaction policy
+func (uc *getTransactionPolicyUseCase) Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) (*entities.TransactionPolicy, error) {
+	ctx = log.WithFields(ctx, log.Field("transaction_policy", uuid))
+	logger := uc.logger.WithContext(ctx)
+
+	policyModel, err := uc.db.TransactionPolicy().FindOneByUUID(ctx, uuid, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(getTransactionPolicyComponent)
+	}
+
+	logger.Debug("transaction policy found successfully")
+	return parsers.NewTransactionPolicyFromModel(policyModel), nil
+}
@@ -0,0 +1,45 @@
+package policies
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+)
+
+const searchTransactionPoliciesComponent = "use-cases.search-transaction-policies"
+
+// searchTransactionPoliciesUseCase is a use case to search transaction policies
+type searchTransactionPoliciesUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+// NewSearchTransactionPoliciesUseCase creates a new SearchTransactionPoliciesUseCase
+func NewSearchTransactionPoliciesUseCase(db store.DB) usecases.SearchTransactionPoliciesUseCase {
+	return &searchTransactionPoliciesUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(searchTransactionPoliciesComponent),
+	}
+}
+
+// Execute searches transaction policies
+func (uc *searchTransactionPoliciesUseCase) Execute(ctx context.Context, filters *entities.TransactionPolicyFilters, userInfo *multitenancy.UserInfo) ([]*entities.TransactionPolicy, error) {
+	policyModels, err := uc.db.TransactionPolicy().Search(ctx, filters, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(searchTransactionPoliciesComponent)
+	}
+
+	var policies []*entities.TransactionPolicy
+	for _, policyModel := range policyModels {
+		policies = append(policies, parsers.NewTransactionPolicyFromModel(policyModel))
+	}
+
+	uc.logger.WithContext(ctx).Debug("transaction policies found successfully")
+	return policies, nil
+}
@@ -0,0 +1,55 @@
+// +build unit
+
+package policies
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	"github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	"github.com/consensys/orchestrate/services/api/store/models/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchTransactionPolicies_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	txPolicyAgent := mocks.NewMockTransactionPolicyAgent(ctrl)
+	mockDB.EXPECT().TransactionPolicy().Return(txPolicyAgent).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewSearchTransactionPoliciesUseCase(mockDB)
+
+	t.Run("should execute use case successfully", func(t *testing.T) {
+		policyModel := testutils.FakeTransactionPolicyModel()
+		filters := &entities.TransactionPolicyFilters{ChainUUID: "chainUUID"}
+		txPolicyAgent.EXPECT().Search(gomock.Any(), filters, userInfo.AllowedTenants, userInfo.Username).
+			Return([]*models.TransactionPolicy{policyModel}, nil)
+
+		resp, err := usecase.Execute(ctx, filters, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*entities.TransactionPolicy{parsers.NewTransactionPolicyFromModel(policyModel)}, resp)
+	})
+
+	t.Run("should fail with same error if search transaction policies fails", func(t *testing.T) {
+		filters := &entities.TransactionPolicyFilters{}
+		expectedErr := errors.PostgresConnectionError("error")
+		txPolicyAgent.EXPECT().Search(gomock.Any(), filters, userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, expectedErr)
+
+		resp, err := usecase.Execute(ctx, filters, userInfo)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(searchTransactionPoliciesComponent), err)
+	})
+}
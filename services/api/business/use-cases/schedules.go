@@ -15,6 +15,7 @@ Schedule Use Cases
 */
 type ScheduleUseCases interface {
 	CreateSchedule() CreateScheduleUseCase
+	CreateScheduleGraph() CreateScheduleGraphUseCase
 	GetSchedule() GetScheduleUseCase
 	SearchSchedules() SearchSchedulesUseCase
 }
@@ -24,10 +25,14 @@ type CreateScheduleUseCase interface {
 	WithDBTransaction(dbtx store.Tx) CreateScheduleUseCase
 }
 
+type CreateScheduleGraphUseCase interface {
+	Execute(ctx context.Context, graph *entities.ScheduleGraph, userInfo *multitenancy.UserInfo) (*entities.Schedule, error)
+}
+
 type GetScheduleUseCase interface {
 	Execute(ctx context.Context, scheduleUUID string, userInfo *multitenancy.UserInfo) (*entities.Schedule, error)
 }
 
 type SearchSchedulesUseCase interface {
-	Execute(ctx context.Context, userInfo *multitenancy.UserInfo) ([]*entities.Schedule, error)
+	Execute(ctx context.Context, filters *entities.ScheduleFilters, userInfo *multitenancy.UserInfo) ([]*entities.Schedule, error)
 }
@@ -0,0 +1,312 @@
+package schedules
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/database"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/gofrs/uuid"
+)
+
+const createScheduleGraphComponent = "use-cases.create-schedule-graph"
+
+// createScheduleGraphUseCase is a use case to create a schedule of jobs linked by dependencies
+type createScheduleGraphUseCase struct {
+	db               store.DB
+	searchChainsUC   usecases.SearchChainsUseCase
+	getContractUC    usecases.GetContractUseCase
+	createScheduleUC usecases.CreateScheduleUseCase
+	createJobUC      usecases.CreateJobUseCase
+	startJobsUC      usecases.StartJobsUseCase
+	logger           *log.Logger
+}
+
+// NewCreateScheduleGraphUseCase creates a new CreateScheduleGraphUseCase
+func NewCreateScheduleGraphUseCase(
+	db store.DB,
+	searchChainsUC usecases.SearchChainsUseCase,
+	getContractUC usecases.GetContractUseCase,
+	createScheduleUC usecases.CreateScheduleUseCase,
+	createJobUC usecases.CreateJobUseCase,
+	startJobsUC usecases.StartJobsUseCase,
+) usecases.CreateScheduleGraphUseCase {
+	return &createScheduleGraphUseCase{
+		db:               db,
+		searchChainsUC:   searchChainsUC,
+		getContractUC:    getContractUC,
+		createScheduleUC: createScheduleUC,
+		createJobUC:      createJobUC,
+		startJobsUC:      startJobsUC,
+		logger:           log.NewLogger().SetComponent(createScheduleGraphComponent),
+	}
+}
+
+// Execute validates the graph of jobs, creates the schedule and all its jobs in a single DB transaction and starts the
+// jobs without dependencies. Other jobs are started once all the jobs they depend on are mined
+func (uc *createScheduleGraphUseCase) Execute(ctx context.Context, graph *entities.ScheduleGraph, userInfo *multitenancy.UserInfo) (*entities.Schedule, error) {
+	ctx = log.WithFields(ctx, log.Field("chain_name", graph.ChainName), log.Field("jobs", len(graph.Jobs)))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("creating new schedule graph")
+
+	sortedJobs, err := sortGraphJobs(graph.Jobs)
+	if err != nil {
+		logger.WithError(err).Error("invalid graph of jobs")
+		return nil, errors.FromError(err).ExtendComponent(createScheduleGraphComponent)
+	}
+
+	chains, err := uc.searchChainsUC.Execute(ctx, &entities.ChainFilters{Names: []string{graph.ChainName}}, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(createScheduleGraphComponent)
+	}
+	if len(chains) == 0 {
+		return nil, errors.InvalidParameterError("chain '%s' does not exist", graph.ChainName).
+			ExtendComponent(createScheduleGraphComponent)
+	}
+
+	// Job UUIDs are generated beforehand so that jobs can reference the jobs they depend on
+	jobUUIDs := make(map[string]string, len(sortedJobs))
+	for _, graphJob := range sortedJobs {
+		jobUUIDs[graphJob.ID] = uuid.Must(uuid.NewV4()).String()
+	}
+
+	contracts := make(map[string]*entities.Contract)
+	jobs := make([]*entities.Job, len(sortedJobs))
+	for idx, graphJob := range sortedJobs {
+		jobs[idx], err = uc.newJob(ctx, graphJob, jobUUIDs, chains[0].UUID, graph.Labels, contracts, userInfo)
+		if err != nil {
+			logger.WithError(err).WithField("graph_job", graphJob.ID).Error("failed to prepare job")
+			return nil, errors.FromError(err).SetMessage("job %s: %s", graphJob.ID, errors.FromError(err).GetMessage()).
+				ExtendComponent(createScheduleGraphComponent)
+		}
+	}
+
+	schedule, err := uc.insertJobs(ctx, jobs, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(createScheduleGraphComponent)
+	}
+
+	var rootJobUUIDs []string
+	for _, job := range schedule.Jobs {
+		if len(job.InternalData.DependsOn) == 0 {
+			rootJobUUIDs = append(rootJobUUIDs, job.UUID)
+		}
+	}
+
+	err = uc.startJobsUC.Execute(ctx, rootJobUUIDs, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(createScheduleGraphComponent)
+	}
+
+	// Started jobs are reloaded as they may be awaiting approval or scheduled instead
+	for idx, job := range schedule.Jobs {
+		if !utils.ContainsString(rootJobUUIDs, job.UUID) {
+			continue
+		}
+
+		jobModel, der := uc.db.Job().FindOneByUUID(ctx, job.UUID, userInfo.AllowedTenants, userInfo.Username, false)
+		if der != nil {
+			return nil, errors.FromError(der).ExtendComponent(createScheduleGraphComponent)
+		}
+
+		schedule.Jobs[idx] = parsers.NewJobEntityFromModels(jobModel)
+	}
+
+	logger.WithField("schedule", schedule.UUID).Info("schedule graph created successfully")
+	return schedule, nil
+}
+
+func (uc *createScheduleGraphUseCase) newJob(
+	ctx context.Context,
+	graphJob *entities.GraphJob,
+	jobUUIDs map[string]string,
+	chainUUID string,
+	labels map[string]string,
+	contracts map[string]*entities.Contract,
+	userInfo *multitenancy.UserInfo,
+) (*entities.Job, error) {
+	params := graphJob.Params
+	contractKey := params.ContractName + ":" + params.ContractTag
+	contract, ok := contracts[contractKey]
+	if !ok {
+		var err error
+		contract, err = uc.getContractUC.Execute(ctx, params.ContractName, params.ContractTag, userInfo)
+		if errors.IsNotFoundError(err) {
+			return nil, errors.InvalidParameterError("contract not found")
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		contracts[contractKey] = contract
+	}
+
+	// References are stored with the UUIDs of the jobs as IDs are only known by the graph
+	hasReferences := false
+	replaceJobID := func(jobID, field string) (string, error) {
+		if !utils.ContainsString(graphJob.DependsOn, jobID) {
+			return "", errors.InvalidParameterError("job '%s' is referenced but is not a dependency", jobID)
+		}
+		if !isReceiptField(field) {
+			return "", errors.InvalidParameterError("receipt field '%s' cannot be referenced", field)
+		}
+
+		hasReferences = true
+		return parsers.NewJobReference(jobUUIDs[jobID], field), nil
+	}
+
+	to, err := parsers.ReplaceJobReferences(graphJob.To, replaceJobID)
+	if err != nil {
+		return nil, err
+	}
+	args, err := parsers.ReplaceJobReferences(params.Args, replaceJobID)
+	if err != nil {
+		return nil, err
+	}
+
+	internalData := *graphJob.InternalData
+	internalData.GraphJobID = graphJob.ID
+	for _, parentID := range graphJob.DependsOn {
+		internalData.DependsOn = append(internalData.DependsOn, jobUUIDs[parentID])
+	}
+
+	tx := &entities.ETHTransaction{
+		From:            params.From,
+		Value:           params.Value,
+		Gas:             params.Gas,
+		GasPrice:        params.GasPrice,
+		GasFeeCap:       params.GasFeeCap,
+		GasTipCap:       params.GasTipCap,
+		AccessList:      params.AccessList,
+		TransactionType: entities.TransactionType(params.TransactionType),
+		ContractName:    params.ContractName,
+		ContractTag:     params.ContractTag,
+	}
+
+	switch {
+	case hasReferences:
+		// Transaction is crafted once the referenced receipts are available
+		internalData.TxTemplate = &entities.TxTemplate{
+			To:              to.(string),
+			MethodSignature: params.MethodSignature,
+			Args:            args.([]interface{}),
+			Libraries:       params.Libraries,
+		}
+	case graphJob.To == "":
+		tx.Data, err = parsers.EncodeContractDeployment(contract, params.Args, params.Libraries)
+	default:
+		if !ethcommon.IsHexAddress(graphJob.To) {
+			return nil, errors.InvalidParameterError("field 'to' must be an address or a reference to a receipt field")
+		}
+
+		tx.To = utils.ToPtr(ethcommon.HexToAddress(graphJob.To)).(*ethcommon.Address)
+		tx.Data, err = parsers.EncodeContractCall(contract, params.MethodSignature, params.Args)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.Job{
+		UUID:         jobUUIDs[graphJob.ID],
+		ChainUUID:    chainUUID,
+		Type:         entities.EthereumTransaction,
+		Labels:       labels,
+		InternalData: &internalData,
+		Transaction:  tx,
+	}, nil
+}
+
+func (uc *createScheduleGraphUseCase) insertJobs(ctx context.Context, jobs []*entities.Job, userInfo *multitenancy.UserInfo) (*entities.Schedule, error) {
+	var schedule *entities.Schedule
+	err := database.ExecuteInDBTx(uc.db, func(dbtx database.Tx) error {
+		var der error
+		schedule, der = uc.createScheduleUC.WithDBTransaction(dbtx.(store.Tx)).Execute(ctx, &entities.Schedule{}, userInfo)
+		if der != nil {
+			return der
+		}
+
+		for _, job := range jobs {
+			job.ScheduleUUID = schedule.UUID
+			createdJob, der := uc.createJobUC.WithDBTransaction(dbtx.(store.Tx)).Execute(ctx, job, userInfo)
+			if der != nil {
+				return der
+			}
+
+			schedule.Jobs = append(schedule.Jobs, createdJob)
+		}
+
+		return nil
+	})
+
+	return schedule, err
+}
+
+// sortGraphJobs validates the graph of jobs and sorts it so that every job comes after the jobs it depends on
+func sortGraphJobs(graphJobs []*entities.GraphJob) ([]*entities.GraphJob, error) {
+	jobsByID := make(map[string]*entities.GraphJob, len(graphJobs))
+	for _, graphJob := range graphJobs {
+		if _, ok := jobsByID[graphJob.ID]; ok {
+			return nil, errors.InvalidParameterError("job id '%s' is used more than once", graphJob.ID)
+		}
+		jobsByID[graphJob.ID] = graphJob
+	}
+
+	inDegrees := make(map[string]int, len(graphJobs))
+	dependents := make(map[string][]string, len(graphJobs))
+	for _, graphJob := range graphJobs {
+		for _, parentID := range graphJob.DependsOn {
+			if parentID == graphJob.ID {
+				return nil, errors.InvalidParameterError("job %s cannot depend on itself", graphJob.ID)
+			}
+			if _, ok := jobsByID[parentID]; !ok {
+				return nil, errors.InvalidParameterError("job %s depends on unknown job '%s'", graphJob.ID, parentID)
+			}
+
+			inDegrees[graphJob.ID]++
+			dependents[parentID] = append(dependents[parentID], graphJob.ID)
+		}
+	}
+
+	var queue []string
+	for _, graphJob := range graphJobs {
+		if inDegrees[graphJob.ID] == 0 {
+			queue = append(queue, graphJob.ID)
+		}
+	}
+
+	sortedJobs := make([]*entities.GraphJob, 0, len(graphJobs))
+	for len(queue) > 0 {
+		jobID := queue[0]
+		queue = queue[1:]
+		sortedJobs = append(sortedJobs, jobsByID[jobID])
+
+		for _, dependentID := range dependents[jobID] {
+			inDegrees[dependentID]--
+			if inDegrees[dependentID] == 0 {
+				queue = append(queue, dependentID)
+			}
+		}
+	}
+
+	if len(sortedJobs) != len(graphJobs) {
+		return nil, errors.InvalidParameterError("job dependencies contain a cycle")
+	}
+
+	return sortedJobs, nil
+}
+
+func isReceiptField(field string) bool {
+	switch field {
+	case entities.ReceiptContractAddress, entities.ReceiptTxHash, entities.ReceiptBlockHash, entities.ReceiptBlockNumber:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,236 @@
+// +build unit
+
+package schedules
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	"github.com/consensys/orchestrate/services/api/business/use-cases/mocks"
+	mocks2 "github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateScheduleGraph_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks2.NewMockDB(ctrl)
+	mockDBTX := mocks2.NewMockTx(ctrl)
+	mockSearchChainsUC := mocks.NewMockSearchChainsUseCase(ctrl)
+	mockGetContractUC := mocks.NewMockGetContractUseCase(ctrl)
+	mockCreateScheduleUC := mocks.NewMockCreateScheduleUseCase(ctrl)
+	mockCreateJobUC := mocks.NewMockCreateJobUseCase(ctrl)
+	mockStartJobsUC := mocks.NewMockStartJobsUseCase(ctrl)
+	mockJobDA := mocks2.NewMockJobAgent(ctrl)
+
+	mockDB.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
+	mockDBTX.EXPECT().Commit().Return(nil).AnyTimes()
+	mockDBTX.EXPECT().Rollback().Return(nil).AnyTimes()
+	mockDBTX.EXPECT().Close().Return(nil).AnyTimes()
+	mockCreateScheduleUC.EXPECT().WithDBTransaction(mockDBTX).Return(mockCreateScheduleUC).AnyTimes()
+	mockCreateJobUC.EXPECT().WithDBTransaction(mockDBTX).Return(mockCreateJobUC).AnyTimes()
+
+	ctx := context.Background()
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	chain := testutils.FakeChain()
+	contract := testutils.FakeContract()
+	recipient := "0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"
+
+	usecase := NewCreateScheduleGraphUseCase(mockDB, mockSearchChainsUC, mockGetContractUC, mockCreateScheduleUC,
+		mockCreateJobUC, mockStartJobsUC)
+
+	newGraphJob := func(id, to string, dependsOn ...string) *entities.GraphJob {
+		params := &entities.ETHTransactionParams{
+			From:         &testutils.FromAddress,
+			ContractName: contract.Name,
+			ContractTag:  contract.Tag,
+		}
+		if to != "" {
+			params.MethodSignature = "transfer(address,uint256)"
+			params.Args = []interface{}{recipient, "10"}
+		}
+
+		return &entities.GraphJob{
+			ID:           id,
+			DependsOn:    dependsOn,
+			To:           to,
+			Params:       params,
+			InternalData: &entities.InternalData{Priority: "medium"},
+		}
+	}
+
+	t.Run("should execute use case successfully", func(t *testing.T) {
+		transfer := newGraphJob("transfer", "${deploy.receipt.contractAddress}", "deploy")
+		transfer.Params.Args = []interface{}{"${deploy.receipt.contractAddress}", "10"}
+		graph := &entities.ScheduleGraph{
+			ChainName: chain.Name,
+			Jobs:      []*entities.GraphJob{transfer, newGraphJob("deploy", ""), newGraphJob("call", recipient)},
+		}
+		schedule := testutils.FakeSchedule()
+		schedule.Jobs = nil
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{chain.Name}}, userInfo).
+			Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), contract.Name, contract.Tag, userInfo).Return(contract, nil)
+		mockCreateScheduleUC.EXPECT().Execute(gomock.Any(), &entities.Schedule{}, userInfo).Return(schedule, nil)
+
+		var createdJobs []*entities.Job
+		mockCreateJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).
+			DoAndReturn(func(_ context.Context, job *entities.Job, _ *multitenancy.UserInfo) (*entities.Job, error) {
+				assert.Equal(t, schedule.UUID, job.ScheduleUUID)
+				assert.Equal(t, chain.UUID, job.ChainUUID)
+				assert.Equal(t, entities.EthereumTransaction, job.Type)
+				createdJobs = append(createdJobs, job)
+				return job, nil
+			}).Times(3)
+		mockStartJobsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).
+			DoAndReturn(func(_ context.Context, jobUUIDs []string, _ *multitenancy.UserInfo) error {
+				assert.Equal(t, []string{createdJobs[0].UUID, createdJobs[1].UUID}, jobUUIDs)
+				return nil
+			})
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username, false).
+			DoAndReturn(func(_ context.Context, jobUUID string, _ []string, _ string, _ bool) (*models.Job, error) {
+				// The call job matches an approval policy
+				jobModel := parsers.NewJobModelFromEntities(createdJobs[0], nil)
+				jobModel.Status = entities.StatusStarted
+				if jobUUID == createdJobs[1].UUID {
+					jobModel = parsers.NewJobModelFromEntities(createdJobs[1], nil)
+					jobModel.Status = entities.StatusAwaitingApproval
+				}
+				return jobModel, nil
+			}).Times(2)
+
+		result, err := usecase.Execute(ctx, graph, userInfo)
+		require.NoError(t, err)
+
+		// Jobs are created after the jobs they depend on
+		deployJob, callJob, transferJob := result.Jobs[0], result.Jobs[1], result.Jobs[2]
+		assert.Equal(t, "deploy", deployJob.InternalData.GraphJobID)
+		assert.Equal(t, []byte(contract.Bytecode), []byte(deployJob.Transaction.Data))
+		assert.Equal(t, entities.StatusStarted, deployJob.Status)
+		assert.Equal(t, recipient, callJob.Transaction.To.String())
+		assert.NotEmpty(t, callJob.Transaction.Data)
+		assert.Equal(t, entities.StatusAwaitingApproval, callJob.Status)
+
+		reference := parsers.NewJobReference(deployJob.UUID, entities.ReceiptContractAddress)
+		assert.Equal(t, []string{deployJob.UUID}, transferJob.InternalData.DependsOn)
+		assert.Equal(t, &entities.TxTemplate{
+			To:              reference,
+			MethodSignature: "transfer(address,uint256)",
+			Args:            []interface{}{reference, "10"},
+		}, transferJob.InternalData.TxTemplate)
+		assert.Nil(t, transferJob.Transaction.To)
+		assert.Empty(t, transferJob.Transaction.Data)
+		assert.NotEqual(t, entities.StatusStarted, transferJob.Status)
+	})
+
+	t.Run("should fail with InvalidParameterError if job ids are duplicated", func(t *testing.T) {
+		graph := &entities.ScheduleGraph{
+			ChainName: chain.Name,
+			Jobs:      []*entities.GraphJob{newGraphJob("deploy", ""), newGraphJob("deploy", "")},
+		}
+
+		_, err := usecase.Execute(ctx, graph, userInfo)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if a dependency is unknown", func(t *testing.T) {
+		graph := &entities.ScheduleGraph{
+			ChainName: chain.Name,
+			Jobs:      []*entities.GraphJob{newGraphJob("call", recipient, "deploy")},
+		}
+
+		_, err := usecase.Execute(ctx, graph, userInfo)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if dependencies contain a cycle", func(t *testing.T) {
+		graph := &entities.ScheduleGraph{
+			ChainName: chain.Name,
+			Jobs: []*entities.GraphJob{
+				newGraphJob("first", recipient, "third"),
+				newGraphJob("second", recipient, "first"),
+				newGraphJob("third", recipient, "second"),
+			},
+		}
+
+		_, err := usecase.Execute(ctx, graph, userInfo)
+		assert.True(t, errors.IsInvalidParameterError(err))
+		assert.Contains(t, err.Error(), "cycle")
+	})
+
+	t.Run("should fail with InvalidParameterError if a referenced job is not a dependency", func(t *testing.T) {
+		graph := &entities.ScheduleGraph{
+			ChainName: chain.Name,
+			Jobs: []*entities.GraphJob{
+				newGraphJob("deploy", ""),
+				newGraphJob("call", "${deploy.receipt.contractAddress}"),
+			},
+		}
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), contract.Name, contract.Tag, userInfo).Return(contract, nil)
+
+		_, err := usecase.Execute(ctx, graph, userInfo)
+		assert.True(t, errors.IsInvalidParameterError(err))
+		assert.Contains(t, err.Error(), "job call")
+	})
+
+	t.Run("should fail with InvalidParameterError if a referenced receipt field is not supported", func(t *testing.T) {
+		graph := &entities.ScheduleGraph{
+			ChainName: chain.Name,
+			Jobs: []*entities.GraphJob{
+				newGraphJob("deploy", ""),
+				newGraphJob("call", "${deploy.receipt.logs}", "deploy"),
+			},
+		}
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), contract.Name, contract.Tag, userInfo).Return(contract, nil)
+
+		_, err := usecase.Execute(ctx, graph, userInfo)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if chain does not exist", func(t *testing.T) {
+		graph := &entities.ScheduleGraph{ChainName: chain.Name, Jobs: []*entities.GraphJob{newGraphJob("deploy", "")}}
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{}, nil)
+
+		_, err := usecase.Execute(ctx, graph, userInfo)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if contract is not found", func(t *testing.T) {
+		graph := &entities.ScheduleGraph{ChainName: chain.Name, Jobs: []*entities.GraphJob{newGraphJob("deploy", "")}}
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), contract.Name, contract.Tag, userInfo).Return(nil, errors.NotFoundError("error"))
+
+		_, err := usecase.Execute(ctx, graph, userInfo)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with same error if job creation fails", func(t *testing.T) {
+		graph := &entities.ScheduleGraph{ChainName: chain.Name, Jobs: []*entities.GraphJob{newGraphJob("deploy", "")}}
+		expectedErr := errors.PostgresConnectionError("error")
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), contract.Name, contract.Tag, userInfo).Return(contract, nil)
+		mockCreateScheduleUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return(testutils.FakeSchedule(), nil)
+		mockCreateJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return(nil, expectedErr)
+
+		_, err := usecase.Execute(ctx, graph, userInfo)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(createScheduleGraphComponent), err)
+	})
+}
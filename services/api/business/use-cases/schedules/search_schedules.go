@@ -30,8 +30,8 @@ func NewSearchSchedulesUseCase(db store.DB) usecases.SearchSchedulesUseCase {
 }
 
 // Execute search schedules
-func (uc *searchSchedulesUseCase) Execute(ctx context.Context, userInfo *multitenancy.UserInfo) ([]*entities.Schedule, error) {
-	scheduleModels, err := uc.db.Schedule().FindAll(ctx, userInfo.AllowedTenants, userInfo.Username)
+func (uc *searchSchedulesUseCase) Execute(ctx context.Context, filters *entities.ScheduleFilters, userInfo *multitenancy.UserInfo) ([]*entities.Schedule, error) {
+	scheduleModels, err := uc.db.Schedule().Search(ctx, filters, userInfo.AllowedTenants, userInfo.Username)
 	if err != nil {
 		return nil, err
 	}
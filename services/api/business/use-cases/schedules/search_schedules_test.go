@@ -28,6 +28,7 @@ func TestSearchSchedules_Execute(t *testing.T) {
 	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
 	usecase := NewSearchSchedulesUseCase(mockDB)
 	ctx := context.Background()
+	filters := &entities.ScheduleFilters{Pagination: entities.CursorPagination{Limit: 10}}
 
 	t.Run("should execute use case successfully", func(t *testing.T) {
 		scheduleEntity := testutils.FakeSchedule()
@@ -38,29 +39,29 @@ func TestSearchSchedules_Execute(t *testing.T) {
 		mockDB.EXPECT().Job().Return(mockJobDA).Times(1)
 
 		mockScheduleDA.EXPECT().
-			FindAll(gomock.Any(), userInfo.AllowedTenants, userInfo.Username).
+			Search(gomock.Any(), filters, userInfo.AllowedTenants, userInfo.Username).
 			Return([]*models.Schedule{scheduleModel}, nil)
 
 		mockJobDA.EXPECT().
 			FindOneByUUID(gomock.Any(), scheduleModel.Jobs[0].UUID, userInfo.AllowedTenants, userInfo.Username, false).
 			Return(scheduleModel.Jobs[0], nil)
 
-		schedulesResponse, err := usecase.Execute(ctx, userInfo)
+		schedulesResponse, err := usecase.Execute(ctx, filters, userInfo)
 
 		assert.NoError(t, err)
 		assert.Equal(t, expectedResponse, schedulesResponse)
 	})
 
-	t.Run("should fail with same error if FindAll fails for schedules", func(t *testing.T) {
+	t.Run("should fail with same error if Search fails for schedules", func(t *testing.T) {
 		expectedErr := errors.NotFoundError("error")
 
 		mockDB.EXPECT().Schedule().Return(mockScheduleDA).Times(1)
 
 		mockScheduleDA.EXPECT().
-			FindAll(gomock.Any(), userInfo.AllowedTenants, userInfo.Username).
+			Search(gomock.Any(), filters, userInfo.AllowedTenants, userInfo.Username).
 			Return(nil, expectedErr)
 
-		scheduleResponse, err := usecase.Execute(ctx, userInfo)
+		scheduleResponse, err := usecase.Execute(ctx, filters, userInfo)
 
 		assert.Nil(t, scheduleResponse)
 		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(createScheduleComponent), err)
@@ -75,14 +76,14 @@ func TestSearchSchedules_Execute(t *testing.T) {
 		mockDB.EXPECT().Job().Return(mockJobDA).Times(1)
 
 		mockScheduleDA.EXPECT().
-			FindAll(gomock.Any(), userInfo.AllowedTenants, userInfo.Username).
+			Search(gomock.Any(), filters, userInfo.AllowedTenants, userInfo.Username).
 			Return([]*models.Schedule{scheduleModel}, nil)
 
 		mockJobDA.EXPECT().
 			FindOneByUUID(gomock.Any(), scheduleModel.Jobs[0].UUID, userInfo.AllowedTenants, userInfo.Username, false).
 			Return(scheduleModel.Jobs[0], expectedErr)
 
-		scheduleResponse, err := usecase.Execute(ctx, userInfo)
+		scheduleResponse, err := usecase.Execute(ctx, filters, userInfo)
 
 		assert.Nil(t, scheduleResponse)
 		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(createScheduleComponent), err)
@@ -0,0 +1,38 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+)
+
+//go:generate mockgen -source=subscriptions.go -destination=mocks/subscriptions.go -package=mocks
+
+type SubscriptionUseCases interface {
+	CreateSubscription() CreateSubscriptionUseCase
+	GetSubscription() GetSubscriptionUseCase
+	SearchSubscriptions() SearchSubscriptionsUseCase
+	UpdateSubscription() UpdateSubscriptionUseCase
+	DeleteSubscription() DeleteSubscriptionUseCase
+}
+
+type CreateSubscriptionUseCase interface {
+	Execute(ctx context.Context, subscription *entities.Subscription, userInfo *multitenancy.UserInfo) (*entities.Subscription, error)
+}
+
+type GetSubscriptionUseCase interface {
+	Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) (*entities.Subscription, error)
+}
+
+type SearchSubscriptionsUseCase interface {
+	Execute(ctx context.Context, filters *entities.SubscriptionFilters, userInfo *multitenancy.UserInfo) ([]*entities.Subscription, error)
+}
+
+type UpdateSubscriptionUseCase interface {
+	Execute(ctx context.Context, subscription *entities.Subscription, userInfo *multitenancy.UserInfo) (*entities.Subscription, error)
+}
+
+type DeleteSubscriptionUseCase interface {
+	Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) error
+}
@@ -0,0 +1,63 @@
+package subscriptions
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+)
+
+const createSubscriptionComponent = "use-cases.create-subscription"
+
+// createSubscriptionUseCase is a use case to create a subscription to the events of a contract
+type createSubscriptionUseCase struct {
+	db           store.DB
+	tenantTopics map[string]string
+	logger       *log.Logger
+}
+
+// NewCreateSubscriptionUseCase creates a new CreateSubscriptionUseCase
+func NewCreateSubscriptionUseCase(db store.DB, tenantTopics map[string]string) usecases.CreateSubscriptionUseCase {
+	return &createSubscriptionUseCase{
+		db:           db,
+		tenantTopics: tenantTopics,
+		logger:       log.NewLogger().SetComponent(createSubscriptionComponent),
+	}
+}
+
+// Execute creates a new subscription, events can only be produced to the Kafka topics dedicated to the tenant
+func (uc *createSubscriptionUseCase) Execute(ctx context.Context, subscription *entities.Subscription, userInfo *multitenancy.UserInfo) (*entities.Subscription, error) {
+	ctx = log.WithFields(ctx, log.Field("chain", subscription.ChainUUID), log.Field("contract_address", subscription.ContractAddress.Hex()),
+		log.Field("event", subscription.EventSignature))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("creating new subscription")
+
+	err := validateTarget(subscription.Target, userInfo.TenantID, uc.tenantTopics)
+	if err != nil {
+		logger.WithError(err).Error("invalid subscription target")
+		return nil, errors.FromError(err).ExtendComponent(createSubscriptionComponent)
+	}
+
+	_, err = uc.db.Chain().FindOneByUUID(ctx, subscription.ChainUUID, userInfo.AllowedTenants, userInfo.Username)
+	if errors.IsNotFoundError(err) {
+		return nil, errors.InvalidParameterError("cannot find linked chain").ExtendComponent(createSubscriptionComponent)
+	} else if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(createSubscriptionComponent)
+	}
+
+	subscriptionModel := parsers.NewSubscriptionModelFromEntity(subscription)
+	subscriptionModel.TenantID = userInfo.TenantID
+	subscriptionModel.OwnerID = userInfo.Username
+	err = uc.db.Subscription().Insert(ctx, subscriptionModel)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(createSubscriptionComponent)
+	}
+
+	logger.WithField("subscription", subscriptionModel.UUID).Info("subscription created successfully")
+	return parsers.NewSubscriptionFromModel(subscriptionModel), nil
+}
@@ -0,0 +1,115 @@
+// +build unit
+
+package subscriptions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	testutils2 "github.com/consensys/orchestrate/services/api/store/models/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSubscription_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	chainAgent := mocks.NewMockChainAgent(ctrl)
+	subscriptionAgent := mocks.NewMockSubscriptionAgent(ctrl)
+	mockDB.EXPECT().Chain().Return(chainAgent).AnyTimes()
+	mockDB.EXPECT().Subscription().Return(subscriptionAgent).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewCreateSubscriptionUseCase(mockDB, map[string]string{"topic-events": "tenantOne", "topic-other": "tenantTwo"})
+
+	t.Run("should execute use case successfully", func(t *testing.T) {
+		subscription := testutils.FakeSubscription()
+
+		chainAgent.EXPECT().FindOneByUUID(gomock.Any(), subscription.ChainUUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(testutils2.FakeChainModel(), nil)
+		subscriptionAgent.EXPECT().Insert(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, subscriptionModel *models.Subscription) error {
+				assert.Equal(t, userInfo.TenantID, subscriptionModel.TenantID)
+				assert.Equal(t, userInfo.Username, subscriptionModel.OwnerID)
+				return nil
+			})
+
+		resp, err := usecase.Execute(ctx, subscription, userInfo)
+		require.NoError(t, err)
+
+		assert.Equal(t, subscription.EventSignature, resp.EventSignature)
+		assert.Equal(t, subscription.Target, resp.Target)
+		assert.Equal(t, userInfo.TenantID, resp.TenantID)
+	})
+
+	t.Run("should execute use case successfully with a topic of the tenant", func(t *testing.T) {
+		subscription := testutils.FakeSubscription()
+		subscription.Target = &entities.SubscriptionTarget{Type: entities.KafkaSubscriptionTargetType, Topic: "topic-events"}
+
+		chainAgent.EXPECT().FindOneByUUID(gomock.Any(), subscription.ChainUUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(testutils2.FakeChainModel(), nil)
+		subscriptionAgent.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+
+		_, err := usecase.Execute(ctx, subscription, userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with InvalidParameterError if the topic is not dedicated to the tenant", func(t *testing.T) {
+		for _, topic := range []string{"topic-other", "topic-tx-sender"} {
+			subscription := testutils.FakeSubscription()
+			subscription.Target = &entities.SubscriptionTarget{Type: entities.KafkaSubscriptionTargetType, Topic: topic}
+
+			resp, err := usecase.Execute(ctx, subscription, userInfo)
+
+			assert.Nil(t, resp)
+			assert.True(t, errors.IsInvalidParameterError(err))
+		}
+	})
+
+	t.Run("should fail with InvalidParameterError if the webhook is not a public destination", func(t *testing.T) {
+		subscription := testutils.FakeSubscription()
+		subscription.Target.URL = "http://169.254.169.254/latest/meta-data"
+
+		resp, err := usecase.Execute(ctx, subscription, userInfo)
+
+		assert.Nil(t, resp)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if chain is not found", func(t *testing.T) {
+		subscription := testutils.FakeSubscription()
+
+		chainAgent.EXPECT().FindOneByUUID(gomock.Any(), subscription.ChainUUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, errors.NotFoundError("error"))
+
+		resp, err := usecase.Execute(ctx, subscription, userInfo)
+
+		assert.Nil(t, resp)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with same error if insert subscription fails", func(t *testing.T) {
+		subscription := testutils.FakeSubscription()
+		expectedErr := errors.PostgresConnectionError("error")
+
+		chainAgent.EXPECT().FindOneByUUID(gomock.Any(), subscription.ChainUUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(testutils2.FakeChainModel(), nil)
+		subscriptionAgent.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(expectedErr)
+
+		resp, err := usecase.Execute(ctx, subscription, userInfo)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(createSubscriptionComponent), err)
+	})
+}
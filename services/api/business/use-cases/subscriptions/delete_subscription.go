@@ -0,0 +1,47 @@
+package subscriptions
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+)
+
+const deleteSubscriptionComponent = "use-cases.delete-subscription"
+
+// deleteSubscriptionUseCase is a use case to delete a subscription
+type deleteSubscriptionUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+// NewDeleteSubscriptionUseCase creates a new DeleteSubscriptionUseCase
+func NewDeleteSubscriptionUseCase(db store.DB) usecases.DeleteSubscriptionUseCase {
+	return &deleteSubscriptionUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(deleteSubscriptionComponent),
+	}
+}
+
+// Execute deletes a subscription
+func (uc *deleteSubscriptionUseCase) Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) error {
+	ctx = log.WithFields(ctx, log.Field("subscription", uuid))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("deleting subscription")
+
+	subscriptionModel, err := uc.db.Subscription().FindOneByUUID(ctx, uuid, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(deleteSubscriptionComponent)
+	}
+
+	err = uc.db.Subscription().Delete(ctx, subscriptionModel, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(deleteSubscriptionComponent)
+	}
+
+	logger.Info("subscription deleted successfully")
+	return nil
+}
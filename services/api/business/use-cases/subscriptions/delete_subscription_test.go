@@ -0,0 +1,53 @@
+// +build unit
+
+package subscriptions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteSubscription_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	subscriptionAgent := mocks.NewMockSubscriptionAgent(ctrl)
+	mockDB.EXPECT().Subscription().Return(subscriptionAgent).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewDeleteSubscriptionUseCase(mockDB)
+
+	t.Run("should execute use case successfully", func(t *testing.T) {
+		subscriptionModel := testutils.FakeSubscriptionModel("chainUUID")
+
+		subscriptionAgent.EXPECT().FindOneByUUID(gomock.Any(), subscriptionModel.UUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(subscriptionModel, nil)
+		subscriptionAgent.EXPECT().Delete(gomock.Any(), subscriptionModel, userInfo.AllowedTenants, userInfo.Username).Return(nil)
+
+		err := usecase.Execute(ctx, subscriptionModel.UUID, userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with same error if delete subscription fails", func(t *testing.T) {
+		subscriptionModel := testutils.FakeSubscriptionModel("chainUUID")
+		expectedErr := errors.PostgresConnectionError("error")
+
+		subscriptionAgent.EXPECT().FindOneByUUID(gomock.Any(), subscriptionModel.UUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(subscriptionModel, nil)
+		subscriptionAgent.EXPECT().Delete(gomock.Any(), subscriptionModel, userInfo.AllowedTenants, userInfo.Username).Return(expectedErr)
+
+		err := usecase.Execute(ctx, subscriptionModel.UUID, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(deleteSubscriptionComponent), err)
+	})
+}
@@ -0,0 +1,43 @@
+package subscriptions
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+)
+
+const getSubscriptionComponent = "use-cases.get-subscription"
+
+// getSubscriptionUseCase is a use case to get a subscription
+type getSubscriptionUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+// NewGetSubscriptionUseCase creates a new GetSubscriptionUseCase
+func NewGetSubscriptionUseCase(db store.DB) usecases.GetSubscriptionUseCase {
+	return &getSubscriptionUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(getSubscriptionComponent),
+	}
+}
+
+// Execute gets a subscription
+func (uc *getSubscriptionUseCase) Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) (*entities.Subscription, error) {
+	ctx = log.WithFields(ctx, log.Field("subscription", uuid))
+	logger := uc.logger.WithContext(ctx)
+
+	subscriptionModel, err := uc.db.Subscription().FindOneByUUID(ctx, uuid, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(getSubscriptionComponent)
+	}
+
+	logger.Debug("subscription found successfully")
+	return parsers.NewSubscriptionFromModel(subscriptionModel), nil
+}
@@ -0,0 +1,51 @@
+// +build unit
+
+package subscriptions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	"github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSubscription_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	subscriptionAgent := mocks.NewMockSubscriptionAgent(ctrl)
+	mockDB.EXPECT().Subscription().Return(subscriptionAgent).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewGetSubscriptionUseCase(mockDB)
+
+	t.Run("should execute use case successfully", func(t *testing.T) {
+		subscription := testutils.FakeSubscriptionModel("chainUUID")
+		subscriptionAgent.EXPECT().FindOneByUUID(gomock.Any(), subscription.UUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(subscription, nil)
+
+		resp, err := usecase.Execute(ctx, subscription.UUID, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, parsers.NewSubscriptionFromModel(subscription), resp)
+	})
+
+	t.Run("should fail with same error if get subscription fails", func(t *testing.T) {
+		expectedErr := errors.NotFoundError("error")
+		subscriptionAgent.EXPECT().FindOneByUUID(gomock.Any(), "uuid", userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, expectedErr)
+
+		resp, err := usecase.Execute(ctx, "uuid", userInfo)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(getSubscriptionComponent), err)
+	})
+}
@@ -0,0 +1,45 @@
+package subscriptions
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+)
+
+const searchSubscriptionsComponent = "use-cases.search-subscriptions"
+
+// searchSubscriptionsUseCase is a use case to search subscriptions
+type searchSubscriptionsUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+// NewSearchSubscriptionsUseCase creates a new SearchSubscriptionsUseCase
+func NewSearchSubscriptionsUseCase(db store.DB) usecases.SearchSubscriptionsUseCase {
+	return &searchSubscriptionsUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(searchSubscriptionsComponent),
+	}
+}
+
+// Execute searches subscriptions
+func (uc *searchSubscriptionsUseCase) Execute(ctx context.Context, filters *entities.SubscriptionFilters, userInfo *multitenancy.UserInfo) ([]*entities.Subscription, error) {
+	subscriptionModels, err := uc.db.Subscription().Search(ctx, filters, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(searchSubscriptionsComponent)
+	}
+
+	var subscriptions []*entities.Subscription
+	for _, subscriptionModel := range subscriptionModels {
+		subscriptions = append(subscriptions, parsers.NewSubscriptionFromModel(subscriptionModel))
+	}
+
+	uc.logger.WithContext(ctx).Debug("subscriptions found successfully")
+	return subscriptions, nil
+}
@@ -0,0 +1,55 @@
+// +build unit
+
+package subscriptions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	"github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	"github.com/consensys/orchestrate/services/api/store/models/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchSubscriptions_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	subscriptionAgent := mocks.NewMockSubscriptionAgent(ctrl)
+	mockDB.EXPECT().Subscription().Return(subscriptionAgent).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewSearchSubscriptionsUseCase(mockDB)
+
+	t.Run("should execute use case successfully", func(t *testing.T) {
+		subscription := testutils.FakeSubscriptionModel("chainUUID")
+		filters := &entities.SubscriptionFilters{ChainUUID: "chainUUID"}
+		subscriptionAgent.EXPECT().Search(gomock.Any(), filters, userInfo.AllowedTenants, userInfo.Username).
+			Return([]*models.Subscription{subscription}, nil)
+
+		resp, err := usecase.Execute(ctx, filters, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*entities.Subscription{parsers.NewSubscriptionFromModel(subscription)}, resp)
+	})
+
+	t.Run("should fail with same error if search subscriptions fails", func(t *testing.T) {
+		filters := &entities.SubscriptionFilters{}
+		expectedErr := errors.PostgresConnectionError("error")
+		subscriptionAgent.EXPECT().Search(gomock.Any(), filters, userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, expectedErr)
+
+		resp, err := usecase.Execute(ctx, filters, userInfo)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(searchSubscriptionsComponent), err)
+	})
+}
@@ -0,0 +1,24 @@
+package subscriptions
+
+import (
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/utils"
+)
+
+// validateTarget checks that events are only produced to Kafka topics dedicated to the tenant of the subscription and
+// only posted to webhooks reachable on the internet
+func validateTarget(target *entities.SubscriptionTarget, tenantID string, tenantTopics map[string]string) error {
+	switch target.Type {
+	case entities.KafkaSubscriptionTargetType:
+		if topicTenant, ok := tenantTopics[target.Topic]; !ok || topicTenant != tenantID {
+			return errors.InvalidParameterError("topic %s is not allowed", target.Topic)
+		}
+	case entities.WebhookSubscriptionTargetType:
+		if !utils.IsPublicURL(target.URL) {
+			return errors.InvalidParameterError("webhook url %s is not allowed", target.URL)
+		}
+	}
+
+	return nil
+}
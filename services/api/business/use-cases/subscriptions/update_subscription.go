@@ -0,0 +1,67 @@
+package subscriptions
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+)
+
+const updateSubscriptionComponent = "use-cases.update-subscription"
+
+// updateSubscriptionUseCase is a use case to update a subscription
+type updateSubscriptionUseCase struct {
+	db           store.DB
+	tenantTopics map[string]string
+	logger       *log.Logger
+}
+
+// NewUpdateSubscriptionUseCase creates a new UpdateSubscriptionUseCase
+func NewUpdateSubscriptionUseCase(db store.DB, tenantTopics map[string]string) usecases.UpdateSubscriptionUseCase {
+	return &updateSubscriptionUseCase{
+		db:           db,
+		tenantTopics: tenantTopics,
+		logger:       log.NewLogger().SetComponent(updateSubscriptionComponent),
+	}
+}
+
+// Execute updates the topic filters and the target of a subscription
+func (uc *updateSubscriptionUseCase) Execute(ctx context.Context, subscription *entities.Subscription, userInfo *multitenancy.UserInfo) (*entities.Subscription, error) {
+	ctx = log.WithFields(ctx, log.Field("subscription", subscription.UUID))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("updating subscription")
+
+	subscriptionModel, err := uc.db.Subscription().FindOneByUUID(ctx, subscription.UUID, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(updateSubscriptionComponent)
+	}
+
+	if subscription.Target != nil {
+		err = validateTarget(subscription.Target, subscriptionModel.TenantID, uc.tenantTopics)
+		if err != nil {
+			logger.WithError(err).Error("invalid subscription target")
+			return nil, errors.FromError(err).ExtendComponent(updateSubscriptionComponent)
+		}
+	}
+
+	newSubscriptionModel := parsers.NewSubscriptionModelFromEntity(subscription)
+	if newSubscriptionModel.TopicFilters != nil {
+		subscriptionModel.TopicFilters = newSubscriptionModel.TopicFilters
+	}
+	if newSubscriptionModel.Target != nil {
+		subscriptionModel.Target = newSubscriptionModel.Target
+	}
+
+	err = uc.db.Subscription().Update(ctx, subscriptionModel, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(updateSubscriptionComponent)
+	}
+
+	logger.Info("subscription updated successfully")
+	return parsers.NewSubscriptionFromModel(subscriptionModel), nil
+}
@@ -0,0 +1,85 @@
+// +build unit
+
+package subscriptions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateSubscription_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	subscriptionAgent := mocks.NewMockSubscriptionAgent(ctrl)
+	mockDB.EXPECT().Subscription().Return(subscriptionAgent).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewUpdateSubscriptionUseCase(mockDB, map[string]string{"topic-events": "tenantID"})
+
+	t.Run("should execute use case successfully", func(t *testing.T) {
+		subscriptionModel := testutils.FakeSubscriptionModel("chainUUID")
+		target := &entities.SubscriptionTarget{Type: entities.KafkaSubscriptionTargetType, Topic: "topic-events"}
+
+		subscriptionAgent.EXPECT().FindOneByUUID(gomock.Any(), subscriptionModel.UUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(subscriptionModel, nil)
+		subscriptionAgent.EXPECT().Update(gomock.Any(), subscriptionModel, userInfo.AllowedTenants, userInfo.Username).Return(nil)
+
+		resp, err := usecase.Execute(ctx, &entities.Subscription{UUID: subscriptionModel.UUID, Target: target}, userInfo)
+		require.NoError(t, err)
+
+		assert.Equal(t, target, resp.Target)
+		assert.Len(t, resp.TopicFilters, 1)
+		assert.Equal(t, subscriptionModel.EventSignature, resp.EventSignature)
+	})
+
+	t.Run("should fail with InvalidParameterError if the topic is not dedicated to the tenant of the subscription", func(t *testing.T) {
+		subscriptionModel := testutils.FakeSubscriptionModel("chainUUID")
+		subscriptionModel.TenantID = "tenantOne"
+		target := &entities.SubscriptionTarget{Type: entities.KafkaSubscriptionTargetType, Topic: "topic-events"}
+
+		subscriptionAgent.EXPECT().FindOneByUUID(gomock.Any(), subscriptionModel.UUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(subscriptionModel, nil)
+
+		resp, err := usecase.Execute(ctx, &entities.Subscription{UUID: subscriptionModel.UUID, Target: target}, userInfo)
+
+		assert.Nil(t, resp)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with same error if subscription is not found", func(t *testing.T) {
+		expectedErr := errors.NotFoundError("error")
+		subscriptionAgent.EXPECT().FindOneByUUID(gomock.Any(), "uuid", userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, expectedErr)
+
+		resp, err := usecase.Execute(ctx, &entities.Subscription{UUID: "uuid"}, userInfo)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(updateSubscriptionComponent), err)
+	})
+
+	t.Run("should fail with same error if update subscription fails", func(t *testing.T) {
+		subscriptionModel := testutils.FakeSubscriptionModel("chainUUID")
+		expectedErr := errors.PostgresConnectionError("error")
+
+		subscriptionAgent.EXPECT().FindOneByUUID(gomock.Any(), subscriptionModel.UUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(subscriptionModel, nil)
+		subscriptionAgent.EXPECT().Update(gomock.Any(), subscriptionModel, userInfo.AllowedTenants, userInfo.Username).Return(expectedErr)
+
+		resp, err := usecase.Execute(ctx, &entities.Subscription{UUID: subscriptionModel.UUID}, userInfo)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(updateSubscriptionComponent), err)
+	})
+}
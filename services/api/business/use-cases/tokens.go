@@ -0,0 +1,23 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+)
+
+//go:generate mockgen -source=tokens.go -destination=mocks/tokens.go -package=mocks
+
+type TokenUseCases interface {
+	SendTokenTransaction() SendTokenTxUseCase
+	CallToken() CallTokenUseCase
+}
+
+type SendTokenTxUseCase interface {
+	Execute(ctx context.Context, standard entities.TokenStandard, txRequest *entities.TxRequest, userInfo *multitenancy.UserInfo) (*entities.TxRequest, error)
+}
+
+type CallTokenUseCase interface {
+	Execute(ctx context.Context, chainUUID string, standard entities.TokenStandard, call *entities.ContractCall, userInfo *multitenancy.UserInfo) (map[string]interface{}, error)
+}
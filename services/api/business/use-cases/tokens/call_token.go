@@ -0,0 +1,47 @@
+package tokens
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/tokens"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+)
+
+const callTokenComponent = "use-cases.call-token"
+
+// callTokenUseCase is a use case to execute a read-only call of a token contract using the ABI of its standard
+type callTokenUseCase struct {
+	callContractUC usecases.CallContractUseCase
+	logger         *log.Logger
+}
+
+// NewCallTokenUseCase creates a new CallTokenUseCase
+func NewCallTokenUseCase(callContractUC usecases.CallContractUseCase) usecases.CallTokenUseCase {
+	return &callTokenUseCase{
+		callContractUC: callContractUC,
+		logger:         log.NewLogger().SetComponent(callTokenComponent),
+	}
+}
+
+// Execute calls the token contract with the ABI of the token standard and returns the decoded outputs
+func (uc *callTokenUseCase) Execute(ctx context.Context, chainUUID string, standard entities.TokenStandard, call *entities.ContractCall, userInfo *multitenancy.UserInfo) (map[string]interface{}, error) {
+	ctx = log.WithFields(ctx, log.Field("standard", standard), log.Field("token", call.To.Hex()))
+
+	rawABI, ok := tokens.ABI(standard)
+	if !ok {
+		return nil, errors.InvalidParameterError("token standard %s is not supported", standard)
+	}
+
+	call.RawABI = rawABI
+	outputs, err := uc.callContractUC.Execute(ctx, chainUUID, call, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(callTokenComponent)
+	}
+
+	uc.logger.WithContext(ctx).Debug("token called successfully")
+	return outputs, nil
+}
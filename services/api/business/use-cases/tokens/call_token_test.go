@@ -0,0 +1,59 @@
+// +build unit
+
+package tokens
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/tokens"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/services/api/business/use-cases/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallToken_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCallContractUC := mocks.NewMockCallContractUseCase(ctrl)
+
+	ctx := context.Background()
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewCallTokenUseCase(mockCallContractUC)
+
+	t.Run("should call the token with the ABI of its standard", func(t *testing.T) {
+		call := testutils.FakeContractCall()
+		outputs := map[string]interface{}{"balance": "1000"}
+
+		mockCallContractUC.EXPECT().Execute(gomock.Any(), "chainUUID", call, userInfo).Return(outputs, nil)
+
+		result, err := usecase.Execute(ctx, "chainUUID", entities.ERC721TokenStandard, call, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, outputs, result)
+		assert.Equal(t, tokens.ERC721ABI, call.RawABI)
+	})
+
+	t.Run("should fail with InvalidParameterError if the token standard is not supported", func(t *testing.T) {
+		result, err := usecase.Execute(ctx, "chainUUID", "erc777", testutils.FakeContractCall(), userInfo)
+
+		assert.Nil(t, result)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with same error if call contract use case fails", func(t *testing.T) {
+		expectedErr := errors.EthConnectionError("error")
+
+		mockCallContractUC.EXPECT().Execute(gomock.Any(), "chainUUID", gomock.Any(), userInfo).Return(nil, expectedErr)
+
+		result, err := usecase.Execute(ctx, "chainUUID", entities.ERC20TokenStandard, testutils.FakeContractCall(), userInfo)
+
+		assert.Nil(t, result)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(callTokenComponent), err)
+	})
+}
@@ -0,0 +1,59 @@
+package tokens
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/tokens"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+)
+
+const sendTokenTxComponent = "use-cases.send-token-tx"
+
+// sendTokenTxUseCase is a use case to send a transaction to a token contract using the ABI of its standard
+type sendTokenTxUseCase struct {
+	sendTxUseCase usecases.SendTxUseCase
+	logger        *log.Logger
+}
+
+// NewSendTokenTxUseCase creates a new SendTokenTxUseCase
+func NewSendTokenTxUseCase(sendTxUseCase usecases.SendTxUseCase) usecases.SendTokenTxUseCase {
+	return &sendTokenTxUseCase{
+		sendTxUseCase: sendTxUseCase,
+		logger:        log.NewLogger().SetComponent(sendTokenTxComponent),
+	}
+}
+
+// Execute encodes the token method call with the ABI of the token standard, creates and starts a new transaction
+func (uc *sendTokenTxUseCase) Execute(ctx context.Context, standard entities.TokenStandard, txRequest *entities.TxRequest, userInfo *multitenancy.UserInfo) (*entities.TxRequest, error) {
+	ctx = log.WithFields(
+		ctx,
+		log.Field("idempotency-key", txRequest.IdempotencyKey),
+		log.Field("standard", standard),
+		log.Field("method", txRequest.Params.MethodSignature),
+	)
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("creating new token transaction")
+
+	rawABI, ok := tokens.ABI(standard)
+	if !ok {
+		return nil, errors.InvalidParameterError("token standard %s is not supported", standard)
+	}
+
+	txData, err := parsers.EncodeContractCall(&entities.Contract{RawABI: rawABI}, txRequest.Params.MethodSignature, txRequest.Params.Args)
+	if err != nil {
+		logger.WithError(err).Error("failed to compute tx data from method signature and arguments")
+		return nil, errors.FromError(err).ExtendComponent(sendTokenTxComponent)
+	}
+
+	tx, err := uc.sendTxUseCase.Execute(ctx, txRequest, txData, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(sendTokenTxComponent)
+	}
+
+	return tx, nil
+}
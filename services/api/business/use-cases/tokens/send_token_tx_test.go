@@ -0,0 +1,75 @@
+// +build unit
+
+package tokens
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/tokens"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/services/api/business/use-cases/mocks"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSendTokenTx_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSendTxUC := mocks.NewMockSendTxUseCase(ctrl)
+
+	ctx := context.Background()
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewSendTokenTxUseCase(mockSendTxUC)
+
+	t.Run("should encode the token method call and send the transaction", func(t *testing.T) {
+		txRequest := testutils.FakeTxRequest()
+		txRequest.Params.MethodSignature = tokens.ERC20Transfer
+		txRequest.Params.Args = []interface{}{"0xdbb881a51CD4023E4400CEF3ef73046743f08da3", "0x3e8"}
+		txRequestResponse := testutils.FakeTxRequest()
+		expectedTxData := hexutil.MustDecode("0xa9059cbb000000000000000000000000dbb881a51cd4023e4400cef3ef73046743f08da300000000000000000000000000000000000000000000000000000000000003e8")
+
+		mockSendTxUC.EXPECT().Execute(gomock.Any(), txRequest, expectedTxData, userInfo).Return(txRequestResponse, nil)
+
+		response, err := usecase.Execute(ctx, entities.ERC20TokenStandard, txRequest, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, txRequestResponse, response)
+	})
+
+	t.Run("should fail with InvalidParameterError if the token standard is not supported", func(t *testing.T) {
+		response, err := usecase.Execute(ctx, "erc777", testutils.FakeTxRequest(), userInfo)
+
+		assert.Nil(t, response)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if the method is not part of the token standard", func(t *testing.T) {
+		txRequest := testutils.FakeTxRequest()
+		txRequest.Params.MethodSignature = "unknown(address)"
+
+		response, err := usecase.Execute(ctx, entities.ERC20TokenStandard, txRequest, userInfo)
+
+		assert.Nil(t, response)
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail with same error if send tx use case fails", func(t *testing.T) {
+		txRequest := testutils.FakeTxRequest()
+		txRequest.Params.MethodSignature = tokens.ERC20Approve
+		txRequest.Params.Args = []interface{}{"0xdbb881a51CD4023E4400CEF3ef73046743f08da3", "0x3e8"}
+		expectedErr := errors.NotFoundError("error")
+
+		mockSendTxUC.EXPECT().Execute(gomock.Any(), txRequest, gomock.Any(), userInfo).Return(nil, expectedErr)
+
+		response, err := usecase.Execute(ctx, entities.ERC20TokenStandard, txRequest, userInfo)
+
+		assert.Nil(t, response)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(sendTokenTxComponent), err)
+	})
+}
@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/store"
+)
+
+//go:generate mockgen -source=transaction_policies.go -destination=mocks/transaction_policies.go -package=mocks
+
+type TransactionPolicyUseCases interface {
+	CreateTransactionPolicy() CreateTransactionPolicyUseCase
+	GetTransactionPolicy() GetTransactionPolicyUseCase
+	SearchTransactionPolicies() SearchTransactionPoliciesUseCase
+	DeleteTransactionPolicy() DeleteTransactionPolicyUseCase
+	EnforceTransactionPolicies() EnforceTransactionPoliciesUseCase
+}
+
+type CreateTransactionPolicyUseCase interface {
+	Execute(ctx context.Context, policy *entities.TransactionPolicy, userInfo *multitenancy.UserInfo) (*entities.TransactionPolicy, error)
+}
+
+type GetTransactionPolicyUseCase interface {
+	Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) (*entities.TransactionPolicy, error)
+}
+
+type SearchTransactionPoliciesUseCase interface {
+	Execute(ctx context.Context, filters *entities.TransactionPolicyFilters, userInfo *multitenancy.UserInfo) ([]*entities.TransactionPolicy, error)
+}
+
+type DeleteTransactionPolicyUseCase interface {
+	Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) error
+}
+
+type EnforceTransactionPoliciesUseCase interface {
+	Execute(ctx context.Context, job *entities.Job, tenantID string) error
+	WithDBTransaction(dbtx store.Tx) EnforceTransactionPoliciesUseCase
+}
@@ -15,12 +15,14 @@ Transaction Use Cases
 */
 type TransactionUseCases interface {
 	SendContractTransaction() SendContractTxUseCase
+	SendContractTransactions() SendContractTxsUseCase
 	SendDeployTransaction() SendDeployTxUseCase
 	SendTransaction() SendTxUseCase
 	GetTransaction() GetTxUseCase
 	SearchTransactions() SearchTransactionsUseCase
 	SpeedUp() SpeedUpTxUseCase
 	CallOff() CallOffTxUseCase
+	SimulateTransaction() SimulateTxUseCase
 }
 
 type GetTxUseCase interface {
@@ -38,6 +40,10 @@ type SendContractTxUseCase interface {
 	Execute(ctx context.Context, txRequest *entities.TxRequest, userInfo *multitenancy.UserInfo) (*entities.TxRequest, error)
 }
 
+type SendContractTxsUseCase interface {
+	Execute(ctx context.Context, txRequests []*entities.TxRequest, userInfo *multitenancy.UserInfo) ([]*entities.TxRequestResult, error)
+}
+
 type SendTxUseCase interface {
 	Execute(ctx context.Context, txRequest *entities.TxRequest, txData hexutil.Bytes, userInfo *multitenancy.UserInfo) (*entities.TxRequest, error)
 }
@@ -49,3 +55,7 @@ type SpeedUpTxUseCase interface {
 type CallOffTxUseCase interface {
 	Execute(ctx context.Context, scheduleUUID string, userInfo *multitenancy.UserInfo) (*entities.TxRequest, error)
 }
+
+type SimulateTxUseCase interface {
+	Execute(ctx context.Context, txRequest *entities.TxRequest, userInfo *multitenancy.UserInfo) (*entities.TransactionSimulation, error)
+}
@@ -10,16 +10,21 @@ import (
 	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
 )
 
+const calledOffJobMessage = "transaction called off before being sent"
+
 type callOffTxUseCase struct {
 	getTxUC      usecases.GetTxUseCase
 	retryJobTxUC usecases.RetryJobTxUseCase
+	updateJobUC  usecases.UpdateJobUseCase
 	logger       *log.Logger
 }
 
-func NewCallOffTxUseCase(getTxUC usecases.GetTxUseCase, retryJobTxUC usecases.RetryJobTxUseCase) usecases.CallOffTxUseCase {
+func NewCallOffTxUseCase(getTxUC usecases.GetTxUseCase, retryJobTxUC usecases.RetryJobTxUseCase,
+	updateJobUC usecases.UpdateJobUseCase) usecases.CallOffTxUseCase {
 	return &callOffTxUseCase{
 		getTxUC:      getTxUC,
 		retryJobTxUC: retryJobTxUC,
+		updateJobUC:  updateJobUC,
 		logger:       log.NewLogger().SetComponent("use-cases.call-off-tx"),
 	}
 }
@@ -37,6 +42,22 @@ func (uc *callOffTxUseCase) Execute(ctx context.Context, scheduleUUID string, us
 		return nil, err
 	}
 
+	// A scheduled transaction or a transaction awaiting approval was not sent yet, so it is enough to cancel its job
+	if job := tx.Schedule.Jobs[0]; job.Status == entities.StatusScheduled || job.Status == entities.StatusAwaitingApproval {
+		_, err = uc.updateJobUC.Execute(ctx, &entities.Job{UUID: job.UUID}, entities.StatusFailed, calledOffJobMessage, userInfo)
+		if err != nil {
+			return nil, err
+		}
+
+		txRequest, err := uc.getTxUC.Execute(ctx, scheduleUUID, userInfo)
+		if err != nil {
+			return nil, err
+		}
+
+		logger.Info("scheduled transaction was called off successfully")
+		return txRequest, nil
+	}
+
 	if tx.Params.Protocol != "" {
 		errMsg := "call off is not supported for private transaction"
 		logger.Error(errMsg)
@@ -0,0 +1,103 @@
+// +build unit
+
+package transactions
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/services/api/business/use-cases/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallOffTx_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGetTxUC := mocks.NewMockGetTxUseCase(ctrl)
+	mockRetryJobTxUC := mocks.NewMockRetryJobTxUseCase(ctrl)
+	mockUpdateJobUC := mocks.NewMockUpdateJobUseCase(ctrl)
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewCallOffTxUseCase(mockGetTxUC, mockRetryJobTxUC, mockUpdateJobUC)
+
+	t.Run("should send a cancel transaction successfully", func(t *testing.T) {
+		tx := testutils.FakeTxRequest()
+		tx.Schedule.Jobs[0].Status = entities.StatusPending
+		scheduleUUID := tx.Schedule.UUID
+
+		mockGetTxUC.EXPECT().Execute(gomock.Any(), scheduleUUID, userInfo).Return(tx, nil).Times(2)
+		mockRetryJobTxUC.EXPECT().Execute(gomock.Any(), tx.Schedule.Jobs[0].UUID, 0.1, nil, userInfo).Return(nil)
+
+		result, err := usecase.Execute(ctx, scheduleUUID, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, tx, result)
+	})
+
+	t.Run("should cancel a scheduled transaction without sending it", func(t *testing.T) {
+		tx := testutils.FakeTxRequest()
+		job := tx.Schedule.Jobs[0]
+		job.Status = entities.StatusScheduled
+		scheduleUUID := tx.Schedule.UUID
+
+		mockGetTxUC.EXPECT().Execute(gomock.Any(), scheduleUUID, userInfo).Return(tx, nil).Times(2)
+		mockUpdateJobUC.EXPECT().Execute(gomock.Any(), &entities.Job{UUID: job.UUID}, entities.StatusFailed, calledOffJobMessage, userInfo).
+			Return(job, nil)
+
+		result, err := usecase.Execute(ctx, scheduleUUID, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, tx, result)
+	})
+
+	t.Run("should cancel a transaction awaiting approval without sending it", func(t *testing.T) {
+		tx := testutils.FakeTxRequest()
+		job := tx.Schedule.Jobs[0]
+		job.Status = entities.StatusAwaitingApproval
+		scheduleUUID := tx.Schedule.UUID
+
+		mockGetTxUC.EXPECT().Execute(gomock.Any(), scheduleUUID, userInfo).Return(tx, nil).Times(2)
+		mockUpdateJobUC.EXPECT().Execute(gomock.Any(), &entities.Job{UUID: job.UUID}, entities.StatusFailed, calledOffJobMessage, userInfo).
+			Return(job, nil)
+
+		result, err := usecase.Execute(ctx, scheduleUUID, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, tx, result)
+	})
+
+	t.Run("should fail with same error if update of scheduled job fails", func(t *testing.T) {
+		tx := testutils.FakeTxRequest()
+		tx.Schedule.Jobs[0].Status = entities.StatusScheduled
+		expectedErr := fmt.Errorf("error")
+
+		mockGetTxUC.EXPECT().Execute(gomock.Any(), tx.Schedule.UUID, userInfo).Return(tx, nil)
+		mockUpdateJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), entities.StatusFailed, calledOffJobMessage, userInfo).
+			Return(nil, expectedErr)
+
+		result, err := usecase.Execute(ctx, tx.Schedule.UUID, userInfo)
+
+		assert.Nil(t, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail with InvalidParameterError if transaction is private", func(t *testing.T) {
+		tx := testutils.FakeTesseraTxRequest()
+		tx.Schedule.Jobs[0].Status = entities.StatusPending
+
+		mockGetTxUC.EXPECT().Execute(gomock.Any(), tx.Schedule.UUID, userInfo).Return(tx, nil)
+
+		result, err := usecase.Execute(ctx, tx.Schedule.UUID, userInfo)
+
+		assert.Nil(t, result)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
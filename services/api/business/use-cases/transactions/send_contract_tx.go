@@ -7,8 +7,8 @@ import (
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
 	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
-	"github.com/umbracle/go-web3/abi"
 )
 
 const sendContractTxComponent = "use-cases.send-contract-tx"
@@ -39,7 +39,7 @@ func (uc *sendContractTxUseCase) Execute(ctx context.Context, txRequest *entitie
 	logger := uc.logger.WithContext(ctx)
 	logger.Debug("creating new contract transaction")
 
-	contract, err := uc.getContractUseCase.Execute(ctx, txRequest.Params.ContractName, txRequest.Params.ContractTag)
+	contract, err := uc.getContractUseCase.Execute(ctx, txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo)
 	if errors.IsNotFoundError(err) {
 		return nil, errors.InvalidParameterError("contract not found")
 	}
@@ -47,25 +47,10 @@ func (uc *sendContractTxUseCase) Execute(ctx context.Context, txRequest *entitie
 		return nil, errors.FromError(err).ExtendComponent(sendContractTxComponent)
 	}
 
-	// TODO: We restrict the usage of web3-go to only generate the txData but ideally we should use it as much as possible and change the ABI type everywhere in the codebase
-	web3ABI, err := abi.NewABI(contract.RawABI)
-	if err != nil {
-		errMessage := "failed to parse contract ABI for contract transaction"
-		logger.WithError(err).Error(errMessage)
-		return nil, errors.DataCorruptedError(errMessage).ExtendComponent(sendContractTxComponent)
-	}
-
-	method := web3ABI.GetMethodBySignature(txRequest.Params.MethodSignature)
-	if method == nil {
-		errMessage := "method not found"
-		logger.WithError(err).Error(errMessage)
-		return nil, errors.InvalidParameterError(errMessage).ExtendComponent(sendContractTxComponent)
-	}
-
-	txData, err := method.Encode(txRequest.Params.Args)
+	txData, err := parsers.EncodeContractCall(contract, txRequest.Params.MethodSignature, txRequest.Params.Args)
 	if err != nil {
 		logger.WithError(err).Error("failed to compute tx data from method signature and arguments")
-		return nil, errors.InvalidParameterError(err.Error()).ExtendComponent(sendContractTxComponent)
+		return nil, errors.FromError(err).ExtendComponent(sendContractTxComponent)
 	}
 
 	tx, err := uc.sendTxUseCase.Execute(ctx, txRequest, txData, userInfo)
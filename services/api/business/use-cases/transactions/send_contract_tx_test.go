@@ -32,7 +32,7 @@ func TestSendContractTx_Execute(t *testing.T) {
 	usecase := NewSendContractTxUseCase(mockSendTxUC, mockGetContractUC)
 
 	t.Run("should execute use case successfully", func(t *testing.T) {
-		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag).Return(c, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo).Return(c, nil)
 		mockSendTxUC.EXPECT().Execute(gomock.Any(), txRequest, gomock.Any(), userInfo).Return(txRequestResponse, nil)
 
 		response, err := usecase.Execute(ctx, txRequest, userInfo)
@@ -59,7 +59,7 @@ func TestSendContractTx_Execute(t *testing.T) {
 		}
 		expectedTxData := hexutil.MustDecode("0x52ca78230000000000000000000000000000000000000000000000000000000000000020000000000000000000000000dbb881a51cd4023e4400cef3ef73046743f08da30000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000001f4000000000000000000000000dbb881a51cd4023e4400cef3ef73046743f08da3")
 
-		mockGetContractUC.EXPECT().Execute(gomock.Any(), newTxRequest.Params.ContractName, newTxRequest.Params.ContractTag).Return(newContract, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), newTxRequest.Params.ContractName, newTxRequest.Params.ContractTag, userInfo).Return(newContract, nil)
 		mockSendTxUC.EXPECT().Execute(gomock.Any(), newTxRequest, expectedTxData, userInfo).Return(txRequestResponse, nil)
 
 		response, err := usecase.Execute(ctx, newTxRequest, userInfo)
@@ -76,7 +76,7 @@ func TestSendContractTx_Execute(t *testing.T) {
 		newTxRequest.Params.Args = []interface{}{"0xdbb881a51CD4023E4400CEF3ef73046743f08da3", "0xdbb881a51CD4023E4400CEF3ef73046743f08da3", 500}
 		expectedTxData := hexutil.MustDecode("0xed629438000000000000000000000000dbb881a51cd4023e4400cef3ef73046743f08da3000000000000000000000000dbb881a51cd4023e4400cef3ef73046743f08da300000000000000000000000000000000000000000000000000000000000001f4")
 
-		mockGetContractUC.EXPECT().Execute(gomock.Any(), newTxRequest.Params.ContractName, newTxRequest.Params.ContractTag).Return(newContract, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), newTxRequest.Params.ContractName, newTxRequest.Params.ContractTag, userInfo).Return(newContract, nil)
 		mockSendTxUC.EXPECT().Execute(gomock.Any(), newTxRequest, expectedTxData, userInfo).Return(txRequestResponse, nil)
 
 		response, err := usecase.Execute(ctx, newTxRequest, userInfo)
@@ -88,7 +88,7 @@ func TestSendContractTx_Execute(t *testing.T) {
 	t.Run("should fail with same error if get contract use case fails", func(t *testing.T) {
 		expectedErr := fmt.Errorf("error")
 
-		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag).Return(nil, expectedErr)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo).Return(nil, expectedErr)
 
 		response, err := usecase.Execute(ctx, txRequest, userInfo)
 
@@ -99,7 +99,7 @@ func TestSendContractTx_Execute(t *testing.T) {
 	t.Run("should fail with same error if send tx use case fails", func(t *testing.T) {
 		expectedErr := fmt.Errorf("error")
 
-		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag).Return(c, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo).Return(c, nil)
 		mockSendTxUC.EXPECT().Execute(gomock.Any(), txRequest, gomock.Any(), userInfo).Return(nil, expectedErr)
 
 		response, err := usecase.Execute(ctx, txRequest, userInfo)
@@ -0,0 +1,312 @@
+package transactions
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/database"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/store"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gofrs/uuid"
+)
+
+const sendContractTxsComponent = "use-cases.send-contract-txs"
+
+type sendContractTxsUseCase struct {
+	db                 store.DB
+	searchChainsUC     usecases.SearchChainsUseCase
+	getContractUseCase usecases.GetContractUseCase
+	createJobUC        usecases.CreateJobUseCase
+	startJobsUC        usecases.StartJobsUseCase
+	getTxUC            usecases.GetTxUseCase
+	getFaucetCandidate usecases.GetFaucetCandidateUseCase
+	logger             *log.Logger
+}
+
+type batchItem struct {
+	txRequest   *entities.TxRequest
+	txData      hexutil.Bytes
+	requestHash string
+	chain       *entities.Chain
+}
+
+// NewSendContractTxsUseCase creates a new SendContractTxsUseCase
+func NewSendContractTxsUseCase(
+	db store.DB,
+	searchChainsUC usecases.SearchChainsUseCase,
+	getContractUseCase usecases.GetContractUseCase,
+	createJobUC usecases.CreateJobUseCase,
+	startJobsUC usecases.StartJobsUseCase,
+	getTxUC usecases.GetTxUseCase,
+	getFaucetCandidate usecases.GetFaucetCandidateUseCase,
+) usecases.SendContractTxsUseCase {
+	return &sendContractTxsUseCase{
+		db:                 db,
+		searchChainsUC:     searchChainsUC,
+		getContractUseCase: getContractUseCase,
+		createJobUC:        createJobUC,
+		startJobsUC:        startJobsUC,
+		getTxUC:            getTxUC,
+		getFaucetCandidate: getFaucetCandidate,
+		logger:             log.NewLogger().SetComponent(sendContractTxsComponent),
+	}
+}
+
+// Execute validates every contract transaction of the batch, creates them in a single DB transaction and starts them
+// in a single batch of messages.
+// Transactions with an idempotency key already used return the existing transaction, or a conflict error if params differ.
+// Faucets are triggered once per sender account and chain of the new transactions
+func (uc *sendContractTxsUseCase) Execute(ctx context.Context, txRequests []*entities.TxRequest, userInfo *multitenancy.UserInfo) ([]*entities.TxRequestResult, error) {
+	ctx = log.WithFields(ctx, log.Field("transactions", len(txRequests)))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("creating new batch of contract transactions")
+
+	// Step 1: Validate and compute the data of every transaction before inserting any of them
+	items, err := uc.prepareItems(ctx, txRequests, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(sendContractTxsComponent)
+	}
+
+	// Step 2: Resolve transactions already sent with the same idempotency key
+	results := make([]*entities.TxRequestResult, len(items))
+	var newItems []*batchItem
+	var jobsToStart []string
+	for idx, item := range items {
+		if item.txRequest.IdempotencyKey == "" {
+			newItems = append(newItems, item)
+			results[idx] = &entities.TxRequestResult{TxRequest: item.txRequest}
+			continue
+		}
+
+		txRequestModel, der := uc.db.TransactionRequest().FindOneByIdempotencyKey(ctx, item.txRequest.IdempotencyKey,
+			userInfo.TenantID, userInfo.Username)
+		switch {
+		case errors.IsNotFoundError(der):
+			newItems = append(newItems, item)
+			results[idx] = &entities.TxRequestResult{TxRequest: item.txRequest}
+		case der != nil:
+			return nil, errors.FromError(der).ExtendComponent(sendContractTxsComponent)
+		case txRequestModel.RequestHash != item.requestHash:
+			errMessage := "transaction request with the same idempotency key and different params already exists"
+			logger.WithField("idempotency-key", item.txRequest.IdempotencyKey).Error(errMessage)
+			results[idx] = &entities.TxRequestResult{TxRequest: item.txRequest, Err: errors.AlreadyExistsError(errMessage)}
+		default:
+			txRequest, der := uc.getTxUC.Execute(ctx, txRequestModel.Schedule.UUID, userInfo)
+			if der != nil {
+				return nil, errors.FromError(der).ExtendComponent(sendContractTxsComponent)
+			}
+
+			// Start the job again if the previous request failed before starting it
+			if job := txRequest.Schedule.Jobs[0]; job.Status == entities.StatusCreated {
+				jobsToStart = append(jobsToStart, job.UUID)
+			}
+			results[idx] = &entities.TxRequestResult{TxRequest: txRequest}
+		}
+	}
+
+	// Step 3: Insert Schedules + Jobs + Transactions + TxRequests atomically
+	err = uc.insertTxRequests(ctx, newItems, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(sendContractTxsComponent)
+	}
+
+	// Step 4: Fund the accounts sending the new transactions
+	err = uc.startFaucetJobs(ctx, newItems, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(sendContractTxsComponent)
+	}
+
+	// Step 5: Start the first job of every schedule at once
+	for _, item := range newItems {
+		jobsToStart = append(jobsToStart, item.txRequest.Schedule.Jobs[0].UUID)
+	}
+
+	err = uc.startJobsUC.Execute(ctx, jobsToStart, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(sendContractTxsComponent)
+	}
+
+	for _, item := range newItems {
+		job := item.txRequest.Schedule.Jobs[0]
+		if entities.IsScheduledJob(job) {
+			job.Status = entities.StatusScheduled
+		} else {
+			job.Status = entities.StatusStarted
+		}
+	}
+
+	logger.WithField("created", len(newItems)).Info("batch of contract transactions created successfully")
+	return results, nil
+}
+
+func (uc *sendContractTxsUseCase) prepareItems(ctx context.Context, txRequests []*entities.TxRequest, userInfo *multitenancy.UserInfo) ([]*batchItem, error) {
+	chains := make(map[string]*entities.Chain)
+	contracts := make(map[string]*entities.Contract)
+	idempotencyKeys := make(map[string]bool)
+
+	items := make([]*batchItem, len(txRequests))
+	for idx, txRequest := range txRequests {
+		logger := uc.logger.WithContext(ctx).WithField("transaction", idx)
+
+		if txRequest.IdempotencyKey != "" {
+			if idempotencyKeys[txRequest.IdempotencyKey] {
+				return nil, errors.InvalidParameterError("transaction %d: idempotency key '%s' is used more than once",
+					idx, txRequest.IdempotencyKey)
+			}
+			idempotencyKeys[txRequest.IdempotencyKey] = true
+		}
+
+		chain, ok := chains[txRequest.ChainName]
+		if !ok {
+			filteredChains, err := uc.searchChainsUC.Execute(ctx, &entities.ChainFilters{Names: []string{txRequest.ChainName}}, userInfo)
+			if err != nil {
+				logger.WithError(err).WithField("chain_name", txRequest.ChainName).Error("failed to get chain")
+				return nil, err
+			}
+
+			if len(filteredChains) == 0 {
+				return nil, errors.InvalidParameterError("transaction %d: chain '%s' does not exist", idx, txRequest.ChainName)
+			}
+
+			chain = filteredChains[0]
+			chains[txRequest.ChainName] = chain
+		}
+
+		contractKey := txRequest.Params.ContractName + ":" + txRequest.Params.ContractTag
+		contract, ok := contracts[contractKey]
+		if !ok {
+			var err error
+			contract, err = uc.getContractUseCase.Execute(ctx, txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo)
+			if errors.IsNotFoundError(err) {
+				return nil, errors.InvalidParameterError("transaction %d: contract not found", idx)
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			contracts[contractKey] = contract
+		}
+
+		txData, err := parsers.EncodeContractCall(contract, txRequest.Params.MethodSignature, txRequest.Params.Args)
+		if err != nil {
+			logger.WithError(err).Error("failed to compute tx data from method signature and arguments")
+			return nil, errors.FromError(err).SetMessage("transaction %d: %s", idx, errors.FromError(err).GetMessage())
+		}
+
+		requestHash, err := generateRequestHash(chain.UUID, txRequest.Params)
+		if err != nil {
+			logger.WithError(err).Error("failed to generate request hash")
+			return nil, err
+		}
+
+		items[idx] = &batchItem{
+			txRequest:   txRequest,
+			txData:      txData,
+			requestHash: requestHash,
+			chain:       chain,
+		}
+	}
+
+	return items, nil
+}
+
+// startFaucetJobs credits the sender of the new transactions from a faucet, once per account and chain. The faucet job
+// is added to the schedule of the first transaction of the account
+func (uc *sendContractTxsUseCase) startFaucetJobs(ctx context.Context, items []*batchItem, userInfo *multitenancy.UserInfo) error {
+	internalAdminUser := multitenancy.NewInternalAdminUser()
+	internalAdminUser.TenantID = userInfo.TenantID
+
+	funded := make(map[string]bool)
+	var faucetJobUUIDs []string
+	for _, item := range items {
+		job := item.txRequest.Schedule.Jobs[0]
+		if job.Transaction.From == nil {
+			continue
+		}
+
+		accountKey := item.chain.UUID + job.Transaction.From.Hex()
+		if funded[accountKey] {
+			continue
+		}
+		funded[accountKey] = true
+
+		faucet, err := uc.getFaucetCandidate.Execute(ctx, *job.Transaction.From, item.chain, userInfo)
+		if errors.IsNotFoundError(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		uc.logger.WithContext(ctx).WithField("chain", item.chain.UUID).WithField("faucet_amount", faucet.Amount).
+			Debug("faucet: credit approved")
+		fctJob, err := uc.createJobUC.Execute(ctx, newFaucetJob(faucet, job.Transaction.From, job.ScheduleUUID, item.chain.UUID),
+			internalAdminUser)
+		if err != nil {
+			return err
+		}
+
+		item.txRequest.Schedule.Jobs = append(item.txRequest.Schedule.Jobs, fctJob)
+		faucetJobUUIDs = append(faucetJobUUIDs, fctJob.UUID)
+	}
+
+	if len(faucetJobUUIDs) == 0 {
+		return nil
+	}
+
+	return uc.startJobsUC.Execute(ctx, faucetJobUUIDs, internalAdminUser)
+}
+
+func (uc *sendContractTxsUseCase) insertTxRequests(ctx context.Context, items []*batchItem, userInfo *multitenancy.UserInfo) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	return database.ExecuteInDBTx(uc.db, func(dbtx database.Tx) error {
+		for _, item := range items {
+			schedule := &models.Schedule{TenantID: userInfo.TenantID, OwnerID: userInfo.Username}
+			if err := dbtx.(store.Tx).Schedule().Insert(ctx, schedule); err != nil {
+				return err
+			}
+
+			txRequestModel := parsers.NewTxRequestModelFromEntities(item.txRequest, item.requestHash, schedule.ID)
+			if err := dbtx.(store.Tx).TransactionRequest().Insert(ctx, txRequestModel); err != nil {
+				return err
+			}
+			item.txRequest.Schedule = parsers.NewScheduleEntityFromModels(schedule)
+
+			sendTxJobs, err := parsers.NewJobEntitiesFromTxRequest(item.txRequest, item.chain.UUID, item.txData)
+			if err != nil {
+				return err
+			}
+
+			item.txRequest.Schedule.Jobs = make([]*entities.Job, len(sendTxJobs))
+			var nextJobUUID string
+			for idx, txJob := range sendTxJobs {
+				if nextJobUUID != "" {
+					txJob.UUID = nextJobUUID
+				}
+
+				if idx < len(sendTxJobs)-1 {
+					nextJobUUID = uuid.Must(uuid.NewV4()).String()
+					txJob.NextJobUUID = nextJobUUID
+				}
+
+				job, err := uc.createJobUC.WithDBTransaction(dbtx.(store.Tx)).Execute(ctx, txJob, userInfo)
+				if err != nil {
+					return err
+				}
+
+				item.txRequest.Schedule.Jobs[idx] = job
+			}
+		}
+
+		return nil
+	})
+}
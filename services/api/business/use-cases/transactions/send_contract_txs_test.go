@@ -0,0 +1,255 @@
+// +build unit
+
+package transactions
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	testutils3 "github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/services/api/business/use-cases/mocks"
+	mocks2 "github.com/consensys/orchestrate/services/api/store/mocks"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	testutils2 "github.com/consensys/orchestrate/services/api/store/models/testutils"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendContractTxs_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks2.NewMockDB(ctrl)
+	mockDBTX := mocks2.NewMockTx(ctrl)
+	mockTxRequestDA := mocks2.NewMockTransactionRequestAgent(ctrl)
+	mockScheduleDA := mocks2.NewMockScheduleAgent(ctrl)
+	mockSearchChainsUC := mocks.NewMockSearchChainsUseCase(ctrl)
+	mockGetContractUC := mocks.NewMockGetContractUseCase(ctrl)
+	mockCreateJobUC := mocks.NewMockCreateJobUseCase(ctrl)
+	mockStartJobsUC := mocks.NewMockStartJobsUseCase(ctrl)
+	mockGetTxUC := mocks.NewMockGetTxUseCase(ctrl)
+	mockGetFaucetCandidateUC := mocks.NewMockGetFaucetCandidateUseCase(ctrl)
+
+	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
+	mockDB.EXPECT().TransactionRequest().Return(mockTxRequestDA).AnyTimes()
+	mockDBTX.EXPECT().Schedule().Return(mockScheduleDA).AnyTimes()
+	mockDBTX.EXPECT().TransactionRequest().Return(mockTxRequestDA).AnyTimes()
+	mockDBTX.EXPECT().Rollback().Return(nil).AnyTimes()
+	mockDBTX.EXPECT().Close().Return(nil).AnyTimes()
+	mockCreateJobUC.EXPECT().WithDBTransaction(mockDBTX).Return(mockCreateJobUC).AnyTimes()
+
+	ctx := context.Background()
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	internalAdminUser := multitenancy.NewInternalAdminUser()
+	internalAdminUser.TenantID = userInfo.TenantID
+	faucetNotFoundErr := errors.NotFoundError("no faucet")
+	chain := testutils3.FakeChain()
+	contract := testutils3.FakeContract()
+
+	usecase := NewSendContractTxsUseCase(mockDB, mockSearchChainsUC, mockGetContractUC, mockCreateJobUC, mockStartJobsUC, mockGetTxUC,
+		mockGetFaucetCandidateUC)
+
+	newTxRequests := func(idempotencyKeys ...string) []*entities.TxRequest {
+		txRequests := make([]*entities.TxRequest, len(idempotencyKeys))
+		for idx, key := range idempotencyKeys {
+			txRequests[idx] = testutils3.FakeTxRequest()
+			txRequests[idx].IdempotencyKey = key
+			txRequests[idx].ChainName = chain.Name
+			txRequests[idx].Schedule = nil
+		}
+		return txRequests
+	}
+
+	expectInsert := func(n int) []string {
+		jobUUIDs := make([]string, n)
+		for idx := range jobUUIDs {
+			jobUUIDs[idx] = uuid.Must(uuid.NewV4()).String()
+		}
+
+		mockScheduleDA.EXPECT().Insert(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, schedule *models.Schedule) error {
+				schedule.UUID = uuid.Must(uuid.NewV4()).String()
+				return nil
+			}).Times(n)
+		mockTxRequestDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil).Times(n)
+
+		jobIdx := 0
+		mockCreateJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).
+			DoAndReturn(func(_ context.Context, job *entities.Job, _ *multitenancy.UserInfo) (*entities.Job, error) {
+				assert.Equal(t, chain.UUID, job.ChainUUID)
+				job.UUID = jobUUIDs[jobIdx]
+				job.Status = entities.StatusCreated
+				jobIdx++
+				return job, nil
+			}).Times(n)
+		mockDBTX.EXPECT().Commit().Return(nil)
+
+		return jobUUIDs
+	}
+
+	t.Run("should create every transaction atomically and start them at once", func(t *testing.T) {
+		txRequests := newTxRequests("key1", "")
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{chain.Name}}, userInfo).
+			Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequests[0].Params.ContractName, txRequests[0].Params.ContractTag, userInfo).
+			Return(contract, nil)
+		mockTxRequestDA.EXPECT().FindOneByIdempotencyKey(gomock.Any(), "key1", userInfo.TenantID, userInfo.Username).
+			Return(nil, errors.NotFoundError("not found"))
+		jobUUIDs := expectInsert(2)
+		mockGetFaucetCandidateUC.EXPECT().Execute(gomock.Any(), *txRequests[0].Params.From, chain, userInfo).
+			Return(nil, faucetNotFoundErr)
+		mockStartJobsUC.EXPECT().Execute(gomock.Any(), jobUUIDs, userInfo).Return(nil)
+
+		results, err := usecase.Execute(ctx, txRequests, userInfo)
+
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		for idx, result := range results {
+			assert.NoError(t, result.Err)
+			assert.Equal(t, txRequests[idx], result.TxRequest)
+			assert.NotEmpty(t, result.TxRequest.Schedule.UUID)
+			assert.Equal(t, jobUUIDs[idx], result.TxRequest.Schedule.Jobs[0].UUID)
+			assert.Equal(t, entities.StatusStarted, result.TxRequest.Schedule.Jobs[0].Status)
+		}
+		assert.NotEqual(t, results[0].TxRequest.Schedule.UUID, results[1].TxRequest.Schedule.UUID)
+	})
+
+	t.Run("should fund the sender account once before starting the transactions", func(t *testing.T) {
+		txRequests := newTxRequests("", "")
+		faucet := testutils3.FakeFaucet()
+		faucetJobUUID := uuid.Must(uuid.NewV4()).String()
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), userInfo).Return(contract, nil)
+		jobUUIDs := expectInsert(2)
+		gomock.InOrder(
+			mockGetFaucetCandidateUC.EXPECT().Execute(gomock.Any(), *txRequests[0].Params.From, chain, userInfo).Return(faucet, nil),
+			mockCreateJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), internalAdminUser).
+				DoAndReturn(func(_ context.Context, job *entities.Job, _ *multitenancy.UserInfo) (*entities.Job, error) {
+					assert.Equal(t, faucet.CreditorAccount, *job.Transaction.From)
+					assert.Equal(t, *txRequests[0].Params.From, *job.Transaction.To)
+					assert.Equal(t, faucet.UUID, job.Labels["faucetUUID"])
+					assert.True(t, job.InternalData.Faucet)
+					assert.Equal(t, txRequests[0].Schedule.UUID, job.ScheduleUUID)
+					job.UUID = faucetJobUUID
+					return job, nil
+				}),
+			mockStartJobsUC.EXPECT().Execute(gomock.Any(), []string{faucetJobUUID}, internalAdminUser).Return(nil),
+			mockStartJobsUC.EXPECT().Execute(gomock.Any(), jobUUIDs, userInfo).Return(nil),
+		)
+
+		results, err := usecase.Execute(ctx, txRequests, userInfo)
+
+		require.NoError(t, err)
+		require.Len(t, results[0].TxRequest.Schedule.Jobs, 2)
+		assert.Equal(t, faucetJobUUID, results[0].TxRequest.Schedule.Jobs[1].UUID)
+		assert.Len(t, results[1].TxRequest.Schedule.Jobs, 1)
+	})
+
+	t.Run("should return existing transactions and conflicts per item", func(t *testing.T) {
+		txRequests := newTxRequests("existing", "conflict", "new")
+		requestHash, _ := generateRequestHash(chain.UUID, txRequests[0].Params)
+
+		existingModel := testutils2.FakeTxRequest(0)
+		existingModel.RequestHash = requestHash
+		conflictModel := testutils2.FakeTxRequest(0)
+		conflictModel.RequestHash = "another-hash"
+		existingTxRequest := testutils3.FakeTxRequest()
+		existingTxRequest.Schedule.Jobs[0].Status = entities.StatusPending
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), userInfo).Return(contract, nil)
+		mockTxRequestDA.EXPECT().FindOneByIdempotencyKey(gomock.Any(), "existing", userInfo.TenantID, userInfo.Username).
+			Return(existingModel, nil)
+		mockGetTxUC.EXPECT().Execute(gomock.Any(), existingModel.Schedule.UUID, userInfo).Return(existingTxRequest, nil)
+		mockTxRequestDA.EXPECT().FindOneByIdempotencyKey(gomock.Any(), "conflict", userInfo.TenantID, userInfo.Username).
+			Return(conflictModel, nil)
+		mockTxRequestDA.EXPECT().FindOneByIdempotencyKey(gomock.Any(), "new", userInfo.TenantID, userInfo.Username).
+			Return(nil, errors.NotFoundError("not found"))
+		jobUUIDs := expectInsert(1)
+		mockGetFaucetCandidateUC.EXPECT().Execute(gomock.Any(), gomock.Any(), chain, userInfo).Return(nil, faucetNotFoundErr)
+		mockStartJobsUC.EXPECT().Execute(gomock.Any(), jobUUIDs, userInfo).Return(nil)
+
+		results, err := usecase.Execute(ctx, txRequests, userInfo)
+
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		assert.NoError(t, results[0].Err)
+		assert.Equal(t, existingTxRequest, results[0].TxRequest)
+		assert.True(t, errors.IsAlreadyExistsError(results[1].Err))
+		assert.Equal(t, "conflict", results[1].TxRequest.IdempotencyKey)
+		assert.NoError(t, results[2].Err)
+		assert.Equal(t, jobUUIDs[0], results[2].TxRequest.Schedule.Jobs[0].UUID)
+	})
+
+	t.Run("should fail without creating anything if one transaction is invalid", func(t *testing.T) {
+		txRequests := newTxRequests("", "")
+		txRequests[1].Params.MethodSignature = "unknown()"
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), userInfo).Return(contract, nil)
+
+		_, err := usecase.Execute(ctx, txRequests, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+		assert.Contains(t, err.Error(), "transaction 1: method not found")
+	})
+
+	t.Run("should fail if chain does not exist", func(t *testing.T) {
+		txRequests := newTxRequests("")
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{}, nil)
+
+		_, err := usecase.Execute(ctx, txRequests, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail if the same idempotency key is used twice", func(t *testing.T) {
+		txRequests := newTxRequests("key", "key")
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), userInfo).Return(contract, nil)
+
+		_, err := usecase.Execute(ctx, txRequests, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail and rollback if a job cannot be created", func(t *testing.T) {
+		txRequests := newTxRequests("")
+		expectedErr := fmt.Errorf("error")
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), userInfo).Return(contract, nil)
+		mockScheduleDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockTxRequestDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockCreateJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return(nil, expectedErr)
+
+		_, err := usecase.Execute(ctx, txRequests, userInfo)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail if jobs cannot be started", func(t *testing.T) {
+		txRequests := newTxRequests("")
+		expectedErr := errors.KafkaConnectionError("error")
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), userInfo).Return(contract, nil)
+		expectInsert(1)
+		mockGetFaucetCandidateUC.EXPECT().Execute(gomock.Any(), gomock.Any(), chain, userInfo).Return(nil, faucetNotFoundErr)
+		mockStartJobsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return(expectedErr)
+
+		_, err := usecase.Execute(ctx, txRequests, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(sendContractTxsComponent), err)
+	})
+}
@@ -3,12 +3,11 @@ package transactions
 import (
 	"context"
 
-	"github.com/umbracle/go-web3/abi"
-
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
 	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
 )
 
@@ -40,7 +39,7 @@ func (uc *sendDeployTxUsecase) Execute(ctx context.Context, txRequest *entities.
 	logger := uc.logger.WithContext(ctx)
 	logger.Debug("creating new deployment transaction")
 
-	contract, err := uc.getContractUseCase.Execute(ctx, txRequest.Params.ContractName, txRequest.Params.ContractTag)
+	contract, err := uc.getContractUseCase.Execute(ctx, txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo)
 	if errors.IsNotFoundError(err) {
 		return nil, errors.InvalidParameterError("contract not found")
 	}
@@ -48,29 +47,11 @@ func (uc *sendDeployTxUsecase) Execute(ctx context.Context, txRequest *entities.
 		return nil, errors.FromError(err).ExtendComponent(sendDeployTxComponent)
 	}
 
-	if contract.Bytecode == nil || len(contract.Bytecode) == 0 {
-		errMessage := "contract has no bytecode"
-		uc.logger.WithError(err).Error(errMessage)
-		return nil, errors.DataCorruptedError(errMessage).ExtendComponent(sendDeployTxComponent)
-	}
-
-	// TODO: We restrict the usage of web3-go to only generate the txData but ideally we should use it as much as possible and change the ABI type everywhere in the codebase
-	web3ABI, err := abi.NewABI(contract.RawABI)
+	txData, err := parsers.EncodeContractDeployment(contract, txRequest.Params.Args, txRequest.Params.Libraries)
 	if err != nil {
-		errMessage := "failed to parse contract ABI"
-		logger.WithError(err).Error(errMessage)
-		return nil, errors.DataCorruptedError(errMessage).ExtendComponent(sendContractTxComponent)
-	}
-
-	var arguments []byte
-	if web3ABI.Constructor != nil { // It is possible to create a smart contract without constructor
-		arguments, err = abi.Encode(txRequest.Params.Args, web3ABI.Constructor.Inputs)
-		if err != nil {
-			logger.WithError(err).Error("failed to compute tx data from constructor and arguments")
-			return nil, errors.InvalidParameterError(err.Error()).ExtendComponent(sendContractTxComponent)
-		}
+		logger.WithError(err).Error("failed to compute tx data from constructor and arguments")
+		return nil, errors.FromError(err).ExtendComponent(sendDeployTxComponent)
 	}
 
-	txData := append(contract.Bytecode, arguments...)
 	return uc.sendTxUseCase.Execute(ctx, txRequest, txData, userInfo)
 }
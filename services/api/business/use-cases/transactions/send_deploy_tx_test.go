@@ -7,10 +7,14 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
 	testutils2 "github.com/consensys/orchestrate/pkg/types/testutils"
 
 	mocks2 "github.com/consensys/orchestrate/services/api/business/use-cases/mocks"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -33,7 +37,7 @@ func TestSendDeployTx_Execute(t *testing.T) {
 		txRequestResponse := testutils2.FakeTxRequest()
 		fakeContract := testutils2.FakeContract()
 
-		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag).Return(fakeContract, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo).Return(fakeContract, nil)
 		mockSendTxUC.EXPECT().Execute(gomock.Any(), txRequest, gomock.Any(), userInfo).Return(txRequestResponse, nil)
 
 		response, err := usecase.Execute(ctx, txRequest, userInfo)
@@ -42,10 +46,46 @@ func TestSendDeployTx_Execute(t *testing.T) {
 		assert.Equal(t, txRequestResponse, response)
 	})
 
+	t.Run("should link libraries in the bytecode of the contract", func(t *testing.T) {
+		library := ethcommon.HexToAddress("0x1abae27a0cbfb02945720425d3b80c7e09728534")
+		txRequestWithLibraries := testutils2.FakeTxRequest()
+		txRequestWithLibraries.Params.Args = nil
+		txRequestWithLibraries.Params.Libraries = map[string]ethcommon.Address{"SafeMath": library}
+		fakeContract := testutils2.FakeContract()
+		fakeContract.LinkReferences = entities.LinkReferences{
+			"contracts/SafeMath.sol": {"SafeMath": {{Start: 1, Length: ethcommon.AddressLength}}},
+		}
+
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequestWithLibraries.Params.ContractName, txRequestWithLibraries.Params.ContractTag, userInfo).Return(fakeContract, nil)
+		mockSendTxUC.EXPECT().Execute(gomock.Any(), txRequestWithLibraries, gomock.Any(), userInfo).
+			DoAndReturn(func(_ context.Context, _ *entities.TxRequest, txData hexutil.Bytes, _ *multitenancy.UserInfo) (*entities.TxRequest, error) {
+				assert.Equal(t, library.Bytes(), []byte(txData[1:1+ethcommon.AddressLength]))
+				return txRequestWithLibraries, nil
+			})
+
+		_, err := usecase.Execute(ctx, txRequestWithLibraries, userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with InvalidParameterError if a library is missing", func(t *testing.T) {
+		fakeContract := testutils2.FakeContract()
+		fakeContract.LinkReferences = entities.LinkReferences{
+			"contracts/SafeMath.sol": {"SafeMath": {{Start: 1, Length: ethcommon.AddressLength}}},
+		}
+
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo).Return(fakeContract, nil)
+
+		response, err := usecase.Execute(ctx, txRequest, userInfo)
+
+		assert.Nil(t, response)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
 	t.Run("should fail with same error if validator fails", func(t *testing.T) {
 		expectedErr := fmt.Errorf("error")
 
-		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag).Return(nil, expectedErr)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo).Return(nil, expectedErr)
 		response, err := usecase.Execute(ctx, txRequest, userInfo)
 
 		assert.Nil(t, response)
@@ -56,7 +96,7 @@ func TestSendDeployTx_Execute(t *testing.T) {
 		expectedErr := fmt.Errorf("error")
 		fakeContract := testutils2.FakeContract()
 
-		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag).Return(fakeContract, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo).Return(fakeContract, nil)
 		mockSendTxUC.EXPECT().Execute(gomock.Any(), txRequest, gomock.Any(), userInfo).Return(nil, expectedErr)
 
 		response, err := usecase.Execute(ctx, txRequest, userInfo)
@@ -152,32 +152,26 @@ func (uc *sendTxUsecase) insertNewTxRequest(
 	txData []byte, requestHash, chainUUID, tenantID string,
 	userInfo *multitenancy.UserInfo,
 ) (*entities.TxRequest, error) {
+	// Schedule, transaction request and jobs are inserted atomically so that a job rejected by a transaction policy
+	// does not leave an empty transaction request behind
 	err := database.ExecuteInDBTx(uc.db, func(dbtx database.Tx) error {
 		schedule := &models.Schedule{TenantID: tenantID, OwnerID: userInfo.Username}
-		if err := dbtx.(store.Tx).Schedule().Insert(ctx, schedule); err != nil {
-			return err
+		if der := dbtx.(store.Tx).Schedule().Insert(ctx, schedule); der != nil {
+			return der
 		}
 
 		txRequestModel := parsers.NewTxRequestModelFromEntities(txRequest, requestHash, schedule.ID)
-		if err := dbtx.(store.Tx).TransactionRequest().Insert(ctx, txRequestModel); err != nil {
-			return err
+		if der := dbtx.(store.Tx).TransactionRequest().Insert(ctx, txRequestModel); der != nil {
+			return der
 		}
 
 		txRequest.Schedule = parsers.NewScheduleEntityFromModels(schedule)
-		return nil
-	})
-
-	if err != nil {
-		return nil, errors.FromError(err).ExtendComponent(sendTxComponent)
-	}
-
-	sendTxJobs, err := parsers.NewJobEntitiesFromTxRequest(txRequest, chainUUID, txData)
-	if err != nil {
-		return nil, errors.FromError(err).ExtendComponent(sendTxComponent)
-	}
+		sendTxJobs, der := parsers.NewJobEntitiesFromTxRequest(txRequest, chainUUID, txData)
+		if der != nil {
+			return der
+		}
 
-	txRequest.Schedule.Jobs = make([]*entities.Job, len(sendTxJobs))
-	err = database.ExecuteInDBTx(uc.db, func(dbtx database.Tx) error {
+		txRequest.Schedule.Jobs = make([]*entities.Job, len(sendTxJobs))
 		var nextJobUUID string
 		for idx, txJob := range sendTxJobs {
 			if nextJobUUID != "" {
@@ -189,10 +183,9 @@ func (uc *sendTxUsecase) insertNewTxRequest(
 				txJob.NextJobUUID = nextJobUUID
 			}
 
-			var job *entities.Job
-			job, err = uc.createJobUC.WithDBTransaction(dbtx.(store.Tx)).Execute(ctx, txJob, userInfo)
-			if err != nil {
-				return err
+			job, der := uc.createJobUC.WithDBTransaction(dbtx.(store.Tx)).Execute(ctx, txJob, userInfo)
+			if der != nil {
+				return der
 			}
 
 			txRequest.Schedule.Jobs[idx] = job
@@ -223,20 +216,7 @@ func (uc *sendTxUsecase) startFaucetJob(ctx context.Context, account *ethcommon.
 	}
 	logger.WithField("faucet_amount", faucet.Amount).Debug("faucet: credit approved")
 
-	txJob := &entities.Job{
-		ScheduleUUID: scheduleUUID,
-		ChainUUID:    chain.UUID,
-		Type:         entities.EthereumTransaction,
-		Labels: map[string]string{
-			"faucetUUID": faucet.UUID,
-		},
-		InternalData: &entities.InternalData{},
-		Transaction: &entities.ETHTransaction{
-			From:  &faucet.CreditorAccount,
-			To:    account,
-			Value: &faucet.Amount,
-		},
-	}
+	txJob := newFaucetJob(faucet, account, scheduleUUID, chain.UUID)
 
 	internalAdminUser := multitenancy.NewInternalAdminUser()
 	internalAdminUser.TenantID = userInfo.TenantID
@@ -253,6 +233,24 @@ func (uc *sendTxUsecase) startFaucetJob(ctx context.Context, account *ethcommon.
 	return fctJob, nil
 }
 
+// newFaucetJob creates the job crediting the account from the faucet
+func newFaucetJob(faucet *entities.Faucet, account *ethcommon.Address, scheduleUUID, chainUUID string) *entities.Job {
+	return &entities.Job{
+		ScheduleUUID: scheduleUUID,
+		ChainUUID:    chainUUID,
+		Type:         entities.EthereumTransaction,
+		Labels: map[string]string{
+			"faucetUUID": faucet.UUID,
+		},
+		InternalData: &entities.InternalData{Faucet: true},
+		Transaction: &entities.ETHTransaction{
+			From:  &faucet.CreditorAccount,
+			To:    account,
+			Value: &faucet.Amount,
+		},
+	}
+}
+
 func generateRequestHash(chainUUID string, params interface{}) (string, error) {
 	jsonParams, err := json.Marshal(params)
 	if err != nil {
@@ -0,0 +1,114 @@
+package transactions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	ethabi "github.com/consensys/orchestrate/pkg/ethereum/abi"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/ethclient"
+	ethclientutils "github.com/consensys/orchestrate/pkg/toolkit/ethclient/utils"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/business/parsers"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	eth "github.com/ethereum/go-ethereum"
+)
+
+const simulateTxComponent = "use-cases.simulate-tx"
+
+// simulateTxUseCase is a use case to execute a contract transaction with eth_call without sending it
+type simulateTxUseCase struct {
+	searchChainsUC usecases.SearchChainsUseCase
+	getContractUC  usecases.GetContractUseCase
+	ec             ethclient.ContractCaller
+	logger         *log.Logger
+}
+
+// NewSimulateTxUseCase creates a new SimulateTxUseCase
+func NewSimulateTxUseCase(searchChainsUC usecases.SearchChainsUseCase, getContractUC usecases.GetContractUseCase, ec ethclient.ContractCaller) usecases.SimulateTxUseCase {
+	return &simulateTxUseCase{
+		searchChainsUC: searchChainsUC,
+		getContractUC:  getContractUC,
+		ec:             ec,
+		logger:         log.NewLogger().SetComponent(simulateTxComponent),
+	}
+}
+
+// Execute executes the contract transaction at pending state and decodes the revert reason against the contract ABI
+// if it reverts
+func (uc *simulateTxUseCase) Execute(ctx context.Context, txRequest *entities.TxRequest, userInfo *multitenancy.UserInfo) (*entities.TransactionSimulation, error) {
+	ctx = log.WithFields(ctx, log.Field("chain", txRequest.ChainName), log.Field("method", txRequest.Params.MethodSignature))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("simulating transaction")
+
+	chains, err := uc.searchChainsUC.Execute(ctx, &entities.ChainFilters{Names: []string{txRequest.ChainName}}, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(simulateTxComponent)
+	}
+	if len(chains) == 0 {
+		return nil, errors.InvalidParameterError(fmt.Sprintf("chain '%s' does not exist", txRequest.ChainName))
+	}
+
+	contract, err := uc.getContractUC.Execute(ctx, txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo)
+	if errors.IsNotFoundError(err) {
+		return nil, errors.InvalidParameterError("contract not found")
+	}
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(simulateTxComponent)
+	}
+
+	data, err := parsers.EncodeContractCall(contract, txRequest.Params.MethodSignature, txRequest.Params.Args)
+	if err != nil {
+		logger.WithError(err).Error("failed to compute tx data from method signature and arguments")
+		return nil, errors.FromError(err).ExtendComponent(simulateTxComponent)
+	}
+
+	msg := &eth.CallMsg{To: txRequest.Params.To, Data: data}
+	if txRequest.Params.From != nil {
+		msg.From = *txRequest.Params.From
+	}
+	if txRequest.Params.Value != nil {
+		msg.Value = txRequest.Params.Value.ToInt()
+	}
+	if txRequest.Params.Gas != nil {
+		msg.Gas = *txRequest.Params.Gas
+	}
+	if txRequest.Params.GasPrice != nil {
+		msg.GasPrice = txRequest.Params.GasPrice.ToInt()
+	}
+
+	output, err := uc.call(ctx, chains[0].URLs, msg)
+	if errors.IsRevertedError(err) {
+		simulation := &entities.TransactionSimulation{Reverted: true, RevertData: ethclientutils.RevertData(err)}
+		simulation.RevertReason, err = ethabi.DecodeRevertReason(simulation.RevertData, contract.RawABI)
+		if err != nil {
+			logger.WithError(err).Warn("failed to decode revert reason")
+		}
+
+		logger.WithField("reason", simulation.RevertReason).Info("transaction simulation reverted")
+		return simulation, nil
+	}
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(simulateTxComponent)
+	}
+
+	logger.Debug("transaction simulated successfully")
+	return &entities.TransactionSimulation{Output: output}, nil
+}
+
+// call executes the call on the first reachable chain URL
+func (uc *simulateTxUseCase) call(ctx context.Context, uris []string, msg *eth.CallMsg) ([]byte, error) {
+	for _, uri := range uris {
+		result, err := uc.ec.PendingCallContract(ctx, uri, msg)
+		if err != nil && errors.IsConnectionError(err) {
+			uc.logger.WithContext(ctx).WithField("url", uri).WithError(err).Warn("failed to simulate transaction")
+			continue
+		}
+
+		return result, err
+	}
+
+	return nil, errors.EthConnectionError("failed to simulate transaction for all urls")
+}
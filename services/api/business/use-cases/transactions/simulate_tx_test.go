@@ -0,0 +1,127 @@
+// +build unit
+
+package transactions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/ethclient/mock"
+	ethclientutils "github.com/consensys/orchestrate/pkg/toolkit/ethclient/utils"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	testutils2 "github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/services/api/business/use-cases/mocks"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Error(string) revert data of "insufficient balance"
+const errorStringRevertData = "0x08c379a000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000014696e73756666696369656e742062616c616e6365000000000000000000000000"
+
+func TestSimulateTx_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSearchChainsUC := mocks.NewMockSearchChainsUseCase(ctrl)
+	mockGetContractUC := mocks.NewMockGetContractUseCase(ctrl)
+	mockEthClient := mock.NewMockContractCaller(ctrl)
+
+	ctx := context.Background()
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewSimulateTxUseCase(mockSearchChainsUC, mockGetContractUC, mockEthClient)
+
+	chain := testutils2.FakeChain()
+	chainFilters := &entities.ChainFilters{Names: []string{"chain"}}
+
+	t.Run("should execute use case successfully if the transaction does not revert", func(t *testing.T) {
+		txRequest := testutils2.FakeTxRequest()
+		output := hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000000000000000001")
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), chainFilters, userInfo).Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo).
+			Return(testutils2.FakeContract(), nil)
+		mockEthClient.EXPECT().PendingCallContract(gomock.Any(), chain.URLs[0], gomock.Any()).Return(output, nil)
+
+		simulation, err := usecase.Execute(ctx, txRequest, userInfo)
+
+		require.NoError(t, err)
+		assert.False(t, simulation.Reverted)
+		assert.Equal(t, hexutil.Bytes(output), simulation.Output)
+	})
+
+	t.Run("should decode the revert reason if the transaction reverts", func(t *testing.T) {
+		txRequest := testutils2.FakeTxRequest()
+		revertErr := errors.RevertedError("execution reverted")
+		revertErr.Extra = map[string]string{ethclientutils.RevertDataKey: errorStringRevertData}
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), chainFilters, userInfo).Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo).
+			Return(testutils2.FakeContract(), nil)
+		mockEthClient.EXPECT().PendingCallContract(gomock.Any(), chain.URLs[0], gomock.Any()).Return(nil, revertErr)
+
+		simulation, err := usecase.Execute(ctx, txRequest, userInfo)
+
+		require.NoError(t, err)
+		assert.True(t, simulation.Reverted)
+		assert.Equal(t, "insufficient balance", simulation.RevertReason)
+		assert.Equal(t, errorStringRevertData, simulation.RevertData.String())
+	})
+
+	t.Run("should try next url on connection error", func(t *testing.T) {
+		txRequest := testutils2.FakeTxRequest()
+		multiURLsChain := testutils2.FakeChain()
+		multiURLsChain.URLs = []string{"http://node-1:8545", "http://node-2:8545"}
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), chainFilters, userInfo).Return([]*entities.Chain{multiURLsChain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo).
+			Return(testutils2.FakeContract(), nil)
+		mockEthClient.EXPECT().PendingCallContract(gomock.Any(), multiURLsChain.URLs[0], gomock.Any()).Return(nil, errors.EthConnectionError("error"))
+		mockEthClient.EXPECT().PendingCallContract(gomock.Any(), multiURLsChain.URLs[1], gomock.Any()).Return(nil, nil)
+
+		simulation, err := usecase.Execute(ctx, txRequest, userInfo)
+
+		require.NoError(t, err)
+		assert.False(t, simulation.Reverted)
+	})
+
+	t.Run("should fail with InvalidParameterError if the chain does not exist", func(t *testing.T) {
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), chainFilters, userInfo).Return([]*entities.Chain{}, nil)
+
+		simulation, err := usecase.Execute(ctx, testutils2.FakeTxRequest(), userInfo)
+
+		assert.Nil(t, simulation)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if the contract does not exist", func(t *testing.T) {
+		txRequest := testutils2.FakeTxRequest()
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), chainFilters, userInfo).Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo).
+			Return(nil, errors.NotFoundError("error"))
+
+		simulation, err := usecase.Execute(ctx, txRequest, userInfo)
+
+		assert.Nil(t, simulation)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with same error if the call fails", func(t *testing.T) {
+		txRequest := testutils2.FakeTxRequest()
+		expectedErr := errors.EthereumError("error")
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), chainFilters, userInfo).Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo).
+			Return(testutils2.FakeContract(), nil)
+		mockEthClient.EXPECT().PendingCallContract(gomock.Any(), chain.URLs[0], gomock.Any()).Return(nil, expectedErr)
+
+		simulation, err := usecase.Execute(ctx, txRequest, userInfo)
+
+		assert.Nil(t, simulation)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(simulateTxComponent), err)
+	})
+}
@@ -8,4 +8,8 @@ type UseCases interface {
 	FaucetUseCases
 	ChainUseCases
 	ContractUseCases
+	SubscriptionUseCases
+	ApprovalPolicyUseCases
+	TransactionPolicyUseCases
+	TokenUseCases
 }
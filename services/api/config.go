@@ -1,11 +1,16 @@
 package api
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/broker/multi"
 	broker "github.com/consensys/orchestrate/pkg/broker/sarama"
 	qkm "github.com/consensys/orchestrate/pkg/quorum-key-manager"
 	"github.com/consensys/orchestrate/pkg/toolkit/app"
 	authjwt "github.com/consensys/orchestrate/pkg/toolkit/app/auth/jwt/jose"
 	authkey "github.com/consensys/orchestrate/pkg/toolkit/app/auth/key"
+	authutils "github.com/consensys/orchestrate/pkg/toolkit/app/auth/utils"
 	httpmetrics "github.com/consensys/orchestrate/pkg/toolkit/app/http/metrics"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	metricregistry "github.com/consensys/orchestrate/pkg/toolkit/app/metrics/registry"
@@ -18,6 +23,84 @@ import (
 	"github.com/spf13/viper"
 )
 
+func init() {
+	viper.SetDefault(DispatcherIntervalViperKey, dispatcherIntervalDefault)
+	_ = viper.BindEnv(DispatcherIntervalViperKey, dispatcherIntervalEnv)
+	viper.SetDefault(OutboxRelayIntervalViperKey, outboxRelayIntervalDefault)
+	_ = viper.BindEnv(OutboxRelayIntervalViperKey, outboxRelayIntervalEnv)
+	viper.SetDefault(OutboxRelayBatchSizeViperKey, outboxRelayBatchSizeDefault)
+	_ = viper.BindEnv(OutboxRelayBatchSizeViperKey, outboxRelayBatchSizeEnv)
+	viper.SetDefault(TxRequestIngressEnabledViperKey, txRequestIngressEnabledDefault)
+	_ = viper.BindEnv(TxRequestIngressEnabledViperKey, txRequestIngressEnabledEnv)
+	viper.SetDefault(TxRequestIngressGroupNameViperKey, txRequestIngressGroupNameDefault)
+	_ = viper.BindEnv(TxRequestIngressGroupNameViperKey, txRequestIngressGroupNameEnv)
+	viper.SetDefault(TxRequestIngressTenantTopicsViperKey, txRequestIngressTenantTopicsDefault)
+	_ = viper.BindEnv(TxRequestIngressTenantTopicsViperKey, txRequestIngressTenantTopicsEnv)
+	viper.SetDefault(TxRequestIngressSigningKeysViperKey, txRequestIngressSigningKeysDefault)
+	_ = viper.BindEnv(TxRequestIngressSigningKeysViperKey, txRequestIngressSigningKeysEnv)
+	viper.SetDefault(SubscriptionTenantTopicsViperKey, subscriptionTenantTopicsDefault)
+	_ = viper.BindEnv(SubscriptionTenantTopicsViperKey, subscriptionTenantTopicsEnv)
+}
+
+const (
+	dispatcherIntervalFlag     = "scheduled-jobs-dispatcher-interval"
+	DispatcherIntervalViperKey = "scheduled-jobs.dispatcher.interval"
+	dispatcherIntervalDefault  = 5 * time.Second
+	dispatcherIntervalEnv      = "SCHEDULED_JOBS_DISPATCHER_INTERVAL"
+)
+
+const (
+	outboxRelayIntervalFlag     = "outbox-relay-interval"
+	OutboxRelayIntervalViperKey = "outbox.relay.interval"
+	outboxRelayIntervalDefault  = 500 * time.Millisecond
+	outboxRelayIntervalEnv      = "OUTBOX_RELAY_INTERVAL"
+)
+
+const (
+	outboxRelayBatchSizeFlag     = "outbox-relay-batch-size"
+	OutboxRelayBatchSizeViperKey = "outbox.relay.batch-size"
+	outboxRelayBatchSizeDefault  = 100
+	outboxRelayBatchSizeEnv      = "OUTBOX_RELAY_BATCH_SIZE"
+)
+
+const (
+	txRequestIngressEnabledFlag     = "tx-request-ingress-enabled"
+	TxRequestIngressEnabledViperKey = "tx-request.ingress.enabled"
+	txRequestIngressEnabledDefault  = false
+	txRequestIngressEnabledEnv      = "TX_REQUEST_INGRESS_ENABLED"
+)
+
+const (
+	txRequestIngressGroupNameFlag     = "tx-request-ingress-group-name"
+	TxRequestIngressGroupNameViperKey = "tx-request.ingress.group-name"
+	txRequestIngressGroupNameDefault  = "group-api"
+	txRequestIngressGroupNameEnv      = "TX_REQUEST_INGRESS_GROUP_NAME"
+)
+
+const (
+	txRequestIngressTenantTopicsFlag     = "tx-request-ingress-tenant-topics"
+	TxRequestIngressTenantTopicsViperKey = "tx-request.ingress.tenant-topics"
+	txRequestIngressTenantTopicsEnv      = "TX_REQUEST_INGRESS_TENANT_TOPICS"
+)
+
+var txRequestIngressTenantTopicsDefault []string
+
+const (
+	txRequestIngressSigningKeysFlag     = "tx-request-ingress-signing-keys"
+	TxRequestIngressSigningKeysViperKey = "tx-request.ingress.signing-keys"
+	txRequestIngressSigningKeysEnv      = "TX_REQUEST_INGRESS_SIGNING_KEYS"
+)
+
+var txRequestIngressSigningKeysDefault []string
+
+const (
+	subscriptionTenantTopicsFlag     = "subscription-tenant-topics"
+	SubscriptionTenantTopicsViperKey = "subscription.tenant-topics"
+	subscriptionTenantTopicsEnv      = "SUBSCRIPTION_TENANT_TOPICS"
+)
+
+var subscriptionTenantTopicsDefault []string
+
 // Flags register flags for API
 func Flags(f *pflag.FlagSet) {
 	log.Flags(f)
@@ -25,27 +108,115 @@ func Flags(f *pflag.FlagSet) {
 	authjwt.Flags(f)
 	authkey.Flags(f)
 	broker.KafkaProducerFlags(f)
+	multi.Flags(f)
 	broker.KafkaTopicTxSender(f)
+	broker.KafkaTopicTxRecover(f)
+	broker.KafkaTopicTxRequest(f)
 	qkm.Flags(f)
 	store.Flags(f)
 	app.Flags(f)
 	app.MetricFlags(f)
 	metricregistry.Flags(f, httpmetrics.ModuleName, tcpmetrics.ModuleName, metrics.ModuleName)
 	proxy.Flags(f)
+	dispatcherInterval(f)
+	outboxRelayInterval(f)
+	outboxRelayBatchSize(f)
+	txRequestIngressEnabled(f)
+	txRequestIngressGroupName(f)
+	txRequestIngressTenantTopics(f)
+	txRequestIngressSigningKeys(f)
+	subscriptionTenantTopics(f)
+}
+
+func dispatcherInterval(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Interval at which scheduled jobs are checked against their execution conditions (0 disables dispatching). Environment variable: %q`, dispatcherIntervalEnv)
+	f.Duration(dispatcherIntervalFlag, dispatcherIntervalDefault, desc)
+	_ = viper.BindPFlag(DispatcherIntervalViperKey, f.Lookup(dispatcherIntervalFlag))
+}
+
+func outboxRelayInterval(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Interval at which job messages waiting in the outbox are published to Kafka. Environment variable: %q`, outboxRelayIntervalEnv)
+	f.Duration(outboxRelayIntervalFlag, outboxRelayIntervalDefault, desc)
+	_ = viper.BindPFlag(OutboxRelayIntervalViperKey, f.Lookup(outboxRelayIntervalFlag))
+}
+
+func outboxRelayBatchSize(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Maximum number of outbox messages published to Kafka in a single batch. Environment variable: %q`, outboxRelayBatchSizeEnv)
+	f.Int(outboxRelayBatchSizeFlag, outboxRelayBatchSizeDefault, desc)
+	_ = viper.BindPFlag(OutboxRelayBatchSizeViperKey, f.Lookup(outboxRelayBatchSizeFlag))
+}
+
+func txRequestIngressEnabled(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Enables the submission of transaction requests produced to the Kafka tx-request topic. Environment variable: %q`, txRequestIngressEnabledEnv)
+	f.Bool(txRequestIngressEnabledFlag, txRequestIngressEnabledDefault, desc)
+	_ = viper.BindPFlag(TxRequestIngressEnabledViperKey, f.Lookup(txRequestIngressEnabledFlag))
+}
+
+func txRequestIngressGroupName(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Kafka consumer group name used to consume transaction requests. Environment variable: %q`, txRequestIngressGroupNameEnv)
+	f.String(txRequestIngressGroupNameFlag, txRequestIngressGroupNameDefault, desc)
+	_ = viper.BindPFlag(TxRequestIngressGroupNameViperKey, f.Lookup(txRequestIngressGroupNameFlag))
+}
+
+func txRequestIngressTenantTopics(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Additional transaction request topics dedicated to a tenant, as <topic>=<tenantID> entries. Messages produced to these topics are submitted on behalf of the tenant, username headers are ignored. Environment variable: %q`, txRequestIngressTenantTopicsEnv)
+	f.StringSlice(txRequestIngressTenantTopicsFlag, txRequestIngressTenantTopicsDefault, desc)
+	_ = viper.BindPFlag(TxRequestIngressTenantTopicsViperKey, f.Lookup(txRequestIngressTenantTopicsFlag))
+}
+
+func txRequestIngressSigningKeys(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`HMAC keys of the tenants allowed to produce to the Kafka tx-request topic, as <tenantID>=<key> entries. Messages carry the %q and optional %q headers, and the hex encoded HMAC-SHA256 of the tenant, the username and the message value in the %q header. Environment variable: %q`,
+		authutils.TenantIDHeader, authutils.UsernameHeader, authutils.SignatureHeader, txRequestIngressSigningKeysEnv)
+	f.StringSlice(txRequestIngressSigningKeysFlag, txRequestIngressSigningKeysDefault, desc)
+	_ = viper.BindPFlag(TxRequestIngressSigningKeysViperKey, f.Lookup(txRequestIngressSigningKeysFlag))
+}
+
+func subscriptionTenantTopics(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Kafka topics subscription events can be produced to, as <topic>=<tenantID> entries. A subscription can only target the topics of its tenant. Environment variable: %q`, subscriptionTenantTopicsEnv)
+	f.StringSlice(subscriptionTenantTopicsFlag, subscriptionTenantTopicsDefault, desc)
+	_ = viper.BindPFlag(SubscriptionTenantTopicsViperKey, f.Lookup(subscriptionTenantTopicsFlag))
 }
 
 type Config struct {
-	App          *app.Config
-	Store        *store.Config
-	Multitenancy bool
-	Proxy        *proxy.Config
+	App                      *app.Config
+	Store                    *store.Config
+	Multitenancy             bool
+	Proxy                    *proxy.Config
+	DispatcherInterval       time.Duration
+	OutboxRelay              *OutboxRelayConfig
+	TxRequestIngress         *TxRequestIngressConfig
+	SubscriptionTenantTopics []string
+}
+
+type OutboxRelayConfig struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+type TxRequestIngressConfig struct {
+	Enabled      bool
+	GroupName    string
+	TenantTopics []string
+	SigningKeys  []string
 }
 
 func NewConfig(vipr *viper.Viper) *Config {
 	return &Config{
-		App:          app.NewConfig(vipr),
-		Store:        store.NewConfig(vipr),
-		Multitenancy: viper.GetBool(multitenancy.EnabledViperKey),
-		Proxy:        proxy.NewConfig(),
+		App:                app.NewConfig(vipr),
+		Store:              store.NewConfig(vipr),
+		Multitenancy:       viper.GetBool(multitenancy.EnabledViperKey),
+		Proxy:              proxy.NewConfig(),
+		DispatcherInterval: vipr.GetDuration(DispatcherIntervalViperKey),
+		OutboxRelay: &OutboxRelayConfig{
+			Interval:  vipr.GetDuration(OutboxRelayIntervalViperKey),
+			BatchSize: vipr.GetInt(OutboxRelayBatchSizeViperKey),
+		},
+		TxRequestIngress: &TxRequestIngressConfig{
+			Enabled:      vipr.GetBool(TxRequestIngressEnabledViperKey),
+			GroupName:    vipr.GetString(TxRequestIngressGroupNameViperKey),
+			TenantTopics: vipr.GetStringSlice(TxRequestIngressTenantTopicsViperKey),
+			SigningKeys:  vipr.GetStringSlice(TxRequestIngressSigningKeysViperKey),
+		},
+		SubscriptionTenantTopics: vipr.GetStringSlice(SubscriptionTenantTopicsViperKey),
 	}
 }
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+)
+
+const dispatcherComponent = "api.dispatcher"
+
+// dispatcher periodically starts the scheduled jobs whose execution conditions are met
+type dispatcher struct {
+	dispatchJobsUC usecases.DispatchScheduledJobsUseCase
+	interval       time.Duration
+	logger         *log.Logger
+}
+
+func DispatcherOpt(dispatchJobsUC usecases.DispatchScheduledJobsUseCase, interval time.Duration) app.Option {
+	return func(ap *app.App) error {
+		if interval == 0 {
+			return nil
+		}
+
+		ap.RegisterDaemon(&dispatcher{
+			dispatchJobsUC: dispatchJobsUC,
+			interval:       interval,
+			logger:         log.NewLogger().SetComponent(dispatcherComponent),
+		})
+		return nil
+	}
+}
+
+func (d *dispatcher) Run(ctx context.Context) error {
+	d.logger.WithField("interval", d.interval.String()).Info("scheduled jobs dispatcher started")
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := d.dispatchJobsUC.Execute(ctx); err != nil {
+				d.logger.WithError(err).Error("failed to dispatch scheduled jobs")
+			}
+		case <-ctx.Done():
+			d.logger.Info("scheduled jobs dispatcher stopped")
+			return nil
+		}
+	}
+}
+
+func (d *dispatcher) Close() error {
+	return nil
+}
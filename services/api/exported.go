@@ -8,6 +8,7 @@ import (
 
 	qkm "github.com/consensys/orchestrate/pkg/quorum-key-manager"
 
+	"github.com/consensys/orchestrate/pkg/broker/multi"
 	"github.com/consensys/orchestrate/pkg/broker/sarama"
 	"github.com/consensys/orchestrate/pkg/toolkit/app"
 	authkey "github.com/consensys/orchestrate/pkg/toolkit/app/auth/key"
@@ -20,7 +21,7 @@ func New(ctx context.Context) (*app.App, error) {
 	// Initialize dependencies
 	authjwt.Init(ctx)
 	authkey.Init(ctx)
-	sarama.InitSyncProducer(ctx)
+	multi.Init(ctx)
 	ethclient.Init(ctx)
 	qkm.Init()
 
@@ -35,7 +36,7 @@ func New(ctx context.Context) (*app.App, error) {
 		qkm.GlobalClient(),
 		qkm.GlobalStoreName(),
 		ethclient.GlobalClient(),
-		sarama.GlobalSyncProducer(),
+		multi.GlobalBroker(),
 		sarama.NewKafkaTopicConfig(viper.GetViper()),
 	)
 }
@@ -10,6 +10,7 @@ import (
 
 	authjwt "github.com/consensys/orchestrate/pkg/toolkit/app/auth/jwt"
 
+	"github.com/consensys/orchestrate/pkg/broker/multi"
 	"github.com/consensys/orchestrate/pkg/broker/sarama"
 	qkm "github.com/consensys/orchestrate/pkg/quorum-key-manager"
 	"github.com/consensys/orchestrate/pkg/toolkit/app"
@@ -411,7 +412,7 @@ func newAPI(ctx context.Context, topicCfg *sarama.KafkaTopicConfig) (*app.App, e
 	// Initialize dependencies
 	authjwt.Init(ctx)
 	authkey.Init(ctx)
-	sarama.InitSyncProducer(ctx)
+	multi.Init(ctx)
 	ethclient.Init(ctx)
 	qkm.Init()
 
@@ -428,7 +429,7 @@ func newAPI(ctx context.Context, topicCfg *sarama.KafkaTopicConfig) (*app.App, e
 		qkm.GlobalClient(),
 		qkm.GlobalStoreName(),
 		ethclient.GlobalClient(),
-		sarama.GlobalSyncProducer(),
+		multi.GlobalBroker(),
 		topicCfg,
 	)
 }
@@ -1,3 +1,4 @@
+//go:build integration
 // +build integration
 
 package integrationtests
@@ -106,7 +107,7 @@ func (s *metricsTestSuite) TestHTTP() {
 func (s *metricsTestSuite) TestZHealthCheck() {
 	type healthRes struct {
 		Database string `json:"database,omitempty"`
-		Kafka    string `json:"kafka,omitempty"`
+		Broker   string `json:"broker,omitempty"`
 	}
 
 	httpClient := http.NewClient(http.NewDefaultConfig())
@@ -127,7 +128,7 @@ func (s *metricsTestSuite) TestZHealthCheck() {
 
 		assert.NoError(s.T(), err)
 		assert.Equal(s.T(), "OK", status.Database)
-		assert.Equal(s.T(), "OK", status.Kafka)
+		assert.Equal(s.T(), "OK", status.Broker)
 	})
 
 	s.T().Run("should retrieve a negative health check over kafka service", func(t *testing.T) {
@@ -152,7 +153,7 @@ func (s *metricsTestSuite) TestZHealthCheck() {
 
 		assert.NoError(s.T(), err)
 		assert.Equal(s.T(), "OK", status.Database)
-		assert.NotEqual(s.T(), "OK", status.Kafka)
+		assert.NotEqual(s.T(), "OK", status.Broker)
 	})
 
 	s.T().Run("should retrieve a negative health check over postgres service", func(t *testing.T) {
@@ -177,7 +178,7 @@ func (s *metricsTestSuite) TestZHealthCheck() {
 
 		assert.NoError(s.T(), err)
 		assert.NotEqual(s.T(), "OK", status.Database)
-		assert.Equal(s.T(), "OK", status.Kafka)
+		assert.Equal(s.T(), "OK", status.Broker)
 	})
 }
 
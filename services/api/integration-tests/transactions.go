@@ -152,6 +152,45 @@ func (s *transactionsTestSuite) TestSuccess() {
 		assert.Equal(t, evlp.PartitionKey(), "")
 	})
 
+	s.T().Run("should send a batch of contract transactions successfully", func(t *testing.T) {
+		txsRequest := &api.SendTransactionsRequest{}
+		for i := 0; i < 3; i++ {
+			txRequest := testutils.FakeSendTransactionRequest()
+			txRequest.Params.From = nil
+			txRequest.Params.OneTimeKey = true
+			txRequest.Params.ContractTag = s.contract.Tag
+			txRequest.Params.ContractName = s.contract.Name
+			txsRequest.Transactions = append(txsRequest.Transactions, &api.SendTransactionsItem{
+				IdempotencyKey:         utils.RandString(16),
+				SendTransactionRequest: *txRequest,
+			})
+		}
+
+		txsResponse, err := s.client.SendContractTransactions(ctx, txsRequest)
+		require.NoError(t, err)
+		require.Len(t, txsResponse.Transactions, 3)
+
+		for idx, item := range txsResponse.Transactions {
+			require.Empty(t, item.Error)
+			assert.Equal(t, txsRequest.Transactions[idx].IdempotencyKey, item.IdempotencyKey)
+
+			job := item.Transaction.Jobs[0]
+			assert.Equal(t, entities.StatusStarted, job.Status)
+
+			evlp, err := s.env.consumer.WaitForEnvelope(job.ScheduleUUID, s.env.kafkaTopicConfig.Sender, waitForEnvelopeTimeOut)
+			require.NoError(t, err)
+			assert.Equal(t, job.UUID, evlp.GetJobUUID())
+		}
+
+		// Sending the same batch again returns the existing transactions
+		txsRequest.Transactions[0].Params.Args = []interface{}{"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18", 1}
+		txsResponseRetry, err := s.client.SendContractTransactions(ctx, txsRequest)
+		require.NoError(t, err)
+		assert.NotEmpty(t, txsResponseRetry.Transactions[0].Error)
+		assert.Equal(t, txsResponse.Transactions[1].Transaction.UUID, txsResponseRetry.Transactions[1].Transaction.UUID)
+		assert.Equal(t, txsResponse.Transactions[2].Transaction.UUID, txsResponseRetry.Transactions[2].Transaction.UUID)
+	})
+
 	s.T().Run("should send a tessera transaction successfully", func(t *testing.T) {
 		txRequest := testutils.FakeSendTesseraRequest()
 		txRequest.Params.ContractTag = s.contract.Tag
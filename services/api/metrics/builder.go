@@ -5,17 +5,35 @@ import (
 )
 
 type metrics struct {
-	jobsLatencyHistogram  kitmetrics.Histogram
-	minedLatencyHistogram kitmetrics.Histogram
+	jobsLatencyHistogram          kitmetrics.Histogram
+	minedLatencyHistogram         kitmetrics.Histogram
+	outboxLagGauge                kitmetrics.Gauge
+	outboxPendingGauge            kitmetrics.Gauge
+	outboxPublishLatencyHistogram kitmetrics.Histogram
+	chainNodeHealthyGauge         kitmetrics.Gauge
+	chainNodeBlockLagGauge        kitmetrics.Gauge
+	chainNodeLatencyGauge         kitmetrics.Gauge
 }
 
 func buildMetrics(
 	jobsLatencyHistogram,
 	minedLatencyHistogram kitmetrics.Histogram,
+	outboxLagGauge,
+	outboxPendingGauge kitmetrics.Gauge,
+	outboxPublishLatencyHistogram kitmetrics.Histogram,
+	chainNodeHealthyGauge,
+	chainNodeBlockLagGauge,
+	chainNodeLatencyGauge kitmetrics.Gauge,
 ) *metrics {
 	return &metrics{
-		jobsLatencyHistogram:  jobsLatencyHistogram,
-		minedLatencyHistogram: minedLatencyHistogram,
+		jobsLatencyHistogram:          jobsLatencyHistogram,
+		minedLatencyHistogram:         minedLatencyHistogram,
+		outboxLagGauge:                outboxLagGauge,
+		outboxPendingGauge:            outboxPendingGauge,
+		outboxPublishLatencyHistogram: outboxPublishLatencyHistogram,
+		chainNodeHealthyGauge:         chainNodeHealthyGauge,
+		chainNodeBlockLagGauge:        chainNodeBlockLagGauge,
+		chainNodeLatencyGauge:         chainNodeLatencyGauge,
 	}
 }
 
@@ -26,3 +44,27 @@ func (r *metrics) JobsLatencyHistogram() kitmetrics.Histogram {
 func (r *metrics) MinedLatencyHistogram() kitmetrics.Histogram {
 	return r.minedLatencyHistogram
 }
+
+func (r *metrics) OutboxLagGauge() kitmetrics.Gauge {
+	return r.outboxLagGauge
+}
+
+func (r *metrics) OutboxPendingGauge() kitmetrics.Gauge {
+	return r.outboxPendingGauge
+}
+
+func (r *metrics) OutboxPublishLatencyHistogram() kitmetrics.Histogram {
+	return r.outboxPublishLatencyHistogram
+}
+
+func (r *metrics) ChainNodeHealthyGauge() kitmetrics.Gauge {
+	return r.chainNodeHealthyGauge
+}
+
+func (r *metrics) ChainNodeBlockLagGauge() kitmetrics.Gauge {
+	return r.chainNodeBlockLagGauge
+}
+
+func (r *metrics) ChainNodeLatencyGauge() kitmetrics.Gauge {
+	return r.chainNodeLatencyGauge
+}
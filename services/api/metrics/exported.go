@@ -14,5 +14,11 @@ var ModuleName = fmt.Sprintf("%s_%s", pkgmetrics.Namespace, Subsystem)
 type TransactionSchedulerMetrics interface {
 	JobsLatencyHistogram() kitmetrics.Histogram
 	MinedLatencyHistogram() kitmetrics.Histogram
+	OutboxLagGauge() kitmetrics.Gauge
+	OutboxPendingGauge() kitmetrics.Gauge
+	OutboxPublishLatencyHistogram() kitmetrics.Histogram
+	ChainNodeHealthyGauge() kitmetrics.Gauge
+	ChainNodeBlockLagGauge() kitmetrics.Gauge
+	ChainNodeLatencyGauge() kitmetrics.Gauge
 	pkgmetrics.Prometheus
 }
@@ -12,6 +12,12 @@ const (
 	Subsystem           = "api"
 	JobLatencySeconds   = "job_latency_seconds"
 	MinedLatencySeconds = "mined_latency_seconds"
+	OutboxLagSeconds    = "outbox_lag_seconds"
+	OutboxPending       = "outbox_pending_messages"
+	OutboxLatency       = "outbox_publish_latency_seconds"
+	ChainNodeHealthy    = "chain_node_healthy"
+	ChainNodeBlockLag   = "chain_node_block_lag"
+	ChainNodeLatency    = "chain_node_latency_seconds"
 )
 
 type tpcMetrics struct {
@@ -46,11 +52,84 @@ func NewTransactionSchedulerMetrics() TransactionSchedulerMetrics {
 	)
 	multi.Collectors = append(multi.Collectors, minedLatencyHistogram)
 
+	outboxLagGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metrics1.Namespace,
+			Subsystem: Subsystem,
+			Name:      OutboxLagSeconds,
+			Help:      "Age of the oldest message waiting in the outbox (second)",
+		},
+		[]string{},
+	)
+	multi.Collectors = append(multi.Collectors, outboxLagGauge)
+
+	outboxPendingGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metrics1.Namespace,
+			Subsystem: Subsystem,
+			Name:      OutboxPending,
+			Help:      "Number of messages waiting in the outbox",
+		},
+		[]string{},
+	)
+	multi.Collectors = append(multi.Collectors, outboxPendingGauge)
+
+	outboxPublishLatencyHistogram := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metrics1.Namespace,
+			Subsystem: Subsystem,
+			Name:      OutboxLatency,
+			Help:      "Histogram of latency between the insertion of a message in the outbox and its publication (second)",
+			Buckets:   []float64{.1, .5, 1, 5, 10, 30},
+		},
+		[]string{"topic"},
+	)
+	multi.Collectors = append(multi.Collectors, outboxPublishLatencyHistogram)
+
+	chainNodeHealthyGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metrics1.Namespace,
+			Subsystem: Subsystem,
+			Name:      ChainNodeHealthy,
+			Help:      "Health of the chain nodes (1 if healthy, 0 otherwise)",
+		},
+		[]string{"chain_uuid", "node"},
+	)
+	multi.Collectors = append(multi.Collectors, chainNodeHealthyGauge)
+
+	chainNodeBlockLagGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metrics1.Namespace,
+			Subsystem: Subsystem,
+			Name:      ChainNodeBlockLag,
+			Help:      "Number of blocks a chain node is behind the highest head of its peers",
+		},
+		[]string{"chain_uuid", "node"},
+	)
+	multi.Collectors = append(multi.Collectors, chainNodeBlockLagGauge)
+
+	chainNodeLatencyGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metrics1.Namespace,
+			Subsystem: Subsystem,
+			Name:      ChainNodeLatency,
+			Help:      "Average latency of the requests to a chain node (second)",
+		},
+		[]string{"chain_uuid", "node"},
+	)
+	multi.Collectors = append(multi.Collectors, chainNodeLatencyGauge)
+
 	return &tpcMetrics{
 		Collector: multi,
 		metrics: buildMetrics(
 			kitprometheus.NewHistogram(jobsLatencyHistogram),
 			kitprometheus.NewHistogram(minedLatencyHistogram),
+			kitprometheus.NewGauge(outboxLagGauge),
+			kitprometheus.NewGauge(outboxPendingGauge),
+			kitprometheus.NewHistogram(outboxPublishLatencyHistogram),
+			kitprometheus.NewGauge(chainNodeHealthyGauge),
+			kitprometheus.NewGauge(chainNodeBlockLagGauge),
+			kitprometheus.NewGauge(chainNodeLatencyGauge),
 		),
 	}
 }
@@ -61,6 +140,12 @@ func NewTransactionSchedulerNopMetrics() TransactionSchedulerMetrics {
 		metrics: buildMetrics(
 			discard.NewHistogram(),
 			discard.NewHistogram(),
+			discard.NewGauge(),
+			discard.NewGauge(),
+			discard.NewHistogram(),
+			discard.NewGauge(),
+			discard.NewGauge(),
+			discard.NewGauge(),
 		),
 	}
 }
@@ -32,10 +32,37 @@ func TestTransactionSchedulerMetrics(t *testing.T) {
 		With("prev_status", "created").
 		Observe(1)
 
+	ep.OutboxLagGauge().Set(1)
+	ep.OutboxPendingGauge().Set(2)
+	ep.OutboxPublishLatencyHistogram().With("topic", "topic-tx-sender").Observe(1)
+
 	families, err := registry.Gather()
 	require.NoError(t, err, "Gathering metrics should not error")
-	require.Len(t, families, 2, "Count of metrics families should be correct")
+	require.Len(t, families, 5, "Count of metrics families should be correct")
 
 	testutils.AssertHistogramFamily(t, families[0], fmt.Sprintf("%s_%s", metrics1.Namespace, Subsystem), JobLatencySeconds, []uint64{1}, "Histogram of job latency between status (second). Except PENDING and MINED, see mined_latency_seconds", nil)
 	testutils.AssertHistogramFamily(t, families[1], fmt.Sprintf("%s_%s", metrics1.Namespace, Subsystem), MinedLatencySeconds, []uint64{1}, "Histogram of latency between PENDING and MINED (second)", nil)
+	testutils.AssertGaugeFamily(t, families[2], fmt.Sprintf("%s_%s", metrics1.Namespace, Subsystem), OutboxLagSeconds, []float64{1}, "Age of the oldest message waiting in the outbox (second)", nil)
+	testutils.AssertGaugeFamily(t, families[3], fmt.Sprintf("%s_%s", metrics1.Namespace, Subsystem), OutboxPending, []float64{2}, "Number of messages waiting in the outbox", nil)
+	testutils.AssertHistogramFamily(t, families[4], fmt.Sprintf("%s_%s", metrics1.Namespace, Subsystem), OutboxLatency, []uint64{1}, "Histogram of latency between the insertion of a message in the outbox and its publication (second)", nil)
+}
+
+func TestChainNodeMetrics(t *testing.T) {
+	ep := NewTransactionSchedulerMetrics()
+
+	registry := prometheus.NewRegistry()
+	err := registry.Register(ep)
+	assert.NoError(t, err, "Registering TransactionSchedulerMetrics should not fail")
+
+	ep.ChainNodeBlockLagGauge().With("chain_uuid", "chain_uuid", "node", "http://node:8545").Set(3)
+	ep.ChainNodeHealthyGauge().With("chain_uuid", "chain_uuid", "node", "http://node:8545").Set(1)
+	ep.ChainNodeLatencyGauge().With("chain_uuid", "chain_uuid", "node", "http://node:8545").Set(0.5)
+
+	families, err := registry.Gather()
+	require.NoError(t, err, "Gathering metrics should not error")
+	require.Len(t, families, 3, "Count of metrics families should be correct")
+
+	testutils.AssertGaugeFamily(t, families[0], fmt.Sprintf("%s_%s", metrics1.Namespace, Subsystem), ChainNodeBlockLag, []float64{3}, "Number of blocks a chain node is behind the highest head of its peers", nil)
+	testutils.AssertGaugeFamily(t, families[1], fmt.Sprintf("%s_%s", metrics1.Namespace, Subsystem), ChainNodeHealthy, []float64{1}, "Health of the chain nodes (1 if healthy, 0 otherwise)", nil)
+	testutils.AssertGaugeFamily(t, families[2], fmt.Sprintf("%s_%s", metrics1.Namespace, Subsystem), ChainNodeLatency, []float64{0.5}, "Average latency of the requests to a chain node (second)", nil)
 }
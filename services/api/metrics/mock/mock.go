@@ -62,6 +62,90 @@ func (mr *MockTransactionSchedulerMetricsMockRecorder) MinedLatencyHistogram() *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MinedLatencyHistogram", reflect.TypeOf((*MockTransactionSchedulerMetrics)(nil).MinedLatencyHistogram))
 }
 
+// OutboxLagGauge mocks base method
+func (m *MockTransactionSchedulerMetrics) OutboxLagGauge() metrics.Gauge {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutboxLagGauge")
+	ret0, _ := ret[0].(metrics.Gauge)
+	return ret0
+}
+
+// OutboxLagGauge indicates an expected call of OutboxLagGauge
+func (mr *MockTransactionSchedulerMetricsMockRecorder) OutboxLagGauge() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutboxLagGauge", reflect.TypeOf((*MockTransactionSchedulerMetrics)(nil).OutboxLagGauge))
+}
+
+// OutboxPendingGauge mocks base method
+func (m *MockTransactionSchedulerMetrics) OutboxPendingGauge() metrics.Gauge {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutboxPendingGauge")
+	ret0, _ := ret[0].(metrics.Gauge)
+	return ret0
+}
+
+// OutboxPendingGauge indicates an expected call of OutboxPendingGauge
+func (mr *MockTransactionSchedulerMetricsMockRecorder) OutboxPendingGauge() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutboxPendingGauge", reflect.TypeOf((*MockTransactionSchedulerMetrics)(nil).OutboxPendingGauge))
+}
+
+// OutboxPublishLatencyHistogram mocks base method
+func (m *MockTransactionSchedulerMetrics) OutboxPublishLatencyHistogram() metrics.Histogram {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutboxPublishLatencyHistogram")
+	ret0, _ := ret[0].(metrics.Histogram)
+	return ret0
+}
+
+// OutboxPublishLatencyHistogram indicates an expected call of OutboxPublishLatencyHistogram
+func (mr *MockTransactionSchedulerMetricsMockRecorder) OutboxPublishLatencyHistogram() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutboxPublishLatencyHistogram", reflect.TypeOf((*MockTransactionSchedulerMetrics)(nil).OutboxPublishLatencyHistogram))
+}
+
+// ChainNodeHealthyGauge mocks base method
+func (m *MockTransactionSchedulerMetrics) ChainNodeHealthyGauge() metrics.Gauge {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChainNodeHealthyGauge")
+	ret0, _ := ret[0].(metrics.Gauge)
+	return ret0
+}
+
+// ChainNodeHealthyGauge indicates an expected call of ChainNodeHealthyGauge
+func (mr *MockTransactionSchedulerMetricsMockRecorder) ChainNodeHealthyGauge() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainNodeHealthyGauge", reflect.TypeOf((*MockTransactionSchedulerMetrics)(nil).ChainNodeHealthyGauge))
+}
+
+// ChainNodeBlockLagGauge mocks base method
+func (m *MockTransactionSchedulerMetrics) ChainNodeBlockLagGauge() metrics.Gauge {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChainNodeBlockLagGauge")
+	ret0, _ := ret[0].(metrics.Gauge)
+	return ret0
+}
+
+// ChainNodeBlockLagGauge indicates an expected call of ChainNodeBlockLagGauge
+func (mr *MockTransactionSchedulerMetricsMockRecorder) ChainNodeBlockLagGauge() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainNodeBlockLagGauge", reflect.TypeOf((*MockTransactionSchedulerMetrics)(nil).ChainNodeBlockLagGauge))
+}
+
+// ChainNodeLatencyGauge mocks base method
+func (m *MockTransactionSchedulerMetrics) ChainNodeLatencyGauge() metrics.Gauge {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChainNodeLatencyGauge")
+	ret0, _ := ret[0].(metrics.Gauge)
+	return ret0
+}
+
+// ChainNodeLatencyGauge indicates an expected call of ChainNodeLatencyGauge
+func (mr *MockTransactionSchedulerMetricsMockRecorder) ChainNodeLatencyGauge() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainNodeLatencyGauge", reflect.TypeOf((*MockTransactionSchedulerMetrics)(nil).ChainNodeLatencyGauge))
+}
+
 // Describe mocks base method
 func (m *MockTransactionSchedulerMetrics) Describe(arg0 chan<- *prometheus.Desc) {
 	m.ctrl.T.Helper()
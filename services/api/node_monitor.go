@@ -0,0 +1,109 @@
+package api
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/nodehealth"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/metrics"
+)
+
+const nodeMonitorComponent = "api.node-monitor"
+
+// nodeMonitor periodically checks the health of the nodes of every chain
+type nodeMonitor struct {
+	searchChainsUC usecases.SearchChainsUseCase
+	checker        *nodehealth.Checker
+	registry       *nodehealth.Registry
+	metrics        metrics.TransactionSchedulerMetrics
+	interval       time.Duration
+	logger         *log.Logger
+}
+
+func NodeMonitorOpt(searchChainsUC usecases.SearchChainsUseCase, ec nodehealth.Client, registry *nodehealth.Registry,
+	appMetrics metrics.TransactionSchedulerMetrics, cfg *nodehealth.Config) app.Option {
+	return func(ap *app.App) error {
+		if cfg.CheckInterval == 0 {
+			return nil
+		}
+
+		ap.RegisterDaemon(&nodeMonitor{
+			searchChainsUC: searchChainsUC,
+			checker:        nodehealth.NewChecker(ec, registry, cfg.CheckTimeout),
+			registry:       registry,
+			metrics:        appMetrics,
+			interval:       cfg.CheckInterval,
+			logger:         log.NewLogger().SetComponent(nodeMonitorComponent),
+		})
+		return nil
+	}
+}
+
+func (m *nodeMonitor) Run(ctx context.Context) error {
+	m.logger.WithField("interval", m.interval.String()).Info("chain nodes monitor started")
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := m.checkNodes(ctx); err != nil {
+				m.logger.WithError(err).Error("failed to check chain nodes")
+			}
+		case <-ctx.Done():
+			m.logger.Info("chain nodes monitor stopped")
+			return nil
+		}
+	}
+}
+
+func (m *nodeMonitor) Close() error {
+	return nil
+}
+
+func (m *nodeMonitor) checkNodes(ctx context.Context) error {
+	chains, err := m.searchChainsUC.Execute(ctx, &entities.ChainFilters{}, multitenancy.NewInternalAdminUser())
+	if err != nil {
+		return err
+	}
+
+	var urls []string
+	for _, chain := range chains {
+		m.checker.Check(ctx, chain.URLs)
+
+		for _, status := range m.registry.Status(chain.URLs) {
+			labels := []string{"chain_uuid", chain.UUID, "node", redactURL(status.URL)}
+
+			healthy := 0.
+			if status.Healthy {
+				healthy = 1
+			}
+			m.metrics.ChainNodeHealthyGauge().With(labels...).Set(healthy)
+			m.metrics.ChainNodeBlockLagGauge().With(labels...).Set(float64(status.BlockLag))
+			m.metrics.ChainNodeLatencyGauge().With(labels...).Set(status.Latency.Seconds())
+		}
+
+		urls = append(urls, chain.URLs...)
+	}
+
+	m.registry.Retain(urls)
+
+	return nil
+}
+
+// redactURL hides the credentials which might be part of a node URL
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+
+	return u.Redacted()
+}
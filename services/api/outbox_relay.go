@@ -0,0 +1,59 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+)
+
+const outboxRelayComponent = "api.outbox-relay"
+
+// outboxRelay periodically publishes the job messages written in the outbox to Kafka
+type outboxRelay struct {
+	relayMessagesUC usecases.RelayOutboxMessagesUseCase
+	interval        time.Duration
+	logger          *log.Logger
+}
+
+func OutboxRelayOpt(relayMessagesUC usecases.RelayOutboxMessagesUseCase, interval time.Duration) app.Option {
+	return func(ap *app.App) error {
+		// Job messages are only published by the relay, hence it cannot be disabled
+		if interval <= 0 {
+			return errors.InvalidParameterError("outbox relay interval must be positive")
+		}
+
+		ap.RegisterDaemon(&outboxRelay{
+			relayMessagesUC: relayMessagesUC,
+			interval:        interval,
+			logger:          log.NewLogger().SetComponent(outboxRelayComponent),
+		})
+		return nil
+	}
+}
+
+func (r *outboxRelay) Run(ctx context.Context) error {
+	r.logger.WithField("interval", r.interval.String()).Info("outbox relay started")
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := r.relayMessagesUC.Execute(ctx); err != nil {
+				r.logger.WithError(err).Error("failed to relay outbox messages")
+			}
+		case <-ctx.Done():
+			r.logger.Info("outbox relay stopped")
+			return nil
+		}
+	}
+}
+
+func (r *outboxRelay) Close() error {
+	return nil
+}
@@ -20,10 +20,10 @@ const (
 	InternalProvider = "internal"
 )
 
-func NewProvider(searchChains usecases.SearchChainsUseCase, refresh time.Duration, proxyCacheTTL *time.Duration, accessLog bool) provider.Provider {
+func NewProvider(searchChains usecases.SearchChainsUseCase, refresh time.Duration, proxyCacheTTL *time.Duration, accessLog bool, lbStrategy string) provider.Provider {
 	prvdr := aggregator.New()
 	prvdr.AddProvider(NewInternalProvider())
-	prvdr.AddProvider(proxy.NewChainsProxyProvider(searchChains, refresh, proxyCacheTTL, accessLog))
+	prvdr.AddProvider(proxy.NewChainsProxyProvider(searchChains, refresh, proxyCacheTTL, accessLog, lbStrategy))
 	return prvdr
 
 }
@@ -35,7 +35,7 @@ func NewInternalProvider() provider.Provider {
 func newInternalConfig() *dynamic.Configuration {
 	cfg := dynamic.NewConfig()
 
-	pathPrefix := []string{"/transactions", "/schedules", "/jobs", "/accounts", "/faucets", "/contracts", "/chains"}
+	pathPrefix := []string{"/transactions", "/schedules", "/jobs", "/accounts", "/faucets", "/contracts", "/chains", "/subscriptions"}
 	for idx, path := range pathPrefix {
 		pathPrefix[idx] = fmt.Sprintf("PathPrefix(`%s`)", path)
 	}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/http"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/http/config/dynamic"
+	"github.com/consensys/orchestrate/pkg/toolkit/nodehealth"
 	traefikdynamic "github.com/traefik/traefik/v2/pkg/config/dynamic"
 	"github.com/stretchr/testify/assert"
 )
@@ -26,7 +27,7 @@ func TestNewInternalConfig(t *testing.T) {
 						EntryPoints: []string{http.DefaultHTTPAppEntryPoint},
 						Service:     "api",
 						Priority:    math.MaxInt32,
-						Rule:        "PathPrefix(`/transactions`) || PathPrefix(`/schedules`) || PathPrefix(`/jobs`) || PathPrefix(`/accounts`) || PathPrefix(`/faucets`) || PathPrefix(`/contracts`) || PathPrefix(`/chains`)",
+						Rule:        "PathPrefix(`/transactions`) || PathPrefix(`/schedules`) || PathPrefix(`/jobs`) || PathPrefix(`/accounts`) || PathPrefix(`/faucets`) || PathPrefix(`/contracts`) || PathPrefix(`/chains`) || PathPrefix(`/subscriptions`)",
 						Middlewares: []string{"base@logger-base", "auth@multitenancy"},
 					},
 				},
@@ -106,6 +107,7 @@ func TestNewChainsProxyConfig(t *testing.T) {
 								{URL: "http://testURL1.com"},
 								{URL: "http://testURL2.com"},
 							},
+							Strategy: nodehealth.LatencyStrategy,
 						},
 					},
 				}
@@ -198,6 +200,7 @@ func TestNewChainsProxyConfig(t *testing.T) {
 								{URL: "http://testURL1.com"},
 								{URL: "http://testURL2.com"},
 							},
+							Strategy: nodehealth.LatencyStrategy,
 						},
 					},
 				}
@@ -209,6 +212,7 @@ func TestNewChainsProxyConfig(t *testing.T) {
 							Servers: []*dynamic.Server{
 								{URL: "http://testURL10.com"},
 							},
+							Strategy: nodehealth.LatencyStrategy,
 						},
 					},
 				}
@@ -235,7 +239,7 @@ func TestNewChainsProxyConfig(t *testing.T) {
 	}
 
 	for i, test := range testSet {
-		cfg := proxy.NewProxyConfig(test.chains, nil, true)
+		cfg := proxy.NewProxyConfig(test.chains, nil, true, nodehealth.LatencyStrategy)
 		expectedCfg := test.expectedCfg(dynamic.NewConfig())
 		assert.Equal(t, expectedCfg, cfg, "Chain-registry - Store (%d/%d): expected %v but got %v", i+1, len(testSet), expectedCfg, cfg)
 	}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/nodehealth"
 	"github.com/dgraph-io/ristretto"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -17,6 +18,14 @@ func init() {
 	viper.SetDefault(CacheTTLViperKey, cacheDefault)
 	_ = viper.BindEnv(MaxIdleConnsPerHostViperKey, maxIdleConnsPerHostEnv)
 	viper.SetDefault(MaxIdleConnsPerHostViperKey, maxIdleConnsPerHostDefault)
+	_ = viper.BindEnv(LoadBalancingStrategyViperKey, loadBalancingStrategyEnv)
+	viper.SetDefault(LoadBalancingStrategyViperKey, loadBalancingStrategyDefault)
+	_ = viper.BindEnv(HealthCheckIntervalViperKey, healthCheckIntervalEnv)
+	viper.SetDefault(HealthCheckIntervalViperKey, healthCheckIntervalDefault)
+	_ = viper.BindEnv(HealthMaxBlockLagViperKey, healthMaxBlockLagEnv)
+	viper.SetDefault(HealthMaxBlockLagViperKey, healthMaxBlockLagDefault)
+	_ = viper.BindEnv(HealthEjectionDurationViperKey, healthEjectionDurationEnv)
+	viper.SetDefault(HealthEjectionDurationViperKey, healthEjectionDurationDefault)
 }
 
 var (
@@ -29,6 +38,26 @@ var (
 	MaxIdleConnsPerHostViperKey = "proxy.max-idle-connections-per-host"
 	maxIdleConnsPerHostDefault  = 50
 	maxIdleConnsPerHostEnv      = "PROXY_MAXIDLECONNSPERHOST"
+
+	loadBalancingStrategyFlag     = "proxy-load-balancing-strategy"
+	LoadBalancingStrategyViperKey = "proxy.load-balancing-strategy"
+	loadBalancingStrategyDefault  = nodehealth.LatencyStrategy
+	loadBalancingStrategyEnv      = "PROXY_LOAD_BALANCING_STRATEGY"
+
+	healthCheckIntervalFlag     = "proxy-health-check-interval"
+	HealthCheckIntervalViperKey = "proxy.health-check.interval"
+	healthCheckIntervalDefault  = 10 * time.Second
+	healthCheckIntervalEnv      = "PROXY_HEALTH_CHECK_INTERVAL"
+
+	healthMaxBlockLagFlag     = "proxy-health-max-block-lag"
+	HealthMaxBlockLagViperKey = "proxy.health-check.max-block-lag"
+	healthMaxBlockLagDefault  = uint64(5)
+	healthMaxBlockLagEnv      = "PROXY_HEALTH_MAX_BLOCK_LAG"
+
+	healthEjectionDurationFlag     = "proxy-health-ejection-duration"
+	HealthEjectionDurationViperKey = "proxy.health-check.ejection-duration"
+	healthEjectionDurationDefault  = 30 * time.Second
+	healthEjectionDurationEnv      = "PROXY_HEALTH_EJECTION_DURATION"
 )
 
 type Config struct {
@@ -37,6 +66,7 @@ type Config struct {
 	ProxyCacheTTL    *time.Duration
 	ServersTransport *traefikstatic.ServersTransport
 	Multitenancy     bool
+	NodeHealth       *nodehealth.Config
 }
 
 func Flags(f *pflag.FlagSet) {
@@ -47,6 +77,23 @@ func Flags(f *pflag.FlagSet) {
 	maxIdleConnsPerHostDesc := fmt.Sprintf(`Maximum number of open HTTP connections to a chain proxied. Environment variable: %q`, maxIdleConnsPerHostEnv)
 	f.Int(maxIdleConnsPerHostFlag, maxIdleConnsPerHostDefault, maxIdleConnsPerHostDesc)
 	_ = viper.BindPFlag(MaxIdleConnsPerHostViperKey, f.Lookup(maxIdleConnsPerHostFlag))
+
+	loadBalancingStrategyDesc := fmt.Sprintf(`Strategy used to select the node of a chain to proxy a request to (one of %q, %q or %q). Environment variable: %q`,
+		nodehealth.LatencyStrategy, nodehealth.HeadStrategy, nodehealth.RoundRobinStrategy, loadBalancingStrategyEnv)
+	f.String(loadBalancingStrategyFlag, loadBalancingStrategyDefault, loadBalancingStrategyDesc)
+	_ = viper.BindPFlag(LoadBalancingStrategyViperKey, f.Lookup(loadBalancingStrategyFlag))
+
+	healthCheckIntervalDesc := fmt.Sprintf(`Interval between two active health checks of the chain nodes (0 to disable). Environment variable: %q`, healthCheckIntervalEnv)
+	f.Duration(healthCheckIntervalFlag, healthCheckIntervalDefault, healthCheckIntervalDesc)
+	_ = viper.BindPFlag(HealthCheckIntervalViperKey, f.Lookup(healthCheckIntervalFlag))
+
+	healthMaxBlockLagDesc := fmt.Sprintf(`Maximum number of blocks a chain node can be behind its peers to be considered healthy. Environment variable: %q`, healthMaxBlockLagEnv)
+	f.Uint64(healthMaxBlockLagFlag, healthMaxBlockLagDefault, healthMaxBlockLagDesc)
+	_ = viper.BindPFlag(HealthMaxBlockLagViperKey, f.Lookup(healthMaxBlockLagFlag))
+
+	healthEjectionDurationDesc := fmt.Sprintf(`Duration during which a failing chain node does not receive traffic. Environment variable: %q`, healthEjectionDurationEnv)
+	f.Duration(healthEjectionDurationFlag, healthEjectionDurationDefault, healthEjectionDurationDesc)
+	_ = viper.BindPFlag(HealthEjectionDurationViperKey, f.Lookup(healthEjectionDurationFlag))
 }
 
 func NewConfig() *Config {
@@ -61,8 +108,14 @@ func NewConfig() *Config {
 			InsecureSkipVerify:  true,
 		},
 		Multitenancy: viper.GetBool(multitenancy.EnabledViperKey),
+		NodeHealth:   nodehealth.NewDefaultConfig(),
 	}
 
+	cfg.NodeHealth.BalancingStrategy = viper.GetString(LoadBalancingStrategyViperKey)
+	cfg.NodeHealth.CheckInterval = viper.GetDuration(HealthCheckIntervalViperKey)
+	cfg.NodeHealth.MaxBlockLag = viper.GetUint64(HealthMaxBlockLagViperKey)
+	cfg.NodeHealth.EjectionDuration = viper.GetDuration(HealthEjectionDurationViperKey)
+
 	cacheStr := viper.GetDuration(CacheTTLViperKey)
 	if cacheStr != time.Duration(0) {
 		cfg.ProxyCacheTTL = &cacheStr
@@ -20,7 +20,7 @@ import (
 
 const ChainsProxyProvider = "chains-proxy"
 
-func NewChainsProxyProvider(searchChains usecases.SearchChainsUseCase, refresh time.Duration, proxyCacheTTL *time.Duration, accessLog bool) provider.Provider {
+func NewChainsProxyProvider(searchChains usecases.SearchChainsUseCase, refresh time.Duration, proxyCacheTTL *time.Duration, accessLog bool, lbStrategy string) provider.Provider {
 	poller := func(ctx context.Context) (provider.Message, error) {
 		// Wildcard user including chains owned by individual users (Special rights)
 		chains, err := searchChains.Execute(ctx, &entities.ChainFilters{}, multitenancy.NewInternalAdminUser())
@@ -28,13 +28,13 @@ func NewChainsProxyProvider(searchChains usecases.SearchChainsUseCase, refresh t
 			return nil, err
 		}
 
-		return dynamic.NewMessage(ChainsProxyProvider, NewProxyConfig(chains, proxyCacheTTL, accessLog)), nil
+		return dynamic.NewMessage(ChainsProxyProvider, NewProxyConfig(chains, proxyCacheTTL, accessLog, lbStrategy)), nil
 	}
 
 	return poll.New(poller, refresh)
 }
 
-func NewProxyConfig(chains []*entities.Chain, proxyCacheTTL *time.Duration, accessLog bool) *dynamic.Configuration {
+func NewProxyConfig(chains []*entities.Chain, proxyCacheTTL *time.Duration, accessLog bool, lbStrategy string) *dynamic.Configuration {
 	cfg := dynamic.NewConfig()
 
 	for _, chain := range chains {
@@ -97,7 +97,7 @@ func NewProxyConfig(chains []*entities.Chain, proxyCacheTTL *time.Duration, acce
 
 		middlewares = append(middlewares, "ratelimit@internal")
 
-		appendChainServices(cfg, chain, middlewares)
+		appendChainServices(cfg, chain, middlewares, lbStrategy)
 
 		if chain.PrivateTxManager != nil {
 			appendTesseraPrivateTxServices(cfg, chain, middlewares)
@@ -138,11 +138,11 @@ func NewInternalConfig(dynamicCfg *dynamic.Configuration) *dynamic.Configuration
 	return dynamicCfg
 }
 
-func appendChainServices(cfg *dynamic.Configuration, chain *entities.Chain, middlewares []string) {
+func appendChainServices(cfg *dynamic.Configuration, chain *entities.Chain, middlewares []string, lbStrategy string) {
 	chainService := fmt.Sprintf("chain-%v", chain.UUID)
 
 	servers := make([]*dynamic.Server, 0)
-	for _, chainURL := range chain.URLs {
+	for _, chainURL := range utils.HTTPURLs(chain.URLs) {
 		servers = append(servers, &dynamic.Server{
 			URL: chainURL,
 		})
@@ -162,7 +162,8 @@ func appendChainServices(cfg *dynamic.Configuration, chain *entities.Chain, midd
 		ReverseProxy: &dynamic.ReverseProxy{
 			PassHostHeader: utils.Bool(false),
 			LoadBalancer: &dynamic.LoadBalancer{
-				Servers: servers,
+				Servers:  servers,
+				Strategy: lbStrategy,
 			},
 		},
 	}
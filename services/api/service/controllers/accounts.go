@@ -37,6 +37,7 @@ func (c *AccountsController) Append(router *mux.Router) {
 	router.Methods(http.MethodGet).Path("/accounts").HandlerFunc(c.search)
 	router.Methods(http.MethodPost).Path("/accounts").HandlerFunc(c.create)
 	router.Methods(http.MethodPost).Path("/accounts/import").HandlerFunc(c.importKey)
+	router.Methods(http.MethodPost).Path("/accounts/smart").HandlerFunc(c.registerSmartAccount)
 	router.Methods(http.MethodGet).Path("/accounts/{address}").HandlerFunc(c.getOne)
 	router.Methods(http.MethodDelete).Path("/accounts/{address}").HandlerFunc(c.deleteOne)
 	router.Methods(http.MethodPatch).Path("/accounts/{address}").HandlerFunc(c.update)
@@ -80,6 +81,41 @@ func (c *AccountsController) create(rw http.ResponseWriter, request *http.Reques
 	_ = json.NewEncoder(rw).Encode(formatters.FormatAccountResponse(acc))
 }
 
+// @Summary Registers a smart account
+// @Description Registers a deployed Safe or ERC-4337 smart account whose transactions are signed by an existing account
+// @Tags Accounts
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param request body api.RegisterSmartAccountRequest true "Smart account registration request"
+// @Success 200 {object} api.AccountResponse "Account object"
+// @Failure 400 {object} httputil.ErrorResponse "Invalid request"
+// @Failure 401 {object} httputil.ErrorResponse "Unauthorized"
+// @Failure 409 {object} httputil.ErrorResponse "Account already exists"
+// @Failure 422 {object} httputil.ErrorResponse "Invalid parameters"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /accounts/smart [post]
+func (c *AccountsController) registerSmartAccount(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	req := &api.RegisterSmartAccountRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, req)
+	if err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	acc, err := c.ucs.RegisterSmartAccount().Execute(ctx, formatters.FormatRegisterSmartAccountRequest(req), multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatAccountResponse(acc))
+}
+
 // @Summary Fetch an account by address
 // @Description Fetch a single account by address
 // @Tags Accounts
@@ -39,6 +39,7 @@ type accountsCtrlTestSuite struct {
 	updateAccountUC  *mocks.MockUpdateAccountUseCase
 	fundAccountUC    *mocks.MockFundAccountUseCase
 	deleteAccountUC  *mocks.MockDeleteAccountUseCase
+	registerSmartUC  *mocks.MockRegisterSmartAccountUseCase
 	keyManagerClient *qkmmock.MockKeyManagerClient
 	ctx              context.Context
 	userInfo         *multitenancy.UserInfo
@@ -71,6 +72,10 @@ func (s *accountsCtrlTestSuite) DeleteAccount() usecases.DeleteAccountUseCase {
 	return s.deleteAccountUC
 }
 
+func (s *accountsCtrlTestSuite) RegisterSmartAccount() usecases.RegisterSmartAccountUseCase {
+	return s.registerSmartUC
+}
+
 const (
 	inputTestAddress     = "0x7e654d251da770a068413677967f6d3ea2feA9e4"
 	mixedCaseTestAddress = "0x7E654d251Da770A068413677967F6d3Ea2FeA9E4"
@@ -90,6 +95,7 @@ func (s *accountsCtrlTestSuite) SetupTest() {
 	s.getAccountUC = mocks.NewMockGetAccountUseCase(ctrl)
 	s.searchAccountUC = mocks.NewMockSearchAccountsUseCase(ctrl)
 	s.updateAccountUC = mocks.NewMockUpdateAccountUseCase(ctrl)
+	s.registerSmartUC = mocks.NewMockRegisterSmartAccountUseCase(ctrl)
 	s.keyManagerClient = qkmmock.NewMockKeyManagerClient(ctrl)
 	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
 	s.ctx = multitenancy.WithUserInfo(context.Background(), s.userInfo)
@@ -173,6 +179,55 @@ func (s *accountsCtrlTestSuite) TestAccountController_Import() {
 	})
 }
 
+func (s *accountsCtrlTestSuite) TestAccountController_RegisterSmartAccount() {
+	signer := ethcommon.HexToAddress("0x5bd1b3b1d4bd1e0e7b8e8e27a1c5dd7e9b1bf8c3")
+	entryPoint := ethcommon.HexToAddress("0x5ff137d4b0fdcd49dca30c7cf57e578a026d2789")
+
+	s.T().Run("should execute register smart account request successfully", func(t *testing.T) {
+		req := &api.RegisterSmartAccountRequest{
+			Alias:      "my-smart-account",
+			Type:       entities.ERC4337SmartAccountType,
+			Address:    ethcommon.HexToAddress(inputTestAddress),
+			Signer:     signer,
+			EntryPoint: &entryPoint,
+		}
+		requestBytes, _ := json.Marshal(req)
+		accResp := formatters.FormatRegisterSmartAccountRequest(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/accounts/smart", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.registerSmartUC.EXPECT().Execute(gomock.Any(), accResp, s.userInfo).Return(accResp, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := formatters.FormatAccountResponse(accResp)
+		expectedBody, _ := json.Marshal(response)
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with 400 if the entry point of an ERC-4337 account is missing", func(t *testing.T) {
+		req := &api.RegisterSmartAccountRequest{
+			Type:    entities.ERC4337SmartAccountType,
+			Address: ethcommon.HexToAddress(inputTestAddress),
+			Signer:  signer,
+		}
+		requestBytes, _ := json.Marshal(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/accounts/smart", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+}
+
 func (s *accountsCtrlTestSuite) TestAccountController_GetOne() {
 	s.T().Run("should execute get account request successfully", func(t *testing.T) {
 		accResp := testutils.FakeAccount()
@@ -0,0 +1,145 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	jsonutils "github.com/consensys/orchestrate/pkg/encoding/json"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/http/httputil"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/formatters"
+
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/gorilla/mux"
+)
+
+type ApprovalPoliciesController struct {
+	ucs usecases.ApprovalPolicyUseCases
+}
+
+func NewApprovalPoliciesController(ucs usecases.ApprovalPolicyUseCases) *ApprovalPoliciesController {
+	return &ApprovalPoliciesController{ucs: ucs}
+}
+
+// Add routes to router
+func (c *ApprovalPoliciesController) Append(router *mux.Router) {
+	router.Methods(http.MethodGet).Path("/approval-policies").HandlerFunc(c.search)
+	router.Methods(http.MethodGet).Path("/approval-policies/{uuid}").HandlerFunc(c.getOne)
+	router.Methods(http.MethodPost).Path("/approval-policies").HandlerFunc(c.create)
+	router.Methods(http.MethodDelete).Path("/approval-policies/{uuid}").HandlerFunc(c.delete)
+}
+
+// @Summary Retrieves a list of all approval policies
+// @Tags Approval Policies
+// @Produce json
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param chain_uuid query string false "UUID of the chain"
+// @Success 200 {array} api.ApprovalPolicyResponse
+// @Failure 400 {object} httputil.ErrorResponse "Invalid request"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /approval-policies [get]
+func (c *ApprovalPoliciesController) search(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	filters, err := formatters.FormatApprovalPolicyFiltersRequest(request)
+	if err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	policies, err := c.ucs.SearchApprovalPolicies().Execute(ctx, filters, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	response := []*api.ApprovalPolicyResponse{}
+	for _, policy := range policies {
+		response = append(response, formatters.FormatApprovalPolicyResponse(policy))
+	}
+
+	_ = json.NewEncoder(rw).Encode(response)
+}
+
+// @Summary Retrieves an approval policy by ID
+// @Tags Approval Policies
+// @Produce json
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param uuid path string true "ID of the approval policy"
+// @Success 200 {object} api.ApprovalPolicyResponse
+// @Failure 404 {object} httputil.ErrorResponse "Approval policy not found"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /approval-policies/{uuid} [get]
+func (c *ApprovalPoliciesController) getOne(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	policy, err := c.ucs.GetApprovalPolicy().Execute(ctx, mux.Vars(request)["uuid"], multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatApprovalPolicyResponse(policy))
+}
+
+// @Summary Creates an approval policy
+// @Description Jobs of the tenant matching all the conditions of the policy await the approval of threshold of its approvers before being sent
+// @Tags Approval Policies
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param request body api.CreateApprovalPolicyRequest true "Approval policy creation request"
+// @Success 200 {object} api.ApprovalPolicyResponse
+// @Failure 400 {object} httputil.ErrorResponse "Invalid request"
+// @Failure 422 {object} httputil.ErrorResponse "Unprocessable entity"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /approval-policies [post]
+func (c *ApprovalPoliciesController) create(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	policyRequest := &api.CreateApprovalPolicyRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, policyRequest)
+	if err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	policy, err := c.ucs.CreateApprovalPolicy().Execute(ctx, formatters.FormatCreateApprovalPolicyRequest(policyRequest),
+		multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatApprovalPolicyResponse(policy))
+}
+
+// @Summary Deletes an approval policy by ID
+// @Description Jobs already awaiting approval keep the approval requirements of the deleted policy
+// @Tags Approval Policies
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param uuid path string true "ID of the approval policy"
+// @Success 204
+// @Failure 400 {object} httputil.ErrorResponse "Invalid request"
+// @Failure 404 {object} httputil.ErrorResponse "Approval policy not found"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /approval-policies/{uuid} [delete]
+func (c *ApprovalPoliciesController) delete(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	err := c.ucs.DeleteApprovalPolicy().Execute(ctx, mux.Vars(request)["uuid"], multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,216 @@
+// +build unit
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/formatters"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/business/use-cases/mocks"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+const approvalPoliciesEndpoint = "/approval-policies"
+
+type approvalPoliciesCtrlTestSuite struct {
+	suite.Suite
+	createApprovalPolicyUC   *mocks.MockCreateApprovalPolicyUseCase
+	getApprovalPolicyUC      *mocks.MockGetApprovalPolicyUseCase
+	searchApprovalPoliciesUC *mocks.MockSearchApprovalPoliciesUseCase
+	deleteApprovalPolicyUC   *mocks.MockDeleteApprovalPolicyUseCase
+	ctx                      context.Context
+	userInfo                 *multitenancy.UserInfo
+	router                   *mux.Router
+}
+
+var _ usecases.ApprovalPolicyUseCases = &approvalPoliciesCtrlTestSuite{}
+
+func (s *approvalPoliciesCtrlTestSuite) CreateApprovalPolicy() usecases.CreateApprovalPolicyUseCase {
+	return s.createApprovalPolicyUC
+}
+
+func (s *approvalPoliciesCtrlTestSuite) GetApprovalPolicy() usecases.GetApprovalPolicyUseCase {
+	return s.getApprovalPolicyUC
+}
+
+func (s *approvalPoliciesCtrlTestSuite) SearchApprovalPolicies() usecases.SearchApprovalPoliciesUseCase {
+	return s.searchApprovalPoliciesUC
+}
+
+func (s *approvalPoliciesCtrlTestSuite) DeleteApprovalPolicy() usecases.DeleteApprovalPolicyUseCase {
+	return s.deleteApprovalPolicyUC
+}
+
+func TestApprovalPoliciesController(t *testing.T) {
+	s := new(approvalPoliciesCtrlTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *approvalPoliciesCtrlTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.createApprovalPolicyUC = mocks.NewMockCreateApprovalPolicyUseCase(ctrl)
+	s.getApprovalPolicyUC = mocks.NewMockGetApprovalPolicyUseCase(ctrl)
+	s.searchApprovalPoliciesUC = mocks.NewMockSearchApprovalPoliciesUseCase(ctrl)
+	s.deleteApprovalPolicyUC = mocks.NewMockDeleteApprovalPolicyUseCase(ctrl)
+
+	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
+	s.ctx = multitenancy.WithUserInfo(context.Background(), s.userInfo)
+	s.router = mux.NewRouter()
+
+	controller := NewApprovalPoliciesController(s)
+	controller.Append(s.router)
+}
+
+func (s *approvalPoliciesCtrlTestSuite) TestApprovalPoliciesController_Create() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		req := testutils.FakeCreateApprovalPolicyRequest()
+		requestBytes, _ := json.Marshal(req)
+		policy := testutils.FakeApprovalPolicy()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, approvalPoliciesEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.createApprovalPolicyUC.EXPECT().Execute(gomock.Any(), formatters.FormatCreateApprovalPolicyRequest(req), s.userInfo).
+			Return(policy, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(formatters.FormatApprovalPolicyResponse(policy))
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if no approvers are provided", func(t *testing.T) {
+		req := testutils.FakeCreateApprovalPolicyRequest()
+		req.Approvers = nil
+		requestBytes, _ := json.Marshal(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, approvalPoliciesEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with 422 if use case fails with InvalidParameterError", func(t *testing.T) {
+		req := testutils.FakeCreateApprovalPolicyRequest()
+		requestBytes, _ := json.Marshal(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, approvalPoliciesEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.createApprovalPolicyUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).
+			Return(nil, errors.InvalidParameterError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rw.Code)
+	})
+}
+
+func (s *approvalPoliciesCtrlTestSuite) TestApprovalPoliciesController_GetOne() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		policy := testutils.FakeApprovalPolicy()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, approvalPoliciesEndpoint+"/"+policy.UUID, nil).
+			WithContext(s.ctx)
+
+		s.getApprovalPolicyUC.EXPECT().Execute(gomock.Any(), policy.UUID, s.userInfo).Return(policy, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(formatters.FormatApprovalPolicyResponse(policy))
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with 404 if use case fails with NotFoundError", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, approvalPoliciesEndpoint+"/uuid", nil).
+			WithContext(s.ctx)
+
+		s.getApprovalPolicyUC.EXPECT().Execute(gomock.Any(), "uuid", s.userInfo).Return(nil, errors.NotFoundError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+	})
+}
+
+func (s *approvalPoliciesCtrlTestSuite) TestApprovalPoliciesController_Search() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		policy := testutils.FakeApprovalPolicy()
+		policy.ChainUUID = uuid.Must(uuid.NewV4()).String()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, approvalPoliciesEndpoint+"?chain_uuid="+policy.ChainUUID, nil).
+			WithContext(s.ctx)
+
+		s.searchApprovalPoliciesUC.EXPECT().
+			Execute(gomock.Any(), &entities.ApprovalPolicyFilters{ChainUUID: policy.ChainUUID}, s.userInfo).
+			Return([]*entities.ApprovalPolicy{policy}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal([]*api.ApprovalPolicyResponse{formatters.FormatApprovalPolicyResponse(policy)})
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if chain UUID is invalid", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, approvalPoliciesEndpoint+"?chain_uuid=invalid", nil).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+}
+
+func (s *approvalPoliciesCtrlTestSuite) TestApprovalPoliciesController_Delete() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodDelete, approvalPoliciesEndpoint+"/uuid", nil).
+			WithContext(s.ctx)
+
+		s.deleteApprovalPolicyUC.EXPECT().Execute(gomock.Any(), "uuid", s.userInfo).Return(nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusNoContent, rw.Code)
+	})
+}
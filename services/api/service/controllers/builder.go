@@ -8,6 +8,7 @@ import (
 	qkm "github.com/consensys/quorum-key-manager/pkg/client"
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/http/config/dynamic"
+	"github.com/consensys/orchestrate/pkg/toolkit/nodehealth"
 	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
 	"github.com/gorilla/mux"
 )
@@ -25,6 +26,10 @@ import (
 // @description Faucets represent funded accounts (holding ETH) linked to specific chains, allowed to fund newly created accounts automatically for them to be able to send transactions.
 // @description Accounts represent Ethereum accounts (private keys). By usage of the generated cryptographic key pair, accounts can be used to sign/verify and to encrypt/decrypt messages.
 // @description Contracts represent Solidity contracts management.
+// @description Subscriptions represent the events of contracts delivered to a Kafka topic or to a webhook.
+// @description Approval policies represent the approvals required by the jobs of a tenant before being sent.
+// @description Transaction policies represent the spending limits and allowlists enforced on the jobs of a tenant before their creation.
+// @description Tokens represent the ERC-20, ERC-721 and ERC-1155 tokens, operated without being registered as contracts.
 
 // @contact.name Contact ConsenSys Codefi Orchestrate
 // @contact.url https://consensys.net/codefi/orchestrate/contact
@@ -49,17 +54,25 @@ type Builder struct {
 	faucetsCtrl   *FaucetsController
 	chainsCtrl    *ChainsController
 	contractsCtrl *ContractsController
+	subsCtrl      *SubscriptionsController
+	tokensCtrl    *TokensController
+	approvalsCtrl *ApprovalPoliciesController
+	txPolicyCtrl  *TransactionPoliciesController
 }
 
-func NewBuilder(ucs usecases.UseCases, keyManagerClient qkm.KeyManagerClient, qkmStoreID string) *Builder {
+func NewBuilder(ucs usecases.UseCases, keyManagerClient qkm.KeyManagerClient, qkmStoreID string, nodeHealth *nodehealth.Registry) *Builder {
 	return &Builder{
 		txCtrl:        NewTransactionsController(ucs),
 		schedulesCtrl: NewSchedulesController(ucs),
 		jobsCtrl:      NewJobsController(ucs),
 		accountsCtrl:  NewAccountsController(ucs, keyManagerClient, qkmStoreID),
 		faucetsCtrl:   NewFaucetsController(ucs),
-		chainsCtrl:    NewChainsController(ucs),
+		chainsCtrl:    NewChainsController(ucs, nodeHealth),
 		contractsCtrl: NewContractsController(ucs),
+		subsCtrl:      NewSubscriptionsController(ucs),
+		tokensCtrl:    NewTokensController(ucs),
+		approvalsCtrl: NewApprovalPoliciesController(ucs),
+		txPolicyCtrl:  NewTransactionPoliciesController(ucs),
 	}
 }
 
@@ -77,6 +90,10 @@ func (b *Builder) Build(_ context.Context, _ string, configuration interface{},
 	b.faucetsCtrl.Append(router)
 	b.chainsCtrl.Append(router)
 	b.contractsCtrl.Append(router)
+	b.subsCtrl.Append(router)
+	b.tokensCtrl.Append(router)
+	b.approvalsCtrl.Append(router)
+	b.txPolicyCtrl.Append(router)
 
 	return router, nil
 }
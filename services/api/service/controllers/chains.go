@@ -9,6 +9,7 @@ import (
 	jsonutils "github.com/consensys/orchestrate/pkg/encoding/json"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/http/httputil"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/nodehealth"
 	"github.com/consensys/orchestrate/pkg/types/api"
 	"github.com/consensys/orchestrate/pkg/types/formatters"
 	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
@@ -19,11 +20,12 @@ import (
 var _ entities.PrivateTxManager
 
 type ChainsController struct {
-	ucs usecases.ChainUseCases
+	ucs        usecases.ChainUseCases
+	nodeHealth *nodehealth.Registry
 }
 
-func NewChainsController(chainUCs usecases.ChainUseCases) *ChainsController {
-	return &ChainsController{ucs: chainUCs}
+func NewChainsController(chainUCs usecases.ChainUseCases, nodeHealth *nodehealth.Registry) *ChainsController {
+	return &ChainsController{ucs: chainUCs, nodeHealth: nodeHealth}
 }
 
 func (c *ChainsController) Append(router *mux.Router) {
@@ -32,6 +34,7 @@ func (c *ChainsController) Append(router *mux.Router) {
 	router.Methods(http.MethodPost).Path("/chains").HandlerFunc(c.register)
 	router.Methods(http.MethodPatch).Path("/chains/{uuid}").HandlerFunc(c.update)
 	router.Methods(http.MethodDelete).Path("/chains/{uuid}").HandlerFunc(c.delete)
+	router.Methods(http.MethodPost).Path("/chains/{uuid}/call").HandlerFunc(c.call)
 }
 
 // @Summary Retrieves a list of all registered chains
@@ -73,7 +76,7 @@ func (c *ChainsController) search(rw http.ResponseWriter, request *http.Request)
 // @Security ApiKeyAuth
 // @Security JWTAuth
 // @Param uuid path string true "ID of the chain"
-// @Success 200 {object} api.ChainResponse{privateTxManager=entities.PrivateTxManager}
+// @Success 200 {object} api.ChainResponse{privateTxManager=entities.PrivateTxManager,nodes=[]api.ChainNodeResponse}
 // @Failure 400 {object} httputil.ErrorResponse "Invalid request"
 // @Failure 404 {object} httputil.ErrorResponse "Chain not found"
 // @Failure 500 {object} httputil.ErrorResponse "Internal server error"
@@ -88,7 +91,12 @@ func (c *ChainsController) getOne(rw http.ResponseWriter, request *http.Request)
 		return
 	}
 
-	_ = json.NewEncoder(rw).Encode(formatters.FormatChainResponse(chain))
+	response := formatters.FormatChainResponse(chain)
+	if c.nodeHealth != nil {
+		response.Nodes = formatters.FormatChainNodesResponse(c.nodeHealth.Status(chain.URLs))
+	}
+
+	_ = json.NewEncoder(rw).Encode(response)
 }
 
 // @Summary Updates a chain by ID
@@ -186,3 +194,49 @@ func (c *ChainsController) delete(rw http.ResponseWriter, request *http.Request)
 
 	rw.WriteHeader(http.StatusNoContent)
 }
+
+// @Summary Calls a contract method without sending a transaction
+// @Description Encodes the call using the ABI of a registered contract (or the given ABI), executes it on the chain (`eth_call`, or `priv_call` if `privacyGroupId` is set) and returns the decoded outputs
+// @Tags Chains
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param uuid path string true "ID of the chain"
+// @Param request body api.CallContractRequest true "Contract call request"
+// @Success 200 {object} api.CallContractResponse
+// @Failure 400 {object} httputil.ErrorResponse "Invalid request"
+// @Failure 404 {object} httputil.ErrorResponse "Chain not found"
+// @Failure 422 {object} httputil.ErrorResponse "Unprocessable parameters were sent"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /chains/{uuid}/call [post]
+func (c *ChainsController) call(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	callRequest := &api.CallContractRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, callRequest)
+	if err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err = callRequest.Validate(); err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	call, err := formatters.FormatCallContractRequest(callRequest)
+	if err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	outputs, err := c.ucs.CallContract().Execute(ctx, mux.Vars(request)["uuid"], call, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatCallContractResponse(outputs))
+}
@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/types/api"
 	"github.com/consensys/orchestrate/pkg/types/entities"
 	"github.com/consensys/orchestrate/services/api/business/use-cases"
@@ -21,6 +22,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"encoding/json"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/nodehealth"
 	"github.com/consensys/orchestrate/pkg/types/testutils"
 	"github.com/consensys/orchestrate/services/api/business/use-cases/mocks"
 
@@ -36,6 +38,8 @@ type chainsCtrlTestSuite struct {
 	searchChainUC   *mocks.MockSearchChainsUseCase
 	updateChainUC   *mocks.MockUpdateChainUseCase
 	deleteChainUC   *mocks.MockDeleteChainUseCase
+	callContractUC  *mocks.MockCallContractUseCase
+	nodeHealth      *nodehealth.Registry
 	ctx             context.Context
 	userInfo		 *multitenancy.UserInfo
 	router          *mux.Router
@@ -63,6 +67,10 @@ func (s *chainsCtrlTestSuite) DeleteChain() usecases.DeleteChainUseCase {
 	return s.deleteChainUC
 }
 
+func (s *chainsCtrlTestSuite) CallContract() usecases.CallContractUseCase {
+	return s.callContractUC
+}
+
 func TestChainsController(t *testing.T) {
 	s := new(chainsCtrlTestSuite)
 	suite.Run(t, s)
@@ -77,11 +85,13 @@ func (s *chainsCtrlTestSuite) SetupTest() {
 	s.searchChainUC = mocks.NewMockSearchChainsUseCase(ctrl)
 	s.updateChainUC = mocks.NewMockUpdateChainUseCase(ctrl)
 	s.deleteChainUC = mocks.NewMockDeleteChainUseCase(ctrl)
+	s.callContractUC = mocks.NewMockCallContractUseCase(ctrl)
 	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
 	s.ctx = multitenancy.WithUserInfo(context.Background(), s.userInfo)
+	s.nodeHealth = nodehealth.NewRegistry(nodehealth.NewDefaultConfig())
 	s.router = mux.NewRouter()
 
-	controller := NewChainsController(s)
+	controller := NewChainsController(s, s.nodeHealth)
 	controller.Append(s.router)
 }
 
@@ -169,10 +179,12 @@ func (s *chainsCtrlTestSuite) TestGetOne() {
 			WithContext(s.ctx)
 
 		s.getChainUC.EXPECT().Execute(gomock.Any(), "chainUUID", s.userInfo).Return(chain, nil)
+		s.nodeHealth.SetHead(chain.URLs[0], 100, false)
 
 		s.router.ServeHTTP(rw, httpRequest)
 
 		response := formatters.FormatChainResponse(chain)
+		response.Nodes = formatters.FormatChainNodesResponse(s.nodeHealth.Status(chain.URLs))
 		expectedBody, _ := json.Marshal(response)
 		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
 		assert.Equal(t, http.StatusOK, rw.Code)
@@ -285,3 +297,68 @@ func (s *chainsCtrlTestSuite) TestDelete() {
 		assert.Equal(t, http.StatusNoContent, rw.Code)
 	})
 }
+
+func (s *chainsCtrlTestSuite) TestCall() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		req := testutils.FakeCallContractRequest()
+		requestBytes, _ := json.Marshal(req)
+		outputs := map[string]interface{}{"0": 1000}
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, chainsEndpoint+"/chainUUID/call", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		expectedCall, _ := formatters.FormatCallContractRequest(req)
+		s.callContractUC.EXPECT().Execute(gomock.Any(), "chainUUID", expectedCall, s.userInfo).Return(outputs, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, `{"outputs":{"0":1000}}`+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if neither contract name nor ABI is set", func(t *testing.T) {
+		req := testutils.FakeCallContractRequest()
+		req.ContractName = ""
+		requestBytes, _ := json.Marshal(req)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, chainsEndpoint+"/chainUUID/call", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if method signature is missing", func(t *testing.T) {
+		req := testutils.FakeCallContractRequest()
+		req.MethodSignature = ""
+		requestBytes, _ := json.Marshal(req)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, chainsEndpoint+"/chainUUID/call", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with 422 if use case fails with InvalidParameterError", func(t *testing.T) {
+		req := testutils.FakeCallContractRequest()
+		requestBytes, _ := json.Marshal(req)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, chainsEndpoint+"/chainUUID/call", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.callContractUC.EXPECT().Execute(gomock.Any(), "chainUUID", gomock.Any(), s.userInfo).
+			Return(nil, errors.InvalidParameterError("contract not found"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusUnprocessableEntity, rw.Code)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	jsonutils "github.com/consensys/orchestrate/pkg/encoding/json"
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/http/httputil"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/types/api"
 	"github.com/consensys/orchestrate/pkg/types/formatters"
 	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
@@ -36,6 +37,9 @@ func (c *ContractsController) Append(router *mux.Router) {
 	router.Methods(http.MethodGet).Path("/contracts/accounts/{chain_id}/{address}/events").HandlerFunc(c.getEvents)
 	router.Methods(http.MethodGet).Path("/contracts/{name}").HandlerFunc(c.getTags)
 	router.Methods(http.MethodGet).Path("/contracts/{name}/{tag}").HandlerFunc(c.getContract)
+	router.Methods(http.MethodPut).Path("/contracts/{name}/{tag}").HandlerFunc(c.setTag)
+	router.Methods(http.MethodDelete).Path("/contracts/{name}").HandlerFunc(c.delete)
+	router.Methods(http.MethodDelete).Path("/contracts/{name}/{tag}").HandlerFunc(c.deleteTag)
 }
 
 // @Summary Returns a list of all registered contracts
@@ -51,7 +55,7 @@ func (c *ContractsController) getCatalog(rw http.ResponseWriter, request *http.R
 	rw.Header().Set("Content-Type", "application/json")
 	ctx := request.Context()
 
-	names, err := c.ucs.GetContractsCatalog().Execute(ctx)
+	names, err := c.ucs.GetContractsCatalog().Execute(ctx, multitenancy.UserInfoValue(ctx))
 
 	if err != nil {
 		httputil.WriteHTTPErrorResponse(rw, err)
@@ -94,13 +98,13 @@ func (c *ContractsController) register(rw http.ResponseWriter, request *http.Req
 		return
 	}
 
-	err = c.ucs.RegisterContract().Execute(ctx, contract)
+	err = c.ucs.RegisterContract().Execute(ctx, contract, multitenancy.UserInfoValue(ctx))
 	if err != nil {
 		httputil.WriteHTTPErrorResponse(rw, err)
 		return
 	}
 
-	contract, err = c.ucs.GetContract().Execute(ctx, contract.Name, contract.Tag)
+	contract, err = c.ucs.GetContract().Execute(ctx, contract.Name, contract.Tag, multitenancy.UserInfoValue(ctx))
 	if err != nil {
 		httputil.WriteHTTPErrorResponse(rw, err)
 		return
@@ -133,7 +137,7 @@ func (c *ContractsController) search(rw http.ResponseWriter, request *http.Reque
 		return
 	}
 
-	contract, err := c.ucs.SearchContract().Execute(ctx, req.CodeHash, req.Address)
+	contract, err := c.ucs.SearchContract().Execute(ctx, req.CodeHash, req.Address, multitenancy.UserInfoValue(ctx))
 	if err != nil {
 		httputil.WriteHTTPErrorResponse(rw, err)
 		return
@@ -240,7 +244,7 @@ func (c *ContractsController) getTags(rw http.ResponseWriter, request *http.Requ
 	rw.Header().Set("Content-Type", "application/json")
 	ctx := request.Context()
 
-	tags, err := c.ucs.GetContractTags().Execute(ctx, mux.Vars(request)["name"])
+	tags, err := c.ucs.GetContractTags().Execute(ctx, mux.Vars(request)["name"], multitenancy.UserInfoValue(ctx))
 
 	if err != nil {
 		httputil.WriteHTTPErrorResponse(rw, err)
@@ -269,7 +273,7 @@ func (c *ContractsController) getContract(rw http.ResponseWriter, request *http.
 	rw.Header().Set("Content-Type", "application/json")
 	ctx := request.Context()
 
-	contract, err := c.ucs.GetContract().Execute(ctx, mux.Vars(request)["name"], mux.Vars(request)["tag"])
+	contract, err := c.ucs.GetContract().Execute(ctx, mux.Vars(request)["name"], mux.Vars(request)["tag"], multitenancy.UserInfoValue(ctx))
 	if err != nil {
 		httputil.WriteHTTPErrorResponse(rw, err)
 		return
@@ -277,3 +281,92 @@ func (c *ContractsController) getContract(rw http.ResponseWriter, request *http.
 
 	_ = json.NewEncoder(rw).Encode(formatters.FormatContractResponse(contract))
 }
+
+// @Summary Set a tag of a registered contract
+// @Description Point the tag {tag} of the contract {name} to the artifact of the target tag, e.g. move `latest` to a release
+// @Tags Contracts
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param name path string true "solidity contract registered name"
+// @Param tag path string true "tag to set"
+// @Param request body api.SetContractTagRequest true "Contract tag request"
+// @Success 200 {object} api.ContractResponse{constructor=entities.ABIComponent,methods=[]entities.ABIComponent,events=[]entities.ABIComponent} "Contract found with the tag"
+// @Failure 400 {object} httputil.ErrorResponse "Invalid request"
+// @Failure 404 {object} httputil.ErrorResponse "Contract or target tag not found"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /contracts/{name}/{tag} [put]
+func (c *ContractsController) setTag(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	req := &api.SetContractTagRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, req)
+	if err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	name := mux.Vars(request)["name"]
+	tag := mux.Vars(request)["tag"]
+	userInfo := multitenancy.UserInfoValue(ctx)
+	err = c.ucs.SetContractTag().Execute(ctx, name, tag, req.Target, userInfo)
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	contract, err := c.ucs.GetContract().Execute(ctx, name, tag, userInfo)
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatContractResponse(contract))
+}
+
+// @Summary Deregister a contract
+// @Description Deregister the contract {name} and all its tags. Accounts deployed with the contract are still decoded
+// @Tags Contracts
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param name path string true "solidity contract registered name"
+// @Success 204
+// @Failure 404 {object} httputil.ErrorResponse "Contract not found"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /contracts/{name} [delete]
+func (c *ContractsController) delete(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	err := c.ucs.DeleteContract().Execute(ctx, mux.Vars(request)["name"], multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary Deregister a contract tag
+// @Description Deregister the tag {tag} of the contract {name}, the contract being deregistered with its last tag
+// @Tags Contracts
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param name path string true "solidity contract registered name"
+// @Param tag path string true "solidity contract registered tag"
+// @Success 204
+// @Failure 404 {object} httputil.ErrorResponse "Contract not found"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /contracts/{name}/{tag} [delete]
+func (c *ContractsController) deleteTag(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	err := c.ucs.DeleteContractTag().Execute(ctx, mux.Vars(request)["name"], mux.Vars(request)["tag"], multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
@@ -11,6 +11,8 @@ import (
 	"testing"
 
 	"encoding/json"
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/types/api"
 	"github.com/consensys/orchestrate/pkg/types/testutils"
 	"github.com/consensys/orchestrate/pkg/utils"
@@ -33,7 +35,12 @@ type contractsCtrlTestSuite struct {
 	setContractCodeHash *mocks.MockSetContractCodeHashUseCase
 	registerContract    *mocks.MockRegisterContractUseCase
 	searchContract      *mocks.MockSearchContractUseCase
+	deleteContract      *mocks.MockDeleteContractUseCase
+	deleteContractTag   *mocks.MockDeleteContractTagUseCase
+	setContractTag      *mocks.MockSetContractTagUseCase
 	router              *mux.Router
+	userInfo            *multitenancy.UserInfo
+	ctx                 context.Context
 }
 
 var _ usecases.ContractUseCases = &contractsCtrlTestSuite{}
@@ -59,6 +66,15 @@ func (s *contractsCtrlTestSuite) RegisterContract() usecases.RegisterContractUse
 func (s *contractsCtrlTestSuite) SearchContract() usecases.SearchContractUseCase {
 	return s.searchContract
 }
+func (s *contractsCtrlTestSuite) DeleteContract() usecases.DeleteContractUseCase {
+	return s.deleteContract
+}
+func (s *contractsCtrlTestSuite) DeleteContractTag() usecases.DeleteContractTagUseCase {
+	return s.deleteContractTag
+}
+func (s *contractsCtrlTestSuite) SetContractTag() usecases.SetContractTagUseCase {
+	return s.setContractTag
+}
 
 func TestContractController(t *testing.T) {
 	s := new(contractsCtrlTestSuite)
@@ -76,14 +92,19 @@ func (s *contractsCtrlTestSuite) SetupTest() {
 	s.setContractCodeHash = mocks.NewMockSetContractCodeHashUseCase(ctrl)
 	s.registerContract = mocks.NewMockRegisterContractUseCase(ctrl)
 	s.searchContract = mocks.NewMockSearchContractUseCase(ctrl)
+	s.deleteContract = mocks.NewMockDeleteContractUseCase(ctrl)
+	s.deleteContractTag = mocks.NewMockDeleteContractTagUseCase(ctrl)
+	s.setContractTag = mocks.NewMockSetContractTagUseCase(ctrl)
 	s.router = mux.NewRouter()
+	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
+	s.ctx = multitenancy.WithUserInfo(context.Background(), s.userInfo)
 
 	controller := NewContractsController(s)
 	controller.Append(s.router)
 }
 
 func (s *contractsCtrlTestSuite) TestContractsController_Register() {
-	ctx := context.Background()
+	ctx := s.ctx
 	s.T().Run("should execute register contract request successfully", func(t *testing.T) {
 		rw := httptest.NewRecorder()
 		req := testutils.FakeRegisterContractRequest()
@@ -93,10 +114,10 @@ func (s *contractsCtrlTestSuite) TestContractsController_Register() {
 			WithContext(ctx)
 
 		expectedContract, _ := formatters.FormatRegisterContractRequest(req)
-		s.registerContract.EXPECT().Execute(gomock.Any(), expectedContract).Return(nil)
+		s.registerContract.EXPECT().Execute(gomock.Any(), expectedContract, s.userInfo).Return(nil)
 
 		contract := testutils.FakeContract()
-		s.getContract.EXPECT().Execute(gomock.Any(), req.Name, req.Tag).Return(contract, nil)
+		s.getContract.EXPECT().Execute(gomock.Any(), req.Name, req.Tag, s.userInfo).Return(contract, nil)
 
 		s.router.ServeHTTP(rw, httpRequest)
 		expectedBody, _ := json.Marshal(formatters.FormatContractResponse(contract))
@@ -125,7 +146,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_Register() {
 			WithContext(ctx)
 
 		expectedContract, _ := formatters.FormatRegisterContractRequest(req)
-		s.registerContract.EXPECT().Execute(gomock.Any(), expectedContract).Return(fmt.Errorf("error"))
+		s.registerContract.EXPECT().Execute(gomock.Any(), expectedContract, s.userInfo).Return(fmt.Errorf("error"))
 
 		s.router.ServeHTTP(rw, httpRequest)
 		assert.Equal(t, http.StatusInternalServerError, rw.Code)
@@ -140,9 +161,9 @@ func (s *contractsCtrlTestSuite) TestContractsController_Register() {
 			WithContext(ctx)
 
 		expectedContract, _ := formatters.FormatRegisterContractRequest(req)
-		s.registerContract.EXPECT().Execute(gomock.Any(), expectedContract).Return(nil)
+		s.registerContract.EXPECT().Execute(gomock.Any(), expectedContract, s.userInfo).Return(nil)
 
-		s.getContract.EXPECT().Execute(gomock.Any(), req.Name, req.Tag).Return(nil, fmt.Errorf("error"))
+		s.getContract.EXPECT().Execute(gomock.Any(), req.Name, req.Tag, s.userInfo).Return(nil, fmt.Errorf("error"))
 
 		s.router.ServeHTTP(rw, httpRequest)
 		assert.Equal(t, http.StatusInternalServerError, rw.Code)
@@ -150,7 +171,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_Register() {
 }
 
 func (s *contractsCtrlTestSuite) TestContractsController_CodeHash() {
-	ctx := context.Background()
+	ctx := s.ctx
 	chainID := "2017"
 	address := testutils.FakeAddress()
 
@@ -201,7 +222,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_CodeHash() {
 }
 
 func (s *contractsCtrlTestSuite) TestContractsController_GetContract() {
-	ctx := context.Background()
+	ctx := s.ctx
 
 	s.T().Run("should execute get contract successfully", func(t *testing.T) {
 		rw := httptest.NewRecorder()
@@ -210,7 +231,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_GetContract() {
 			NewRequest(http.MethodGet, fmt.Sprintf("/contracts/%s/%s", contract.Name, contract.Tag), nil).
 			WithContext(ctx)
 
-		s.getContract.EXPECT().Execute(gomock.Any(), contract.Name, contract.Tag).Return(contract, nil)
+		s.getContract.EXPECT().Execute(gomock.Any(), contract.Name, contract.Tag, s.userInfo).Return(contract, nil)
 
 		s.router.ServeHTTP(rw, httpRequest)
 		expectedBody, _ := json.Marshal(formatters.FormatContractResponse(contract))
@@ -224,7 +245,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_GetContract() {
 			NewRequest(http.MethodGet, fmt.Sprintf("/contracts/%s/%s", contract.Name, contract.Tag), nil).
 			WithContext(ctx)
 
-		s.getContract.EXPECT().Execute(gomock.Any(), contract.Name, contract.Tag).Return(nil, fmt.Errorf("error"))
+		s.getContract.EXPECT().Execute(gomock.Any(), contract.Name, contract.Tag, s.userInfo).Return(nil, fmt.Errorf("error"))
 
 		s.router.ServeHTTP(rw, httpRequest)
 		assert.Equal(t, http.StatusInternalServerError, rw.Code)
@@ -232,7 +253,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_GetContract() {
 }
 
 func (s *contractsCtrlTestSuite) TestContractsController_SearchContract() {
-	ctx := context.Background()
+	ctx := s.ctx
 
 	req := testutils.FakeSearchContractRequest()
 
@@ -243,7 +264,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_SearchContract() {
 			NewRequest(http.MethodGet, fmt.Sprintf("/contracts/search?code_hash=%s", req.CodeHash.String()), nil).
 			WithContext(ctx)
 
-		s.searchContract.EXPECT().Execute(gomock.Any(), req.CodeHash, nil).Return(contract, nil)
+		s.searchContract.EXPECT().Execute(gomock.Any(), req.CodeHash, nil, s.userInfo).Return(contract, nil)
 
 		s.router.ServeHTTP(rw, httpRequest)
 		expectedBody, _ := json.Marshal(formatters.FormatContractResponse(contract))
@@ -257,7 +278,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_SearchContract() {
 			NewRequest(http.MethodGet, fmt.Sprintf("/contracts/search?address=%s", req.Address.String()), nil).
 			WithContext(ctx)
 
-		s.searchContract.EXPECT().Execute(gomock.Any(), nil, req.Address).Return(contract, nil)
+		s.searchContract.EXPECT().Execute(gomock.Any(), nil, req.Address, s.userInfo).Return(contract, nil)
 
 		s.router.ServeHTTP(rw, httpRequest)
 		expectedBody, _ := json.Marshal(formatters.FormatContractResponse(contract))
@@ -266,7 +287,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_SearchContract() {
 }
 
 func (s *contractsCtrlTestSuite) TestContractsController_GetContractEvents() {
-	ctx := context.Background()
+	ctx := s.ctx
 	address := ethcommon.HexToAddress(utils.RandHexString(10))
 	sigHash := utils.StringToHexBytes("0x" + utils.RandHexString(10))
 	indexInput := uint32(2)
@@ -305,7 +326,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_GetContractEvents() {
 }
 
 func (s *contractsCtrlTestSuite) TestContractsController_GetContractsCatalog() {
-	ctx := context.Background()
+	ctx := s.ctx
 
 	s.T().Run("should execute get catalog successfully", func(t *testing.T) {
 		rw := httptest.NewRecorder()
@@ -314,10 +335,110 @@ func (s *contractsCtrlTestSuite) TestContractsController_GetContractsCatalog() {
 			WithContext(ctx)
 
 		catalog := []string{"contractOne", "contractTwo"}
-		s.getContractsCatalog.EXPECT().Execute(gomock.Any()).Return(catalog, nil)
+		s.getContractsCatalog.EXPECT().Execute(gomock.Any(), s.userInfo).Return(catalog, nil)
 
 		s.router.ServeHTTP(rw, httpRequest)
 		expectedBody, _ := json.Marshal(catalog)
 		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
 	})
 }
+
+func (s *contractsCtrlTestSuite) TestContractsController_SetContractTag() {
+	ctx := s.ctx
+
+	s.T().Run("should execute set contract tag successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		contract := testutils.FakeContract()
+		requestBytes, _ := json.Marshal(&api.SetContractTagRequest{Target: "v1.0.0"})
+		httpRequest := httptest.
+			NewRequest(http.MethodPut, fmt.Sprintf("/contracts/%s/%s", contract.Name, "stable"), bytes.NewReader(requestBytes)).
+			WithContext(ctx)
+
+		s.setContractTag.EXPECT().Execute(gomock.Any(), contract.Name, "stable", "v1.0.0", s.userInfo).Return(nil)
+		s.getContract.EXPECT().Execute(gomock.Any(), contract.Name, "stable", s.userInfo).Return(contract, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		expectedBody, _ := json.Marshal(formatters.FormatContractResponse(contract))
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+	})
+
+	s.T().Run("should fail with 400 if target is missing", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPut, "/contracts/contractName/stable", bytes.NewReader([]byte("{}"))).
+			WithContext(ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with 404 if target tag is not found", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		requestBytes, _ := json.Marshal(&api.SetContractTagRequest{Target: "v1.0.0"})
+		httpRequest := httptest.
+			NewRequest(http.MethodPut, "/contracts/contractName/stable", bytes.NewReader(requestBytes)).
+			WithContext(ctx)
+
+		s.setContractTag.EXPECT().Execute(gomock.Any(), "contractName", "stable", "v1.0.0", s.userInfo).
+			Return(errors.NotFoundError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+	})
+}
+
+func (s *contractsCtrlTestSuite) TestContractsController_DeleteContract() {
+	ctx := s.ctx
+
+	s.T().Run("should execute delete contract successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodDelete, "/contracts/contractName", nil).
+			WithContext(ctx)
+
+		s.deleteContract.EXPECT().Execute(gomock.Any(), "contractName", s.userInfo).Return(nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusNoContent, rw.Code)
+	})
+
+	s.T().Run("should fail with 404 if contract is not found", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodDelete, "/contracts/contractName", nil).
+			WithContext(ctx)
+
+		s.deleteContract.EXPECT().Execute(gomock.Any(), "contractName", s.userInfo).Return(errors.NotFoundError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+	})
+}
+
+func (s *contractsCtrlTestSuite) TestContractsController_DeleteContractTag() {
+	ctx := s.ctx
+
+	s.T().Run("should execute delete contract tag successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodDelete, "/contracts/contractName/v1.0.0", nil).
+			WithContext(ctx)
+
+		s.deleteContractTag.EXPECT().Execute(gomock.Any(), "contractName", "v1.0.0", s.userInfo).Return(nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusNoContent, rw.Code)
+	})
+
+	s.T().Run("should fail with 500 if usecase fails", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodDelete, "/contracts/contractName/v1.0.0", nil).
+			WithContext(ctx)
+
+		s.deleteContractTag.EXPECT().Execute(gomock.Any(), "contractName", "v1.0.0", s.userInfo).Return(fmt.Errorf("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+	})
+}
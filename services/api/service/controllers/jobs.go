@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/consensys/orchestrate/pkg/types/entities"
@@ -35,6 +36,8 @@ func (c *JobsController) Append(router *mux.Router) {
 	router.Methods(http.MethodPatch).Path("/jobs/{uuid}").HandlerFunc(c.update)
 	router.Methods(http.MethodPut).Path("/jobs/{uuid}/start").HandlerFunc(c.start)
 	router.Methods(http.MethodPut).Path("/jobs/{uuid}/resend").HandlerFunc(c.resend)
+	router.Methods(http.MethodPut).Path("/jobs/{uuid}/approve").HandlerFunc(c.approve)
+	router.Methods(http.MethodPut).Path("/jobs/{uuid}/reject").HandlerFunc(c.reject)
 }
 
 // @Summary Search jobs by provided filters
@@ -46,7 +49,17 @@ func (c *JobsController) Append(router *mux.Router) {
 // @Security JWTAuth
 // @Param tx_hashes query []string false "List of transaction hashes" collectionFormat(csv)
 // @Param chain_uuid query string false "Chain UUID"
-// @Success 200 {array} api.JobResponse{annotations=api.Annotations{gasPricePolicy=api.GasPriceParams{retryPolicy=api.RetryParams}},transaction=entities.ETHTransaction,logs=[]entities.Log} "List of Jobs found"
+// @Param type query string false "Job type"
+// @Param labels query []string false "List of labels formatted as key=value" collectionFormat(csv)
+// @Param from query string false "Sender of the transaction"
+// @Param to query string false "Recipient of the transaction"
+// @Param created_after query string false "Jobs created after the given date (RFC3339)"
+// @Param created_before query string false "Jobs created before the given date (RFC3339)"
+// @Param limit query int false "Maximum response size"
+// @Param cursor query string false "Cursor of the page, as returned in the next field of the previous page"
+// @Param sort_by query string false "Sort field" Enums(created_at, updated_at)
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Success 200 {object} api.JobSearchResponse{jobs=[]api.JobResponse{annotations=api.Annotations{gasPricePolicy=api.GasPriceParams{retryPolicy=api.RetryParams}},transaction=entities.ETHTransaction,logs=[]entities.Log}} "Page of Jobs found"
 // @Failure 400 {object} httputil.ErrorResponse "Invalid filter in the request"
 // @Failure 500 {object} httputil.ErrorResponse "Internal server error"
 // @Router /jobs [get]
@@ -60,15 +73,44 @@ func (c *JobsController) search(rw http.ResponseWriter, request *http.Request) {
 		return
 	}
 
+	if filters.Pagination.Limit > api.MaxJobPageSize {
+		httputil.WriteError(rw, fmt.Sprintf("limit cannot exceed %d", api.MaxJobPageSize), http.StatusBadRequest)
+		return
+	}
+
+	limit := filters.Pagination.Limit
+	if limit == 0 {
+		limit = api.DefaultJobPageSize
+	}
+	if filters.Pagination.SortBy == "" {
+		filters.Pagination.SortBy = entities.SortByCreatedAt
+	}
+	if filters.Pagination.SortOrder == "" {
+		filters.Pagination.SortOrder = entities.SortOrderAsc
+	}
+
+	// increase Limit to test more items available
+	filters.Pagination.Limit = limit + 1
+
 	jobRes, err := c.ucs.SearchJobs().Execute(ctx, filters, multitenancy.UserInfoValue(ctx))
 	if err != nil {
 		httputil.WriteHTTPErrorResponse(rw, err)
 		return
 	}
 
-	response := []*api.JobResponse{}
+	response := &api.JobSearchResponse{Jobs: []*api.JobResponse{}}
+	if len(jobRes) > limit {
+		jobRes = jobRes[:limit]
+		last := jobRes[limit-1]
+		cursor := &entities.Cursor{Time: last.CreatedAt, UUID: last.UUID}
+		if filters.Pagination.SortBy == entities.SortByUpdatedAt {
+			cursor.Time = last.UpdatedAt
+		}
+		response.Next = cursor.String()
+	}
+
 	for _, jb := range jobRes {
-		response = append(response, formatters.FormatJobResponse(jb))
+		response.Jobs = append(response.Jobs, formatters.FormatJobResponse(jb))
 	}
 
 	_ = json.NewEncoder(rw).Encode(response)
@@ -164,6 +206,56 @@ func (c *JobsController) start(rw http.ResponseWriter, request *http.Request) {
 	rw.WriteHeader(http.StatusAccepted)
 }
 
+// @Summary Approve a Job by UUID
+// @Description Records the approval of the user authenticated with a JWT in the job logs, the job is started once the approval policies it matches are satisfied
+// @Tags Jobs
+// @Produce json
+// @Security JWTAuth
+// @Param uuid path string true "UUID of the job"
+// @Success 200 {object} api.JobResponse{annotations=api.Annotations{gasPricePolicy=api.GasPriceParams{retryPolicy=api.RetryParams}}} "Job approved"
+// @Failure 401 {object} httputil.ErrorResponse "User is not an approver of the job"
+// @Failure 404 {object} httputil.ErrorResponse "Job not found"
+// @Failure 409 {object} httputil.ErrorResponse "Job not awaiting approval or already approved by the user"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /jobs/{uuid}/approve [put]
+func (c *JobsController) approve(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	jobRes, err := c.ucs.ApproveJob().Execute(ctx, mux.Vars(request)["uuid"], multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatJobResponse(jobRes))
+}
+
+// @Summary Reject a Job by UUID
+// @Description Fails a job awaiting approval, recording the user authenticated with a JWT in the job logs
+// @Tags Jobs
+// @Produce json
+// @Security JWTAuth
+// @Param uuid path string true "UUID of the job"
+// @Success 200 {object} api.JobResponse{annotations=api.Annotations{gasPricePolicy=api.GasPriceParams{retryPolicy=api.RetryParams}}} "Job rejected"
+// @Failure 401 {object} httputil.ErrorResponse "User is not an approver of the job"
+// @Failure 404 {object} httputil.ErrorResponse "Job not found"
+// @Failure 409 {object} httputil.ErrorResponse "Job not awaiting approval"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /jobs/{uuid}/reject [put]
+func (c *JobsController) reject(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	jobRes, err := c.ucs.RejectJob().Execute(ctx, mux.Vars(request)["uuid"], multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatJobResponse(jobRes))
+}
+
 // @Summary Resend Job transaction by UUID
 // @Description Resend transaction of specific job by UUID, effectively executing the re-sending of transaction asynchronously
 // @Tags Jobs
@@ -38,6 +38,11 @@ type jobsCtrlTestSuite struct {
 	updateJobUC    *mocks.MockUpdateJobUseCase
 	searchJobUC    *mocks.MockSearchJobsUseCase
 	RetryTxUC      *mocks.MockRetryJobTxUseCase
+	dispatchJobsUC *mocks.MockDispatchScheduledJobsUseCase
+	relayOutboxUC  *mocks.MockRelayOutboxMessagesUseCase
+	enqueueMsgUC   *mocks.MockEnqueueMessageUseCase
+	approveJobUC   *mocks.MockApproveJobUseCase
+	rejectJobUC    *mocks.MockRejectJobUseCase
 	ctx            context.Context
 	userInfo       *multitenancy.UserInfo
 	router         *mux.Router
@@ -77,6 +82,26 @@ func (s jobsCtrlTestSuite) RetryTx() usecases.RetryJobTxUseCase {
 	return s.RetryTxUC
 }
 
+func (s jobsCtrlTestSuite) DispatchScheduledJobs() usecases.DispatchScheduledJobsUseCase {
+	return s.dispatchJobsUC
+}
+
+func (s jobsCtrlTestSuite) RelayOutboxMessages() usecases.RelayOutboxMessagesUseCase {
+	return s.relayOutboxUC
+}
+
+func (s jobsCtrlTestSuite) EnqueueMessage() usecases.EnqueueMessageUseCase {
+	return s.enqueueMsgUC
+}
+
+func (s jobsCtrlTestSuite) ApproveJob() usecases.ApproveJobUseCase {
+	return s.approveJobUC
+}
+
+func (s jobsCtrlTestSuite) RejectJob() usecases.RejectJobUseCase {
+	return s.rejectJobUC
+}
+
 func TestJobsController(t *testing.T) {
 	s := new(jobsCtrlTestSuite)
 	suite.Run(t, s)
@@ -92,6 +117,8 @@ func (s *jobsCtrlTestSuite) SetupTest() {
 	s.updateJobUC = mocks.NewMockUpdateJobUseCase(ctrl)
 	s.searchJobUC = mocks.NewMockSearchJobsUseCase(ctrl)
 	s.resentJobTxUC = mocks.NewMockResendJobTxUseCase(ctrl)
+	s.approveJobUC = mocks.NewMockApproveJobUseCase(ctrl)
+	s.rejectJobUC = mocks.NewMockRejectJobUseCase(ctrl)
 	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
 	s.ctx = multitenancy.WithUserInfo(context.Background(), s.userInfo)
 	s.router = mux.NewRouter()
@@ -209,7 +236,7 @@ func (s *jobsCtrlTestSuite) TestJobsController_GetOne() {
 func (s *jobsCtrlTestSuite) TestJobsController_Search() {
 	s.T().Run("should execute search jobs successfully", func(t *testing.T) {
 		rw := httptest.NewRecorder()
-		filters := &entities.JobFilters{}
+		filters := &entities.JobFilters{Pagination: defaultJobPagination()}
 		httpRequest := httptest.NewRequest(http.MethodGet, "/jobs", nil).WithContext(s.ctx)
 		jobEntities := []*entities.Job{testutils.FakeJob()}
 
@@ -217,7 +244,7 @@ func (s *jobsCtrlTestSuite) TestJobsController_Search() {
 
 		s.router.ServeHTTP(rw, httpRequest)
 
-		response := []*txschedulertypes.JobResponse{formatters.FormatJobResponse(jobEntities[0])}
+		response := &txschedulertypes.JobSearchResponse{Jobs: []*txschedulertypes.JobResponse{formatters.FormatJobResponse(jobEntities[0])}}
 		expectedBody, _ := json.Marshal(response)
 		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
 		assert.Equal(t, http.StatusOK, rw.Code)
@@ -225,7 +252,10 @@ func (s *jobsCtrlTestSuite) TestJobsController_Search() {
 
 	s.T().Run("should execute search jobs by tx_hashes successfully", func(t *testing.T) {
 		rw := httptest.NewRecorder()
-		filters := &entities.JobFilters{TxHashes: []string{common.HexToHash("0x1").String(), common.HexToHash("0x2").String()}}
+		filters := &entities.JobFilters{
+			TxHashes:   []string{common.HexToHash("0x1").String(), common.HexToHash("0x2").String()},
+			Pagination: defaultJobPagination(),
+		}
 		url := fmt.Sprintf("/jobs?tx_hashes=%s", strings.Join([]string{
 			common.HexToHash("0x1").String(),
 			common.HexToHash("0x2").String(),
@@ -240,12 +270,61 @@ func (s *jobsCtrlTestSuite) TestJobsController_Search() {
 
 		s.router.ServeHTTP(rw, httpRequest)
 
-		response := []*txschedulertypes.JobResponse{formatters.FormatJobResponse(jobEntities[0])}
+		response := &txschedulertypes.JobSearchResponse{Jobs: []*txschedulertypes.JobResponse{formatters.FormatJobResponse(jobEntities[0])}}
 		expectedBody, _ := json.Marshal(response)
 		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
 		assert.Equal(t, http.StatusOK, rw.Code)
 	})
 
+	s.T().Run("should execute search jobs by labels and return the cursor of the next page", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		filters := &entities.JobFilters{
+			Labels: map[string]string{"env": "prod"},
+			Type:   entities.EthereumTransaction,
+			Pagination: entities.CursorPagination{
+				Limit:     2,
+				SortBy:    entities.SortByUpdatedAt,
+				SortOrder: entities.SortOrderDesc,
+			},
+		}
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, "/jobs?labels=env=prod&type=eth://ethereum/transaction&limit=1&sort_by=updated_at&order=desc", nil).
+			WithContext(s.ctx)
+		jobEntities := []*entities.Job{testutils.FakeJob(), testutils.FakeJob()}
+
+		s.searchJobUC.EXPECT().Execute(gomock.Any(), filters, s.userInfo).Return(jobEntities, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := &txschedulertypes.JobSearchResponse{
+			Jobs: []*txschedulertypes.JobResponse{formatters.FormatJobResponse(jobEntities[0])},
+			Next: (&entities.Cursor{Time: jobEntities[0].UpdatedAt, UUID: jobEntities[0].UUID}).String(),
+		}
+		expectedBody, _ := json.Marshal(response)
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with 400 if limit exceeds the maximum page size", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, fmt.Sprintf("/jobs?limit=%d", txschedulertypes.MaxJobPageSize+1), nil).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with 400 if cursor is invalid", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, "/jobs?cursor=invalid", nil).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
 	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
 	s.T().Run("should fail with 422 if use case fails on invalid tx hashes as input", func(t *testing.T) {
 		rw := httptest.NewRecorder()
@@ -265,7 +344,7 @@ func (s *jobsCtrlTestSuite) TestJobsController_Search() {
 	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
 	s.T().Run("should fail with 422 if use case fails with NotFoundError", func(t *testing.T) {
 		rw := httptest.NewRecorder()
-		filters := &entities.JobFilters{}
+		filters := &entities.JobFilters{Pagination: defaultJobPagination()}
 		httpRequest := httptest.
 			NewRequest(http.MethodGet, "/jobs", bytes.NewReader(nil)).
 			WithContext(s.ctx)
@@ -333,6 +412,68 @@ func (s *jobsCtrlTestSuite) TestJobsController_ResendTxJob() {
 	})
 }
 
+func (s *jobsCtrlTestSuite) TestJobsController_Approve() {
+	s.T().Run("should execute approve job request successfully", func(t *testing.T) {
+		job := testutils.FakeJob()
+		job.Status = entities.StatusAwaitingApproval
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPut, "/jobs/jobUUID/approve", nil).
+			WithContext(s.ctx)
+
+		s.approveJobUC.EXPECT().Execute(gomock.Any(), "jobUUID", s.userInfo).Return(job, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(formatters.FormatJobResponse(job))
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with 409 if use case fails with AlreadyExistsError", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPut, "/jobs/jobUUID/approve", nil).
+			WithContext(s.ctx)
+
+		s.approveJobUC.EXPECT().Execute(gomock.Any(), "jobUUID", s.userInfo).Return(nil, errors.AlreadyExistsError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusConflict, rw.Code)
+	})
+}
+
+func (s *jobsCtrlTestSuite) TestJobsController_Reject() {
+	s.T().Run("should execute reject job request successfully", func(t *testing.T) {
+		job := testutils.FakeJob()
+		job.Status = entities.StatusFailed
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPut, "/jobs/jobUUID/reject", nil).
+			WithContext(s.ctx)
+
+		s.rejectJobUC.EXPECT().Execute(gomock.Any(), "jobUUID", s.userInfo).Return(job, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(formatters.FormatJobResponse(job))
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with 401 if use case fails with UnauthorizedError", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPut, "/jobs/jobUUID/reject", nil).
+			WithContext(s.ctx)
+
+		s.rejectJobUC.EXPECT().Execute(gomock.Any(), "jobUUID", s.userInfo).Return(nil, errors.UnauthorizedError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusUnauthorized, rw.Code)
+	})
+}
+
 func (s *jobsCtrlTestSuite) TestJobsController_Update() {
 	s.T().Run("should execute update a job request successfully", func(t *testing.T) {
 		rw := httptest.NewRecorder()
@@ -373,3 +514,11 @@ func (s *jobsCtrlTestSuite) TestJobsController_Update() {
 		assert.Equal(t, http.StatusConflict, rw.Code)
 	})
 }
+
+func defaultJobPagination() entities.CursorPagination {
+	return entities.CursorPagination{
+		Limit:     txschedulertypes.DefaultJobPageSize + 1,
+		SortBy:    entities.SortByCreatedAt,
+		SortOrder: entities.SortOrderAsc,
+	}
+}
@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/consensys/orchestrate/pkg/types/entities"
@@ -28,6 +29,7 @@ func NewSchedulesController(useCases usecases.ScheduleUseCases) *SchedulesContro
 // Add routes to router
 func (c *SchedulesController) Append(router *mux.Router) {
 	router.Methods(http.MethodPost).Path("/schedules").HandlerFunc(c.create)
+	router.Methods(http.MethodPost).Path("/schedules/graph").HandlerFunc(c.createGraph)
 	router.Methods(http.MethodGet).Path("/schedules/{uuid}").HandlerFunc(c.getOne)
 	router.Methods(http.MethodGet).Path("/schedules").HandlerFunc(c.getAll)
 }
@@ -66,6 +68,48 @@ func (c *SchedulesController) create(rw http.ResponseWriter, request *http.Reque
 	_ = json.NewEncoder(rw).Encode(response)
 }
 
+// @Summary Creates a new Schedule from a graph of jobs
+// @Description Creates a schedule of contract deployments and transactions linked by dependencies
+// @Description Jobs without dependencies are started immediately, other jobs are started once all the jobs they depend on are mined
+// @Description Recipient and arguments can reference a receipt field of a job it depends on, such as ${jobID.receipt.contractAddress}
+// @Description Jobs depending on a failed job are failed
+// @Tags Schedules
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param request body api.CreateScheduleGraphRequest true "Schedule graph creation request"
+// @Success 200 {object} api.ScheduleResponse{jobs=[]api.JobResponse} "Created schedule"
+// @Failure 400 {object} httputil.ErrorResponse "Invalid request"
+// @Failure 422 {object} httputil.ErrorResponse "Unprocessable parameters were sent"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /schedules/graph [post]
+func (c *SchedulesController) createGraph(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	graphRequest := &api.CreateScheduleGraphRequest{}
+	if err := jsonutils.UnmarshalBody(request.Body, graphRequest); err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := graphRequest.Validate(); err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	scheduleEntity, err := c.ucs.CreateScheduleGraph().Execute(ctx, formatters.FormatCreateScheduleGraphRequest(graphRequest),
+		multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	response := formatters.FormatScheduleResponse(scheduleEntity)
+	_ = json.NewEncoder(rw).Encode(response)
+}
+
 // @Summary Fetch a schedule by uuid
 // @Description Fetch a single schedule by uuid
 // @Tags Schedules
@@ -99,22 +143,58 @@ func (c *SchedulesController) getOne(rw http.ResponseWriter, request *http.Reque
 // @Produce json
 // @Security ApiKeyAuth
 // @Security JWTAuth
-// @Success 200 {array} api.ScheduleResponse "List of schedules found"
+// @Param created_after query string false "Schedules created after the given date (RFC3339)"
+// @Param created_before query string false "Schedules created before the given date (RFC3339)"
+// @Param limit query int false "Maximum response size"
+// @Param cursor query string false "Cursor of the page, as returned in the next field of the previous page"
+// @Param sort_by query string false "Sort field" Enums(created_at)
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Success 200 {object} api.ScheduleSearchResponse "Page of schedules found"
+// @Failure 400 {object} httputil.ErrorResponse "Invalid filter in the request"
 // @Failure 500 {object} httputil.ErrorResponse "Internal server error"
 // @Router /schedules [get]
 func (c *SchedulesController) getAll(rw http.ResponseWriter, request *http.Request) {
 	rw.Header().Set("Content-Type", "application/json")
 	ctx := request.Context()
 
-	schedules, err := c.ucs.SearchSchedules().Execute(ctx, multitenancy.UserInfoValue(ctx))
+	filters, err := formatters.FormatScheduleFilterRequest(request)
+	if err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if filters.Pagination.Limit > api.MaxSchedulePageSize {
+		httputil.WriteError(rw, fmt.Sprintf("limit cannot exceed %d", api.MaxSchedulePageSize), http.StatusBadRequest)
+		return
+	}
+
+	limit := filters.Pagination.Limit
+	if limit == 0 {
+		limit = api.DefaultSchedulePageSize
+	}
+	filters.Pagination.SortBy = entities.SortByCreatedAt
+	if filters.Pagination.SortOrder == "" {
+		filters.Pagination.SortOrder = entities.SortOrderAsc
+	}
+
+	// increase Limit to test more items available
+	filters.Pagination.Limit = limit + 1
+
+	schedules, err := c.ucs.SearchSchedules().Execute(ctx, filters, multitenancy.UserInfoValue(ctx))
 	if err != nil {
 		httputil.WriteHTTPErrorResponse(rw, err)
 		return
 	}
 
-	response := []*api.ScheduleResponse{}
+	response := &api.ScheduleSearchResponse{Schedules: []*api.ScheduleResponse{}}
+	if len(schedules) > limit {
+		schedules = schedules[:limit]
+		last := schedules[limit-1]
+		response.Next = (&entities.Cursor{Time: last.CreatedAt, UUID: last.UUID}).String()
+	}
+
 	for _, schedule := range schedules {
-		response = append(response, formatters.FormatScheduleResponse(schedule))
+		response.Schedules = append(response.Schedules, formatters.FormatScheduleResponse(schedule))
 	}
 
 	_ = json.NewEncoder(rw).Encode(response)
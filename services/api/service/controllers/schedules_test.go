@@ -27,18 +27,23 @@ import (
 
 type schedulesCtrlTestSuite struct {
 	suite.Suite
-	createScheduleUC  *mocks.MockCreateScheduleUseCase
-	getScheduleUC     *mocks.MockGetScheduleUseCase
-	searchSchedulesUC *mocks.MockSearchSchedulesUseCase
-	ctx               context.Context
-	userInfo          *multitenancy.UserInfo
-	router            *mux.Router
+	createScheduleUC      *mocks.MockCreateScheduleUseCase
+	createScheduleGraphUC *mocks.MockCreateScheduleGraphUseCase
+	getScheduleUC         *mocks.MockGetScheduleUseCase
+	searchSchedulesUC     *mocks.MockSearchSchedulesUseCase
+	ctx                   context.Context
+	userInfo              *multitenancy.UserInfo
+	router                *mux.Router
 }
 
 func (s *schedulesCtrlTestSuite) CreateSchedule() usecases.CreateScheduleUseCase {
 	return s.createScheduleUC
 }
 
+func (s *schedulesCtrlTestSuite) CreateScheduleGraph() usecases.CreateScheduleGraphUseCase {
+	return s.createScheduleGraphUC
+}
+
 func (s *schedulesCtrlTestSuite) GetSchedule() usecases.GetScheduleUseCase {
 	return s.getScheduleUC
 }
@@ -59,6 +64,7 @@ func (s *schedulesCtrlTestSuite) SetupTest() {
 	defer ctrl.Finish()
 
 	s.createScheduleUC = mocks.NewMockCreateScheduleUseCase(ctrl)
+	s.createScheduleGraphUC = mocks.NewMockCreateScheduleGraphUseCase(ctrl)
 	s.getScheduleUC = mocks.NewMockGetScheduleUseCase(ctrl)
 	s.searchSchedulesUC = mocks.NewMockSearchSchedulesUseCase(ctrl)
 	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
@@ -110,6 +116,77 @@ func (s *schedulesCtrlTestSuite) TestScheduleController_Create() {
 	})
 }
 
+func (s *schedulesCtrlTestSuite) TestScheduleController_CreateGraph() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		graphRequest := testutils.FakeCreateScheduleGraphRequest()
+		requestBytes, _ := json.Marshal(graphRequest)
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/schedules/graph", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		scheduleEntityResp := testutils.FakeSchedule()
+
+		s.createScheduleGraphUC.EXPECT().
+			Execute(gomock.Any(), gomock.Any(), s.userInfo).
+			DoAndReturn(func(ctx context.Context, graph *entities.ScheduleGraph, userInfo *multitenancy.UserInfo) (*entities.Schedule, error) {
+				assert.Equal(t, "ganache", graph.ChainName)
+				assert.Len(t, graph.Jobs, 2)
+				assert.Equal(t, []string{"deployRegistry"}, graph.Jobs[1].DependsOn)
+				assert.Equal(t, "${deployRegistry.receipt.contractAddress}", graph.Jobs[1].To)
+				return scheduleEntityResp, nil
+			})
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := formatters.FormatScheduleResponse(scheduleEntityResp)
+		expectedBody, _ := json.Marshal(response)
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with 400 if methodSignature is missing for a contract transaction", func(t *testing.T) {
+		graphRequest := testutils.FakeCreateScheduleGraphRequest()
+		graphRequest.Jobs[1].Params.MethodSignature = ""
+		requestBytes, _ := json.Marshal(graphRequest)
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/schedules/graph", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with 400 if jobs are missing", func(t *testing.T) {
+		graphRequest := testutils.FakeCreateScheduleGraphRequest()
+		graphRequest.Jobs = nil
+		requestBytes, _ := json.Marshal(graphRequest)
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/schedules/graph", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with 422 if use case fails with InvalidParameterError", func(t *testing.T) {
+		requestBytes, _ := json.Marshal(testutils.FakeCreateScheduleGraphRequest())
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/schedules/graph", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.createScheduleGraphUC.EXPECT().
+			Execute(gomock.Any(), gomock.Any(), s.userInfo).
+			Return(nil, errors.InvalidParameterError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusUnprocessableEntity, rw.Code)
+	})
+}
+
 func (s *schedulesCtrlTestSuite) TestScheduleController_GetOne() {
 	s.T().Run("should execute request successfully", func(t *testing.T) {
 		rw := httptest.NewRecorder()
@@ -149,16 +226,59 @@ func (s *schedulesCtrlTestSuite) TestScheduleController_GetAll() {
 		httpRequest := httptest.NewRequest(http.MethodGet, "/schedules", nil).WithContext(s.ctx)
 		schedulesEntities := []*entities.Schedule{testutils.FakeSchedule()}
 
-		s.searchSchedulesUC.EXPECT().Execute(gomock.Any(), s.userInfo).Return(schedulesEntities, nil)
+		filters := &entities.ScheduleFilters{
+			Pagination: entities.CursorPagination{
+				Limit:     txschedulertypes.DefaultSchedulePageSize + 1,
+				SortBy:    entities.SortByCreatedAt,
+				SortOrder: entities.SortOrderAsc,
+			},
+		}
+
+		s.searchSchedulesUC.EXPECT().Execute(gomock.Any(), filters, s.userInfo).Return(schedulesEntities, nil)
 
 		s.router.ServeHTTP(rw, httpRequest)
 
-		response := []*txschedulertypes.ScheduleResponse{formatters.FormatScheduleResponse(schedulesEntities[0])}
+		response := &txschedulertypes.ScheduleSearchResponse{
+			Schedules: []*txschedulertypes.ScheduleResponse{formatters.FormatScheduleResponse(schedulesEntities[0])},
+		}
 		expectedBody, _ := json.Marshal(response)
 		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
 		assert.Equal(t, http.StatusOK, rw.Code)
 	})
 
+	s.T().Run("should return the cursor of the next page", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/schedules?limit=1&order=desc", nil).WithContext(s.ctx)
+		schedulesEntities := []*entities.Schedule{testutils.FakeSchedule(), testutils.FakeSchedule()}
+		filters := &entities.ScheduleFilters{
+			Pagination: entities.CursorPagination{
+				Limit:     2,
+				SortBy:    entities.SortByCreatedAt,
+				SortOrder: entities.SortOrderDesc,
+			},
+		}
+
+		s.searchSchedulesUC.EXPECT().Execute(gomock.Any(), filters, s.userInfo).Return(schedulesEntities, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := &txschedulertypes.ScheduleSearchResponse{
+			Schedules: []*txschedulertypes.ScheduleResponse{formatters.FormatScheduleResponse(schedulesEntities[0])},
+			Next:      (&entities.Cursor{Time: schedulesEntities[0].CreatedAt, UUID: schedulesEntities[0].UUID}).String(),
+		}
+		expectedBody, _ := json.Marshal(response)
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with 400 if sorted by an unsupported field", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/schedules?sort_by=updated_at", nil).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
 	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
 	s.T().Run("should fail with 404 if use case fails with NotFoundError", func(t *testing.T) {
 		rw := httptest.NewRecorder()
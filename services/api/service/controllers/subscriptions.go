@@ -0,0 +1,189 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	jsonutils "github.com/consensys/orchestrate/pkg/encoding/json"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/http/httputil"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/formatters"
+
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/gorilla/mux"
+)
+
+type SubscriptionsController struct {
+	ucs usecases.SubscriptionUseCases
+}
+
+func NewSubscriptionsController(ucs usecases.SubscriptionUseCases) *SubscriptionsController {
+	return &SubscriptionsController{ucs: ucs}
+}
+
+// Add routes to router
+func (c *SubscriptionsController) Append(router *mux.Router) {
+	router.Methods(http.MethodGet).Path("/subscriptions").HandlerFunc(c.search)
+	router.Methods(http.MethodGet).Path("/subscriptions/{uuid}").HandlerFunc(c.getOne)
+	router.Methods(http.MethodPost).Path("/subscriptions").HandlerFunc(c.create)
+	router.Methods(http.MethodPatch).Path("/subscriptions/{uuid}").HandlerFunc(c.update)
+	router.Methods(http.MethodDelete).Path("/subscriptions/{uuid}").HandlerFunc(c.delete)
+}
+
+// @Summary Retrieves a list of all subscriptions
+// @Tags Subscriptions
+// @Produce json
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param chain_uuid query string false "UUID of the chain"
+// @Param contract_address query string false "address of the contract"
+// @Success 200 {array} api.SubscriptionResponse
+// @Failure 400 {object} httputil.ErrorResponse "Invalid request"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /subscriptions [get]
+func (c *SubscriptionsController) search(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	filters, err := formatters.FormatSubscriptionFiltersRequest(request)
+	if err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	subscriptions, err := c.ucs.SearchSubscriptions().Execute(ctx, filters, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	response := []*api.SubscriptionResponse{}
+	for _, subscription := range subscriptions {
+		response = append(response, formatters.FormatSubscriptionResponse(subscription, canReadSecrets(ctx)))
+	}
+
+	_ = json.NewEncoder(rw).Encode(response)
+}
+
+// @Summary Retrieves a subscription by ID
+// @Tags Subscriptions
+// @Produce json
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param uuid path string true "ID of the subscription"
+// @Success 200 {object} api.SubscriptionResponse
+// @Failure 404 {object} httputil.ErrorResponse "Subscription not found"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /subscriptions/{uuid} [get]
+func (c *SubscriptionsController) getOne(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	subscription, err := c.ucs.GetSubscription().Execute(ctx, mux.Vars(request)["uuid"], multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatSubscriptionResponse(subscription, canReadSecrets(ctx)))
+}
+
+// @Summary Subscribes to the events of a contract
+// @Description Every log emitted by the contract and matching the event signature and the topic filters is decoded and delivered to a Kafka topic or to a webhook
+// @Tags Subscriptions
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param request body api.CreateSubscriptionRequest true "Subscription creation request"
+// @Success 200 {object} api.SubscriptionResponse
+// @Failure 400 {object} httputil.ErrorResponse "Invalid request"
+// @Failure 422 {object} httputil.ErrorResponse "Unprocessable entity"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /subscriptions [post]
+func (c *SubscriptionsController) create(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	subscriptionRequest := &api.CreateSubscriptionRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, subscriptionRequest)
+	if err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	subscription, err := c.ucs.CreateSubscription().Execute(ctx, formatters.FormatCreateSubscriptionRequest(subscriptionRequest),
+		multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatSubscriptionResponse(subscription, canReadSecrets(ctx)))
+}
+
+// @Summary Updates a subscription by ID
+// @Tags Subscriptions
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param uuid path string true "ID of the subscription"
+// @Param request body api.UpdateSubscriptionRequest true "Subscription update request"
+// @Success 200 {object} api.SubscriptionResponse
+// @Failure 400 {object} httputil.ErrorResponse "Invalid request"
+// @Failure 404 {object} httputil.ErrorResponse "Subscription not found"
+// @Failure 422 {object} httputil.ErrorResponse "Unprocessable entity"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /subscriptions/{uuid} [patch]
+func (c *SubscriptionsController) update(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	subscriptionRequest := &api.UpdateSubscriptionRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, subscriptionRequest)
+	if err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	uuid := mux.Vars(request)["uuid"]
+	subscription, err := c.ucs.UpdateSubscription().Execute(ctx, formatters.FormatUpdateSubscriptionRequest(subscriptionRequest, uuid),
+		multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatSubscriptionResponse(subscription, canReadSecrets(ctx)))
+}
+
+// @Summary Deletes a subscription by ID
+// @Tags Subscriptions
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param uuid path string true "ID of the subscription"
+// @Success 204
+// @Failure 400 {object} httputil.ErrorResponse "Invalid request"
+// @Failure 404 {object} httputil.ErrorResponse "Subscription not found"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /subscriptions/{uuid} [delete]
+func (c *SubscriptionsController) delete(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	err := c.ucs.DeleteSubscription().Execute(ctx, mux.Vars(request)["uuid"], multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// canReadSecrets indicates whether the webhook secrets and headers can be returned. Users authenticated with a JWT are
+// scoped to a tenant and never read them back, they are only returned to internal services delivering the events
+func canReadSecrets(ctx context.Context) bool {
+	return multitenancy.UserInfoValue(ctx).AuthMode != multitenancy.AuthMethodJWT
+}
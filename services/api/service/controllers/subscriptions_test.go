@@ -0,0 +1,279 @@
+// +build unit
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/formatters"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/business/use-cases/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+const subscriptionsEndpoint = "/subscriptions"
+
+type subscriptionsCtrlTestSuite struct {
+	suite.Suite
+	createSubscriptionUC  *mocks.MockCreateSubscriptionUseCase
+	getSubscriptionUC     *mocks.MockGetSubscriptionUseCase
+	searchSubscriptionsUC *mocks.MockSearchSubscriptionsUseCase
+	updateSubscriptionUC  *mocks.MockUpdateSubscriptionUseCase
+	deleteSubscriptionUC  *mocks.MockDeleteSubscriptionUseCase
+	ctx                   context.Context
+	userInfo              *multitenancy.UserInfo
+	router                *mux.Router
+}
+
+var _ usecases.SubscriptionUseCases = &subscriptionsCtrlTestSuite{}
+
+func (s *subscriptionsCtrlTestSuite) CreateSubscription() usecases.CreateSubscriptionUseCase {
+	return s.createSubscriptionUC
+}
+
+func (s *subscriptionsCtrlTestSuite) GetSubscription() usecases.GetSubscriptionUseCase {
+	return s.getSubscriptionUC
+}
+
+func (s *subscriptionsCtrlTestSuite) SearchSubscriptions() usecases.SearchSubscriptionsUseCase {
+	return s.searchSubscriptionsUC
+}
+
+func (s *subscriptionsCtrlTestSuite) UpdateSubscription() usecases.UpdateSubscriptionUseCase {
+	return s.updateSubscriptionUC
+}
+
+func (s *subscriptionsCtrlTestSuite) DeleteSubscription() usecases.DeleteSubscriptionUseCase {
+	return s.deleteSubscriptionUC
+}
+
+func TestSubscriptionsController(t *testing.T) {
+	s := new(subscriptionsCtrlTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *subscriptionsCtrlTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.createSubscriptionUC = mocks.NewMockCreateSubscriptionUseCase(ctrl)
+	s.getSubscriptionUC = mocks.NewMockGetSubscriptionUseCase(ctrl)
+	s.searchSubscriptionsUC = mocks.NewMockSearchSubscriptionsUseCase(ctrl)
+	s.updateSubscriptionUC = mocks.NewMockUpdateSubscriptionUseCase(ctrl)
+	s.deleteSubscriptionUC = mocks.NewMockDeleteSubscriptionUseCase(ctrl)
+
+	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
+	s.ctx = multitenancy.WithUserInfo(context.Background(), s.userInfo)
+	s.router = mux.NewRouter()
+
+	controller := NewSubscriptionsController(s)
+	controller.Append(s.router)
+}
+
+func (s *subscriptionsCtrlTestSuite) TestSubscriptionsController_Create() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		req := testutils.FakeCreateSubscriptionRequest()
+		requestBytes, _ := json.Marshal(req)
+		subscription := testutils.FakeSubscription()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, subscriptionsEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.createSubscriptionUC.EXPECT().Execute(gomock.Any(), formatters.FormatCreateSubscriptionRequest(req), s.userInfo).
+			Return(subscription, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(formatters.FormatSubscriptionResponse(subscription, true))
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if webhook target has no URL", func(t *testing.T) {
+		req := testutils.FakeCreateSubscriptionRequest()
+		req.Target = &api.SubscriptionTargetRequest{Type: entities.WebhookSubscriptionTargetType}
+		requestBytes, _ := json.Marshal(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, subscriptionsEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if target type is invalid", func(t *testing.T) {
+		req := testutils.FakeCreateSubscriptionRequest()
+		req.Target.Type = "invalid"
+		requestBytes, _ := json.Marshal(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, subscriptionsEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with 422 if use case fails with InvalidParameterError", func(t *testing.T) {
+		req := testutils.FakeCreateSubscriptionRequest()
+		requestBytes, _ := json.Marshal(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, subscriptionsEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.createSubscriptionUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).
+			Return(nil, errors.InvalidParameterError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rw.Code)
+	})
+}
+
+func (s *subscriptionsCtrlTestSuite) TestSubscriptionsController_GetOne() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		subscription := testutils.FakeSubscription()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, subscriptionsEndpoint+"/"+subscription.UUID, nil).
+			WithContext(s.ctx)
+
+		s.getSubscriptionUC.EXPECT().Execute(gomock.Any(), subscription.UUID, s.userInfo).Return(subscription, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(formatters.FormatSubscriptionResponse(subscription, true))
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should not return webhook secret and headers to users authenticated with a JWT", func(t *testing.T) {
+		subscription := testutils.FakeSubscription()
+		userInfo := multitenancy.NewJWTUserInfo(&entities.UserClaims{TenantID: "tenantOne", Username: "username"}, "token")
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, subscriptionsEndpoint+"/"+subscription.UUID, nil).
+			WithContext(multitenancy.WithUserInfo(context.Background(), userInfo))
+
+		s.getSubscriptionUC.EXPECT().Execute(gomock.Any(), subscription.UUID, userInfo).Return(subscription, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		resp := &api.SubscriptionResponse{}
+		assert.NoError(t, json.Unmarshal(rw.Body.Bytes(), resp))
+		assert.Equal(t, http.StatusOK, rw.Code)
+		assert.Equal(t, subscription.Target.URL, resp.Target.URL)
+		assert.Empty(t, resp.Target.Secret)
+		assert.Empty(t, resp.Target.Headers)
+	})
+
+	s.T().Run("should fail with 404 if use case fails with NotFoundError", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, subscriptionsEndpoint+"/uuid", nil).
+			WithContext(s.ctx)
+
+		s.getSubscriptionUC.EXPECT().Execute(gomock.Any(), "uuid", s.userInfo).Return(nil, errors.NotFoundError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+	})
+}
+
+func (s *subscriptionsCtrlTestSuite) TestSubscriptionsController_Search() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		subscription := testutils.FakeSubscription()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, subscriptionsEndpoint+"?chain_uuid="+subscription.ChainUUID, nil).
+			WithContext(s.ctx)
+
+		s.searchSubscriptionsUC.EXPECT().
+			Execute(gomock.Any(), &entities.SubscriptionFilters{ChainUUID: subscription.ChainUUID}, s.userInfo).
+			Return([]*entities.Subscription{subscription}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal([]*api.SubscriptionResponse{formatters.FormatSubscriptionResponse(subscription, true)})
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if chain UUID is invalid", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, subscriptionsEndpoint+"?chain_uuid=invalid", nil).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+}
+
+func (s *subscriptionsCtrlTestSuite) TestSubscriptionsController_Update() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		req := testutils.FakeUpdateSubscriptionRequest()
+		requestBytes, _ := json.Marshal(req)
+		subscription := testutils.FakeSubscription()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPatch, subscriptionsEndpoint+"/"+subscription.UUID, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.updateSubscriptionUC.EXPECT().
+			Execute(gomock.Any(), formatters.FormatUpdateSubscriptionRequest(req, subscription.UUID), s.userInfo).
+			Return(subscription, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(formatters.FormatSubscriptionResponse(subscription, true))
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+}
+
+func (s *subscriptionsCtrlTestSuite) TestSubscriptionsController_Delete() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodDelete, subscriptionsEndpoint+"/uuid", nil).
+			WithContext(s.ctx)
+
+		s.deleteSubscriptionUC.EXPECT().Execute(gomock.Any(), "uuid", s.userInfo).Return(nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusNoContent, rw.Code)
+	})
+}
@@ -0,0 +1,209 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	jsonutils "github.com/consensys/orchestrate/pkg/encoding/json"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/http/httputil"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/formatters"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/gorilla/mux"
+)
+
+type TokensController struct {
+	ucs usecases.TokenUseCases
+}
+
+func NewTokensController(ucs usecases.TokenUseCases) *TokensController {
+	return &TokensController{
+		ucs: ucs,
+	}
+}
+
+// Add routes to router
+func (c *TokensController) Append(router *mux.Router) {
+	router.Methods(http.MethodPost).Path("/tokens/{standard}/{operation:transfer|approve|mint}").HandlerFunc(c.send)
+	router.Methods(http.MethodGet).Path("/tokens/{standard}/{address}/balanceOf").HandlerFunc(c.balanceOf)
+	router.Methods(http.MethodGet).Path("/tokens/{standard}/{address}/allowance").HandlerFunc(c.allowance)
+	router.Methods(http.MethodGet).Path("/tokens/{standard}/{address}/ownerOf").HandlerFunc(c.ownerOf)
+}
+
+// @Summary Creates and sends a new token transaction
+// @Description Transfers (`safeTransferFrom` for ERC-721 and ERC-1155), approves (`setApprovalForAll` for ERC-1155) or mints tokens
+// @Description The transaction is encoded with the ABI of the token standard, the token does not need to be registered
+// @Tags Tokens
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param standard path string true "token standard" Enums(erc20, erc721, erc1155)
+// @Param operation path string true "token operation" Enums(transfer, approve, mint)
+// @Param request body api.TokenTxRequest{params=api.TokenTxParams{gasPricePolicy=api.GasPriceParams{retryPolicy=api.RetryParams}}} true "Token transaction request"
+// @Success 202 {object} api.TransactionResponse "Created token transaction request"
+// @Failure 400 {object} httputil.ErrorResponse "Invalid request"
+// @Failure 409 {object} httputil.ErrorResponse "Already existing transaction"
+// @Failure 422 {object} httputil.ErrorResponse "Unprocessable parameters were sent"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /tokens/{standard}/{operation} [post]
+func (c *TokensController) send(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	txRequest := &api.TokenTxRequest{}
+	if err := jsonutils.UnmarshalBody(request.Body, txRequest); err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := txRequest.Params.Validate(); err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	standard := entities.TokenStandard(mux.Vars(request)["standard"])
+	operation := entities.TokenOperation(mux.Vars(request)["operation"])
+	txReq, err := formatters.FormatTokenTxRequest(standard, operation, txRequest, request.Header.Get(IdempotencyKeyHeader))
+	if err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	txResponse, err := c.ucs.SendTokenTransaction().Execute(ctx, standard, txReq, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(rw).Encode(formatters.FormatTxResponse(txResponse))
+}
+
+// @Summary Gets the token balance of an account
+// @Tags Tokens
+// @Produce json
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param standard path string true "token standard" Enums(erc20, erc721, erc1155)
+// @Param address path string true "address of the token contract"
+// @Param chain_uuid query string true "UUID of the chain"
+// @Param owner query string true "account holding the tokens"
+// @Param tokenId query string false "ERC-1155 only. Identifier of the token"
+// @Param block query int false "block at which the balance is read (default latest block)"
+// @Success 200 {object} api.TokenBalanceResponse
+// @Failure 400 {object} httputil.ErrorResponse "Invalid request"
+// @Failure 404 {object} httputil.ErrorResponse "Chain not found"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /tokens/{standard}/{address}/balanceOf [get]
+func (c *TokensController) balanceOf(rw http.ResponseWriter, request *http.Request) {
+	outputs, ok := c.call(rw, request, formatters.TokenBalanceOfMethod)
+	if !ok {
+		return
+	}
+
+	response, err := formatters.FormatTokenBalanceResponse(outputs)
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(response)
+}
+
+// @Summary Gets the amount of ERC-20 tokens a spender is allowed to transfer
+// @Tags Tokens
+// @Produce json
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param standard path string true "token standard" Enums(erc20)
+// @Param address path string true "address of the token contract"
+// @Param chain_uuid query string true "UUID of the chain"
+// @Param owner query string true "account holding the tokens"
+// @Param spender query string true "account allowed to transfer the tokens"
+// @Param block query int false "block at which the allowance is read (default latest block)"
+// @Success 200 {object} api.TokenAllowanceResponse
+// @Failure 400 {object} httputil.ErrorResponse "Invalid request"
+// @Failure 404 {object} httputil.ErrorResponse "Chain not found"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /tokens/{standard}/{address}/allowance [get]
+func (c *TokensController) allowance(rw http.ResponseWriter, request *http.Request) {
+	outputs, ok := c.call(rw, request, formatters.TokenAllowanceMethod)
+	if !ok {
+		return
+	}
+
+	response, err := formatters.FormatTokenAllowanceResponse(outputs)
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(response)
+}
+
+// @Summary Gets the owner of an ERC-721 token
+// @Tags Tokens
+// @Produce json
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param standard path string true "token standard" Enums(erc721)
+// @Param address path string true "address of the token contract"
+// @Param chain_uuid query string true "UUID of the chain"
+// @Param tokenId query string true "identifier of the token"
+// @Param block query int false "block at which the owner is read (default latest block)"
+// @Success 200 {object} api.TokenOwnerResponse
+// @Failure 400 {object} httputil.ErrorResponse "Invalid request"
+// @Failure 404 {object} httputil.ErrorResponse "Chain not found"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /tokens/{standard}/{address}/ownerOf [get]
+func (c *TokensController) ownerOf(rw http.ResponseWriter, request *http.Request) {
+	outputs, ok := c.call(rw, request, formatters.TokenOwnerOfMethod)
+	if !ok {
+		return
+	}
+
+	response, err := formatters.FormatTokenOwnerResponse(outputs)
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(response)
+}
+
+// call executes a read-only method of the token, the error response being written if it fails
+func (c *TokensController) call(rw http.ResponseWriter, request *http.Request, method string) (map[string]interface{}, bool) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	address := mux.Vars(request)["address"]
+	if !ethcommon.IsHexAddress(address) {
+		httputil.WriteError(rw, "address must be an address", http.StatusBadRequest)
+		return nil, false
+	}
+
+	chainUUID := request.URL.Query().Get("chain_uuid")
+	if chainUUID == "" {
+		httputil.WriteError(rw, "chain_uuid is required", http.StatusBadRequest)
+		return nil, false
+	}
+
+	standard := entities.TokenStandard(mux.Vars(request)["standard"])
+	call, err := formatters.FormatTokenCallRequest(standard, method, ethcommon.HexToAddress(address), request)
+	if err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+
+	outputs, err := c.ucs.CallToken().Execute(ctx, chainUUID, standard, call, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return nil, false
+	}
+
+	return outputs, true
+}
@@ -0,0 +1,238 @@
+// +build unit
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/tokens"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/formatters"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/business/use-cases/mocks"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+const tokenAddress = "0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"
+
+type tokensCtrlTestSuite struct {
+	suite.Suite
+	sendTokenTxUC *mocks.MockSendTokenTxUseCase
+	callTokenUC   *mocks.MockCallTokenUseCase
+	ctx           context.Context
+	userInfo      *multitenancy.UserInfo
+	router        *mux.Router
+}
+
+var _ usecases.TokenUseCases = &tokensCtrlTestSuite{}
+
+func (s *tokensCtrlTestSuite) SendTokenTransaction() usecases.SendTokenTxUseCase {
+	return s.sendTokenTxUC
+}
+
+func (s *tokensCtrlTestSuite) CallToken() usecases.CallTokenUseCase {
+	return s.callTokenUC
+}
+
+func TestTokensController(t *testing.T) {
+	s := new(tokensCtrlTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *tokensCtrlTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.sendTokenTxUC = mocks.NewMockSendTokenTxUseCase(ctrl)
+	s.callTokenUC = mocks.NewMockCallTokenUseCase(ctrl)
+	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
+	s.ctx = multitenancy.WithUserInfo(context.Background(), s.userInfo)
+	s.router = mux.NewRouter()
+
+	controller := NewTokensController(s)
+	controller.Append(s.router)
+}
+
+func (s *tokensCtrlTestSuite) TestSend() {
+	newRequest := func() *api.TokenTxRequest {
+		return &api.TokenTxRequest{
+			ChainName: "chain",
+			Params: api.TokenTxParams{
+				Token:  ethcommon.HexToAddress(tokenAddress),
+				From:   ethcommon.HexToAddress("0x1abae27a0cbfb02945720425d3b80c7e09728534"),
+				To:     ethcommon.HexToAddress("0x2abae27a0cbfb02945720425d3b80c7e09728534"),
+				Amount: (*hexutil.Big)(big.NewInt(1000)),
+			},
+		}
+	}
+
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		req := newRequest()
+		requestBytes, _ := json.Marshal(req)
+		txRequest := testutils.FakeTxRequest()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/tokens/erc20/transfer", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+		httpRequest.Header.Set(IdempotencyKeyHeader, "idempotencyKey")
+
+		expectedTxRequest, _ := formatters.FormatTokenTxRequest(entities.ERC20TokenStandard, entities.TransferTokenOperation, req, "idempotencyKey")
+		s.sendTokenTxUC.EXPECT().Execute(gomock.Any(), entities.ERC20TokenStandard, expectedTxRequest, s.userInfo).Return(txRequest, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := formatters.FormatTxResponse(txRequest)
+		expectedBody, _ := json.Marshal(response)
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusAccepted, rw.Code)
+	})
+
+	s.T().Run("should fail with 400 if token is missing", func(t *testing.T) {
+		req := newRequest()
+		req.Params.Token = ethcommon.Address{}
+		requestBytes, _ := json.Marshal(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/tokens/erc20/transfer", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with 400 if operation is missing parameters of the standard", func(t *testing.T) {
+		requestBytes, _ := json.Marshal(newRequest())
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/tokens/erc721/mint", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with 404 if operation is not supported", func(t *testing.T) {
+		requestBytes, _ := json.Marshal(newRequest())
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/tokens/erc20/burn", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+	})
+}
+
+func (s *tokensCtrlTestSuite) TestBalanceOf() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, "/tokens/erc20/"+tokenAddress+"/balanceOf?chain_uuid=chainUUID&owner=0x1abae27a0cbfb02945720425d3b80c7e09728534", nil).
+			WithContext(s.ctx)
+
+		s.callTokenUC.EXPECT().Execute(gomock.Any(), "chainUUID", entities.ERC20TokenStandard, gomock.Any(), s.userInfo).
+			DoAndReturn(func(_ context.Context, _ string, _ entities.TokenStandard, call *entities.ContractCall, _ *multitenancy.UserInfo) (map[string]interface{}, error) {
+				assert.Equal(t, tokens.ERC20BalanceOf, call.MethodSignature)
+				assert.Equal(t, ethcommon.HexToAddress(tokenAddress), call.To)
+				return map[string]interface{}{"balance": big.NewInt(1000)}, nil
+			})
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, `{"balance":"0x3e8"}`+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with 400 if chain_uuid is missing", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, "/tokens/erc20/"+tokenAddress+"/balanceOf?owner=0x1abae27a0cbfb02945720425d3b80c7e09728534", nil).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with 404 if use case fails with NotFoundError", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, "/tokens/erc20/"+tokenAddress+"/balanceOf?chain_uuid=chainUUID&owner=0x1abae27a0cbfb02945720425d3b80c7e09728534", nil).
+			WithContext(s.ctx)
+
+		s.callTokenUC.EXPECT().Execute(gomock.Any(), "chainUUID", entities.ERC20TokenStandard, gomock.Any(), s.userInfo).
+			Return(nil, errors.NotFoundError("chain not found"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+	})
+}
+
+func (s *tokensCtrlTestSuite) TestAllowance() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, "/tokens/erc20/"+tokenAddress+"/allowance?chain_uuid=chainUUID&owner=0x1abae27a0cbfb02945720425d3b80c7e09728534&spender=0x2abae27a0cbfb02945720425d3b80c7e09728534", nil).
+			WithContext(s.ctx)
+
+		s.callTokenUC.EXPECT().Execute(gomock.Any(), "chainUUID", entities.ERC20TokenStandard, gomock.Any(), s.userInfo).
+			Return(map[string]interface{}{"allowance": big.NewInt(16)}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, `{"allowance":"0x10"}`+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+}
+
+func (s *tokensCtrlTestSuite) TestOwnerOf() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		owner := ethcommon.HexToAddress("0x1abae27a0cbfb02945720425d3b80c7e09728534")
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, "/tokens/erc721/"+tokenAddress+"/ownerOf?chain_uuid=chainUUID&tokenId=1", nil).
+			WithContext(s.ctx)
+
+		s.callTokenUC.EXPECT().Execute(gomock.Any(), "chainUUID", entities.ERC721TokenStandard, gomock.Any(), s.userInfo).
+			Return(map[string]interface{}{"owner": owner}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, `{"owner":"0x1abae27a0cbfb02945720425d3b80c7e09728534"}`+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with 400 if token identifier is missing", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, "/tokens/erc721/"+tokenAddress+"/ownerOf?chain_uuid=chainUUID", nil).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+}
@@ -0,0 +1,151 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	jsonutils "github.com/consensys/orchestrate/pkg/encoding/json"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/http/httputil"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/formatters"
+
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/gorilla/mux"
+)
+
+type TransactionPoliciesController struct {
+	ucs usecases.TransactionPolicyUseCases
+}
+
+func NewTransactionPoliciesController(ucs usecases.TransactionPolicyUseCases) *TransactionPoliciesController {
+	return &TransactionPoliciesController{ucs: ucs}
+}
+
+// Add routes to router
+func (c *TransactionPoliciesController) Append(router *mux.Router) {
+	router.Methods(http.MethodGet).Path("/transaction-policies").HandlerFunc(c.search)
+	router.Methods(http.MethodGet).Path("/transaction-policies/{uuid}").HandlerFunc(c.getOne)
+	router.Methods(http.MethodPost).Path("/transaction-policies").HandlerFunc(c.create)
+	router.Methods(http.MethodDelete).Path("/transaction-policies/{uuid}").HandlerFunc(c.delete)
+}
+
+// @Summary Retrieves a list of all transaction policies
+// @Tags Transaction Policies
+// @Produce json
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param type query string false "type of the policy"
+// @Param chain_uuid query string false "UUID of the chain"
+// @Success 200 {array} api.TransactionPolicyResponse
+// @Failure 400 {object} httputil.ErrorResponse "Invalid request"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /transaction-policies [get]
+func (c *TransactionPoliciesController) search(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	filters, err := formatters.FormatTransactionPolicyFiltersRequest(request)
+	if err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	policies, err := c.ucs.SearchTransactionPolicies().Execute(ctx, filters, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	response := []*api.TransactionPolicyResponse{}
+	for _, policy := range policies {
+		response = append(response, formatters.FormatTransactionPolicyResponse(policy))
+	}
+
+	_ = json.NewEncoder(rw).Encode(response)
+}
+
+// @Summary Retrieves a transaction policy by ID
+// @Tags Transaction Policies
+// @Produce json
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param uuid path string true "ID of the transaction policy"
+// @Success 200 {object} api.TransactionPolicyResponse
+// @Failure 404 {object} httputil.ErrorResponse "Transaction policy not found"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /transaction-policies/{uuid} [get]
+func (c *TransactionPoliciesController) getOne(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	policy, err := c.ucs.GetTransactionPolicy().Execute(ctx, mux.Vars(request)["uuid"], multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatTransactionPolicyResponse(policy))
+}
+
+// @Summary Creates a transaction policy
+// @Description Jobs of the tenant breaking the policy are rejected at creation. Daily value caps count the value of the jobs created during the day (UTC)
+// @Tags Transaction Policies
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param request body api.CreateTransactionPolicyRequest true "Transaction policy creation request"
+// @Success 200 {object} api.TransactionPolicyResponse
+// @Failure 400 {object} httputil.ErrorResponse "Invalid request"
+// @Failure 422 {object} httputil.ErrorResponse "Unprocessable entity"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /transaction-policies [post]
+func (c *TransactionPoliciesController) create(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	policyRequest := &api.CreateTransactionPolicyRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, policyRequest)
+	if err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err = policyRequest.Validate(); err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	policy, err := c.ucs.CreateTransactionPolicy().Execute(ctx, formatters.FormatCreateTransactionPolicyRequest(policyRequest),
+		multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatTransactionPolicyResponse(policy))
+}
+
+// @Summary Deletes a transaction policy by ID
+// @Description Spendings recorded for the policy are deleted with it
+// @Tags Transaction Policies
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param uuid path string true "ID of the transaction policy"
+// @Success 204
+// @Failure 400 {object} httputil.ErrorResponse "Invalid request"
+// @Failure 404 {object} httputil.ErrorResponse "Transaction policy not found"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /transaction-policies/{uuid} [delete]
+func (c *TransactionPoliciesController) delete(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	err := c.ucs.DeleteTransactionPolicy().Execute(ctx, mux.Vars(request)["uuid"], multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
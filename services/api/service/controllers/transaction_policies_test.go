@@ -0,0 +1,252 @@
+// +build unit
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/formatters"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/business/use-cases/mocks"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+const txPoliciesEndpoint = "/transaction-policies"
+
+type txPoliciesCtrlTestSuite struct {
+	suite.Suite
+	createTransactionPolicyUC   *mocks.MockCreateTransactionPolicyUseCase
+	getTransactionPolicyUC      *mocks.MockGetTransactionPolicyUseCase
+	searchTransactionPoliciesUC *mocks.MockSearchTransactionPoliciesUseCase
+	deleteTransactionPolicyUC   *mocks.MockDeleteTransactionPolicyUseCase
+	enforceTxPoliciesUC         *mocks.MockEnforceTransactionPoliciesUseCase
+	ctx                         context.Context
+	userInfo                    *multitenancy.UserInfo
+	router                      *mux.Router
+}
+
+var _ usecases.TransactionPolicyUseCases = &txPoliciesCtrlTestSuite{}
+
+func (s *txPoliciesCtrlTestSuite) CreateTransactionPolicy() usecases.CreateTransactionPolicyUseCase {
+	return s.createTransactionPolicyUC
+}
+
+func (s *txPoliciesCtrlTestSuite) GetTransactionPolicy() usecases.GetTransactionPolicyUseCase {
+	return s.getTransactionPolicyUC
+}
+
+func (s *txPoliciesCtrlTestSuite) SearchTransactionPolicies() usecases.SearchTransactionPoliciesUseCase {
+	return s.searchTransactionPoliciesUC
+}
+
+func (s *txPoliciesCtrlTestSuite) DeleteTransactionPolicy() usecases.DeleteTransactionPolicyUseCase {
+	return s.deleteTransactionPolicyUC
+}
+
+func (s *txPoliciesCtrlTestSuite) EnforceTransactionPolicies() usecases.EnforceTransactionPoliciesUseCase {
+	return s.enforceTxPoliciesUC
+}
+
+func TestTransactionPoliciesController(t *testing.T) {
+	s := new(txPoliciesCtrlTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *txPoliciesCtrlTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.createTransactionPolicyUC = mocks.NewMockCreateTransactionPolicyUseCase(ctrl)
+	s.getTransactionPolicyUC = mocks.NewMockGetTransactionPolicyUseCase(ctrl)
+	s.searchTransactionPoliciesUC = mocks.NewMockSearchTransactionPoliciesUseCase(ctrl)
+	s.deleteTransactionPolicyUC = mocks.NewMockDeleteTransactionPolicyUseCase(ctrl)
+	s.enforceTxPoliciesUC = mocks.NewMockEnforceTransactionPoliciesUseCase(ctrl)
+
+	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
+	s.ctx = multitenancy.WithUserInfo(context.Background(), s.userInfo)
+	s.router = mux.NewRouter()
+
+	controller := NewTransactionPoliciesController(s)
+	controller.Append(s.router)
+}
+
+func (s *txPoliciesCtrlTestSuite) TestTransactionPoliciesController_Create() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		req := testutils.FakeCreateTransactionPolicyRequest()
+		requestBytes, _ := json.Marshal(req)
+		policy := testutils.FakeTransactionPolicy()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, txPoliciesEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.createTransactionPolicyUC.EXPECT().Execute(gomock.Any(), formatters.FormatCreateTransactionPolicyRequest(req), s.userInfo).
+			Return(policy, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(formatters.FormatTransactionPolicyResponse(policy))
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if type is invalid", func(t *testing.T) {
+		req := testutils.FakeCreateTransactionPolicyRequest()
+		req.Type = "invalidType"
+		requestBytes, _ := json.Marshal(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, txPoliciesEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if the max daily value of a daily value cap is missing", func(t *testing.T) {
+		req := testutils.FakeCreateTransactionPolicyRequest()
+		req.Type = entities.DailyValueCapPolicyType
+		requestBytes, _ := json.Marshal(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, txPoliciesEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with 422 if the addresses of an allowlist are missing", func(t *testing.T) {
+		req := testutils.FakeCreateTransactionPolicyRequest()
+		req.Addresses = nil
+		requestBytes, _ := json.Marshal(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, txPoliciesEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rw.Code)
+	})
+
+	s.T().Run("should fail with 422 if use case fails with InvalidParameterError", func(t *testing.T) {
+		req := testutils.FakeCreateTransactionPolicyRequest()
+		requestBytes, _ := json.Marshal(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, txPoliciesEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.createTransactionPolicyUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).
+			Return(nil, errors.InvalidParameterError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rw.Code)
+	})
+}
+
+func (s *txPoliciesCtrlTestSuite) TestTransactionPoliciesController_GetOne() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		policy := testutils.FakeTransactionPolicy()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, txPoliciesEndpoint+"/"+policy.UUID, nil).
+			WithContext(s.ctx)
+
+		s.getTransactionPolicyUC.EXPECT().Execute(gomock.Any(), policy.UUID, s.userInfo).Return(policy, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(formatters.FormatTransactionPolicyResponse(policy))
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with 404 if use case fails with NotFoundError", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, txPoliciesEndpoint+"/uuid", nil).
+			WithContext(s.ctx)
+
+		s.getTransactionPolicyUC.EXPECT().Execute(gomock.Any(), "uuid", s.userInfo).Return(nil, errors.NotFoundError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+	})
+}
+
+func (s *txPoliciesCtrlTestSuite) TestTransactionPoliciesController_Search() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		policy := testutils.FakeTransactionPolicy()
+		policy.ChainUUID = uuid.Must(uuid.NewV4()).String()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, txPoliciesEndpoint+"?chain_uuid="+policy.ChainUUID, nil).
+			WithContext(s.ctx)
+
+		s.searchTransactionPoliciesUC.EXPECT().
+			Execute(gomock.Any(), &entities.TransactionPolicyFilters{ChainUUID: policy.ChainUUID}, s.userInfo).
+			Return([]*entities.TransactionPolicy{policy}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal([]*api.TransactionPolicyResponse{formatters.FormatTransactionPolicyResponse(policy)})
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if chain UUID is invalid", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, txPoliciesEndpoint+"?chain_uuid=invalid", nil).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+}
+
+func (s *txPoliciesCtrlTestSuite) TestTransactionPoliciesController_Delete() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodDelete, txPoliciesEndpoint+"/uuid", nil).
+			WithContext(s.ctx)
+
+		s.deleteTransactionPolicyUC.EXPECT().Execute(gomock.Any(), "uuid", s.userInfo).Return(nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusNoContent, rw.Code)
+	})
+}
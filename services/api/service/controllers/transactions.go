@@ -39,12 +39,16 @@ func NewTransactionsController(ucs usecases.TransactionUseCases) *TransactionsCo
 func (c *TransactionsController) Append(router *mux.Router) {
 	router.Methods(http.MethodPost).Path("/transactions/send").
 		Handler(http.HandlerFunc(c.send))
+	router.Methods(http.MethodPost).Path("/transactions/batch").
+		Handler(http.HandlerFunc(c.sendBatch))
 	router.Methods(http.MethodPost).Path("/transactions/send-raw").
 		Handler(http.HandlerFunc(c.sendRaw))
 	router.Methods(http.MethodPost).Path("/transactions/transfer").
 		Handler(http.HandlerFunc(c.transfer))
 	router.Methods(http.MethodPost).Path("/transactions/deploy-contract").
 		Handler(http.HandlerFunc(c.deployContract))
+	router.Methods(http.MethodPost).Path("/transactions/simulate").
+		Handler(http.HandlerFunc(c.simulate))
 	router.Methods(http.MethodGet).Path("/transactions/{uuid}").
 		Handler(http.HandlerFunc(c.getOne))
 	router.Methods(http.MethodPut).Path("/transactions/{uuid}/speed-up").
@@ -97,6 +101,48 @@ func (c *TransactionsController) send(rw http.ResponseWriter, request *http.Requ
 	_ = json.NewEncoder(rw).Encode(formatters.FormatTxResponse(txResponse))
 }
 
+// @Summary Creates and sends a batch of contract transactions
+// @Description Validates every contract transaction of the batch, then creates all of them atomically and sends them at once
+// @Description Each transaction can have its own idempotency key. A transaction already sent with the same idempotency key is returned as is,
+// @Description or fails with an error if sent with different params, without failing the rest of the batch
+// @Description Faucets are not triggered for transactions sent in batch
+// @Tags Transactions
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param request body api.SendTransactionsRequest true "Batch of contract transaction requests"
+// @Success 202 {object} api.SendTransactionsResponse "Result of every transaction of the batch"
+// @Failure 400 {object} httputil.ErrorResponse "Invalid request"
+// @Failure 422 {object} httputil.ErrorResponse "Unprocessable parameters were sent"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /transactions/batch [post]
+func (c *TransactionsController) sendBatch(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	txsRequest := &api.SendTransactionsRequest{}
+	if err := jsonutils.UnmarshalBody(request.Body, txsRequest); err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := txsRequest.Validate(); err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	txReqs := formatters.FormatSendTxsRequest(txsRequest)
+	results, err := c.ucs.SendContractTransactions().Execute(ctx, txReqs, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(rw).Encode(formatters.FormatSendTxsResponse(results))
+}
+
 // @Summary Creates and sends a new contract deployment
 // @Description Creates and executes a new contract deployment request
 // @Description The transaction can be private (Tessera, EEA).
@@ -216,6 +262,44 @@ func (c *TransactionsController) transfer(rw http.ResponseWriter, request *http.
 	_ = json.NewEncoder(rw).Encode(formatters.FormatTxResponse(txResponse))
 }
 
+// @Summary Simulates a contract transaction
+// @Description Executes a contract transaction with eth_call at pending state without sending it
+// @Description If the transaction reverts, its reason is decoded from `Error(string)`, `Panic(uint256)` or the custom errors declared in the contract ABI
+// @Tags Transactions
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security JWTAuth
+// @Param request body api.SimulateTransactionRequest{params=api.SimulateTransactionParams} true "Contract transaction to simulate"
+// @Success 200 {object} api.SimulateTransactionResponse "Result of the simulation"
+// @Failure 400 {object} httputil.ErrorResponse "Invalid request"
+// @Failure 422 {object} httputil.ErrorResponse "Unprocessable parameters were sent"
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /transactions/simulate [post]
+func (c *TransactionsController) simulate(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	simulateRequest := &api.SimulateTransactionRequest{}
+	if err := jsonutils.UnmarshalBody(request.Body, simulateRequest); err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := simulateRequest.Params.Validate(); err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	simulation, err := c.ucs.SimulateTransaction().Execute(ctx, formatters.FormatSimulateTxRequest(simulateRequest), multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatSimulateTxResponse(simulation))
+}
+
 // @Summary Fetch a transaction request by uuid
 // @Description Fetch a single transaction request by uuid
 // @Tags Transactions
@@ -21,9 +21,11 @@ import (
 	"github.com/consensys/orchestrate/pkg/types/formatters"
 	"github.com/consensys/orchestrate/pkg/types/testutils"
 	"github.com/consensys/orchestrate/services/api/business/use-cases/mocks"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/mock/gomock"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -31,22 +33,28 @@ type transactionsControllerTestSuite struct {
 	suite.Suite
 	controller            *TransactionsController
 	router                *mux.Router
-	sendContractTxUseCase *mocks.MockSendContractTxUseCase
-	sendDeployTxUseCase   *mocks.MockSendDeployTxUseCase
-	sendTxUseCase         *mocks.MockSendTxUseCase
-	getTxUseCase          *mocks.MockGetTxUseCase
-	speedUpTxUseCase      *mocks.MockSpeedUpTxUseCase
-	callOffTxUseCase      *mocks.MockCallOffTxUseCase
-	searchTxsUseCase      *mocks.MockSearchTransactionsUseCase
-	ctx                   context.Context
-	userInfo              *multitenancy.UserInfo
-	defaultRetryInterval  time.Duration
+	sendContractTxUseCase  *mocks.MockSendContractTxUseCase
+	sendContractTxsUseCase *mocks.MockSendContractTxsUseCase
+	sendDeployTxUseCase    *mocks.MockSendDeployTxUseCase
+	sendTxUseCase          *mocks.MockSendTxUseCase
+	getTxUseCase           *mocks.MockGetTxUseCase
+	speedUpTxUseCase       *mocks.MockSpeedUpTxUseCase
+	callOffTxUseCase       *mocks.MockCallOffTxUseCase
+	searchTxsUseCase       *mocks.MockSearchTransactionsUseCase
+	simulateTxUseCase      *mocks.MockSimulateTxUseCase
+	ctx                    context.Context
+	userInfo               *multitenancy.UserInfo
+	defaultRetryInterval   time.Duration
 }
 
 func (s *transactionsControllerTestSuite) SendContractTransaction() usecases.SendContractTxUseCase {
 	return s.sendContractTxUseCase
 }
 
+func (s *transactionsControllerTestSuite) SendContractTransactions() usecases.SendContractTxsUseCase {
+	return s.sendContractTxsUseCase
+}
+
 func (s *transactionsControllerTestSuite) SendDeployTransaction() usecases.SendDeployTxUseCase {
 	return s.sendDeployTxUseCase
 }
@@ -71,6 +79,10 @@ func (s *transactionsControllerTestSuite) CallOff() usecases.CallOffTxUseCase {
 	return s.callOffTxUseCase
 }
 
+func (s *transactionsControllerTestSuite) SimulateTransaction() usecases.SimulateTxUseCase {
+	return s.simulateTxUseCase
+}
+
 var _ usecases.TransactionUseCases = &transactionsControllerTestSuite{}
 
 func TestTransactionsController(t *testing.T) {
@@ -83,10 +95,12 @@ func (s *transactionsControllerTestSuite) SetupTest() {
 	defer ctrl.Finish()
 
 	s.sendContractTxUseCase = mocks.NewMockSendContractTxUseCase(ctrl)
+	s.sendContractTxsUseCase = mocks.NewMockSendContractTxsUseCase(ctrl)
 	s.sendDeployTxUseCase = mocks.NewMockSendDeployTxUseCase(ctrl)
 	s.sendTxUseCase = mocks.NewMockSendTxUseCase(ctrl)
 	s.getTxUseCase = mocks.NewMockGetTxUseCase(ctrl)
 	s.searchTxsUseCase = mocks.NewMockSearchTransactionsUseCase(ctrl)
+	s.simulateTxUseCase = mocks.NewMockSimulateTxUseCase(ctrl)
 	s.defaultRetryInterval = time.Second * 2
 	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
 	s.ctx = multitenancy.WithUserInfo(context.Background(), s.userInfo)
@@ -184,6 +198,156 @@ func (s *transactionsControllerTestSuite) TestSend() {
 	})
 }
 
+func (s *transactionsControllerTestSuite) TestSimulate() {
+	urlPath := "/transactions/simulate"
+	simulateRequest := &api.SimulateTransactionRequest{
+		ChainName: "ganache",
+		Params: api.SimulateTransactionParams{
+			From:            &testutils.FromAddress,
+			To:              testutils.FakeAddress(),
+			MethodSignature: "transfer(address,uint256)",
+			Args:            []interface{}{"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18", 5},
+			ContractName:    "contractName",
+		},
+	}
+
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		requestBytes, _ := json.Marshal(simulateRequest)
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, urlPath, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+		simulation := &entities.TransactionSimulation{
+			Reverted:     true,
+			RevertReason: "insufficient balance",
+			RevertData:   hexutil.MustDecode("0x08c379a0"),
+		}
+
+		s.simulateTxUseCase.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).
+			DoAndReturn(func(ctx context.Context, txReq *entities.TxRequest, userInfo *multitenancy.UserInfo) (*entities.TransactionSimulation, error) {
+				assert.Equal(t, simulateRequest.ChainName, txReq.ChainName)
+				assert.Equal(t, entities.DefaultTagValue, txReq.Params.ContractTag)
+				return simulation, nil
+			})
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(formatters.FormatSimulateTxResponse(simulation))
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with 422 if use case fails with InvalidParameterError", func(t *testing.T) {
+		requestBytes, _ := json.Marshal(simulateRequest)
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, urlPath, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.simulateTxUseCase.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).
+			Return(nil, errors.InvalidParameterError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rw.Code)
+	})
+
+	s.T().Run("should fail with 400 if the method signature is missing", func(t *testing.T) {
+		invalidRequest := *simulateRequest
+		invalidRequest.Params.MethodSignature = ""
+		requestBytes, _ := json.Marshal(invalidRequest)
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, urlPath, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+}
+
+func (s *transactionsControllerTestSuite) TestSendBatch() {
+	urlPath := "/transactions/batch"
+
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		txsRequest := &api.SendTransactionsRequest{
+			Transactions: []*api.SendTransactionsItem{
+				{IdempotencyKey: "key1", SendTransactionRequest: *testutils.FakeSendTransactionRequest()},
+				{IdempotencyKey: "key2", SendTransactionRequest: *testutils.FakeSendTransactionRequest()},
+			},
+		}
+		requestBytes, _ := json.Marshal(txsRequest)
+		httpRequest := httptest.NewRequest(http.MethodPost, urlPath, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		txRequestEntityResp := testutils.FakeTxRequest()
+		txRequestEntityResp.IdempotencyKey = "key1"
+		results := []*entities.TxRequestResult{
+			{TxRequest: txRequestEntityResp},
+			{TxRequest: &entities.TxRequest{IdempotencyKey: "key2"}, Err: errors.AlreadyExistsError("already exists")},
+		}
+
+		s.sendContractTxsUseCase.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).
+			DoAndReturn(func(ctx context.Context, txReqs []*entities.TxRequest, userInfo *multitenancy.UserInfo) ([]*entities.TxRequestResult, error) {
+				require.Len(t, txReqs, 2)
+				assert.Equal(t, "key1", txReqs[0].IdempotencyKey)
+				assert.Equal(t, "key2", txReqs[1].IdempotencyKey)
+				return results, nil
+			})
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(formatters.FormatSendTxsResponse(results))
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusAccepted, rw.Code)
+
+		response := &api.SendTransactionsResponse{}
+		_ = json.Unmarshal(rw.Body.Bytes(), response)
+		assert.Equal(t, txRequestEntityResp.Schedule.UUID, response.Transactions[0].Transaction.UUID)
+		assert.Nil(t, response.Transactions[1].Transaction)
+		assert.Equal(t, "already exists", response.Transactions[1].Error)
+	})
+
+	s.T().Run("should fail with Bad request if batch is empty", func(t *testing.T) {
+		requestBytes, _ := json.Marshal(&api.SendTransactionsRequest{})
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, urlPath, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if one transaction is invalid", func(t *testing.T) {
+		invalidTx := testutils.FakeSendTransactionRequest()
+		invalidTx.Params.From = nil
+		requestBytes, _ := json.Marshal(&api.SendTransactionsRequest{
+			Transactions: []*api.SendTransactionsItem{
+				{SendTransactionRequest: *testutils.FakeSendTransactionRequest()},
+				{SendTransactionRequest: *invalidTx},
+			},
+		})
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, urlPath, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		assert.Contains(t, rw.Body.String(), "transaction 1")
+	})
+
+	s.T().Run("should fail with 422 if use case fails with InvalidParameterError", func(t *testing.T) {
+		requestBytes, _ := json.Marshal(&api.SendTransactionsRequest{
+			Transactions: []*api.SendTransactionsItem{{SendTransactionRequest: *testutils.FakeSendTransactionRequest()}},
+		})
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, urlPath, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.sendContractTxsUseCase.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).
+			Return(nil, errors.InvalidParameterError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusUnprocessableEntity, rw.Code)
+	})
+}
+
 func (s *transactionsControllerTestSuite) TestDeploy() {
 	urlPath := "/transactions/deploy-contract"
 	idempotencyKey := "idempotencyKey"
@@ -0,0 +1,223 @@
+package listeners
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/broker"
+	encoding "github.com/consensys/orchestrate/pkg/encoding/proto"
+	"github.com/consensys/orchestrate/pkg/errors"
+	authutils "github.com/consensys/orchestrate/pkg/toolkit/app/auth/utils"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/tx"
+	"github.com/consensys/orchestrate/pkg/utils/envelope"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+)
+
+const txRequestListenerComponent = "service.tx-request-listener"
+
+// TxRequestListener consumes the transaction requests produced to the message broker and submits them as the HTTP API
+// would. Messages are authenticated by the HMAC signature of the tenant they are produced for, unless they have been
+// produced to a topic mapped to a tenant, in which case access to the topic is expected to be restricted by the broker
+// ACLs and messages are submitted on behalf of the tenant itself
+type TxRequestListener struct {
+	ucs                 usecases.TransactionUseCases
+	multitenancyEnabled bool
+	tenantTopics        map[string]string
+	signingKeys         map[string]string
+	enqueueMsgUC        usecases.EnqueueMessageUseCase
+	recoverTopic        string
+	logger              *log.Logger
+}
+
+func NewTxRequestListener(
+	ucs usecases.TransactionUseCases,
+	multitenancyEnabled bool,
+	tenantTopics, signingKeys map[string]string,
+	enqueueMsgUC usecases.EnqueueMessageUseCase,
+	recoverTopic string,
+) *TxRequestListener {
+	return &TxRequestListener{
+		ucs:                 ucs,
+		multitenancyEnabled: multitenancyEnabled,
+		tenantTopics:        tenantTopics,
+		signingKeys:         signingKeys,
+		enqueueMsgUC:        enqueueMsgUC,
+		recoverTopic:        recoverTopic,
+		logger:              log.NewLogger().SetComponent(txRequestListenerComponent),
+	}
+}
+
+func (listener *TxRequestListener) Setup(session broker.ConsumerGroupSession) error {
+	listener.logger.WithContext(session.Context()).
+		WithField("member_id", session.MemberID()).
+		WithField("claims", session.Claims()).
+		Info("ready to consume transaction requests")
+
+	return nil
+}
+
+func (listener *TxRequestListener) Cleanup(session broker.ConsumerGroupSession) error {
+	listener.logger.WithContext(session.Context()).Info("all claims consumed")
+	return nil
+}
+
+func (listener *TxRequestListener) ConsumeClaim(session broker.ConsumerGroupSession, claim broker.ConsumerGroupClaim) error {
+	ctx := session.Context()
+	logger := listener.logger.WithContext(ctx).WithField("topic", claim.Topic())
+	logger.Info("started consuming transaction requests")
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.WithField("reason", ctx.Err().Error()).Info("gracefully stopping transaction request listener...")
+			return nil
+		case msg, ok := <-claim.Messages():
+			// Input channel has been close so we leave the loop
+			if !ok {
+				return nil
+			}
+
+			err := listener.processMessage(log.With(ctx, logger), msg)
+			if err != nil && errors.IsConnectionError(err) {
+				// Message is not marked so that it is consumed again once the connection is back
+				logger.WithError(err).Error("failed to process transaction request")
+				return err
+			}
+
+			session.MarkMessage(msg)
+			session.Commit()
+		}
+	}
+}
+
+func (listener *TxRequestListener) processMessage(ctx context.Context, msg *broker.Message) error {
+	logger := listener.logger.WithContext(ctx)
+
+	txEnvelope := &tx.TxEnvelope{}
+	if err := encoding.Unmarshal(msg.Value, txEnvelope); err != nil {
+		logger.WithError(err).Error("failed to decode transaction request message")
+		return nil
+	}
+
+	if txEnvelope.GetTxRequest() == nil {
+		logger.Error("message is not a transaction request")
+		return nil
+	}
+
+	evlp, err := txEnvelope.Envelope()
+	if err != nil {
+		logger.WithError(err).Error("invalid transaction request")
+		return listener.sendRecoverMessage(ctx, invalidEnvelope(txEnvelope.GetTxRequest()), err)
+	}
+
+	logger = logger.WithField("envelope_id", evlp.GetID()).WithField("chain", evlp.GetChainName())
+	ctx = log.With(ctx, logger)
+
+	userInfo, err := listener.authenticate(msg, evlp)
+	if err != nil {
+		logger.WithError(err).Error("unauthorized transaction request")
+		return listener.sendRecoverMessage(ctx, evlp, err)
+	}
+	ctx = multitenancy.WithUserInfo(ctx, userInfo)
+
+	txRequest, err := listener.sendTx(ctx, evlp, userInfo)
+	switch {
+	case err != nil && errors.IsConnectionError(err):
+		return err
+	case err != nil:
+		logger.WithError(err).Error("failed to send transaction request")
+		return listener.sendRecoverMessage(ctx, evlp, err)
+	}
+
+	logger.WithField("schedule", txRequest.Schedule.UUID).Info("transaction request submitted")
+	return nil
+}
+
+func (listener *TxRequestListener) sendTx(ctx context.Context, evlp *tx.Envelope, userInfo *multitenancy.UserInfo) (*entities.TxRequest, error) {
+	txRequest := envelope.NewTxRequestFromEnvelope(evlp)
+
+	switch {
+	case len(txRequest.Params.Raw) > 0:
+		return listener.ucs.SendTransaction().Execute(ctx, txRequest, nil, userInfo)
+	case txRequest.Params.ContractName != "" && txRequest.Params.To == nil:
+		return listener.ucs.SendDeployTransaction().Execute(ctx, txRequest, userInfo)
+	case txRequest.Params.MethodSignature != "":
+		return listener.ucs.SendContractTransaction().Execute(ctx, txRequest, userInfo)
+	default:
+		return listener.ucs.SendTransaction().Execute(ctx, txRequest, evlp.GetData(), userInfo)
+	}
+}
+
+// authenticate identifies the tenant and username a message is produced for. Credentials are never read from the
+// messages as they would remain in the topic log for anyone with read access to the topic
+func (listener *TxRequestListener) authenticate(msg *broker.Message, evlp *tx.Envelope) (*multitenancy.UserInfo, error) {
+	if !listener.multitenancyEnabled {
+		return multitenancy.DefaultUser(), nil
+	}
+
+	// Username headers are ignored on topics mapped to a tenant as any producer to the topic could set them
+	if tenantID, ok := listener.tenantTopics[msg.Topic]; ok {
+		return multitenancy.NewUserInfo(tenantID, ""), nil
+	}
+
+	tenantID := headerValue(msg, evlp, authutils.TenantIDHeader)
+	username := headerValue(msg, evlp, authutils.UsernameHeader)
+	key, ok := listener.signingKeys[tenantID]
+	if !ok || !authutils.VerifyMessageSignature([]byte(key), tenantID, username, msg.Value, msg.Headers[authutils.SignatureHeader]) {
+		return nil, errors.UnauthorizedError("missing or invalid message signature")
+	}
+
+	if username == multitenancy.WildcardOwner {
+		return nil, errors.PermissionDeniedError("access to username %q forbidden", username)
+	}
+
+	return multitenancy.NewUserInfo(tenantID, username), nil
+}
+
+func (listener *TxRequestListener) sendRecoverMessage(ctx context.Context, evlp *tx.Envelope, err error) error {
+	logger := listener.logger.WithContext(ctx).WithField("topic", listener.recoverTopic)
+
+	msg := &broker.Message{Topic: listener.recoverTopic}
+	if partitionKey := evlp.PartitionKey(); partitionKey != "" {
+		msg.Key = []byte(partitionKey)
+	}
+
+	txResponse := evlp.AppendError(errors.FromError(err)).TxResponse()
+	// Credentials must never be forwarded to the topics read by the consumers of transaction responses
+	delete(txResponse.Headers, authutils.AuthorizationHeader)
+	delete(txResponse.Headers, authutils.APIKeyHeader)
+
+	var merr error
+	msg.Value, merr = encoding.Marshal(txResponse)
+	if merr != nil {
+		logger.WithError(merr).Error("failed to marshal transaction response")
+		return nil
+	}
+
+	// Responses are written to the outbox so they are published in order with the messages of the same partition key
+	if serr := listener.enqueueMsgUC.Execute(ctx, msg, ""); serr != nil {
+		logger.WithError(serr).Error("failed to produce transaction response")
+		return errors.FromError(serr).ExtendComponent(txRequestListenerComponent)
+	}
+
+	return nil
+}
+
+// headerValue looks for a value in the message headers first, then in the headers of the envelope
+func headerValue(msg *broker.Message, evlp *tx.Envelope, key string) string {
+	if value, ok := msg.Headers[key]; ok {
+		return value
+	}
+
+	return evlp.GetHeadersValue(key)
+}
+
+// invalidEnvelope builds an envelope from a request which failed validation so that the error can still be reported
+func invalidEnvelope(req *tx.TxRequest) *tx.Envelope {
+	return tx.NewEnvelope().
+		SetID(req.GetId()).
+		SetContextLabels(req.GetContextLabels()).
+		SetChainName(req.GetChain())
+}
@@ -0,0 +1,296 @@
+// +build unit
+
+package listeners
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/consensys/orchestrate/pkg/broker"
+	pkgsarama "github.com/consensys/orchestrate/pkg/broker/sarama"
+	"github.com/consensys/orchestrate/pkg/broker/sarama/mock"
+	encoding "github.com/consensys/orchestrate/pkg/encoding/proto"
+	"github.com/consensys/orchestrate/pkg/errors"
+	authutils "github.com/consensys/orchestrate/pkg/toolkit/app/auth/utils"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/pkg/types/tx"
+	"github.com/consensys/orchestrate/services/api/business/use-cases/mocks"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	signingKey   = "signing-key"
+	requestTopic = "topic-tx-request"
+	tenantTopic  = "topic-tx-request-tenant"
+	recoverTopic = "topic-tx-recover"
+)
+
+func TestTxRequestListener(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ucs := mocks.NewMockTransactionUseCases(ctrl)
+	sendContractTxUC := mocks.NewMockSendContractTxUseCase(ctrl)
+	sendDeployTxUC := mocks.NewMockSendDeployTxUseCase(ctrl)
+	sendTxUC := mocks.NewMockSendTxUseCase(ctrl)
+	ucs.EXPECT().SendContractTransaction().Return(sendContractTxUC).AnyTimes()
+	ucs.EXPECT().SendDeployTransaction().Return(sendDeployTxUC).AnyTimes()
+	ucs.EXPECT().SendTransaction().Return(sendTxUC).AnyTimes()
+
+	enqueueMsgUC := mocks.NewMockEnqueueMessageUseCase(ctrl)
+	var lastMsg *broker.Message
+	enqueueMsgUC.EXPECT().Execute(gomock.Any(), gomock.Any(), "").
+		DoAndReturn(func(_ context.Context, msg *broker.Message, _ string) error {
+			lastMsg = msg
+			return nil
+		}).AnyTimes()
+
+	listener := NewTxRequestListener(ucs, true, map[string]string{tenantTopic: "tenantTopicID"},
+		map[string]string{"tenantID": signingKey}, enqueueMsgUC, recoverTopic)
+
+	t.Run("should send a contract transaction signed by the tenant", func(t *testing.T) {
+		lastMsg = nil
+		req := fakeTxRequest()
+		req.Params.Contract = "ERC20"
+		req.Params.MethodSignature = "transfer(address,uint256)"
+		req.Params.Args = []string{"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18", "1000", "[1,2]"}
+		msg := sign(newMessage(t, requestTopic, req), signingKey, "tenantID", "username")
+
+		sendContractTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, txRequest *entities.TxRequest, userInfo *multitenancy.UserInfo) (*entities.TxRequest, error) {
+				assert.Equal(t, req.Id, txRequest.IdempotencyKey)
+				assert.Equal(t, req.Chain, txRequest.ChainName)
+				assert.Equal(t, req.ContextLabels, txRequest.Labels)
+				assert.Equal(t, "ERC20", txRequest.Params.ContractName)
+				assert.Equal(t, entities.DefaultTagValue, txRequest.Params.ContractTag)
+				assert.Equal(t, []interface{}{"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18", "1000", []interface{}{float64(1), float64(2)}},
+					txRequest.Params.Args)
+				assert.Equal(t, "tenantID", userInfo.TenantID)
+				assert.Equal(t, "username", userInfo.Username)
+				return testutils.FakeTxRequest(), nil
+			})
+
+		consume(t, listener, msg)
+		assert.Nil(t, lastMsg)
+	})
+
+	t.Run("should send a deployment transaction", func(t *testing.T) {
+		lastMsg = nil
+		req := fakeTxRequest()
+		req.Params.To = ""
+		req.Params.Contract = "ERC20[v1.0.0]"
+		msg := sign(newMessage(t, requestTopic, req), signingKey, "tenantID", "")
+
+		sendDeployTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, txRequest *entities.TxRequest, _ *multitenancy.UserInfo) (*entities.TxRequest, error) {
+				assert.Equal(t, "ERC20", txRequest.Params.ContractName)
+				assert.Equal(t, "v1.0.0", txRequest.Params.ContractTag)
+				return testutils.FakeTxRequest(), nil
+			})
+
+		consume(t, listener, msg)
+		assert.Nil(t, lastMsg)
+	})
+
+	t.Run("should send a raw transaction for the tenant of the envelope headers", func(t *testing.T) {
+		lastMsg = nil
+		req := &tx.TxRequest{
+			Id:      uuid.Must(uuid.NewV4()).String(),
+			Chain:   "besu",
+			Headers: map[string]string{authutils.TenantIDHeader: "tenantID"},
+			JobType: tx.JobType_ETH_RAW_TX,
+			Params:  &tx.Params{Raw: "0xf85380839896808252088083989680808216b4a0d35c752d3498e6f5ca1630d264802a992a141ca4b6a3f439d673c75e944e5fb0a05278aaa5fabbeac362c321b54e298dedae2d31471e432c26ea36a8d49cf08f1e"},
+		}
+
+		sendTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), hexutil.Bytes(nil), gomock.Any()).
+			DoAndReturn(func(_ context.Context, txRequest *entities.TxRequest, _ hexutil.Bytes, userInfo *multitenancy.UserInfo) (*entities.TxRequest, error) {
+				assert.NotEmpty(t, txRequest.Params.Raw)
+				assert.Equal(t, "tenantID", userInfo.TenantID)
+				return testutils.FakeTxRequest(), nil
+			})
+
+		msg := newMessage(t, requestTopic, req)
+		msg.Headers = []*sarama.RecordHeader{{
+			Key:   []byte(authutils.SignatureHeader),
+			Value: []byte(authutils.SignMessage([]byte(signingKey), "tenantID", "", msg.Value)),
+		}}
+		consume(t, listener, msg)
+		assert.Nil(t, lastMsg)
+	})
+
+	t.Run("should send a transaction on behalf of the tenant mapped to the topic ignoring the username", func(t *testing.T) {
+		lastMsg = nil
+		req := fakeTxRequest()
+		req.Params.Data = "0x0102"
+		msg := newMessage(t, tenantTopic, req)
+		msg.Headers = []*sarama.RecordHeader{{Key: []byte(authutils.UsernameHeader), Value: []byte(multitenancy.WildcardOwner)}}
+
+		sendTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), hexutil.Bytes{0x01, 0x02}, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ *entities.TxRequest, _ hexutil.Bytes, userInfo *multitenancy.UserInfo) (*entities.TxRequest, error) {
+				assert.Equal(t, "tenantTopicID", userInfo.TenantID)
+				assert.Empty(t, userInfo.Username)
+				return testutils.FakeTxRequest(), nil
+			})
+
+		consume(t, listener, msg)
+		assert.Nil(t, lastMsg)
+	})
+
+	t.Run("should report requests carrying credentials instead of a signature to the recover topic without credentials", func(t *testing.T) {
+		lastMsg = nil
+		req := fakeTxRequest()
+		req.Headers = map[string]string{authutils.APIKeyHeader: "api-key", authutils.TenantIDHeader: "tenantID"}
+
+		consume(t, listener, newMessage(t, requestTopic, req))
+
+		msg := lastMsg
+		require.NotNil(t, msg)
+		assert.Equal(t, recoverTopic, msg.Topic)
+
+		txResponse := &tx.TxResponse{}
+		require.NoError(t, encoding.Unmarshal(msg.Value, txResponse))
+		assert.Equal(t, req.Id, txResponse.Id)
+		assert.Equal(t, req.ContextLabels, txResponse.ContextLabels)
+		assert.Len(t, txResponse.Errors, 1)
+		assert.Empty(t, txResponse.Headers[authutils.APIKeyHeader])
+	})
+
+	t.Run("should report requests with an invalid signature to the recover topic", func(t *testing.T) {
+		lastMsg = nil
+		msg := sign(newMessage(t, requestTopic, fakeTxRequest()), "invalid-key", "tenantID", "")
+
+		consume(t, listener, msg)
+		require.NotNil(t, lastMsg)
+		assert.Equal(t, recoverTopic, lastMsg.Topic)
+	})
+
+	t.Run("should report requests signed for another tenant to the recover topic", func(t *testing.T) {
+		lastMsg = nil
+		msg := sign(newMessage(t, requestTopic, fakeTxRequest()), signingKey, "tenantID", "")
+		for _, header := range msg.Headers {
+			if string(header.Key) == authutils.TenantIDHeader {
+				header.Value = []byte("tenantID2")
+			}
+		}
+
+		consume(t, listener, msg)
+		require.NotNil(t, lastMsg)
+		assert.Equal(t, recoverTopic, lastMsg.Topic)
+	})
+
+	t.Run("should report signed requests on behalf of the wildcard owner to the recover topic", func(t *testing.T) {
+		lastMsg = nil
+		msg := sign(newMessage(t, requestTopic, fakeTxRequest()), signingKey, "tenantID", multitenancy.WildcardOwner)
+
+		consume(t, listener, msg)
+		require.NotNil(t, lastMsg)
+		assert.Equal(t, recoverTopic, lastMsg.Topic)
+	})
+
+	t.Run("should report invalid requests to the recover topic", func(t *testing.T) {
+		lastMsg = nil
+		req := fakeTxRequest()
+		req.Params.From = "invalid-address"
+
+		consume(t, listener, newMessage(t, tenantTopic, req))
+
+		msg := lastMsg
+		require.NotNil(t, msg)
+		assert.Equal(t, recoverTopic, msg.Topic)
+	})
+
+	t.Run("should stop consuming without committing on connection errors", func(t *testing.T) {
+		lastMsg = nil
+		req := fakeTxRequest()
+		msg := newMessage(t, tenantTopic, req)
+
+		sendTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.PostgresConnectionError("connection lost"))
+
+		session := mock.NewConsumerGroupSession(context.Background(), "group-api", nil)
+		claim := mock.NewConsumerGroupClaim(tenantTopic, 0, 0)
+
+		cerr := make(chan error)
+		go func() {
+			cerr <- pkgsarama.NewConsumerGroupHandler(listener).ConsumeClaim(session, claim)
+		}()
+		claim.ExpectMessage(msg)
+
+		err := <-cerr
+		assert.True(t, errors.IsConnectionError(err))
+		assert.Equal(t, int64(0), session.LastMarkedOffset(tenantTopic, 0).Offset)
+		assert.Nil(t, lastMsg)
+	})
+}
+
+func TestTxRequestListener_MultitenancyDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ucs := mocks.NewMockTransactionUseCases(ctrl)
+	sendTxUC := mocks.NewMockSendTxUseCase(ctrl)
+	ucs.EXPECT().SendTransaction().Return(sendTxUC).AnyTimes()
+
+	listener := NewTxRequestListener(ucs, false, nil, nil, mocks.NewMockEnqueueMessageUseCase(ctrl), recoverTopic)
+
+	sendTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), multitenancy.DefaultUser()).
+		Return(testutils.FakeTxRequest(), nil)
+
+	consume(t, listener, newMessage(t, requestTopic, fakeTxRequest()))
+}
+
+func consume(t *testing.T, listener *TxRequestListener, msg *sarama.ConsumerMessage) {
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	session := mock.NewConsumerGroupSession(ctx, "group-api", nil)
+	claim := mock.NewConsumerGroupClaim(msg.Topic, 0, 0)
+
+	cerr := make(chan error)
+	go func() {
+		cerr <- pkgsarama.NewConsumerGroupHandler(listener).ConsumeClaim(session, claim)
+	}()
+	claim.ExpectMessage(msg)
+
+	assert.NoError(t, <-cerr)
+	assert.Equal(t, msg.Offset+1, session.LastMarkedOffset(msg.Topic, 0).Offset)
+}
+
+func newMessage(t *testing.T, topic string, req *tx.TxRequest) *sarama.ConsumerMessage {
+	b, err := encoding.Marshal(&tx.TxEnvelope{Msg: &tx.TxEnvelope_TxRequest{TxRequest: req}})
+	require.NoError(t, err)
+
+	return &sarama.ConsumerMessage{Topic: topic, Value: b}
+}
+
+func sign(msg *sarama.ConsumerMessage, key, tenantID, username string) *sarama.ConsumerMessage {
+	msg.Headers = append(msg.Headers,
+		&sarama.RecordHeader{Key: []byte(authutils.TenantIDHeader), Value: []byte(tenantID)},
+		&sarama.RecordHeader{Key: []byte(authutils.UsernameHeader), Value: []byte(username)},
+		&sarama.RecordHeader{
+			Key:   []byte(authutils.SignatureHeader),
+			Value: []byte(authutils.SignMessage([]byte(key), tenantID, username, msg.Value)),
+		},
+	)
+	return msg
+}
+
+func fakeTxRequest() *tx.TxRequest {
+	return &tx.TxRequest{
+		Id:            uuid.Must(uuid.NewV4()).String(),
+		Chain:         "besu",
+		ContextLabels: map[string]string{"correlationID": "correlation-id"},
+		Params: &tx.Params{
+			From: "0x7E654d251Da770A068413677967F6d3Ea2FeA9E4",
+			To:   "0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18",
+		},
+	}
+}
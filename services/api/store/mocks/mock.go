@@ -90,6 +90,20 @@ func (mr *MockAgentsMockRecorder) Account() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Account", reflect.TypeOf((*MockAgents)(nil).Account))
 }
 
+// ApprovalPolicy mocks base method.
+func (m *MockAgents) ApprovalPolicy() store.ApprovalPolicyAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApprovalPolicy")
+	ret0, _ := ret[0].(store.ApprovalPolicyAgent)
+	return ret0
+}
+
+// ApprovalPolicy indicates an expected call of ApprovalPolicy.
+func (mr *MockAgentsMockRecorder) ApprovalPolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApprovalPolicy", reflect.TypeOf((*MockAgents)(nil).ApprovalPolicy))
+}
+
 // Artifact mocks base method.
 func (m *MockAgents) Artifact() store.ArtifactAgent {
 	m.ctrl.T.Helper()
@@ -188,6 +202,20 @@ func (mr *MockAgentsMockRecorder) Job() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockAgents)(nil).Job))
 }
 
+// Lock mocks base method.
+func (m *MockAgents) Lock() store.LockAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock")
+	ret0, _ := ret[0].(store.LockAgent)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockAgentsMockRecorder) Lock() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockAgents)(nil).Lock))
+}
+
 // Log mocks base method.
 func (m *MockAgents) Log() store.LogAgent {
 	m.ctrl.T.Helper()
@@ -202,6 +230,20 @@ func (mr *MockAgentsMockRecorder) Log() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockAgents)(nil).Log))
 }
 
+// Outbox mocks base method.
+func (m *MockAgents) Outbox() store.OutboxAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outbox")
+	ret0, _ := ret[0].(store.OutboxAgent)
+	return ret0
+}
+
+// Outbox indicates an expected call of Outbox.
+func (mr *MockAgentsMockRecorder) Outbox() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outbox", reflect.TypeOf((*MockAgents)(nil).Outbox))
+}
+
 // PrivateTxManager mocks base method.
 func (m *MockAgents) PrivateTxManager() store.PrivateTxManagerAgent {
 	m.ctrl.T.Helper()
@@ -244,6 +286,20 @@ func (mr *MockAgentsMockRecorder) Schedule() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockAgents)(nil).Schedule))
 }
 
+// Subscription mocks base method.
+func (m *MockAgents) Subscription() store.SubscriptionAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscription")
+	ret0, _ := ret[0].(store.SubscriptionAgent)
+	return ret0
+}
+
+// Subscription indicates an expected call of Subscription.
+func (mr *MockAgentsMockRecorder) Subscription() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscription", reflect.TypeOf((*MockAgents)(nil).Subscription))
+}
+
 // Tag mocks base method.
 func (m *MockAgents) Tag() store.TagAgent {
 	m.ctrl.T.Helper()
@@ -272,6 +328,20 @@ func (mr *MockAgentsMockRecorder) Transaction() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockAgents)(nil).Transaction))
 }
 
+// TransactionPolicy mocks base method.
+func (m *MockAgents) TransactionPolicy() store.TransactionPolicyAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionPolicy")
+	ret0, _ := ret[0].(store.TransactionPolicyAgent)
+	return ret0
+}
+
+// TransactionPolicy indicates an expected call of TransactionPolicy.
+func (mr *MockAgentsMockRecorder) TransactionPolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionPolicy", reflect.TypeOf((*MockAgents)(nil).TransactionPolicy))
+}
+
 // TransactionRequest mocks base method.
 func (m *MockAgents) TransactionRequest() store.TransactionRequestAgent {
 	m.ctrl.T.Helper()
@@ -323,6 +393,20 @@ func (mr *MockDBMockRecorder) Account() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Account", reflect.TypeOf((*MockDB)(nil).Account))
 }
 
+// ApprovalPolicy mocks base method.
+func (m *MockDB) ApprovalPolicy() store.ApprovalPolicyAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApprovalPolicy")
+	ret0, _ := ret[0].(store.ApprovalPolicyAgent)
+	return ret0
+}
+
+// ApprovalPolicy indicates an expected call of ApprovalPolicy.
+func (mr *MockDBMockRecorder) ApprovalPolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApprovalPolicy", reflect.TypeOf((*MockDB)(nil).ApprovalPolicy))
+}
+
 // Artifact mocks base method.
 func (m *MockDB) Artifact() store.ArtifactAgent {
 	m.ctrl.T.Helper()
@@ -436,6 +520,20 @@ func (mr *MockDBMockRecorder) Job() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockDB)(nil).Job))
 }
 
+// Lock mocks base method.
+func (m *MockDB) Lock() store.LockAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock")
+	ret0, _ := ret[0].(store.LockAgent)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockDBMockRecorder) Lock() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockDB)(nil).Lock))
+}
+
 // Log mocks base method.
 func (m *MockDB) Log() store.LogAgent {
 	m.ctrl.T.Helper()
@@ -450,6 +548,20 @@ func (mr *MockDBMockRecorder) Log() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockDB)(nil).Log))
 }
 
+// Outbox mocks base method.
+func (m *MockDB) Outbox() store.OutboxAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outbox")
+	ret0, _ := ret[0].(store.OutboxAgent)
+	return ret0
+}
+
+// Outbox indicates an expected call of Outbox.
+func (mr *MockDBMockRecorder) Outbox() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outbox", reflect.TypeOf((*MockDB)(nil).Outbox))
+}
+
 // PrivateTxManager mocks base method.
 func (m *MockDB) PrivateTxManager() store.PrivateTxManagerAgent {
 	m.ctrl.T.Helper()
@@ -492,6 +604,20 @@ func (mr *MockDBMockRecorder) Schedule() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockDB)(nil).Schedule))
 }
 
+// Subscription mocks base method.
+func (m *MockDB) Subscription() store.SubscriptionAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscription")
+	ret0, _ := ret[0].(store.SubscriptionAgent)
+	return ret0
+}
+
+// Subscription indicates an expected call of Subscription.
+func (mr *MockDBMockRecorder) Subscription() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscription", reflect.TypeOf((*MockDB)(nil).Subscription))
+}
+
 // Tag mocks base method.
 func (m *MockDB) Tag() store.TagAgent {
 	m.ctrl.T.Helper()
@@ -520,6 +646,20 @@ func (mr *MockDBMockRecorder) Transaction() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockDB)(nil).Transaction))
 }
 
+// TransactionPolicy mocks base method.
+func (m *MockDB) TransactionPolicy() store.TransactionPolicyAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionPolicy")
+	ret0, _ := ret[0].(store.TransactionPolicyAgent)
+	return ret0
+}
+
+// TransactionPolicy indicates an expected call of TransactionPolicy.
+func (mr *MockDBMockRecorder) TransactionPolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionPolicy", reflect.TypeOf((*MockDB)(nil).TransactionPolicy))
+}
+
 // TransactionRequest mocks base method.
 func (m *MockDB) TransactionRequest() store.TransactionRequestAgent {
 	m.ctrl.T.Helper()
@@ -571,6 +711,20 @@ func (mr *MockTxMockRecorder) Account() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Account", reflect.TypeOf((*MockTx)(nil).Account))
 }
 
+// ApprovalPolicy mocks base method.
+func (m *MockTx) ApprovalPolicy() store.ApprovalPolicyAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApprovalPolicy")
+	ret0, _ := ret[0].(store.ApprovalPolicyAgent)
+	return ret0
+}
+
+// ApprovalPolicy indicates an expected call of ApprovalPolicy.
+func (mr *MockTxMockRecorder) ApprovalPolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApprovalPolicy", reflect.TypeOf((*MockTx)(nil).ApprovalPolicy))
+}
+
 // Artifact mocks base method.
 func (m *MockTx) Artifact() store.ArtifactAgent {
 	m.ctrl.T.Helper()
@@ -712,6 +866,20 @@ func (mr *MockTxMockRecorder) Job() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockTx)(nil).Job))
 }
 
+// Lock mocks base method.
+func (m *MockTx) Lock() store.LockAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock")
+	ret0, _ := ret[0].(store.LockAgent)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockTxMockRecorder) Lock() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockTx)(nil).Lock))
+}
+
 // Log mocks base method.
 func (m *MockTx) Log() store.LogAgent {
 	m.ctrl.T.Helper()
@@ -726,6 +894,20 @@ func (mr *MockTxMockRecorder) Log() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockTx)(nil).Log))
 }
 
+// Outbox mocks base method.
+func (m *MockTx) Outbox() store.OutboxAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outbox")
+	ret0, _ := ret[0].(store.OutboxAgent)
+	return ret0
+}
+
+// Outbox indicates an expected call of Outbox.
+func (mr *MockTxMockRecorder) Outbox() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outbox", reflect.TypeOf((*MockTx)(nil).Outbox))
+}
+
 // PrivateTxManager mocks base method.
 func (m *MockTx) PrivateTxManager() store.PrivateTxManagerAgent {
 	m.ctrl.T.Helper()
@@ -782,6 +964,20 @@ func (mr *MockTxMockRecorder) Schedule() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockTx)(nil).Schedule))
 }
 
+// Subscription mocks base method.
+func (m *MockTx) Subscription() store.SubscriptionAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscription")
+	ret0, _ := ret[0].(store.SubscriptionAgent)
+	return ret0
+}
+
+// Subscription indicates an expected call of Subscription.
+func (mr *MockTxMockRecorder) Subscription() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscription", reflect.TypeOf((*MockTx)(nil).Subscription))
+}
+
 // Tag mocks base method.
 func (m *MockTx) Tag() store.TagAgent {
 	m.ctrl.T.Helper()
@@ -810,6 +1006,20 @@ func (mr *MockTxMockRecorder) Transaction() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockTx)(nil).Transaction))
 }
 
+// TransactionPolicy mocks base method.
+func (m *MockTx) TransactionPolicy() store.TransactionPolicyAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionPolicy")
+	ret0, _ := ret[0].(store.TransactionPolicyAgent)
+	return ret0
+}
+
+// TransactionPolicy indicates an expected call of TransactionPolicy.
+func (mr *MockTxMockRecorder) TransactionPolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionPolicy", reflect.TypeOf((*MockTx)(nil).TransactionPolicy))
+}
+
 // TransactionRequest mocks base method.
 func (m *MockTx) TransactionRequest() store.TransactionRequestAgent {
 	m.ctrl.T.Helper()
@@ -929,21 +1139,6 @@ func (m *MockScheduleAgent) EXPECT() *MockScheduleAgentMockRecorder {
 	return m.recorder
 }
 
-// FindAll mocks base method.
-func (m *MockScheduleAgent) FindAll(ctx context.Context, tenants []string, ownerID string) ([]*models.Schedule, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll", ctx, tenants, ownerID)
-	ret0, _ := ret[0].([]*models.Schedule)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// FindAll indicates an expected call of FindAll.
-func (mr *MockScheduleAgentMockRecorder) FindAll(ctx, tenants, ownerID interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockScheduleAgent)(nil).FindAll), ctx, tenants, ownerID)
-}
-
 // FindOneByUUID mocks base method.
 func (m *MockScheduleAgent) FindOneByUUID(ctx context.Context, uuid string, tenants []string, ownerID string) (*models.Schedule, error) {
 	m.ctrl.T.Helper()
@@ -973,6 +1168,21 @@ func (mr *MockScheduleAgentMockRecorder) Insert(ctx, schedule interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockScheduleAgent)(nil).Insert), ctx, schedule)
 }
 
+// Search mocks base method.
+func (m *MockScheduleAgent) Search(ctx context.Context, filters *entities.ScheduleFilters, tenants []string, ownerID string) ([]*models.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filters, tenants, ownerID)
+	ret0, _ := ret[0].([]*models.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockScheduleAgentMockRecorder) Search(ctx, filters, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockScheduleAgent)(nil).Search), ctx, filters, tenants, ownerID)
+}
+
 // MockJobAgent is a mock of JobAgent interface.
 type MockJobAgent struct {
 	ctrl     *gomock.Controller
@@ -1105,6 +1315,102 @@ func (mr *MockLogAgentMockRecorder) Insert(ctx, log interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockLogAgent)(nil).Insert), ctx, log)
 }
 
+// MockOutboxAgent is a mock of OutboxAgent interface.
+type MockOutboxAgent struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxAgentMockRecorder
+}
+
+// MockOutboxAgentMockRecorder is the mock recorder for MockOutboxAgent.
+type MockOutboxAgentMockRecorder struct {
+	mock *MockOutboxAgent
+}
+
+// NewMockOutboxAgent creates a new mock instance.
+func NewMockOutboxAgent(ctrl *gomock.Controller) *MockOutboxAgent {
+	mock := &MockOutboxAgent{ctrl: ctrl}
+	mock.recorder = &MockOutboxAgentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxAgent) EXPECT() *MockOutboxAgentMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockOutboxAgent) Count(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockOutboxAgentMockRecorder) Count(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockOutboxAgent)(nil).Count), ctx)
+}
+
+// Delete mocks base method.
+func (m *MockOutboxAgent) Delete(ctx context.Context, ids []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockOutboxAgentMockRecorder) Delete(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOutboxAgent)(nil).Delete), ctx, ids)
+}
+
+// FindOldest mocks base method.
+func (m *MockOutboxAgent) FindOldest(ctx context.Context) (*models.OutboxMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOldest", ctx)
+	ret0, _ := ret[0].(*models.OutboxMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOldest indicates an expected call of FindOldest.
+func (mr *MockOutboxAgentMockRecorder) FindOldest(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOldest", reflect.TypeOf((*MockOutboxAgent)(nil).FindOldest), ctx)
+}
+
+// InsertMultiple mocks base method.
+func (m *MockOutboxAgent) InsertMultiple(ctx context.Context, messages []*models.OutboxMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertMultiple", ctx, messages)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertMultiple indicates an expected call of InsertMultiple.
+func (mr *MockOutboxAgentMockRecorder) InsertMultiple(ctx, messages interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertMultiple", reflect.TypeOf((*MockOutboxAgent)(nil).InsertMultiple), ctx, messages)
+}
+
+// LockOldest mocks base method.
+func (m *MockOutboxAgent) LockOldest(ctx context.Context, limit int) ([]*models.OutboxMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockOldest", ctx, limit)
+	ret0, _ := ret[0].([]*models.OutboxMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockOldest indicates an expected call of LockOldest.
+func (mr *MockOutboxAgentMockRecorder) LockOldest(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockOldest", reflect.TypeOf((*MockOutboxAgent)(nil).LockOldest), ctx, limit)
+}
+
 // MockTransactionAgent is a mock of TransactionAgent interface.
 type MockTransactionAgent struct {
 	ctrl     *gomock.Controller
@@ -1456,6 +1762,277 @@ func (mr *MockChainAgentMockRecorder) Update(ctx, chain, tenants, ownerID interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockChainAgent)(nil).Update), ctx, chain, tenants, ownerID)
 }
 
+// MockSubscriptionAgent is a mock of SubscriptionAgent interface.
+type MockSubscriptionAgent struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubscriptionAgentMockRecorder
+}
+
+// MockSubscriptionAgentMockRecorder is the mock recorder for MockSubscriptionAgent.
+type MockSubscriptionAgentMockRecorder struct {
+	mock *MockSubscriptionAgent
+}
+
+// NewMockSubscriptionAgent creates a new mock instance.
+func NewMockSubscriptionAgent(ctrl *gomock.Controller) *MockSubscriptionAgent {
+	mock := &MockSubscriptionAgent{ctrl: ctrl}
+	mock.recorder = &MockSubscriptionAgentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubscriptionAgent) EXPECT() *MockSubscriptionAgentMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockSubscriptionAgent) Delete(ctx context.Context, subscription *models.Subscription, tenants []string, ownerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, subscription, tenants, ownerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSubscriptionAgentMockRecorder) Delete(ctx, subscription, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSubscriptionAgent)(nil).Delete), ctx, subscription, tenants, ownerID)
+}
+
+// FindOneByUUID mocks base method.
+func (m *MockSubscriptionAgent) FindOneByUUID(ctx context.Context, uuid string, tenants []string, ownerID string) (*models.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByUUID", ctx, uuid, tenants, ownerID)
+	ret0, _ := ret[0].(*models.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByUUID indicates an expected call of FindOneByUUID.
+func (mr *MockSubscriptionAgentMockRecorder) FindOneByUUID(ctx, uuid, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByUUID", reflect.TypeOf((*MockSubscriptionAgent)(nil).FindOneByUUID), ctx, uuid, tenants, ownerID)
+}
+
+// Insert mocks base method.
+func (m *MockSubscriptionAgent) Insert(ctx context.Context, subscription *models.Subscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, subscription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockSubscriptionAgentMockRecorder) Insert(ctx, subscription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockSubscriptionAgent)(nil).Insert), ctx, subscription)
+}
+
+// Search mocks base method.
+func (m *MockSubscriptionAgent) Search(ctx context.Context, filters *entities.SubscriptionFilters, tenants []string, ownerID string) ([]*models.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filters, tenants, ownerID)
+	ret0, _ := ret[0].([]*models.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockSubscriptionAgentMockRecorder) Search(ctx, filters, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSubscriptionAgent)(nil).Search), ctx, filters, tenants, ownerID)
+}
+
+// Update mocks base method.
+func (m *MockSubscriptionAgent) Update(ctx context.Context, subscription *models.Subscription, tenants []string, ownerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, subscription, tenants, ownerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSubscriptionAgentMockRecorder) Update(ctx, subscription, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSubscriptionAgent)(nil).Update), ctx, subscription, tenants, ownerID)
+}
+
+// MockApprovalPolicyAgent is a mock of ApprovalPolicyAgent interface.
+type MockApprovalPolicyAgent struct {
+	ctrl     *gomock.Controller
+	recorder *MockApprovalPolicyAgentMockRecorder
+}
+
+// MockApprovalPolicyAgentMockRecorder is the mock recorder for MockApprovalPolicyAgent.
+type MockApprovalPolicyAgentMockRecorder struct {
+	mock *MockApprovalPolicyAgent
+}
+
+// NewMockApprovalPolicyAgent creates a new mock instance.
+func NewMockApprovalPolicyAgent(ctrl *gomock.Controller) *MockApprovalPolicyAgent {
+	mock := &MockApprovalPolicyAgent{ctrl: ctrl}
+	mock.recorder = &MockApprovalPolicyAgentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApprovalPolicyAgent) EXPECT() *MockApprovalPolicyAgentMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockApprovalPolicyAgent) Delete(ctx context.Context, policy *models.ApprovalPolicy, tenants []string, ownerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, policy, tenants, ownerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockApprovalPolicyAgentMockRecorder) Delete(ctx, policy, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockApprovalPolicyAgent)(nil).Delete), ctx, policy, tenants, ownerID)
+}
+
+// FindOneByUUID mocks base method.
+func (m *MockApprovalPolicyAgent) FindOneByUUID(ctx context.Context, uuid string, tenants []string, ownerID string) (*models.ApprovalPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByUUID", ctx, uuid, tenants, ownerID)
+	ret0, _ := ret[0].(*models.ApprovalPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByUUID indicates an expected call of FindOneByUUID.
+func (mr *MockApprovalPolicyAgentMockRecorder) FindOneByUUID(ctx, uuid, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByUUID", reflect.TypeOf((*MockApprovalPolicyAgent)(nil).FindOneByUUID), ctx, uuid, tenants, ownerID)
+}
+
+// Insert mocks base method.
+func (m *MockApprovalPolicyAgent) Insert(ctx context.Context, policy *models.ApprovalPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockApprovalPolicyAgentMockRecorder) Insert(ctx, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockApprovalPolicyAgent)(nil).Insert), ctx, policy)
+}
+
+// Search mocks base method.
+func (m *MockApprovalPolicyAgent) Search(ctx context.Context, filters *entities.ApprovalPolicyFilters, tenants []string, ownerID string) ([]*models.ApprovalPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filters, tenants, ownerID)
+	ret0, _ := ret[0].([]*models.ApprovalPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockApprovalPolicyAgentMockRecorder) Search(ctx, filters, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockApprovalPolicyAgent)(nil).Search), ctx, filters, tenants, ownerID)
+}
+
+// MockTransactionPolicyAgent is a mock of TransactionPolicyAgent interface.
+type MockTransactionPolicyAgent struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionPolicyAgentMockRecorder
+}
+
+// MockTransactionPolicyAgentMockRecorder is the mock recorder for MockTransactionPolicyAgent.
+type MockTransactionPolicyAgentMockRecorder struct {
+	mock *MockTransactionPolicyAgent
+}
+
+// NewMockTransactionPolicyAgent creates a new mock instance.
+func NewMockTransactionPolicyAgent(ctrl *gomock.Controller) *MockTransactionPolicyAgent {
+	mock := &MockTransactionPolicyAgent{ctrl: ctrl}
+	mock.recorder = &MockTransactionPolicyAgentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionPolicyAgent) EXPECT() *MockTransactionPolicyAgentMockRecorder {
+	return m.recorder
+}
+
+// AddSpending mocks base method.
+func (m *MockTransactionPolicyAgent) AddSpending(ctx context.Context, spending *models.TransactionPolicySpending) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSpending", ctx, spending)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSpending indicates an expected call of AddSpending.
+func (mr *MockTransactionPolicyAgentMockRecorder) AddSpending(ctx, spending interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSpending", reflect.TypeOf((*MockTransactionPolicyAgent)(nil).AddSpending), ctx, spending)
+}
+
+// Delete mocks base method.
+func (m *MockTransactionPolicyAgent) Delete(ctx context.Context, policy *models.TransactionPolicy, tenants []string, ownerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, policy, tenants, ownerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTransactionPolicyAgentMockRecorder) Delete(ctx, policy, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTransactionPolicyAgent)(nil).Delete), ctx, policy, tenants, ownerID)
+}
+
+// FindOneByUUID mocks base method.
+func (m *MockTransactionPolicyAgent) FindOneByUUID(ctx context.Context, uuid string, tenants []string, ownerID string) (*models.TransactionPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByUUID", ctx, uuid, tenants, ownerID)
+	ret0, _ := ret[0].(*models.TransactionPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByUUID indicates an expected call of FindOneByUUID.
+func (mr *MockTransactionPolicyAgentMockRecorder) FindOneByUUID(ctx, uuid, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByUUID", reflect.TypeOf((*MockTransactionPolicyAgent)(nil).FindOneByUUID), ctx, uuid, tenants, ownerID)
+}
+
+// Insert mocks base method.
+func (m *MockTransactionPolicyAgent) Insert(ctx context.Context, policy *models.TransactionPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockTransactionPolicyAgentMockRecorder) Insert(ctx, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockTransactionPolicyAgent)(nil).Insert), ctx, policy)
+}
+
+// Search mocks base method.
+func (m *MockTransactionPolicyAgent) Search(ctx context.Context, filters *entities.TransactionPolicyFilters, tenants []string, ownerID string) ([]*models.TransactionPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filters, tenants, ownerID)
+	ret0, _ := ret[0].([]*models.TransactionPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockTransactionPolicyAgentMockRecorder) Search(ctx, filters, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockTransactionPolicyAgent)(nil).Search), ctx, filters, tenants, ownerID)
+}
+
 // MockPrivateTxManagerAgent is a mock of PrivateTxManagerAgent interface.
 type MockPrivateTxManagerAgent struct {
 	ctrl     *gomock.Controller
@@ -1575,18 +2152,18 @@ func (mr *MockArtifactAgentMockRecorder) FindOneByABIAndCodeHash(ctx, abi, codeH
 }
 
 // FindOneByNameAndTag mocks base method.
-func (m *MockArtifactAgent) FindOneByNameAndTag(ctx context.Context, name, tag string) (*models.ArtifactModel, error) {
+func (m *MockArtifactAgent) FindOneByNameAndTag(ctx context.Context, name, tag string, tenants []string) (*models.ArtifactModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindOneByNameAndTag", ctx, name, tag)
+	ret := m.ctrl.Call(m, "FindOneByNameAndTag", ctx, name, tag, tenants)
 	ret0, _ := ret[0].(*models.ArtifactModel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindOneByNameAndTag indicates an expected call of FindOneByNameAndTag.
-func (mr *MockArtifactAgentMockRecorder) FindOneByNameAndTag(ctx, name, tag interface{}) *gomock.Call {
+func (mr *MockArtifactAgentMockRecorder) FindOneByNameAndTag(ctx, name, tag, tenants interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByNameAndTag", reflect.TypeOf((*MockArtifactAgent)(nil).FindOneByNameAndTag), ctx, name, tag)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByNameAndTag", reflect.TypeOf((*MockArtifactAgent)(nil).FindOneByNameAndTag), ctx, name, tag, tenants)
 }
 
 // Insert mocks base method.
@@ -1641,33 +2218,33 @@ func (m *MockContractAgent) EXPECT() *MockContractAgentMockRecorder {
 }
 
 // FindOneByAddress mocks base method.
-func (m *MockContractAgent) FindOneByAddress(ctx context.Context, address string) (*entities.Contract, error) {
+func (m *MockContractAgent) FindOneByAddress(ctx context.Context, address string, tenants []string) (*entities.Contract, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindOneByAddress", ctx, address)
+	ret := m.ctrl.Call(m, "FindOneByAddress", ctx, address, tenants)
 	ret0, _ := ret[0].(*entities.Contract)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindOneByAddress indicates an expected call of FindOneByAddress.
-func (mr *MockContractAgentMockRecorder) FindOneByAddress(ctx, address interface{}) *gomock.Call {
+func (mr *MockContractAgentMockRecorder) FindOneByAddress(ctx, address, tenants interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByAddress", reflect.TypeOf((*MockContractAgent)(nil).FindOneByAddress), ctx, address)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByAddress", reflect.TypeOf((*MockContractAgent)(nil).FindOneByAddress), ctx, address, tenants)
 }
 
 // FindOneByCodeHash mocks base method.
-func (m *MockContractAgent) FindOneByCodeHash(ctx context.Context, codeHash string) (*entities.Contract, error) {
+func (m *MockContractAgent) FindOneByCodeHash(ctx context.Context, codeHash string, tenants []string) (*entities.Contract, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindOneByCodeHash", ctx, codeHash)
+	ret := m.ctrl.Call(m, "FindOneByCodeHash", ctx, codeHash, tenants)
 	ret0, _ := ret[0].(*entities.Contract)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindOneByCodeHash indicates an expected call of FindOneByCodeHash.
-func (mr *MockContractAgentMockRecorder) FindOneByCodeHash(ctx, codeHash interface{}) *gomock.Call {
+func (mr *MockContractAgentMockRecorder) FindOneByCodeHash(ctx, codeHash, tenants interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByCodeHash", reflect.TypeOf((*MockContractAgent)(nil).FindOneByCodeHash), ctx, codeHash)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByCodeHash", reflect.TypeOf((*MockContractAgent)(nil).FindOneByCodeHash), ctx, codeHash, tenants)
 }
 
 // MockCodeHashAgent is a mock of CodeHashAgent interface.
@@ -1797,34 +2374,48 @@ func (m *MockRepositoryAgent) EXPECT() *MockRepositoryAgentMockRecorder {
 	return m.recorder
 }
 
+// Delete mocks base method.
+func (m *MockRepositoryAgent) Delete(ctx context.Context, repository *models.RepositoryModel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, repository)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRepositoryAgentMockRecorder) Delete(ctx, repository interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepositoryAgent)(nil).Delete), ctx, repository)
+}
+
 // FindAll mocks base method.
-func (m *MockRepositoryAgent) FindAll(ctx context.Context) ([]string, error) {
+func (m *MockRepositoryAgent) FindAll(ctx context.Context, tenants []string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret := m.ctrl.Call(m, "FindAll", ctx, tenants)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindAll indicates an expected call of FindAll.
-func (mr *MockRepositoryAgentMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+func (mr *MockRepositoryAgentMockRecorder) FindAll(ctx, tenants interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockRepositoryAgent)(nil).FindAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockRepositoryAgent)(nil).FindAll), ctx, tenants)
 }
 
 // FindOne mocks base method.
-func (m *MockRepositoryAgent) FindOne(ctx context.Context, name string) (*models.RepositoryModel, error) {
+func (m *MockRepositoryAgent) FindOne(ctx context.Context, name string, tenants []string) (*models.RepositoryModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindOne", ctx, name)
+	ret := m.ctrl.Call(m, "FindOne", ctx, name, tenants)
 	ret0, _ := ret[0].(*models.RepositoryModel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindOne indicates an expected call of FindOne.
-func (mr *MockRepositoryAgentMockRecorder) FindOne(ctx, name interface{}) *gomock.Call {
+func (mr *MockRepositoryAgentMockRecorder) FindOne(ctx, name, tenants interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOne", reflect.TypeOf((*MockRepositoryAgent)(nil).FindOne), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOne", reflect.TypeOf((*MockRepositoryAgent)(nil).FindOne), ctx, name, tenants)
 }
 
 // Insert mocks base method.
@@ -1878,19 +2469,62 @@ func (m *MockTagAgent) EXPECT() *MockTagAgentMockRecorder {
 	return m.recorder
 }
 
+// Delete mocks base method.
+func (m *MockTagAgent) Delete(ctx context.Context, tag *models.TagModel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTagAgentMockRecorder) Delete(ctx, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTagAgent)(nil).Delete), ctx, tag)
+}
+
+// DeleteAllByRepositoryID mocks base method.
+func (m *MockTagAgent) DeleteAllByRepositoryID(ctx context.Context, repositoryID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAllByRepositoryID", ctx, repositoryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllByRepositoryID indicates an expected call of DeleteAllByRepositoryID.
+func (mr *MockTagAgentMockRecorder) DeleteAllByRepositoryID(ctx, repositoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllByRepositoryID", reflect.TypeOf((*MockTagAgent)(nil).DeleteAllByRepositoryID), ctx, repositoryID)
+}
+
 // FindAllByName mocks base method.
-func (m *MockTagAgent) FindAllByName(ctx context.Context, name string) ([]string, error) {
+func (m *MockTagAgent) FindAllByName(ctx context.Context, name string, tenants []string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAllByName", ctx, name)
+	ret := m.ctrl.Call(m, "FindAllByName", ctx, name, tenants)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindAllByName indicates an expected call of FindAllByName.
-func (mr *MockTagAgentMockRecorder) FindAllByName(ctx, name interface{}) *gomock.Call {
+func (mr *MockTagAgentMockRecorder) FindAllByName(ctx, name, tenants interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByName", reflect.TypeOf((*MockTagAgent)(nil).FindAllByName), ctx, name, tenants)
+}
+
+// FindOneByName mocks base method.
+func (m *MockTagAgent) FindOneByName(ctx context.Context, repositoryID int, name string) (*models.TagModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByName", ctx, repositoryID, name)
+	ret0, _ := ret[0].(*models.TagModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByName indicates an expected call of FindOneByName.
+func (mr *MockTagAgentMockRecorder) FindOneByName(ctx, repositoryID, name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByName", reflect.TypeOf((*MockTagAgent)(nil).FindAllByName), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByName", reflect.TypeOf((*MockTagAgent)(nil).FindOneByName), ctx, repositoryID, name)
 }
 
 // Insert mocks base method.
@@ -1906,3 +2540,41 @@ func (mr *MockTagAgentMockRecorder) Insert(ctx, tag interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockTagAgent)(nil).Insert), ctx, tag)
 }
+
+// MockLockAgent is a mock of LockAgent interface.
+type MockLockAgent struct {
+	ctrl     *gomock.Controller
+	recorder *MockLockAgentMockRecorder
+}
+
+// MockLockAgentMockRecorder is the mock recorder for MockLockAgent.
+type MockLockAgentMockRecorder struct {
+	mock *MockLockAgent
+}
+
+// NewMockLockAgent creates a new mock instance.
+func NewMockLockAgent(ctrl *gomock.Controller) *MockLockAgent {
+	mock := &MockLockAgent{ctrl: ctrl}
+	mock.recorder = &MockLockAgentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLockAgent) EXPECT() *MockLockAgentMockRecorder {
+	return m.recorder
+}
+
+// TryLock mocks base method.
+func (m *MockLockAgent) TryLock(ctx context.Context, key string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TryLock", ctx, key)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TryLock indicates an expected call of TryLock.
+func (mr *MockLockAgentMockRecorder) TryLock(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TryLock", reflect.TypeOf((*MockLockAgent)(nil).TryLock), ctx, key)
+}
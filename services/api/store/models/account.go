@@ -2,6 +2,8 @@ package models
 
 import (
 	"time"
+
+	"github.com/consensys/orchestrate/pkg/types/entities"
 )
 
 type Account struct {
@@ -15,6 +17,7 @@ type Account struct {
 	TenantID            string
 	OwnerID             string
 	Attributes          map[string]string
+	SmartAccount        *entities.SmartAccount
 	// TODO add internal labels to store accountID
 	StoreID string
 
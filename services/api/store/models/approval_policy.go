@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+)
+
+type ApprovalPolicy struct {
+	tableName struct{} `pg:"approval_policies"` // nolint:unused,structcheck // reason
+
+	UUID            string `pg:",pk"`
+	Name            string
+	ChainUUID       string
+	MinValue        string
+	Recipient       string
+	MethodSignature string
+	Threshold       int
+	Approvers       []string `pg:",array"`
+	TenantID        string
+	OwnerID         string
+	CreatedAt       time.Time `pg:"default:now()"`
+	UpdatedAt       time.Time `pg:"default:now()"`
+}
@@ -2,6 +2,8 @@ package models
 
 import (
 	"time"
+
+	"github.com/consensys/orchestrate/pkg/types/entities"
 )
 
 type Chain struct {
@@ -19,6 +21,8 @@ type Chain struct {
 	ListenerBackOffDuration   string
 	ListenerExternalTxEnabled *bool `pg:"default:false,notnull"`
 	PrivateTxManagers         []*PrivateTxManager
+	GasOracle                 *entities.GasOracle
+	BundlerURL                string
 	Labels                    map[string]string
 	Headers                   map[string]string
 	CreatedAt                 time.Time `pg:"default:now()"`
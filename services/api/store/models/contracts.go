@@ -1,5 +1,11 @@
 package models
 
+import (
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/types/entities"
+)
+
 type ArtifactModel struct {
 	tableName struct{} `pg:"artifacts"` // nolint:unused,structcheck // reason
 
@@ -12,6 +18,8 @@ type ArtifactModel struct {
 	DeployedBytecode string
 	// Codehash stored on the Ethereum account. Correspond to the hash of the deployedBytecode
 	Codehash string
+	// Positions of the unlinked libraries in the bytecode
+	LinkReferences entities.LinkReferences
 }
 
 type CodehashModel struct {
@@ -47,7 +55,11 @@ type RepositoryModel struct {
 	ID int
 
 	// Repository name
-	Name string
+	Name     string
+	TenantID string
+
+	// DeletedAt is set when the repository is deregistered
+	DeletedAt *time.Time
 }
 
 type TagModel struct {
@@ -61,4 +73,7 @@ type TagModel struct {
 	RepositoryID int
 
 	ArtifactID int
+
+	// DeletedAt is set when the tag is deregistered
+	DeletedAt *time.Time
 }
@@ -23,6 +23,8 @@ type Job struct {
 	InternalData  *entities.InternalData
 	IsParent      bool `pg:"alias:is_parent,default:false,use_zero"`
 	Status        entities.JobStatus
+	RevertReason  string
+	Events        []*entities.TxEvent
 	CreatedAt     time.Time `pg:"default:now()"`
 	UpdatedAt     time.Time `pg:"default:now()"`
 }
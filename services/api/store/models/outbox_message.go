@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+)
+
+// OutboxMessage is a Kafka message waiting to be published by the outbox relay
+type OutboxMessage struct {
+	tableName struct{} `pg:"outbox_messages"` // nolint:unused,structcheck // reason
+
+	ID        int64 `pg:"alias:id"`
+	Topic     string
+	Key       []byte
+	Value     []byte
+	JobUUID   string
+	CreatedAt time.Time `pg:"default:now()"`
+}
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/types/entities"
+)
+
+type Subscription struct {
+	tableName struct{} `pg:"subscriptions"` // nolint:unused,structcheck // reason
+
+	UUID            string `pg:",pk"`
+	ChainUUID       string
+	ContractAddress string
+	EventSignature  string
+	TopicFilters    [][]string
+	Target          *entities.SubscriptionTarget
+	TenantID        string
+	OwnerID         string
+	CreatedAt       time.Time `pg:"default:now()"`
+	UpdatedAt       time.Time `pg:"default:now()"`
+}
@@ -126,6 +126,43 @@ func FakeFaucetModel() *models.Faucet {
 	}
 }
 
+func FakeSubscriptionModel(chainUUID string) *models.Subscription {
+	return &models.Subscription{
+		UUID:            uuid.Must(uuid.NewV4()).String(),
+		ChainUUID:       chainUUID,
+		ContractAddress: "0x5Cc634233E4a454d47aACd9fC68801482Fb02610",
+		EventSignature:  "Transfer(address,address,uint256)",
+		TopicFilters:    [][]string{{"0x0000000000000000000000005cc634233e4a454d47aacd9fc68801482fb02610"}},
+		Target: &entities.SubscriptionTarget{
+			Type:   entities.WebhookSubscriptionTargetType,
+			URL:    "https://example.com/events",
+			Secret: "secret",
+		},
+		TenantID: "tenantID",
+	}
+}
+
+func FakeApprovalPolicyModel() *models.ApprovalPolicy {
+	return &models.ApprovalPolicy{
+		UUID:      uuid.Must(uuid.NewV4()).String(),
+		Name:      "treasury-transfers",
+		MinValue:  "1000",
+		Threshold: 2,
+		Approvers: []string{"alice", "bob", "carol"},
+		TenantID:  "tenantID",
+	}
+}
+
+func FakeTransactionPolicyModel() *models.TransactionPolicy {
+	return &models.TransactionPolicy{
+		UUID:          uuid.Must(uuid.NewV4()).String(),
+		Name:          "daily-cap",
+		Type:          "DailyValueCap",
+		MaxDailyValue: "1000",
+		TenantID:      "tenantID",
+	}
+}
+
 func FakeChainModel() *models.Chain {
 	return &models.Chain{
 		UUID:                      uuid.Must(uuid.NewV4()).String(),
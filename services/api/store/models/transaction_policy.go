@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+)
+
+type TransactionPolicy struct {
+	tableName struct{} `pg:"transaction_policies"` // nolint:unused,structcheck // reason
+
+	UUID             string `pg:",pk"`
+	Name             string
+	Type             string
+	ChainUUID        string
+	Account          string
+	MaxDailyValue    string
+	Addresses        []string `pg:",array"`
+	Contract         string
+	MethodSignatures []string `pg:",array"`
+	MaxGasPrice      string
+	TenantID         string
+	OwnerID          string
+	CreatedAt        time.Time `pg:"default:now()"`
+	UpdatedAt        time.Time `pg:"default:now()"`
+}
+
+// TransactionPolicySpending is the amount of ether transferred in a day by the jobs matching a daily value cap policy
+type TransactionPolicySpending struct {
+	tableName struct{} `pg:"transaction_policy_spendings"` // nolint:unused,structcheck // reason
+
+	PolicyUUID string `pg:",pk"`
+	Day        string `pg:",pk"`
+	Amount     string
+}
@@ -21,6 +21,11 @@ type PGAgents struct {
 	contract         store.ContractAgent
 	chain            store.ChainAgent
 	privateTxManager store.PrivateTxManagerAgent
+	outbox           store.OutboxAgent
+	subscription     store.SubscriptionAgent
+	approvalPolicy   store.ApprovalPolicyAgent
+	txPolicy         store.TransactionPolicyAgent
+	lock             store.LockAgent
 }
 
 func New(db pg.DB) *PGAgents {
@@ -40,6 +45,11 @@ func New(db pg.DB) *PGAgents {
 		contract:         NewPGContract(db),
 		chain:            NewPGChain(db),
 		privateTxManager: NewPGPrivateTxManager(db),
+		outbox:           NewPGOutbox(db),
+		subscription:     NewPGSubscription(db),
+		approvalPolicy:   NewPGApprovalPolicy(db),
+		txPolicy:         NewPGTransactionPolicy(db),
+		lock:             NewPGLock(db),
 	}
 }
 
@@ -102,3 +112,23 @@ func (a *PGAgents) Chain() store.ChainAgent {
 func (a *PGAgents) PrivateTxManager() store.PrivateTxManagerAgent {
 	return a.privateTxManager
 }
+
+func (a *PGAgents) Outbox() store.OutboxAgent {
+	return a.outbox
+}
+
+func (a *PGAgents) Subscription() store.SubscriptionAgent {
+	return a.subscription
+}
+
+func (a *PGAgents) ApprovalPolicy() store.ApprovalPolicyAgent {
+	return a.approvalPolicy
+}
+
+func (a *PGAgents) TransactionPolicy() store.TransactionPolicyAgent {
+	return a.txPolicy
+}
+
+func (a *PGAgents) Lock() store.LockAgent {
+	return a.lock
+}
@@ -0,0 +1,99 @@
+package dataagents
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	pg "github.com/consensys/orchestrate/pkg/toolkit/database/postgres"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/store"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	"github.com/gofrs/uuid"
+)
+
+const approvalPolicyDAComponent = "data-agents.approval-policy"
+
+// PGApprovalPolicy is an ApprovalPolicy data agent for PostgreSQL
+type PGApprovalPolicy struct {
+	db     pg.DB
+	logger *log.Logger
+}
+
+// NewPGApprovalPolicy creates a new PGApprovalPolicy
+func NewPGApprovalPolicy(db pg.DB) store.ApprovalPolicyAgent {
+	return &PGApprovalPolicy{db: db, logger: log.NewLogger().SetComponent(approvalPolicyDAComponent)}
+}
+
+// Insert Inserts a new approval policy in DB
+func (agent *PGApprovalPolicy) Insert(ctx context.Context, policy *models.ApprovalPolicy) error {
+	if policy.UUID == "" {
+		policy.UUID = uuid.Must(uuid.NewV4()).String()
+	}
+
+	err := pg.Insert(ctx, agent.db, policy)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to insert approval policy")
+		return errors.FromError(err).ExtendComponent(approvalPolicyDAComponent)
+	}
+
+	return nil
+}
+
+// FindOneByUUID Finds an approval policy in DB by UUID
+func (agent *PGApprovalPolicy) FindOneByUUID(ctx context.Context, policyUUID string, tenants []string, ownerID string) (*models.ApprovalPolicy, error) {
+	policy := &models.ApprovalPolicy{}
+
+	query := agent.db.ModelContext(ctx, policy).Where("uuid = ?", policyUUID)
+	query = pg.WhereAllowedTenants(query, "tenant_id", tenants)
+	query = pg.WhereAllowedOwner(query, "owner_id", ownerID)
+
+	err := pg.SelectOne(ctx, query)
+	if err != nil {
+		if !errors.IsNotFoundError(err) {
+			agent.logger.WithContext(ctx).WithError(err).Error("failed to select approval policy by uuid")
+		}
+		return nil, errors.FromError(err).ExtendComponent(approvalPolicyDAComponent)
+	}
+
+	return policy, nil
+}
+
+func (agent *PGApprovalPolicy) Search(ctx context.Context, filters *entities.ApprovalPolicyFilters, tenants []string, ownerID string) ([]*models.ApprovalPolicy, error) {
+	var policies []*models.ApprovalPolicy
+
+	query := agent.db.ModelContext(ctx, &policies)
+	if filters.ChainUUID != "" {
+		query = query.Where("chain_uuid = ?", filters.ChainUUID)
+	}
+	if filters.TenantID != "" {
+		query = query.Where("tenant_id = ?", filters.TenantID)
+	}
+
+	query = pg.WhereAllowedTenants(query, "tenant_id", tenants).Order("created_at ASC")
+	query = pg.WhereAllowedOwner(query, "owner_id", ownerID)
+
+	err := pg.Select(ctx, query)
+	if err != nil {
+		if !errors.IsNotFoundError(err) {
+			agent.logger.WithContext(ctx).WithError(err).Error("failed to search approval policies")
+		}
+		return nil, errors.FromError(err).ExtendComponent(approvalPolicyDAComponent)
+	}
+
+	return policies, nil
+}
+
+func (agent *PGApprovalPolicy) Delete(ctx context.Context, policy *models.ApprovalPolicy, tenants []string, ownerID string) error {
+	query := agent.db.ModelContext(ctx, policy).Where("uuid = ?", policy.UUID)
+	query = pg.WhereAllowedTenantsDefault(query, tenants)
+	query = pg.WhereAllowedOwner(query, "owner_id", ownerID)
+
+	err := pg.Delete(ctx, query)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to delete approval policy")
+		return errors.FromError(err).ExtendComponent(approvalPolicyDAComponent)
+	}
+
+	return nil
+}
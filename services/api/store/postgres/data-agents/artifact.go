@@ -66,18 +66,20 @@ func (agent *PGArtifact) SelectOrInsert(ctx context.Context, artifact *models.Ar
 	return nil
 }
 
-func (agent *PGArtifact) FindOneByNameAndTag(ctx context.Context, name, tag string) (*models.ArtifactModel, error) {
+func (agent *PGArtifact) FindOneByNameAndTag(ctx context.Context, name, tag string, tenants []string) (*models.ArtifactModel, error) {
 	artifact := &models.ArtifactModel{}
 	query := agent.db.ModelContext(ctx, artifact).
 		Column("artifact_model.id", "abi", "bytecode", "deployed_bytecode").
 		Join("JOIN tags AS t ON t.artifact_id = artifact_model.id").
-		Join("JOIN repositories AS registry ON registry.id = t.repository_id").
 		Where("LOWER(t.name) = ?", strings.ToLower(tag)).
-		Where("LOWER(registry.name) = ?", strings.ToLower(name))
+		Where("t.deleted_at IS NULL").
+		Where("t.repository_id = (?)", repositoryIDQuery(agent.db, name, tenants))
 
 	err := pg.SelectOne(ctx, query)
 	if err != nil {
-		agent.logger.WithContext(ctx).WithError(err).Error("failed to select artifact by name and tag")
+		if !errors.IsNotFoundError(err) {
+			agent.logger.WithContext(ctx).WithError(err).Error("failed to select artifact by name and tag")
+		}
 		return nil, errors.FromError(err).ExtendComponent(artifactDAComponent)
 	}
 
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/toolkit/database/postgres"
 	pgTestUtils "github.com/consensys/orchestrate/pkg/toolkit/database/postgres/testutils"
 	"github.com/consensys/orchestrate/services/api/store/models"
@@ -146,14 +147,14 @@ func (s *artifactTestSuite) TestPGArtifact_FindOneByNameAndTag() {
 	ctx := context.Background()
 
 	s.T().Run("should return NotFoundError if none is found", func(t *testing.T) {
-		_, err := s.agents.Artifact().FindOneByNameAndTag(ctx, "name", "tag")
+		_, err := s.agents.Artifact().FindOneByNameAndTag(ctx, "name", "tag", []string{multitenancy.DefaultTenant})
 		assert.True(t, errors.IsNotFoundError(err))
 	})
 
 	s.T().Run("should find successfully", func(t *testing.T) {
 		_ = s.insertArtifacts(ctx, "myContract", "tag")
 
-		artifact, err := s.agents.Artifact().FindOneByNameAndTag(ctx, "myContract", "tag")
+		artifact, err := s.agents.Artifact().FindOneByNameAndTag(ctx, "myContract", "tag", []string{multitenancy.DefaultTenant})
 
 		assert.NoError(t, err)
 		assert.Equal(t, 1, artifact.ID)
@@ -162,7 +163,7 @@ func (s *artifactTestSuite) TestPGArtifact_FindOneByNameAndTag() {
 	s.T().Run("should return PostgresConnectionError if select fails", func(t *testing.T) {
 		// We drop the DB to make the test fail
 		s.pg.DropTestDB(t)
-		_, err := s.agents.Artifact().FindOneByNameAndTag(ctx, "name", "tag")
+		_, err := s.agents.Artifact().FindOneByNameAndTag(ctx, "name", "tag", []string{multitenancy.DefaultTenant})
 
 		assert.True(t, errors.IsInternalError(err))
 
@@ -7,10 +7,13 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	pg "github.com/consensys/orchestrate/pkg/toolkit/database/postgres"
 	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/orchestrate/services/api/store"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	gopg "github.com/go-pg/pg/v9"
 )
 
 const contractDAComponent = "data-agents.contract"
@@ -35,18 +38,20 @@ func NewPGContract(db pg.DB) store.ContractAgent {
 	}
 }
 
-func (agent *PGContract) FindOneByCodeHash(ctx context.Context, codeHash string) (*entities.Contract, error) {
+// FindOneByCodeHash returns the latest contract registered with the code hash, deregistered contracts included so that
+// the accounts deployed before the deregistration are still resolved
+func (agent *PGContract) FindOneByCodeHash(ctx context.Context, codeHash string, tenants []string) (*entities.Contract, error) {
 	qContract := &contractQuery{}
 	query := `
 SELECT a.abi, a.bytecode, a.deployed_bytecode, r.name as name, t.name as tag 
 FROM artifacts a
 INNER JOIN tags t ON (a.id = t.artifact_id)
 INNER JOIN repositories r ON (r.id = t.repository_id) 
-WHERE a.codehash = ?
-ORDER BY t.id DESC
+WHERE a.codehash = ?` + whereAllowedTenants("r.tenant_id", tenants) + `
+ORDER BY t.deleted_at IS NOT NULL, t.id DESC
 LIMIT 1
 `
-	_, err := agent.db.QueryOneContext(ctx, qContract, query, codeHash)
+	_, err := agent.db.QueryOneContext(ctx, qContract, query, codeHash, gopg.In(tenants))
 	if err != nil {
 		return nil, pg.ParsePGError(err)
 	}
@@ -54,7 +59,8 @@ LIMIT 1
 	return parseContract(qContract)
 }
 
-func (agent *PGContract) FindOneByAddress(ctx context.Context, address string) (*entities.Contract, error) {
+// FindOneByAddress returns the contract deployed at the address, deregistered contracts included
+func (agent *PGContract) FindOneByAddress(ctx context.Context, address string, tenants []string) (*entities.Contract, error) {
 	qContract := &contractQuery{}
 	query := `
 SELECT a.abi, a.bytecode, a.deployed_bytecode, r.name as name, t.name as tag 
@@ -62,10 +68,11 @@ FROM artifacts a
 INNER JOIN tags t ON (a.id = t.artifact_id)
 INNER JOIN repositories r ON (r.id = t.repository_id) 
 INNER JOIN codehashes ch ON (ch.codehash = a.codehash) 
-WHERE ch.address = ?
+WHERE ch.address = ?` + whereAllowedTenants("r.tenant_id", tenants) + `
+ORDER BY t.deleted_at IS NOT NULL, t.id DESC
 LIMIT 1
 `
-	_, err := agent.db.QueryOneContext(ctx, qContract, query, address)
+	_, err := agent.db.QueryOneContext(ctx, qContract, query, address, gopg.In(tenants))
 	if err != nil {
 		return nil, pg.ParsePGError(err)
 	}
@@ -73,6 +80,15 @@ LIMIT 1
 	return parseContract(qContract)
 }
 
+// whereAllowedTenants is the raw SQL counterpart of pg.WhereAllowedTenants, expecting the tenants as second parameter
+func whereAllowedTenants(field string, tenants []string) string {
+	if len(tenants) == 0 || utils.ContainsString(tenants, multitenancy.WildcardTenant) {
+		return ""
+	}
+
+	return " AND " + field + " IN (?)"
+}
+
 func parseContract(qContract *contractQuery) (*entities.Contract, error) {
 	parsedABI, err := abi.JSON(strings.NewReader(qContract.ABI))
 	if err != nil {
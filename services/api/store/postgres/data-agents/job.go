@@ -160,16 +160,45 @@ func (agent *PGJob) Search(ctx context.Context, filters *entities.JobFilters, te
 		query = query.Where("job.is_parent is true")
 	}
 
+	if filters.Type != "" {
+		query = query.Where("job.type = ?", filters.Type)
+	}
+
+	if len(filters.Labels) > 0 {
+		query = query.Where("job.labels @> ?", filters.Labels)
+	}
+
+	if filters.From != "" {
+		query = query.Where("lower(transaction.sender) = lower(?)", filters.From)
+	}
+
+	if filters.To != "" {
+		query = query.Where("lower(transaction.recipient) = lower(?)", filters.To)
+	}
+
 	if filters.UpdatedAfter.Second() > 0 {
 		query = query.Where("job.updated_at >= ?", filters.UpdatedAfter)
 	}
 
-	query = pg.WhereAllowedTenants(query, "schedule.tenant_id", tenants).Order("id ASC")
+	if !filters.CreatedAfter.IsZero() {
+		query = query.Where("job.created_at >= ?", filters.CreatedAfter)
+	}
+
+	if !filters.CreatedBefore.IsZero() {
+		query = query.Where("job.created_at < ?", filters.CreatedBefore)
+	}
+
+	query = pg.WhereAllowedTenants(query, "schedule.tenant_id", tenants)
 	if ownerID != "" {
 		query = pg.WhereAllowedOwner(query, "schedule.owner_id", ownerID)
 	}
 
-	err := pg.Select(ctx, query)
+	query, err := pg.CursorPaginate(query, "job", &filters.Pagination)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(jobDAComponent)
+	}
+
+	err = pg.Select(ctx, query)
 	if err != nil {
 		if !errors.IsNotFoundError(err) {
 			agent.logger.WithError(err).Error("failed to search jobs")
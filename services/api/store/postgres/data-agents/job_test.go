@@ -236,6 +236,9 @@ func (s *jobTestSuite) TestPGJob_Search() {
 	job1.Schedule.OwnerID = s.username
 	job1.InternalData.ParentJobUUID = job0.UUID
 	job1.Logs[0].Status = entities.StatusPending
+	job1.Labels = map[string]string{"env": "prod", "team": "core"}
+	job1.Transaction.Sender = "0x7E654d251Da770A068413677967F6d3Ea2FeA9E4"
+	job1.Type = entities.EthereumRawTransaction
 	err = s.insertJob(ctx, s.agents, job1)
 	assert.NoError(s.T(), err)
 
@@ -316,6 +319,71 @@ func (s *jobTestSuite) TestPGJob_Search() {
 		assert.NoError(t, err)
 		assert.Equal(t, len(retrievedJobs), 2)
 	})
+
+	s.T().Run("should find models successfully by labels, type and sender", func(t *testing.T) {
+		filters := &entities.JobFilters{
+			Labels: map[string]string{"env": "prod"},
+			Type:   entities.EthereumRawTransaction,
+			From:   "0x7e654d251da770a068413677967f6d3ea2fea9e4",
+		}
+
+		retrievedJobs, err := s.agents.Job().Search(ctx, filters, s.allowedTenants, s.username)
+
+		assert.NoError(t, err)
+		assert.Len(t, retrievedJobs, 1)
+		assert.Equal(t, job1.UUID, retrievedJobs[0].UUID)
+	})
+
+	s.T().Run("should not find any model by labels", func(t *testing.T) {
+		filters := &entities.JobFilters{
+			Labels: map[string]string{"env": "dev"},
+		}
+
+		retrievedJobs, err := s.agents.Job().Search(ctx, filters, s.allowedTenants, s.username)
+
+		assert.NoError(t, err)
+		assert.Empty(t, retrievedJobs)
+	})
+
+	s.T().Run("should find models page by page", func(t *testing.T) {
+		filters := &entities.JobFilters{
+			Pagination: entities.CursorPagination{Limit: 1, SortBy: entities.SortByCreatedAt},
+		}
+
+		firstPage, err := s.agents.Job().Search(ctx, filters, s.allowedTenants, s.username)
+		assert.NoError(t, err)
+		assert.Len(t, firstPage, 1)
+		assert.Equal(t, job0.UUID, firstPage[0].UUID)
+
+		filters.Pagination.Cursor = (&entities.Cursor{Time: firstPage[0].CreatedAt, UUID: firstPage[0].UUID}).String()
+		secondPage, err := s.agents.Job().Search(ctx, filters, s.allowedTenants, s.username)
+		assert.NoError(t, err)
+		assert.Len(t, secondPage, 1)
+		assert.Equal(t, job1.UUID, secondPage[0].UUID)
+
+		filters.Pagination.Cursor = (&entities.Cursor{Time: secondPage[0].CreatedAt, UUID: secondPage[0].UUID}).String()
+		lastPage, err := s.agents.Job().Search(ctx, filters, s.allowedTenants, s.username)
+		assert.NoError(t, err)
+		assert.Empty(t, lastPage)
+	})
+
+	s.T().Run("should fail with InvalidParameterError if cursor is invalid", func(t *testing.T) {
+		filters := &entities.JobFilters{
+			Pagination: entities.CursorPagination{Cursor: "invalid"},
+		}
+
+		_, err := s.agents.Job().Search(ctx, filters, s.allowedTenants, s.username)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	s.T().Run("should fail with InvalidParameterError if limit exceeds the maximum page size", func(t *testing.T) {
+		filters := &entities.JobFilters{
+			Pagination: entities.CursorPagination{Limit: entities.MaxPageSize + 1},
+		}
+
+		_, err := s.agents.Job().Search(ctx, filters, s.allowedTenants, s.username)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
 }
 
 func (s *jobTestSuite) TestPGJob_ConnectionErr() {
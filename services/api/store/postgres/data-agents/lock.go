@@ -0,0 +1,36 @@
+package dataagents
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	pg "github.com/consensys/orchestrate/pkg/toolkit/database/postgres"
+	"github.com/consensys/orchestrate/services/api/store"
+	gopg "github.com/go-pg/pg/v9"
+)
+
+const lockDAComponent = "data-agents.lock"
+
+// PGLock is a lock data agent for PostgreSQL, based on transaction level advisory locks
+type PGLock struct {
+	db     pg.DB
+	logger *log.Logger
+}
+
+// NewPGLock creates a new PGLock
+func NewPGLock(db pg.DB) store.LockAgent {
+	return &PGLock{db: db, logger: log.NewLogger().SetComponent(lockDAComponent)}
+}
+
+// TryLock takes the advisory lock identified by the key until the end of the DB transaction, without waiting for it
+func (agent *PGLock) TryLock(ctx context.Context, key string) (bool, error) {
+	var locked bool
+	_, err := agent.db.QueryOneContext(ctx, gopg.Scan(&locked), "SELECT pg_try_advisory_xact_lock(hashtext(?))", key)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to take advisory lock")
+		return false, errors.FromError(pg.ParsePGError(err)).ExtendComponent(lockDAComponent)
+	}
+
+	return locked, nil
+}
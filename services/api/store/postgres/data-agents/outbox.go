@@ -0,0 +1,93 @@
+package dataagents
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	pg "github.com/consensys/orchestrate/pkg/toolkit/database/postgres"
+	"github.com/consensys/orchestrate/services/api/store"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	gopg "github.com/go-pg/pg/v9"
+)
+
+const outboxDAComponent = "data-agents.outbox"
+
+// PGOutbox is an outbox data agent for PostgreSQL
+type PGOutbox struct {
+	db     pg.DB
+	logger *log.Logger
+}
+
+// NewPGOutbox creates a new PGOutbox
+func NewPGOutbox(db pg.DB) store.OutboxAgent {
+	return &PGOutbox{db: db, logger: log.NewLogger().SetComponent(outboxDAComponent)}
+}
+
+// InsertMultiple inserts messages to be published in the outbox
+func (agent *PGOutbox) InsertMultiple(ctx context.Context, messages []*models.OutboxMessage) error {
+	err := pg.InsertQuery(ctx, agent.db.ModelContext(ctx, &messages))
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to insert outbox messages")
+		return errors.FromError(err).ExtendComponent(outboxDAComponent)
+	}
+
+	return nil
+}
+
+// LockOldest locks the oldest messages of the outbox, skipping the messages locked by other relays
+func (agent *PGOutbox) LockOldest(ctx context.Context, limit int) ([]*models.OutboxMessage, error) {
+	var messages []*models.OutboxMessage
+	query := agent.db.ModelContext(ctx, &messages).
+		Order("id ASC").
+		Limit(limit).
+		For("UPDATE SKIP LOCKED")
+
+	err := pg.Select(ctx, query)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to lock outbox messages")
+		return nil, errors.FromError(err).ExtendComponent(outboxDAComponent)
+	}
+
+	return messages, nil
+}
+
+// FindOldest gets the oldest message of the outbox
+func (agent *PGOutbox) FindOldest(ctx context.Context) (*models.OutboxMessage, error) {
+	message := &models.OutboxMessage{}
+	query := agent.db.ModelContext(ctx, message).Order("id ASC").Limit(1)
+
+	err := pg.SelectOne(ctx, query)
+	if err != nil {
+		if !errors.IsNotFoundError(err) {
+			agent.logger.WithContext(ctx).WithError(err).Error("failed to find oldest outbox message")
+		}
+		return nil, errors.FromError(err).ExtendComponent(outboxDAComponent)
+	}
+
+	return message, nil
+}
+
+// Count counts the messages waiting in the outbox
+func (agent *PGOutbox) Count(ctx context.Context) (int, error) {
+	count, err := agent.db.ModelContext(ctx, (*models.OutboxMessage)(nil)).Count()
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to count outbox messages")
+		return 0, errors.FromError(pg.ParsePGError(err)).ExtendComponent(outboxDAComponent)
+	}
+
+	return count, nil
+}
+
+// Delete deletes published messages from the outbox
+func (agent *PGOutbox) Delete(ctx context.Context, ids []int64) error {
+	query := agent.db.ModelContext(ctx, (*models.OutboxMessage)(nil)).Where("id IN (?)", gopg.In(ids))
+
+	err := pg.Delete(ctx, query)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to delete outbox messages")
+		return errors.FromError(err).ExtendComponent(outboxDAComponent)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+// +build !unit
+// +build !race
+// +build !integration
+
+package dataagents
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	pgTestUtils "github.com/consensys/orchestrate/pkg/toolkit/database/postgres/testutils"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	"github.com/consensys/orchestrate/services/api/store/postgres/migrations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type outboxTestSuite struct {
+	suite.Suite
+	agents *PGAgents
+	pg     *pgTestUtils.PGTestHelper
+}
+
+func TestPGOutbox(t *testing.T) {
+	s := new(outboxTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *outboxTestSuite) SetupSuite() {
+	s.pg, _ = pgTestUtils.NewPGTestHelper(nil, migrations.Collection)
+	s.pg.InitTestDB(s.T())
+}
+
+func (s *outboxTestSuite) SetupTest() {
+	s.pg.UpgradeTestDB(s.T())
+	s.agents = New(s.pg.DB)
+}
+
+func (s *outboxTestSuite) TearDownTest() {
+	s.pg.DowngradeTestDB(s.T())
+}
+
+func (s *outboxTestSuite) TearDownSuite() {
+	s.pg.DropTestDB(s.T())
+}
+
+func (s *outboxTestSuite) TestPGOutbox() {
+	ctx := context.Background()
+
+	outboxMsgs := []*models.OutboxMessage{
+		{Topic: "topic-tx-sender", Key: []byte("key"), Value: []byte("first"), JobUUID: "6380e2b6-b828-43ee-abdc-de0f8d57dc5f"},
+		{Topic: "topic-tx-sender", Value: []byte("second")},
+	}
+
+	s.T().Run("should insert messages successfully", func(t *testing.T) {
+		err := s.agents.Outbox().InsertMultiple(ctx, outboxMsgs)
+
+		require.NoError(t, err)
+		assert.NotEmpty(t, outboxMsgs[0].ID)
+		assert.Greater(t, outboxMsgs[1].ID, outboxMsgs[0].ID)
+	})
+
+	s.T().Run("should count and find oldest message successfully", func(t *testing.T) {
+		count, err := s.agents.Outbox().Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+
+		oldestMsg, err := s.agents.Outbox().FindOldest(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, outboxMsgs[0].Value, oldestMsg.Value)
+		assert.Equal(t, outboxMsgs[0].JobUUID, oldestMsg.JobUUID)
+	})
+
+	s.T().Run("should lock oldest messages successfully", func(t *testing.T) {
+		lockedMsgs, err := s.agents.Outbox().LockOldest(ctx, 1)
+
+		require.NoError(t, err)
+		require.Len(t, lockedMsgs, 1)
+		assert.Equal(t, outboxMsgs[0].ID, lockedMsgs[0].ID)
+	})
+
+	s.T().Run("should delete messages successfully", func(t *testing.T) {
+		err := s.agents.Outbox().Delete(ctx, []int64{outboxMsgs[0].ID, outboxMsgs[1].ID})
+		require.NoError(t, err)
+
+		_, err = s.agents.Outbox().FindOldest(ctx)
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
@@ -2,12 +2,15 @@ package dataagents
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	pg "github.com/consensys/orchestrate/pkg/toolkit/database/postgres"
 	"github.com/consensys/orchestrate/services/api/store"
 	"github.com/consensys/orchestrate/services/api/store/models"
+	"github.com/go-pg/pg/v9/orm"
 )
 
 const repositoryDAComponent = "data-agents.repository"
@@ -22,9 +25,9 @@ func NewPGRepository(db pg.DB) store.RepositoryAgent {
 	return &PGRepository{db: db, logger: log.NewLogger().SetComponent(repositoryDAComponent)}
 }
 
-func (agent *PGRepository) FindOne(ctx context.Context, name string) (*models.RepositoryModel, error) {
+func (agent *PGRepository) FindOne(ctx context.Context, name string, tenants []string) (*models.RepositoryModel, error) {
 	model := &models.RepositoryModel{}
-	query := agent.db.ModelContext(ctx, model).Where("LOWER(name) = LOWER(?)", name)
+	query := agent.db.ModelContext(ctx, model).Where("id = (?)", repositoryIDQuery(agent.db, name, tenants))
 	err := pg.SelectOne(ctx, query)
 	if err != nil {
 		if !errors.IsNotFoundError(err) {
@@ -37,7 +40,8 @@ func (agent *PGRepository) FindOne(ctx context.Context, name string) (*models.Re
 }
 
 func (agent *PGRepository) SelectOrInsert(ctx context.Context, repository *models.RepositoryModel) error {
-	q := agent.db.ModelContext(ctx, repository).Column("id").Where("name = ?name").
+	q := agent.db.ModelContext(ctx, repository).Column("id").
+		Where("lower(name) = lower(?name)").Where("tenant_id = ?tenant_id").Where("deleted_at IS NULL").
 		OnConflict("DO NOTHING").Returning("id")
 
 	err := pg.SelectOrInsert(ctx, q)
@@ -59,12 +63,16 @@ func (agent *PGRepository) Insert(ctx context.Context, repository *models.Reposi
 	return nil
 }
 
-func (agent *PGRepository) FindAll(ctx context.Context) ([]string, error) {
+func (agent *PGRepository) FindAll(ctx context.Context, tenants []string) ([]string, error) {
 	var names []string
 	query := agent.db.ModelContext(ctx, (*models.RepositoryModel)(nil)).
 		Column("name").
+		Where("deleted_at IS NULL").
+		Group("name").
 		OrderExpr("lower(name)")
 
+	query = pg.WhereAllowedTenants(query, "tenant_id", tenants)
+
 	err := pg.SelectColumn(ctx, query, &names)
 	if err != nil {
 		if !errors.IsNotFoundError(err) {
@@ -75,3 +83,30 @@ func (agent *PGRepository) FindAll(ctx context.Context) ([]string, error) {
 
 	return names, nil
 }
+
+func (agent *PGRepository) Delete(ctx context.Context, repository *models.RepositoryModel) error {
+	now := time.Now().UTC()
+	repository.DeletedAt = &now
+
+	query := agent.db.ModelContext(ctx, repository).Column("deleted_at").WherePK()
+	err := pg.Update(ctx, query)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to delete repository")
+		return errors.FromError(err).ExtendComponent(repositoryDAComponent)
+	}
+
+	return nil
+}
+
+// repositoryIDQuery selects the live repository with the given name, the most specific tenant shadowing its parent
+// tenants and the default tenant
+func repositoryIDQuery(db pg.DB, name string, tenants []string) *orm.Query {
+	query := db.Model((*models.RepositoryModel)(nil)).
+		Column("id").
+		Where("lower(name) = ?", strings.ToLower(name)).
+		Where("deleted_at IS NULL").
+		OrderExpr("length(tenant_id) DESC").
+		Limit(1)
+
+	return pg.WhereAllowedTenants(query, "tenant_id", tenants)
+}
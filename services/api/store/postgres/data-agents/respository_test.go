@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/toolkit/database/postgres"
 	pgTestUtils "github.com/consensys/orchestrate/pkg/toolkit/database/postgres/testutils"
 	"github.com/consensys/orchestrate/services/api/store/models"
@@ -104,14 +105,14 @@ func (s *repositoryTestSuite) TestPGRepository_FindOneByName() {
 	ctx := context.Background()
 
 	s.T().Run("should return NotFoundError if none is found", func(t *testing.T) {
-		_, err := s.agents.Repository().FindOne(ctx, "unknown")
+		_, err := s.agents.Repository().FindOne(ctx, "unknown", []string{multitenancy.DefaultTenant})
 		assert.True(t, errors.IsNotFoundError(err))
 	})
 
 	s.T().Run("should find successfully", func(t *testing.T) {
 		s.insertRepo(ctx, 1)
 
-		artifact, err := s.agents.Repository().FindOne(ctx, "myRepository_0")
+		artifact, err := s.agents.Repository().FindOne(ctx, "myRepository_0", []string{multitenancy.DefaultTenant})
 
 		assert.NoError(t, err)
 		assert.NotEmpty(t, artifact.ID)
@@ -120,7 +121,7 @@ func (s *repositoryTestSuite) TestPGRepository_FindOneByName() {
 	s.T().Run("should return PostgresConnectionError if select fails", func(t *testing.T) {
 		// We drop the DB to make the test fail
 		s.pg.DropTestDB(t)
-		_, err := s.agents.Repository().FindOne(ctx, "repository")
+		_, err := s.agents.Repository().FindOne(ctx, "repository", []string{multitenancy.DefaultTenant})
 
 		assert.True(t, errors.IsInternalError(err))
 
@@ -134,7 +135,7 @@ func (s *repositoryTestSuite) TestPGRepository_FindAll() {
 	s.T().Run("should find all successfully", func(t *testing.T) {
 		s.insertRepo(ctx, 5)
 
-		names, err := s.agents.Repository().FindAll(ctx)
+		names, err := s.agents.Repository().FindAll(ctx, []string{multitenancy.DefaultTenant})
 
 		assert.Equal(t, 5, len(names))
 		assert.NoError(t, err)
@@ -143,7 +144,7 @@ func (s *repositoryTestSuite) TestPGRepository_FindAll() {
 	s.T().Run("should return PostgresConnectionError if select fails", func(t *testing.T) {
 		// We drop the DB to make the test fail
 		s.pg.DropTestDB(t)
-		_, err := s.agents.Repository().FindAll(ctx)
+		_, err := s.agents.Repository().FindAll(ctx, []string{multitenancy.DefaultTenant})
 
 		assert.True(t, errors.IsInternalError(err))
 
@@ -6,6 +6,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	pg "github.com/consensys/orchestrate/pkg/toolkit/database/postgres"
+	"github.com/consensys/orchestrate/pkg/types/entities"
 	"github.com/consensys/orchestrate/services/api/store"
 	"github.com/go-pg/pg/v9/orm"
 	"github.com/gofrs/uuid"
@@ -65,7 +66,7 @@ func (agent *PGSchedule) FindOneByUUID(ctx context.Context, scheduleUUID string,
 }
 
 // Search Finds schedules in DB
-func (agent *PGSchedule) FindAll(ctx context.Context, tenants []string, ownerID string) ([]*models.Schedule, error) {
+func (agent *PGSchedule) Search(ctx context.Context, filters *entities.ScheduleFilters, tenants []string, ownerID string) ([]*models.Schedule, error) {
 	var schedules []*models.Schedule
 
 	query := agent.db.ModelContext(ctx, &schedules).
@@ -73,15 +74,28 @@ func (agent *PGSchedule) FindAll(ctx context.Context, tenants []string, ownerID
 			return q.Order("id ASC"), nil
 		})
 
+	if !filters.CreatedAfter.IsZero() {
+		query = query.Where("schedule.created_at >= ?", filters.CreatedAfter)
+	}
+
+	if !filters.CreatedBefore.IsZero() {
+		query = query.Where("schedule.created_at < ?", filters.CreatedBefore)
+	}
+
 	query = pg.WhereAllowedTenants(query, "schedule.tenant_id", tenants)
 	query = pg.WhereAllowedOwner(query, "owner_id", ownerID)
 
-	err := pg.Select(ctx, query)
+	query, err := pg.CursorPaginate(query, "schedule", &filters.Pagination)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(scheduleDAComponent)
+	}
+
+	err = pg.Select(ctx, query)
 	if err != nil {
 		if !errors.IsNotFoundError(err) {
-			agent.logger.WithContext(ctx).WithError(err).Error("failed to insert all schedules")
+			agent.logger.WithContext(ctx).WithError(err).Error("failed to search schedules")
 		}
-		return nil, errors.FromError(err).ExtendComponent(jobDAComponent)
+		return nil, errors.FromError(err).ExtendComponent(scheduleDAComponent)
 	}
 
 	return schedules, nil
@@ -10,10 +10,12 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	pgTestUtils "github.com/consensys/orchestrate/pkg/toolkit/database/postgres/testutils"
+	"github.com/consensys/orchestrate/pkg/types/entities"
 	"github.com/consensys/orchestrate/services/api/store/models"
 	"github.com/consensys/orchestrate/services/api/store/models/testutils"
 	"github.com/consensys/orchestrate/services/api/store/postgres/migrations"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -95,7 +97,7 @@ func (s *scheduleTestSuite) TestPGSchedule_FindOneByUUID() {
 	})
 }
 
-func (s *scheduleTestSuite) TestPGSchedule_FindAll() {
+func (s *scheduleTestSuite) TestPGSchedule_Search() {
 	ctx := context.Background()
 	tenantID2 := "tenantID2"
 	schedules := []*models.Schedule{
@@ -111,7 +113,7 @@ func (s *scheduleTestSuite) TestPGSchedule_FindAll() {
 	}
 
 	s.T().Run("should get models successfully as tenant", func(t *testing.T) {
-		schedulesRetrieved, err := s.agents.Schedule().FindAll(ctx, s.allowedTenants, s.username)
+		schedulesRetrieved, err := s.agents.Schedule().Search(ctx, &entities.ScheduleFilters{}, s.allowedTenants, s.username)
 
 		assert.NoError(t, err)
 		assert.Equal(t, 2, len(schedulesRetrieved))
@@ -120,8 +122,32 @@ func (s *scheduleTestSuite) TestPGSchedule_FindAll() {
 		}
 	})
 
+	s.T().Run("should get models page by page", func(t *testing.T) {
+		filters := &entities.ScheduleFilters{Pagination: entities.CursorPagination{Limit: 1}}
+
+		firstPage, err := s.agents.Schedule().Search(ctx, filters, s.allowedTenants, s.username)
+		require.NoError(t, err)
+		require.Len(t, firstPage, 1)
+		assert.Equal(t, schedules[0].UUID, firstPage[0].UUID)
+
+		filters.Pagination.Cursor = (&entities.Cursor{Time: firstPage[0].CreatedAt, UUID: firstPage[0].UUID}).String()
+		secondPage, err := s.agents.Schedule().Search(ctx, filters, s.allowedTenants, s.username)
+		require.NoError(t, err)
+		require.Len(t, secondPage, 1)
+		assert.Equal(t, schedules[1].UUID, secondPage[0].UUID)
+	})
+
+	s.T().Run("should get models in descending order", func(t *testing.T) {
+		filters := &entities.ScheduleFilters{Pagination: entities.CursorPagination{SortOrder: entities.SortOrderDesc}}
+
+		schedulesRetrieved, err := s.agents.Schedule().Search(ctx, filters, s.allowedTenants, s.username)
+		require.NoError(t, err)
+		require.Len(t, schedulesRetrieved, 2)
+		assert.Equal(t, schedules[1].UUID, schedulesRetrieved[0].UUID)
+	})
+
 	s.T().Run("should return empty array if nothing is found", func(t *testing.T) {
-		schedules, err := s.agents.Schedule().FindAll(ctx, []string{"randomID"}, s.username)
+		schedules, err := s.agents.Schedule().Search(ctx, &entities.ScheduleFilters{}, []string{"randomID"}, s.username)
 		assert.NoError(t, err)
 		assert.Empty(t, schedules)
 	})
@@ -144,8 +170,8 @@ func (s *scheduleTestSuite) TestPGSchedule_ConnectionErr() {
 		assert.True(t, errors.IsInternalError(err))
 	})
 
-	s.T().Run("should return PostgresConnectionError if FindAll fails", func(t *testing.T) {
-		_, err := s.agents.Schedule().FindAll(ctx, s.allowedTenants, s.username)
+	s.T().Run("should return PostgresConnectionError if Search fails", func(t *testing.T) {
+		_, err := s.agents.Schedule().Search(ctx, &entities.ScheduleFilters{}, s.allowedTenants, s.username)
 		assert.True(t, errors.IsInternalError(err))
 	})
 
@@ -0,0 +1,118 @@
+package dataagents
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	pg "github.com/consensys/orchestrate/pkg/toolkit/database/postgres"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/store"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	"github.com/gofrs/uuid"
+)
+
+const subscriptionDAComponent = "data-agents.subscription"
+
+// PGSubscription is a Subscription data agent for PostgreSQL
+type PGSubscription struct {
+	db     pg.DB
+	logger *log.Logger
+}
+
+// NewPGSubscription creates a new PGSubscription
+func NewPGSubscription(db pg.DB) store.SubscriptionAgent {
+	return &PGSubscription{db: db, logger: log.NewLogger().SetComponent(subscriptionDAComponent)}
+}
+
+// Insert Inserts a new subscription in DB
+func (agent *PGSubscription) Insert(ctx context.Context, subscription *models.Subscription) error {
+	if subscription.UUID == "" {
+		subscription.UUID = uuid.Must(uuid.NewV4()).String()
+	}
+
+	err := pg.Insert(ctx, agent.db, subscription)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to insert subscription")
+		return errors.FromError(err).ExtendComponent(subscriptionDAComponent)
+	}
+
+	return nil
+}
+
+// FindOneByUUID Finds a subscription in DB by UUID
+func (agent *PGSubscription) FindOneByUUID(ctx context.Context, subscriptionUUID string, tenants []string, ownerID string) (*models.Subscription, error) {
+	subscription := &models.Subscription{}
+
+	query := agent.db.ModelContext(ctx, subscription).Where("uuid = ?", subscriptionUUID)
+	query = pg.WhereAllowedTenants(query, "tenant_id", tenants)
+	query = pg.WhereAllowedOwner(query, "owner_id", ownerID)
+
+	err := pg.SelectOne(ctx, query)
+	if err != nil {
+		if !errors.IsNotFoundError(err) {
+			agent.logger.WithContext(ctx).WithError(err).Error("failed to select subscription by uuid")
+		}
+		return nil, errors.FromError(err).ExtendComponent(subscriptionDAComponent)
+	}
+
+	return subscription, nil
+}
+
+func (agent *PGSubscription) Search(ctx context.Context, filters *entities.SubscriptionFilters, tenants []string, ownerID string) ([]*models.Subscription, error) {
+	var subscriptions []*models.Subscription
+
+	query := agent.db.ModelContext(ctx, &subscriptions)
+	if filters.ChainUUID != "" {
+		query = query.Where("chain_uuid = ?", filters.ChainUUID)
+	}
+	if filters.ContractAddress != "" {
+		query = query.Where("lower(contract_address) = lower(?)", filters.ContractAddress)
+	}
+	if filters.TenantID != "" {
+		query = query.Where("tenant_id = ?", filters.TenantID)
+	}
+
+	query = pg.WhereAllowedTenants(query, "tenant_id", tenants).Order("created_at ASC")
+	query = pg.WhereAllowedOwner(query, "owner_id", ownerID)
+
+	err := pg.Select(ctx, query)
+	if err != nil {
+		if !errors.IsNotFoundError(err) {
+			agent.logger.WithContext(ctx).WithError(err).Error("failed to search subscriptions")
+		}
+		return nil, errors.FromError(err).ExtendComponent(subscriptionDAComponent)
+	}
+
+	return subscriptions, nil
+}
+
+func (agent *PGSubscription) Update(ctx context.Context, subscription *models.Subscription, tenants []string, ownerID string) error {
+	subscription.UpdatedAt = time.Now().UTC()
+	query := agent.db.ModelContext(ctx, subscription).Where("uuid = ?", subscription.UUID)
+	query = pg.WhereAllowedTenantsDefault(query, tenants)
+	query = pg.WhereAllowedOwner(query, "owner_id", ownerID)
+
+	err := pg.Update(ctx, query)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to update subscription")
+		return errors.FromError(err).ExtendComponent(subscriptionDAComponent)
+	}
+
+	return nil
+}
+
+func (agent *PGSubscription) Delete(ctx context.Context, subscription *models.Subscription, tenants []string, ownerID string) error {
+	query := agent.db.ModelContext(ctx, subscription).Where("uuid = ?", subscription.UUID)
+	query = pg.WhereAllowedTenantsDefault(query, tenants)
+	query = pg.WhereAllowedOwner(query, "owner_id", ownerID)
+
+	err := pg.Delete(ctx, query)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to delete subscription")
+		return errors.FromError(err).ExtendComponent(subscriptionDAComponent)
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+// +build !unit
+// +build !race
+// +build !integration
+
+package dataagents
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	"github.com/consensys/orchestrate/services/api/store/models/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pgTestUtils "github.com/consensys/orchestrate/pkg/toolkit/database/postgres/testutils"
+	"github.com/consensys/orchestrate/services/api/store/postgres/migrations"
+	"github.com/stretchr/testify/suite"
+)
+
+type subscriptionTestSuite struct {
+	suite.Suite
+	agents         *PGAgents
+	pg             *pgTestUtils.PGTestHelper
+	tenantID       string
+	allowedTenants []string
+	chain          *models.Chain
+}
+
+func TestPGSubscription(t *testing.T) {
+	s := new(subscriptionTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *subscriptionTestSuite) SetupSuite() {
+	s.pg, _ = pgTestUtils.NewPGTestHelper(nil, migrations.Collection)
+	s.tenantID = tenantID
+	s.allowedTenants = []string{s.tenantID, "_"}
+	s.pg.InitTestDB(s.T())
+}
+
+func (s *subscriptionTestSuite) SetupTest() {
+	s.pg.UpgradeTestDB(s.T())
+	s.agents = New(s.pg.DB)
+
+	s.chain = testutils.FakeChainModel()
+	s.chain.TenantID = s.tenantID
+	err := s.agents.Chain().Insert(context.Background(), s.chain)
+	require.NoError(s.T(), err)
+}
+
+func (s *subscriptionTestSuite) TearDownTest() {
+	s.pg.DowngradeTestDB(s.T())
+}
+
+func (s *subscriptionTestSuite) TearDownSuite() {
+	s.pg.DropTestDB(s.T())
+}
+
+func (s *subscriptionTestSuite) TestPGSubscription_Insert() {
+	ctx := context.Background()
+
+	s.T().Run("should insert model successfully", func(t *testing.T) {
+		subscription := testutils.FakeSubscriptionModel(s.chain.UUID)
+		subscription.TenantID = s.tenantID
+		err := s.agents.Subscription().Insert(ctx, subscription)
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, subscription.UUID)
+	})
+
+	s.T().Run("should insert model without UUID successfully", func(t *testing.T) {
+		subscription := testutils.FakeSubscriptionModel(s.chain.UUID)
+		subscription.TenantID = s.tenantID
+		subscription.UUID = ""
+		err := s.agents.Subscription().Insert(ctx, subscription)
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, subscription.UUID)
+	})
+
+	s.T().Run("should fail to insert model if chain does not exist", func(t *testing.T) {
+		subscription := testutils.FakeSubscriptionModel("b6fe7a2a-1a4d-49ca-99d8-8a34aa495ef0")
+		subscription.TenantID = s.tenantID
+		err := s.agents.Subscription().Insert(ctx, subscription)
+
+		assert.Error(t, err)
+	})
+}
+
+func (s *subscriptionTestSuite) TestPGSubscription_FindOneByUUID() {
+	ctx := context.Background()
+	subscription := testutils.FakeSubscriptionModel(s.chain.UUID)
+	subscription.TenantID = s.tenantID
+	err := s.agents.Subscription().Insert(ctx, subscription)
+	require.NoError(s.T(), err)
+
+	s.T().Run("should get model successfully", func(t *testing.T) {
+		subscriptionRetrieved, err := s.agents.Subscription().FindOneByUUID(ctx, subscription.UUID, s.allowedTenants, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, subscription.UUID, subscriptionRetrieved.UUID)
+		assert.Equal(t, subscription.TopicFilters, subscriptionRetrieved.TopicFilters)
+		assert.Equal(t, subscription.Target, subscriptionRetrieved.Target)
+	})
+
+	s.T().Run("should return NotFoundError if tenant is not allowed", func(t *testing.T) {
+		_, err := s.agents.Subscription().FindOneByUUID(ctx, subscription.UUID, []string{"notAllowedTenant"}, "")
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	s.T().Run("should return NotFoundError if select fails", func(t *testing.T) {
+		_, err := s.agents.Subscription().FindOneByUUID(ctx, "b6fe7a2a-1a4d-49ca-99d8-8a34aa495ef0", s.allowedTenants, "")
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
+
+func (s *subscriptionTestSuite) TestPGSubscription_Search() {
+	ctx := context.Background()
+
+	subscription0 := testutils.FakeSubscriptionModel(s.chain.UUID)
+	subscription0.TenantID = s.tenantID
+	err := s.agents.Subscription().Insert(ctx, subscription0)
+	require.NoError(s.T(), err)
+
+	subscription1 := testutils.FakeSubscriptionModel(s.chain.UUID)
+	subscription1.TenantID = s.tenantID
+	subscription1.ContractAddress = "0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"
+	err = s.agents.Subscription().Insert(ctx, subscription1)
+	require.NoError(s.T(), err)
+
+	s.T().Run("should find models successfully with filters", func(t *testing.T) {
+		filters := &entities.SubscriptionFilters{
+			ChainUUID:       s.chain.UUID,
+			ContractAddress: "0x905b88eff8bda1543d4d6f4aa05afef143d27e18",
+		}
+
+		retrievedSubscriptions, err := s.agents.Subscription().Search(ctx, filters, s.allowedTenants, "")
+
+		assert.NoError(t, err)
+		assert.Len(t, retrievedSubscriptions, 1)
+		assert.Equal(t, subscription1.UUID, retrievedSubscriptions[0].UUID)
+	})
+
+	s.T().Run("should not find any model by chain", func(t *testing.T) {
+		filters := &entities.SubscriptionFilters{ChainUUID: "b6fe7a2a-1a4d-49ca-99d8-8a34aa495ef0"}
+
+		retrievedSubscriptions, err := s.agents.Subscription().Search(ctx, filters, s.allowedTenants, "")
+
+		assert.NoError(t, err)
+		assert.Empty(t, retrievedSubscriptions)
+	})
+
+	s.T().Run("should find every inserted model successfully", func(t *testing.T) {
+		retrievedSubscriptions, err := s.agents.Subscription().Search(ctx, &entities.SubscriptionFilters{}, s.allowedTenants, "")
+
+		assert.NoError(t, err)
+		assert.Len(t, retrievedSubscriptions, 2)
+	})
+}
+
+func (s *subscriptionTestSuite) TestPGSubscription_Update() {
+	ctx := context.Background()
+	subscription := testutils.FakeSubscriptionModel(s.chain.UUID)
+	subscription.TenantID = s.tenantID
+	err := s.agents.Subscription().Insert(ctx, subscription)
+	require.NoError(s.T(), err)
+
+	s.T().Run("should update model successfully", func(t *testing.T) {
+		newSubscription := &models.Subscription{
+			UUID: subscription.UUID,
+			Target: &entities.SubscriptionTarget{
+				Type:  entities.KafkaSubscriptionTargetType,
+				Topic: "topic-events",
+			},
+		}
+
+		err = s.agents.Subscription().Update(ctx, newSubscription, s.allowedTenants, "")
+		assert.NoError(t, err)
+
+		subscriptionRetrieved, _ := s.agents.Subscription().FindOneByUUID(ctx, subscription.UUID, s.allowedTenants, "")
+		assert.Equal(t, newSubscription.Target, subscriptionRetrieved.Target)
+		assert.Equal(t, subscription.EventSignature, subscriptionRetrieved.EventSignature)
+	})
+}
+
+func (s *subscriptionTestSuite) TestPGSubscription_Delete() {
+	ctx := context.Background()
+	subscription := testutils.FakeSubscriptionModel(s.chain.UUID)
+	subscription.TenantID = s.tenantID
+	err := s.agents.Subscription().Insert(ctx, subscription)
+	require.NoError(s.T(), err)
+
+	s.T().Run("should delete model successfully", func(t *testing.T) {
+		err = s.agents.Subscription().Delete(ctx, subscription, s.allowedTenants, "")
+		assert.NoError(t, err)
+
+		_, err = s.agents.Subscription().FindOneByUUID(ctx, subscription.UUID, s.allowedTenants, "")
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
@@ -2,7 +2,7 @@ package dataagents
 
 import (
 	"context"
-	"strings"
+	"time"
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 
@@ -27,7 +27,7 @@ func NewPGTag(db pg.DB) store.TagAgent {
 
 func (agent *PGTag) Insert(ctx context.Context, tag *models.TagModel) error {
 	query := agent.db.ModelContext(ctx, tag).
-		OnConflict("ON CONSTRAINT tags_name_repository_id_key DO UPDATE").
+		OnConflict("(repository_id, (lower(name))) WHERE deleted_at IS NULL DO UPDATE").
 		Set("artifact_id = ?artifact_id")
 
 	err := pg.InsertQuery(ctx, query)
@@ -38,12 +38,12 @@ func (agent *PGTag) Insert(ctx context.Context, tag *models.TagModel) error {
 
 	return nil
 }
-func (agent *PGTag) FindAllByName(ctx context.Context, name string) ([]string, error) {
+func (agent *PGTag) FindAllByName(ctx context.Context, name string, tenants []string) ([]string, error) {
 	var tags []string
 	query := agent.db.ModelContext(ctx, (*models.TagModel)(nil)).
 		Column("tag_model.name").
-		Join("JOIN repositories AS repo ON repo.id = tag_model.repository_id").
-		Where("lower(repo.name) = ?", strings.ToLower(name)).
+		Where("tag_model.repository_id = (?)", repositoryIDQuery(agent.db, name, tenants)).
+		Where("tag_model.deleted_at IS NULL").
 		OrderExpr("lower(tag_model.name)")
 
 	err := pg.SelectColumn(ctx, query, &tags)
@@ -56,3 +56,50 @@ func (agent *PGTag) FindAllByName(ctx context.Context, name string) ([]string, e
 
 	return tags, nil
 }
+
+func (agent *PGTag) FindOneByName(ctx context.Context, repositoryID int, name string) (*models.TagModel, error) {
+	tag := &models.TagModel{}
+	query := agent.db.ModelContext(ctx, tag).
+		Where("repository_id = ?", repositoryID).
+		Where("lower(name) = lower(?)", name).
+		Where("deleted_at IS NULL")
+
+	err := pg.SelectOne(ctx, query)
+	if err != nil {
+		if !errors.IsNotFoundError(err) {
+			agent.logger.WithContext(ctx).WithError(err).Error("failed to find tag")
+		}
+		return nil, errors.FromError(err).ExtendComponent(tagDAComponent)
+	}
+
+	return tag, nil
+}
+
+func (agent *PGTag) Delete(ctx context.Context, tag *models.TagModel) error {
+	now := time.Now().UTC()
+	tag.DeletedAt = &now
+
+	query := agent.db.ModelContext(ctx, tag).Column("deleted_at").WherePK()
+	err := pg.Update(ctx, query)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to delete tag")
+		return errors.FromError(err).ExtendComponent(tagDAComponent)
+	}
+
+	return nil
+}
+
+func (agent *PGTag) DeleteAllByRepositoryID(ctx context.Context, repositoryID int) error {
+	query := agent.db.ModelContext(ctx, (*models.TagModel)(nil)).
+		Set("deleted_at = ?", time.Now().UTC()).
+		Where("repository_id = ?", repositoryID).
+		Where("deleted_at IS NULL")
+
+	err := pg.Update(ctx, query)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to delete tags")
+		return errors.FromError(err).ExtendComponent(tagDAComponent)
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/toolkit/database/postgres"
 	pgTestUtils "github.com/consensys/orchestrate/pkg/toolkit/database/postgres/testutils"
 	"github.com/consensys/orchestrate/services/api/store/models"
@@ -89,7 +90,7 @@ func (s *tagTestSuite) TestPGTag_FindAllByName() {
 	contractName := "myContract"
 
 	s.T().Run("should return NotFoundError if none is found", func(t *testing.T) {
-		result, err := s.agents.Tag().FindAllByName(ctx, contractName)
+		result, err := s.agents.Tag().FindAllByName(ctx, contractName, []string{multitenancy.DefaultTenant})
 		assert.NoError(t, err)
 		assert.Empty(t, result)
 	})
@@ -97,7 +98,7 @@ func (s *tagTestSuite) TestPGTag_FindAllByName() {
 	s.T().Run("should find all successfully", func(t *testing.T) {
 		_, _ = s.insertTag(ctx, contractName, "tag")
 
-		tags, err := s.agents.Tag().FindAllByName(ctx, contractName)
+		tags, err := s.agents.Tag().FindAllByName(ctx, contractName, []string{multitenancy.DefaultTenant})
 
 		assert.Equal(t, 1, len(tags))
 		assert.Equal(t, "tag", tags[0])
@@ -107,7 +108,7 @@ func (s *tagTestSuite) TestPGTag_FindAllByName() {
 	s.T().Run("should return PostgresConnectionError if select fails", func(t *testing.T) {
 		// We drop the DB to make the test fail
 		s.pg.DropTestDB(t)
-		_, err := s.agents.Tag().FindAllByName(ctx, contractName)
+		_, err := s.agents.Tag().FindAllByName(ctx, contractName, []string{multitenancy.DefaultTenant})
 
 		assert.True(t, errors.IsInternalError(err))
 
@@ -115,6 +116,25 @@ func (s *tagTestSuite) TestPGTag_FindAllByName() {
 	})
 }
 
+func (s *tagTestSuite) TestPGTag_Delete() {
+	ctx := context.Background()
+	contractName := "myContract"
+
+	s.T().Run("should delete tag successfully", func(t *testing.T) {
+		tag, _ := s.insertTag(ctx, contractName, "tag")
+
+		err := s.agents.Tag().Delete(ctx, tag)
+		assert.NoError(t, err)
+
+		tags, err := s.agents.Tag().FindAllByName(ctx, contractName, []string{multitenancy.DefaultTenant})
+		assert.NoError(t, err)
+		assert.Empty(t, tags)
+
+		_, err = s.agents.Tag().FindOneByName(ctx, tag.RepositoryID, "tag")
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
+
 func (s *tagTestSuite) insertTag(ctx context.Context, contractName, tagName string) (*models.TagModel, error) {
 	repo := &models.RepositoryModel{
 		Name: contractName,
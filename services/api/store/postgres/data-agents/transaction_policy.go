@@ -0,0 +1,118 @@
+package dataagents
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	pg "github.com/consensys/orchestrate/pkg/toolkit/database/postgres"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/store"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	"github.com/gofrs/uuid"
+)
+
+const txPolicyDAComponent = "data-agents.transaction-policy"
+
+// PGTransactionPolicy is a TransactionPolicy data agent for PostgreSQL
+type PGTransactionPolicy struct {
+	db     pg.DB
+	logger *log.Logger
+}
+
+// NewPGTransactionPolicy creates a new PGTransactionPolicy
+func NewPGTransactionPolicy(db pg.DB) store.TransactionPolicyAgent {
+	return &PGTransactionPolicy{db: db, logger: log.NewLogger().SetComponent(txPolicyDAComponent)}
+}
+
+// Insert Inserts a new transaction policy in DB
+func (agent *PGTransactionPolicy) Insert(ctx context.Context, policy *models.TransactionPolicy) error {
+	if policy.UUID == "" {
+		policy.UUID = uuid.Must(uuid.NewV4()).String()
+	}
+
+	err := pg.Insert(ctx, agent.db, policy)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to insert transaction policy")
+		return errors.FromError(err).ExtendComponent(txPolicyDAComponent)
+	}
+
+	return nil
+}
+
+// FindOneByUUID Finds a transaction policy in DB by UUID
+func (agent *PGTransactionPolicy) FindOneByUUID(ctx context.Context, policyUUID string, tenants []string, ownerID string) (*models.TransactionPolicy, error) {
+	policy := &models.TransactionPolicy{}
+
+	query := agent.db.ModelContext(ctx, policy).Where("uuid = ?", policyUUID)
+	query = pg.WhereAllowedTenants(query, "tenant_id", tenants)
+	query = pg.WhereAllowedOwner(query, "owner_id", ownerID)
+
+	err := pg.SelectOne(ctx, query)
+	if err != nil {
+		if !errors.IsNotFoundError(err) {
+			agent.logger.WithContext(ctx).WithError(err).Error("failed to select transaction policy by uuid")
+		}
+		return nil, errors.FromError(err).ExtendComponent(txPolicyDAComponent)
+	}
+
+	return policy, nil
+}
+
+func (agent *PGTransactionPolicy) Search(ctx context.Context, filters *entities.TransactionPolicyFilters, tenants []string, ownerID string) ([]*models.TransactionPolicy, error) {
+	var policies []*models.TransactionPolicy
+
+	query := agent.db.ModelContext(ctx, &policies)
+	if filters.Type != "" {
+		query = query.Where("type = ?", filters.Type)
+	}
+	if filters.ChainUUID != "" {
+		query = query.Where("chain_uuid = ?", filters.ChainUUID)
+	}
+	if filters.TenantID != "" {
+		query = query.Where("tenant_id = ?", filters.TenantID)
+	}
+
+	query = pg.WhereAllowedTenants(query, "tenant_id", tenants).Order("created_at ASC")
+	query = pg.WhereAllowedOwner(query, "owner_id", ownerID)
+
+	err := pg.Select(ctx, query)
+	if err != nil {
+		if !errors.IsNotFoundError(err) {
+			agent.logger.WithContext(ctx).WithError(err).Error("failed to search transaction policies")
+		}
+		return nil, errors.FromError(err).ExtendComponent(txPolicyDAComponent)
+	}
+
+	return policies, nil
+}
+
+func (agent *PGTransactionPolicy) Delete(ctx context.Context, policy *models.TransactionPolicy, tenants []string, ownerID string) error {
+	query := agent.db.ModelContext(ctx, policy).Where("uuid = ?", policy.UUID)
+	query = pg.WhereAllowedTenantsDefault(query, tenants)
+	query = pg.WhereAllowedOwner(query, "owner_id", ownerID)
+
+	err := pg.Delete(ctx, query)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to delete transaction policy")
+		return errors.FromError(err).ExtendComponent(txPolicyDAComponent)
+	}
+
+	return nil
+}
+
+// AddSpending adds an amount to the spendings of a policy for a day and sets the amount of the spending to the total of
+// the day. The row is locked until the end of the DB transaction so that concurrent jobs cannot exceed the cap
+func (agent *PGTransactionPolicy) AddSpending(ctx context.Context, spending *models.TransactionPolicySpending) error {
+	_, err := agent.db.ModelContext(ctx, spending).
+		OnConflict("(policy_uuid, day) DO UPDATE").
+		Set("amount = ?TableAlias.amount + EXCLUDED.amount").
+		Returning("amount").
+		Insert()
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to add transaction policy spending")
+		return errors.FromError(pg.ParsePGError(err)).ExtendComponent(txPolicyDAComponent)
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+// +build !unit
+// +build !race
+// +build !integration
+
+package dataagents
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/services/api/store/models"
+	"github.com/consensys/orchestrate/services/api/store/models/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pgTestUtils "github.com/consensys/orchestrate/pkg/toolkit/database/postgres/testutils"
+	"github.com/consensys/orchestrate/services/api/store/postgres/migrations"
+	"github.com/stretchr/testify/suite"
+)
+
+type txPolicyTestSuite struct {
+	suite.Suite
+	agents         *PGAgents
+	pg             *pgTestUtils.PGTestHelper
+	tenantID       string
+	allowedTenants []string
+	chain          *models.Chain
+}
+
+func TestPGTransactionPolicy(t *testing.T) {
+	s := new(txPolicyTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *txPolicyTestSuite) SetupSuite() {
+	s.pg, _ = pgTestUtils.NewPGTestHelper(nil, migrations.Collection)
+	s.tenantID = tenantID
+	s.allowedTenants = []string{s.tenantID, "_"}
+	s.pg.InitTestDB(s.T())
+}
+
+func (s *txPolicyTestSuite) SetupTest() {
+	s.pg.UpgradeTestDB(s.T())
+	s.agents = New(s.pg.DB)
+
+	s.chain = testutils.FakeChainModel()
+	s.chain.TenantID = s.tenantID
+	err := s.agents.Chain().Insert(context.Background(), s.chain)
+	require.NoError(s.T(), err)
+}
+
+func (s *txPolicyTestSuite) TearDownTest() {
+	s.pg.DowngradeTestDB(s.T())
+}
+
+func (s *txPolicyTestSuite) TearDownSuite() {
+	s.pg.DropTestDB(s.T())
+}
+
+func (s *txPolicyTestSuite) TestPGTransactionPolicy_Insert() {
+	ctx := context.Background()
+
+	s.T().Run("should insert model successfully", func(t *testing.T) {
+		policy := testutils.FakeTransactionPolicyModel()
+		policy.TenantID = s.tenantID
+		err := s.agents.TransactionPolicy().Insert(ctx, policy)
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, policy.UUID)
+	})
+
+	s.T().Run("should insert model without UUID successfully", func(t *testing.T) {
+		policy := testutils.FakeTransactionPolicyModel()
+		policy.TenantID = s.tenantID
+		policy.UUID = ""
+		err := s.agents.TransactionPolicy().Insert(ctx, policy)
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, policy.UUID)
+	})
+
+	s.T().Run("should fail to insert model if chain does not exist", func(t *testing.T) {
+		policy := testutils.FakeTransactionPolicyModel()
+		policy.TenantID = s.tenantID
+		policy.ChainUUID = "b6fe7a2a-1a4d-49ca-99d8-8a34aa495ef0"
+		err := s.agents.TransactionPolicy().Insert(ctx, policy)
+
+		assert.Error(t, err)
+	})
+}
+
+func (s *txPolicyTestSuite) TestPGTransactionPolicy_FindOneByUUID() {
+	ctx := context.Background()
+	policy := testutils.FakeTransactionPolicyModel()
+	policy.TenantID = s.tenantID
+	policy.Type = "DeniedRecipients"
+	policy.Addresses = []string{"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"}
+	err := s.agents.TransactionPolicy().Insert(ctx, policy)
+	require.NoError(s.T(), err)
+
+	s.T().Run("should get model successfully", func(t *testing.T) {
+		policyRetrieved, err := s.agents.TransactionPolicy().FindOneByUUID(ctx, policy.UUID, s.allowedTenants, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, policy.UUID, policyRetrieved.UUID)
+		assert.Equal(t, policy.Type, policyRetrieved.Type)
+		assert.Equal(t, policy.MaxDailyValue, policyRetrieved.MaxDailyValue)
+		assert.Equal(t, policy.Addresses, policyRetrieved.Addresses)
+	})
+
+	s.T().Run("should return NotFoundError if tenant is not allowed", func(t *testing.T) {
+		_, err := s.agents.TransactionPolicy().FindOneByUUID(ctx, policy.UUID, []string{"notAllowedTenant"}, "")
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	s.T().Run("should return NotFoundError if select fails", func(t *testing.T) {
+		_, err := s.agents.TransactionPolicy().FindOneByUUID(ctx, "b6fe7a2a-1a4d-49ca-99d8-8a34aa495ef0", s.allowedTenants, "")
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
+
+func (s *txPolicyTestSuite) TestPGTransactionPolicy_Search() {
+	ctx := context.Background()
+
+	policy0 := testutils.FakeTransactionPolicyModel()
+	policy0.TenantID = s.tenantID
+	err := s.agents.TransactionPolicy().Insert(ctx, policy0)
+	require.NoError(s.T(), err)
+
+	policy1 := testutils.FakeTransactionPolicyModel()
+	policy1.TenantID = s.tenantID
+	policy1.ChainUUID = s.chain.UUID
+	err = s.agents.TransactionPolicy().Insert(ctx, policy1)
+	require.NoError(s.T(), err)
+
+	s.T().Run("should find models successfully with filters", func(t *testing.T) {
+		filters := &entities.TransactionPolicyFilters{ChainUUID: s.chain.UUID}
+
+		retrievedPolicies, err := s.agents.TransactionPolicy().Search(ctx, filters, s.allowedTenants, "")
+
+		assert.NoError(t, err)
+		assert.Len(t, retrievedPolicies, 1)
+		assert.Equal(t, policy1.UUID, retrievedPolicies[0].UUID)
+	})
+
+	s.T().Run("should find every inserted model successfully", func(t *testing.T) {
+		retrievedPolicies, err := s.agents.TransactionPolicy().Search(ctx, &entities.TransactionPolicyFilters{}, s.allowedTenants, "")
+
+		assert.NoError(t, err)
+		assert.Len(t, retrievedPolicies, 2)
+	})
+
+	s.T().Run("should find models successfully by type", func(t *testing.T) {
+		filters := &entities.TransactionPolicyFilters{Type: entities.DeniedRecipientsPolicyType}
+
+		retrievedPolicies, err := s.agents.TransactionPolicy().Search(ctx, filters, s.allowedTenants, "")
+
+		assert.NoError(t, err)
+		assert.Empty(t, retrievedPolicies)
+	})
+
+	s.T().Run("should not find any model of another tenant", func(t *testing.T) {
+		retrievedPolicies, err := s.agents.TransactionPolicy().Search(ctx, &entities.TransactionPolicyFilters{}, []string{"notAllowedTenant"}, "")
+
+		assert.NoError(t, err)
+		assert.Empty(t, retrievedPolicies)
+	})
+}
+
+func (s *txPolicyTestSuite) TestPGTransactionPolicy_Delete() {
+	ctx := context.Background()
+	policy := testutils.FakeTransactionPolicyModel()
+	policy.TenantID = s.tenantID
+	err := s.agents.TransactionPolicy().Insert(ctx, policy)
+	require.NoError(s.T(), err)
+
+	s.T().Run("should delete model successfully", func(t *testing.T) {
+		err = s.agents.TransactionPolicy().Delete(ctx, policy, s.allowedTenants, "")
+		assert.NoError(t, err)
+
+		_, err = s.agents.TransactionPolicy().FindOneByUUID(ctx, policy.UUID, s.allowedTenants, "")
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
+
+func (s *txPolicyTestSuite) TestPGTransactionPolicy_AddSpending() {
+	ctx := context.Background()
+	policy := testutils.FakeTransactionPolicyModel()
+	policy.TenantID = s.tenantID
+	err := s.agents.TransactionPolicy().Insert(ctx, policy)
+	require.NoError(s.T(), err)
+
+	s.T().Run("should add spendings of the day successfully", func(t *testing.T) {
+		spending := &models.TransactionPolicySpending{PolicyUUID: policy.UUID, Day: "2021-06-01", Amount: "600"}
+		err = s.agents.TransactionPolicy().AddSpending(ctx, spending)
+		require.NoError(t, err)
+		assert.Equal(t, "600", spending.Amount)
+
+		spending = &models.TransactionPolicySpending{PolicyUUID: policy.UUID, Day: "2021-06-01", Amount: "500"}
+		err = s.agents.TransactionPolicy().AddSpending(ctx, spending)
+		require.NoError(t, err)
+		assert.Equal(t, "1100", spending.Amount)
+	})
+
+	s.T().Run("should not add spendings of another day", func(t *testing.T) {
+		spending := &models.TransactionPolicySpending{PolicyUUID: policy.UUID, Day: "2021-06-02", Amount: "100"}
+		err = s.agents.TransactionPolicy().AddSpending(ctx, spending)
+		require.NoError(t, err)
+		assert.Equal(t, "100", spending.Amount)
+	})
+
+	s.T().Run("should fail to add spendings if policy does not exist", func(t *testing.T) {
+		spending := &models.TransactionPolicySpending{PolicyUUID: "b6fe7a2a-1a4d-49ca-99d8-8a34aa495ef0", Day: "2021-06-01", Amount: "100"}
+		err = s.agents.TransactionPolicy().AddSpending(ctx, spending)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,38 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func upgradeAddChainGasOracle(db migrations.DB) error {
+	log.Debug("Applying adding chain gas oracle...")
+	_, err := db.Exec(`
+ALTER TABLE chains
+	ADD COLUMN gas_oracle JSONB;
+`)
+	if err != nil {
+		return err
+	}
+	log.Info("Applied adding chain gas oracle")
+
+	return nil
+}
+
+func downgradeAddChainGasOracle(db migrations.DB) error {
+	log.Debug("Downgrading adding chain gas oracle...")
+	_, err := db.Exec(`
+ALTER TABLE chains
+	DROP COLUMN gas_oracle;
+`)
+	if err != nil {
+		return err
+	}
+	log.Info("Downgraded adding chain gas oracle")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(upgradeAddChainGasOracle, downgradeAddChainGasOracle)
+}
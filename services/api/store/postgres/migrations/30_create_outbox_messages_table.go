@@ -0,0 +1,45 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func createOutboxMessagesTable(db migrations.DB) error {
+	log.Debug("Creating outbox_messages table...")
+	_, err := db.Exec(`
+CREATE TABLE outbox_messages (
+	id BIGSERIAL PRIMARY KEY,
+	topic TEXT NOT NULL,
+	key BYTEA,
+	value BYTEA NOT NULL,
+	job_uuid UUID,
+	created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not create outbox_messages table")
+		return err
+	}
+	log.Info("Created outbox_messages table")
+
+	return nil
+}
+
+func dropOutboxMessagesTable(db migrations.DB) error {
+	log.Debug("Dropping outbox_messages table...")
+	_, err := db.Exec(`
+DROP TABLE outbox_messages;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not drop outbox_messages table")
+		return err
+	}
+	log.Info("Dropped outbox_messages table")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(createOutboxMessagesTable, dropOutboxMessagesTable)
+}
@@ -0,0 +1,51 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func createSubscriptionsTable(db migrations.DB) error {
+	log.Debug("Creating subscriptions table...")
+	_, err := db.Exec(`
+CREATE TABLE subscriptions (
+	uuid UUID PRIMARY KEY,
+	chain_uuid UUID NOT NULL REFERENCES chains(uuid) ON DELETE CASCADE,
+	contract_address CHAR(42) NOT NULL,
+	event_signature TEXT NOT NULL,
+	topic_filters JSONB,
+	target JSONB NOT NULL,
+	tenant_id TEXT NOT NULL,
+	owner_id TEXT,
+	created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+	updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+
+CREATE INDEX subscriptions_chain_uuid_idx ON subscriptions (chain_uuid);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not create subscriptions table")
+		return err
+	}
+	log.Info("Created subscriptions table")
+
+	return nil
+}
+
+func dropSubscriptionsTable(db migrations.DB) error {
+	log.Debug("Dropping subscriptions table...")
+	_, err := db.Exec(`
+DROP TABLE subscriptions;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not drop subscriptions table")
+		return err
+	}
+	log.Info("Dropped subscriptions table")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(createSubscriptionsTable, dropSubscriptionsTable)
+}
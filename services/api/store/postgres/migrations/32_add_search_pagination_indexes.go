@@ -0,0 +1,42 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func addSearchPaginationIndexes(db migrations.DB) error {
+	log.Debug("Adding search pagination indexes...")
+	_, err := db.Exec(`
+CREATE INDEX jobs_created_at_uuid_idx ON jobs (created_at, uuid);
+CREATE INDEX jobs_updated_at_uuid_idx ON jobs (updated_at, uuid);
+CREATE INDEX schedules_created_at_uuid_idx ON schedules (created_at, uuid);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add search pagination indexes")
+		return err
+	}
+	log.Info("Added search pagination indexes")
+
+	return nil
+}
+
+func dropSearchPaginationIndexes(db migrations.DB) error {
+	log.Debug("Dropping search pagination indexes...")
+	_, err := db.Exec(`
+DROP INDEX jobs_created_at_uuid_idx;
+DROP INDEX jobs_updated_at_uuid_idx;
+DROP INDEX schedules_created_at_uuid_idx;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not drop search pagination indexes")
+		return err
+	}
+	log.Info("Dropped search pagination indexes")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addSearchPaginationIndexes, dropSearchPaginationIndexes)
+}
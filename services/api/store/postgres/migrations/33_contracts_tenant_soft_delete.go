@@ -0,0 +1,62 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func upgradeContractsTenantSoftDelete(db migrations.DB) error {
+	log.Debug("Adding tenant and soft delete to contract tables...")
+	_, err := db.Exec(`
+ALTER TABLE repositories
+	ADD COLUMN tenant_id TEXT NOT NULL DEFAULT '_',
+	ADD COLUMN deleted_at TIMESTAMPTZ;
+DROP INDEX repositories_name_idx;
+CREATE UNIQUE INDEX repositories_tenant_name_idx ON repositories (tenant_id, (lower(name))) WHERE deleted_at IS NULL;
+
+ALTER TABLE tags
+	ADD COLUMN deleted_at TIMESTAMPTZ,
+	DROP CONSTRAINT tags_name_repository_id_key;
+DROP INDEX tags_repository_name_idx;
+CREATE UNIQUE INDEX tags_repository_name_idx ON tags (repository_id, (lower(name))) WHERE deleted_at IS NULL;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add tenant and soft delete to contract tables")
+		return err
+	}
+	log.Info("Added tenant and soft delete to contract tables")
+
+	return nil
+}
+
+func downgradeContractsTenantSoftDelete(db migrations.DB) error {
+	log.Debug("Removing tenant and soft delete from contract tables...")
+	_, err := db.Exec(`
+DELETE FROM tags WHERE deleted_at IS NOT NULL;
+DELETE FROM tags USING repositories r WHERE r.id = tags.repository_id AND r.deleted_at IS NOT NULL;
+DELETE FROM repositories WHERE deleted_at IS NOT NULL;
+
+DROP INDEX tags_repository_name_idx;
+CREATE UNIQUE INDEX tags_repository_name_idx ON tags (repository_id, (lower(name)));
+ALTER TABLE tags
+	DROP COLUMN deleted_at,
+	ADD CONSTRAINT tags_name_repository_id_key UNIQUE (name, repository_id);
+
+DROP INDEX repositories_tenant_name_idx;
+CREATE UNIQUE INDEX repositories_name_idx ON repositories ((lower(name)));
+ALTER TABLE repositories
+	DROP COLUMN tenant_id,
+	DROP COLUMN deleted_at;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not remove tenant and soft delete from contract tables")
+		return err
+	}
+	log.Info("Removed tenant and soft delete from contract tables")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(upgradeContractsTenantSoftDelete, downgradeContractsTenantSoftDelete)
+}
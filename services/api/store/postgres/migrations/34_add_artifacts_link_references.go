@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func addArtifactsLinkReferences(db migrations.DB) error {
+	log.Debug("Adding link references to artifacts...")
+	_, err := db.Exec(`
+ALTER TABLE artifacts
+	ADD COLUMN link_references JSONB;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add link references to artifacts")
+		return err
+	}
+	log.Info("Added link references to artifacts")
+
+	return nil
+}
+
+func removeArtifactsLinkReferences(db migrations.DB) error {
+	log.Debug("Removing link references from artifacts...")
+	_, err := db.Exec(`
+ALTER TABLE artifacts
+	DROP COLUMN link_references;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not remove link references from artifacts")
+		return err
+	}
+	log.Info("Removed link references from artifacts")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addArtifactsLinkReferences, removeArtifactsLinkReferences)
+}
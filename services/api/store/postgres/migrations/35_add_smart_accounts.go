@@ -0,0 +1,46 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func addSmartAccounts(db migrations.DB) error {
+	log.Debug("Adding smart accounts...")
+	_, err := db.Exec(`
+ALTER TABLE accounts
+	ADD COLUMN smart_account JSONB;
+
+ALTER TABLE chains
+	ADD COLUMN bundler_url TEXT;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add smart accounts")
+		return err
+	}
+	log.Info("Added smart accounts")
+
+	return nil
+}
+
+func removeSmartAccounts(db migrations.DB) error {
+	log.Debug("Removing smart accounts...")
+	_, err := db.Exec(`
+ALTER TABLE accounts
+	DROP COLUMN smart_account;
+
+ALTER TABLE chains
+	DROP COLUMN bundler_url;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not remove smart accounts")
+		return err
+	}
+	log.Info("Removed smart accounts")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addSmartAccounts, removeSmartAccounts)
+}
@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+// ALTER TYPE ... ADD VALUE cannot run inside a transaction block, hence the migration is not registered with MustRegisterTx
+func upgradeAddJobStatusAwaitingApproval(db migrations.DB) error {
+	log.Debug("Applying adding AWAITING_APPROVAL job status...")
+	_, err := db.Exec(`
+ALTER TYPE job_status ADD VALUE IF NOT EXISTS 'AWAITING_APPROVAL';
+`)
+	if err != nil {
+		return err
+	}
+	log.Info("Applied adding AWAITING_APPROVAL job status")
+
+	return nil
+}
+
+// Values cannot be removed from a Postgres enum type, downgrade is a no-op
+func downgradeAddJobStatusAwaitingApproval(_ migrations.DB) error {
+	log.Debug("Downgrading adding AWAITING_APPROVAL job status...")
+	log.Info("Downgraded adding AWAITING_APPROVAL job status")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegister(upgradeAddJobStatusAwaitingApproval, downgradeAddJobStatusAwaitingApproval)
+}
@@ -0,0 +1,53 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func createApprovalPoliciesTable(db migrations.DB) error {
+	log.Debug("Creating approval_policies table...")
+	_, err := db.Exec(`
+CREATE TABLE approval_policies (
+	uuid UUID PRIMARY KEY,
+	name TEXT NOT NULL,
+	chain_uuid UUID REFERENCES chains(uuid) ON DELETE CASCADE,
+	min_value TEXT,
+	recipient CHAR(42),
+	method_signature TEXT,
+	threshold INTEGER NOT NULL,
+	approvers TEXT[] NOT NULL,
+	tenant_id TEXT NOT NULL,
+	owner_id TEXT,
+	created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+	updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+
+CREATE INDEX approval_policies_tenant_id_idx ON approval_policies (tenant_id);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not create approval_policies table")
+		return err
+	}
+	log.Info("Created approval_policies table")
+
+	return nil
+}
+
+func dropApprovalPoliciesTable(db migrations.DB) error {
+	log.Debug("Dropping approval_policies table...")
+	_, err := db.Exec(`
+DROP TABLE approval_policies;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not drop approval_policies table")
+		return err
+	}
+	log.Info("Dropped approval_policies table")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(createApprovalPoliciesTable, dropApprovalPoliciesTable)
+}
@@ -0,0 +1,63 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func createTransactionPoliciesTables(db migrations.DB) error {
+	log.Debug("Creating transaction_policies and transaction_policy_spendings tables...")
+	_, err := db.Exec(`
+CREATE TABLE transaction_policies (
+	uuid UUID PRIMARY KEY,
+	name TEXT NOT NULL,
+	type TEXT NOT NULL,
+	chain_uuid UUID REFERENCES chains(uuid) ON DELETE CASCADE,
+	account CHAR(42),
+	max_daily_value TEXT,
+	addresses TEXT[],
+	contract CHAR(42),
+	method_signatures TEXT[],
+	max_gas_price TEXT,
+	tenant_id TEXT NOT NULL,
+	owner_id TEXT,
+	created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+	updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+
+CREATE INDEX transaction_policies_tenant_id_idx ON transaction_policies (tenant_id);
+
+CREATE TABLE transaction_policy_spendings (
+	policy_uuid UUID NOT NULL REFERENCES transaction_policies(uuid) ON DELETE CASCADE,
+	day DATE NOT NULL,
+	amount NUMERIC NOT NULL,
+	PRIMARY KEY (policy_uuid, day)
+);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not create transaction_policies and transaction_policy_spendings tables")
+		return err
+	}
+	log.Info("Created transaction_policies and transaction_policy_spendings tables")
+
+	return nil
+}
+
+func dropTransactionPoliciesTables(db migrations.DB) error {
+	log.Debug("Dropping transaction_policies and transaction_policy_spendings tables...")
+	_, err := db.Exec(`
+DROP TABLE transaction_policy_spendings;
+DROP TABLE transaction_policies;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not drop transaction_policies and transaction_policy_spendings tables")
+		return err
+	}
+	log.Info("Dropped transaction_policies and transaction_policy_spendings tables")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(createTransactionPoliciesTables, dropTransactionPoliciesTables)
+}
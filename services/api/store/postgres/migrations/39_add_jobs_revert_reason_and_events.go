@@ -0,0 +1,42 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func addJobsRevertReasonAndEvents(db migrations.DB) error {
+	log.Debug("Adding revert reason and events to jobs...")
+	_, err := db.Exec(`
+ALTER TABLE jobs
+	ADD COLUMN revert_reason TEXT,
+	ADD COLUMN events JSONB;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add revert reason and events to jobs")
+		return err
+	}
+	log.Info("Added revert reason and events to jobs")
+
+	return nil
+}
+
+func removeJobsRevertReasonAndEvents(db migrations.DB) error {
+	log.Debug("Removing revert reason and events from jobs...")
+	_, err := db.Exec(`
+ALTER TABLE jobs
+	DROP COLUMN revert_reason,
+	DROP COLUMN events;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not remove revert reason and events from jobs")
+		return err
+	}
+	log.Info("Removed revert reason and events from jobs")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addJobsRevertReasonAndEvents, removeJobsRevertReasonAndEvents)
+}
@@ -0,0 +1,48 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func createTxListenerLeasesTables(db migrations.DB) error {
+	log.Debug("Creating tx_listener_replicas and tx_listener_chain_leases tables...")
+	_, err := db.Exec(`
+CREATE TABLE tx_listener_replicas (
+	id TEXT PRIMARY KEY,
+	expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE tx_listener_chain_leases (
+	chain_uuid UUID PRIMARY KEY,
+	owner TEXT NOT NULL,
+	expires_at TIMESTAMPTZ NOT NULL
+);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not create tx_listener_replicas and tx_listener_chain_leases tables")
+		return err
+	}
+	log.Info("Created tx_listener_replicas and tx_listener_chain_leases tables")
+
+	return nil
+}
+
+func dropTxListenerLeasesTables(db migrations.DB) error {
+	log.Debug("Dropping tx_listener_replicas and tx_listener_chain_leases tables...")
+	_, err := db.Exec(`
+DROP TABLE tx_listener_chain_leases;
+DROP TABLE tx_listener_replicas;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not drop tx_listener_replicas and tx_listener_chain_leases tables")
+		return err
+	}
+	log.Info("Dropped tx_listener_replicas and tx_listener_chain_leases tables")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(createTxListenerLeasesTables, dropTxListenerLeasesTables)
+}
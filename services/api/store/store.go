@@ -31,6 +31,11 @@ type Agents interface {
 	Contract() ContractAgent
 	Chain() ChainAgent
 	PrivateTxManager() PrivateTxManagerAgent
+	Outbox() OutboxAgent
+	Subscription() SubscriptionAgent
+	ApprovalPolicy() ApprovalPolicyAgent
+	TransactionPolicy() TransactionPolicyAgent
+	Lock() LockAgent
 }
 
 type DB interface {
@@ -53,7 +58,7 @@ type TransactionRequestAgent interface {
 type ScheduleAgent interface {
 	Insert(ctx context.Context, schedule *models.Schedule) error
 	FindOneByUUID(ctx context.Context, uuid string, tenants []string, ownerID string) (*models.Schedule, error)
-	FindAll(ctx context.Context, tenants []string, ownerID string) ([]*models.Schedule, error)
+	Search(ctx context.Context, filters *entities.ScheduleFilters, tenants []string, ownerID string) ([]*models.Schedule, error)
 }
 
 type JobAgent interface {
@@ -68,6 +73,14 @@ type LogAgent interface {
 	Insert(ctx context.Context, log *models.Log) error
 }
 
+type OutboxAgent interface {
+	InsertMultiple(ctx context.Context, messages []*models.OutboxMessage) error
+	LockOldest(ctx context.Context, limit int) ([]*models.OutboxMessage, error)
+	FindOldest(ctx context.Context) (*models.OutboxMessage, error)
+	Count(ctx context.Context) (int, error)
+	Delete(ctx context.Context, ids []int64) error
+}
+
 type TransactionAgent interface {
 	Insert(ctx context.Context, tx *models.Transaction) error
 	Update(ctx context.Context, tx *models.Transaction) error
@@ -98,6 +111,29 @@ type ChainAgent interface {
 	Delete(ctx context.Context, chain *models.Chain, tenants []string) error
 }
 
+type SubscriptionAgent interface {
+	Insert(ctx context.Context, subscription *models.Subscription) error
+	Update(ctx context.Context, subscription *models.Subscription, tenants []string, ownerID string) error
+	Search(ctx context.Context, filters *entities.SubscriptionFilters, tenants []string, ownerID string) ([]*models.Subscription, error)
+	FindOneByUUID(ctx context.Context, uuid string, tenants []string, ownerID string) (*models.Subscription, error)
+	Delete(ctx context.Context, subscription *models.Subscription, tenants []string, ownerID string) error
+}
+
+type ApprovalPolicyAgent interface {
+	Insert(ctx context.Context, policy *models.ApprovalPolicy) error
+	Search(ctx context.Context, filters *entities.ApprovalPolicyFilters, tenants []string, ownerID string) ([]*models.ApprovalPolicy, error)
+	FindOneByUUID(ctx context.Context, uuid string, tenants []string, ownerID string) (*models.ApprovalPolicy, error)
+	Delete(ctx context.Context, policy *models.ApprovalPolicy, tenants []string, ownerID string) error
+}
+
+type TransactionPolicyAgent interface {
+	Insert(ctx context.Context, policy *models.TransactionPolicy) error
+	Search(ctx context.Context, filters *entities.TransactionPolicyFilters, tenants []string, ownerID string) ([]*models.TransactionPolicy, error)
+	FindOneByUUID(ctx context.Context, uuid string, tenants []string, ownerID string) (*models.TransactionPolicy, error)
+	Delete(ctx context.Context, policy *models.TransactionPolicy, tenants []string, ownerID string) error
+	AddSpending(ctx context.Context, spending *models.TransactionPolicySpending) error
+}
+
 type PrivateTxManagerAgent interface {
 	Insert(ctx context.Context, privateTxManager *models.PrivateTxManager) error
 	Update(ctx context.Context, privateTxManager *models.PrivateTxManager) error
@@ -109,12 +145,12 @@ type ArtifactAgent interface {
 	FindOneByABIAndCodeHash(ctx context.Context, abi, codeHash string) (*models.ArtifactModel, error)
 	SelectOrInsert(ctx context.Context, artifact *models.ArtifactModel) error
 	Insert(ctx context.Context, artifact *models.ArtifactModel) error
-	FindOneByNameAndTag(ctx context.Context, name, tag string) (*models.ArtifactModel, error)
+	FindOneByNameAndTag(ctx context.Context, name, tag string, tenants []string) (*models.ArtifactModel, error)
 }
 
 type ContractAgent interface {
-	FindOneByCodeHash(ctx context.Context, codeHash string) (*entities.Contract, error)
-	FindOneByAddress(ctx context.Context, address string) (*entities.Contract, error)
+	FindOneByCodeHash(ctx context.Context, codeHash string, tenants []string) (*entities.Contract, error)
+	FindOneByAddress(ctx context.Context, address string, tenants []string) (*entities.Contract, error)
 }
 
 type CodeHashAgent interface {
@@ -130,11 +166,21 @@ type EventAgent interface {
 type RepositoryAgent interface {
 	SelectOrInsert(ctx context.Context, repository *models.RepositoryModel) error
 	Insert(ctx context.Context, repository *models.RepositoryModel) error
-	FindOne(ctx context.Context, name string) (*models.RepositoryModel, error)
-	FindAll(ctx context.Context) ([]string, error)
+	FindOne(ctx context.Context, name string, tenants []string) (*models.RepositoryModel, error)
+	FindAll(ctx context.Context, tenants []string) ([]string, error)
+	Delete(ctx context.Context, repository *models.RepositoryModel) error
 }
 
 type TagAgent interface {
 	Insert(ctx context.Context, tag *models.TagModel) error
-	FindAllByName(ctx context.Context, name string) ([]string, error)
+	FindAllByName(ctx context.Context, name string, tenants []string) ([]string, error)
+	FindOneByName(ctx context.Context, repositoryID int, name string) (*models.TagModel, error)
+	Delete(ctx context.Context, tag *models.TagModel) error
+	DeleteAllByRepositoryID(ctx context.Context, repositoryID int) error
+}
+
+// LockAgent takes locks shared by all the replicas of the API, they must be taken inside a DB transaction
+type LockAgent interface {
+	// TryLock takes the lock identified by the key until the end of the DB transaction. Returns false if already held
+	TryLock(ctx context.Context, key string) (bool, error)
 }
@@ -0,0 +1,120 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/consensys/orchestrate/pkg/broker"
+	pkgsarama "github.com/consensys/orchestrate/pkg/broker/sarama"
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/services/api/business/use-cases"
+	"github.com/consensys/orchestrate/services/api/service/listeners"
+)
+
+const txRequestIngressComponent = "api.tx-request-ingress"
+
+// txRequestIngress consumes the transaction requests produced to the message broker and submits them to the transaction use cases
+type txRequestIngress struct {
+	group    broker.ConsumerGroup
+	topics   []string
+	listener broker.ConsumerGroupHandler
+	logger   *log.Logger
+}
+
+func TxRequestIngressOpt(
+	cfg *TxRequestIngressConfig,
+	ucs usecases.TransactionUseCases,
+	enqueueMsgUC usecases.EnqueueMessageUseCase,
+	multitenancyEnabled bool,
+	msgBroker broker.Broker,
+	topicCfg *pkgsarama.KafkaTopicConfig,
+) app.Option {
+	return func(ap *app.App) error {
+		if !cfg.Enabled {
+			return nil
+		}
+
+		tenantTopics, err := parseTenantEntries(cfg.TenantTopics, "topic")
+		if err != nil {
+			return err
+		}
+
+		signingKeys, err := parseTenantEntries(cfg.SigningKeys, "signing key")
+		if err != nil {
+			return err
+		}
+
+		group, err := msgBroker.NewConsumerGroup(cfg.GroupName)
+		if err != nil {
+			return err
+		}
+
+		topics := []string{topicCfg.Request}
+		for topic := range tenantTopics {
+			topics = append(topics, topic)
+		}
+
+		ap.RegisterDaemon(&txRequestIngress{
+			group:  group,
+			topics: topics,
+			listener: listeners.NewTxRequestListener(ucs, multitenancyEnabled, tenantTopics, signingKeys, enqueueMsgUC,
+				topicCfg.Recover),
+			logger: log.NewLogger().SetComponent(txRequestIngressComponent),
+		})
+		return nil
+	}
+}
+
+func (i *txRequestIngress) Run(ctx context.Context) error {
+	i.logger.WithField("topics", i.topics).Info("transaction request ingress started")
+
+	// We retry after consume exits to prevent entire stack to exit after a rebalance is triggered
+	err := backoff.RetryNotify(
+		func() error {
+			err := i.group.Consume(ctx, i.topics, i.listener)
+
+			// In this case, a rebalance was triggered and we want to retry
+			if err == nil && ctx.Err() == nil {
+				return fmt.Errorf("rebalance was triggered")
+			}
+
+			return backoff.Permanent(err)
+		},
+		backoff.NewConstantBackOff(time.Millisecond*500),
+		func(err error, duration time.Duration) {
+			i.logger.WithError(err).Warnf("consuming session exited, retrying in %s", duration.String())
+		},
+	)
+
+	i.logger.Info("transaction request ingress stopped")
+	return err
+}
+
+func (i *txRequestIngress) Close() error {
+	return i.group.Close()
+}
+
+// parseTenantEntries parses <topic>=<tenantID> and <tenantID>=<key> entries, none of them can grant the wildcard tenant
+func parseTenantEntries(entries []string, name string) (map[string]string, error) {
+	values := make(map[string]string)
+	for _, entry := range entries {
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, errors.InvalidParameterError("invalid tenant %s entry, expected <key>=<value>", name)
+		}
+
+		if parts[0] == multitenancy.WildcardTenant || parts[1] == multitenancy.WildcardTenant {
+			return nil, errors.InvalidParameterError("tenant %s entries cannot use the wildcard tenant", name)
+		}
+
+		values[parts[0]] = parts[1]
+	}
+
+	return values, nil
+}
@@ -1,9 +1,10 @@
 package txlistener
 
 import (
-	pkgsarama "github.com/consensys/orchestrate/pkg/broker/sarama"
+	"github.com/consensys/orchestrate/pkg/broker/multi"
 	orchestrateclient "github.com/consensys/orchestrate/pkg/sdk/client"
 	"github.com/consensys/orchestrate/pkg/toolkit/app"
+	dbredis "github.com/consensys/orchestrate/pkg/toolkit/database/redis"
 	listenermetrics "github.com/consensys/orchestrate/services/tx-listener/metrics"
 	provider "github.com/consensys/orchestrate/services/tx-listener/providers"
 	"github.com/consensys/orchestrate/services/tx-listener/session/ethereum"
@@ -20,6 +21,7 @@ func New(
 	offsets offset.Manager,
 	ec ethereum.EthClient,
 	client orchestrateclient.OrchestrateClient,
+	redisCli *dbredis.Client,
 ) (*app.App, error) {
 
 	var listenerMetrics listenermetrics.ListenerMetrics
@@ -30,8 +32,8 @@ func New(
 	}
 
 	listener = NewTxListener(prvdr, hk, offsets, ec, client, listenerMetrics)
-	sentry = txsentry.NewTxSentry(client, txsentry.NewConfig(viper.GetViper()))
-	appli, err := app.New(cfg, ReadinessOpt(client), app.MetricsOpt(listenerMetrics))
+	sentry = txsentry.NewTxSentry(client, redisCli, txsentry.NewConfig(viper.GetViper()))
+	appli, err := app.New(cfg, ReadinessOpt(client, redisCli), app.MetricsOpt(listenerMetrics))
 	if err != nil {
 		return nil, err
 	}
@@ -47,10 +49,13 @@ func New(
 	return appli, nil
 }
 
-func ReadinessOpt(client orchestrateclient.OrchestrateClient) app.Option {
+func ReadinessOpt(client orchestrateclient.OrchestrateClient, redisCli *dbredis.Client) app.Option {
 	return func(ap *app.App) error {
 		ap.AddReadinessCheck("api", client.Checker())
-		ap.AddReadinessCheck("kafka", pkgsarama.GlobalClientChecker())
+		ap.AddReadinessCheck("broker", multi.GlobalBroker().Checker())
+		if redisCli != nil {
+			ap.AddReadinessCheck("redis", redisCli.Ping)
+		}
 		return nil
 	}
 }
@@ -1,6 +1,7 @@
 package txlistener
 
 import (
+	"github.com/consensys/orchestrate/pkg/broker/multi"
 	broker "github.com/consensys/orchestrate/pkg/broker/sarama"
 	orchestrateclient "github.com/consensys/orchestrate/pkg/sdk/client"
 	"github.com/consensys/orchestrate/pkg/toolkit/app"
@@ -10,6 +11,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/toolkit/cache/ristretto"
 	tcpmetrics "github.com/consensys/orchestrate/pkg/toolkit/tcp/metrics"
 	provider "github.com/consensys/orchestrate/services/tx-listener/providers/chain-registry"
+	"github.com/consensys/orchestrate/services/tx-listener/providers/sharded"
 	txsentry "github.com/consensys/orchestrate/services/tx-sentry"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -31,11 +33,14 @@ func Flags(f *pflag.FlagSet) {
 	log.Flags(f)
 	authkey.Flags(f)
 	broker.KafkaProducerFlags(f)
+	multi.Flags(f)
 	broker.KafkaTopicTxDecoded(f)
+	broker.KafkaTopicTxTokenTransfers(f)
 	app.MetricFlags(f)
 	metricregistry.Flags(f, tcpmetrics.ModuleName)
 	txsentry.Flags(f)
 	provider.Flags(f)
+	sharded.Flags(f)
 	orchestrateclient.Flags(f)
 	ristretto.Flags(f)
 }
@@ -29,6 +29,8 @@ type Chain struct {
 	OwnerID  string
 	Name     string
 	URL      string
+	// WebSocket URL of a node of the chain, new blocks are polled when empty
+	WSURL    string
 	ChainID  string
 	Listener Listener
 	Active   bool
@@ -11,11 +11,20 @@ import (
 	authkey "github.com/consensys/orchestrate/pkg/toolkit/app/auth/key"
 	authutils "github.com/consensys/orchestrate/pkg/toolkit/app/auth/utils"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/database/postgres"
+	dbredis "github.com/consensys/orchestrate/pkg/toolkit/database/redis"
 	ethclient "github.com/consensys/orchestrate/pkg/toolkit/ethclient/rpc"
 	"github.com/consensys/orchestrate/pkg/utils"
 	registryprovider "github.com/consensys/orchestrate/services/tx-listener/providers/chain-registry"
+	"github.com/consensys/orchestrate/services/tx-listener/providers/sharded"
+	"github.com/consensys/orchestrate/services/tx-listener/providers/sharded/store"
+	"github.com/consensys/orchestrate/services/tx-listener/providers/sharded/store/memory"
+	pgstore "github.com/consensys/orchestrate/services/tx-listener/providers/sharded/store/postgres"
+	redisstore "github.com/consensys/orchestrate/services/tx-listener/providers/sharded/store/redis"
 	kafkahook "github.com/consensys/orchestrate/services/tx-listener/session/ethereum/hooks/kafka"
 	registryoffset "github.com/consensys/orchestrate/services/tx-listener/session/ethereum/offset/chain-registry"
+	txsentry "github.com/consensys/orchestrate/services/tx-sentry"
+	"github.com/gofrs/uuid"
 	"github.com/spf13/viper"
 )
 
@@ -50,16 +59,48 @@ func NewApp(ctx context.Context) (*app.App, error) {
 	registryprovider.Init(client)
 	registryoffset.Init(client)
 
+	shardingConf := sharded.NewConfig(viper.GetViper())
+	if txsentry.NewConfig(viper.GetViper()).SessionStoreType == txsentry.SessionStoreTypeRedis ||
+		shardingConf.LeaseStoreType == sharded.LeaseStoreTypeRedis {
+		dbredis.Init()
+	}
+
+	leaseStore, err := newLeaseStore(ctx, shardingConf)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each replica owns the leases of the chains it listens to
+	replica := uuid.Must(uuid.NewV4()).String()
+
 	return New(
 		config,
-		registryprovider.GlobalProvider(),
+		sharded.NewProvider(registryprovider.GlobalProvider(), leaseStore, replica, shardingConf),
 		kafkahook.GlobalHook(),
 		registryoffset.GlobalManager(),
 		ethclient.GlobalClient(),
 		client,
+		dbredis.GlobalClient(),
 	)
 }
 
+func newLeaseStore(ctx context.Context, conf *sharded.Config) (store.LeaseStore, error) {
+	switch conf.LeaseStoreType {
+	case sharded.LeaseStoreTypeRedis:
+		return redisstore.NewLeaseStore(dbredis.GlobalClient()), nil
+	case sharded.LeaseStoreTypePostgres:
+		pgConf := postgres.NewConfig(viper.GetViper())
+		pgConf.ApplicationName = "tx-listener"
+		opts, err := pgConf.PGOptions()
+		if err != nil {
+			return nil, err
+		}
+		return pgstore.NewLeaseStore(postgres.GetManager().Connect(ctx, opts)), nil
+	default:
+		return memory.NewLeaseStore(), nil
+	}
+}
+
 // Start starts application
 func Run(ctx context.Context) error {
 	var err error
@@ -5,19 +5,26 @@ import (
 )
 
 type metrics struct {
-	blockCounter kitmetrics.Counter
+	blockCounter    kitmetrics.Counter
+	listenModeGauge kitmetrics.Gauge
 }
 
 // IsSync metric
 
 func buildMetrics(
 	blockCounter kitmetrics.Counter,
+	listenModeGauge kitmetrics.Gauge,
 ) *metrics {
 	return &metrics{
-		blockCounter: blockCounter,
+		blockCounter:    blockCounter,
+		listenModeGauge: listenModeGauge,
 	}
 }
 
 func (r *metrics) BlockCounter() kitmetrics.Counter {
 	return r.blockCounter
 }
+
+func (r *metrics) ListenModeGauge() kitmetrics.Gauge {
+	return r.listenModeGauge
+}
@@ -13,5 +13,6 @@ var ModuleName = fmt.Sprintf("%s_%s", pkgmetrics.Namespace, Subsystem)
 
 type ListenerMetrics interface {
 	BlockCounter() kitmetrics.Counter
+	ListenModeGauge() kitmetrics.Gauge
 	pkgmetrics.Prometheus
 }
@@ -9,8 +9,15 @@ import (
 )
 
 const (
-	Subsystem = "transaction_listener"
-	BlockName = "current_block"
+	Subsystem      = "transaction_listener"
+	BlockName      = "current_block"
+	ListenModeName = "listen_mode"
+)
+
+// Modes used by the chain sessions to be notified of new blocks
+const (
+	PollingListenMode      = "polling"
+	SubscriptionListenMode = "subscription"
 )
 
 type tpcMetrics struct {
@@ -32,10 +39,22 @@ func NewListenerMetrics() ListenerMetrics {
 	)
 	multi.Collectors = append(multi.Collectors, blockCounter)
 
+	listenModeGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metrics1.Namespace,
+			Subsystem: Subsystem,
+			Name:      ListenModeName,
+			Help:      "Mode used to be notified of new blocks (1 if active)",
+		},
+		[]string{"chain_uuid", "mode"},
+	)
+	multi.Collectors = append(multi.Collectors, listenModeGauge)
+
 	return &tpcMetrics{
 		Collector: multi,
 		metrics: buildMetrics(
 			kitprometheus.NewCounter(blockCounter),
+			kitprometheus.NewGauge(listenModeGauge),
 		),
 	}
 }
@@ -45,6 +64,7 @@ func NewListenerNopMetrics() ListenerMetrics {
 		Collector: pkgmetrics.NewMulti(),
 		metrics: buildMetrics(
 			discard.NewCounter(),
+			discard.NewGauge(),
 		),
 	}
 }
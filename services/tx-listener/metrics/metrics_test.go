@@ -1,3 +1,4 @@
+//go:build unit
 // +build unit
 
 package metrics
@@ -23,10 +24,14 @@ func TestListenerMetrics(t *testing.T) {
 	m.BlockCounter().
 		With("chain_uuid", "chain_uuid").
 		Add(1)
+	m.ListenModeGauge().
+		With("chain_uuid", "chain_uuid", "mode", SubscriptionListenMode).
+		Set(1)
 
 	families, err := registry.Gather()
 	require.NoError(t, err, "Gathering metrics should not error")
-	require.Len(t, families, 1, "Count of metrics families should be correct")
+	require.Len(t, families, 2, "Count of metrics families should be correct")
 
 	testutils.AssertCounterFamily(t, families[0], fmt.Sprintf("%s_%s", metrics1.Namespace, Subsystem), BlockName, []float64{1}, "Current block processed", nil)
+	testutils.AssertGaugeFamily(t, families[1], fmt.Sprintf("%s_%s", metrics1.Namespace, Subsystem), ListenModeName, []float64{1}, "Mode used to be notified of new blocks (1 if active)", nil)
 }
@@ -48,6 +48,20 @@ func (mr *MockListenerMetricsMockRecorder) BlockCounter() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockCounter", reflect.TypeOf((*MockListenerMetrics)(nil).BlockCounter))
 }
 
+// ListenModeGauge mocks base method
+func (m *MockListenerMetrics) ListenModeGauge() metrics.Gauge {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenModeGauge")
+	ret0, _ := ret[0].(metrics.Gauge)
+	return ret0
+}
+
+// ListenModeGauge indicates an expected call of ListenModeGauge
+func (mr *MockListenerMetricsMockRecorder) ListenModeGauge() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenModeGauge", reflect.TypeOf((*MockListenerMetrics)(nil).ListenModeGauge))
+}
+
 // Describe mocks base method
 func (m *MockListenerMetrics) Describe(arg0 chan<- *prometheus.Desc) {
 	m.ctrl.T.Helper()
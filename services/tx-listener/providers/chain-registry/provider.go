@@ -91,6 +91,7 @@ func (p *Provider) buildConfiguration(ctx context.Context, chains []*api.ChainRe
 			OwnerID:  chain.OwnerID,
 			Name:     chain.Name,
 			URL:      utils.GetProxyURL(p.conf.ProxyURL, chain.UUID),
+			WSURL:    utils.WebSocketURL(chain.URLs),
 			ChainID:  chain.ChainID.String(),
 			Listener: dynamic.Listener{
 				StartingBlock:     chain.ListenerStartingBlock,
@@ -0,0 +1,90 @@
+package sharded
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/database/postgres"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const (
+	leaseStoreTypeFlag     = "tx-listener-lease-store-type"
+	leaseStoreTypeViperKey = "tx-listener.lease-store.type"
+	leaseStoreTypeDefault  = LeaseStoreTypeInMemory
+	leaseStoreTypeEnv      = "TX_LISTENER_LEASE_STORE_TYPE"
+
+	LeaseStoreTypeInMemory = "in-memory"
+	LeaseStoreTypeRedis    = "redis"
+	LeaseStoreTypePostgres = "postgres"
+)
+
+const (
+	chainLeaseFlag     = "tx-listener-chain-lease"
+	chainLeaseViperKey = "tx-listener.chain-lease"
+	chainLeaseDefault  = 30 * time.Second
+	chainLeaseEnv      = "TX_LISTENER_CHAIN_LEASE"
+)
+
+const (
+	heartbeatIntervalFlag     = "tx-listener-heartbeat-interval"
+	heartbeatIntervalViperKey = "tx-listener.heartbeat-interval"
+	heartbeatIntervalDefault  = 10 * time.Second
+	heartbeatIntervalEnv      = "TX_LISTENER_HEARTBEAT_INTERVAL"
+)
+
+func init() {
+	viper.SetDefault(leaseStoreTypeViperKey, leaseStoreTypeDefault)
+	_ = viper.BindEnv(leaseStoreTypeViperKey, leaseStoreTypeEnv)
+
+	viper.SetDefault(chainLeaseViperKey, chainLeaseDefault)
+	_ = viper.BindEnv(chainLeaseViperKey, chainLeaseEnv)
+
+	viper.SetDefault(heartbeatIntervalViperKey, heartbeatIntervalDefault)
+	_ = viper.BindEnv(heartbeatIntervalViperKey, heartbeatIntervalEnv)
+}
+
+// Flags register flags for chain sharding across tx-listener replicas
+func Flags(f *pflag.FlagSet) {
+	leaseStoreType(f)
+	chainLease(f)
+	heartbeatInterval(f)
+	postgres.PGFlags(f)
+}
+
+func leaseStoreType(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Type of storage of chain leases (one of %q). Use %q or %q to share chains across tx-listener replicas.
+Environment variable: %q`, []string{LeaseStoreTypeInMemory, LeaseStoreTypeRedis, LeaseStoreTypePostgres},
+		LeaseStoreTypeRedis, LeaseStoreTypePostgres, leaseStoreTypeEnv)
+	f.String(leaseStoreTypeFlag, leaseStoreTypeDefault, desc)
+	_ = viper.BindPFlag(leaseStoreTypeViperKey, f.Lookup(leaseStoreTypeFlag))
+}
+
+func chainLease(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Duration of the ownership of a chain by a replica, chains of a replica which stops heartbeating are taken over once expired.
+Environment variable: %q`, chainLeaseEnv)
+	f.Duration(chainLeaseFlag, chainLeaseDefault, desc)
+	_ = viper.BindPFlag(chainLeaseViperKey, f.Lookup(chainLeaseFlag))
+}
+
+func heartbeatInterval(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Time interval between heartbeats of a replica, chain leases are renewed and rebalanced at each heartbeat. Must be lower than the chain lease.
+Environment variable: %q`, heartbeatIntervalEnv)
+	f.Duration(heartbeatIntervalFlag, heartbeatIntervalDefault, desc)
+	_ = viper.BindPFlag(heartbeatIntervalViperKey, f.Lookup(heartbeatIntervalFlag))
+}
+
+type Config struct {
+	LeaseStoreType    string
+	ChainLease        time.Duration
+	HeartbeatInterval time.Duration
+}
+
+func NewConfig(vipr *viper.Viper) *Config {
+	return &Config{
+		LeaseStoreType:    vipr.GetString(leaseStoreTypeViperKey),
+		ChainLease:        vipr.GetDuration(chainLeaseViperKey),
+		HeartbeatInterval: vipr.GetDuration(heartbeatIntervalViperKey),
+	}
+}
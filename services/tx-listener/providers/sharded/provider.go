@@ -0,0 +1,240 @@
+package sharded
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/services/tx-listener/dynamic"
+	provider "github.com/consensys/orchestrate/services/tx-listener/providers"
+	"github.com/consensys/orchestrate/services/tx-listener/providers/sharded/store"
+)
+
+const component = "tx-listener.sharded.provider"
+
+// Provider shares the chains of a provider between tx-listener replicas. Each chain is assigned to one of the replicas
+// alive by rendezvous hashing and is only provided by the replica holding its lease
+type Provider struct {
+	provider provider.Provider
+	store    store.LeaseStore
+	replica  string
+	conf     *Config
+	logger   *log.Logger
+
+	// Latest message of the wrapped provider
+	latest *dynamic.Message
+	// Expiration of the chain leases held by the replica
+	leases map[string]time.Time
+	// Release time of the chain leases held while the session of the chain stops
+	draining map[string]time.Time
+}
+
+func NewProvider(prvdr provider.Provider, leaseStore store.LeaseStore, replica string, conf *Config) *Provider {
+	return &Provider{
+		provider: prvdr,
+		store:    leaseStore,
+		replica:  replica,
+		conf:     conf,
+		logger:   log.NewLogger().SetComponent(component).WithField("replica", replica),
+		leases:   make(map[string]time.Time),
+		draining: make(map[string]time.Time),
+	}
+}
+
+func (p *Provider) Run(ctx context.Context, configInput chan<- *dynamic.Message) error {
+	input := make(chan *dynamic.Message)
+	providerErr := make(chan error, 1)
+	go func() {
+		providerErr <- p.provider.Run(ctx, input)
+		close(input)
+	}()
+
+	ticker := time.NewTicker(p.conf.HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case msg, ok := <-input:
+			if !ok {
+				p.leave()
+				return <-providerErr
+			}
+			p.latest = msg
+			configInput <- p.rebalance(ctx)
+		case <-ticker.C:
+			msg := p.rebalance(ctx)
+			if p.latest != nil {
+				configInput <- msg
+			}
+		case <-ctx.Done():
+			p.leave()
+			// Wait for the wrapped provider to stop
+			for range input {
+			}
+			return <-providerErr
+		}
+	}
+}
+
+// rebalance renews the heartbeat of the replica and the leases of the chains assigned to it. Chains assigned to other
+// replicas, or removed, are drained before being released so they can be taken over. Returns the configuration
+// restricted to the chains owned
+func (p *Provider) rebalance(ctx context.Context) *dynamic.Message {
+	err := p.store.Heartbeat(ctx, p.replica, p.conf.ChainLease)
+	if err != nil {
+		p.logger.WithContext(ctx).WithError(err).Warn("failed to send replica heartbeat")
+	}
+
+	if p.latest == nil {
+		return nil
+	}
+
+	replicas, err := p.store.Replicas(ctx)
+	if err != nil {
+		p.logger.WithContext(ctx).WithError(err).Warn("failed to fetch replicas, only renewing owned chains")
+	}
+
+	msg := &dynamic.Message{
+		Provider: p.latest.Provider,
+		Configuration: &dynamic.Configuration{
+			Chains: make(map[string]*dynamic.Chain),
+		},
+	}
+
+	now := time.Now()
+	for chainUUID, chain := range p.latest.Configuration.Chains {
+		_, held := p.leases[chainUUID]
+		_, draining := p.draining[chainUUID]
+		assigned := held && !draining
+		if err == nil {
+			assigned = assign(chainUUID, replicas) == p.replica
+		}
+
+		if !assigned {
+			p.drain(ctx, chainUUID, now)
+			continue
+		}
+
+		delete(p.draining, chainUUID)
+		if p.acquire(ctx, chainUUID, now) {
+			msg.Configuration.Chains[chainUUID] = chain
+		}
+	}
+
+	// Drain the chains which have been removed
+	for chainUUID := range p.leases {
+		if _, ok := p.latest.Configuration.Chains[chainUUID]; !ok {
+			p.drain(ctx, chainUUID, now)
+		}
+	}
+
+	return msg
+}
+
+func (p *Provider) acquire(ctx context.Context, chainUUID string, now time.Time) bool {
+	logger := p.logger.WithContext(ctx).WithField("chain", chainUUID)
+	expiresAt, held := p.leases[chainUUID]
+
+	acquired, err := p.store.Acquire(ctx, chainUUID, p.replica, p.conf.ChainLease)
+	switch {
+	case err != nil && held && now.Before(expiresAt):
+		// The lease is still ours until it expires, so we keep listening in the meantime
+		logger.WithError(err).Warn("failed to renew chain lease")
+		return true
+	case err != nil:
+		logger.WithError(err).Warn("failed to acquire chain lease")
+		delete(p.leases, chainUUID)
+		return false
+	case !acquired:
+		if held {
+			logger.Warn("chain lease was lost")
+			delete(p.leases, chainUUID)
+		}
+		return false
+	default:
+		if !held {
+			logger.Info("chain lease acquired")
+		}
+		p.leases[chainUUID] = now.Add(p.conf.ChainLease)
+		return true
+	}
+}
+
+// drain keeps the lease of a chain no longer provided for a heartbeat interval, so that its session is stopped before
+// another replica takes the chain over, and releases it afterwards
+func (p *Provider) drain(ctx context.Context, chainUUID string, now time.Time) {
+	if _, held := p.leases[chainUUID]; !held {
+		delete(p.draining, chainUUID)
+		return
+	}
+
+	releaseAt, draining := p.draining[chainUUID]
+	switch {
+	case !draining:
+		p.logger.WithContext(ctx).WithField("chain", chainUUID).Debug("draining chain lease")
+		p.draining[chainUUID] = now.Add(p.conf.HeartbeatInterval)
+	case !now.Before(releaseAt):
+		p.release(ctx, chainUUID)
+		return
+	}
+
+	if !p.acquire(ctx, chainUUID, now) {
+		delete(p.draining, chainUUID)
+	}
+}
+
+func (p *Provider) release(ctx context.Context, chainUUID string) {
+	delete(p.draining, chainUUID)
+	if _, held := p.leases[chainUUID]; !held {
+		return
+	}
+
+	delete(p.leases, chainUUID)
+	err := p.store.Release(ctx, chainUUID, p.replica)
+	if err != nil {
+		p.logger.WithContext(ctx).WithError(err).WithField("chain", chainUUID).Warn("failed to release chain lease")
+		return
+	}
+
+	p.logger.WithContext(ctx).WithField("chain", chainUUID).Info("chain lease released")
+}
+
+// leave unregisters the replica and releases all the chains once their sessions, canceled with the provider, had a
+// heartbeat interval to stop so that other replicas take over without waiting for the leases to expire
+func (p *Provider) leave() {
+	// Context of the provider is already canceled when leaving
+	ctx, cancel := context.WithTimeout(context.Background(), 2*p.conf.HeartbeatInterval)
+	defer cancel()
+
+	err := p.store.Leave(ctx, p.replica)
+	if err != nil {
+		p.logger.WithError(err).Warn("failed to unregister replica")
+	}
+
+	if len(p.leases) == 0 {
+		return
+	}
+
+	time.Sleep(p.conf.HeartbeatInterval)
+	for chainUUID := range p.leases {
+		p.release(ctx, chainUUID)
+	}
+}
+
+// assign returns the replica a chain is assigned to. Rendezvous hashing only moves the chains of a replica joining or
+// leaving, other chains keep their owner
+func assign(chainUUID string, replicas []string) string {
+	var owner string
+	var maxScore uint64
+	for _, replica := range replicas {
+		h := sha256.Sum256([]byte(replica + "/" + chainUUID))
+		score := binary.BigEndian.Uint64(h[:8])
+		if owner == "" || score > maxScore {
+			owner, maxScore = replica, score
+		}
+	}
+
+	return owner
+}
@@ -0,0 +1,190 @@
+// +build unit
+
+package sharded
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/services/tx-listener/dynamic"
+	"github.com/consensys/orchestrate/services/tx-listener/providers/mock"
+	"github.com/consensys/orchestrate/services/tx-listener/providers/sharded/store"
+	"github.com/consensys/orchestrate/services/tx-listener/providers/sharded/store/memory"
+	storemock "github.com/consensys/orchestrate/services/tx-listener/providers/sharded/store/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	nChains   = 20
+	heartbeat = 10 * time.Millisecond
+)
+
+func newMessage() *dynamic.Message {
+	msg := &dynamic.Message{
+		Provider: "chain-registry",
+		Configuration: &dynamic.Configuration{
+			Chains: make(map[string]*dynamic.Chain),
+		},
+	}
+	for i := 0; i < nChains; i++ {
+		chainUUID := fmt.Sprintf("chain-%d", i)
+		msg.Configuration.Chains[chainUUID] = &dynamic.Chain{UUID: chainUUID}
+	}
+
+	return msg
+}
+
+func newReplica(leaseStore store.LeaseStore, replica string, lease time.Duration) *Provider {
+	p := NewProvider(nil, leaseStore, replica, &Config{ChainLease: lease, HeartbeatInterval: heartbeat})
+	p.latest = newMessage()
+	return p
+}
+
+func TestProvider_SingleReplica(t *testing.T) {
+	p := newReplica(memory.NewLeaseStore(), "replica-a", time.Minute)
+
+	msg := p.rebalance(context.Background())
+	assert.Equal(t, "chain-registry", msg.Provider)
+	assert.Len(t, msg.Configuration.Chains, nChains)
+}
+
+func TestProvider_Rebalance(t *testing.T) {
+	ctx := context.Background()
+	leaseStore := memory.NewLeaseStore()
+	pA := newReplica(leaseStore, "replica-a", time.Minute)
+	pB := newReplica(leaseStore, "replica-b", time.Minute)
+
+	t.Run("should own all chains when alone", func(t *testing.T) {
+		assert.Len(t, pA.rebalance(ctx).Configuration.Chains, nChains)
+	})
+
+	t.Run("should not provide chains held by another replica when joining", func(t *testing.T) {
+		assert.Empty(t, pB.rebalance(ctx).Configuration.Chains)
+	})
+
+	t.Run("should keep the leases of the chains reassigned while their sessions stop", func(t *testing.T) {
+		chainsA := pA.rebalance(ctx).Configuration.Chains
+		assert.NotEmpty(t, chainsA)
+		assert.Less(t, len(chainsA), nChains)
+		assert.Len(t, pA.leases, nChains)
+		assert.Empty(t, pB.rebalance(ctx).Configuration.Chains)
+	})
+
+	t.Run("should share chains once the other replica has released them", func(t *testing.T) {
+		time.Sleep(heartbeat)
+		chainsA := pA.rebalance(ctx).Configuration.Chains
+		chainsB := pB.rebalance(ctx).Configuration.Chains
+
+		assert.NotEmpty(t, chainsA)
+		assert.NotEmpty(t, chainsB)
+		assert.Len(t, chainsA, nChains-len(chainsB))
+		for chainUUID := range chainsB {
+			assert.NotContains(t, chainsA, chainUUID)
+		}
+	})
+
+	t.Run("should take over chains of a replica leaving", func(t *testing.T) {
+		pB.leave()
+		assert.Len(t, pA.rebalance(ctx).Configuration.Chains, nChains)
+	})
+
+	t.Run("should release chains removed from the configuration", func(t *testing.T) {
+		delete(pA.latest.Configuration.Chains, "chain-0")
+		assert.Len(t, pA.rebalance(ctx).Configuration.Chains, nChains-1)
+		assert.Contains(t, pA.leases, "chain-0", "lease should be kept while the session stops")
+
+		time.Sleep(heartbeat)
+		assert.Len(t, pA.rebalance(ctx).Configuration.Chains, nChains-1)
+		assert.NotContains(t, pA.leases, "chain-0")
+		assert.Empty(t, pA.draining)
+	})
+}
+
+func TestProvider_DeadReplica(t *testing.T) {
+	ctx := context.Background()
+	leaseStore := memory.NewLeaseStore()
+	lease := 50 * time.Millisecond
+	pA := newReplica(leaseStore, "replica-a", lease)
+	pB := newReplica(leaseStore, "replica-b", lease)
+
+	_ = pB.rebalance(ctx)
+	assert.Len(t, pB.rebalance(ctx).Configuration.Chains, nChains)
+	chainsA := pA.rebalance(ctx).Configuration.Chains
+	assert.Empty(t, chainsA, "chains should still be leased by replica-b")
+
+	// replica-b stops heartbeating
+	time.Sleep(2 * lease)
+	assert.Len(t, pA.rebalance(ctx).Configuration.Chains, nChains)
+}
+
+func TestProvider_LeaseStoreErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	leaseStore := storemock.NewMockLeaseStore(ctrl)
+	lease := 50 * time.Millisecond
+	p := newReplica(leaseStore, "replica-a", lease)
+	p.latest.Configuration.Chains = map[string]*dynamic.Chain{"chainUUID": {UUID: "chainUUID"}}
+
+	leaseStore.EXPECT().Heartbeat(gomock.Any(), "replica-a", lease).Return(nil)
+	leaseStore.EXPECT().Replicas(gomock.Any()).Return([]string{"replica-a"}, nil)
+	leaseStore.EXPECT().Acquire(gomock.Any(), "chainUUID", "replica-a", lease).Return(true, nil)
+	require.Len(t, p.rebalance(ctx).Configuration.Chains, 1)
+
+	expectedErr := fmt.Errorf("error")
+	leaseStore.EXPECT().Heartbeat(gomock.Any(), "replica-a", lease).Return(expectedErr).Times(2)
+	leaseStore.EXPECT().Replicas(gomock.Any()).Return(nil, expectedErr).Times(2)
+	leaseStore.EXPECT().Acquire(gomock.Any(), "chainUUID", "replica-a", lease).Return(false, expectedErr).Times(2)
+
+	assert.Len(t, p.rebalance(ctx).Configuration.Chains, 1, "chain should be kept until its lease expires")
+	time.Sleep(2 * lease)
+	assert.Empty(t, p.rebalance(ctx).Configuration.Chains, "chain should be stopped once its lease has expired")
+}
+
+func TestProvider_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	leaseStore := memory.NewLeaseStore()
+	prvdr := mock.NewMockProvider(ctrl)
+	p := NewProvider(prvdr, leaseStore, "replica-a", &Config{ChainLease: time.Minute, HeartbeatInterval: time.Millisecond})
+
+	prvdr.EXPECT().Run(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, input chan<- *dynamic.Message) error {
+		input <- newMessage()
+		<-ctx.Done()
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	configInput := make(chan *dynamic.Message)
+	done := make(chan error)
+	go func() {
+		done <- p.Run(ctx, configInput)
+	}()
+
+	msg := <-configInput
+	assert.Len(t, msg.Configuration.Chains, nChains)
+	msg = <-configInput
+	assert.Len(t, msg.Configuration.Chains, nChains)
+
+	cancel()
+	go func() {
+		for range configInput {
+		}
+	}()
+	assert.NoError(t, <-done)
+	close(configInput)
+
+	// Leases are released when leaving
+	acquired, err := leaseStore.Acquire(context.Background(), "chain-0", "replica-b", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+	replicas, err := leaseStore.Replicas(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, replicas)
+}
@@ -0,0 +1,89 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/consensys/orchestrate/services/tx-listener/providers/sharded/store"
+)
+
+type lease struct {
+	owner     string
+	expiresAt time.Time
+}
+
+// leaseStore keeps leases in memory, so chains are only shared inside the current process
+type leaseStore struct {
+	mutex    *sync.Mutex
+	replicas map[string]time.Time
+	leases   map[string]*lease
+}
+
+// NewLeaseStore creates a new in-memory LeaseStore
+func NewLeaseStore() store.LeaseStore {
+	return &leaseStore{
+		mutex:    &sync.Mutex{},
+		replicas: make(map[string]time.Time),
+		leases:   make(map[string]*lease),
+	}
+}
+
+func (s *leaseStore) Heartbeat(_ context.Context, replica string, ttl time.Duration) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.replicas[replica] = time.Now().Add(ttl)
+	return nil
+}
+
+func (s *leaseStore) Leave(_ context.Context, replica string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.replicas, replica)
+	return nil
+}
+
+func (s *leaseStore) Replicas(_ context.Context) ([]string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := time.Now()
+	replicas := make([]string, 0, len(s.replicas))
+	for replica, expiresAt := range s.replicas {
+		if now.After(expiresAt) {
+			delete(s.replicas, replica)
+			continue
+		}
+		replicas = append(replicas, replica)
+	}
+
+	sort.Strings(replicas)
+	return replicas, nil
+}
+
+func (s *leaseStore) Acquire(_ context.Context, chainUUID, owner string, ttl time.Duration) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	l, ok := s.leases[chainUUID]
+	if ok && l.owner != owner && time.Now().Before(l.expiresAt) {
+		return false, nil
+	}
+
+	s.leases[chainUUID] = &lease{owner: owner, expiresAt: time.Now().Add(ttl)}
+	return true, nil
+}
+
+func (s *leaseStore) Release(_ context.Context, chainUUID, owner string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if l, ok := s.leases[chainUUID]; ok && l.owner == owner {
+		delete(s.leases, chainUUID)
+	}
+
+	return nil
+}
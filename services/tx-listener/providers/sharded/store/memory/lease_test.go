@@ -0,0 +1,61 @@
+// +build unit
+
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeaseStoreInMemory(t *testing.T) {
+	ls := NewLeaseStore()
+	ctx := context.Background()
+
+	err := ls.Heartbeat(ctx, "replica-1", time.Second)
+	assert.NoError(t, err)
+	err = ls.Heartbeat(ctx, "replica-0", time.Millisecond)
+	assert.NoError(t, err)
+
+	replicas, err := ls.Replicas(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"replica-0", "replica-1"}, replicas)
+
+	time.Sleep(5 * time.Millisecond)
+	replicas, err = ls.Replicas(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"replica-1"}, replicas)
+
+	err = ls.Leave(ctx, "replica-1")
+	assert.NoError(t, err)
+	replicas, err = ls.Replicas(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, replicas)
+
+	acquired, err := ls.Acquire(ctx, "chainUUID", "replica-0", time.Second)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+
+	acquired, err = ls.Acquire(ctx, "chainUUID", "replica-1", time.Second)
+	assert.NoError(t, err)
+	assert.False(t, acquired)
+
+	err = ls.Release(ctx, "chainUUID", "replica-1")
+	assert.NoError(t, err)
+	acquired, err = ls.Acquire(ctx, "chainUUID", "replica-1", time.Second)
+	assert.NoError(t, err)
+	assert.False(t, acquired)
+
+	err = ls.Release(ctx, "chainUUID", "replica-0")
+	assert.NoError(t, err)
+	acquired, err = ls.Acquire(ctx, "chainUUID", "replica-1", time.Millisecond)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+
+	time.Sleep(5 * time.Millisecond)
+	acquired, err = ls.Acquire(ctx, "chainUUID", "replica-0", time.Second)
+	assert.NoError(t, err)
+	assert.True(t, acquired, "expired lease should be taken over")
+}
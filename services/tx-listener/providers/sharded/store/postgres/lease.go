@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	pg "github.com/consensys/orchestrate/pkg/toolkit/database/postgres"
+	"github.com/consensys/orchestrate/services/tx-listener/providers/sharded/store"
+)
+
+const component = "tx-listener.lease-store.postgres"
+
+// Expiration dates are computed by Postgres so that replicas do not depend on their local clocks
+const (
+	heartbeatQuery = `
+INSERT INTO tx_listener_replicas (id, expires_at) VALUES (?, now() + ? * interval '1 millisecond')
+ON CONFLICT (id) DO UPDATE SET expires_at = EXCLUDED.expires_at`
+
+	leaveQuery = `DELETE FROM tx_listener_replicas WHERE id = ?`
+
+	replicasQuery = `SELECT id FROM tx_listener_replicas WHERE expires_at > now() ORDER BY id`
+
+	// acquireQuery sets the lease if free or expired, or extends it if already held by the same owner
+	acquireQuery = `
+INSERT INTO tx_listener_chain_leases (chain_uuid, owner, expires_at) VALUES (?, ?, now() + ? * interval '1 millisecond')
+ON CONFLICT (chain_uuid) DO UPDATE SET owner = EXCLUDED.owner, expires_at = EXCLUDED.expires_at
+WHERE tx_listener_chain_leases.owner = EXCLUDED.owner OR tx_listener_chain_leases.expires_at <= now()`
+
+	releaseQuery = `DELETE FROM tx_listener_chain_leases WHERE chain_uuid = ? AND owner = ?`
+)
+
+// leaseStore keeps chain leases in Postgres so chains can be shared across tx-listener replicas
+type leaseStore struct {
+	db     pg.DB
+	logger *log.Logger
+}
+
+// NewLeaseStore creates a new Postgres LeaseStore
+func NewLeaseStore(db pg.DB) store.LeaseStore {
+	return &leaseStore{
+		db:     db,
+		logger: log.NewLogger().SetComponent(component),
+	}
+}
+
+func (s *leaseStore) Heartbeat(ctx context.Context, replica string, ttl time.Duration) error {
+	_, err := s.db.ExecContext(ctx, heartbeatQuery, replica, ttl.Milliseconds())
+	if err != nil {
+		s.logger.WithContext(ctx).WithError(err).Error("failed to register replica heartbeat")
+		return errors.FromError(pg.ParsePGError(err)).ExtendComponent(component)
+	}
+
+	return nil
+}
+
+func (s *leaseStore) Leave(ctx context.Context, replica string) error {
+	_, err := s.db.ExecContext(ctx, leaveQuery, replica)
+	if err != nil {
+		s.logger.WithContext(ctx).WithError(err).Error("failed to unregister replica")
+		return errors.FromError(pg.ParsePGError(err)).ExtendComponent(component)
+	}
+
+	return nil
+}
+
+func (s *leaseStore) Replicas(ctx context.Context) ([]string, error) {
+	var replicas []string
+	_, err := s.db.QueryContext(ctx, &replicas, replicasQuery)
+	if err != nil {
+		s.logger.WithContext(ctx).WithError(err).Error("failed to fetch replicas")
+		return nil, errors.FromError(pg.ParsePGError(err)).ExtendComponent(component)
+	}
+
+	return replicas, nil
+}
+
+func (s *leaseStore) Acquire(ctx context.Context, chainUUID, owner string, ttl time.Duration) (bool, error) {
+	res, err := s.db.ExecContext(ctx, acquireQuery, chainUUID, owner, ttl.Milliseconds())
+	if err != nil {
+		s.logger.WithContext(ctx).WithError(err).Error("failed to acquire chain lease")
+		return false, errors.FromError(pg.ParsePGError(err)).ExtendComponent(component)
+	}
+
+	return res.RowsAffected() == 1, nil
+}
+
+func (s *leaseStore) Release(ctx context.Context, chainUUID, owner string) error {
+	_, err := s.db.ExecContext(ctx, releaseQuery, chainUUID, owner)
+	if err != nil {
+		s.logger.WithContext(ctx).WithError(err).Error("failed to release chain lease")
+		return errors.FromError(pg.ParsePGError(err)).ExtendComponent(component)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/database/redis"
+	"github.com/consensys/orchestrate/services/tx-listener/providers/sharded/store"
+)
+
+const (
+	replicasSetKey = "tx-listener-replicas"
+	replicaSuf     = "tx-listener-replica"
+	leaseSuf       = "tx-listener-lease"
+)
+
+// leaseStore keeps chain leases in Redis so chains can be shared across tx-listener replicas
+type leaseStore struct {
+	redis *redis.Client
+}
+
+// NewLeaseStore creates a new Redis LeaseStore
+func NewLeaseStore(client *redis.Client) store.LeaseStore {
+	return &leaseStore{
+		redis: client,
+	}
+}
+
+func (s *leaseStore) Heartbeat(_ context.Context, replica string, ttl time.Duration) error {
+	err := s.redis.SetWithTTL(computeKey(replica, replicaSuf), true, ttl)
+	if err != nil {
+		return err
+	}
+
+	return s.redis.AddToSet(replicasSetKey, replica)
+}
+
+func (s *leaseStore) Leave(_ context.Context, replica string) error {
+	err := s.redis.Delete(computeKey(replica, replicaSuf))
+	if err != nil {
+		return err
+	}
+
+	return s.redis.RemoveFromSet(replicasSetKey, replica)
+}
+
+func (s *leaseStore) Replicas(ctx context.Context) ([]string, error) {
+	members, err := s.redis.LoadSet(replicasSetKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var replicas []string
+	for _, replica := range members {
+		_, ok, err := s.redis.Load(computeKey(replica, replicaSuf))
+		if err != nil {
+			return nil, err
+		}
+
+		// Replica stopped heartbeating, we clean the index
+		if !ok {
+			log.FromContext(ctx).WithField("replica", replica).Debug("removing expired replica from index")
+			_ = s.redis.RemoveFromSet(replicasSetKey, replica)
+			continue
+		}
+
+		replicas = append(replicas, replica)
+	}
+
+	sort.Strings(replicas)
+	return replicas, nil
+}
+
+func (s *leaseStore) Acquire(_ context.Context, chainUUID, owner string, ttl time.Duration) (bool, error) {
+	return s.redis.AcquireLease(computeKey(chainUUID, leaseSuf), owner, ttl)
+}
+
+func (s *leaseStore) Release(_ context.Context, chainUUID, owner string) error {
+	return s.redis.ReleaseLease(computeKey(chainUUID, leaseSuf), owner)
+}
+
+func computeKey(key, suffix string) string {
+	return fmt.Sprintf("%v-%v", key, suffix)
+}
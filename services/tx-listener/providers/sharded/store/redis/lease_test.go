@@ -0,0 +1,73 @@
+// +build unit
+
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/consensys/orchestrate/pkg/toolkit/database/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeaseStoreRedis(t *testing.T) {
+	mredis, _ := miniredis.Run()
+	defer mredis.Close()
+	conf := &redis.Config{
+		Expiration: 1,
+		Host:       mredis.Host(),
+		Port:       mredis.Port(),
+	}
+
+	pool, _ := redis.NewPool(conf)
+	ls := NewLeaseStore(redis.NewClient(pool, conf))
+	ctx := context.Background()
+
+	err := ls.Heartbeat(ctx, "replica-1", 10*time.Second)
+	assert.NoError(t, err)
+	err = ls.Heartbeat(ctx, "replica-0", time.Second)
+	assert.NoError(t, err)
+
+	replicas, err := ls.Replicas(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"replica-0", "replica-1"}, replicas)
+
+	mredis.FastForward(2 * time.Second)
+	replicas, err = ls.Replicas(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"replica-1"}, replicas)
+	assert.False(t, mredis.Exists("replica-0-tx-listener-replica"))
+
+	err = ls.Leave(ctx, "replica-1")
+	assert.NoError(t, err)
+	replicas, err = ls.Replicas(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, replicas)
+
+	acquired, err := ls.Acquire(ctx, "chainUUID", "replica-0", time.Second)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+
+	acquired, err = ls.Acquire(ctx, "chainUUID", "replica-1", time.Second)
+	assert.NoError(t, err)
+	assert.False(t, acquired)
+
+	err = ls.Release(ctx, "chainUUID", "replica-1")
+	assert.NoError(t, err)
+	acquired, err = ls.Acquire(ctx, "chainUUID", "replica-1", time.Second)
+	assert.NoError(t, err)
+	assert.False(t, acquired)
+
+	mredis.FastForward(2 * time.Second)
+	acquired, err = ls.Acquire(ctx, "chainUUID", "replica-1", time.Second)
+	assert.NoError(t, err)
+	assert.True(t, acquired, "expired lease should be taken over")
+
+	err = ls.Release(ctx, "chainUUID", "replica-1")
+	assert.NoError(t, err)
+	acquired, err = ls.Acquire(ctx, "chainUUID", "replica-0", time.Second)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+}
@@ -0,0 +1,25 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=store.go -destination=mock/store.go -package=mock
+
+type LeaseStore interface {
+	// Heartbeat registers, or renews, the replica as alive for ttl
+	Heartbeat(ctx context.Context, replica string, ttl time.Duration) error
+
+	// Leave unregisters the replica
+	Leave(ctx context.Context, replica string) error
+
+	// Replicas retrieves the replicas which are alive
+	Replicas(ctx context.Context) ([]string, error)
+
+	// Acquire takes, or renews, the lease of the chain for the owner. Returns false if held by another owner
+	Acquire(ctx context.Context, chainUUID, owner string, ttl time.Duration) (bool, error)
+
+	// Release releases the lease of the chain if held by the owner
+	Release(ctx context.Context, chainUUID, owner string) error
+}
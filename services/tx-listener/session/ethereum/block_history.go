@@ -0,0 +1,68 @@
+package ethereum
+
+import (
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// BlockHistorySize is the number of processed blocks kept to detect chain reorganizations
+const BlockHistorySize = 128
+
+type processedBlock struct {
+	number uint64
+	hash   ethcommon.Hash
+	jobs   []*entities.Job
+}
+
+// blockHistory is a ring of the latest processed blocks
+type blockHistory struct {
+	blocks []*processedBlock
+	head   int
+	size   int
+}
+
+func newBlockHistory(capacity int) *blockHistory {
+	return &blockHistory{
+		blocks: make([]*processedBlock, capacity),
+	}
+}
+
+func (h *blockHistory) push(b *processedBlock) {
+	h.blocks[h.head] = b
+	h.head = (h.head + 1) % len(h.blocks)
+	if h.size < len(h.blocks) {
+		h.size++
+	}
+}
+
+// last returns the latest processed block or nil if history is empty
+func (h *blockHistory) last() *processedBlock {
+	if h.size == 0 {
+		return nil
+	}
+
+	return h.recent(0)
+}
+
+// recent returns the i-th latest processed block, 0 being the latest
+func (h *blockHistory) recent(i int) *processedBlock {
+	return h.blocks[(h.head-1-i+2*len(h.blocks))%len(h.blocks)]
+}
+
+func (h *blockHistory) len() int {
+	return h.size
+}
+
+// pop removes and returns the latest processed block or nil if history is empty
+func (h *blockHistory) pop() *processedBlock {
+	b := h.last()
+	if b == nil {
+		return nil
+	}
+
+	h.head = (h.head - 1 + len(h.blocks)) % len(h.blocks)
+	h.blocks[h.head] = nil
+	h.size--
+
+	return b
+}
@@ -0,0 +1,50 @@
+package ethereum
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	eth "github.com/ethereum/go-ethereum"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+//go:generate mockgen -source=head_subscriber.go -destination=mocks/head_subscriber.go -package=mocks
+
+type HeadSubscriber interface {
+	// SubscribeNewHead subscribes to the headers of the blocks added to the chain
+	SubscribeNewHead(ctx context.Context, url string, ch chan<- *ethtypes.Header) (eth.Subscription, error)
+}
+
+type wsHeadSubscriber struct{}
+
+// NewWSHeadSubscriber creates a HeadSubscriber calling eth_subscribe("newHeads") over a WebSocket connection
+func NewWSHeadSubscriber() HeadSubscriber {
+	return &wsHeadSubscriber{}
+}
+
+func (*wsHeadSubscriber) SubscribeNewHead(ctx context.Context, url string, ch chan<- *ethtypes.Header) (eth.Subscription, error) {
+	client, err := rpc.DialContext(ctx, url)
+	if err != nil {
+		return nil, errors.ConnectionError("failed to connect to %s", url)
+	}
+
+	sub, err := client.EthSubscribe(ctx, ch, "newHeads")
+	if err != nil {
+		client.Close()
+		return nil, errors.ConnectionError("failed to subscribe to new heads: %v", err)
+	}
+
+	return &wsSubscription{Subscription: sub, client: client}, nil
+}
+
+// wsSubscription closes the WebSocket connection when unsubscribing
+type wsSubscription struct {
+	eth.Subscription
+	client *rpc.Client
+}
+
+func (s *wsSubscription) Unsubscribe() {
+	s.Subscription.Unsubscribe()
+	s.client.Close()
+}
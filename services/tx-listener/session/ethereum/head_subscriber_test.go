@@ -0,0 +1,159 @@
+// +build unit
+
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	metricsmock "github.com/consensys/orchestrate/pkg/toolkit/app/metrics/mock"
+	"github.com/consensys/orchestrate/services/tx-listener/dynamic"
+	"github.com/consensys/orchestrate/services/tx-listener/metrics"
+	listenermetricsmock "github.com/consensys/orchestrate/services/tx-listener/metrics/mock"
+	"github.com/consensys/orchestrate/services/tx-listener/session/ethereum/mocks"
+	eth "github.com/ethereum/go-ethereum"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type listenNewHeadsTest struct {
+	ec              *mocks.MockEthClient
+	subscriber      *mocks.MockHeadSubscriber
+	listenModeGauge *metricsmock.MockGauge
+	fetchedBlocks   chan uint64
+	session         *Session
+}
+
+func newListenNewHeadsTest(t *testing.T, chain *dynamic.Chain) *listenNewHeadsTest {
+	ctrl := gomock.NewController(t)
+	test := &listenNewHeadsTest{
+		ec:              mocks.NewMockEthClient(ctrl),
+		subscriber:      mocks.NewMockHeadSubscriber(ctrl),
+		listenModeGauge: metricsmock.NewMockGauge(ctrl),
+		fetchedBlocks:   make(chan uint64, 10),
+	}
+
+	mockMetrics := listenermetricsmock.NewMockListenerMetrics(ctrl)
+	mockMetrics.EXPECT().ListenModeGauge().AnyTimes().Return(test.listenModeGauge)
+	test.listenModeGauge.EXPECT().Set(gomock.Any()).AnyTimes()
+
+	test.ec.EXPECT().BlockByNumber(gomock.Any(), chain.URL, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, number *big.Int) (*ethtypes.Block, error) {
+			test.fetchedBlocks <- number.Uint64()
+			return nil, errors.ConnectionError("error")
+		}).AnyTimes()
+
+	test.session = NewSession(chain, test.ec, test.subscriber, nil, nil, nil, mockMetrics)
+	test.session.trigger = make(chan struct{}, 1)
+	test.session.errors = make(chan error, 1)
+	test.session.fetchedBlocks = make(chan *Future, 20)
+	test.session.blockPosition = 1
+
+	return test
+}
+
+// listen starts the listening loop of the session and returns the function stopping it
+func (test *listenNewHeadsTest) listen() func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		for range test.session.fetchedBlocks {
+		}
+	}()
+	go func() {
+		test.session.trig()
+		test.session.listen(ctx)
+		close(done)
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+func (test *listenNewHeadsTest) awaitBlock(t *testing.T, expected uint64) {
+	select {
+	case number := <-test.fetchedBlocks:
+		assert.Equal(t, expected, number)
+	case <-time.After(time.Second):
+		require.Fail(t, "block should have been fetched", "block %d", expected)
+	}
+}
+
+func TestSession_ListenNewHeads(t *testing.T) {
+	chain := &dynamic.Chain{
+		UUID:     "chainUUID",
+		URL:      "chainURL",
+		WSURL:    "ws://node:8546",
+		Listener: dynamic.Listener{Backoff: 10 * time.Millisecond},
+	}
+
+	t.Run("should fetch the blocks pushed by the new heads subscription", func(t *testing.T) {
+		test := newListenNewHeadsTest(t, chain)
+		heads := make(chan chan<- *ethtypes.Header, 1)
+		test.subscriber.EXPECT().SubscribeNewHead(gomock.Any(), chain.WSURL, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, ch chan<- *ethtypes.Header) (eth.Subscription, error) {
+				heads <- ch
+				return event.NewSubscription(func(quit <-chan struct{}) error {
+					<-quit
+					return nil
+				}), nil
+			})
+		test.listenModeGauge.EXPECT().With("chain_uuid", chain.UUID, "mode", metrics.PollingListenMode).Return(test.listenModeGauge).Times(2)
+		test.listenModeGauge.EXPECT().With("chain_uuid", chain.UUID, "mode", metrics.SubscriptionListenMode).Return(test.listenModeGauge).Times(2)
+		// The chain tip is only polled once to start listening
+		test.ec.EXPECT().HeaderByNumber(gomock.Any(), chain.URL, nil).Return(&ethtypes.Header{Number: big.NewInt(1)}, nil)
+
+		stop := test.listen()
+		test.awaitBlock(t, 1)
+
+		(<-heads) <- &ethtypes.Header{Number: big.NewInt(2)}
+		test.awaitBlock(t, 2)
+
+		<-time.After(50 * time.Millisecond)
+		stop()
+	})
+
+	t.Run("should poll new blocks when the subscription drops", func(t *testing.T) {
+		test := newListenNewHeadsTest(t, chain)
+		dropped := make(chan struct{})
+		test.subscriber.EXPECT().SubscribeNewHead(gomock.Any(), chain.WSURL, gomock.Any()).
+			Return(event.NewSubscription(func(quit <-chan struct{}) error {
+				<-dropped
+				return fmt.Errorf("connection lost")
+			}), nil)
+		test.listenModeGauge.EXPECT().With(gomock.Any()).Return(test.listenModeGauge).AnyTimes()
+		test.ec.EXPECT().HeaderByNumber(gomock.Any(), chain.URL, nil).Return(&ethtypes.Header{Number: big.NewInt(1)}, nil)
+
+		stop := test.listen()
+		test.awaitBlock(t, 1)
+
+		test.ec.EXPECT().HeaderByNumber(gomock.Any(), chain.URL, nil).Return(&ethtypes.Header{Number: big.NewInt(2)}, nil).MinTimes(1)
+		close(dropped)
+		test.awaitBlock(t, 2)
+
+		stop()
+	})
+
+	t.Run("should poll new blocks if the subscription fails", func(t *testing.T) {
+		test := newListenNewHeadsTest(t, chain)
+		test.subscriber.EXPECT().SubscribeNewHead(gomock.Any(), chain.WSURL, gomock.Any()).
+			Return(nil, errors.ConnectionError("error"))
+		test.listenModeGauge.EXPECT().With(gomock.Any()).Return(test.listenModeGauge).AnyTimes()
+		test.ec.EXPECT().HeaderByNumber(gomock.Any(), chain.URL, nil).Return(&ethtypes.Header{Number: big.NewInt(1)}, nil).MinTimes(2)
+
+		stop := test.listen()
+		test.awaitBlock(t, 1)
+		<-time.After(50 * time.Millisecond)
+
+		stop()
+	})
+}
@@ -13,4 +13,16 @@ import (
 
 type Hook interface {
 	AfterNewBlock(ctx context.Context, chain *dynamic.Chain, block *ethtypes.Block, jobs []*entities.Job) error
+	// AfterReorg is called once a chain reorganization has been detected, with the jobs mined in the orphaned blocks
+	AfterReorg(ctx context.Context, chain *dynamic.Chain, ancestor uint64, jobs []*entities.Job) error
+	// AfterNewLogs is called with the logs of a new block matching the subscriptions registered on the chain
+	AfterNewLogs(ctx context.Context, chain *dynamic.Chain, block *ethtypes.Block, logs []*SubscriptionLog) error
+	// Close stops the background work of the hook once all the sessions are stopped
+	Close() error
+}
+
+// SubscriptionLog is a log emitted in a block together with the subscription it matches
+type SubscriptionLog struct {
+	Subscription *entities.Subscription
+	Log          *ethtypes.Log
 }
@@ -6,11 +6,13 @@ import (
 )
 
 type Config struct {
-	OutTopic string
+	OutTopic            string
+	TokenTransfersTopic string
 }
 
 func NewConfig() *Config {
 	return &Config{
-		OutTopic: viper.GetString(broker.TxDecodedViperKey),
+		OutTopic:            viper.GetString(broker.TxDecodedViperKey),
+		TokenTransfersTopic: viper.GetString(broker.TxTokenTransfersViperKey),
 	}
 }
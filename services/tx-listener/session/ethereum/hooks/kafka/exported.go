@@ -7,7 +7,7 @@ import (
 	txscheduler "github.com/consensys/orchestrate/pkg/sdk/client"
 	"github.com/consensys/orchestrate/pkg/toolkit/cache/ristretto"
 
-	broker "github.com/consensys/orchestrate/pkg/broker/sarama"
+	"github.com/consensys/orchestrate/pkg/broker/multi"
 	ethclient "github.com/consensys/orchestrate/pkg/toolkit/ethclient/rpc"
 	"github.com/consensys/orchestrate/pkg/utils"
 )
@@ -21,8 +21,8 @@ func initComponent(ctx context.Context) {
 	utils.InParallel(
 		// Initialize Ethereum Client
 		func() { ethclient.Init(ctx) },
-		// Initialize Sync Producer
-		func() { broker.InitSyncProducer(ctx) },
+		// Initialize message broker
+		func() { multi.Init(ctx) },
 		// Initialize transaction scheduler client
 		func() { txscheduler.Init() },
 		func() { ristretto.Init(ctx) },
@@ -37,9 +37,10 @@ func Init(ctx context.Context) {
 		hook = NewHook(
 			NewConfig(),
 			ethclient.GlobalClient(),
-			broker.GlobalSyncProducer(),
+			multi.GlobalBroker().Producer(),
 			txscheduler.GlobalClient(),
 			ristretto.GlobalClient(),
+			NewWebhookClient(),
 		)
 	})
 }
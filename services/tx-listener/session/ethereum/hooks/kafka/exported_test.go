@@ -7,14 +7,15 @@ import (
 	"testing"
 
 	"github.com/Shopify/sarama/mocks"
-	broker "github.com/consensys/orchestrate/pkg/broker/sarama"
+	"github.com/consensys/orchestrate/pkg/broker/multi"
+	pkgsarama "github.com/consensys/orchestrate/pkg/broker/sarama"
 
 	"github.com/stretchr/testify/assert"
 )
 
 func TestInit(t *testing.T) {
 	producer := &mocks.SyncProducer{}
-	broker.SetGlobalSyncProducer(producer)
+	multi.SetGlobalBroker(pkgsarama.NewBroker(nil, producer, nil))
 
 	Init(context.Background())
 	assert.NotNil(t, GlobalHook(), "Global should have been set")
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
+	"sync"
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/pkg/toolkit/cache"
@@ -15,8 +17,8 @@ import (
 	"github.com/consensys/orchestrate/pkg/utils/envelope"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
-	"github.com/Shopify/sarama"
-	encoding "github.com/consensys/orchestrate/pkg/encoding/sarama"
+	"github.com/consensys/orchestrate/pkg/broker"
+	encoding "github.com/consensys/orchestrate/pkg/encoding/proto"
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/ethereum/abi"
 	sdk "github.com/consensys/orchestrate/pkg/sdk/client"
@@ -35,29 +37,55 @@ import (
 const component = "tx-listener.session.ethereum.hook"
 
 type Hook struct {
-	conf     *Config
-	ec       ethclient.MultiClient
-	producer sarama.SyncProducer
-	client   sdk.OrchestrateClient
-	cache    cache.Manager
-	logger   *log.Logger
+	conf       *Config
+	ec         ethclient.MultiClient
+	producer   broker.Producer
+	client     sdk.OrchestrateClient
+	cache      cache.Manager
+	httpClient *http.Client
+	logger     *log.Logger
+
+	webhooks       chan *webhookDelivery
+	webhookWorkers sync.WaitGroup
+	closed         chan struct{}
+	closeOnce      sync.Once
 }
 
 func NewHook(
 	conf *Config,
 	ec ethclient.MultiClient,
-	producer sarama.SyncProducer,
+	producer broker.Producer,
 	client sdk.OrchestrateClient,
 	cMngr cache.Manager,
+	httpClient *http.Client,
 ) *Hook {
-	return &Hook{
-		conf:     conf,
-		ec:       ec,
-		producer: producer,
-		client:   client,
-		cache:    cMngr,
-		logger:   log.NewLogger().SetComponent(component),
+	hk := &Hook{
+		conf:       conf,
+		ec:         ec,
+		producer:   producer,
+		client:     client,
+		cache:      cMngr,
+		httpClient: httpClient,
+		logger:     log.NewLogger().SetComponent(component),
+		webhooks:   make(chan *webhookDelivery, webhookQueueSize),
+		closed:     make(chan struct{}),
 	}
+
+	hk.webhookWorkers.Add(webhookMaxWorkers)
+	for i := 0; i < webhookMaxWorkers; i++ {
+		go hk.runWebhookWorker()
+	}
+
+	return hk
+}
+
+// Close stops the webhook workers, interrupting the deliveries in progress and dropping the queued events
+func (hk *Hook) Close() error {
+	hk.closeOnce.Do(func() {
+		close(hk.closed)
+	})
+	hk.webhookWorkers.Wait()
+	return nil
 }
 
 func (hk *Hook) AfterNewBlock(ctx context.Context, c *dynamic.Chain, block *ethtypes.Block, jobs []*entities.Job) error {
@@ -65,6 +93,7 @@ func (hk *Hook) AfterNewBlock(ctx context.Context, c *dynamic.Chain, block *etht
 	logger := hk.logger.WithContext(blockLogCtx)
 
 	var txResponses []*tx.TxResponse
+	failedSmartAccountJobs := make(map[string]bool)
 	for _, job := range jobs {
 		receiptLogCtx := log.WithFields(blockLogCtx, log.Field("receipt_tx_hash", job.Receipt.TxHash))
 		// Register deployed contract
@@ -105,6 +134,23 @@ func (hk *Hook) AfterNewBlock(ctx context.Context, c *dynamic.Chain, block *etht
 			txResponse.Errors = []*ierror.Error{errors.FromError(err)}
 		}
 
+		if txResponse.Receipt.Status == 0 && isPublicTx(job) {
+			txResponse.Receipt.RevertReason, err = hk.decodeRevertReason(receiptLogCtx, c, job, block)
+			if errors.IsConnectionError(err) {
+				return err
+			}
+			if err != nil {
+				hk.logger.WithContext(receiptLogCtx).WithError(err).Warn("could not decode revert reason")
+			}
+		}
+
+		// The receipt of smart account jobs reports the status of the inner call rather than the outer transaction
+		if smartAccountCallFailed(job) {
+			txResponse.Receipt.Status = 0
+			txResponse.Errors = append(txResponse.Errors, errors.EthereumError("smart account call failed").ExtendComponent(component))
+			failedSmartAccountJobs[job.UUID] = true
+		}
+
 		txResponses = append(txResponses, txResponse)
 	}
 
@@ -118,8 +164,13 @@ func (hk *Hook) AfterNewBlock(ctx context.Context, c *dynamic.Chain, block *etht
 		txResponse := txResponse
 
 		updateReq := &api.UpdateJobRequest{
-			Status:  entities.StatusMined,
-			Message: fmt.Sprintf("transaction mined in block %v", block.NumberU64()),
+			Status:       entities.StatusMined,
+			Message:      fmt.Sprintf("transaction mined in block %v", block.NumberU64()),
+			RevertReason: txResponse.Receipt.GetRevertReason(),
+			Events:       txEvents(txResponse.Receipt),
+		}
+		if failedSmartAccountJobs[txResponse.GetJobUUID()] {
+			updateReq.Message = fmt.Sprintf("transaction mined in block %v, smart account call failed", block.NumberU64())
 		}
 
 		if txResponse.Receipt.EffectiveGasPrice != "" {
@@ -149,6 +200,13 @@ func (hk *Hook) AfterNewBlock(ctx context.Context, c *dynamic.Chain, block *etht
 		return err
 	}
 
+	tokenMsgs, err := hk.prepareTokenTransferMsgs(blockLogCtx, c, jobs)
+	if err != nil {
+		logger.WithError(err).Errorf("failed to prepare token transfer messages")
+		return err
+	}
+	msgs = append(msgs, tokenMsgs...)
+
 	// Produce messages in Apache Kafka
 	err = hk.produce(msgs)
 	if err != nil {
@@ -160,6 +218,71 @@ func (hk *Hook) AfterNewBlock(ctx context.Context, c *dynamic.Chain, block *etht
 	return nil
 }
 
+func (hk *Hook) AfterReorg(ctx context.Context, c *dynamic.Chain, ancestor uint64, jobs []*entities.Job) error {
+	reorgLogCtx := log.WithFields(ctx, log.Field("chain", c.UUID), log.Field("ancestor_block", ancestor))
+	logger := hk.logger.WithContext(reorgLogCtx)
+
+	var txResponses []*tx.TxResponse
+	for _, job := range jobs {
+		txResponses = append(txResponses, &tx.TxResponse{
+			Headers: map[string]string{
+				tx.EventHeader:         tx.ReorgedEventValue,
+				tx.AncestorBlockHeader: fmt.Sprintf("%d", ancestor),
+			},
+			Id:            job.ScheduleUUID,
+			JobUUID:       job.UUID,
+			ContextLabels: job.Labels,
+			Transaction: &types.Transaction{
+				From:   utils.StringerToString(job.Transaction.From),
+				Nonce:  utils.ValueToString(job.Transaction.Nonce),
+				To:     utils.StringerToString(job.Transaction.To),
+				TxHash: utils.StringerToString(job.Transaction.Hash),
+			},
+			Receipt: job.Receipt,
+			Chain:   c.Name,
+		})
+	}
+
+	// Move transactions back to "PENDING" so they are matched again once included in the canonical chain
+	// TODO: pass batch variable by environment variable
+	wp := workerpool.New(20)
+	for _, txResponse := range txResponses {
+		if txResponse.GetJobUUID() == "" {
+			continue
+		}
+		txResponse := txResponse
+
+		updateReq := &api.UpdateJobRequest{
+			Status: entities.StatusReorged,
+			Message: fmt.Sprintf("transaction removed from block %v by a chain reorganization (common ancestor %v)",
+				txResponse.Receipt.GetBlockNumber(), ancestor),
+		}
+
+		wp.Submit(func() {
+			_, err := hk.client.UpdateJob(ctx, txResponse.GetJobUUID(), updateReq)
+			if err != nil {
+				logger.WithError(err).Warnf("failed to update status of %s to REORGED", txResponse.GetJobUUID())
+			}
+		})
+	}
+	wp.StopWait()
+
+	msgs, err := hk.prepareEnvelopeMsgs(txResponses, hk.conf.OutTopic, c.UUID)
+	if err != nil {
+		logger.WithError(err).Errorf("failed to prepare messages")
+		return err
+	}
+
+	err = hk.produce(msgs)
+	if err != nil {
+		logger.WithError(err).Errorf("failed to produce message")
+		return err
+	}
+
+	logger.WithField("jobs", len(jobs)).Warn("chain reorganization processed")
+	return nil
+}
+
 func (hk *Hook) decodeReceipt(ctx context.Context, c *dynamic.Chain, receipt *types.Receipt) error {
 	hk.logger.WithContext(ctx).Debug("decoding receipt...")
 	var contractAddress *ethcommon.Address
@@ -170,74 +293,12 @@ func (hk *Hook) decodeReceipt(ctx context.Context, c *dynamic.Chain, receipt *ty
 			return errors.DependencyFailureError("invalid receipt (no topics in log)")
 		}
 
-		logger := hk.logger.WithContext(ctx).WithField("sig_hash", utils.ShortString(l.Topics[0], 5)).
-			WithField("address", l.GetAddress()).WithField("indexed", uint32(len(l.Topics)-1))
-
-		logger.Debug("decoding receipt logs")
-		sigHash := hexutil.MustDecode(l.Topics[0])
-
-		var eventResp = &api.GetContractEventsBySignHashResponse{}
-		cKey := fmt.Sprintf("GetContractEvents/%s/%s/%s/%d", l.GetAddress(), c.ChainID, l.Topics[0], uint32(len(l.Topics)-1))
-
-		err := hk.GetOrCallAndSet(ctx, cKey, eventResp, func() (interface{}, error) {
-			return hk.client.GetContractEvents(
-				ctx,
-				l.GetAddress(),
-				c.ChainID,
-				&api.GetContractEventsRequest{
-					SigHash:           sigHash,
-					IndexedInputCount: uint32(len(l.Topics) - 1),
-				},
-			)
-		})
-
+		event, mapping, err := hk.decodeLog(ctx, c, l)
 		if err != nil {
-			if errors.IsNotFoundError(err) {
-				continue
-			}
-
-			logger.WithError(err).Error("failed to decode receipt logs")
 			return err
 		}
 
-		if eventResp.Event == "" && len(eventResp.DefaultEvents) == 0 {
-			logger.WithError(err).Trace("could not retrieve event ABI")
-			continue
-		}
-
-		var mapping map[string]string
-		event := &ethAbi.Event{}
-
-		if eventResp.Event != "" {
-			err = json.Unmarshal([]byte(eventResp.Event), event)
-			if err != nil {
-				logger.WithError(err).
-					Warnf("could not unmarshal event ABI provided by the Contract Registry, txHash: %s sigHash: %s, ", l.GetTxHash(), l.GetTopics()[0])
-				continue
-			}
-			mapping, err = abi.Decode(event, l)
-		} else {
-			for _, potentialEvent := range eventResp.DefaultEvents {
-				// Try to unmarshal
-				err = json.Unmarshal([]byte(potentialEvent), event)
-				if err != nil {
-					// If it fails to unmarshal, try the next potential event
-					logger.WithError(err).Tracef("could not unmarshal potential event ABI, txHash: %s sigHash: %s, ", l.GetTxHash(), l.GetTopics()[0])
-					continue
-				}
-
-				// Try to decode
-				mapping, err = abi.Decode(event, l)
-				if err == nil {
-					// As the decoding is successful, stop looping
-					break
-				}
-			}
-		}
-
-		if err != nil {
-			// As all potentialEvents fail to unmarshal, go to the next log
-			logger.WithError(err).Tracef("could not unmarshal potential event ABI, txHash: %s sigHash: %s, ", l.GetTxHash(), l.GetTopics()[0])
+		if event == nil {
 			continue
 		}
 
@@ -249,9 +310,6 @@ func (hk *Hook) decodeReceipt(ctx context.Context, c *dynamic.Chain, receipt *ty
 		// Set decoded data on log
 		l.DecodedData = mapping
 		l.Event = GetAbi(event)
-
-		logger.WithField("receipt_log", fmt.Sprintf("%v", mapping)).
-			Debug("log decoded")
 	}
 
 	// It will only execute in cae of external txs
@@ -285,6 +343,85 @@ func (hk *Hook) decodeReceipt(ctx context.Context, c *dynamic.Chain, receipt *ty
 	return nil
 }
 
+// decodeLog decodes a log using the event ABIs known by the contract registry, a nil event is returned when the log cannot be decoded
+func (hk *Hook) decodeLog(ctx context.Context, c *dynamic.Chain, l *types.Log) (*ethAbi.Event, map[string]string, error) {
+	logger := hk.logger.WithContext(ctx).WithField("sig_hash", utils.ShortString(l.Topics[0], 5)).
+		WithField("address", l.GetAddress()).WithField("indexed", uint32(len(l.Topics)-1))
+
+	logger.Debug("decoding receipt logs")
+	sigHash := hexutil.MustDecode(l.Topics[0])
+
+	var eventResp = &api.GetContractEventsBySignHashResponse{}
+	cKey := fmt.Sprintf("GetContractEvents/%s/%s/%s/%d", l.GetAddress(), c.ChainID, l.Topics[0], uint32(len(l.Topics)-1))
+
+	err := hk.GetOrCallAndSet(ctx, cKey, eventResp, func() (interface{}, error) {
+		return hk.client.GetContractEvents(
+			ctx,
+			l.GetAddress(),
+			c.ChainID,
+			&api.GetContractEventsRequest{
+				SigHash:           sigHash,
+				IndexedInputCount: uint32(len(l.Topics) - 1),
+			},
+		)
+	})
+
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil, nil
+		}
+
+		logger.WithError(err).Error("failed to decode receipt logs")
+		return nil, nil, err
+	}
+
+	if eventResp.Event == "" && len(eventResp.DefaultEvents) == 0 {
+		logger.WithError(err).Trace("could not retrieve event ABI")
+		return nil, nil, nil
+	}
+
+	var mapping map[string]string
+	event := &ethAbi.Event{}
+
+	if eventResp.Event != "" {
+		err = json.Unmarshal([]byte(eventResp.Event), event)
+		if err != nil {
+			logger.WithError(err).
+				Warnf("could not unmarshal event ABI provided by the Contract Registry, txHash: %s sigHash: %s, ", l.GetTxHash(), l.GetTopics()[0])
+			return nil, nil, nil
+		}
+		mapping, err = abi.Decode(event, l)
+	} else {
+		for _, potentialEvent := range eventResp.DefaultEvents {
+			// Try to unmarshal
+			err = json.Unmarshal([]byte(potentialEvent), event)
+			if err != nil {
+				// If it fails to unmarshal, try the next potential event
+				logger.WithError(err).Tracef("could not unmarshal potential event ABI, txHash: %s sigHash: %s, ", l.GetTxHash(), l.GetTopics()[0])
+				continue
+			}
+
+			// Try to decode
+			mapping, err = abi.Decode(event, l)
+			if err == nil {
+				// As the decoding is successful, stop looping
+				break
+			}
+		}
+	}
+
+	if err != nil {
+		// As all potentialEvents fail to unmarshal, go to the next log
+		logger.WithError(err).Tracef("could not unmarshal potential event ABI, txHash: %s sigHash: %s, ", l.GetTxHash(), l.GetTopics()[0])
+		return nil, nil, nil
+	}
+
+	logger.WithField("receipt_log", fmt.Sprintf("%v", mapping)).
+		Debug("log decoded")
+
+	return event, mapping, nil
+}
+
 // GetAbi creates a string ABI (format EventName(argType1, argType2)) from an event
 func GetAbi(e *ethAbi.Event) string {
 	inputs := make([]string, len(e.Inputs))
@@ -357,8 +494,8 @@ func (hk *Hook) registerDeployedContract(ctx context.Context, c *dynamic.Chain,
 	return nil
 }
 
-func (hk *Hook) prepareEnvelopeMsgs(evlps []*tx.TxResponse, topic, key string) ([]*sarama.ProducerMessage, error) {
-	var msgs []*sarama.ProducerMessage
+func (hk *Hook) prepareEnvelopeMsgs(evlps []*tx.TxResponse, topic, key string) ([]*broker.Message, error) {
+	var msgs []*broker.Message
 	for _, e := range evlps {
 		msg, err := hk.prepareMsg(e, topic, key)
 		if err != nil {
@@ -370,24 +507,25 @@ func (hk *Hook) prepareEnvelopeMsgs(evlps []*tx.TxResponse, topic, key string) (
 	return msgs, nil
 }
 
-func (hk *Hook) prepareMsg(pb proto.Message, topic, key string) (*sarama.ProducerMessage, error) {
-	msg := &sarama.ProducerMessage{}
+func (hk *Hook) prepareMsg(pb proto.Message, topic, key string) (*broker.Message, error) {
+	msg := &broker.Message{}
 
-	err := encoding.Marshal(pb, msg)
+	b, err := encoding.Marshal(pb)
 	if err != nil {
-		return nil, err
+		return nil, errors.FromError(err).ExtendComponent(component)
 	}
+	msg.Value = b
 
 	// Set topic to TxDecoder
 	msg.Topic = topic
 
 	// Set Message key to chain UUID
-	msg.Key = sarama.StringEncoder(key)
+	msg.Key = []byte(key)
 
 	return msg, nil
 }
 
-func (hk *Hook) produce(msgs []*sarama.ProducerMessage) error {
+func (hk *Hook) produce(msgs []*broker.Message) error {
 	return hk.producer.SendMessages(msgs)
 }
 
@@ -0,0 +1,90 @@
+package kafka
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/abi"
+	ethclientutils "github.com/consensys/orchestrate/pkg/toolkit/ethclient/utils"
+	"github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	types "github.com/consensys/orchestrate/pkg/types/ethereum"
+	"github.com/consensys/orchestrate/services/tx-listener/dynamic"
+	eth "github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// decodeRevertReason replays a failed public transaction with eth_call at the state of the parent block and decodes
+// the reason of its revert. Custom errors are decoded against the ABI of the contract registered at the recipient
+// address. An empty reason is returned when the replayed call does not revert
+func (hk *Hook) decodeRevertReason(ctx context.Context, c *dynamic.Chain, job *entities.Job, block *ethtypes.Block) (string, error) {
+	logger := hk.logger.WithContext(ctx)
+	logger.Debug("replaying failed transaction...")
+
+	call := &eth.CallMsg{
+		To:   job.Transaction.To,
+		Data: job.Transaction.Data,
+	}
+	if job.Transaction.From != nil {
+		call.From = *job.Transaction.From
+	}
+	if job.Transaction.Value != nil {
+		call.Value = job.Transaction.Value.ToInt()
+	}
+	if job.Transaction.Gas != nil {
+		call.Gas = *job.Transaction.Gas
+	}
+
+	parentNumber := new(big.Int).Sub(block.Number(), big.NewInt(1))
+	_, err := hk.ec.CallContract(ctx, c.URL, call, parentNumber)
+	if err == nil {
+		logger.Debug("replayed transaction did not revert")
+		return "", nil
+	}
+	if !errors.IsRevertedError(err) {
+		return "", err
+	}
+
+	data := ethclientutils.RevertData(err)
+	reason, err := abi.DecodeRevertReason(data)
+	if errors.IsNotFoundError(err) && job.Transaction.To != nil {
+		var contract = &api.ContractResponse{}
+		err = hk.GetOrCallAndSet(ctx, "SearchContract/"+job.Transaction.To.String(), contract, func() (interface{}, error) {
+			return hk.client.SearchContract(ctx, &api.SearchContractRequest{Address: job.Transaction.To})
+		})
+		if err == nil {
+			reason, err = abi.DecodeRevertReason(data, contract.ABI)
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+
+	logger.WithField("reason", reason).Debug("revert reason decoded")
+	return reason, nil
+}
+
+// txEvents returns the logs of a receipt that could be decoded
+func txEvents(receipt *types.Receipt) []*entities.TxEvent {
+	var events []*entities.TxEvent
+	for _, l := range receipt.GetLogs() {
+		if l.GetEvent() == "" {
+			continue
+		}
+
+		events = append(events, &entities.TxEvent{
+			Address:     ethcommon.HexToAddress(l.GetAddress()),
+			Signature:   l.GetEvent(),
+			DecodedData: l.GetDecodedData(),
+			LogIndex:    l.GetIndex(),
+		})
+	}
+
+	return events
+}
+
+func isPublicTx(job *entities.Job) bool {
+	return job.Type == entities.EthereumTransaction || job.Type == entities.EthereumRawTransaction
+}
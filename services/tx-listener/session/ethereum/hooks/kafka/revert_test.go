@@ -0,0 +1,119 @@
+// +build unit
+
+package kafka
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/Shopify/sarama/mocks"
+	pkgsarama "github.com/consensys/orchestrate/pkg/broker/sarama"
+	"github.com/consensys/orchestrate/pkg/errors"
+	sdkmock "github.com/consensys/orchestrate/pkg/sdk/client/mock"
+	noncache "github.com/consensys/orchestrate/pkg/toolkit/cache/noncache"
+	ethclientmock "github.com/consensys/orchestrate/pkg/toolkit/ethclient/mock"
+	ethclientutils "github.com/consensys/orchestrate/pkg/toolkit/ethclient/utils"
+	"github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	types "github.com/consensys/orchestrate/pkg/types/ethereum"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/services/tx-listener/dynamic"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Error(string) revert data of "insufficient balance"
+const errorStringRevertData = "0x08c379a000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000014696e73756666696369656e742062616c616e6365000000000000000000000000"
+
+// InsufficientBalance(uint256,uint256) revert data with available 10 and required 20
+const customErrorRevertData = "0xcf479181000000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000000000000000000000000000000000000000014"
+
+func TestHook_DecodeRevertReason(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	chain := &dynamic.Chain{UUID: "chain-uuid", Name: "chain-name", ChainID: "888", URL: "http://node:8545"}
+	block := ethtypes.NewBlockWithHeader(&ethtypes.Header{Number: big.NewInt(10)})
+
+	ec := ethclientmock.NewMockMultiClient(ctrl)
+	client := sdkmock.NewMockOrchestrateClient(ctrl)
+	hk := NewHook(&Config{}, ec, pkgsarama.NewProducer(mocks.NewSyncProducer(t, nil)), client, noncache.NewNonCacheManager(), http.DefaultClient)
+
+	revertedError := func(data string) error {
+		err := errors.RevertedError("execution reverted")
+		err.Extra = map[string]string{ethclientutils.RevertDataKey: data}
+		return err
+	}
+
+	t.Run("should replay the transaction at the parent block and decode the revert reason", func(t *testing.T) {
+		job := testutils.FakeJob()
+		ec.EXPECT().CallContract(gomock.Any(), chain.URL, gomock.Any(), big.NewInt(9)).Return(nil, revertedError(errorStringRevertData))
+
+		reason, err := hk.decodeRevertReason(ctx, chain, job, block)
+
+		require.NoError(t, err)
+		assert.Equal(t, "insufficient balance", reason)
+	})
+
+	t.Run("should decode custom errors against the ABI of the recipient contract", func(t *testing.T) {
+		job := testutils.FakeJob()
+		ec.EXPECT().CallContract(gomock.Any(), chain.URL, gomock.Any(), big.NewInt(9)).Return(nil, revertedError(customErrorRevertData))
+		client.EXPECT().SearchContract(gomock.Any(), &api.SearchContractRequest{Address: job.Transaction.To}).
+			Return(&api.ContractResponse{
+				ABI: `[{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]}]`,
+			}, nil)
+
+		reason, err := hk.decodeRevertReason(ctx, chain, job, block)
+
+		require.NoError(t, err)
+		assert.Equal(t, "InsufficientBalance(available: 10, required: 20)", reason)
+	})
+
+	t.Run("should return an empty reason if the replayed transaction does not revert", func(t *testing.T) {
+		ec.EXPECT().CallContract(gomock.Any(), chain.URL, gomock.Any(), big.NewInt(9)).Return([]byte{}, nil)
+
+		reason, err := hk.decodeRevertReason(ctx, chain, testutils.FakeJob(), block)
+
+		require.NoError(t, err)
+		assert.Empty(t, reason)
+	})
+
+	t.Run("should fail with the same error if the replay fails", func(t *testing.T) {
+		expectedErr := errors.EthConnectionError("error")
+		ec.EXPECT().CallContract(gomock.Any(), chain.URL, gomock.Any(), big.NewInt(9)).Return(nil, expectedErr)
+
+		_, err := hk.decodeRevertReason(ctx, chain, testutils.FakeJob(), block)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestTxEvents(t *testing.T) {
+	address := ethcommon.HexToAddress("0x1abae27a0cbfb02945720425d3b80c7e09728534")
+	receipt := &types.Receipt{
+		Logs: []*types.Log{
+			{
+				Address:     address.Hex(),
+				Event:       "Transfer(address,address,uint256)",
+				DecodedData: map[string]string{"value": "1000"},
+				Index:       2,
+			},
+			{Address: address.Hex()},
+		},
+	}
+
+	events := txEvents(receipt)
+
+	assert.Equal(t, []*entities.TxEvent{{
+		Address:     address,
+		Signature:   "Transfer(address,address,uint256)",
+		DecodedData: map[string]string{"value": "1000"},
+		LogIndex:    2,
+	}}, events)
+}
@@ -0,0 +1,60 @@
+package kafka
+
+import (
+	"github.com/consensys/orchestrate/pkg/ethereum/smartaccounts"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// smartAccountCallFailed indicates whether the call wrapped by the outer transaction of a smart account job failed,
+// the outer transaction of a Safe or a bundle of UserOperations being mined successfully even if the inner call reverts
+func smartAccountCallFailed(job *entities.Job) bool {
+	if job.InternalData == nil || job.InternalData.SmartAccount == nil || job.Receipt == nil {
+		return false
+	}
+
+	smartAccount := job.InternalData.SmartAccount
+	for _, l := range job.Receipt.GetLogs() {
+		if len(l.GetTopics()) == 0 {
+			continue
+		}
+
+		topics := make([]ethcommon.Hash, len(l.GetTopics()))
+		for i, topic := range l.GetTopics() {
+			topics[i] = ethcommon.HexToHash(topic)
+		}
+		address := ethcommon.HexToAddress(l.GetAddress())
+
+		switch smartAccount.Type {
+		case entities.SafeSmartAccountType:
+			if address != smartAccount.Address {
+				continue
+			}
+			if topics[0] == smartaccounts.SafeExecutionFailureTopic {
+				return true
+			}
+			if topics[0] == smartaccounts.SafeExecutionSuccessTopic {
+				return false
+			}
+		case entities.ERC4337SmartAccountType:
+			if smartAccount.EntryPoint == nil || address != *smartAccount.EntryPoint || job.Transaction.Hash == nil {
+				continue
+			}
+
+			data, err := hexutil.Decode(l.GetData())
+			if err != nil {
+				continue
+			}
+
+			event, err := smartaccounts.DecodeUserOperationEvent(topics, data)
+			if err != nil || event == nil || event.UserOpHash != *job.Transaction.Hash {
+				continue
+			}
+
+			return !event.Success
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,96 @@
+// +build unit
+
+package kafka
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/ethereum/smartaccounts"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	types "github.com/consensys/orchestrate/pkg/types/ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSmartAccountCallFailed(t *testing.T) {
+	smartAccountAddress := ethcommon.HexToAddress("0x1abae27a0cbfb02945720425d3b80c7e09728534")
+	signer := ethcommon.HexToAddress("0x5bd1b3b1d4bd1e0e7b8e8e27a1c5dd7e9b1bf8c3")
+	entryPoint := ethcommon.HexToAddress("0x5ff137d4b0fdcd49dca30c7cf57e578a026d2789")
+	userOpHash := ethcommon.HexToHash("0x0a0cafa26ca3f411e6629e9e02c53f23713b0033d7a72e534136104b5447a210")
+
+	newJob := func(smartAccount *entities.SmartAccount, logs ...*types.Log) *entities.Job {
+		return &entities.Job{
+			UUID:         "job-uuid",
+			Transaction:  &entities.ETHTransaction{Hash: &userOpHash},
+			InternalData: &entities.InternalData{SmartAccount: smartAccount},
+			Receipt:      &types.Receipt{Status: 1, Logs: logs},
+		}
+	}
+
+	safeAccount := &entities.SmartAccount{Type: entities.SafeSmartAccountType, Address: smartAccountAddress, Signer: signer}
+	safeLog := func(topic ethcommon.Hash) *types.Log {
+		return &types.Log{Address: smartAccountAddress.Hex(), Topics: []string{topic.Hex()}}
+	}
+
+	erc4337Account := &entities.SmartAccount{
+		Type:       entities.ERC4337SmartAccountType,
+		Address:    smartAccountAddress,
+		Signer:     signer,
+		EntryPoint: &entryPoint,
+	}
+	userOpLog := func(hash ethcommon.Hash, success bool) *types.Log {
+		successWord := big.NewInt(0)
+		if success {
+			successWord = big.NewInt(1)
+		}
+
+		var data []byte
+		for _, word := range []*big.Int{big.NewInt(1), successWord, big.NewInt(1000), big.NewInt(50000)} {
+			data = append(data, ethcommon.BigToHash(word).Bytes()...)
+		}
+
+		return &types.Log{
+			Address: entryPoint.Hex(),
+			Topics:  []string{smartaccounts.UserOperationEventTopic.Hex(), hash.Hex(), smartAccountAddress.Hash().Hex(), ethcommon.Hash{}.Hex()},
+			Data:    hexutil.Encode(data),
+		}
+	}
+
+	t.Run("should report failed Safe executions", func(t *testing.T) {
+		assert.True(t, smartAccountCallFailed(newJob(safeAccount, safeLog(smartaccounts.SafeExecutionFailureTopic))))
+	})
+
+	t.Run("should report successful Safe executions", func(t *testing.T) {
+		assert.False(t, smartAccountCallFailed(newJob(safeAccount, safeLog(smartaccounts.SafeExecutionSuccessTopic))))
+	})
+
+	t.Run("should ignore Safe events emitted by other contracts", func(t *testing.T) {
+		l := safeLog(smartaccounts.SafeExecutionFailureTopic)
+		l.Address = signer.Hex()
+
+		assert.False(t, smartAccountCallFailed(newJob(safeAccount, l)))
+	})
+
+	t.Run("should report failed user operations", func(t *testing.T) {
+		assert.True(t, smartAccountCallFailed(newJob(erc4337Account, userOpLog(userOpHash, false))))
+	})
+
+	t.Run("should report successful user operations", func(t *testing.T) {
+		assert.False(t, smartAccountCallFailed(newJob(erc4337Account, userOpLog(userOpHash, true))))
+	})
+
+	t.Run("should ignore other user operations of the bundle", func(t *testing.T) {
+		otherUserOp := userOpLog(ethcommon.HexToHash("0xabc"), false)
+
+		assert.False(t, smartAccountCallFailed(newJob(erc4337Account, otherUserOp, userOpLog(userOpHash, true))))
+	})
+
+	t.Run("should ignore jobs not sent by smart accounts", func(t *testing.T) {
+		job := newJob(nil, safeLog(smartaccounts.SafeExecutionFailureTopic))
+		job.InternalData = nil
+
+		assert.False(t, smartAccountCallFailed(job))
+	})
+}
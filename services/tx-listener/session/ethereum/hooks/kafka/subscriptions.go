@@ -0,0 +1,158 @@
+package kafka
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/consensys/orchestrate/pkg/broker"
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	types "github.com/consensys/orchestrate/pkg/types/ethereum"
+	"github.com/consensys/orchestrate/services/tx-listener/dynamic"
+	hooks "github.com/consensys/orchestrate/services/tx-listener/session/ethereum/hooks"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// SignatureHeader is the HTTP header holding the HMAC-SHA256 signature of the events posted to webhooks
+const SignatureHeader = "X-Orchestrate-Signature"
+
+const webhookMaxElapsedTime = 30 * time.Second
+
+func (hk *Hook) AfterNewLogs(ctx context.Context, c *dynamic.Chain, block *ethtypes.Block, logs []*hooks.SubscriptionLog) error {
+	blockLogCtx := log.WithFields(ctx, log.Field("chain", c.UUID), log.Field("block_number", block.Number().String()))
+	logger := hk.logger.WithContext(blockLogCtx)
+
+	var msgs []*broker.Message
+	for _, subLog := range logs {
+		event, err := hk.newSubscriptionEvent(blockLogCtx, c, subLog)
+		if err != nil {
+			return err
+		}
+
+		body, err := json.Marshal(event)
+		if err != nil {
+			logger.WithError(err).Error("failed to marshal subscription event")
+			return errors.EncodingError(err.Error()).ExtendComponent(component)
+		}
+
+		target := subLog.Subscription.Target
+		switch target.Type {
+		case entities.KafkaSubscriptionTargetType:
+			msgs = append(msgs, &broker.Message{
+				Topic: target.Topic,
+				Key:   []byte(subLog.Subscription.UUID),
+				Value: body,
+			})
+		case entities.WebhookSubscriptionTargetType:
+			// Webhooks are delivered in the background so a slow or failing webhook never blocks the listener, the
+			// delivery is only logged
+			delivery := &webhookDelivery{
+				ctx:              blockLogCtx,
+				subscriptionUUID: subLog.Subscription.UUID,
+				target:           target,
+				body:             body,
+			}
+			if !hk.enqueueWebhook(delivery) {
+				logger.WithField("subscription", delivery.subscriptionUUID).Error("webhook queue is full, event dropped")
+			}
+		}
+	}
+
+	if len(msgs) > 0 {
+		err := hk.produce(msgs)
+		if err != nil {
+			logger.WithError(err).Errorf("failed to produce subscription events")
+			return err
+		}
+	}
+
+	logger.WithField("logs", len(logs)).Debug("subscription logs processed")
+	return nil
+}
+
+func (hk *Hook) newSubscriptionEvent(ctx context.Context, c *dynamic.Chain, subLog *hooks.SubscriptionLog) (*api.SubscriptionEvent, error) {
+	l := subLog.Log
+	event := &api.SubscriptionEvent{
+		SubscriptionUUID: subLog.Subscription.UUID,
+		ChainUUID:        c.UUID,
+		Event:            subLog.Subscription.EventSignature,
+		Address:          l.Address,
+		Topics:           l.Topics,
+		Data:             l.Data,
+		BlockNumber:      l.BlockNumber,
+		BlockHash:        l.BlockHash,
+		TxHash:           l.TxHash,
+		TxIndex:          l.TxIndex,
+		LogIndex:         l.Index,
+	}
+
+	abiEvent, mapping, err := hk.decodeLog(ctx, c, types.FromGethLog(l))
+	if err != nil {
+		return nil, err
+	}
+
+	if abiEvent != nil {
+		event.Event = GetAbi(abiEvent)
+		event.DecodedData = mapping
+	}
+
+	return event, nil
+}
+
+func (hk *Hook) postWebhook(ctx context.Context, target *entities.SubscriptionTarget, body []byte) error {
+	bckOff := backoff.NewExponentialBackOff()
+	bckOff.MaxElapsedTime = webhookMaxElapsedTime
+
+	return backoff.RetryNotify(
+		func() error {
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.URL, bytes.NewReader(body))
+			if err != nil {
+				return backoff.Permanent(err)
+			}
+
+			req.Header.Set("Content-Type", "application/json")
+			for key, value := range target.Headers {
+				req.Header.Set(key, value)
+			}
+			if target.Secret != "" {
+				req.Header.Set(SignatureHeader, Sign(target.Secret, body))
+			}
+
+			resp, err := hk.httpClient.Do(req)
+			if err != nil {
+				return err
+			}
+			defer resp.Body.Close()
+
+			switch {
+			case resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests:
+				return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+			case resp.StatusCode >= http.StatusMultipleChoices:
+				return backoff.Permanent(fmt.Errorf("webhook responded with status %d", resp.StatusCode))
+			}
+
+			return nil
+		},
+		backoff.WithContext(bckOff, ctx),
+		func(err error, duration time.Duration) {
+			hk.logger.WithContext(ctx).WithError(err).Warnf("failed to post event to webhook, retrying in %v...", duration)
+		},
+	)
+}
+
+// Sign computes the hex encoded HMAC-SHA256 of a payload
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,198 @@
+// +build unit
+
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/Shopify/sarama/mocks"
+	pkgsarama "github.com/consensys/orchestrate/pkg/broker/sarama"
+	"github.com/consensys/orchestrate/pkg/errors"
+	mock2 "github.com/consensys/orchestrate/pkg/sdk/client/mock"
+	cachemocks "github.com/consensys/orchestrate/pkg/toolkit/cache/mocks"
+	"github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/services/tx-listener/dynamic"
+	hooks "github.com/consensys/orchestrate/services/tx-listener/session/ethereum/hooks"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHook_AfterNewLogs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mock2.NewMockOrchestrateClient(ctrl)
+	cacheMngr := cachemocks.NewMockManager(ctrl)
+	chain := &dynamic.Chain{UUID: "chain-uuid", ChainID: "888"}
+	block := ethtypes.NewBlockWithHeader(&ethtypes.Header{Number: big.NewInt(10)})
+	ctx := context.Background()
+
+	newSubscriptionLog := func(target *entities.SubscriptionTarget) *hooks.SubscriptionLog {
+		sub := testutils.FakeSubscription()
+		sub.Target = target
+
+		return &hooks.SubscriptionLog{
+			Subscription: sub,
+			Log: &ethtypes.Log{
+				Address:     sub.ContractAddress,
+				Topics:      []ethcommon.Hash{sub.EventTopic()},
+				BlockNumber: 10,
+			},
+		}
+	}
+
+	t.Run("should produce event to the Kafka topic of the subscription", func(t *testing.T) {
+		producer := mocks.NewSyncProducer(t, nil)
+		hk := NewHook(&Config{}, nil, pkgsarama.NewProducer(producer), client, cacheMngr, http.DefaultClient)
+		subLog := newSubscriptionLog(&entities.SubscriptionTarget{
+			Type:  entities.KafkaSubscriptionTargetType,
+			Topic: "topic-events",
+		})
+
+		cacheMngr.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, false)
+		client.EXPECT().GetContractEvents(gomock.Any(), subLog.Log.Address.Hex(), chain.ChainID, gomock.Any()).
+			Return(nil, errors.NotFoundError("error"))
+		producer.ExpectSendMessageWithCheckerFunctionAndSucceed(func(val []byte) error {
+			event := &api.SubscriptionEvent{}
+			err := json.Unmarshal(val, event)
+			assert.NoError(t, err)
+			assert.Equal(t, subLog.Subscription.UUID, event.SubscriptionUUID)
+			assert.Equal(t, chain.UUID, event.ChainUUID)
+			assert.Equal(t, subLog.Subscription.EventSignature, event.Event)
+			return nil
+		})
+
+		err := hk.AfterNewLogs(ctx, chain, block, []*hooks.SubscriptionLog{subLog})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should post signed event to the webhook of the subscription", func(t *testing.T) {
+		var received *http.Request
+		var body []byte
+		delivered := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			received = req
+			body, _ = ioutil.ReadAll(req.Body)
+			rw.WriteHeader(http.StatusOK)
+			close(delivered)
+		}))
+		defer server.Close()
+
+		hk := NewHook(&Config{}, nil, pkgsarama.NewProducer(mocks.NewSyncProducer(t, nil)), client, cacheMngr, server.Client())
+		subLog := newSubscriptionLog(&entities.SubscriptionTarget{
+			Type:    entities.WebhookSubscriptionTargetType,
+			URL:     server.URL,
+			Secret:  "my-secret",
+			Headers: map[string]string{"Authorization": "Bearer token"},
+		})
+
+		cacheMngr.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, false)
+		client.EXPECT().GetContractEvents(gomock.Any(), subLog.Log.Address.Hex(), chain.ChainID, gomock.Any()).
+			Return(nil, errors.NotFoundError("error"))
+
+		err := hk.AfterNewLogs(ctx, chain, block, []*hooks.SubscriptionLog{subLog})
+
+		assert.NoError(t, err)
+		select {
+		case <-delivered:
+		case <-time.After(5 * time.Second):
+			t.Fatal("webhook was not delivered")
+		}
+		require.NotNil(t, received)
+		assert.Equal(t, http.MethodPost, received.Method)
+		assert.Equal(t, "Bearer token", received.Header.Get("Authorization"))
+		assert.Equal(t, Sign("my-secret", body), received.Header.Get(SignatureHeader))
+	})
+
+	t.Run("should drop events once the webhook queue is full", func(t *testing.T) {
+		hk := NewHook(&Config{}, nil, pkgsarama.NewProducer(mocks.NewSyncProducer(t, nil)), client, cacheMngr, http.DefaultClient)
+		// Workers are stopped so queued events are never consumed
+		_ = hk.Close()
+		hk.closed = make(chan struct{})
+		for i := 0; i < webhookQueueSize; i++ {
+			require.True(t, hk.enqueueWebhook(&webhookDelivery{ctx: ctx}))
+		}
+
+		subLog := newSubscriptionLog(&entities.SubscriptionTarget{
+			Type: entities.WebhookSubscriptionTargetType,
+			URL:  "http://webhook",
+		})
+		cacheMngr.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, false)
+		client.EXPECT().GetContractEvents(gomock.Any(), subLog.Log.Address.Hex(), chain.ChainID, gomock.Any()).
+			Return(nil, errors.NotFoundError("error"))
+
+		err := hk.AfterNewLogs(ctx, chain, block, []*hooks.SubscriptionLog{subLog})
+
+		assert.NoError(t, err)
+		assert.Len(t, hk.webhooks, webhookQueueSize)
+	})
+
+	t.Run("should not deliver events once the session or the hook is stopped", func(t *testing.T) {
+		posted := make(chan struct{}, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			posted <- struct{}{}
+			rw.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		hk := NewHook(&Config{}, nil, pkgsarama.NewProducer(mocks.NewSyncProducer(t, nil)), client, cacheMngr, server.Client())
+		target := &entities.SubscriptionTarget{Type: entities.WebhookSubscriptionTargetType, URL: server.URL}
+
+		sessionCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		hk.deliverWebhook(&webhookDelivery{ctx: sessionCtx, target: target})
+
+		require.NoError(t, hk.Close())
+		assert.False(t, hk.enqueueWebhook(&webhookDelivery{ctx: ctx, target: target}))
+		assert.Empty(t, posted)
+	})
+
+	t.Run("should fail if producer fails", func(t *testing.T) {
+		producer := mocks.NewSyncProducer(t, nil)
+		hk := NewHook(&Config{}, nil, pkgsarama.NewProducer(producer), client, cacheMngr, http.DefaultClient)
+		subLog := newSubscriptionLog(&entities.SubscriptionTarget{
+			Type:  entities.KafkaSubscriptionTargetType,
+			Topic: "topic-events",
+		})
+
+		cacheMngr.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, false)
+		client.EXPECT().GetContractEvents(gomock.Any(), subLog.Log.Address.Hex(), chain.ChainID, gomock.Any()).
+			Return(nil, errors.NotFoundError("error"))
+		producer.ExpectSendMessageAndFail(sarama.ErrOutOfBrokers)
+
+		err := hk.AfterNewLogs(ctx, chain, block, []*hooks.SubscriptionLog{subLog})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestNewWebhookClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	t.Run("should refuse to post to a loopback address", func(t *testing.T) {
+		_, err := NewWebhookClient().Post(server.URL, "application/json", nil)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestSign(t *testing.T) {
+	assert.Equal(t, "b82fcb791acec57859b989b430a826488ce2e479fdf92326bd0a2e8375a42ba4", Sign("secret", []byte("payload")))
+}
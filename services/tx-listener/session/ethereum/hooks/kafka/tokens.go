@@ -0,0 +1,76 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/consensys/orchestrate/pkg/broker"
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/tokens"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/formatters"
+	"github.com/consensys/orchestrate/services/tx-listener/dynamic"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// prepareTokenTransferMsgs prepares a message for every ERC-20, ERC-721 and ERC-1155 token transfer emitted by the
+// mined transactions, logs which cannot be decoded are skipped
+func (hk *Hook) prepareTokenTransferMsgs(ctx context.Context, c *dynamic.Chain, jobs []*entities.Job) ([]*broker.Message, error) {
+	if hk.conf.TokenTransfersTopic == "" {
+		return nil, nil
+	}
+
+	logger := hk.logger.WithContext(ctx)
+
+	var msgs []*broker.Message
+	for _, job := range jobs {
+		for _, l := range job.Receipt.GetLogs() {
+			if l.GetRemoved() || len(l.GetTopics()) == 0 {
+				continue
+			}
+
+			topics := make([]ethcommon.Hash, len(l.GetTopics()))
+			for i, topic := range l.GetTopics() {
+				topics[i] = ethcommon.HexToHash(topic)
+			}
+
+			data, err := hexutil.Decode(l.GetData())
+			if err != nil {
+				data = []byte{}
+			}
+
+			transfers, err := tokens.DecodeTransfers(ethcommon.HexToAddress(l.GetAddress()), topics, data)
+			if err != nil {
+				logger.WithError(err).WithField("tx_hash", job.Receipt.TxHash).WithField("log_index", l.GetIndex()).
+					Warn("could not decode token transfer")
+				continue
+			}
+
+			for _, transfer := range transfers {
+				msg := formatters.FormatTokenTransferMessage(transfer)
+				msg.ChainUUID = c.UUID
+				msg.ChainName = c.Name
+				msg.JobUUID = job.UUID
+				msg.BlockNumber = job.Receipt.BlockNumber
+				msg.BlockHash = ethcommon.HexToHash(job.Receipt.BlockHash)
+				msg.TxHash = ethcommon.HexToHash(job.Receipt.TxHash)
+				msg.LogIndex = l.GetIndex()
+
+				body, err := json.Marshal(msg)
+				if err != nil {
+					logger.WithError(err).Error("failed to marshal token transfer")
+					return nil, errors.EncodingError(err.Error()).ExtendComponent(component)
+				}
+
+				msgs = append(msgs, &broker.Message{
+					Topic: hk.conf.TokenTransfersTopic,
+					Key:   []byte(c.UUID),
+					Value: body,
+				})
+			}
+		}
+	}
+
+	return msgs, nil
+}
@@ -0,0 +1,105 @@
+// +build unit
+
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/Shopify/sarama/mocks"
+	pkgsarama "github.com/consensys/orchestrate/pkg/broker/sarama"
+	"github.com/consensys/orchestrate/pkg/ethereum/tokens"
+	"github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	types "github.com/consensys/orchestrate/pkg/types/ethereum"
+	"github.com/consensys/orchestrate/services/tx-listener/dynamic"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHook_PrepareTokenTransferMsgs(t *testing.T) {
+	chain := &dynamic.Chain{UUID: "chain-uuid", Name: "chain-name", ChainID: "888"}
+	ctx := context.Background()
+
+	token := ethcommon.HexToAddress("0x1abae27a0cbfb02945720425d3b80c7e09728534")
+	from := ethcommon.HexToAddress("0x5bd1b3b1d4bd1e0e7b8e8e27a1c5dd7e9b1bf8c3")
+	to := ethcommon.HexToAddress("0xdbb881a51cd4023e4400cef3ef73046743f08da3")
+
+	newJob := func(logs ...*types.Log) *entities.Job {
+		return &entities.Job{
+			UUID: "job-uuid",
+			Receipt: &types.Receipt{
+				TxHash:      "0x0a0cafa26ca3f411e6629e9e02c53f23713b0033d7a72e534136104b5447a210",
+				BlockHash:   "0x6f0c4c8a7d6d2d8a0b3e5e6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f",
+				BlockNumber: 10,
+				Logs:        logs,
+			},
+		}
+	}
+
+	transferLog := &types.Log{
+		Address: token.Hex(),
+		Topics: []string{
+			tokens.TransferTopic.Hex(),
+			from.Hash().Hex(),
+			to.Hash().Hex(),
+		},
+		Data:  hexutil.Encode(ethcommon.BigToHash(big.NewInt(1000)).Bytes()),
+		Index: 2,
+	}
+
+	t.Run("should prepare a message for every token transfer", func(t *testing.T) {
+		hk := NewHook(&Config{TokenTransfersTopic: "topic-token-transfers"}, nil, pkgsarama.NewProducer(mocks.NewSyncProducer(t, nil)), nil, nil, http.DefaultClient)
+		otherLog := &types.Log{
+			Address: token.Hex(),
+			Topics:  []string{ethcommon.HexToHash("0x01").Hex()},
+		}
+
+		msgs, err := hk.prepareTokenTransferMsgs(ctx, chain, []*entities.Job{newJob(otherLog, transferLog)})
+
+		require.NoError(t, err)
+		require.Len(t, msgs, 1)
+		assert.Equal(t, "topic-token-transfers", msgs[0].Topic)
+
+		msg := &api.TokenTransferMessage{}
+		require.NoError(t, json.Unmarshal(msgs[0].Value, msg))
+		assert.Equal(t, entities.ERC20TokenStandard, msg.Standard)
+		assert.Equal(t, chain.UUID, msg.ChainUUID)
+		assert.Equal(t, chain.Name, msg.ChainName)
+		assert.Equal(t, "job-uuid", msg.JobUUID)
+		assert.Equal(t, token, msg.Token)
+		assert.Equal(t, from, msg.From)
+		assert.Equal(t, to, msg.To)
+		assert.Equal(t, "0x3e8", msg.Amount.String())
+		assert.Equal(t, uint64(10), msg.BlockNumber)
+		assert.Equal(t, uint64(2), msg.LogIndex)
+	})
+
+	t.Run("should skip transfers which cannot be decoded", func(t *testing.T) {
+		hk := NewHook(&Config{TokenTransfersTopic: "topic-token-transfers"}, nil, pkgsarama.NewProducer(mocks.NewSyncProducer(t, nil)), nil, nil, http.DefaultClient)
+		invalidLog := &types.Log{
+			Address: token.Hex(),
+			Topics:  transferLog.Topics,
+			Data:    "0x01",
+		}
+
+		msgs, err := hk.prepareTokenTransferMsgs(ctx, chain, []*entities.Job{newJob(invalidLog)})
+
+		require.NoError(t, err)
+		assert.Empty(t, msgs)
+	})
+
+	t.Run("should not prepare messages if no topic is configured", func(t *testing.T) {
+		hk := NewHook(&Config{}, nil, pkgsarama.NewProducer(mocks.NewSyncProducer(t, nil)), nil, nil, http.DefaultClient)
+
+		msgs, err := hk.prepareTokenTransferMsgs(ctx, chain, []*entities.Job{newJob(transferLog)})
+
+		require.NoError(t, err)
+		assert.Empty(t, msgs)
+	})
+}
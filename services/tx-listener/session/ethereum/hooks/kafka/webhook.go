@@ -0,0 +1,116 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/utils"
+)
+
+const (
+	webhookTimeout    = 10 * time.Second
+	webhookMaxWorkers = 20
+	// Events waiting for a worker are bounded so slow webhooks cannot grow the memory of the listener
+	webhookQueueSize = 1000
+)
+
+type webhookDelivery struct {
+	ctx              context.Context
+	subscriptionUUID string
+	target           *entities.SubscriptionTarget
+	body             []byte
+}
+
+// NewWebhookClient creates the HTTP client posting events to webhooks. The destination is checked once resolved, so
+// webhooks can never reach loopback, private or link-local addresses, and redirects are not followed
+func NewWebhookClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   webhookTimeout,
+		KeepAlive: 30 * time.Second,
+		Control:   checkWebhookDestination,
+	}
+
+	return &http.Client{
+		Timeout: webhookTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			MaxIdleConnsPerHost: webhookMaxWorkers,
+			IdleConnTimeout:     90 * time.Second,
+			TLSHandshakeTimeout: webhookTimeout,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func checkWebhookDestination(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	if !utils.IsPublicIP(net.ParseIP(host)) {
+		return fmt.Errorf("webhook destination %s is not allowed", host)
+	}
+
+	return nil
+}
+
+// enqueueWebhook queues an event for the webhook workers, the event is dropped if the hook is closed or the queue is full
+func (hk *Hook) enqueueWebhook(delivery *webhookDelivery) bool {
+	select {
+	case <-hk.closed:
+		return false
+	default:
+	}
+
+	select {
+	case hk.webhooks <- delivery:
+		return true
+	default:
+		return false
+	}
+}
+
+func (hk *Hook) runWebhookWorker() {
+	defer hk.webhookWorkers.Done()
+	for {
+		select {
+		case <-hk.closed:
+			return
+		case delivery := <-hk.webhooks:
+			hk.deliverWebhook(delivery)
+		}
+	}
+}
+
+func (hk *Hook) deliverWebhook(delivery *webhookDelivery) {
+	logger := hk.logger.WithContext(delivery.ctx).WithField("subscription", delivery.subscriptionUUID)
+
+	// The session of the chain stopped while the event was waiting for a worker
+	if delivery.ctx.Err() != nil {
+		logger.Error("session stopped, event not delivered to webhook")
+		return
+	}
+
+	// Retries are interrupted when either the session or the hook stops
+	ctx, cancel := context.WithCancel(delivery.ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-hk.closed:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	if err := hk.postWebhook(ctx, delivery.target, delivery.body); err != nil {
+		logger.WithError(err).Error("failed to deliver event to webhook")
+	}
+}
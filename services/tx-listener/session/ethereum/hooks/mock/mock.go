@@ -6,37 +6,39 @@ package mock
 
 import (
 	context "context"
-	types "github.com/ethereum/go-ethereum/core/types"
-	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+
 	entities "github.com/consensys/orchestrate/pkg/types/entities"
 	dynamic "github.com/consensys/orchestrate/services/tx-listener/dynamic"
-	reflect "reflect"
+	hook "github.com/consensys/orchestrate/services/tx-listener/session/ethereum/hooks"
+	types "github.com/ethereum/go-ethereum/core/types"
+	gomock "github.com/golang/mock/gomock"
 )
 
-// MockHook is a mock of Hook interface
+// MockHook is a mock of Hook interface.
 type MockHook struct {
 	ctrl     *gomock.Controller
 	recorder *MockHookMockRecorder
 }
 
-// MockHookMockRecorder is the mock recorder for MockHook
+// MockHookMockRecorder is the mock recorder for MockHook.
 type MockHookMockRecorder struct {
 	mock *MockHook
 }
 
-// NewMockHook creates a new mock instance
+// NewMockHook creates a new mock instance.
 func NewMockHook(ctrl *gomock.Controller) *MockHook {
 	mock := &MockHook{ctrl: ctrl}
 	mock.recorder = &MockHookMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockHook) EXPECT() *MockHookMockRecorder {
 	return m.recorder
 }
 
-// AfterNewBlock mocks base method
+// AfterNewBlock mocks base method.
 func (m *MockHook) AfterNewBlock(ctx context.Context, chain *dynamic.Chain, block *types.Block, jobs []*entities.Job) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AfterNewBlock", ctx, chain, block, jobs)
@@ -44,8 +46,50 @@ func (m *MockHook) AfterNewBlock(ctx context.Context, chain *dynamic.Chain, bloc
 	return ret0
 }
 
-// AfterNewBlock indicates an expected call of AfterNewBlock
+// AfterNewBlock indicates an expected call of AfterNewBlock.
 func (mr *MockHookMockRecorder) AfterNewBlock(ctx, chain, block, jobs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterNewBlock", reflect.TypeOf((*MockHook)(nil).AfterNewBlock), ctx, chain, block, jobs)
 }
+
+// AfterNewLogs mocks base method.
+func (m *MockHook) AfterNewLogs(ctx context.Context, chain *dynamic.Chain, block *types.Block, logs []*hook.SubscriptionLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterNewLogs", ctx, chain, block, logs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterNewLogs indicates an expected call of AfterNewLogs.
+func (mr *MockHookMockRecorder) AfterNewLogs(ctx, chain, block, logs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterNewLogs", reflect.TypeOf((*MockHook)(nil).AfterNewLogs), ctx, chain, block, logs)
+}
+
+// AfterReorg mocks base method.
+func (m *MockHook) AfterReorg(ctx context.Context, chain *dynamic.Chain, ancestor uint64, jobs []*entities.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterReorg", ctx, chain, ancestor, jobs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterReorg indicates an expected call of AfterReorg.
+func (mr *MockHookMockRecorder) AfterReorg(ctx, chain, ancestor, jobs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterReorg", reflect.TypeOf((*MockHook)(nil).AfterReorg), ctx, chain, ancestor, jobs)
+}
+
+// Close mocks base method.
+func (m *MockHook) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockHookMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockHook)(nil).Close))
+}
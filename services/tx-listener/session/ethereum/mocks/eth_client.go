@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	ethereum "github.com/consensys/orchestrate/pkg/types/ethereum"
+	ethereum0 "github.com/ethereum/go-ethereum"
 	common "github.com/ethereum/go-ethereum/common"
 	types "github.com/ethereum/go-ethereum/core/types"
 	gomock "github.com/golang/mock/gomock"
@@ -248,6 +249,21 @@ func (mr *MockEthClientMockRecorder) TransactionReceipt(ctx, url, txHash interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionReceipt", reflect.TypeOf((*MockEthClient)(nil).TransactionReceipt), ctx, url, txHash)
 }
 
+// FilterLogs mocks base method
+func (m *MockEthClient) FilterLogs(ctx context.Context, url string, q ethereum0.FilterQuery) ([]types.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterLogs", ctx, url, q)
+	ret0, _ := ret[0].([]types.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterLogs indicates an expected call of FilterLogs
+func (mr *MockEthClientMockRecorder) FilterLogs(ctx, url, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterLogs", reflect.TypeOf((*MockEthClient)(nil).FilterLogs), ctx, url, q)
+}
+
 // PrivEEANonce mocks base method
 func (m *MockEthClient) PrivEEANonce(ctx context.Context, endpoint string, account common.Address, privateFrom string, privateFor []string) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,107 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// maxParentHashMismatches is the number of consecutive blocks which parent hash does not match while the last processed
+// block is still canonical, after which block hashes are considered not verifiable on the chain
+const maxParentHashMismatches = 3
+
+// checkReorg verifies that the block extends the last processed one. On parent hash mismatch, it walks back to the
+// common ancestor, rolls back the jobs mined in orphaned blocks and returns an error so the session restarts from the ancestor
+func (s *Session) checkReorg(ctx context.Context, block *ethtypes.Block) error {
+	last := s.history.last()
+	if last == nil || last.number+1 != block.NumberU64() {
+		return nil
+	}
+
+	if last.hash == block.ParentHash() {
+		s.parentHashMismatches = 0
+		return nil
+	}
+
+	// Block hashes cannot be verified on this chain, detection resumes as soon as a parent hash matches again
+	if s.parentHashMismatches >= maxParentHashMismatches {
+		return nil
+	}
+
+	logger := s.logger.WithField("block_number", block.NumberU64())
+	ancestor, depth, err := s.findCommonAncestor(ctx)
+	if err != nil {
+		logger.WithError(err).Error("failed to find common ancestor")
+		return err
+	}
+
+	if depth == 0 {
+		// Last processed block is still canonical, either the node returned an inconsistent response or it does not
+		// compute block hashes as go-ethereum does. The latter is the case of BFT consensus algorithms (IBFT, QBFT)
+		// which provide immediate finality, so the block is only accepted once the mismatch has been confirmed
+		s.parentHashMismatches++
+		if s.parentHashMismatches < maxParentHashMismatches {
+			logger.WithField("mismatches", s.parentHashMismatches).Warn("parent hash does not match last processed block")
+			return errors.InvalidStateError("parent hash of block %d does not match last processed block, retrying", block.NumberU64())
+		}
+
+		logger.Info("block hashes cannot be verified on this chain, skipping chain reorganization detection")
+		return nil
+	}
+
+	logger = logger.WithField("ancestor", ancestor).WithField("depth", depth)
+	logger.Warn("chain reorganization detected")
+
+	var jobs []*entities.Job
+	for i := 0; i < depth; i++ {
+		for _, job := range s.history.recent(i).jobs {
+			if job != nil {
+				jobs = append(jobs, job)
+			}
+		}
+	}
+
+	err = s.hook.AfterReorg(ctx, s.Chain, ancestor, jobs)
+	if err != nil {
+		logger.WithError(err).Error("failed to roll back reorganized jobs")
+		return err
+	}
+
+	err = s.offsets.SetLastBlockNumber(ctx, s.Chain, ancestor)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < depth; i++ {
+		s.history.pop()
+	}
+
+	return errors.InvalidStateError("chain reorganization detected at block %d, restarting from block %d", block.NumberU64(), ancestor+1)
+}
+
+// findCommonAncestor returns the latest processed block still part of the canonical chain and the number of orphaned blocks
+func (s *Session) findCommonAncestor(ctx context.Context) (ancestor uint64, depth int, err error) {
+	for depth = 0; depth < s.history.len(); depth++ {
+		b := s.history.recent(depth)
+		header, err := s.ec.HeaderByNumber(ctx, s.Chain.URL, new(big.Int).SetUint64(b.number))
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if header.Hash() == b.hash {
+			return b.number, depth, nil
+		}
+	}
+
+	// Reorganization is deeper than the block history, we restart from the oldest known block
+	oldest := s.history.recent(depth - 1)
+	s.logger.WithField("block_number", oldest.number).Error("chain reorganization is deeper than block history")
+	if oldest.number > 0 {
+		ancestor = oldest.number - 1
+	}
+
+	return ancestor, depth, nil
+}
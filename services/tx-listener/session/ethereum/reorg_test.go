@@ -0,0 +1,139 @@
+// +build unit
+
+package ethereum
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	mock4 "github.com/consensys/orchestrate/pkg/sdk/client/mock"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/services/tx-listener/dynamic"
+	mock5 "github.com/consensys/orchestrate/services/tx-listener/metrics/mock"
+	"github.com/consensys/orchestrate/services/tx-listener/session/ethereum/hooks/mock"
+	mock3 "github.com/consensys/orchestrate/services/tx-listener/session/ethereum/mocks"
+	mock2 "github.com/consensys/orchestrate/services/tx-listener/session/ethereum/offset/mock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockHistory(t *testing.T) {
+	history := newBlockHistory(3)
+	assert.Nil(t, history.last())
+
+	for i := uint64(1); i <= 4; i++ {
+		history.push(&processedBlock{number: i})
+	}
+
+	assert.Equal(t, 3, history.len())
+	assert.Equal(t, uint64(4), history.last().number)
+	assert.Equal(t, uint64(2), history.recent(2).number)
+
+	assert.Equal(t, uint64(4), history.pop().number)
+	assert.Equal(t, uint64(3), history.last().number)
+	assert.Equal(t, 2, history.len())
+
+	history.push(&processedBlock{number: 4})
+	assert.Equal(t, uint64(4), history.last().number)
+	assert.Equal(t, uint64(2), history.recent(2).number)
+}
+
+func TestSession_CheckReorg(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockHook := mock.NewMockHook(ctrl)
+	mockOffsetManager := mock2.NewMockManager(ctrl)
+	mockEthClient := mock3.NewMockEthClient(ctrl)
+	mockClient := mock4.NewMockOrchestrateClient(ctrl)
+	mockMetrics := mock5.NewMockListenerMetrics(ctrl)
+
+	chain := &dynamic.Chain{
+		UUID:     "chainUUID",
+		URL:      "chainURL",
+		Listener: dynamic.Listener{ExternalTxEnabled: utils.ToPtr(false).(*bool)},
+	}
+
+	header1 := &types.Header{Number: big.NewInt(1)}
+	header2 := &types.Header{Number: big.NewInt(2), ParentHash: header1.Hash()}
+	orphanedHeader2 := &types.Header{Number: big.NewInt(2), ParentHash: header1.Hash(), Extra: []byte("orphaned")}
+
+	newSessionWithHistory := func() (*Session, *entities.Job) {
+		session := NewSession(chain, mockEthClient, nil, mockClient, mockHook, mockOffsetManager, mockMetrics)
+		job := testutils.FakeJob()
+		session.history.push(&processedBlock{number: 1, hash: header1.Hash()})
+		session.history.push(&processedBlock{number: 2, hash: orphanedHeader2.Hash(), jobs: []*entities.Job{job}})
+		return session, job
+	}
+
+	t.Run("should do nothing if block extends the last processed one", func(t *testing.T) {
+		session := NewSession(chain, mockEthClient, nil, mockClient, mockHook, mockOffsetManager, mockMetrics)
+		session.history.push(&processedBlock{number: 2, hash: header2.Hash()})
+
+		err := session.checkReorg(ctx, types.NewBlockWithHeader(&types.Header{Number: big.NewInt(3), ParentHash: header2.Hash()}))
+		assert.NoError(t, err)
+	})
+
+	t.Run("should roll back jobs of orphaned blocks and restart from common ancestor", func(t *testing.T) {
+		session, job := newSessionWithHistory()
+
+		mockEthClient.EXPECT().HeaderByNumber(gomock.Any(), chain.URL, big.NewInt(2)).Return(header2, nil)
+		mockEthClient.EXPECT().HeaderByNumber(gomock.Any(), chain.URL, big.NewInt(1)).Return(header1, nil)
+		mockHook.EXPECT().AfterReorg(gomock.Any(), chain, uint64(1), []*entities.Job{job}).Return(nil)
+		mockOffsetManager.EXPECT().SetLastBlockNumber(gomock.Any(), chain, uint64(1)).Return(nil)
+
+		err := session.checkReorg(ctx, types.NewBlockWithHeader(&types.Header{Number: big.NewInt(3), ParentHash: header2.Hash()}))
+		assert.True(t, errors.IsInvalidStateError(err))
+		assert.Equal(t, uint64(1), session.history.last().number)
+	})
+
+	t.Run("should keep history if hook fails", func(t *testing.T) {
+		session, job := newSessionWithHistory()
+		expectedErr := errors.ConnectionError("error")
+
+		mockEthClient.EXPECT().HeaderByNumber(gomock.Any(), chain.URL, big.NewInt(2)).Return(header2, nil)
+		mockEthClient.EXPECT().HeaderByNumber(gomock.Any(), chain.URL, big.NewInt(1)).Return(header1, nil)
+		mockHook.EXPECT().AfterReorg(gomock.Any(), chain, uint64(1), []*entities.Job{job}).Return(expectedErr)
+
+		err := session.checkReorg(ctx, types.NewBlockWithHeader(&types.Header{Number: big.NewInt(3), ParentHash: header2.Hash()}))
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 2, session.history.len())
+	})
+
+	t.Run("should retry if last processed block is still canonical", func(t *testing.T) {
+		session, _ := newSessionWithHistory()
+		block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(3), ParentHash: common.HexToHash("0x1")})
+
+		mockEthClient.EXPECT().HeaderByNumber(gomock.Any(), chain.URL, big.NewInt(2)).Return(orphanedHeader2, nil)
+
+		err := session.checkReorg(ctx, block)
+		assert.True(t, errors.IsInvalidStateError(err))
+		assert.Equal(t, 2, session.history.len())
+	})
+
+	t.Run("should skip detection while block hashes cannot be verified and resume once they match", func(t *testing.T) {
+		session, _ := newSessionWithHistory()
+		block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(3), ParentHash: common.HexToHash("0x1")})
+
+		mockEthClient.EXPECT().HeaderByNumber(gomock.Any(), chain.URL, big.NewInt(2)).Return(orphanedHeader2, nil).
+			Times(maxParentHashMismatches)
+
+		for i := 1; i < maxParentHashMismatches; i++ {
+			assert.Error(t, session.checkReorg(ctx, block))
+		}
+		assert.NoError(t, session.checkReorg(ctx, block))
+
+		// No more header is fetched while hashes cannot be verified
+		assert.NoError(t, session.checkReorg(ctx, block))
+
+		assert.NoError(t, session.checkReorg(ctx, types.NewBlockWithHeader(&types.Header{Number: big.NewInt(3), ParentHash: orphanedHeader2.Hash()})))
+		assert.Equal(t, 0, session.parentHashMismatches)
+	})
+}
@@ -6,8 +6,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/consensys/orchestrate/pkg/ethereum/smartaccounts"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/pkg/types/entities"
+	types "github.com/consensys/orchestrate/pkg/types/ethereum"
+	"github.com/consensys/orchestrate/pkg/types/formatters"
 	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/orchestrate/services/tx-listener/metrics"
 
@@ -19,16 +22,26 @@ import (
 	"github.com/consensys/orchestrate/services/tx-listener/dynamic"
 	hook "github.com/consensys/orchestrate/services/tx-listener/session/ethereum/hooks"
 	"github.com/consensys/orchestrate/services/tx-listener/session/ethereum/offset"
+	eth "github.com/ethereum/go-ethereum"
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"google.golang.org/protobuf/proto"
 )
 
 const MaxTxHashesLength = 30
+
+// SubscriptionsRefreshInterval is the minimal delay between two fetches of the subscriptions registered on the chain
+const SubscriptionsRefreshInterval = 5 * time.Second
+
+// HeadSubscriptionRetryInterval is the minimal delay between two attempts to subscribe to new heads
+const HeadSubscriptionRetryInterval = 30 * time.Second
 const component = "tx-listener.session.ethereum"
 
 type Session struct {
 	Chain         *dynamic.Chain
 	ec            EthClient
+	subscriber    HeadSubscriber
 	client        orchestrateclient.OrchestrateClient
 	hook          hook.Hook
 	offsets       offset.Manager
@@ -40,11 +53,23 @@ type Session struct {
 	pendingJobMapMutex      *sync.RWMutex
 	pendingJobLastCheckedAt time.Time
 
+	subscriptions            []*entities.Subscription
+	subscriptionsMutex       *sync.Mutex
+	subscriptionsRefreshedAt time.Time
+
+	// Latest processed blocks used to detect chain reorganizations
+	history              *blockHistory
+	parentHashMismatches int
+
 	// Listening session
 	trigger                        chan struct{}
 	blockPosition                  uint64
 	eeaPrivPrecompiledContractAddr string
 	currentChainTip                uint64
+	// Subscription to the new heads of the chain, nil when new blocks are polled
+	headSub            eth.Subscription
+	heads              chan *ethtypes.Header
+	headSubAttemptedAt time.Time
 	// Channel stacking blocks waiting for receipts to be fetched
 	fetchedBlocks chan *Future
 	errors        chan error
@@ -54,6 +79,7 @@ type Session struct {
 func NewSession(
 	chain *dynamic.Chain,
 	ec EthClient,
+	subscriber HeadSubscriber,
 	client orchestrateclient.OrchestrateClient,
 	callHook hook.Hook,
 	offsets offset.Manager,
@@ -62,6 +88,7 @@ func NewSession(
 	return &Session{
 		Chain:              chain,
 		ec:                 ec,
+		subscriber:         subscriber,
 		client:             client,
 		hook:               callHook,
 		offsets:            offsets,
@@ -69,6 +96,8 @@ func NewSession(
 		metrics:            m,
 		pendingJobMap:      make(map[string]*entities.Job),
 		pendingJobMapMutex: &sync.RWMutex{},
+		subscriptionsMutex: &sync.Mutex{},
+		history:            newBlockHistory(BlockHistorySize),
 		metricsLabels: []string{
 			"chain_uuid", chain.UUID,
 		},
@@ -77,36 +106,40 @@ func NewSession(
 }
 
 type SessionBuilder struct {
-	hook    hook.Hook
-	offsets offset.Manager
-	ec      EthClient
-	client  orchestrateclient.OrchestrateClient
-	metrics metrics.ListenerMetrics
+	hook       hook.Hook
+	offsets    offset.Manager
+	ec         EthClient
+	subscriber HeadSubscriber
+	client     orchestrateclient.OrchestrateClient
+	metrics    metrics.ListenerMetrics
 }
 
 func NewSessionBuilder(
 	hk hook.Hook,
 	offsets offset.Manager,
 	ec EthClient,
+	subscriber HeadSubscriber,
 	client orchestrateclient.OrchestrateClient,
 	m metrics.ListenerMetrics,
 ) *SessionBuilder {
 	return &SessionBuilder{
-		hook:    hk,
-		offsets: offsets,
-		ec:      ec,
-		client:  client,
-		metrics: m,
+		hook:       hk,
+		offsets:    offsets,
+		ec:         ec,
+		subscriber: subscriber,
+		client:     client,
+		metrics:    m,
 	}
 }
 
 func (b *SessionBuilder) NewSession(chain *dynamic.Chain) (session.Session, error) {
-	return NewSession(chain, b.ec, b.client, b.hook, b.offsets, b.metrics), nil
+	return NewSession(chain, b.ec, b.subscriber, b.client, b.hook, b.offsets, b.metrics), nil
 }
 
 type fetchedBlock struct {
 	block *ethtypes.Block
 	jobs  []*entities.Job
+	logs  []*hook.SubscriptionLog
 }
 
 func (s *Session) Run(ctx context.Context) error {
@@ -229,6 +262,9 @@ func (s *Session) initPosition(ctx context.Context) error {
 func (s *Session) listen(ctx context.Context) {
 	s.logger.WithField("block_start", s.blockPosition).Info("starting fetch block listener")
 
+	s.setListenMode(metrics.PollingListenMode)
+	s.subscribeNewHeads(ctx)
+
 	ticker := time.NewTicker(s.Chain.Listener.Backoff)
 listeningLoop:
 	for {
@@ -241,7 +277,7 @@ listeningLoop:
 				s.fetchedBlocks <- s.fetchBlock(ctx, s.blockPosition)
 				s.blockPosition++
 				s.trig()
-			} else {
+			} else if s.headSub == nil || s.currentChainTip == 0 {
 				//  We are ahead of chain head so we update chain tip
 				tip, err := s.getChainTip(ctx)
 				if err != nil {
@@ -252,19 +288,85 @@ listeningLoop:
 					s.trig()
 				}
 			}
-		case <-ticker.C:
+		case head := <-s.heads:
+			if head.Number.Uint64() > s.currentChainTip {
+				s.logger.WithField("number", head.Number.Uint64()).Debug("new head received")
+				s.currentChainTip = head.Number.Uint64()
+				s.trig()
+			}
+		case err := <-s.headSubErr():
+			s.logger.WithError(err).Warn("new heads subscription dropped, polling new blocks")
+			s.unsubscribeNewHeads()
+			s.setListenMode(metrics.PollingListenMode)
 			s.trig()
+		case <-ticker.C:
+			// New blocks are pushed by the subscription
+			if s.headSub == nil {
+				s.subscribeNewHeads(ctx)
+				s.trig()
+			}
 		}
 	}
 
 	// Close channels
 	ticker.Stop()
+	s.unsubscribeNewHeads()
 	close(s.fetchedBlocks)
 
 	s.logger.WithField("block_stop", s.blockPosition).
 		Info("fetch block listener has been stopped")
 }
 
+// subscribeNewHeads subscribes to the new heads of the chain if it registers a WebSocket URL. Failed attempts are retried
+// after HeadSubscriptionRetryInterval, new blocks being polled meanwhile
+func (s *Session) subscribeNewHeads(ctx context.Context) {
+	if s.Chain.WSURL == "" || time.Since(s.headSubAttemptedAt) < HeadSubscriptionRetryInterval {
+		return
+	}
+	s.headSubAttemptedAt = time.Now()
+
+	heads := make(chan *ethtypes.Header, 16)
+	sub, err := s.subscriber.SubscribeNewHead(ctx, s.Chain.WSURL, heads)
+	if err != nil {
+		s.logger.WithError(err).Warn("failed to subscribe to new heads, polling new blocks")
+		return
+	}
+
+	s.headSub = sub
+	s.heads = heads
+	s.setListenMode(metrics.SubscriptionListenMode)
+	s.logger.Info("subscribed to new heads")
+}
+
+func (s *Session) unsubscribeNewHeads() {
+	if s.headSub == nil {
+		return
+	}
+
+	s.headSub.Unsubscribe()
+	s.headSub = nil
+	s.heads = nil
+}
+
+// headSubErr returns the error channel of the new heads subscription, a nil channel blocking forever if there is none
+func (s *Session) headSubErr() <-chan error {
+	if s.headSub == nil {
+		return nil
+	}
+
+	return s.headSub.Err()
+}
+
+func (s *Session) setListenMode(mode string) {
+	for _, m := range []string{metrics.PollingListenMode, metrics.SubscriptionListenMode} {
+		value := 0.0
+		if m == mode {
+			value = 1
+		}
+		s.metrics.ListenModeGauge().With("chain_uuid", s.Chain.UUID, "mode", m).Set(value)
+	}
+}
+
 func (s *Session) callHooks(ctx context.Context) {
 	var err error
 
@@ -298,11 +400,29 @@ func (s *Session) callHooks(ctx context.Context) {
 }
 
 func (s *Session) callHook(ctx context.Context, block *fetchedBlock) error {
-	err := s.hook.AfterNewBlock(ctx, s.Chain, block.block, block.jobs)
+	err := s.checkReorg(ctx, block.block)
 	if err != nil {
 		return err
 	}
 
+	err = s.hook.AfterNewBlock(ctx, s.Chain, block.block, block.jobs)
+	if err != nil {
+		return err
+	}
+
+	if len(block.logs) > 0 {
+		err = s.hook.AfterNewLogs(ctx, s.Chain, block.block, block.logs)
+		if err != nil {
+			return err
+		}
+	}
+
+	s.history.push(&processedBlock{
+		number: block.block.NumberU64(),
+		hash:   block.block.Hash(),
+		jobs:   block.jobs,
+	})
+
 	if block.block.NumberU64()%3 == 0 {
 		return s.offsets.SetLastBlockNumber(ctx, s.Chain, block.block.NumberU64())
 	}
@@ -369,10 +489,94 @@ func (s *Session) fetchBlock(ctx context.Context, blockPosition uint64) *Future
 			block.jobs = append(block.jobs, jobs...)
 		}
 
+		userOpJobs, err := s.matchUserOperations(ctx, block.block.Transactions(), jobMap)
+		if err != nil {
+			return nil, err
+		}
+		block.jobs = append(block.jobs, userOpJobs...)
+
+		block.logs, err = s.fetchSubscriptionLogs(ctx, block.block)
+		if err != nil {
+			return nil, err
+		}
+
 		return block, nil
 	})
 }
 
+func (s *Session) fetchSubscriptionLogs(ctx context.Context, block *ethtypes.Block) ([]*hook.SubscriptionLog, error) {
+	subscriptions, err := s.getSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(subscriptions) == 0 {
+		return nil, nil
+	}
+
+	var addresses []ethcommon.Address
+	for _, sub := range subscriptions {
+		addresses = append(addresses, sub.ContractAddress)
+	}
+
+	blockHash := block.Hash()
+	logs, err := s.ec.FilterLogs(ctx, s.Chain.URL, eth.FilterQuery{
+		BlockHash: &blockHash,
+		Addresses: addresses,
+	})
+	if err != nil {
+		s.logger.WithError(err).WithField("block", block.NumberU64()).Error("failed to fetch block logs")
+		return nil, err
+	}
+
+	var subLogs []*hook.SubscriptionLog
+	for idx := range logs {
+		for _, sub := range subscriptions {
+			if sub.Matches(&logs[idx]) {
+				subLogs = append(subLogs, &hook.SubscriptionLog{Subscription: sub, Log: &logs[idx]})
+			}
+		}
+	}
+
+	return subLogs, nil
+}
+
+func (s *Session) getSubscriptions(ctx context.Context) ([]*entities.Subscription, error) {
+	s.subscriptionsMutex.Lock()
+	defer s.subscriptionsMutex.Unlock()
+
+	if !s.subscriptionsRefreshedAt.IsZero() && time.Since(s.subscriptionsRefreshedAt) < SubscriptionsRefreshInterval {
+		return s.subscriptions, nil
+	}
+
+	subscriptionResponses, err := s.client.SearchSubscriptions(ctx, &entities.SubscriptionFilters{
+		ChainUUID: s.Chain.UUID,
+	})
+	if err != nil {
+		s.logger.WithError(err).Error("failed to fetch subscriptions")
+		return nil, err
+	}
+
+	s.subscriptions = make([]*entities.Subscription, 0, len(subscriptionResponses))
+	for _, subResponse := range subscriptionResponses {
+		s.subscriptions = append(s.subscriptions, &entities.Subscription{
+			UUID:            subResponse.UUID,
+			ChainUUID:       subResponse.ChainUUID,
+			ContractAddress: subResponse.ContractAddress,
+			EventSignature:  subResponse.EventSignature,
+			TopicFilters:    subResponse.TopicFilters,
+			Target:          subResponse.Target,
+			TenantID:        subResponse.TenantID,
+			OwnerID:         subResponse.OwnerID,
+			CreatedAt:       subResponse.CreatedAt,
+			UpdatedAt:       subResponse.UpdatedAt,
+		})
+	}
+	s.subscriptionsRefreshedAt = time.Now()
+
+	return s.subscriptions, nil
+}
+
 func (s *Session) matchPendingJobs(ctx context.Context, transactions ethtypes.Transactions) (map[string]*entities.Job, error) {
 	s.pendingJobMapMutex.Lock()
 	defer s.pendingJobMapMutex.Unlock()
@@ -431,6 +635,7 @@ func (s *Session) updatePendingJobs(ctx context.Context) error {
 			Type:         jobResponse.Type,
 			Labels:       jobResponse.Labels,
 			Transaction:  &jobResponse.Transaction,
+			InternalData: formatters.FormatAnnotationsToInternalData(jobResponse.Annotations),
 			CreatedAt:    jobResponse.CreatedAt,
 		}
 	}
@@ -438,6 +643,78 @@ func (s *Session) updatePendingJobs(ctx context.Context) error {
 	return nil
 }
 
+// matchUserOperations attaches the receipts of the bundles executed by the EntryPoints to the pending ERC-4337 jobs
+// they include, as those jobs are indexed by the hash of their UserOperation rather than by a transaction hash
+func (s *Session) matchUserOperations(ctx context.Context, transactions ethtypes.Transactions, jobMap map[string]*entities.Job) ([]*entities.Job, error) {
+	s.pendingJobMapMutex.RLock()
+	entryPoints := make(map[ethcommon.Address]bool)
+	for _, job := range s.pendingJobMap {
+		if job.InternalData != nil && job.InternalData.SmartAccount != nil && job.InternalData.SmartAccount.EntryPoint != nil {
+			entryPoints[*job.InternalData.SmartAccount.EntryPoint] = true
+		}
+	}
+	s.pendingJobMapMutex.RUnlock()
+
+	if len(entryPoints) == 0 {
+		return nil, nil
+	}
+
+	var jobs []*entities.Job
+	for _, blckTx := range transactions {
+		if blckTx.To() == nil || !entryPoints[*blckTx.To()] || isInternalTx(jobMap, blckTx) {
+			continue
+		}
+
+		receipt, err := s.ec.TransactionReceipt(ctx, s.Chain.URL, blckTx.Hash())
+		if err != nil {
+			s.logger.WithField("tx_hash", blckTx.Hash().Hex()).WithError(err).Error("failed to fetch bundle receipt")
+			return nil, err
+		}
+		receipt = receipt.
+			SetBlockHash(ethcommon.HexToHash(receipt.GetBlockHash())).
+			SetBlockNumber(receipt.GetBlockNumber()).
+			SetTxIndex(receipt.TxIndex)
+
+		for _, l := range receipt.GetLogs() {
+			if !entryPoints[ethcommon.HexToAddress(l.GetAddress())] || len(l.GetTopics()) == 0 {
+				continue
+			}
+
+			topics := make([]ethcommon.Hash, len(l.GetTopics()))
+			for i, topic := range l.GetTopics() {
+				topics[i] = ethcommon.HexToHash(topic)
+			}
+			data, err := hexutil.Decode(l.GetData())
+			if err != nil {
+				continue
+			}
+
+			event, err := smartaccounts.DecodeUserOperationEvent(topics, data)
+			if err != nil || event == nil {
+				continue
+			}
+
+			s.pendingJobMapMutex.Lock()
+			job, ok := s.pendingJobMap[event.UserOpHash.String()]
+			if ok {
+				delete(s.pendingJobMap, event.UserOpHash.String())
+			}
+			s.pendingJobMapMutex.Unlock()
+			if !ok {
+				continue
+			}
+
+			// Several UserOperations can be included in the same bundle
+			job.Receipt = proto.Clone(receipt).(*types.Receipt)
+			job.Receipt.ContractName = job.Transaction.ContractName
+			job.Receipt.ContractTag = job.Transaction.ContractTag
+			jobs = append(jobs, job)
+		}
+	}
+
+	return jobs, nil
+}
+
 // func (s *Session) fetchJobs(ctx context.Context, transactions ethtypes.Transactions) (map[string]*entities.Job, error) {
 // 	jobMap := make(map[string]*entities.Job)
 //
@@ -51,19 +51,25 @@ func TestSession_Run(t *testing.T) {
 	mockEthClient := mock3.NewMockEthClient(ctrl)
 	mockClient := mock4.NewMockOrchestrateClient(ctrl)
 	mockMetrics := mock5.NewMockListenerMetrics(ctrl)
+	mockHeadSubscriber := mock3.NewMockHeadSubscriber(ctrl)
 
 	blockCounter := mock6.NewMockCounter(ctrl)
 	blockCounter.EXPECT().With(gomock.Any()).AnyTimes().Return(blockCounter)
 	blockCounter.EXPECT().Add(gomock.Any()).AnyTimes()
 	mockMetrics.EXPECT().BlockCounter().AnyTimes().Return(blockCounter)
 
+	listenModeGauge := mock6.NewMockGauge(ctrl)
+	listenModeGauge.EXPECT().With(gomock.Any()).AnyTimes().Return(listenModeGauge)
+	listenModeGauge.EXPECT().Set(gomock.Any()).AnyTimes()
+	mockMetrics.EXPECT().ListenModeGauge().AnyTimes().Return(listenModeGauge)
+
 	t.Run("should process block successfully with internal txs", func(t *testing.T) {
 		cancellableCtx, cancel := context.WithCancel(ctx)
 		jobResponse := testutils.FakeJobResponse()
 		chain := newFakeChain()
 		block := newFakeBlock(newBlockPosition, toAddress)
 		jobResponse.Transaction.Hash = &txHash
-		session := NewSession(chain, mockEthClient, mockClient, mockHook, mockOffsetManager, mockMetrics)
+		session := NewSession(chain, mockEthClient, mockHeadSubscriber, mockClient, mockHook, mockOffsetManager, mockMetrics)
 		bckoff := &backoffmock.MockIntervalBackoff{}
 		session.bckOff = bckoff
 
@@ -126,7 +132,7 @@ func TestSession_Run(t *testing.T) {
 
 		block := types.NewBlock(&types.Header{Number: blockPosition}, txs, []*types.Header{}, []*types.Receipt{}, new(trie.Trie))
 		chain := newFakeChain()
-		session := NewSession(chain, mockEthClient, mockClient, mockHook, mockOffsetManager, mockMetrics)
+		session := NewSession(chain, mockEthClient, mockHeadSubscriber, mockClient, mockHook, mockOffsetManager, mockMetrics)
 		backoff := &backoffmock.MockIntervalBackoff{}
 		session.bckOff = backoff
 
@@ -186,7 +192,7 @@ func TestSession_Run(t *testing.T) {
 		chain := newFakeChain()
 		block := newFakeBlock(newBlockPosition, eeaPrivPrecompiledContractAddr)
 		jobResponse.Transaction.Hash = &txHashPrivate
-		session := NewSession(chain, mockEthClient, mockClient, mockHook, mockOffsetManager, mockMetrics)
+		session := NewSession(chain, mockEthClient, mockHeadSubscriber, mockClient, mockHook, mockOffsetManager, mockMetrics)
 		bckoff := &backoffmock.MockIntervalBackoff{}
 		session.bckOff = bckoff
 
@@ -232,7 +238,7 @@ func TestSession_Run(t *testing.T) {
 		block := newFakeBlock(newBlockPosition, toAddress)
 		chain := newFakeChain()
 		chain.Listener.ExternalTxEnabled = utils.ToPtr(true).(*bool)
-		session := NewSession(chain, mockEthClient, mockClient, mockHook, mockOffsetManager, mockMetrics)
+		session := NewSession(chain, mockEthClient, mockHeadSubscriber, mockClient, mockHook, mockOffsetManager, mockMetrics)
 		bckoff := &backoffmock.MockIntervalBackoff{}
 		session.bckOff = bckoff
 
@@ -278,7 +284,7 @@ func TestSession_Run(t *testing.T) {
 		block := newFakeBlock(newBlockPosition, eeaPrivPrecompiledContractAddr)
 		chain := newFakeChain()
 		chain.Listener.ExternalTxEnabled = utils.ToPtr(true).(*bool)
-		session := NewSession(chain, mockEthClient, mockClient, mockHook, mockOffsetManager, mockMetrics)
+		session := NewSession(chain, mockEthClient, mockHeadSubscriber, mockClient, mockHook, mockOffsetManager, mockMetrics)
 		bckoff := &backoffmock.MockIntervalBackoff{}
 		session.bckOff = bckoff
 
@@ -324,7 +330,7 @@ func TestSession_Run(t *testing.T) {
 		block := newFakeBlock(newBlockPosition, eeaPrivPrecompiledContractAddr)
 		chain := newFakeChain()
 		chain.Listener.ExternalTxEnabled = utils.ToPtr(true).(*bool)
-		session := NewSession(chain, mockEthClient, mockClient, mockHook, mockOffsetManager, mockMetrics)
+		session := NewSession(chain, mockEthClient, mockHeadSubscriber, mockClient, mockHook, mockOffsetManager, mockMetrics)
 		bckoff := &backoffmock.MockIntervalBackoff{}
 		session.bckOff = bckoff
 		err := errors.InvalidParameterError("private receipt not found")
@@ -369,7 +375,7 @@ func TestSession_Run(t *testing.T) {
 	t.Run("should fail and retry if GetLastBlockNumber fails", func(t *testing.T) {
 		cancellableCtx, cancel := context.WithCancel(ctx)
 		chain := newFakeChain()
-		session := NewSession(chain, mockEthClient, mockClient, mockHook, mockOffsetManager, mockMetrics)
+		session := NewSession(chain, mockEthClient, mockHeadSubscriber, mockClient, mockHook, mockOffsetManager, mockMetrics)
 		bckoff := &backoffmock.MockIntervalBackoff{}
 		session.bckOff = bckoff
 
@@ -397,7 +403,7 @@ func TestSession_Run(t *testing.T) {
 	t.Run("should fail and retry if HeaderByNumber fails", func(t *testing.T) {
 		cancellableCtx, cancel := context.WithCancel(ctx)
 		chain := newFakeChain()
-		session := NewSession(chain, mockEthClient, mockClient, mockHook, mockOffsetManager, mockMetrics)
+		session := NewSession(chain, mockEthClient, mockHeadSubscriber, mockClient, mockHook, mockOffsetManager, mockMetrics)
 		bckoff := &backoffmock.MockIntervalBackoff{}
 		session.bckOff = bckoff
 
@@ -427,7 +433,7 @@ func TestSession_Run(t *testing.T) {
 	t.Run("should not fail if BlockByNumber fails", func(t *testing.T) {
 		cancellableCtx, cancel := context.WithCancel(ctx)
 		chain := newFakeChain()
-		session := NewSession(chain, mockEthClient, mockClient, mockHook, mockOffsetManager, mockMetrics)
+		session := NewSession(chain, mockEthClient, mockHeadSubscriber, mockClient, mockHook, mockOffsetManager, mockMetrics)
 		bckoff := &backoffmock.MockIntervalBackoff{}
 		session.bckOff = bckoff
 
@@ -460,7 +466,7 @@ func TestSession_Run(t *testing.T) {
 		cancellableCtx, cancel := context.WithCancel(ctx)
 		chain := newFakeChain()
 		block := newFakeBlock(newBlockPosition, eeaPrivPrecompiledContractAddr)
-		session := NewSession(chain, mockEthClient, mockClient, mockHook, mockOffsetManager, mockMetrics)
+		session := NewSession(chain, mockEthClient, mockHeadSubscriber, mockClient, mockHook, mockOffsetManager, mockMetrics)
 		bckoff := &backoffmock.MockIntervalBackoff{}
 		session.bckOff = bckoff
 
@@ -501,7 +507,7 @@ func TestSession_Run(t *testing.T) {
 		chain := newFakeChain()
 		chain.Listener.ExternalTxEnabled = utils.ToPtr(true).(*bool)
 		block := newFakeBlock(newBlockPosition, eeaPrivPrecompiledContractAddr)
-		session := NewSession(chain, mockEthClient, mockClient, mockHook, mockOffsetManager, mockMetrics)
+		session := NewSession(chain, mockEthClient, mockHeadSubscriber, mockClient, mockHook, mockOffsetManager, mockMetrics)
 		bckoff := &backoffmock.MockIntervalBackoff{}
 		session.bckOff = bckoff
 
@@ -545,7 +551,7 @@ func TestSession_Run(t *testing.T) {
 		chain := newFakeChain()
 		chain.Listener.ExternalTxEnabled = utils.ToPtr(true).(*bool)
 		block := newFakeBlock(newBlockPosition, toAddress)
-		session := NewSession(chain, mockEthClient, mockClient, mockHook, mockOffsetManager, mockMetrics)
+		session := NewSession(chain, mockEthClient, mockHeadSubscriber, mockClient, mockHook, mockOffsetManager, mockMetrics)
 		bckoff := &backoffmock.MockIntervalBackoff{}
 		session.bckOff = bckoff
 
@@ -15,6 +15,7 @@ import (
 
 type TxListener struct {
 	manager session.SManager
+	hook    hook.Hook
 }
 
 func NewTxListener(
@@ -26,12 +27,13 @@ func NewTxListener(
 	m metrics.ListenerMetrics,
 ) *TxListener {
 	manager := session.NewManager(
-		ethereum.NewSessionBuilder(hk, offsets, ec, client, m),
+		ethereum.NewSessionBuilder(hk, offsets, ec, ethereum.NewWSHeadSubscriber(), client, m),
 		prvdr,
 	)
 
 	return &TxListener{
 		manager: manager,
+		hook:    hk,
 	}
 }
 
@@ -40,5 +42,5 @@ func (l *TxListener) Run(ctx context.Context) error {
 }
 
 func (l *TxListener) Close() error {
-	return nil
+	return l.hook.Close()
 }
@@ -3,35 +3,51 @@ package txsender
 import (
 	"context"
 	"fmt"
+	"math"
+	"reflect"
 	"time"
 
-	"github.com/Shopify/sarama"
 	"github.com/cenkalti/backoff/v4"
-	pkgsarama "github.com/consensys/orchestrate/pkg/broker/sarama"
+	"github.com/consensys/orchestrate/pkg/broker"
+	"github.com/consensys/orchestrate/pkg/broker/multi"
 	"github.com/consensys/orchestrate/pkg/errors"
 	api "github.com/consensys/orchestrate/pkg/sdk/client"
 	"github.com/consensys/orchestrate/pkg/toolkit/app"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/http"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/http/config/dynamic"
+	staticprovider "github.com/consensys/orchestrate/pkg/toolkit/app/http/configwatcher/provider/static"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	dbredis "github.com/consensys/orchestrate/pkg/toolkit/database/redis"
 	"github.com/consensys/orchestrate/pkg/toolkit/ethclient"
 	"github.com/consensys/orchestrate/services/tx-sender/service"
+	"github.com/consensys/orchestrate/services/tx-sender/service/controllers"
+	"github.com/consensys/orchestrate/services/tx-sender/store"
 	"github.com/consensys/orchestrate/services/tx-sender/store/memory"
 	"github.com/consensys/orchestrate/services/tx-sender/store/redis"
 	"github.com/consensys/orchestrate/services/tx-sender/tx-sender/builder"
 	"github.com/consensys/orchestrate/services/tx-sender/tx-sender/nonce"
 	keymanager "github.com/consensys/quorum-key-manager/pkg/client"
+	"github.com/gofrs/uuid"
 	"github.com/hashicorp/go-multierror"
+	healthz "github.com/heptiolabs/healthcheck"
+	traefikdynamic "github.com/traefik/traefik/v2/pkg/config/dynamic"
 )
 
 const component = "application"
 
+// nonceGapFillTimeout is the time after which a nonce gap still present is filled again
+const nonceGapFillTimeout = 5 * time.Minute
+
 type txSenderDaemon struct {
 	keyManagerClient keymanager.KeyManagerClient
 	jobClient        api.JobClient
+	chainClient      api.ChainClient
+	contractClient   api.ContractClient
 	ec               ethclient.MultiClient
-	nonceManager     nonce.Manager
-	consumerGroup    []sarama.ConsumerGroup
-	producer         sarama.SyncProducer
+	nonceManager     nonce.Reconciler
+	safeNonces       store.NonceSender
+	consumerGroup    []broker.ConsumerGroup
+	producer         broker.Producer
 	config           *Config
 	logger           *log.Logger
 	cancel           context.CancelFunc
@@ -39,35 +55,62 @@ type txSenderDaemon struct {
 
 func NewTxSender(
 	config *Config,
-	consumerGroup []sarama.ConsumerGroup,
-	producer sarama.SyncProducer,
+	consumerGroup []broker.ConsumerGroup,
+	msgBroker broker.Broker,
 	keyManagerClient keymanager.KeyManagerClient,
 	apiClient api.OrchestrateClient,
 	ec ethclient.MultiClient,
 	redisCli *dbredis.Client,
 ) (*app.App, error) {
-	appli, err := app.New(config.App, readinessOpt(apiClient, redisCli), app.MetricsOpt())
-	if err != nil {
-		return nil, err
+	// Dead-letter entries are inspected and replayed by offset, which only Kafka provides
+	if config.BrokerType == multi.NATSType && config.DeadLetterTopic != "" {
+		return nil, errors.InvalidParameterError("dead-letter topic %q is only supported with Kafka, it must be empty with %q broker",
+			config.DeadLetterTopic, config.BrokerType)
 	}
 
-	var nm nonce.Manager
+	// NATS delivers again to another member the messages which are not acknowledged within AckWait
+	retryBudget := service.RetryBudget(retryMessageMaxElapsedTime, config.DeadLetterMaxAttempts)
+	if config.BrokerType == multi.NATSType && config.NATSAckWait < retryBudget {
+		return nil, errors.InvalidParameterError("NATS ack wait %v must not be shorter than the %v spent retrying a message",
+			config.NATSAckWait, retryBudget)
+	}
+
+	var nonceSender store.NonceSender
+	var recoveryTracker store.RecoveryTracker
+	var reconciledAccounts store.NonceReconciliation
 	if config.NonceManagerType == NonceManagerTypeInMemory {
-		nm = nonce.NewNonceManager(ec, memory.NewNonceSender(config.NonceManagerExpiration), memory.NewNonceRecoveryTracker(),
-			config.ProxyURL, config.NonceMaxRecovery)
+		nonceSender = memory.NewNonceSender(config.NonceManagerExpiration)
+		recoveryTracker = memory.NewNonceRecoveryTracker()
+		reconciledAccounts = memory.NewNonceReconciliation()
 	} else if config.NonceManagerType == NonceManagerTypeRedis {
-		nm = nonce.NewNonceManager(ec, redis.NewNonceSender(redisCli), redis.NewNonceRecoveryTracker(redisCli),
-			config.ProxyURL, config.NonceMaxRecovery)
+		nonceSender = redis.NewNonceSender(redisCli)
+		recoveryTracker = redis.NewNonceRecoveryTracker(redisCli)
+		// Reconciled accounts outlive their last sent nonce by a reconciliation, which stops reconciling them
+		reconciledAccounts = redis.NewNonceReconciliation(redisCli, config.NonceManagerExpiration+config.ReconcilerInterval)
+	}
+
+	nm := nonce.NewReconciler(
+		nonce.NewNonceManager(ec, nonceSender, recoveryTracker, config.ProxyURL, config.NonceMaxRecovery),
+		nonceSender, reconciledAccounts, ec, apiClient, config.ProxyURL, uuid.Must(uuid.NewV4()).String(),
+		config.ReconcilerFillGaps, nonceGapFillTimeout,
+	)
+
+	appli, err := app.New(config.App, readinessOpt(msgBroker.Checker(), apiClient, redisCli), app.MetricsOpt(), noncesAPIOpt(nm))
+	if err != nil {
+		return nil, err
 	}
 
 	txSenderDaemon := &txSenderDaemon{
 		keyManagerClient: keyManagerClient,
 		jobClient:        apiClient,
+		chainClient:      apiClient,
+		contractClient:   apiClient,
 		consumerGroup:    consumerGroup,
-		producer:         producer,
+		producer:         msgBroker.Producer(),
 		config:           config,
 		ec:               ec,
 		nonceManager:     nm,
+		safeNonces:       nonceSender,
 		logger:           log.NewLogger().SetComponent(component),
 	}
 
@@ -80,11 +123,12 @@ func (d *txSenderDaemon) Run(ctx context.Context) error {
 	d.logger.Debug("starting transaction sender")
 
 	// Create business layer use cases
-	useCases := builder.NewUseCases(d.jobClient, d.keyManagerClient, d.ec, d.nonceManager,
-		d.config.ProxyURL, d.config.NonceMaxRecovery)
+	useCases := builder.NewUseCases(d.jobClient, d.chainClient, d.contractClient, d.keyManagerClient, d.ec, d.nonceManager, d.safeNonces,
+		d.config.ProxyURL, d.config.NonceMaxRecovery, d.config.GasOracleAllowedURLs)
 
 	// Create service layer listener
-	listener := service.NewMessageListener(useCases, d.jobClient, d.producer, d.config.RecoverTopic, d.config.BckOff)
+	listener := service.NewMessageListener(useCases, d.jobClient, d.producer, d.config.RecoverTopic, d.config.DeadLetterTopic,
+		d.config.DeadLetterMaxAttempts, d.config.BckOff)
 
 	ctx, d.cancel = context.WithCancel(ctx)
 	gr := &multierror.Group{}
@@ -94,14 +138,14 @@ func (d *txSenderDaemon) Run(ctx context.Context) error {
 		logger := d.logger.WithField("consumer", cGroupID)
 		cctx := log.With(log.WithField(ctx, "consumer", cGroupID), logger)
 		gr.Go(func() error {
-			// We retry once after consume exits to prevent entire stack to exit after kafka rebalance is triggered
+			// We retry once after consume exits to prevent entire stack to exit after a rebalance is triggered
 			err := backoff.RetryNotify(
 				func() error {
 					err := cGroup.Consume(cctx, []string{d.config.SenderTopic}, listener)
 
-					// In this case, kafka rebalance was triggered and we want to retry
+					// In this case, a rebalance was triggered and we want to retry
 					if err == nil && cctx.Err() == nil {
-						return fmt.Errorf("kafka rebalance was triggered")
+						return fmt.Errorf("rebalance was triggered")
 					}
 
 					return backoff.Permanent(err)
@@ -116,9 +160,31 @@ func (d *txSenderDaemon) Run(ctx context.Context) error {
 		})
 	}
 
+	if d.config.ReconcilerInterval > 0 {
+		gr.Go(func() error {
+			d.reconcileNonces(ctx)
+			return nil
+		})
+	}
+
 	return gr.Wait().ErrorOrNil()
 }
 
+func (d *txSenderDaemon) reconcileNonces(ctx context.Context) {
+	ticker := time.NewTicker(d.config.ReconcilerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.logger.Debug("nonce reconciler stopped")
+			return
+		case <-ticker.C:
+			d.nonceManager.Reconcile(ctx)
+		}
+	}
+}
+
 func (d *txSenderDaemon) Close() error {
 	var gerr error
 	for _, consumerGroup := range d.consumerGroup {
@@ -128,9 +194,9 @@ func (d *txSenderDaemon) Close() error {
 	return gerr
 }
 
-func readinessOpt(apiClient api.MetricClient, redisCli *dbredis.Client) app.Option {
+func readinessOpt(brokerChecker healthz.Check, apiClient api.MetricClient, redisCli *dbredis.Client) app.Option {
 	return func(ap *app.App) error {
-		ap.AddReadinessCheck("kafka", pkgsarama.GlobalClientChecker())
+		ap.AddReadinessCheck("broker", brokerChecker)
 		ap.AddReadinessCheck("api", apiClient.Checker())
 		if redisCli != nil {
 			ap.AddReadinessCheck("redis", redisCli.Ping)
@@ -138,3 +204,24 @@ func readinessOpt(apiClient api.MetricClient, redisCli *dbredis.Client) app.Opti
 		return nil
 	}
 }
+
+func noncesAPIOpt(reconciler nonce.Reconciler) app.Option {
+	cfg := dynamic.NewConfig()
+	cfg.HTTP.Routers["nonces"] = &dynamic.Router{
+		Router: &traefikdynamic.Router{
+			EntryPoints: []string{http.DefaultMetricsEntryPoint},
+			Service:     "nonces",
+			Priority:    math.MaxInt32,
+			Rule:        "PathPrefix(`/nonces`)",
+		},
+	}
+
+	cfg.HTTP.Services["nonces"] = &dynamic.Service{
+		API: &dynamic.API{},
+	}
+
+	return app.CombineOptions(
+		app.HandlerOpt(reflect.TypeOf(&dynamic.API{}), controllers.NewBuilder(reconciler)),
+		app.ProviderOpt(staticprovider.New(dynamic.NewMessage("nonces", cfg))),
+	)
+}
@@ -10,6 +10,8 @@ import (
 	orchestrateclient "github.com/consensys/orchestrate/pkg/sdk/client"
 
 	"github.com/cenkalti/backoff/v4"
+	"github.com/consensys/orchestrate/pkg/broker/multi"
+	natsbroker "github.com/consensys/orchestrate/pkg/broker/nats"
 	broker "github.com/consensys/orchestrate/pkg/broker/sarama"
 	qkm "github.com/consensys/orchestrate/pkg/quorum-key-manager"
 	"github.com/consensys/orchestrate/pkg/toolkit/app"
@@ -35,6 +37,18 @@ func init() {
 
 	viper.SetDefault(KafkaConsumerViperKey, kafkaConsumerDefault)
 	_ = viper.BindEnv(KafkaConsumerViperKey, KafkaConsumerEnv)
+
+	viper.SetDefault(NonceReconcilerIntervalViperKey, nonceReconcilerIntervalDefault)
+	_ = viper.BindEnv(NonceReconcilerIntervalViperKey, nonceReconcilerIntervalEnv)
+
+	viper.SetDefault(NonceReconcilerFillGapsViperKey, nonceReconcilerFillGapsDefault)
+	_ = viper.BindEnv(NonceReconcilerFillGapsViperKey, nonceReconcilerFillGapsEnv)
+
+	viper.SetDefault(DeadLetterMaxAttemptsViperKey, deadLetterMaxAttemptsDefault)
+	_ = viper.BindEnv(DeadLetterMaxAttemptsViperKey, deadLetterMaxAttemptsEnv)
+
+	viper.SetDefault(GasOracleAllowedURLsViperKey, gasOracleAllowedURLsDefault)
+	_ = viper.BindEnv(GasOracleAllowedURLsViperKey, gasOracleAllowedURLsEnv)
 }
 
 const (
@@ -74,13 +88,44 @@ const (
 	KafkaConsumerEnv      = "KAFKA_NUM_CONSUMERS"
 )
 
+const (
+	nonceReconcilerIntervalFlag     = "nonce-reconciler-interval"
+	NonceReconcilerIntervalViperKey = "nonce.reconciler.interval"
+	nonceReconcilerIntervalDefault  = time.Minute
+	nonceReconcilerIntervalEnv      = "NONCE_RECONCILER_INTERVAL"
+)
+
+const (
+	nonceReconcilerFillGapsFlag     = "nonce-reconciler-fill-gaps"
+	NonceReconcilerFillGapsViperKey = "nonce.reconciler.fill-gaps"
+	nonceReconcilerFillGapsDefault  = true
+	nonceReconcilerFillGapsEnv      = "NONCE_RECONCILER_FILL_GAPS"
+)
+
+const (
+	deadLetterMaxAttemptsFlag     = "dead-letter-max-attempts"
+	DeadLetterMaxAttemptsViperKey = "dead-letter.max-attempts"
+	deadLetterMaxAttemptsDefault  = 3
+	deadLetterMaxAttemptsEnv      = "DEAD_LETTER_MAX_ATTEMPTS"
+)
+
+const (
+	gasOracleAllowedURLsFlag     = "gas-oracle-allowed-urls"
+	GasOracleAllowedURLsViperKey = "gas-oracle.allowed-urls"
+	gasOracleAllowedURLsEnv      = "GAS_ORACLE_ALLOWED_URLS"
+)
+
+var gasOracleAllowedURLsDefault = []string{}
+
 // Flags register flags for tx sentry
 func Flags(f *pflag.FlagSet) {
 	log.Flags(f)
 	authkey.Flags(f)
 	broker.KafkaConsumerFlags(f)
+	multi.Flags(f)
 	broker.KafkaTopicTxSender(f)
 	broker.KafkaTopicTxRecover(f)
+	broker.KafkaTopicTxDeadLetter(f)
 	qkm.Flags(f)
 	orchestrateclient.Flags(f)
 	app.MetricFlags(f)
@@ -91,6 +136,10 @@ func Flags(f *pflag.FlagSet) {
 	nonceManagerType(f)
 	nonceManagerExpiration(f)
 	kafkaConsumers(f)
+	nonceReconcilerInterval(f)
+	nonceReconcilerFillGaps(f)
+	deadLetterMaxAttempts(f)
+	gasOracleAllowedURLs(f)
 }
 
 func maxRecovery(f *pflag.FlagSet) {
@@ -121,18 +170,53 @@ Environment variable: %q`, KafkaConsumerEnv)
 	_ = viper.BindPFlag(KafkaConsumerViperKey, f.Lookup(kafkaConsumersFlag))
 }
 
+func nonceReconcilerInterval(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Interval between two reconciliations of the nonces of managed accounts against the chain (0 to disable).
+Environment variable: %q`, nonceReconcilerIntervalEnv)
+	f.Duration(nonceReconcilerIntervalFlag, nonceReconcilerIntervalDefault, desc)
+	_ = viper.BindPFlag(NonceReconcilerIntervalViperKey, f.Lookup(nonceReconcilerIntervalFlag))
+}
+
+func nonceReconcilerFillGaps(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Whether to fill nonce gaps detected by the reconciler with zero value self-transfers.
+Environment variable: %q`, nonceReconcilerFillGapsEnv)
+	f.Bool(nonceReconcilerFillGapsFlag, nonceReconcilerFillGapsDefault, desc)
+	_ = viper.BindPFlag(NonceReconcilerFillGapsViperKey, f.Lookup(nonceReconcilerFillGapsFlag))
+}
+
+func deadLetterMaxAttempts(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Maximum number of attempts to process a message before moving it to the dead-letter topic.
+Environment variable: %q`, deadLetterMaxAttemptsEnv)
+	f.Int(deadLetterMaxAttemptsFlag, deadLetterMaxAttemptsDefault, desc)
+	_ = viper.BindPFlag(DeadLetterMaxAttemptsViperKey, f.Lookup(deadLetterMaxAttemptsFlag))
+}
+
+func gasOracleAllowedURLs(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`URLs that chains can use as HTTP gas oracle. An oracle URL is allowed if it has the scheme and host of one of these URLs and its path starts with the same path. HTTP gas oracles are disabled if empty.
+Environment variable: %q`, gasOracleAllowedURLsEnv)
+	f.StringSlice(gasOracleAllowedURLsFlag, gasOracleAllowedURLsDefault, desc)
+	_ = viper.BindPFlag(GasOracleAllowedURLsViperKey, f.Lookup(gasOracleAllowedURLsFlag))
+}
+
 type Config struct {
 	App                    *app.Config
+	BrokerType             string
 	GroupName              string
 	NConsumer              int
 	RecoverTopic           string
 	SenderTopic            string
+	DeadLetterTopic        string
+	DeadLetterMaxAttempts  int
+	NATSAckWait            time.Duration
 	ProxyURL               string
 	BckOff                 backoff.BackOff
 	NonceMaxRecovery       uint64
 	NonceManagerType       string
 	RedisCfg               *redis.Config
 	NonceManagerExpiration time.Duration
+	ReconcilerInterval     time.Duration
+	ReconcilerFillGaps     bool
+	GasOracleAllowedURLs   []string
 }
 
 func NewConfig(vipr *viper.Viper) *Config {
@@ -141,9 +225,13 @@ func NewConfig(vipr *viper.Viper) *Config {
 
 	return &Config{
 		App:                    app.NewConfig(vipr),
+		BrokerType:             vipr.GetString(multi.TypeViperKey),
 		GroupName:              vipr.GetString(broker.ConsumerGroupNameViperKey),
 		RecoverTopic:           vipr.GetString(broker.TxRecoverViperKey),
 		SenderTopic:            vipr.GetString(broker.TxSenderViperKey),
+		DeadLetterTopic:        vipr.GetString(broker.TxDeadLetterViperKey),
+		DeadLetterMaxAttempts:  vipr.GetInt(DeadLetterMaxAttemptsViperKey),
+		NATSAckWait:            vipr.GetDuration(natsbroker.AckWaitViperKey),
 		ProxyURL:               vipr.GetString(orchestrateclient.URLViperKey),
 		NonceMaxRecovery:       vipr.GetUint64(NonceMaxRecoveryViperKey),
 		BckOff:                 retryMessageBackOff(),
@@ -151,12 +239,18 @@ func NewConfig(vipr *viper.Viper) *Config {
 		NonceManagerExpiration: vipr.GetDuration(NonceManagerExpirationViperKey),
 		RedisCfg:               redisCfg,
 		NConsumer:              int(vipr.GetUint64(KafkaConsumerViperKey)),
+		ReconcilerInterval:     vipr.GetDuration(NonceReconcilerIntervalViperKey),
+		ReconcilerFillGaps:     vipr.GetBool(NonceReconcilerFillGapsViperKey),
+		GasOracleAllowedURLs:   vipr.GetStringSlice(GasOracleAllowedURLsViperKey),
 	}
 }
 
+// retryMessageMaxElapsedTime is the longest time the connection errors of a message are retried
+const retryMessageMaxElapsedTime = time.Minute
+
 func retryMessageBackOff() backoff.BackOff {
 	bckOff := backoff.NewExponentialBackOff()
 	bckOff.MaxInterval = time.Second * 15
-	bckOff.MaxElapsedTime = time.Minute
+	bckOff.MaxElapsedTime = retryMessageMaxElapsedTime
 	return bckOff
 }
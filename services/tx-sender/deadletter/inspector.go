@@ -0,0 +1,228 @@
+package deadletter
+
+import (
+	"context"
+
+	"github.com/Shopify/sarama"
+	"github.com/consensys/orchestrate/pkg/broker"
+	pkgsarama "github.com/consensys/orchestrate/pkg/broker/sarama"
+	encoding "github.com/consensys/orchestrate/pkg/encoding/proto"
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/sdk/client"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/tx"
+)
+
+const component = "tx-sender.dead-letter"
+
+// Filter selects dead-letter entries by position in the dead-letter topic, a negative value selects all of them
+type Filter struct {
+	Partition int32
+	Offset    int64
+	Limit     int
+}
+
+type position struct {
+	partition int32
+	offset    int64
+}
+
+// Inspector reads the entries of the dead-letter topic and replays them onto the tx-sender topic. Replayed entries are
+// recorded by markers appended to the dead-letter topic so that they are never replayed twice. Listing entries by
+// offset relies on Kafka so that the inspector is not available with other message brokers
+type Inspector struct {
+	client    sarama.Client
+	producer  sarama.SyncProducer
+	jobClient client.JobClient
+	topic     string
+	logger    *log.Logger
+}
+
+func NewInspector(client sarama.Client, producer sarama.SyncProducer, jobClient client.JobClient, topic string) *Inspector {
+	return &Inspector{
+		client:    client,
+		producer:  producer,
+		jobClient: jobClient,
+		topic:     topic,
+		logger:    log.NewLogger().SetComponent(component),
+	}
+}
+
+// List returns the dead-letter entries matching the filter, up to the last entry present when listing started. All the
+// partitions are read so that the entries already replayed are flagged
+func (i *Inspector) List(ctx context.Context, filter *Filter) ([]*broker.DeadLetter, error) {
+	logger := i.logger.WithContext(ctx).WithField("topic", i.topic)
+
+	partitions, err := i.client.Partitions(i.topic)
+	if err != nil {
+		errMessage := "failed to fetch dead-letter topic partitions"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.KafkaConnectionError(errMessage).ExtendComponent(component)
+	}
+
+	consumer, err := sarama.NewConsumerFromClient(i.client)
+	if err != nil {
+		errMessage := "failed to create dead-letter consumer"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.KafkaConnectionError(errMessage).ExtendComponent(component)
+	}
+	defer func() {
+		_ = consumer.Close()
+	}()
+
+	var deadLetters []*broker.DeadLetter
+	replayed := make(map[position]bool)
+	for _, partition := range partitions {
+		// Partitions not selected, or once the limit is reached, are only read for their replayed markers
+		limit := -1
+		switch {
+		case filter.Partition >= 0 && partition != filter.Partition:
+		case filter.Limit <= 0:
+			limit = 0
+		case len(deadLetters) < filter.Limit:
+			limit = filter.Limit - len(deadLetters)
+		}
+
+		partitionDeadLetters, err := i.listPartition(ctx, consumer, partition, filter, limit, replayed)
+		if err != nil {
+			return nil, err
+		}
+
+		deadLetters = append(deadLetters, partitionDeadLetters...)
+	}
+
+	for _, deadLetter := range deadLetters {
+		deadLetter.Replayed = replayed[position{deadLetter.Partition, deadLetter.Offset}]
+	}
+
+	return deadLetters, nil
+}
+
+// Replay publishes the given dead-letter entries onto the target topic with their original key, value and headers.
+// Entries already replayed, or whose job has been sent or has reached a final status since, are skipped. Returns the
+// entries replayed
+func (i *Inspector) Replay(ctx context.Context, deadLetters []*broker.DeadLetter, targetTopic string) ([]*broker.DeadLetter, error) {
+	logger := i.logger.WithContext(ctx).WithField("topic", targetTopic)
+
+	var replayed []*broker.DeadLetter
+	for _, deadLetter := range deadLetters {
+		ok, err := i.isReplayable(ctx, deadLetter)
+		if err != nil {
+			return replayed, err
+		}
+		if !ok {
+			continue
+		}
+
+		_, _, err = i.producer.SendMessage(pkgsarama.NewProducerMessage(deadLetter.ReplayMessage(targetTopic)))
+		if err != nil {
+			errMessage := "failed to replay dead-letter message"
+			logger.WithError(err).Error(errMessage)
+			return replayed, errors.KafkaConnectionError(errMessage).ExtendComponent(component)
+		}
+
+		_, _, err = i.producer.SendMessage(pkgsarama.NewProducerMessage(deadLetter.ReplayedMarker(i.topic)))
+		if err != nil {
+			errMessage := "failed to record replayed dead-letter message"
+			logger.WithError(err).WithField("partition", deadLetter.Partition).WithField("offset", deadLetter.Offset).
+				Error(errMessage)
+			return append(replayed, deadLetter), errors.KafkaConnectionError(errMessage).ExtendComponent(component)
+		}
+
+		deadLetter.Replayed = true
+		replayed = append(replayed, deadLetter)
+	}
+
+	logger.WithField("messages", len(replayed)).Info("dead-letter messages replayed")
+	return replayed, nil
+}
+
+func (i *Inspector) isReplayable(ctx context.Context, deadLetter *broker.DeadLetter) (bool, error) {
+	logger := i.logger.WithContext(ctx).WithField("partition", deadLetter.Partition).WithField("offset", deadLetter.Offset)
+
+	if deadLetter.Replayed {
+		logger.Warn("skipping dead-letter message already replayed")
+		return false, nil
+	}
+
+	txEnvelope := &tx.TxEnvelope{}
+	if err := encoding.Unmarshal(deadLetter.Value, txEnvelope); err != nil || txEnvelope.GetJobUUID() == "" {
+		logger.Warn("skipping dead-letter message which is not a job envelope")
+		return false, nil
+	}
+
+	logger = logger.WithField("job", txEnvelope.GetJobUUID())
+	job, err := i.jobClient.GetJob(ctx, txEnvelope.GetJobUUID())
+	switch {
+	case err != nil && errors.IsNotFoundError(err):
+		logger.Warn("skipping dead-letter message of a job not found")
+		return false, nil
+	case err != nil:
+		logger.WithError(err).Error("failed to fetch job of dead-letter message")
+		return false, err
+	case job.Status == entities.StatusPending || entities.IsFinalJobStatus(job.Status):
+		logger.WithField("status", job.Status).Warn("skipping dead-letter message of a job already sent")
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// listPartition reads the partition up to its last entry and records the replayed markers read. Entries matching the
+// filter are returned up to the limit, a negative limit selects no entry
+func (i *Inspector) listPartition(ctx context.Context, consumer sarama.Consumer, partition int32, filter *Filter, limit int,
+	replayed map[position]bool) ([]*broker.DeadLetter, error) {
+	logger := i.logger.WithContext(ctx).WithField("topic", i.topic).WithField("partition", partition)
+
+	oldest, err := i.client.GetOffset(i.topic, partition, sarama.OffsetOldest)
+	if err != nil {
+		errMessage := "failed to fetch dead-letter oldest offset"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.KafkaConnectionError(errMessage).ExtendComponent(component)
+	}
+
+	newest, err := i.client.GetOffset(i.topic, partition, sarama.OffsetNewest)
+	if err != nil {
+		errMessage := "failed to fetch dead-letter newest offset"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.KafkaConnectionError(errMessage).ExtendComponent(component)
+	}
+
+	if oldest >= newest {
+		return nil, nil
+	}
+
+	pc, err := consumer.ConsumePartition(i.topic, partition, oldest)
+	if err != nil {
+		errMessage := "failed to consume dead-letter partition"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.KafkaConnectionError(errMessage).ExtendComponent(component)
+	}
+	defer func() {
+		_ = pc.Close()
+	}()
+
+	var deadLetters []*broker.DeadLetter
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case msg := <-pc.Messages():
+			brokerMsg := pkgsarama.NewMessage(msg)
+			if replayedPartition, replayedOffset, ok := broker.ParseReplayedMarker(brokerMsg); ok {
+				replayed[position{replayedPartition, replayedOffset}] = true
+			} else if limit >= 0 && (filter.Offset < 0 || msg.Offset == filter.Offset) && (limit == 0 || len(deadLetters) < limit) {
+				deadLetters = append(deadLetters, broker.ParseDeadLetter(brokerMsg))
+			}
+
+			if msg.Offset >= newest-1 {
+				return deadLetters, nil
+			}
+		case err := <-pc.Errors():
+			errMessage := "failed to read dead-letter partition"
+			logger.WithError(err).Error(errMessage)
+			return nil, errors.KafkaConnectionError(errMessage).ExtendComponent(component)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+// +build unit
+
+package deadletter
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/consensys/orchestrate/pkg/broker"
+	encoding "github.com/consensys/orchestrate/pkg/encoding/proto"
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/sdk/client/mock"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/pkg/types/tx"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	deadLetterTopic = "topic-tx-dead-letter"
+	txSenderTopic   = "topic-tx-sender"
+)
+
+func TestInspector_Replay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	jobClient := mock.NewMockJobClient(ctrl)
+
+	t.Run("should replay entries and record them as replayed", func(t *testing.T) {
+		producer := &recordingProducer{}
+		inspector := NewInspector(nil, producer, jobClient, deadLetterTopic)
+		deadLetter := newDeadLetter(t, "jobUUID", 3)
+
+		job := testutils.FakeJobResponse()
+		job.Status = entities.StatusStarted
+		jobClient.EXPECT().GetJob(gomock.Any(), "jobUUID").Return(job, nil)
+
+		replayed, err := inspector.Replay(ctx, []*broker.DeadLetter{deadLetter}, txSenderTopic)
+		require.NoError(t, err)
+		assert.Equal(t, []*broker.DeadLetter{deadLetter}, replayed)
+		assert.True(t, deadLetter.Replayed)
+
+		require.Len(t, producer.msgs, 2)
+		assert.Equal(t, txSenderTopic, producer.msgs[0].Topic)
+		assert.Equal(t, sarama.ByteEncoder(deadLetter.Value), producer.msgs[0].Value)
+		assert.Equal(t, deadLetterTopic, producer.msgs[1].Topic)
+		assert.Equal(t, []sarama.RecordHeader{{Key: []byte(broker.DeadLetterReplayedHeader), Value: []byte("0/3")}},
+			producer.msgs[1].Headers)
+	})
+
+	t.Run("should skip entries already replayed", func(t *testing.T) {
+		producer := &recordingProducer{}
+		inspector := NewInspector(nil, producer, jobClient, deadLetterTopic)
+		deadLetter := newDeadLetter(t, "jobUUID", 3)
+		deadLetter.Replayed = true
+
+		replayed, err := inspector.Replay(ctx, []*broker.DeadLetter{deadLetter}, txSenderTopic)
+		require.NoError(t, err)
+		assert.Empty(t, replayed)
+		assert.Empty(t, producer.msgs)
+	})
+
+	t.Run("should skip entries of jobs pending or in a final status", func(t *testing.T) {
+		producer := &recordingProducer{}
+		inspector := NewInspector(nil, producer, jobClient, deadLetterTopic)
+
+		pendingJob := testutils.FakeJobResponse()
+		pendingJob.Status = entities.StatusPending
+		minedJob := testutils.FakeJobResponse()
+		minedJob.Status = entities.StatusMined
+		jobClient.EXPECT().GetJob(gomock.Any(), "pendingJobUUID").Return(pendingJob, nil)
+		jobClient.EXPECT().GetJob(gomock.Any(), "minedJobUUID").Return(minedJob, nil)
+		jobClient.EXPECT().GetJob(gomock.Any(), "deletedJobUUID").Return(nil, errors.NotFoundError("not found"))
+
+		replayed, err := inspector.Replay(ctx, []*broker.DeadLetter{
+			newDeadLetter(t, "pendingJobUUID", 1),
+			newDeadLetter(t, "minedJobUUID", 2),
+			newDeadLetter(t, "deletedJobUUID", 3),
+			{Partition: 0, Offset: 4, Value: []byte("invalid")},
+		}, txSenderTopic)
+		require.NoError(t, err)
+		assert.Empty(t, replayed)
+		assert.Empty(t, producer.msgs)
+	})
+
+	t.Run("should fail without replaying if the job cannot be fetched", func(t *testing.T) {
+		producer := &recordingProducer{}
+		inspector := NewInspector(nil, producer, jobClient, deadLetterTopic)
+
+		expectedErr := errors.ServiceConnectionError("error")
+		jobClient.EXPECT().GetJob(gomock.Any(), "jobUUID").Return(nil, expectedErr)
+
+		replayed, err := inspector.Replay(ctx, []*broker.DeadLetter{newDeadLetter(t, "jobUUID", 3)}, txSenderTopic)
+		assert.Equal(t, expectedErr, err)
+		assert.Empty(t, replayed)
+		assert.Empty(t, producer.msgs)
+	})
+
+	t.Run("should fail if the replayed entry cannot be recorded", func(t *testing.T) {
+		producer := &recordingProducer{}
+		inspector := NewInspector(nil, producer, jobClient, deadLetterTopic)
+		deadLetter := newDeadLetter(t, "jobUUID", 3)
+
+		jobClient.EXPECT().GetJob(gomock.Any(), "jobUUID").Return(testutils.FakeJobResponse(), nil)
+		producer.failAt = 2
+
+		replayed, err := inspector.Replay(ctx, []*broker.DeadLetter{deadLetter}, txSenderTopic)
+		assert.True(t, errors.IsKafkaConnectionError(err))
+		assert.Equal(t, []*broker.DeadLetter{deadLetter}, replayed)
+	})
+}
+
+func newDeadLetter(t *testing.T, jobUUID string, offset int64) *broker.DeadLetter {
+	value, err := encoding.Marshal(&tx.TxEnvelope{
+		InternalLabels: map[string]string{tx.JobUUIDLabel: jobUUID},
+		Msg:            &tx.TxEnvelope_TxRequest{TxRequest: &tx.TxRequest{Id: jobUUID}},
+	})
+	require.NoError(t, err)
+
+	return &broker.DeadLetter{Offset: offset, Key: []byte("key"), Value: value, Headers: map[string]string{}}
+}
+
+// recordingProducer records the messages sent and fails the message sent at position failAt
+type recordingProducer struct {
+	msgs   []*sarama.ProducerMessage
+	failAt int
+}
+
+func (p *recordingProducer) SendMessage(msg *sarama.ProducerMessage) (partition int32, offset int64, err error) {
+	if len(p.msgs)+1 == p.failAt {
+		return 0, 0, fmt.Errorf("error")
+	}
+
+	p.msgs = append(p.msgs, msg)
+	return 0, int64(len(p.msgs)), nil
+}
+
+func (p *recordingProducer) SendMessages(msgs []*sarama.ProducerMessage) error {
+	for _, msg := range msgs {
+		if _, _, err := p.SendMessage(msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *recordingProducer) Close() error {
+	return nil
+}
@@ -10,6 +10,8 @@ import (
 	sarama2 "github.com/Shopify/sarama"
 	"github.com/alicebob/miniredis"
 	"github.com/cenkalti/backoff/v4"
+	"github.com/consensys/orchestrate/pkg/broker"
+	"github.com/consensys/orchestrate/pkg/broker/multi"
 	"github.com/consensys/orchestrate/pkg/broker/sarama"
 	qkm "github.com/consensys/orchestrate/pkg/quorum-key-manager"
 	"github.com/consensys/orchestrate/pkg/sdk/client"
@@ -221,7 +223,7 @@ func (env *IntegrationEnvironment) Teardown(ctx context.Context) {
 
 func newTxSender(ctx context.Context, txSenderConfig *txsender.Config, redisCli *redis2.Client) (*app.App, error) {
 	// Initialize dependencies
-	sarama.InitSyncProducer(ctx)
+	multi.Init(ctx)
 	sarama.InitConsumerGroup(ctx, txSenderConfig.GroupName)
 	qkm.Init()
 
@@ -239,7 +241,8 @@ func newTxSender(ctx context.Context, txSenderConfig *txsender.Config, redisCli
 
 	txSenderConfig.NonceMaxRecovery = maxRecoveryDefault
 
-	return txsender.NewTxSender(txSenderConfig, []sarama2.ConsumerGroup{sarama.GlobalConsumerGroup()}, sarama.GlobalSyncProducer(),
+	return txsender.NewTxSender(txSenderConfig, []broker.ConsumerGroup{sarama.NewConsumerGroup(sarama.GlobalConsumerGroup())},
+		multi.GlobalBroker(),
 		qkmClient, apiClient, ec, redisCli)
 }
 
@@ -108,6 +108,10 @@ func (s *txSenderEthereumTestSuite) TestTxSender_Ethereum_Public() {
 			Post(fmt.Sprintf("/stores/%s/ethereum/%s/sign-transaction", qkmStoreName, envelope.GetFromString())).
 			Reply(http2.StatusOK).BodyString(signedRawTx)
 
+		gock.New(apiURL).
+			Get(fmt.Sprintf("/chains/%s", envelope.GetChainUUID())).
+			Reply(http2.StatusOK).JSON(testutils.FakeChainResponse())
+
 		feeHistory := testutils.FakeFeeHistory(new(big.Int).SetUint64(100000))
 		feeHistoryResult, _ := json.Marshal(feeHistory)
 		gock.New(apiURL).
@@ -168,6 +172,10 @@ func (s *txSenderEthereumTestSuite) TestTxSender_Ethereum_Public() {
 			Post(fmt.Sprintf("/stores/%s/ethereum/%s/sign-transaction", qkmStoreName, envelope.GetFromString())).
 			Reply(http2.StatusOK).BodyString(signedRawTx)
 
+		gock.New(apiURL).
+			Get(fmt.Sprintf("/chains/%s", envelope.GetChainUUID())).
+			Reply(http2.StatusOK).JSON(testutils.FakeChainResponse())
+
 		gock.New(apiURL).
 			Post(fmt.Sprintf("/proxy/chains/%s", envelope.GetChainUUID())).
 			AddMatcher(ethCallMatcher(wg, "eth_gasPrice")).
@@ -1253,7 +1261,7 @@ func (s *txSenderEthereumTestSuite) TestTxSender_XNonceManager() {
 func (s *txSenderEthereumTestSuite) TestTxSender_ZHealthCheck() {
 	type healthRes struct {
 		API   string `json:"api,omitempty"`
-		Kafka string `json:"kafka,omitempty"`
+		Broker string `json:"broker,omitempty"`
 		Redis string `json:"redis,omitempty"`
 	}
 
@@ -1277,7 +1285,7 @@ func (s *txSenderEthereumTestSuite) TestTxSender_ZHealthCheck() {
 		err = pkgjson.UnmarshalBody(resp.Body, &status)
 		assert.NoError(s.T(), err)
 		assert.Equal(s.T(), "OK", status.API)
-		assert.Equal(s.T(), "OK", status.Kafka)
+		assert.Equal(s.T(), "OK", status.Broker)
 		assert.Equal(s.T(), "OK", status.Redis)
 	})
 
@@ -1305,7 +1313,7 @@ func (s *txSenderEthereumTestSuite) TestTxSender_ZHealthCheck() {
 		status := healthRes{}
 		err = pkgjson.UnmarshalBody(resp.Body, &status)
 		assert.NoError(s.T(), err)
-		assert.NotEqual(s.T(), "OK", status.Kafka)
+		assert.NotEqual(s.T(), "OK", status.Broker)
 		assert.Equal(s.T(), "OK", status.API)
 		assert.Equal(s.T(), "OK", status.Redis)
 	})
@@ -0,0 +1,33 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/http/config/dynamic"
+	"github.com/consensys/orchestrate/services/tx-sender/tx-sender/nonce"
+	"github.com/gorilla/mux"
+)
+
+type Builder struct {
+	noncesCtrl *NoncesController
+}
+
+func NewBuilder(reconciler nonce.Reconciler) *Builder {
+	return &Builder{
+		noncesCtrl: NewNoncesController(reconciler),
+	}
+}
+
+func (b *Builder) Build(_ context.Context, _ string, configuration interface{}, _ func(response *http.Response) error) (http.Handler, error) {
+	cfg, ok := configuration.(*dynamic.API)
+	if !ok {
+		return nil, fmt.Errorf("invalid configuration type (expected %T but got %T)", cfg, configuration)
+	}
+
+	router := mux.NewRouter()
+	b.noncesCtrl.Append(router)
+
+	return router, nil
+}
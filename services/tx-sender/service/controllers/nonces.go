@@ -0,0 +1,65 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/http/httputil"
+	"github.com/consensys/orchestrate/services/tx-sender/tx-sender/nonce"
+	"github.com/gorilla/mux"
+)
+
+type NoncesController struct {
+	reconciler nonce.Reconciler
+}
+
+func NewNoncesController(reconciler nonce.Reconciler) *NoncesController {
+	return &NoncesController{reconciler: reconciler}
+}
+
+func (c *NoncesController) Append(router *mux.Router) {
+	router.Methods(http.MethodGet).Path("/nonces/reconciliation").HandlerFunc(c.reconciliation)
+}
+
+// @Summary Retrieves the result of the last nonce reconciliation of every account managed by the transaction sender
+// @Tags Nonces
+// @Produce json
+// @Param chain_uuid query string false "filter by chain"
+// @Param account query string false "filter by account address"
+// @Param gaps query bool false "only return accounts with a nonce gap"
+// @Success 200 {array} nonce.Finding
+// @Failure 500 {object} httputil.ErrorResponse "Internal server error"
+// @Router /nonces/reconciliation [get]
+func (c *NoncesController) reconciliation(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+
+	chainUUID := request.URL.Query().Get("chain_uuid")
+	account := request.URL.Query().Get("account")
+	onlyGaps := request.URL.Query().Get("gaps") == "true"
+
+	findings, err := c.reconciler.Findings()
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	response := []*nonce.Finding{}
+	for _, finding := range findings {
+		if chainUUID != "" && finding.ChainUUID != chainUUID {
+			continue
+		}
+
+		if account != "" && !strings.EqualFold(finding.Account.String(), account) {
+			continue
+		}
+
+		if onlyGaps && finding.GapNonce == nil {
+			continue
+		}
+
+		response = append(response, finding)
+	}
+
+	_ = json.NewEncoder(rw).Encode(response)
+}
@@ -0,0 +1,79 @@
+// +build unit
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/services/tx-sender/tx-sender/nonce"
+	"github.com/consensys/orchestrate/services/tx-sender/tx-sender/nonce/mocks"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNoncesController_Reconciliation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reconciler := mocks.NewMockReconciler(ctrl)
+	router := mux.NewRouter()
+	NewNoncesController(reconciler).Append(router)
+
+	gapNonce := uint64(3)
+	findings := []*nonce.Finding{
+		{Account: ethcommon.HexToAddress("0x1"), ChainUUID: "chain-1", PendingNonce: 3, GapNonce: &gapNonce},
+		{Account: ethcommon.HexToAddress("0x2"), ChainUUID: "chain-1", PendingNonce: 7},
+		{Account: ethcommon.HexToAddress("0x1"), ChainUUID: "chain-2", PendingNonce: 1},
+	}
+
+	t.Run("should return all findings", func(t *testing.T) {
+		reconciler.EXPECT().Findings().Return(findings, nil)
+
+		rw := httptest.NewRecorder()
+		router.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/nonces/reconciliation", nil))
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		var resp []*nonce.Finding
+		require.NoError(t, json.Unmarshal(rw.Body.Bytes(), &resp))
+		assert.Equal(t, findings, resp)
+	})
+
+	t.Run("should filter findings", func(t *testing.T) {
+		reconciler.EXPECT().Findings().Return(findings, nil)
+
+		rw := httptest.NewRecorder()
+		url := "/nonces/reconciliation?chain_uuid=chain-1&account=" + ethcommon.HexToAddress("0x1").Hex() + "&gaps=true"
+		router.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, url, nil))
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		var resp []*nonce.Finding
+		require.NoError(t, json.Unmarshal(rw.Body.Bytes(), &resp))
+		assert.Equal(t, findings[:1], resp)
+	})
+
+	t.Run("should return empty list if no account is reconciled", func(t *testing.T) {
+		reconciler.EXPECT().Findings().Return([]*nonce.Finding{}, nil)
+
+		rw := httptest.NewRecorder()
+		router.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/nonces/reconciliation", nil))
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		assert.Equal(t, "[]\n", rw.Body.String())
+	})
+
+	t.Run("should fail with 424 if findings cannot be retrieved", func(t *testing.T) {
+		reconciler.EXPECT().Findings().Return(nil, errors.RedisConnectionError("error"))
+
+		rw := httptest.NewRecorder()
+		router.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/nonces/reconciliation", nil))
+
+		assert.Equal(t, http.StatusFailedDependency, rw.Code)
+	})
+}
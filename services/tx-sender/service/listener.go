@@ -15,7 +15,7 @@ import (
 	utils2 "github.com/consensys/orchestrate/services/tx-sender/tx-sender/utils"
 	"google.golang.org/protobuf/proto"
 
-	"github.com/Shopify/sarama"
+	"github.com/consensys/orchestrate/pkg/broker"
 	encoding "github.com/consensys/orchestrate/pkg/encoding/proto"
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/types/tx"
@@ -27,44 +27,66 @@ const (
 	errorProcessingMessage   = "error processing message"
 )
 
+// attemptRetryDelay is the time waited before processing again a message which failed
+var attemptRetryDelay = 500 * time.Millisecond
+
+// RetryBudget returns the longest time a message is retried before being marked, when the connection errors of its
+// last attempt are retried for retryMaxElapsedTime
+func RetryBudget(retryMaxElapsedTime time.Duration, maxAttempts int) time.Duration {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	return retryMaxElapsedTime + time.Duration(maxAttempts)*attemptRetryDelay
+}
+
 type MessageListener struct {
-	useCases     usecases.UseCases
-	recoverTopic string
-	retryBackOff backoff.BackOff
-	producer     sarama.SyncProducer
-	jobClient    client.JobClient
-	cancel       context.CancelFunc
-	err          error
-	logger       *log.Logger
+	useCases        usecases.UseCases
+	recoverTopic    string
+	deadLetterTopic string
+	maxAttempts     int
+	retryBackOff    backoff.BackOff
+	producer        broker.Producer
+	jobClient       client.JobClient
+	cancel          context.CancelFunc
+	err             error
+	logger          *log.Logger
 }
 
 func NewMessageListener(useCases usecases.UseCases,
 	jobClient client.JobClient,
-	producer sarama.SyncProducer,
+	producer broker.Producer,
 	recoverTopic string,
+	deadLetterTopic string,
+	maxAttempts int,
 	bck backoff.BackOff,
 ) *MessageListener {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
 	return &MessageListener{
-		useCases:     useCases,
-		recoverTopic: recoverTopic,
-		producer:     producer,
-		retryBackOff: bck,
-		jobClient:    jobClient,
-		logger:       log.NewLogger().SetComponent(messageListenerComponent),
+		useCases:        useCases,
+		recoverTopic:    recoverTopic,
+		deadLetterTopic: deadLetterTopic,
+		maxAttempts:     maxAttempts,
+		producer:        producer,
+		retryBackOff:    bck,
+		jobClient:       jobClient,
+		logger:          log.NewLogger().SetComponent(messageListenerComponent),
 	}
 }
 
-func (listener *MessageListener) Setup(session sarama.ConsumerGroupSession) error {
+func (listener *MessageListener) Setup(session broker.ConsumerGroupSession) error {
 	listener.logger.WithContext(session.Context()).
-		WithField("kafka.generation_id", session.GenerationID()).
-		WithField("kafka.member_id", session.MemberID()).
+		WithField("member_id", session.MemberID()).
 		WithField("claims", session.Claims()).
 		Info("ready to consume messages")
 
 	return nil
 }
 
-func (listener *MessageListener) Cleanup(session sarama.ConsumerGroupSession) error {
+func (listener *MessageListener) Cleanup(session broker.ConsumerGroupSession) error {
 	logger := listener.logger.WithContext(session.Context())
 	logger.Info("all claims consumed")
 	if listener.cancel != nil {
@@ -75,14 +97,14 @@ func (listener *MessageListener) Cleanup(session sarama.ConsumerGroupSession) er
 	return listener.err
 }
 
-func (listener *MessageListener) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+func (listener *MessageListener) ConsumeClaim(session broker.ConsumerGroupSession, claim broker.ConsumerGroupClaim) error {
 	var ctx context.Context
 	ctx, listener.cancel = context.WithCancel(session.Context())
 	listener.err = listener.consumeClaimLoop(ctx, session, claim)
 	return listener.err
 }
 
-func (listener *MessageListener) consumeClaimLoop(ctx context.Context, session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+func (listener *MessageListener) consumeClaimLoop(ctx context.Context, session broker.ConsumerGroupSession, claim broker.ConsumerGroupClaim) error {
 	logger := listener.logger.WithContext(ctx)
 	ctx = multitenancy.WithUserInfo(log.With(ctx, logger), multitenancy.NewInternalAdminUser())
 	logger.Info("started consuming claims loop")
@@ -101,7 +123,10 @@ func (listener *MessageListener) consumeClaimLoop(ctx context.Context, session s
 			evlp, err := decodeMessage(logger, msg)
 			if err != nil {
 				logger.WithError(err).Error("error decoding message", msg)
-				session.MarkMessage(msg, "")
+				if derr := listener.sendDeadLetter(ctx, msg, err, 1); derr != nil {
+					return derr
+				}
+				session.MarkMessage(msg)
 				continue
 			}
 
@@ -119,7 +144,7 @@ func (listener *MessageListener) consumeClaimLoop(ctx context.Context, session s
 				newCtx = multitenancy.WithUserInfo(newCtx, userInfo)
 			}
 
-			err = listener.processEnvelope(newCtx, evlp, job)
+			attempts, err := listener.processMessage(newCtx, evlp, job)
 
 			switch {
 			// If job exceeded number of retries, we must Notify, Update job to FAILED and Continue
@@ -135,25 +160,55 @@ func (listener *MessageListener) consumeClaimLoop(ctx context.Context, session s
 					jlogger.WithError(serr).Error(errorProcessingMessage)
 					return serr
 				}
+			// Listener is stopping, the message is consumed again on restart
+			case err != nil && ctx.Err() != nil:
+				return nil
 			case err != nil:
 				curJob, serr := listener.jobClient.GetJob(newCtx, job.UUID)
+				switch {
 				// IMPORTANT: Jobs can be updated in parallel to NEVER_MINED, MINED or FAILED, so that we should
 				// warning and ignore it in case job is in a final status
-				if serr == nil && entities.IsFinalJobStatus(curJob.Status) {
+				case serr == nil && entities.IsFinalJobStatus(curJob.Status):
 					jlogger.WithError(err).Warn(errorProcessingMessage)
-				} else {
+				// Without dead-letter topic or while the API is unreachable, the message is consumed again on restart
+				case listener.deadLetterTopic == "", serr != nil && errors.IsConnectionError(serr):
 					jlogger.WithError(err).Error(errorProcessingMessage)
 					return err
+				default:
+					jlogger.WithError(err).WithField("attempts", attempts).Error(errorProcessingMessage)
+					if derr := listener.sendDeadLetter(newCtx, msg, err, attempts); derr != nil {
+						return derr
+					}
 				}
 			}
 
 			jlogger.Debug("job message has been processed")
-			session.MarkMessage(msg, "")
+			session.MarkMessage(msg)
 			session.Commit()
 		}
 	}
 }
 
+// processMessage processes an envelope up to the maximum number of attempts and returns the number of attempts made.
+// Connection errors are returned right away as they are already retried when processing the envelope
+func (listener *MessageListener) processMessage(ctx context.Context, evlp *tx.Envelope, job *entities.Job) (int, error) {
+	logger := log.FromContext(ctx)
+
+	for attempt := 1; ; attempt++ {
+		err := listener.processEnvelope(ctx, evlp, job)
+		if err == nil || errors.IsConnectionError(err) || attempt >= listener.maxAttempts {
+			return attempt, err
+		}
+
+		logger.WithError(err).WithField("attempt", attempt).Warnf("error processing message, retrying in %v...", attemptRetryDelay)
+		select {
+		case <-ctx.Done():
+			return attempt, err
+		case <-time.After(attemptRetryDelay):
+		}
+	}
+}
+
 func (listener *MessageListener) processEnvelope(ctx context.Context, evlp *tx.Envelope, job *entities.Job) error {
 	logger := log.FromContext(ctx)
 	return backoff.RetryNotify(
@@ -223,14 +278,19 @@ func (listener *MessageListener) executeSendJob(ctx context.Context, job *entiti
 		return listener.useCases.SendEEAPrivateTx().Execute(ctx, job)
 	case tx.JobType_ETH_RAW_TX.String():
 		return listener.useCases.SendETHRawTx().Execute(ctx, job)
-	case tx.JobType_ETH_EEA_MARKING_TX.String(), tx.JobType_ETH_TX.String():
+	case tx.JobType_ETH_TX.String():
+		if job.InternalData.SmartAccount != nil {
+			return listener.useCases.SendSmartAccountTx().Execute(ctx, job)
+		}
+		return listener.useCases.SendETHTx().Execute(ctx, job)
+	case tx.JobType_ETH_EEA_MARKING_TX.String():
 		return listener.useCases.SendETHTx().Execute(ctx, job)
 	default:
 		return errors.InvalidParameterError("job type %s is not supported", job.Type)
 	}
 }
 
-func decodeMessage(logger *log.Logger, msg *sarama.ConsumerMessage) (*tx.Envelope, error) {
+func decodeMessage(logger *log.Logger, msg *broker.Message) (*tx.Envelope, error) {
 	txEnvelope := &tx.TxEnvelope{}
 	err := encoding.Unmarshal(msg.Value, txEnvelope)
 	if err != nil {
@@ -249,15 +309,36 @@ func decodeMessage(logger *log.Logger, msg *sarama.ConsumerMessage) (*tx.Envelop
 	return evlp, nil
 }
 
+func (listener *MessageListener) sendDeadLetter(ctx context.Context, msg *broker.Message, err error, attempts int) error {
+	if listener.deadLetterTopic == "" {
+		return nil
+	}
+
+	logger := listener.logger.WithContext(ctx).WithField("topic", listener.deadLetterTopic).
+		WithField("partition", msg.Partition).WithField("offset", msg.Offset)
+
+	partition, offset, serr := listener.producer.SendMessage(broker.NewDeadLetterMessage(msg, listener.deadLetterTopic, err, attempts))
+	if serr != nil {
+		logger.WithError(serr).Error("failed to produce dead-letter message")
+		return errors.FromError(serr).ExtendComponent(messageListenerComponent)
+	}
+
+	logger.WithField("dead_letter_partition", partition).
+		WithField("dead_letter_offset", offset).
+		Warn("message moved to dead-letter topic")
+
+	return nil
+}
+
 func (listener *MessageListener) sendEnvelope(ctx context.Context, msgID string, protoMessage proto.Message, topic, partitionKey string) error {
 	logger := listener.logger.WithContext(ctx).WithField("topic", topic).WithField("envelope_id", msgID)
 	logger.Debug("sending envelope")
 
-	msg := &sarama.ProducerMessage{}
+	msg := &broker.Message{}
 	msg.Topic = topic
-	// Set key for Kafka partitions
+	// Set key for partitioning
 	if partitionKey != "" {
-		msg.Key = sarama.StringEncoder(partitionKey)
+		msg.Key = []byte(partitionKey)
 	}
 
 	b, err := encoding.Marshal(protoMessage)
@@ -266,13 +347,12 @@ func (listener *MessageListener) sendEnvelope(ctx context.Context, msgID string,
 		logger.WithError(err).Error(errMessage)
 		return errors.EncodingError(errMessage).ExtendComponent(messageListenerComponent)
 	}
-	msg.Value = sarama.ByteEncoder(b)
+	msg.Value = b
 
 	partition, offset, err := listener.producer.SendMessage(msg)
 	if err != nil {
-		errMessage := "failed to produce kafka message"
-		logger.WithError(err).Error(errMessage)
-		return errors.KafkaConnectionError(errMessage).ExtendComponent(messageListenerComponent)
+		logger.WithError(err).Error("failed to produce message")
+		return errors.FromError(err).ExtendComponent(messageListenerComponent)
 	}
 
 	logger.WithField("partition", partition).
@@ -10,6 +10,8 @@ import (
 
 	"github.com/Shopify/sarama"
 	"github.com/cenkalti/backoff/v4"
+	"github.com/consensys/orchestrate/pkg/broker"
+	pkgsarama "github.com/consensys/orchestrate/pkg/broker/sarama"
 	"github.com/consensys/orchestrate/pkg/broker/sarama/mock"
 	"github.com/consensys/orchestrate/pkg/encoding/proto"
 	"github.com/consensys/orchestrate/pkg/errors"
@@ -20,10 +22,12 @@ import (
 	"github.com/consensys/orchestrate/pkg/types/tx"
 	usecases "github.com/consensys/orchestrate/services/tx-sender/tx-sender/use-cases"
 	"github.com/consensys/orchestrate/services/tx-sender/tx-sender/use-cases/mocks"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/gofrs/uuid"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -36,11 +40,13 @@ type messageListenerCtrlTestSuite struct {
 	sendEEAPrivateUC   *mocks.MockSendEEAPrivateTxUseCase
 	sendTesseraMarking *mocks.MockSendTesseraMarkingTxUseCase
 	sendTesseraPrivate *mocks.MockSendTesseraPrivateTxUseCase
+	sendSmartAccount   *mocks.MockSendSmartAccountTxUseCase
 	apiClient          *mock3.MockOrchestrateClient
 	tenantID           string
 	allowedTenants     []string
 	senderTopic        string
 	recoverTopic       string
+	deadLetterTopic    string
 }
 
 var _ usecases.UseCases = &messageListenerCtrlTestSuite{}
@@ -65,6 +71,10 @@ func (s *messageListenerCtrlTestSuite) SendTesseraMarkingTx() usecases.SendTesse
 	return s.sendTesseraMarking
 }
 
+func (s *messageListenerCtrlTestSuite) SendSmartAccountTx() usecases.SendSmartAccountTxUseCase {
+	return s.sendSmartAccount
+}
+
 func TestMessageListener(t *testing.T) {
 	s := new(messageListenerCtrlTestSuite)
 	suite.Run(t, s)
@@ -81,12 +91,15 @@ func (s *messageListenerCtrlTestSuite) SetupTest() {
 	s.sendEEAPrivateUC = mocks.NewMockSendEEAPrivateTxUseCase(ctrl)
 	s.sendTesseraPrivate = mocks.NewMockSendTesseraPrivateTxUseCase(ctrl)
 	s.sendTesseraMarking = mocks.NewMockSendTesseraMarkingTxUseCase(ctrl)
+	s.sendSmartAccount = mocks.NewMockSendSmartAccountTxUseCase(ctrl)
 	s.apiClient = mock3.NewMockOrchestrateClient(ctrl)
 	s.recoverTopic = "recover-topic"
+	s.deadLetterTopic = "dead-letter-topic"
+	attemptRetryDelay = time.Millisecond
 	s.producer = mock.NewMockSyncProducer()
 
 	bckoff := backoff.WithMaxRetries(backoff.NewConstantBackOff(time.Millisecond*100), 2)
-	s.listener = NewMessageListener(s, s.apiClient, s.producer, s.recoverTopic, bckoff)
+	s.listener = NewMessageListener(s, s.apiClient, pkgsarama.NewProducer(s.producer), s.recoverTopic, s.deadLetterTopic, 2, bckoff)
 }
 
 func (s *messageListenerCtrlTestSuite) TestMessageListener_PublicEthereum() {
@@ -104,7 +117,7 @@ func (s *messageListenerCtrlTestSuite) TestMessageListener_PublicEthereum() {
 
 		cerr := make(chan error)
 		go func() {
-			cerr <- s.listener.ConsumeClaim(mockSession, mockClaim)
+			cerr <- pkgsarama.NewConsumerGroupHandler(s.listener).ConsumeClaim(mockSession, mockClaim)
 		}()
 
 		mockClaim.ExpectMessage(msg)
@@ -114,6 +127,39 @@ func (s *messageListenerCtrlTestSuite) TestMessageListener_PublicEthereum() {
 		assert.Nil(t, s.producer.LastMessage())
 	})
 
+	s.T().Run("should execute use case for smart account transactions", func(t *testing.T) {
+		var claims map[string][]int32
+		ctx, _ := context.WithTimeout(context.Background(), time.Millisecond*500)
+
+		mockSession := mock.NewConsumerGroupSession(ctx, "kafka-consumer-group", claims)
+		mockClaim := mock.NewConsumerGroupClaim("topic", 0, 0)
+		envelope := fakeEnvelope(s.tenantID)
+		smartAccount := &entities.SmartAccount{
+			Type:    entities.SafeSmartAccountType,
+			Address: ethcommon.HexToAddress("0x1abae27a0cbfb02945720425d3b80c7e09728534"),
+			Signer:  ethcommon.HexToAddress("0x5bd1b3b1d4bd1e0e7b8e8e27a1c5dd7e9b1bf8c3"),
+		}
+		_ = envelope.SetSmartAccount(smartAccount)
+		msg := &sarama.ConsumerMessage{}
+		msg.Value, _ = proto.Marshal(envelope.TxEnvelopeAsRequest())
+
+		s.sendSmartAccount.EXPECT().Execute(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, job *entities.Job) error {
+				assert.Equal(t, smartAccount, job.InternalData.SmartAccount)
+				return nil
+			})
+
+		cerr := make(chan error)
+		go func() {
+			cerr <- pkgsarama.NewConsumerGroupHandler(s.listener).ConsumeClaim(mockSession, mockClaim)
+		}()
+
+		mockClaim.ExpectMessage(msg)
+
+		assert.NoError(t, <-cerr)
+		assert.Nil(t, s.producer.LastMessage())
+	})
+
 	s.T().Run("should execute use case for public raw ethereum transactions", func(t *testing.T) {
 		var claims map[string][]int32
 		ctx, _ := context.WithTimeout(context.Background(), time.Millisecond*500)
@@ -129,7 +175,7 @@ func (s *messageListenerCtrlTestSuite) TestMessageListener_PublicEthereum() {
 
 		cerr := make(chan error)
 		go func() {
-			cerr <- s.listener.ConsumeClaim(mockSession, mockClaim)
+			cerr <- pkgsarama.NewConsumerGroupHandler(s.listener).ConsumeClaim(mockSession, mockClaim)
 		}()
 
 		mockClaim.ExpectMessage(msg)
@@ -153,7 +199,7 @@ func (s *messageListenerCtrlTestSuite) TestMessageListener_PublicEthereum() {
 
 		cerr := make(chan error)
 		go func() {
-			cerr <- s.listener.ConsumeClaim(mockSession, mockClaim)
+			cerr <- pkgsarama.NewConsumerGroupHandler(s.listener).ConsumeClaim(mockSession, mockClaim)
 		}()
 
 		mockClaim.ExpectMessage(msg)
@@ -177,7 +223,7 @@ func (s *messageListenerCtrlTestSuite) TestMessageListener_PublicEthereum() {
 
 		cerr := make(chan error)
 		go func() {
-			cerr <- s.listener.ConsumeClaim(mockSession, mockClaim)
+			cerr <- pkgsarama.NewConsumerGroupHandler(s.listener).ConsumeClaim(mockSession, mockClaim)
 		}()
 
 		mockClaim.ExpectMessage(msg)
@@ -201,7 +247,7 @@ func (s *messageListenerCtrlTestSuite) TestMessageListener_PublicEthereum() {
 
 		cerr := make(chan error)
 		go func() {
-			cerr <- s.listener.ConsumeClaim(mockSession, mockClaim)
+			cerr <- pkgsarama.NewConsumerGroupHandler(s.listener).ConsumeClaim(mockSession, mockClaim)
 		}()
 
 		mockClaim.ExpectMessage(msg)
@@ -234,7 +280,7 @@ func (s *messageListenerCtrlTestSuite) TestMessageListener_PublicEthereum_Errors
 
 		cerr := make(chan error)
 		go func() {
-			cerr <- s.listener.ConsumeClaim(mockSession, mockClaim)
+			cerr <- pkgsarama.NewConsumerGroupHandler(s.listener).ConsumeClaim(mockSession, mockClaim)
 		}()
 
 		mockClaim.ExpectMessage(msg)
@@ -268,7 +314,7 @@ func (s *messageListenerCtrlTestSuite) TestMessageListener_PublicEthereum_Errors
 
 		cerr := make(chan error)
 		go func() {
-			cerr <- s.listener.ConsumeClaim(mockSession, mockClaim)
+			cerr <- pkgsarama.NewConsumerGroupHandler(s.listener).ConsumeClaim(mockSession, mockClaim)
 		}()
 
 		mockClaim.ExpectMessage(msg)
@@ -277,6 +323,84 @@ func (s *messageListenerCtrlTestSuite) TestMessageListener_PublicEthereum_Errors
 	})
 }
 
+func (s *messageListenerCtrlTestSuite) TestMessageListener_DeadLetter() {
+	s.T().Run("should move undecodable messages to the dead-letter topic", func(t *testing.T) {
+		var claims map[string][]int32
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*500)
+		defer cancel()
+		s.producer.Clean()
+
+		mockSession := mock.NewConsumerGroupSession(ctx, "kafka-consumer-group", claims)
+		mockClaim := mock.NewConsumerGroupClaim("topic", 0, 0)
+		msg := &sarama.ConsumerMessage{Topic: "topic", Offset: 3, Value: []byte("invalid")}
+
+		cerr := make(chan error)
+		go func() {
+			cerr <- pkgsarama.NewConsumerGroupHandler(s.listener).ConsumeClaim(mockSession, mockClaim)
+		}()
+
+		mockClaim.ExpectMessage(msg)
+
+		assert.NoError(t, <-cerr)
+		require.NotNil(t, s.producer.LastMessage())
+		assert.Equal(t, s.deadLetterTopic, s.producer.LastMessage().Topic)
+		assert.Equal(t, int64(4), mockSession.LastMarkedOffset("topic", 0).Offset)
+
+		deadLetter := broker.ParseDeadLetter(pkgsarama.NewMessage(consumerMessage(s.producer.LastMessage())))
+		assert.Equal(t, "topic", deadLetter.OriginalTopic)
+		assert.Equal(t, int64(3), deadLetter.OriginalOffset)
+		assert.Equal(t, 1, deadLetter.Attempts)
+		assert.Equal(t, []byte("invalid"), deadLetter.Value)
+		assert.NotEmpty(t, deadLetter.Error)
+	})
+
+	s.T().Run("should retry and move the message to the dead-letter topic once attempts are exhausted", func(t *testing.T) {
+		var claims map[string][]int32
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*500)
+		defer cancel()
+		s.producer.Clean()
+
+		mockSession := mock.NewConsumerGroupSession(ctx, "kafka-consumer-group", claims)
+		mockClaim := mock.NewConsumerGroupClaim("topic", 0, 0)
+		evlp := fakeEnvelope(s.tenantID)
+		msg := &sarama.ConsumerMessage{Topic: "topic"}
+		msg.Value, _ = proto.Marshal(evlp.TxEnvelopeAsRequest())
+
+		err := errors.InternalError("error")
+		s.sendETHUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(err).Times(2)
+		s.apiClient.EXPECT().UpdateJob(gomock.Any(), evlp.GetJobUUID(), gomock.Any()).
+			Return(nil, errors.InvalidStateError("invalid status")).Times(2)
+		s.apiClient.EXPECT().GetJob(gomock.Any(), evlp.GetJobUUID()).
+			Return(&api.JobResponse{Status: entities.StatusPending}, nil)
+
+		cerr := make(chan error)
+		go func() {
+			cerr <- pkgsarama.NewConsumerGroupHandler(s.listener).ConsumeClaim(mockSession, mockClaim)
+		}()
+
+		mockClaim.ExpectMessage(msg)
+
+		assert.NoError(t, <-cerr)
+		require.NotNil(t, s.producer.LastMessage())
+		assert.Equal(t, s.deadLetterTopic, s.producer.LastMessage().Topic)
+
+		deadLetter := broker.ParseDeadLetter(pkgsarama.NewMessage(consumerMessage(s.producer.LastMessage())))
+		assert.Equal(t, 2, deadLetter.Attempts)
+		assert.Equal(t, errors.FromError(errors.InvalidStateError("invalid status")).Hex(), deadLetter.ErrorCode)
+		assert.Equal(t, []byte(msg.Value), deadLetter.Value)
+	})
+}
+
+func consumerMessage(msg *sarama.ProducerMessage) *sarama.ConsumerMessage {
+	cMsg := &sarama.ConsumerMessage{Topic: msg.Topic}
+	cMsg.Value, _ = msg.Value.Encode()
+	for idx := range msg.Headers {
+		cMsg.Headers = append(cMsg.Headers, &msg.Headers[idx])
+	}
+
+	return cMsg
+}
+
 func fakeEnvelope(tenantID string) *tx.Envelope {
 	jobUUID := uuid.Must(uuid.NewV4()).String()
 	scheduleUUID := uuid.Must(uuid.NewV4()).String()
@@ -298,3 +422,8 @@ func fakeEnvelope(tenantID string) *tx.Envelope {
 
 	return envelope
 }
+
+func TestRetryBudget(t *testing.T) {
+	assert.Equal(t, time.Minute+3*attemptRetryDelay, RetryBudget(time.Minute, 3))
+	assert.Equal(t, time.Minute+attemptRetryDelay, RetryBudget(time.Minute, 0), "at least one attempt")
+}
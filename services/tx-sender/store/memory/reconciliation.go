@@ -0,0 +1,87 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/consensys/orchestrate/services/tx-sender/store"
+)
+
+// nonceReconciliation keeps reconciled accounts in the memory of the process, it must only be used with a single replica
+type nonceReconciliation struct {
+	mux      *sync.RWMutex
+	accounts map[string]*store.ReconciledAccount
+}
+
+func NewNonceReconciliation() store.NonceReconciliation {
+	return &nonceReconciliation{
+		mux:      &sync.RWMutex{},
+		accounts: make(map[string]*store.ReconciledAccount),
+	}
+}
+
+func (r *nonceReconciliation) Track(acc *store.ReconciledAccount) error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if _, ok := r.accounts[acc.Key]; !ok {
+		accCopy := *acc
+		r.accounts[acc.Key] = &accCopy
+	}
+
+	return nil
+}
+
+func (r *nonceReconciliation) Load(key string) (*store.ReconciledAccount, bool, error) {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+
+	acc, ok := r.accounts[key]
+	if !ok {
+		return nil, false, nil
+	}
+
+	accCopy := *acc
+	return &accCopy, true, nil
+}
+
+func (r *nonceReconciliation) Save(acc *store.ReconciledAccount) error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	accCopy := *acc
+	r.accounts[acc.Key] = &accCopy
+	return nil
+}
+
+func (r *nonceReconciliation) Delete(key string) error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	delete(r.accounts, key)
+	return nil
+}
+
+func (r *nonceReconciliation) List() ([]*store.ReconciledAccount, error) {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+
+	accounts := make([]*store.ReconciledAccount, 0, len(r.accounts))
+	for _, acc := range r.accounts {
+		accCopy := *acc
+		accounts = append(accounts, &accCopy)
+	}
+
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].Key < accounts[j].Key })
+	return accounts, nil
+}
+
+// Acquire always succeeds as accounts are only reconciled by the current process
+func (r *nonceReconciliation) Acquire(_, _ string, _ time.Duration) (bool, error) {
+	return true, nil
+}
+
+func (r *nonceReconciliation) Release(_, _ string) error {
+	return nil
+}
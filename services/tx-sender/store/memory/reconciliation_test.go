@@ -0,0 +1,51 @@
+// +build unit
+
+package memory
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/services/tx-sender/store"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNonceReconciliationMemory(t *testing.T) {
+	r := NewNonceReconciliation()
+
+	gapNonce := uint64(3)
+	acc := &store.ReconciledAccount{
+		Key:       "key",
+		From:      ethcommon.HexToAddress("0x1"),
+		ChainUUID: "chainUUID",
+		ChainID:   big.NewInt(1),
+	}
+
+	require.NoError(t, r.Track(acc))
+	acc.GapNonce = &gapNonce
+	require.NoError(t, r.Save(acc))
+
+	// Tracking an account already reconciled keeps its state
+	require.NoError(t, r.Track(&store.ReconciledAccount{Key: "key"}))
+	loaded, ok, err := r.Load("key")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, gapNonce, *loaded.GapNonce)
+
+	accounts, err := r.List()
+	require.NoError(t, err)
+	assert.Len(t, accounts, 1)
+
+	acquired, err := r.Acquire("key", "owner", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+	assert.NoError(t, r.Release("key", "owner"))
+
+	require.NoError(t, r.Delete("key"))
+	_, ok, err = r.Load("key")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -5,34 +5,51 @@
 package mock
 
 import (
-	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
+	time "time"
+
+	store "github.com/consensys/orchestrate/services/tx-sender/store"
+	gomock "github.com/golang/mock/gomock"
 )
 
-// MockNonceSender is a mock of NonceSender interface
+// MockNonceSender is a mock of NonceSender interface.
 type MockNonceSender struct {
 	ctrl     *gomock.Controller
 	recorder *MockNonceSenderMockRecorder
 }
 
-// MockNonceSenderMockRecorder is the mock recorder for MockNonceSender
+// MockNonceSenderMockRecorder is the mock recorder for MockNonceSender.
 type MockNonceSenderMockRecorder struct {
 	mock *MockNonceSender
 }
 
-// NewMockNonceSender creates a new mock instance
+// NewMockNonceSender creates a new mock instance.
 func NewMockNonceSender(ctrl *gomock.Controller) *MockNonceSender {
 	mock := &MockNonceSender{ctrl: ctrl}
 	mock.recorder = &MockNonceSenderMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockNonceSender) EXPECT() *MockNonceSenderMockRecorder {
 	return m.recorder
 }
 
-// GetLastSent mocks base method
+// DeleteLastSent mocks base method.
+func (m *MockNonceSender) DeleteLastSent(key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLastSent", key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLastSent indicates an expected call of DeleteLastSent.
+func (mr *MockNonceSenderMockRecorder) DeleteLastSent(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLastSent", reflect.TypeOf((*MockNonceSender)(nil).DeleteLastSent), key)
+}
+
+// GetLastSent mocks base method.
 func (m *MockNonceSender) GetLastSent(key string) (uint64, bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetLastSent", key)
@@ -42,13 +59,13 @@ func (m *MockNonceSender) GetLastSent(key string) (uint64, bool, error) {
 	return ret0, ret1, ret2
 }
 
-// GetLastSent indicates an expected call of GetLastSent
+// GetLastSent indicates an expected call of GetLastSent.
 func (mr *MockNonceSenderMockRecorder) GetLastSent(key interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastSent", reflect.TypeOf((*MockNonceSender)(nil).GetLastSent), key)
 }
 
-// IncrLastSent mocks base method
+// IncrLastSent mocks base method.
 func (m *MockNonceSender) IncrLastSent(key string) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "IncrLastSent", key)
@@ -56,27 +73,13 @@ func (m *MockNonceSender) IncrLastSent(key string) error {
 	return ret0
 }
 
-// IncrLastSent indicates an expected call of IncrLastSent
+// IncrLastSent indicates an expected call of IncrLastSent.
 func (mr *MockNonceSenderMockRecorder) IncrLastSent(key interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrLastSent", reflect.TypeOf((*MockNonceSender)(nil).IncrLastSent), key)
 }
 
-// DeleteLastSent mocks base method
-func (m *MockNonceSender) DeleteLastSent(key string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteLastSent", key)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// DeleteLastSent indicates an expected call of DeleteLastSent
-func (mr *MockNonceSenderMockRecorder) DeleteLastSent(key interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLastSent", reflect.TypeOf((*MockNonceSender)(nil).DeleteLastSent), key)
-}
-
-// SetLastSent mocks base method
+// SetLastSent mocks base method.
 func (m *MockNonceSender) SetLastSent(key string, value uint64) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetLastSent", key, value)
@@ -84,36 +87,60 @@ func (m *MockNonceSender) SetLastSent(key string, value uint64) error {
 	return ret0
 }
 
-// SetLastSent indicates an expected call of SetLastSent
+// SetLastSent indicates an expected call of SetLastSent.
 func (mr *MockNonceSenderMockRecorder) SetLastSent(key, value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLastSent", reflect.TypeOf((*MockNonceSender)(nil).SetLastSent), key, value)
 }
 
-// MockRecoveryTracker is a mock of RecoveryTracker interface
+// MockRecoveryTracker is a mock of RecoveryTracker interface.
 type MockRecoveryTracker struct {
 	ctrl     *gomock.Controller
 	recorder *MockRecoveryTrackerMockRecorder
 }
 
-// MockRecoveryTrackerMockRecorder is the mock recorder for MockRecoveryTracker
+// MockRecoveryTrackerMockRecorder is the mock recorder for MockRecoveryTracker.
 type MockRecoveryTrackerMockRecorder struct {
 	mock *MockRecoveryTracker
 }
 
-// NewMockRecoveryTracker creates a new mock instance
+// NewMockRecoveryTracker creates a new mock instance.
 func NewMockRecoveryTracker(ctrl *gomock.Controller) *MockRecoveryTracker {
 	mock := &MockRecoveryTracker{ctrl: ctrl}
 	mock.recorder = &MockRecoveryTrackerMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockRecoveryTracker) EXPECT() *MockRecoveryTrackerMockRecorder {
 	return m.recorder
 }
 
-// Recovering mocks base method
+// Recover mocks base method.
+func (m *MockRecoveryTracker) Recover(key string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Recover", key)
+}
+
+// Recover indicates an expected call of Recover.
+func (mr *MockRecoveryTrackerMockRecorder) Recover(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recover", reflect.TypeOf((*MockRecoveryTracker)(nil).Recover), key)
+}
+
+// Recovered mocks base method.
+func (m *MockRecoveryTracker) Recovered(key string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Recovered", key)
+}
+
+// Recovered indicates an expected call of Recovered.
+func (mr *MockRecoveryTrackerMockRecorder) Recovered(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recovered", reflect.TypeOf((*MockRecoveryTracker)(nil).Recovered), key)
+}
+
+// Recovering mocks base method.
 func (m *MockRecoveryTracker) Recovering(key string) uint64 {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Recovering", key)
@@ -121,32 +148,133 @@ func (m *MockRecoveryTracker) Recovering(key string) uint64 {
 	return ret0
 }
 
-// Recovering indicates an expected call of Recovering
+// Recovering indicates an expected call of Recovering.
 func (mr *MockRecoveryTrackerMockRecorder) Recovering(key interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recovering", reflect.TypeOf((*MockRecoveryTracker)(nil).Recovering), key)
 }
 
-// Recover mocks base method
-func (m *MockRecoveryTracker) Recover(key string) {
+// MockNonceReconciliation is a mock of NonceReconciliation interface.
+type MockNonceReconciliation struct {
+	ctrl     *gomock.Controller
+	recorder *MockNonceReconciliationMockRecorder
+}
+
+// MockNonceReconciliationMockRecorder is the mock recorder for MockNonceReconciliation.
+type MockNonceReconciliationMockRecorder struct {
+	mock *MockNonceReconciliation
+}
+
+// NewMockNonceReconciliation creates a new mock instance.
+func NewMockNonceReconciliation(ctrl *gomock.Controller) *MockNonceReconciliation {
+	mock := &MockNonceReconciliation{ctrl: ctrl}
+	mock.recorder = &MockNonceReconciliationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNonceReconciliation) EXPECT() *MockNonceReconciliationMockRecorder {
+	return m.recorder
+}
+
+// Acquire mocks base method.
+func (m *MockNonceReconciliation) Acquire(key, owner string, ttl time.Duration) (bool, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Recover", key)
+	ret := m.ctrl.Call(m, "Acquire", key, owner, ttl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Recover indicates an expected call of Recover
-func (mr *MockRecoveryTrackerMockRecorder) Recover(key interface{}) *gomock.Call {
+// Acquire indicates an expected call of Acquire.
+func (mr *MockNonceReconciliationMockRecorder) Acquire(key, owner, ttl interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recover", reflect.TypeOf((*MockRecoveryTracker)(nil).Recover), key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Acquire", reflect.TypeOf((*MockNonceReconciliation)(nil).Acquire), key, owner, ttl)
 }
 
-// Recovered mocks base method
-func (m *MockRecoveryTracker) Recovered(key string) {
+// Delete mocks base method.
+func (m *MockNonceReconciliation) Delete(key string) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Recovered", key)
+	ret := m.ctrl.Call(m, "Delete", key)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Recovered indicates an expected call of Recovered
-func (mr *MockRecoveryTrackerMockRecorder) Recovered(key interface{}) *gomock.Call {
+// Delete indicates an expected call of Delete.
+func (mr *MockNonceReconciliationMockRecorder) Delete(key interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recovered", reflect.TypeOf((*MockRecoveryTracker)(nil).Recovered), key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNonceReconciliation)(nil).Delete), key)
+}
+
+// List mocks base method.
+func (m *MockNonceReconciliation) List() ([]*store.ReconciledAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List")
+	ret0, _ := ret[0].([]*store.ReconciledAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockNonceReconciliationMockRecorder) List() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockNonceReconciliation)(nil).List))
+}
+
+// Load mocks base method.
+func (m *MockNonceReconciliation) Load(key string) (*store.ReconciledAccount, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load", key)
+	ret0, _ := ret[0].(*store.ReconciledAccount)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Load indicates an expected call of Load.
+func (mr *MockNonceReconciliationMockRecorder) Load(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockNonceReconciliation)(nil).Load), key)
+}
+
+// Release mocks base method.
+func (m *MockNonceReconciliation) Release(key, owner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", key, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockNonceReconciliationMockRecorder) Release(key, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockNonceReconciliation)(nil).Release), key, owner)
+}
+
+// Save mocks base method.
+func (m *MockNonceReconciliation) Save(acc *store.ReconciledAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", acc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockNonceReconciliationMockRecorder) Save(acc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockNonceReconciliation)(nil).Save), acc)
+}
+
+// Track mocks base method.
+func (m *MockNonceReconciliation) Track(acc *store.ReconciledAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Track", acc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Track indicates an expected call of Track.
+func (mr *MockNonceReconciliationMockRecorder) Track(acc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Track", reflect.TypeOf((*MockNonceReconciliation)(nil).Track), acc)
 }
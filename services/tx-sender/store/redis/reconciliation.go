@@ -0,0 +1,117 @@
+package redis
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/database/redis"
+	"github.com/consensys/orchestrate/services/tx-sender/store"
+)
+
+const (
+	reconciledAccountsSetKey = "nonce-reconciled-accounts"
+	reconciledAccountSuf     = "nonce-reconciled-account"
+	reconcileLeaseSuf        = "nonce-reconcile-lease"
+)
+
+// nonceReconciliation persists reconciled accounts in Redis so they are shared across tx-sender replicas
+type nonceReconciliation struct {
+	redis *redis.Client
+	ttl   time.Duration
+}
+
+// NewNonceReconciliation creates a new Redis NonceReconciliation. Accounts expire after ttl if never reconciled
+func NewNonceReconciliation(client *redis.Client, ttl time.Duration) store.NonceReconciliation {
+	return &nonceReconciliation{
+		redis: client,
+		ttl:   ttl,
+	}
+}
+
+func (r *nonceReconciliation) Track(acc *store.ReconciledAccount) error {
+	bData, err := json.Marshal(acc)
+	if err != nil {
+		return errors.EncodingError("failed to marshal reconciled account").AppendReason(err.Error())
+	}
+
+	_, err = r.redis.SetNX(computeKey(acc.Key, reconciledAccountSuf), bData, r.ttl)
+	if err != nil {
+		return err
+	}
+
+	return r.redis.AddToSet(reconciledAccountsSetKey, acc.Key)
+}
+
+func (r *nonceReconciliation) Load(key string) (*store.ReconciledAccount, bool, error) {
+	bData, ok, err := r.redis.LoadBytes(computeKey(key, reconciledAccountSuf))
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	acc := &store.ReconciledAccount{}
+	err = json.Unmarshal(bData, acc)
+	if err != nil {
+		return nil, false, errors.EncodingError("failed to unmarshal reconciled account").AppendReason(err.Error())
+	}
+
+	return acc, true, nil
+}
+
+func (r *nonceReconciliation) Save(acc *store.ReconciledAccount) error {
+	bData, err := json.Marshal(acc)
+	if err != nil {
+		return errors.EncodingError("failed to marshal reconciled account").AppendReason(err.Error())
+	}
+
+	err = r.redis.SetWithTTL(computeKey(acc.Key, reconciledAccountSuf), bData, r.ttl)
+	if err != nil {
+		return err
+	}
+
+	return r.redis.AddToSet(reconciledAccountsSetKey, acc.Key)
+}
+
+func (r *nonceReconciliation) Delete(key string) error {
+	err := r.redis.Delete(computeKey(key, reconciledAccountSuf))
+	if err != nil {
+		return err
+	}
+
+	return r.redis.RemoveFromSet(reconciledAccountsSetKey, key)
+}
+
+func (r *nonceReconciliation) List() ([]*store.ReconciledAccount, error) {
+	keys, err := r.redis.LoadSet(reconciledAccountsSetKey)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := []*store.ReconciledAccount{}
+	for _, key := range keys {
+		acc, ok, err := r.Load(key)
+		if err != nil {
+			return nil, err
+		}
+
+		// Account has expired, we clean the index
+		if !ok {
+			_ = r.redis.RemoveFromSet(reconciledAccountsSetKey, key)
+			continue
+		}
+
+		accounts = append(accounts, acc)
+	}
+
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].Key < accounts[j].Key })
+	return accounts, nil
+}
+
+func (r *nonceReconciliation) Acquire(key, owner string, ttl time.Duration) (bool, error) {
+	return r.redis.AcquireLease(computeKey(key, reconcileLeaseSuf), owner, ttl)
+}
+
+func (r *nonceReconciliation) Release(key, owner string) error {
+	return r.redis.ReleaseLease(computeKey(key, reconcileLeaseSuf), owner)
+}
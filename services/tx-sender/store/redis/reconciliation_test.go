@@ -0,0 +1,82 @@
+// +build unit
+
+package redis
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/consensys/orchestrate/pkg/toolkit/database/redis"
+	"github.com/consensys/orchestrate/services/tx-sender/store"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNonceReconciliationRedis(t *testing.T) {
+	mredis, _ := miniredis.Run()
+	defer mredis.Close()
+	conf := &redis.Config{
+		Expiration: 1,
+		Host:       mredis.Host(),
+		Port:       mredis.Port(),
+	}
+
+	pool, _ := redis.NewPool(conf)
+	r := NewNonceReconciliation(redis.NewClient(pool, conf), time.Hour)
+
+	gapNonce := uint64(3)
+	acc := &store.ReconciledAccount{
+		Key:       "key",
+		From:      ethcommon.HexToAddress("0x1"),
+		ChainUUID: "chainUUID",
+		ChainID:   big.NewInt(1),
+		Finding:   &store.NonceFinding{ChainUUID: "chainUUID", GapNonce: &gapNonce},
+	}
+
+	require.NoError(t, r.Track(acc))
+	acc.GapNonce = &gapNonce
+	require.NoError(t, r.Save(acc))
+
+	// Tracking an account already reconciled keeps its state
+	require.NoError(t, r.Track(&store.ReconciledAccount{Key: "key"}))
+	loaded, ok, err := r.Load("key")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, acc.From, loaded.From)
+	assert.Equal(t, "1", loaded.ChainID.String())
+	assert.Equal(t, gapNonce, *loaded.GapNonce)
+	assert.Equal(t, gapNonce, *loaded.Finding.GapNonce)
+
+	accounts, err := r.List()
+	require.NoError(t, err)
+	assert.Len(t, accounts, 1)
+
+	acquired, err := r.Acquire("key", "owner", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+	acquired, err = r.Acquire("key", "other-owner", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, acquired)
+	require.NoError(t, r.Release("key", "owner"))
+	acquired, err = r.Acquire("key", "other-owner", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	// Expired accounts are removed from the index
+	require.NoError(t, r.Track(&store.ReconciledAccount{Key: "expired"}))
+	mredis.Del(computeKey("expired", reconciledAccountSuf))
+	accounts, err = r.List()
+	require.NoError(t, err)
+	assert.Len(t, accounts, 1)
+
+	require.NoError(t, r.Delete("key"))
+	_, ok, err = r.Load("key")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	accounts, err = r.List()
+	require.NoError(t, err)
+	assert.Empty(t, accounts)
+}
@@ -1,5 +1,12 @@
 package store
 
+import (
+	"math/big"
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
 //go:generate mockgen -source=store.go -destination=mock/store.go -package=mock
 
 type NonceSender interface {
@@ -21,3 +28,58 @@ type RecoveryTracker interface {
 	Recover(key string)
 	Recovered(key string)
 }
+
+// NonceFinding is the state of the nonce of an account at the last reconciliation
+type NonceFinding struct {
+	Account        ethcommon.Address `json:"account"`
+	ChainUUID      string            `json:"chainUUID"`
+	ChainID        string            `json:"chainID"`
+	ConfirmedNonce uint64            `json:"confirmedNonce"`
+	PendingNonce   uint64            `json:"pendingNonce"`
+	LastSent       *uint64           `json:"lastSent,omitempty"`
+	// GapNonce is the lowest nonce missing in the pool, blocking the transactions sent with higher nonces
+	GapNonce    *uint64   `json:"gapNonce,omitempty"`
+	StuckTxs    uint64    `json:"stuckTxs,omitempty"`
+	FillJobUUID string    `json:"fillJobUUID,omitempty"`
+	Error       string    `json:"error,omitempty"`
+	CheckedAt   time.Time `json:"checkedAt"`
+}
+
+// ReconciledAccount is an account whose nonce is reconciled against the chain, with the state of its last reconciliation
+type ReconciledAccount struct {
+	Key       string            `json:"key"`
+	From      ethcommon.Address `json:"from"`
+	ChainUUID string            `json:"chainUUID"`
+	ChainID   *big.Int          `json:"chainID"`
+	TenantID  string            `json:"tenantID"`
+	OwnerID   string            `json:"ownerID,omitempty"`
+	// GapNonce is the gap detected at the previous reconciliation. A gap is only filled once seen twice in a row
+	GapNonce *uint64 `json:"gapNonce,omitempty"`
+	// FillNonce is the nonce of the last job sent to fill the gap
+	FillNonce *uint64       `json:"fillNonce,omitempty"`
+	FilledAt  time.Time     `json:"filledAt,omitempty"`
+	Finding   *NonceFinding `json:"finding,omitempty"`
+}
+
+type NonceReconciliation interface {
+	// Track registers the account if it is not already reconciled
+	Track(acc *ReconciledAccount) error
+
+	// Load retrieves a reconciled account
+	Load(key string) (acc *ReconciledAccount, ok bool, err error)
+
+	// Save persists the reconciliation state of an account
+	Save(acc *ReconciledAccount) error
+
+	// Delete stops the reconciliation of an account
+	Delete(key string) error
+
+	// List retrieves all reconciled accounts
+	List() ([]*ReconciledAccount, error)
+
+	// Acquire takes the lease on the reconciliation of an account. Returns false if held by another owner
+	Acquire(key, owner string, ttl time.Duration) (bool, error)
+
+	// Release releases the lease on the reconciliation of an account if held by the owner
+	Release(key, owner string) error
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/consensys/orchestrate/pkg/sdk/client"
 	"github.com/consensys/orchestrate/pkg/toolkit/ethclient"
 	keymanager "github.com/consensys/quorum-key-manager/pkg/client"
+	"github.com/consensys/orchestrate/services/tx-sender/store"
+	gasoracle "github.com/consensys/orchestrate/services/tx-sender/tx-sender/gas-oracle"
 	"github.com/consensys/orchestrate/services/tx-sender/tx-sender/nonce"
 	usecases "github.com/consensys/orchestrate/services/tx-sender/tx-sender/use-cases"
 	"github.com/consensys/orchestrate/services/tx-sender/tx-sender/use-cases/crafter"
@@ -17,22 +19,27 @@ type useCases struct {
 	sendEEAPrivateTx     usecases.SendEEAPrivateTxUseCase
 	sendTesseraPrivateTx usecases.SendTesseraPrivateTxUseCase
 	sendTesseraMarkingTx usecases.SendTesseraMarkingTxUseCase
+	sendSmartAccountTx   usecases.SendSmartAccountTxUseCase
 }
 
-func NewUseCases(jobClient client.JobClient, keyManagerClient keymanager.KeyManagerClient,
-	ec ethclient.MultiClient, nonceManager nonce.Manager, chainRegistryURL string, checkerMaxRecovery uint64) usecases.UseCases {
+func NewUseCases(jobClient client.JobClient, chainClient client.ChainClient, contractClient client.ContractClient,
+	keyManagerClient keymanager.KeyManagerClient, ec ethclient.MultiClient, nonceManager nonce.Manager, safeNonces store.NonceSender, chainRegistryURL string, checkerMaxRecovery uint64, gasOracleAllowedURLs []string) usecases.UseCases {
 	signETHTransactionUC := signer.NewSignETHTransactionUseCase(keyManagerClient)
 	signEEATransactionUC := signer.NewSignEEATransactionUseCase(keyManagerClient)
 	signQuorumTransactionUC := signer.NewSignQuorumPrivateTransactionUseCase(keyManagerClient)
 
-	crafterUC := crafter.NewCraftTransactionUseCase(ec, chainRegistryURL, nonceManager)
+	gasOracles := gasoracle.NewManager(chainClient, ec, chainRegistryURL, gasOracleAllowedURLs)
+	crafterUC := crafter.NewCraftTransactionUseCase(ec, chainRegistryURL, nonceManager, gasOracles, contractClient)
+
+	sendETHTxUC := sender.NewSendEthTxUseCase(signETHTransactionUC, crafterUC, ec, jobClient, chainRegistryURL, nonceManager)
 
 	return &useCases{
-		sendETHTx:            sender.NewSendEthTxUseCase(signETHTransactionUC, crafterUC, ec, jobClient, chainRegistryURL, nonceManager),
+		sendETHTx:            sendETHTxUC,
 		sendETHRawTx:         sender.NewSendETHRawTxUseCase(ec, jobClient, chainRegistryURL),
 		sendEEAPrivateTx:     sender.NewSendEEAPrivateTxUseCase(signEEATransactionUC, crafterUC, ec, jobClient, chainRegistryURL, nonceManager),
 		sendTesseraPrivateTx: sender.NewSendTesseraPrivateTxUseCase(ec, crafterUC, jobClient, chainRegistryURL),
 		sendTesseraMarkingTx: sender.NewSendTesseraMarkingTxUseCase(signQuorumTransactionUC, crafterUC, ec, jobClient, chainRegistryURL, nonceManager),
+		sendSmartAccountTx:   sender.NewSendSmartAccountTxUseCase(sendETHTxUC, keyManagerClient, ec, chainClient, jobClient, safeNonces, chainRegistryURL),
 	}
 }
 
@@ -55,3 +62,7 @@ func (u *useCases) SendTesseraPrivateTx() usecases.SendTesseraPrivateTxUseCase {
 func (u *useCases) SendTesseraMarkingTx() usecases.SendTesseraMarkingTxUseCase {
 	return u.sendTesseraMarkingTx
 }
+
+func (u *useCases) SendSmartAccountTx() usecases.SendSmartAccountTxUseCase {
+	return u.sendSmartAccountTx
+}
@@ -0,0 +1,65 @@
+package gasoracle
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/ethclient"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/utils"
+)
+
+const mediumPriorityString = "1500000000" // 1.5 gwei
+const thresholdString = "500000000"       // 0.5 gwei
+
+// defaultGasOracle applies multipliers per priority to the gas price suggested by the node, and fixed priority fees
+type defaultGasOracle struct {
+	ec               ethclient.MultiClient
+	chainRegistryURL string
+}
+
+func NewDefaultGasOracle(ec ethclient.MultiClient, chainRegistryURL string) GasOracle {
+	return &defaultGasOracle{
+		ec:               ec,
+		chainRegistryURL: chainRegistryURL,
+	}
+}
+
+func (o *defaultGasOracle) GasPrice(ctx context.Context, job *entities.Job) (*big.Int, error) {
+	proxyURL := utils.GetProxyURL(o.chainRegistryURL, job.ChainUUID)
+	gasPrice, err := o.ec.SuggestGasPrice(ctx, proxyURL)
+	if err != nil {
+		return nil, err
+	}
+
+	switch job.InternalData.Priority {
+	case utils.PriorityVeryLow:
+		return gasPrice.Mul(gasPrice, big.NewInt(6)).Div(gasPrice, big.NewInt(10)), nil
+	case utils.PriorityLow:
+		return gasPrice.Mul(gasPrice, big.NewInt(8)).Div(gasPrice, big.NewInt(10)), nil
+	case utils.PriorityHigh:
+		return gasPrice.Mul(gasPrice, big.NewInt(12)).Div(gasPrice, big.NewInt(10)), nil
+	case utils.PriorityVeryHigh:
+		return gasPrice.Mul(gasPrice, big.NewInt(14)).Div(gasPrice, big.NewInt(10)), nil
+	default:
+		return gasPrice, nil
+	}
+}
+
+func (o *defaultGasOracle) GasTipCap(_ context.Context, job *entities.Job) (*big.Int, error) {
+	mediumPriority, _ := new(big.Int).SetString(mediumPriorityString, 10) // 1.5 gwei
+	threshold, _ := new(big.Int).SetString(thresholdString, 10)           // 0.5 gwei
+
+	switch job.InternalData.Priority {
+	case utils.PriorityVeryLow:
+		return new(big.Int).Sub(mediumPriority, new(big.Int).Mul(threshold, big.NewInt(2))), nil // 0.5 gwei
+	case utils.PriorityLow:
+		return new(big.Int).Sub(mediumPriority, threshold), nil // 1 gwei
+	case utils.PriorityHigh:
+		return new(big.Int).Add(mediumPriority, threshold), nil // 2 gwei
+	case utils.PriorityVeryHigh:
+		return new(big.Int).Add(mediumPriority, new(big.Int).Mul(threshold, big.NewInt(2))), nil // 2.5 gwei
+	default:
+		return mediumPriority, nil // 1.5 gwei
+	}
+}
@@ -0,0 +1,105 @@
+package gasoracle
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/ethclient"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/utils"
+)
+
+const defaultFeeHistoryBlocks = 20
+
+// feeHistoryGasOracle uses a percentile of the priority fees paid over the last blocks, see eth_feeHistory
+type feeHistoryGasOracle struct {
+	ec               ethclient.MultiClient
+	chainRegistryURL string
+	blocks           uint64
+	percentile       float64
+}
+
+func NewFeeHistoryGasOracle(ec ethclient.MultiClient, chainRegistryURL string, blocks uint64, percentile float64) GasOracle {
+	if blocks == 0 {
+		blocks = defaultFeeHistoryBlocks
+	}
+
+	return &feeHistoryGasOracle{
+		ec:               ec,
+		chainRegistryURL: chainRegistryURL,
+		blocks:           blocks,
+		percentile:       percentile,
+	}
+}
+
+// GasPrice returns the base fee of the next block increased by the priority fee
+func (o *feeHistoryGasOracle) GasPrice(ctx context.Context, job *entities.Job) (*big.Int, error) {
+	baseFee, tip, err := o.feeHistory(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).Add(baseFee, tip), nil
+}
+
+func (o *feeHistoryGasOracle) GasTipCap(ctx context.Context, job *entities.Job) (*big.Int, error) {
+	_, tip, err := o.feeHistory(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+
+	return tip, nil
+}
+
+// feeHistory returns the base fee of the next block and the average of the priority fees at the oracle percentile
+func (o *feeHistoryGasOracle) feeHistory(ctx context.Context, job *entities.Job) (baseFee, tip *big.Int, err error) {
+	proxyURL := utils.GetProxyURL(o.chainRegistryURL, job.ChainUUID)
+	feeHistory, err := o.ec.FeeHistory(ctx, proxyURL, int(o.blocks), "latest", []float64{o.rewardPercentile(job.InternalData.Priority)})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	baseFee = big.NewInt(0)
+	if feeHistory != nil && len(feeHistory.BaseFeePerGas) > 0 {
+		baseFee = feeHistory.BaseFeePerGas[len(feeHistory.BaseFeePerGas)-1].ToInt()
+	}
+
+	sum := big.NewInt(0)
+	count := int64(0)
+	if feeHistory != nil {
+		for _, rewards := range feeHistory.Reward {
+			if len(rewards) == 0 {
+				continue
+			}
+
+			sum.Add(sum, rewards[0].ToInt())
+			count++
+		}
+	}
+
+	if count == 0 {
+		return nil, nil, errors.DependencyFailureError("fee history does not contain any priority fee")
+	}
+
+	return baseFee, sum.Div(sum, big.NewInt(count)), nil
+}
+
+func (o *feeHistoryGasOracle) rewardPercentile(priority string) float64 {
+	if o.percentile > 0 {
+		return o.percentile
+	}
+
+	switch priority {
+	case utils.PriorityVeryLow:
+		return 10
+	case utils.PriorityLow:
+		return 25
+	case utils.PriorityHigh:
+		return 75
+	case utils.PriorityVeryHigh:
+		return 90
+	default:
+		return 50
+	}
+}
@@ -0,0 +1,85 @@
+// +build unit
+
+package gasoracle
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	mock2 "github.com/consensys/orchestrate/pkg/toolkit/ethclient/mock"
+	"github.com/consensys/orchestrate/pkg/toolkit/ethclient/rpc"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeHistoryGasOracle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	ec := mock2.NewMockMultiClient(ctrl)
+	chainRegistryURL := "http://chain-registry:8081"
+
+	feeHistory := &rpc.FeeHistory{
+		Reward: [][]hexutil.Big{
+			{*(*hexutil.Big)(big.NewInt(100))},
+			{},
+			{*(*hexutil.Big)(big.NewInt(300))},
+		},
+		BaseFeePerGas: []hexutil.Big{*(*hexutil.Big)(big.NewInt(900)), *(*hexutil.Big)(big.NewInt(1000))},
+	}
+
+	t.Run("should use the average priority fee at the percentile of the job priority", func(t *testing.T) {
+		oracle := NewFeeHistoryGasOracle(ec, chainRegistryURL, 0, 0)
+		job := testutils.FakeJob()
+		job.InternalData.Priority = utils.PriorityHigh
+		proxyURL := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
+
+		ec.EXPECT().FeeHistory(gomock.Any(), proxyURL, defaultFeeHistoryBlocks, "latest", []float64{75}).Return(feeHistory, nil)
+		tip, err := oracle.GasTipCap(ctx, job)
+		require.NoError(t, err)
+		assert.Equal(t, "200", tip.String())
+
+		ec.EXPECT().FeeHistory(gomock.Any(), proxyURL, defaultFeeHistoryBlocks, "latest", []float64{75}).Return(feeHistory, nil)
+		gasPrice, err := oracle.GasPrice(ctx, job)
+		require.NoError(t, err)
+		assert.Equal(t, "1200", gasPrice.String())
+	})
+
+	t.Run("should use the configured percentile and number of blocks", func(t *testing.T) {
+		oracle := NewFeeHistoryGasOracle(ec, chainRegistryURL, 5, 30)
+		job := testutils.FakeJob()
+		job.InternalData.Priority = utils.PriorityVeryHigh
+		proxyURL := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
+
+		ec.EXPECT().FeeHistory(gomock.Any(), proxyURL, 5, "latest", []float64{30}).Return(feeHistory, nil)
+		_, err := oracle.GasTipCap(ctx, job)
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail if fee history does not contain any reward", func(t *testing.T) {
+		oracle := NewFeeHistoryGasOracle(ec, chainRegistryURL, 0, 0)
+		job := testutils.FakeJob()
+
+		ec.EXPECT().FeeHistory(gomock.Any(), gomock.Any(), gomock.Any(), "latest", []float64{50}).Return(&rpc.FeeHistory{}, nil)
+		_, err := oracle.GasTipCap(ctx, job)
+		assert.True(t, errors.IsDependencyFailureError(err))
+	})
+
+	t.Run("should fail if fee history cannot be fetched", func(t *testing.T) {
+		oracle := NewFeeHistoryGasOracle(ec, chainRegistryURL, 0, 0)
+		job := testutils.FakeJob()
+		expectedErr := fmt.Errorf("error")
+
+		ec.EXPECT().FeeHistory(gomock.Any(), gomock.Any(), gomock.Any(), "latest", gomock.Any()).Return(nil, expectedErr)
+		_, err := oracle.GasPrice(ctx, job)
+		assert.Equal(t, expectedErr, err)
+	})
+}
@@ -0,0 +1,41 @@
+package gasoracle
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// fixedGasOracle uses the gas fees configured on the chain, whatever the job priority
+type fixedGasOracle struct {
+	gasPrice  *big.Int
+	gasTipCap *big.Int
+}
+
+func NewFixedGasOracle(gasPrice, gasTipCap *hexutil.Big) GasOracle {
+	oracle := &fixedGasOracle{
+		gasPrice:  big.NewInt(0),
+		gasTipCap: big.NewInt(0),
+	}
+
+	if gasPrice != nil {
+		oracle.gasPrice = gasPrice.ToInt()
+		oracle.gasTipCap = gasPrice.ToInt()
+	}
+
+	if gasTipCap != nil {
+		oracle.gasTipCap = gasTipCap.ToInt()
+	}
+
+	return oracle
+}
+
+func (o *fixedGasOracle) GasPrice(_ context.Context, _ *entities.Job) (*big.Int, error) {
+	return new(big.Int).Set(o.gasPrice), nil
+}
+
+func (o *fixedGasOracle) GasTipCap(_ context.Context, _ *entities.Job) (*big.Int, error) {
+	return new(big.Int).Set(o.gasTipCap), nil
+}
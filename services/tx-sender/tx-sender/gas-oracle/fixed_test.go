@@ -0,0 +1,38 @@
+// +build unit
+
+package gasoracle
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixedGasOracle(t *testing.T) {
+	ctx := context.Background()
+	job := testutils.FakeJob()
+
+	t.Run("should return the configured gas price and priority fee", func(t *testing.T) {
+		oracle := NewFixedGasOracle((*hexutil.Big)(hexutil.MustDecodeBig("0x3e8")), (*hexutil.Big)(hexutil.MustDecodeBig("0x64")))
+
+		gasPrice, err := oracle.GasPrice(ctx, job)
+		require.NoError(t, err)
+		assert.Equal(t, "1000", gasPrice.String())
+
+		tip, err := oracle.GasTipCap(ctx, job)
+		require.NoError(t, err)
+		assert.Equal(t, "100", tip.String())
+	})
+
+	t.Run("should use the gas price as priority fee if not configured", func(t *testing.T) {
+		oracle := NewFixedGasOracle((*hexutil.Big)(hexutil.MustDecodeBig("0x3e8")), nil)
+
+		tip, err := oracle.GasTipCap(ctx, job)
+		require.NoError(t, err)
+		assert.Equal(t, "1000", tip.String())
+	})
+}
@@ -0,0 +1,97 @@
+package gasoracle
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/url"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// HTTPGasOracleResponse is the payload expected from an HTTP gas oracle
+type HTTPGasOracleResponse struct {
+	GasPrice  *hexutil.Big `json:"gasPrice,omitempty"`
+	GasTipCap *hexutil.Big `json:"maxPriorityFeePerGas,omitempty"`
+}
+
+// httpGasOracle fetches gas fees from an external service with GET <url>?chainUUID=<uuid>&priority=<priority>
+type httpGasOracle struct {
+	client *http.Client
+	url    string
+}
+
+func NewHTTPGasOracle(client *http.Client, oracleURL string) GasOracle {
+	return &httpGasOracle{
+		client: client,
+		url:    oracleURL,
+	}
+}
+
+func (o *httpGasOracle) GasPrice(ctx context.Context, job *entities.Job) (*big.Int, error) {
+	resp, err := o.fetch(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GasPrice == nil {
+		return nil, errors.DependencyFailureError("gas oracle did not return any gas price")
+	}
+
+	return resp.GasPrice.ToInt(), nil
+}
+
+func (o *httpGasOracle) GasTipCap(ctx context.Context, job *entities.Job) (*big.Int, error) {
+	resp, err := o.fetch(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GasTipCap == nil {
+		return nil, errors.DependencyFailureError("gas oracle did not return any priority fee")
+	}
+
+	return resp.GasTipCap.ToInt(), nil
+}
+
+func (o *httpGasOracle) fetch(ctx context.Context, job *entities.Job) (*HTTPGasOracleResponse, error) {
+	reqURL, err := url.Parse(o.url)
+	if err != nil {
+		return nil, errors.InvalidParameterError("invalid gas oracle url").AppendReason(err.Error())
+	}
+
+	query := reqURL.Query()
+	query.Set("chainUUID", job.ChainUUID)
+	if job.InternalData.Priority != "" {
+		query.Set("priority", job.InternalData.Priority)
+	}
+	reqURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL.String(), nil)
+	if err != nil {
+		return nil, errors.InvalidParameterError("failed to create gas oracle request").AppendReason(err.Error())
+	}
+
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return nil, errors.HTTPConnectionError("failed to reach gas oracle").AppendReason(err.Error())
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.DependencyFailureError("gas oracle responded with status %d", resp.StatusCode)
+	}
+
+	oracleResp := &HTTPGasOracleResponse{}
+	err = json.NewDecoder(resp.Body).Decode(oracleResp)
+	if err != nil {
+		return nil, errors.EncodingError("failed to decode gas oracle response").AppendReason(err.Error())
+	}
+
+	return oracleResp, nil
+}
@@ -0,0 +1,67 @@
+// +build unit
+
+package gasoracle
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPGasOracle(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should fetch gas fees from the oracle", func(t *testing.T) {
+		job := testutils.FakeJob()
+		job.InternalData.Priority = utils.PriorityLow
+
+		srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, job.ChainUUID, req.URL.Query().Get("chainUUID"))
+			assert.Equal(t, utils.PriorityLow, req.URL.Query().Get("priority"))
+			assert.Equal(t, "bar", req.URL.Query().Get("foo"))
+			_, _ = rw.Write([]byte(`{"gasPrice":"0x3e8","maxPriorityFeePerGas":"0x64"}`))
+		}))
+		defer srv.Close()
+
+		oracle := NewHTTPGasOracle(srv.Client(), srv.URL+"?foo=bar")
+
+		gasPrice, err := oracle.GasPrice(ctx, job)
+		require.NoError(t, err)
+		assert.Equal(t, "1000", gasPrice.String())
+
+		tip, err := oracle.GasTipCap(ctx, job)
+		require.NoError(t, err)
+		assert.Equal(t, "100", tip.String())
+	})
+
+	t.Run("should fail if the oracle does not return the expected fee", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			_, _ = rw.Write([]byte(`{"gasPrice":"0x3e8"}`))
+		}))
+		defer srv.Close()
+
+		oracle := NewHTTPGasOracle(srv.Client(), srv.URL)
+
+		_, err := oracle.GasTipCap(ctx, testutils.FakeJob())
+		assert.True(t, errors.IsDependencyFailureError(err))
+	})
+
+	t.Run("should fail if the oracle responds with an error", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			rw.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer srv.Close()
+
+		oracle := NewHTTPGasOracle(srv.Client(), srv.URL)
+
+		_, err := oracle.GasPrice(ctx, testutils.FakeJob())
+		assert.True(t, errors.IsDependencyFailureError(err))
+	})
+}
@@ -0,0 +1,160 @@
+package gasoracle
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/sdk/client"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/ethclient"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+)
+
+const component = "gas-oracle-manager"
+
+// chainCacheTTL is the duration during which the gas oracle of a chain is kept before fetching the chain again
+const chainCacheTTL = 30 * time.Second
+const httpOracleTimeout = 10 * time.Second
+
+type cachedGasOracle struct {
+	oracle    GasOracle
+	expiresAt time.Time
+}
+
+type manager struct {
+	chainClient      client.ChainClient
+	ec               ethclient.MultiClient
+	httpClient       *http.Client
+	chainRegistryURL string
+	defaultOracle    GasOracle
+	allowedURLs      []*url.URL
+	mutex            *sync.RWMutex
+	oracles          map[string]*cachedGasOracle
+	logger           *log.Logger
+}
+
+// NewManager creates a gas oracle manager. HTTP gas oracles are only used if their URL matches one of allowedURLs
+// (same scheme and host, path equal to the allowed path or below it)
+func NewManager(chainClient client.ChainClient, ec ethclient.MultiClient, chainRegistryURL string, allowedURLs []string) Manager {
+	logger := log.NewLogger().SetComponent(component)
+
+	var allowed []*url.URL
+	for _, rawURL := range allowedURLs {
+		u, err := url.Parse(rawURL)
+		if err != nil || u.Host == "" {
+			logger.WithField("url", rawURL).Warn("ignoring invalid allowed gas oracle url")
+			continue
+		}
+		allowed = append(allowed, u)
+	}
+
+	return &manager{
+		chainClient: chainClient,
+		ec:          ec,
+		httpClient: &http.Client{
+			Timeout: httpOracleTimeout,
+			// Redirects could point the oracle to a destination that is not allowed
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		chainRegistryURL: chainRegistryURL,
+		defaultOracle:    NewDefaultGasOracle(ec, chainRegistryURL),
+		allowedURLs:      allowed,
+		mutex:            &sync.RWMutex{},
+		oracles:          make(map[string]*cachedGasOracle),
+		logger:           logger,
+	}
+}
+
+func (m *manager) GetGasOracle(ctx context.Context, chainUUID string) (GasOracle, error) {
+	if oracle, ok := m.getCached(chainUUID); ok {
+		return oracle, nil
+	}
+
+	chain, err := m.chainClient.GetChain(ctx, chainUUID)
+	if err != nil {
+		m.logger.WithContext(ctx).WithError(err).WithField("chain", chainUUID).Error("failed to fetch chain")
+		return nil, err
+	}
+
+	oracle, err := m.newGasOracle(chain.GasOracle)
+	if err != nil {
+		m.logger.WithContext(ctx).WithError(err).WithField("chain", chainUUID).Error("invalid gas oracle")
+		return nil, err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.oracles[chainUUID] = &cachedGasOracle{oracle: oracle, expiresAt: time.Now().Add(chainCacheTTL)}
+
+	return oracle, nil
+}
+
+func (m *manager) getCached(chainUUID string) (GasOracle, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	cached, ok := m.oracles[chainUUID]
+	if !ok || time.Now().After(cached.expiresAt) {
+		return nil, false
+	}
+
+	return cached.oracle, true
+}
+
+func (m *manager) newGasOracle(cfg *entities.GasOracle) (GasOracle, error) {
+	if cfg == nil {
+		return m.defaultOracle, nil
+	}
+
+	switch cfg.Type {
+	case entities.FeeHistoryGasOracleType:
+		return NewFeeHistoryGasOracle(m.ec, m.chainRegistryURL, cfg.Blocks, cfg.Percentile), nil
+	case entities.FixedGasOracleType:
+		return NewFixedGasOracle(cfg.GasPrice, cfg.GasTipCap), nil
+	case entities.HTTPGasOracleType:
+		if !m.isAllowedURL(cfg.URL) {
+			return nil, errors.InvalidParameterError("gas oracle url %s is not allowed", cfg.URL).ExtendComponent(component)
+		}
+		return NewHTTPGasOracle(m.httpClient, cfg.URL), nil
+	default:
+		return m.defaultOracle, nil
+	}
+}
+
+func (m *manager) isAllowedURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+
+	// Dot segments and duplicated slashes are refused as the oracle could resolve them outside of the allowed path
+	urlPath := cleanPath(u.Path)
+	if strings.TrimSuffix(u.Path, "/") != strings.TrimSuffix(urlPath, "/") {
+		return false
+	}
+
+	for _, allowed := range m.allowedURLs {
+		if !strings.EqualFold(u.Scheme, allowed.Scheme) || !strings.EqualFold(u.Host, allowed.Host) {
+			continue
+		}
+
+		allowedPath := cleanPath(allowed.Path)
+		if allowedPath == "/" || urlPath == allowedPath || strings.HasPrefix(urlPath, allowedPath+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func cleanPath(p string) string {
+	return path.Clean("/" + p)
+}
@@ -0,0 +1,107 @@
+// +build unit
+
+package gasoracle
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/sdk/client/mock"
+	mock2 "github.com/consensys/orchestrate/pkg/toolkit/ethclient/mock"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManager_GetGasOracle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	chainClient := mock.NewMockChainClient(ctrl)
+	ec := mock2.NewMockMultiClient(ctrl)
+	chainRegistryURL := "http://chain-registry:8081"
+
+	m := NewManager(chainClient, ec, chainRegistryURL, []string{"http://gas-oracle/api"})
+
+	t.Run("should return default gas oracle if chain has no gas oracle", func(t *testing.T) {
+		chain := testutils.FakeChainResponse()
+		chainClient.EXPECT().GetChain(gomock.Any(), chain.UUID).Return(chain, nil)
+
+		oracle, err := m.GetGasOracle(ctx, chain.UUID)
+
+		require.NoError(t, err)
+		assert.IsType(t, &defaultGasOracle{}, oracle)
+	})
+
+	t.Run("should return gas oracle configured on the chain and cache it", func(t *testing.T) {
+		chain := testutils.FakeChainResponse()
+		chain.GasOracle = &entities.GasOracle{
+			Type:     entities.FixedGasOracleType,
+			GasPrice: (*hexutil.Big)(hexutil.MustDecodeBig("0x3b9aca00")),
+		}
+		chainClient.EXPECT().GetChain(gomock.Any(), chain.UUID).Return(chain, nil).Times(1)
+
+		oracle, err := m.GetGasOracle(ctx, chain.UUID)
+		require.NoError(t, err)
+		assert.IsType(t, &fixedGasOracle{}, oracle)
+
+		oracle, err = m.GetGasOracle(ctx, chain.UUID)
+		require.NoError(t, err)
+		assert.IsType(t, &fixedGasOracle{}, oracle)
+	})
+
+	t.Run("should return fee history and http gas oracles", func(t *testing.T) {
+		chain := testutils.FakeChainResponse()
+		chain.GasOracle = &entities.GasOracle{Type: entities.FeeHistoryGasOracleType, Blocks: 10}
+		chainClient.EXPECT().GetChain(gomock.Any(), chain.UUID).Return(chain, nil)
+
+		oracle, err := m.GetGasOracle(ctx, chain.UUID)
+		require.NoError(t, err)
+		assert.IsType(t, &feeHistoryGasOracle{}, oracle)
+
+		chain = testutils.FakeChainResponse()
+		chain.GasOracle = &entities.GasOracle{Type: entities.HTTPGasOracleType, URL: "http://gas-oracle/api/fees"}
+		chainClient.EXPECT().GetChain(gomock.Any(), chain.UUID).Return(chain, nil)
+
+		oracle, err = m.GetGasOracle(ctx, chain.UUID)
+		require.NoError(t, err)
+		assert.IsType(t, &httpGasOracle{}, oracle)
+
+		chain = testutils.FakeChainResponse()
+		chain.GasOracle = &entities.GasOracle{Type: entities.HTTPGasOracleType, URL: "http://gas-oracle/api"}
+		chainClient.EXPECT().GetChain(gomock.Any(), chain.UUID).Return(chain, nil)
+
+		oracle, err = m.GetGasOracle(ctx, chain.UUID)
+		require.NoError(t, err)
+		assert.IsType(t, &httpGasOracle{}, oracle)
+	})
+
+	t.Run("should fail if http gas oracle url is not allowed", func(t *testing.T) {
+		for _, oracleURL := range []string{"http://169.254.169.254/api", "https://gas-oracle/api", "http://gas-oracle/admin",
+			"http://gas-oracle.attacker.com/api", "http://gas-oracle/api-admin", "http://gas-oracle/api/../internal",
+			"http://gas-oracle/api/%2e%2e/internal", "http://gas-oracle//api/fees"} {
+			chain := testutils.FakeChainResponse()
+			chain.GasOracle = &entities.GasOracle{Type: entities.HTTPGasOracleType, URL: oracleURL}
+			chainClient.EXPECT().GetChain(gomock.Any(), chain.UUID).Return(chain, nil)
+
+			_, err := m.GetGasOracle(ctx, chain.UUID)
+
+			assert.True(t, errors.IsInvalidParameterError(err), oracleURL)
+		}
+	})
+
+	t.Run("should fail if chain cannot be fetched", func(t *testing.T) {
+		expectedErr := fmt.Errorf("error")
+		chainClient.EXPECT().GetChain(gomock.Any(), "chainUUID").Return(nil, expectedErr)
+
+		_, err := m.GetGasOracle(ctx, "chainUUID")
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
@@ -0,0 +1,24 @@
+package gasoracle
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/consensys/orchestrate/pkg/types/entities"
+)
+
+//go:generate mockgen -source=oracle.go -destination=mocks/oracle.go -package=mocks
+
+// GasOracle computes the gas fees of a job
+type GasOracle interface {
+	// GasPrice returns the gas price of a legacy transaction
+	GasPrice(ctx context.Context, job *entities.Job) (*big.Int, error)
+
+	// GasTipCap returns the priority fee of a dynamic fee transaction
+	GasTipCap(ctx context.Context, job *entities.Job) (*big.Int, error)
+}
+
+// Manager returns the gas oracle configured for a chain
+type Manager interface {
+	GetGasOracle(ctx context.Context, chainUUID string) (GasOracle, error)
+}
@@ -0,0 +1,289 @@
+package nonce
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	orchestrateclient "github.com/consensys/orchestrate/pkg/sdk/client"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/ethclient"
+	types "github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/tx"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/services/tx-sender/store"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const reconcilerComponent = "nonce-reconciler"
+
+// GapFillLabel is set on the jobs sent to fill nonce gaps
+const GapFillLabel = "nonce-gap-fill"
+
+//go:generate mockgen -source=reconciler.go -destination=mocks/reconciler.go -package=mocks -aux_files=github.com/consensys/orchestrate/services/tx-sender/tx-sender/nonce=manager.go
+
+// reconcileLeaseTTL is the maximum duration of the reconciliation of an account by a replica
+const reconcileLeaseTTL = time.Minute
+
+// Reconciler is a nonce Manager keeping track of the accounts it manages,
+// in order to reconcile their last sent nonce against the on-chain state
+type Reconciler interface {
+	Manager
+	Reconcile(ctx context.Context)
+	Findings() ([]*Finding, error)
+}
+
+// Finding is the state of the nonce of an account at the last reconciliation
+type Finding = store.NonceFinding
+
+type reconciler struct {
+	Manager
+	nonce            store.NonceSender
+	accounts         store.NonceReconciliation
+	ec               ethclient.MultiClient
+	client           orchestrateclient.OrchestrateClient
+	chainRegistryURL string
+	owner            string
+	fillGaps         bool
+	fillTimeout      time.Duration
+	logger           *log.Logger
+}
+
+// NewReconciler wraps a nonce Manager. Reconciled accounts are persisted in the accounts store and reconciled by a
+// single replica at a time, identified by owner. Gaps are filled with zero value self-transfers if fillGaps is set,
+// a gap is filled again if still present after fillTimeout
+func NewReconciler(nm Manager, ns store.NonceSender, accounts store.NonceReconciliation, ec ethclient.MultiClient,
+	client orchestrateclient.OrchestrateClient, chainRegistryURL, owner string, fillGaps bool, fillTimeout time.Duration) Reconciler {
+	return &reconciler{
+		Manager:          nm,
+		nonce:            ns,
+		accounts:         accounts,
+		ec:               ec,
+		client:           client,
+		chainRegistryURL: chainRegistryURL,
+		owner:            owner,
+		fillGaps:         fillGaps,
+		fillTimeout:      fillTimeout,
+		logger:           log.NewLogger().SetComponent(reconcilerComponent),
+	}
+}
+
+func (r *reconciler) IncrementNonce(ctx context.Context, job *entities.Job) error {
+	err := r.Manager.IncrementNonce(ctx, job)
+	if err != nil {
+		return err
+	}
+
+	r.track(ctx, job)
+	return nil
+}
+
+func (r *reconciler) Findings() ([]*Finding, error) {
+	accounts, err := r.accounts.List()
+	if err != nil {
+		return nil, err
+	}
+
+	findings := make([]*Finding, 0, len(accounts))
+	for _, acc := range accounts {
+		if acc.Finding != nil {
+			findings = append(findings, acc.Finding)
+		}
+	}
+
+	return findings, nil
+}
+
+func (r *reconciler) Reconcile(ctx context.Context) {
+	accounts, err := r.accounts.List()
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).Error("cannot retrieve reconciled accounts")
+		return
+	}
+
+	for _, acc := range accounts {
+		if ctx.Err() != nil {
+			return
+		}
+
+		r.reconcile(ctx, acc.Key)
+	}
+}
+
+// track registers the accounts of public transactions, the only ones for which nonces can be reconciled
+func (r *reconciler) track(ctx context.Context, job *entities.Job) {
+	if job.Transaction.From == nil || job.InternalData.ChainID == nil || job.InternalData.OneTimeKey ||
+		string(job.Type) == tx.JobType_ETH_EEA_PRIVATE_TX.String() ||
+		string(job.Type) == tx.JobType_ETH_TESSERA_PRIVATE_TX.String() {
+		return
+	}
+
+	err := r.accounts.Track(&store.ReconciledAccount{
+		Key:       partitionKey(job),
+		From:      *job.Transaction.From,
+		ChainUUID: job.ChainUUID,
+		ChainID:   job.InternalData.ChainID,
+		TenantID:  job.TenantID,
+		OwnerID:   job.OwnerID,
+	})
+	if err != nil {
+		r.logger.WithContext(ctx).WithError(err).WithField("account", job.Transaction.From.String()).
+			Warn("failed to track account for nonce reconciliation")
+	}
+}
+
+// reconcile reconciles an account under its lease, so replicas sharing the accounts store never fill the same gap twice
+func (r *reconciler) reconcile(ctx context.Context, key string) {
+	logger := r.logger.WithContext(ctx).WithField("key", key)
+
+	acquired, err := r.accounts.Acquire(key, r.owner, reconcileLeaseTTL)
+	if err != nil {
+		logger.WithError(err).Error("failed to acquire reconciliation lease")
+		return
+	}
+	if !acquired {
+		logger.Debug("account is being reconciled by another replica")
+		return
+	}
+	defer func() {
+		if err := r.accounts.Release(key, r.owner); err != nil {
+			logger.WithError(err).Warn("failed to release reconciliation lease")
+		}
+	}()
+
+	// State is loaded under the lease as it could have been updated by another replica
+	acc, ok, err := r.accounts.Load(key)
+	if err != nil {
+		logger.WithError(err).Error("cannot retrieve reconciled account")
+		return
+	}
+	if !ok {
+		return
+	}
+
+	if !r.reconcileAccount(ctx, acc) {
+		if err := r.accounts.Delete(key); err != nil {
+			logger.WithError(err).Error("failed to delete reconciled account")
+		}
+		return
+	}
+
+	if err := r.accounts.Save(acc); err != nil {
+		logger.WithError(err).Error("failed to save reconciliation state")
+	}
+}
+
+// reconcileAccount updates the reconciliation state of the account. It returns false if the account must no longer be reconciled
+func (r *reconciler) reconcileAccount(ctx context.Context, acc *store.ReconciledAccount) bool {
+	logger := r.logger.WithContext(ctx).WithField("account", acc.From.String()).WithField("chain", acc.ChainUUID)
+
+	finding := &Finding{
+		Account:   acc.From,
+		ChainUUID: acc.ChainUUID,
+		ChainID:   acc.ChainID.String(),
+		CheckedAt: time.Now().UTC(),
+	}
+	acc.Finding = finding
+
+	lastSent, ok, err := r.nonce.GetLastSent(acc.Key)
+	if err != nil {
+		logger.WithError(err).Error("cannot retrieve lastSent nonce")
+		finding.Error = err.Error()
+		return true
+	}
+
+	// Nonce of the account has expired from the store, the next transaction will be calibrated from the chain
+	if !ok {
+		logger.Debug("no last sent nonce, account is no longer reconciled")
+		return false
+	}
+	finding.LastSent = &lastSent
+
+	url := utils.GetProxyURL(r.chainRegistryURL, acc.ChainUUID)
+	finding.ConfirmedNonce, err = r.ec.NonceAt(ctx, url, acc.From, nil)
+	if err != nil {
+		logger.WithError(err).Error("cannot retrieve confirmed nonce")
+		finding.Error = err.Error()
+		return true
+	}
+
+	finding.PendingNonce, err = r.ec.PendingNonceAt(ctx, url, acc.From)
+	if err != nil {
+		logger.WithError(err).Error("cannot retrieve pending nonce")
+		finding.Error = err.Error()
+		return true
+	}
+
+	// Transactions sent with nonces [pending, lastSent] are not executable
+	if lastSent < finding.PendingNonce {
+		acc.GapNonce = nil
+		return true
+	}
+
+	gapNonce := finding.PendingNonce
+	finding.GapNonce = &gapNonce
+	finding.StuckTxs = lastSent - gapNonce + 1
+
+	// Wait for the gap to be confirmed to not fill a nonce which is being propagated
+	if acc.GapNonce == nil || *acc.GapNonce != gapNonce {
+		logger.WithField("gap_nonce", gapNonce).Debug("nonce gap detected, waiting for confirmation")
+		acc.GapNonce = &gapNonce
+		return true
+	}
+
+	logger.WithField("gap_nonce", gapNonce).WithField("last_sent", lastSent).Warn("nonce gap confirmed")
+	if !r.fillGaps {
+		return true
+	}
+
+	if acc.FillNonce != nil && *acc.FillNonce == gapNonce && time.Since(acc.FilledAt) < r.fillTimeout {
+		logger.WithField("gap_nonce", gapNonce).Debug("nonce gap is already being filled")
+		return true
+	}
+
+	jobUUID, err := r.fillGap(ctx, acc, gapNonce)
+	if err != nil {
+		logger.WithError(err).Error("failed to fill nonce gap")
+		finding.Error = err.Error()
+		return true
+	}
+
+	logger.WithField("gap_nonce", gapNonce).WithField("job", jobUUID).Info("nonce gap filled")
+	acc.FillNonce = &gapNonce
+	acc.FilledAt = time.Now()
+	finding.FillJobUUID = jobUUID
+
+	return true
+}
+
+// fillGap sends a zero value self-transfer with the missing nonce through the API
+func (r *reconciler) fillGap(ctx context.Context, acc *store.ReconciledAccount, gapNonce uint64) (string, error) {
+	ctx = multitenancy.WithUserInfo(ctx, multitenancy.NewUserInfo(acc.TenantID, acc.OwnerID))
+
+	chain, err := r.client.GetChain(ctx, acc.ChainUUID)
+	if err != nil {
+		return "", err
+	}
+
+	txResponse, err := r.client.SendTransferTransaction(ctx, &types.TransferRequest{
+		ChainName: chain.Name,
+		Labels:    map[string]string{GapFillLabel: "true"},
+		Params: types.TransferParams{
+			Value: (*hexutil.Big)(big.NewInt(0)),
+			Nonce: &gapNonce,
+			From:  acc.From,
+			To:    acc.From,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(txResponse.Jobs) == 0 {
+		return "", nil
+	}
+
+	return txResponse.Jobs[0].UUID, nil
+}
@@ -0,0 +1,233 @@
+// +build unit
+
+package nonce
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	clientmock "github.com/consensys/orchestrate/pkg/sdk/client/mock"
+	mock2 "github.com/consensys/orchestrate/pkg/toolkit/ethclient/mock"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/services/tx-sender/store"
+	"github.com/consensys/orchestrate/services/tx-sender/store/memory"
+	"github.com/consensys/orchestrate/services/tx-sender/store/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubManager struct {
+	Manager
+	incrementErr error
+}
+
+func (m *stubManager) IncrementNonce(_ context.Context, _ *entities.Job) error {
+	return m.incrementErr
+}
+
+func getFindings(t *testing.T, r Reconciler) []*Finding {
+	findings, err := r.Findings()
+	require.NoError(t, err)
+	return findings
+}
+
+func TestNonceReconciler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ec := mock2.NewMockMultiClient(ctrl)
+	ns := mock.NewMockNonceSender(ctrl)
+	nm := &stubManager{}
+	client := clientmock.NewMockOrchestrateClient(ctrl)
+	chainRegistryURL := "http://chain-registry:8081"
+	owner := "owner"
+	ctx := context.Background()
+
+	newTrackedJob := func(r Reconciler) *entities.Job {
+		job := testutils.FakeJob()
+		require.NoError(t, r.IncrementNonce(ctx, job))
+		return job
+	}
+
+	t.Run("should not reconcile accounts without last sent nonce", func(t *testing.T) {
+		r := NewReconciler(nm, ns, memory.NewNonceReconciliation(), ec, client, chainRegistryURL, owner, true, time.Minute)
+		job := newTrackedJob(r)
+
+		ns.EXPECT().GetLastSent(partitionKey(job)).Return(uint64(0), false, nil)
+		r.Reconcile(ctx)
+		assert.Empty(t, getFindings(t, r))
+
+		// Account is no longer tracked
+		r.Reconcile(ctx)
+	})
+
+	t.Run("should not track private and one time key transactions", func(t *testing.T) {
+		r := NewReconciler(nm, ns, memory.NewNonceReconciliation(), ec, client, chainRegistryURL, owner, true, time.Minute)
+
+		job := testutils.FakeJob()
+		job.InternalData.OneTimeKey = true
+		require.NoError(t, r.IncrementNonce(ctx, job))
+
+		r.Reconcile(ctx)
+		assert.Empty(t, getFindings(t, r))
+	})
+
+	t.Run("should not track account if increment fails", func(t *testing.T) {
+		expectedErr := fmt.Errorf("error")
+		r := NewReconciler(&stubManager{incrementErr: expectedErr}, ns, memory.NewNonceReconciliation(), ec, client, chainRegistryURL, owner, true, time.Minute)
+
+		job := testutils.FakeJob()
+		assert.Equal(t, expectedErr, r.IncrementNonce(ctx, job))
+
+		r.Reconcile(ctx)
+		assert.Empty(t, getFindings(t, r))
+	})
+
+	t.Run("should report account without gap", func(t *testing.T) {
+		r := NewReconciler(nm, ns, memory.NewNonceReconciliation(), ec, client, chainRegistryURL, owner, true, time.Minute)
+		job := newTrackedJob(r)
+		url := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
+
+		ns.EXPECT().GetLastSent(partitionKey(job)).Return(uint64(4), true, nil)
+		ec.EXPECT().NonceAt(ctx, url, *job.Transaction.From, nil).Return(uint64(3), nil)
+		ec.EXPECT().PendingNonceAt(ctx, url, *job.Transaction.From).Return(uint64(5), nil)
+
+		r.Reconcile(ctx)
+
+		findings := getFindings(t, r)
+		require.Len(t, findings, 1)
+		assert.Equal(t, *job.Transaction.From, findings[0].Account)
+		assert.Equal(t, job.ChainUUID, findings[0].ChainUUID)
+		assert.Equal(t, uint64(3), findings[0].ConfirmedNonce)
+		assert.Equal(t, uint64(5), findings[0].PendingNonce)
+		assert.Equal(t, uint64(4), *findings[0].LastSent)
+		assert.Nil(t, findings[0].GapNonce)
+		assert.Empty(t, findings[0].Error)
+	})
+
+	t.Run("should fill gap once confirmed", func(t *testing.T) {
+		r := NewReconciler(nm, ns, memory.NewNonceReconciliation(), ec, client, chainRegistryURL, owner, true, time.Minute)
+		job := newTrackedJob(r)
+		url := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
+		chain := testutils.FakeChain()
+		fillJobUUID := "fill-job-uuid"
+
+		ns.EXPECT().GetLastSent(partitionKey(job)).Return(uint64(7), true, nil).Times(3)
+		ec.EXPECT().NonceAt(ctx, url, *job.Transaction.From, nil).Return(uint64(5), nil).Times(3)
+		ec.EXPECT().PendingNonceAt(ctx, url, *job.Transaction.From).Return(uint64(5), nil).Times(3)
+
+		// First round only detects the gap
+		r.Reconcile(ctx)
+		findings := getFindings(t, r)
+		require.Len(t, findings, 1)
+		assert.Equal(t, uint64(5), *findings[0].GapNonce)
+		assert.Equal(t, uint64(3), findings[0].StuckTxs)
+		assert.Empty(t, findings[0].FillJobUUID)
+
+		// Second round fills it on behalf of the owner of the job
+		client.EXPECT().GetChain(gomock.Any(), job.ChainUUID).DoAndReturn(
+			func(reqCtx context.Context, _ string) (*api.ChainResponse, error) {
+				userInfo := multitenancy.UserInfoValue(reqCtx)
+				assert.Equal(t, job.TenantID, userInfo.TenantID)
+				assert.Equal(t, job.OwnerID, userInfo.Username)
+				return &api.ChainResponse{UUID: job.ChainUUID, Name: chain.Name}, nil
+			})
+		client.EXPECT().SendTransferTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, req *api.TransferRequest) (*api.TransactionResponse, error) {
+				assert.Equal(t, chain.Name, req.ChainName)
+				assert.Equal(t, uint64(5), *req.Params.Nonce)
+				assert.Equal(t, *job.Transaction.From, req.Params.From)
+				assert.Equal(t, *job.Transaction.From, req.Params.To)
+				assert.Equal(t, "0", req.Params.Value.ToInt().String())
+				assert.Equal(t, "true", req.Labels[GapFillLabel])
+				return &api.TransactionResponse{Jobs: []*api.JobResponse{{UUID: fillJobUUID}}}, nil
+			})
+
+		r.Reconcile(ctx)
+		findings = getFindings(t, r)
+		require.Len(t, findings, 1)
+		assert.Equal(t, fillJobUUID, findings[0].FillJobUUID)
+
+		// Third round waits for the fill job to be mined
+		r.Reconcile(ctx)
+		findings = getFindings(t, r)
+		require.Len(t, findings, 1)
+		assert.Equal(t, uint64(5), *findings[0].GapNonce)
+		assert.Empty(t, findings[0].FillJobUUID)
+	})
+
+	t.Run("should not fill gap if disabled", func(t *testing.T) {
+		r := NewReconciler(nm, ns, memory.NewNonceReconciliation(), ec, client, chainRegistryURL, owner, false, time.Minute)
+		job := newTrackedJob(r)
+		url := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
+
+		ns.EXPECT().GetLastSent(partitionKey(job)).Return(uint64(2), true, nil).Times(2)
+		ec.EXPECT().NonceAt(ctx, url, *job.Transaction.From, nil).Return(uint64(1), nil).Times(2)
+		ec.EXPECT().PendingNonceAt(ctx, url, *job.Transaction.From).Return(uint64(1), nil).Times(2)
+
+		r.Reconcile(ctx)
+		r.Reconcile(ctx)
+
+		findings := getFindings(t, r)
+		require.Len(t, findings, 1)
+		assert.Equal(t, uint64(1), *findings[0].GapNonce)
+		assert.Empty(t, findings[0].FillJobUUID)
+	})
+
+	t.Run("should report error if chain cannot be reached", func(t *testing.T) {
+		r := NewReconciler(nm, ns, memory.NewNonceReconciliation(), ec, client, chainRegistryURL, owner, true, time.Minute)
+		job := newTrackedJob(r)
+		url := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
+
+		ns.EXPECT().GetLastSent(partitionKey(job)).Return(uint64(2), true, nil)
+		ec.EXPECT().NonceAt(ctx, url, *job.Transaction.From, nil).Return(uint64(0), fmt.Errorf("connection refused"))
+
+		r.Reconcile(ctx)
+
+		findings := getFindings(t, r)
+		require.Len(t, findings, 1)
+		assert.Equal(t, "connection refused", findings[0].Error)
+	})
+
+	t.Run("should share reconciled accounts between replicas", func(t *testing.T) {
+		accounts := memory.NewNonceReconciliation()
+		r1 := NewReconciler(nm, ns, accounts, ec, client, chainRegistryURL, "replica-1", false, time.Minute)
+		r2 := NewReconciler(nm, ns, accounts, ec, client, chainRegistryURL, "replica-2", false, time.Minute)
+		job := newTrackedJob(r1)
+		url := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
+
+		ns.EXPECT().GetLastSent(partitionKey(job)).Return(uint64(2), true, nil).Times(2)
+		ec.EXPECT().NonceAt(ctx, url, *job.Transaction.From, nil).Return(uint64(1), nil).Times(2)
+		ec.EXPECT().PendingNonceAt(ctx, url, *job.Transaction.From).Return(uint64(1), nil).Times(2)
+
+		r1.Reconcile(ctx)
+		r2.Reconcile(ctx)
+
+		// Gap detected by the first replica is confirmed by the second one
+		acc, ok, err := accounts.Load(partitionKey(job))
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, uint64(1), *acc.GapNonce)
+		findings := getFindings(t, r2)
+		require.Len(t, findings, 1)
+		assert.Equal(t, uint64(1), *findings[0].GapNonce)
+	})
+
+	t.Run("should not reconcile account leased by another replica", func(t *testing.T) {
+		accounts := mock.NewMockNonceReconciliation(ctrl)
+		r := NewReconciler(nm, ns, accounts, ec, client, chainRegistryURL, owner, true, time.Minute)
+		key := "key"
+
+		accounts.EXPECT().List().Return([]*store.ReconciledAccount{{Key: key}}, nil)
+		accounts.EXPECT().Acquire(key, owner, reconcileLeaseTTL).Return(false, nil)
+
+		r.Reconcile(ctx)
+	})
+}
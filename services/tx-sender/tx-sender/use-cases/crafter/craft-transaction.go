@@ -4,11 +4,17 @@ import (
 	"context"
 	"math/big"
 
+	"github.com/consensys/orchestrate/pkg/errors"
+	ethabi "github.com/consensys/orchestrate/pkg/ethereum/abi"
+	"github.com/consensys/orchestrate/pkg/sdk/client"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/pkg/toolkit/ethclient"
+	ethclientutils "github.com/consensys/orchestrate/pkg/toolkit/ethclient/utils"
+	"github.com/consensys/orchestrate/pkg/types/api"
 	"github.com/consensys/orchestrate/pkg/types/entities"
 	"github.com/consensys/orchestrate/pkg/types/tx"
 	"github.com/consensys/orchestrate/pkg/utils"
+	gasoracle "github.com/consensys/orchestrate/services/tx-sender/tx-sender/gas-oracle"
 	"github.com/consensys/orchestrate/services/tx-sender/tx-sender/nonce"
 	usecases "github.com/consensys/orchestrate/services/tx-sender/tx-sender/use-cases"
 	"github.com/ethereum/go-ethereum"
@@ -19,21 +25,23 @@ import (
 const estimationGasError = "cannot estimate gas usage"
 const craftTransactionComponent = "use-cases.craft-transaction"
 
-const mediumPriorityString = "1500000000" // 1.5 gwei
-const thresholdString = "500000000"       // 0.5 gwei
-
 type craftTxUseCase struct {
 	nonceManager     nonce.Manager
+	gasOracles       gasoracle.Manager
 	ec               ethclient.MultiClient
+	contractClient   client.ContractClient
 	chainRegistryURL string
 	logger           *log.Logger
 }
 
-func NewCraftTransactionUseCase(ec ethclient.MultiClient, chainRegistryURL string, nonceManager nonce.Manager) usecases.CraftTransactionUseCase {
+func NewCraftTransactionUseCase(ec ethclient.MultiClient, chainRegistryURL string, nonceManager nonce.Manager,
+	gasOracles gasoracle.Manager, contractClient client.ContractClient) usecases.CraftTransactionUseCase {
 	return &craftTxUseCase{
 		ec:               ec,
+		contractClient:   contractClient,
 		chainRegistryURL: chainRegistryURL,
 		nonceManager:     nonceManager,
+		gasOracles:       gasOracles,
 		logger:           log.NewLogger().SetComponent(craftTransactionComponent),
 	}
 }
@@ -67,6 +75,13 @@ func (uc *craftTxUseCase) Execute(ctx context.Context, job *entities.Job) error
 		}
 	}
 
+	// Children jobs are resent with the same data as their parent job, which has already been simulated
+	if job.InternalData.Simulate && job.Type == entities.EthereumTransaction && job.InternalData.ParentJobUUID != job.UUID {
+		if err := uc.simulate(ctx, job); err != nil {
+			return err
+		}
+	}
+
 	if job.Transaction.Gas == nil {
 		if err := uc.craftGasEstimation(ctx, job); err != nil {
 			return err
@@ -122,24 +137,7 @@ func (uc *craftTxUseCase) craftGasEstimation(ctx context.Context, job *entities.
 		return nil
 	}
 
-	call := &ethereum.CallMsg{}
-	if job.InternalData.OneTimeKey {
-		call.From = ethcommon.HexToAddress("0x1")
-	} else {
-		call.From = *job.Transaction.From
-	}
-
-	if job.Transaction.To != nil {
-		call.To = job.Transaction.To
-	}
-
-	if job.Transaction.Value != nil {
-		call.Value = job.Transaction.Value.ToInt()
-	}
-
-	if job.Transaction.Data != nil {
-		call.Data = job.Transaction.Data
-	}
+	call := newCallMsg(job)
 
 	// We update the data to an arbitrary hash
 	// to avoid errors raised on eth_estimateGas on Besu 1.5.4 & 1.5.5
@@ -149,6 +147,9 @@ func (uc *craftTxUseCase) craftGasEstimation(ctx context.Context, job *entities.
 
 	proxyURL := utils.GetProxyURL(uc.chainRegistryURL, job.ChainUUID)
 	gasEstimated, err := uc.ec.EstimateGas(ctx, proxyURL, call)
+	if errors.IsRevertedError(err) {
+		return uc.revertedError(ctx, job, err)
+	}
 	if err != nil {
 		logger.WithError(err).Error(estimationGasError)
 		return err
@@ -159,6 +160,55 @@ func (uc *craftTxUseCase) craftGasEstimation(ctx context.Context, job *entities.
 	return nil
 }
 
+// simulate executes the transaction with eth_call at pending state and fails if it reverts
+func (uc *craftTxUseCase) simulate(ctx context.Context, job *entities.Job) error {
+	call := newCallMsg(job)
+	if job.Transaction.Gas != nil {
+		call.Gas = *job.Transaction.Gas
+	}
+
+	proxyURL := utils.GetProxyURL(uc.chainRegistryURL, job.ChainUUID)
+	_, err := uc.ec.PendingCallContract(ctx, proxyURL, call)
+	if errors.IsRevertedError(err) {
+		return uc.revertedError(ctx, job, err)
+	}
+	if err != nil {
+		uc.logger.WithContext(ctx).WithError(err).Error("cannot simulate transaction")
+		return err
+	}
+
+	uc.logger.WithContext(ctx).Debug("transaction simulated successfully")
+	return nil
+}
+
+// revertedError decodes the reason of a reverted execution. Custom errors are decoded against the ABI of the contract
+// registered at the recipient address
+func (uc *craftTxUseCase) revertedError(ctx context.Context, job *entities.Job, err error) error {
+	logger := uc.logger.WithContext(ctx)
+
+	data := ethclientutils.RevertData(err)
+	reason, err := ethabi.DecodeRevertReason(data)
+	if errors.IsNotFoundError(err) && job.Transaction.To != nil {
+		var contract *api.ContractResponse
+		contract, err = uc.contractClient.SearchContract(ctx, &api.SearchContractRequest{Address: job.Transaction.To})
+		if err == nil {
+			reason, err = ethabi.DecodeRevertReason(data, contract.ABI)
+		}
+	}
+
+	switch {
+	case err != nil:
+		logger.WithError(err).Warn("cannot decode revert reason")
+		return errors.RevertedError("transaction reverted with data %s", hexutil.Encode(data))
+	case reason == "":
+		logger.Warn("transaction reverted without reason")
+		return errors.RevertedError("transaction reverted without reason")
+	default:
+		logger.WithField("reason", reason).Warn("transaction reverted")
+		return errors.RevertedError("transaction reverted: %s", reason)
+	}
+}
+
 func (uc *craftTxUseCase) craftGasPrice(ctx context.Context, job *entities.Job) error {
 	logger := uc.logger.WithContext(ctx)
 
@@ -167,27 +217,16 @@ func (uc *craftTxUseCase) craftGasPrice(ctx context.Context, job *entities.Job)
 		return nil
 	}
 
-	proxyURL := utils.GetProxyURL(uc.chainRegistryURL, job.ChainUUID)
-	gasPrice, err := uc.ec.SuggestGasPrice(ctx, proxyURL)
+	gasOracle, err := uc.gasOracles.GetGasOracle(ctx, job.ChainUUID)
 	if err != nil {
-		logger.WithError(err).Error("cannot suggest gas price")
+		logger.WithError(err).Error("cannot retrieve gas oracle")
 		return err
 	}
 
-	var txGasPrice *big.Int
-	switch job.InternalData.Priority {
-	case utils.PriorityVeryLow:
-		txGasPrice = gasPrice.Mul(gasPrice, big.NewInt(6)).Div(gasPrice, big.NewInt(10))
-	case utils.PriorityLow:
-		txGasPrice = gasPrice.Mul(gasPrice, big.NewInt(8)).Div(gasPrice, big.NewInt(10))
-	case utils.PriorityMedium:
-		txGasPrice = gasPrice
-	case utils.PriorityHigh:
-		txGasPrice = gasPrice.Mul(gasPrice, big.NewInt(12)).Div(gasPrice, big.NewInt(10))
-	case utils.PriorityVeryHigh:
-		txGasPrice = gasPrice.Mul(gasPrice, big.NewInt(14)).Div(gasPrice, big.NewInt(10))
-	default:
-		txGasPrice = gasPrice
+	txGasPrice, err := gasOracle.GasPrice(ctx, job)
+	if err != nil {
+		logger.WithError(err).Error("cannot suggest gas price")
+		return err
 	}
 
 	job.Transaction.GasPrice = utils.ToPtr(hexutil.Big(*txGasPrice)).(*hexutil.Big)
@@ -217,7 +256,7 @@ func (uc *craftTxUseCase) craftDynamicFeePrice(ctx context.Context, job *entitie
 	}
 
 	proxyURL := utils.GetProxyURL(uc.chainRegistryURL, job.ChainUUID)
-	feeHistory, err := uc.ec.FeeHistory(ctx, proxyURL, 1, "latest")
+	feeHistory, err := uc.ec.FeeHistory(ctx, proxyURL, 1, "latest", nil)
 	if err != nil {
 		logger.WithError(err).Debug("failed to fetch feeHistory. Fallback to craft GasPrice")
 		return uc.craftGasPrice(ctx, job)
@@ -236,22 +275,16 @@ func (uc *craftTxUseCase) craftDynamicFeePrice(ctx context.Context, job *entitie
 
 	var priorityFee *big.Int
 	if job.Transaction.GasTipCap == nil {
-		mediumPriority, _ := new(big.Int).SetString(mediumPriorityString, 10) // 1.5 gwei
-		threshold, _ := new(big.Int).SetString(thresholdString, 10)           // 0.5 gwei
-
-		switch job.InternalData.Priority {
-		case utils.PriorityVeryLow:
-			priorityFee = new(big.Int).Sub(mediumPriority, new(big.Int).Mul(threshold, big.NewInt(2))) // 0.5 gwei
-		case utils.PriorityLow:
-			priorityFee = new(big.Int).Sub(mediumPriority, threshold) // 1 gwei
-		case utils.PriorityMedium:
-			priorityFee = mediumPriority // 1.5 gwei
-		case utils.PriorityHigh:
-			priorityFee = new(big.Int).Add(mediumPriority, threshold) // 2 gwei
-		case utils.PriorityVeryHigh:
-			priorityFee = new(big.Int).Add(mediumPriority, new(big.Int).Mul(threshold, big.NewInt(2))) // 2.5 gwei
-		default:
-			priorityFee = mediumPriority
+		gasOracle, der := uc.gasOracles.GetGasOracle(ctx, job.ChainUUID)
+		if der != nil {
+			logger.WithError(der).Error("cannot retrieve gas oracle")
+			return der
+		}
+
+		priorityFee, err = gasOracle.GasTipCap(ctx, job)
+		if err != nil {
+			logger.WithError(err).Error("cannot suggest priority fee")
+			return err
 		}
 
 		job.Transaction.GasTipCap = utils.ToPtr(hexutil.Big(*priorityFee)).(*hexutil.Big)
@@ -267,3 +300,26 @@ func (uc *craftTxUseCase) craftDynamicFeePrice(ctx context.Context, job *entitie
 		Debug("crafted dynamic fees")
 	return nil
 }
+
+func newCallMsg(job *entities.Job) *ethereum.CallMsg {
+	call := &ethereum.CallMsg{}
+	if job.InternalData.OneTimeKey {
+		call.From = ethcommon.HexToAddress("0x1")
+	} else {
+		call.From = *job.Transaction.From
+	}
+
+	if job.Transaction.To != nil {
+		call.To = job.Transaction.To
+	}
+
+	if job.Transaction.Value != nil {
+		call.Value = job.Transaction.Value.ToInt()
+	}
+
+	if job.Transaction.Data != nil {
+		call.Data = job.Transaction.Data
+	}
+
+	return call
+}
@@ -7,18 +7,33 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/consensys/orchestrate/pkg/errors"
+	sdkmock "github.com/consensys/orchestrate/pkg/sdk/client/mock"
 	mock2 "github.com/consensys/orchestrate/pkg/toolkit/ethclient/mock"
+	ethclientutils "github.com/consensys/orchestrate/pkg/toolkit/ethclient/utils"
+	"github.com/consensys/orchestrate/pkg/types/api"
 	"github.com/consensys/orchestrate/pkg/types/entities"
 	"github.com/consensys/orchestrate/pkg/types/testutils"
 	"github.com/consensys/orchestrate/pkg/types/tx"
 	"github.com/consensys/orchestrate/pkg/utils"
+	gasoracle "github.com/consensys/orchestrate/services/tx-sender/tx-sender/gas-oracle"
+	gasoraclemocks "github.com/consensys/orchestrate/services/tx-sender/tx-sender/gas-oracle/mocks"
 	"github.com/consensys/orchestrate/services/tx-sender/tx-sender/nonce/mocks"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+// Error(string) revert data of "insufficient balance"
+const errorStringRevertData = "0x08c379a000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000014696e73756666696369656e742062616c616e6365000000000000000000000000"
+
+// InsufficientBalance(uint256,uint256) revert data with available 10 and required 20
+const customErrorRevertData = "0xcf479181000000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000000000000000000000000000000000000000014"
+
+const customErrorsABI = `[{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]}]`
+
 func TestCrafterTransaction_Execute(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -26,12 +41,17 @@ func TestCrafterTransaction_Execute(t *testing.T) {
 	ctx := context.Background()
 	ec := mock2.NewMockMultiClient(ctrl)
 	nm := mocks.NewMockManager(ctrl)
+	contractClient := sdkmock.NewMockContractClient(ctrl)
 	chainRegistryURL := "http://chain-registry:8081"
 
 	nextBaseFee, _ := new(big.Int).SetString("1000000000", 10)
-	mediumPriority, _ := new(big.Int).SetString(mediumPriorityString, 10)
+	mediumPriority, _ := new(big.Int).SetString("1500000000", 10)
 
-	usecase := NewCraftTransactionUseCase(ec, chainRegistryURL, nm)
+	gasOracles := gasoraclemocks.NewMockManager(ctrl)
+	gasOracles.EXPECT().GetGasOracle(gomock.Any(), gomock.Any()).
+		Return(gasoracle.NewDefaultGasOracle(ec, chainRegistryURL), nil).AnyTimes()
+
+	usecase := NewCraftTransactionUseCase(ec, chainRegistryURL, nm, gasOracles, contractClient)
 
 	t.Run("should execute use case for LegacyTx successfully", func(t *testing.T) {
 		job := testutils.FakeJob()
@@ -61,7 +81,7 @@ func TestCrafterTransaction_Execute(t *testing.T) {
 
 		proxyURL := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
 		expectedFeeHistory := testutils.FakeFeeHistory(nextBaseFee)
-		ec.EXPECT().FeeHistory(gomock.Any(), proxyURL, 1, "latest").Return(expectedFeeHistory, nil)
+		ec.EXPECT().FeeHistory(gomock.Any(), proxyURL, 1, "latest", nil).Return(expectedFeeHistory, nil)
 		ec.EXPECT().EstimateGas(gomock.Any(), proxyURL, gomock.Any()).Return(uint64(1000), nil)
 		nm.EXPECT().GetNonce(gomock.Any(), gomock.Any()).Return(uint64(1), nil)
 		err := usecase.Execute(ctx, job)
@@ -105,7 +125,7 @@ func TestCrafterTransaction_Execute(t *testing.T) {
 		proxyURL := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
 		expectedContractAddr := ethcommon.HexToAddress("0x1")
 		expectedFeeHistory := testutils.FakeFeeHistory(nextBaseFee)
-		ec.EXPECT().FeeHistory(gomock.Any(), proxyURL, 1, "latest").Return(expectedFeeHistory, nil)
+		ec.EXPECT().FeeHistory(gomock.Any(), proxyURL, 1, "latest", nil).Return(expectedFeeHistory, nil)
 		ec.EXPECT().EstimateGas(gomock.Any(), proxyURL, gomock.Any()).Return(uint64(1000), nil)
 		ec.EXPECT().EEAPrivPrecompiledContractAddr(gomock.Any(), proxyURL).Return(expectedContractAddr, nil)
 		nm.EXPECT().GetNonce(gomock.Any(), gomock.Any()).Return(uint64(1), nil)
@@ -141,7 +161,7 @@ func TestCrafterTransaction_Execute(t *testing.T) {
 
 		proxyURL := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
 		expectedFeeHistory := testutils.FakeFeeHistory(nextBaseFee)
-		ec.EXPECT().FeeHistory(gomock.Any(), proxyURL, 1, "latest").Return(expectedFeeHistory, nil)
+		ec.EXPECT().FeeHistory(gomock.Any(), proxyURL, 1, "latest", nil).Return(expectedFeeHistory, nil)
 
 		err := usecase.Execute(ctx, job)
 		expectedFeeCap := new(big.Int).Add(job.Transaction.GasTipCap.ToInt(), nextBaseFee)
@@ -149,4 +169,66 @@ func TestCrafterTransaction_Execute(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, expectedFeeCap.String(), job.Transaction.GasFeeCap.ToInt().String())
 	})
+
+	t.Run("should execute use case for simulated transaction successfully", func(t *testing.T) {
+		job := testutils.FakeJob()
+		job.Transaction.TransactionType = entities.LegacyTxType
+		job.InternalData.Simulate = true
+
+		proxyURL := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
+		ec.EXPECT().PendingCallContract(gomock.Any(), proxyURL, gomock.Any()).Return([]byte{}, nil)
+
+		err := usecase.Execute(ctx, job)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with RevertedError and the revert reason if the simulation reverts", func(t *testing.T) {
+		job := testutils.FakeJob()
+		job.Transaction.TransactionType = entities.LegacyTxType
+		job.InternalData.Simulate = true
+		revertErr := errors.RevertedError("execution reverted")
+		revertErr.Extra = map[string]string{ethclientutils.RevertDataKey: errorStringRevertData}
+
+		proxyURL := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
+		ec.EXPECT().PendingCallContract(gomock.Any(), proxyURL, gomock.Any()).Return(nil, revertErr)
+
+		err := usecase.Execute(ctx, job)
+
+		require.True(t, errors.IsRevertedError(err))
+		assert.Contains(t, err.Error(), "transaction reverted: insufficient balance")
+	})
+
+	t.Run("should decode custom errors against the ABI of the recipient contract", func(t *testing.T) {
+		job := testutils.FakeJob()
+		job.Transaction.TransactionType = entities.LegacyTxType
+		job.InternalData.Simulate = true
+		revertErr := errors.RevertedError("execution reverted")
+		revertErr.Extra = map[string]string{ethclientutils.RevertDataKey: customErrorRevertData}
+
+		proxyURL := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
+		ec.EXPECT().PendingCallContract(gomock.Any(), proxyURL, gomock.Any()).Return(nil, revertErr)
+		contractClient.EXPECT().SearchContract(gomock.Any(), &api.SearchContractRequest{Address: job.Transaction.To}).
+			Return(&api.ContractResponse{ABI: customErrorsABI}, nil)
+
+		err := usecase.Execute(ctx, job)
+
+		require.True(t, errors.IsRevertedError(err))
+		assert.Contains(t, err.Error(), "transaction reverted: InsufficientBalance(available: 10, required: 20)")
+	})
+
+	t.Run("should fail with RevertedError if the gas estimation reverts", func(t *testing.T) {
+		job := testutils.FakeJob()
+		job.Transaction.TransactionType = entities.LegacyTxType
+		job.Transaction.Gas = nil
+		revertErr := errors.RevertedError("execution reverted")
+
+		proxyURL := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
+		ec.EXPECT().EstimateGas(gomock.Any(), proxyURL, gomock.Any()).Return(uint64(0), revertErr)
+
+		err := usecase.Execute(ctx, job)
+
+		require.True(t, errors.IsRevertedError(err))
+		assert.Contains(t, err.Error(), "transaction reverted without reason")
+	})
 }
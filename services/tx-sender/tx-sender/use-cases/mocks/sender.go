@@ -6,8 +6,8 @@ package mocks
 
 import (
 	context "context"
-	gomock "github.com/golang/mock/gomock"
 	entities "github.com/consensys/orchestrate/pkg/types/entities"
+	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
 )
 
@@ -195,3 +195,40 @@ func (mr *MockSendTesseraMarkingTxUseCaseMockRecorder) Execute(ctx, job interfac
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSendTesseraMarkingTxUseCase)(nil).Execute), ctx, job)
 }
+
+// MockSendSmartAccountTxUseCase is a mock of SendSmartAccountTxUseCase interface
+type MockSendSmartAccountTxUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSendSmartAccountTxUseCaseMockRecorder
+}
+
+// MockSendSmartAccountTxUseCaseMockRecorder is the mock recorder for MockSendSmartAccountTxUseCase
+type MockSendSmartAccountTxUseCaseMockRecorder struct {
+	mock *MockSendSmartAccountTxUseCase
+}
+
+// NewMockSendSmartAccountTxUseCase creates a new mock instance
+func NewMockSendSmartAccountTxUseCase(ctrl *gomock.Controller) *MockSendSmartAccountTxUseCase {
+	mock := &MockSendSmartAccountTxUseCase{ctrl: ctrl}
+	mock.recorder = &MockSendSmartAccountTxUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSendSmartAccountTxUseCase) EXPECT() *MockSendSmartAccountTxUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockSendSmartAccountTxUseCase) Execute(ctx context.Context, job *entities.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockSendSmartAccountTxUseCaseMockRecorder) Execute(ctx, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSendSmartAccountTxUseCase)(nil).Execute), ctx, job)
+}
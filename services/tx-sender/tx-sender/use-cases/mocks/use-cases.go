@@ -5,8 +5,8 @@
 package mocks
 
 import (
-	gomock "github.com/golang/mock/gomock"
 	usecases "github.com/consensys/orchestrate/services/tx-sender/tx-sender/use-cases"
+	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
 )
 
@@ -102,3 +102,17 @@ func (mr *MockUseCasesMockRecorder) SendTesseraMarkingTx() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTesseraMarkingTx", reflect.TypeOf((*MockUseCases)(nil).SendTesseraMarkingTx))
 }
+
+// SendSmartAccountTx mocks base method
+func (m *MockUseCases) SendSmartAccountTx() usecases.SendSmartAccountTxUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendSmartAccountTx")
+	ret0, _ := ret[0].(usecases.SendSmartAccountTxUseCase)
+	return ret0
+}
+
+// SendSmartAccountTx indicates an expected call of SendSmartAccountTx
+func (mr *MockUseCasesMockRecorder) SendSmartAccountTx() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendSmartAccountTx", reflect.TypeOf((*MockUseCases)(nil).SendSmartAccountTx))
+}
@@ -27,3 +27,7 @@ type SendTesseraPrivateTxUseCase interface {
 type SendTesseraMarkingTxUseCase interface {
 	Execute(ctx context.Context, job *entities.Job) error
 }
+
+type SendSmartAccountTxUseCase interface {
+	Execute(ctx context.Context, job *entities.Job) error
+}
@@ -0,0 +1,411 @@
+package sender
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/smartaccounts"
+	"github.com/consensys/orchestrate/pkg/sdk/client"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/ethclient"
+	ethclientutils "github.com/consensys/orchestrate/pkg/toolkit/ethclient/utils"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/services/tx-sender/store"
+	usecases "github.com/consensys/orchestrate/services/tx-sender/tx-sender/use-cases"
+	utils2 "github.com/consensys/orchestrate/services/tx-sender/tx-sender/utils"
+	keymanager "github.com/consensys/quorum-key-manager/pkg/client"
+	qkmtypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+	eth "github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const sendSmartAccountTxComponent = "use-cases.send-smart-account-tx"
+
+type sendSmartAccountTxUseCase struct {
+	sendETHTx        usecases.SendETHTxUseCase
+	keyManagerClient keymanager.EthClient
+	ec               ethclient.MultiClient
+	chainClient      client.ChainClient
+	jobClient        client.JobClient
+	safeNonces       store.NonceSender
+	chainRegistryURL string
+	logger           *log.Logger
+}
+
+func NewSendSmartAccountTxUseCase(sendETHTx usecases.SendETHTxUseCase, keyManagerClient keymanager.EthClient,
+	ec ethclient.MultiClient, chainClient client.ChainClient, jobClient client.JobClient, safeNonces store.NonceSender,
+	chainRegistryURL string) usecases.SendSmartAccountTxUseCase {
+	return &sendSmartAccountTxUseCase{
+		sendETHTx:        sendETHTx,
+		keyManagerClient: keyManagerClient,
+		ec:               ec,
+		chainClient:      chainClient,
+		jobClient:        jobClient,
+		safeNonces:       safeNonces,
+		chainRegistryURL: chainRegistryURL,
+		logger:           log.NewLogger().SetComponent(sendSmartAccountTxComponent),
+	}
+}
+
+// Execute sends the transaction of a smart account job. Safe transactions are wrapped in an `execTransaction` sent by
+// the signer, ERC-4337 transactions are sent to the bundler of the chain as UserOperations
+func (uc *sendSmartAccountTxUseCase) Execute(ctx context.Context, job *entities.Job) error {
+	smartAccount := job.InternalData.SmartAccount
+	ctx = log.With(log.WithFields(
+		ctx,
+		log.Field("job", job.UUID),
+		log.Field("tenant_id", job.TenantID),
+		log.Field("owner_id", job.OwnerID),
+		log.Field("schedule_uuid", job.ScheduleUUID),
+		log.Field("smart_account", smartAccount.Address.Hex()),
+	), uc.logger)
+
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("processing smart account transaction job")
+
+	switch smartAccount.Type {
+	case entities.SafeSmartAccountType:
+		// Resent and retried jobs are already wrapped in an execTransaction sent by the signer
+		if job.Transaction.From == nil || *job.Transaction.From != smartAccount.Address {
+			return uc.sendETHTx.Execute(ctx, job)
+		}
+
+		nonceKey := safeNonceKey(job)
+		safeNonce, err := uc.wrapSafeTx(ctx, job, nonceKey)
+		if err != nil {
+			return errors.FromError(err).ExtendComponent(sendSmartAccountTxComponent)
+		}
+
+		if err = uc.sendETHTx.Execute(ctx, job); err != nil {
+			// The Safe nonce of a failed job may never be used, so the next job reads it again from the chain
+			if der := uc.safeNonces.DeleteLastSent(nonceKey); der != nil {
+				logger.WithError(der).Error("could not delete last sent Safe nonce")
+			}
+			return err
+		}
+
+		// Jobs of a Safe share its partition, so the next one is only wrapped once this nonce is stored
+		if err = uc.safeNonces.SetLastSent(nonceKey, safeNonce); err != nil {
+			logger.WithError(err).Error("could not store last sent Safe nonce")
+			return errors.FromError(err).ExtendComponent(sendSmartAccountTxComponent)
+		}
+
+		return nil
+	case entities.ERC4337SmartAccountType:
+		// UserOperations are kept in the mempool of the bundler, they cannot be resent
+		if job.Transaction.Hash != nil || job.Status == entities.StatusPending {
+			logger.WithField("user_operation_hash", utils.StringerToString(job.Transaction.Hash)).
+				Warn("user operation has already been sent to the bundler, ignoring")
+			return nil
+		}
+
+		if err := uc.sendUserOperation(ctx, job); err != nil {
+			return errors.FromError(err).ExtendComponent(sendSmartAccountTxComponent)
+		}
+
+		logger.Info("user operation was sent successfully")
+		return nil
+	default:
+		return errors.InvalidParameterError("smart account type %s is not supported", smartAccount.Type).
+			ExtendComponent(sendSmartAccountTxComponent)
+	}
+}
+
+// wrapSafeTx wraps the transaction of the job in an execTransaction of the Safe and returns the Safe nonce it uses
+func (uc *sendSmartAccountTxUseCase) wrapSafeTx(ctx context.Context, job *entities.Job, nonceKey string) (uint64, error) {
+	logger := uc.logger.WithContext(ctx)
+	smartAccount := job.InternalData.SmartAccount
+
+	if job.Transaction.To == nil {
+		return 0, errors.InvalidParameterError("smart accounts cannot deploy contracts")
+	}
+
+	safeNonce, err := uc.getSafeNonce(ctx, job, nonceKey)
+	if err != nil {
+		return 0, err
+	}
+
+	proxyURL := utils.GetProxyURL(uc.chainRegistryURL, job.ChainUUID)
+	value := hexToBig(job.Transaction.Value)
+	hashCall, err := smartaccounts.PackSafeGetTransactionHash(*job.Transaction.To, value, job.Transaction.Data,
+		new(big.Int).SetUint64(safeNonce))
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := uc.ec.CallContract(ctx, proxyURL, &eth.CallMsg{To: &smartAccount.Address, Data: hashCall}, nil)
+	if err != nil {
+		logger.WithError(err).Error("failed to get Safe transaction hash")
+		return 0, err
+	}
+
+	safeTxHash, err := smartaccounts.UnpackSafeTransactionHash(result)
+	if err != nil {
+		logger.WithError(err).Error("failed to decode Safe transaction hash")
+		return 0, err
+	}
+
+	signature, err := uc.signHash(ctx, job, safeTxHash)
+	if err != nil {
+		return 0, err
+	}
+
+	safeSignature, err := smartaccounts.SafeEthSignSignature(signature)
+	if err != nil {
+		logger.WithError(err).Error("invalid Safe transaction signature")
+		return 0, err
+	}
+
+	data, err := smartaccounts.PackSafeExecTransaction(*job.Transaction.To, value, job.Transaction.Data, safeSignature)
+	if err != nil {
+		return 0, err
+	}
+
+	job.Transaction.From = &smartAccount.Signer
+	job.Transaction.To = &smartAccount.Address
+	job.Transaction.Value = (*hexutil.Big)(big.NewInt(0))
+	job.Transaction.Data = data
+	job.Transaction.Gas = nil
+	job.Transaction.Nonce = nil
+
+	logger.WithField("safe_nonce", safeNonce).WithField("safe_tx_hash", safeTxHash.Hex()).
+		Debug("Safe transaction wrapped successfully")
+	return safeNonce, nil
+}
+
+// getSafeNonce returns the nonce following the last one sent for the Safe, or its nonce on chain if it is ahead
+func (uc *sendSmartAccountTxUseCase) getSafeNonce(ctx context.Context, job *entities.Job, nonceKey string) (uint64, error) {
+	logger := uc.logger.WithContext(ctx)
+	smartAccount := job.InternalData.SmartAccount
+	proxyURL := utils.GetProxyURL(uc.chainRegistryURL, job.ChainUUID)
+
+	result, err := uc.ec.CallContract(ctx, proxyURL, &eth.CallMsg{To: &smartAccount.Address, Data: smartaccounts.PackSafeNonce()}, nil)
+	if err != nil {
+		logger.WithError(err).Error("failed to get Safe nonce")
+		return 0, err
+	}
+
+	chainNonce, err := smartaccounts.UnpackSafeNonce(result)
+	if err != nil {
+		logger.WithError(err).Error("failed to decode Safe nonce")
+		return 0, err
+	}
+
+	lastSent, ok, err := uc.safeNonces.GetLastSent(nonceKey)
+	if err != nil {
+		logger.WithError(err).Error("cannot retrieve last sent Safe nonce")
+		return 0, err
+	}
+
+	if !ok || lastSent+1 <= chainNonce.Uint64() {
+		return chainNonce.Uint64(), nil
+	}
+
+	// execTransaction calls still pending are not reflected by the nonce of the Safe on chain. When more nonces were
+	// sent than there are pending jobs, some execTransaction calls failed or reverted without using their nonce and
+	// the following ones can never be executed, so the nonce of the Safe on chain is used again
+	pendingJobs, err := uc.jobClient.SearchJob(ctx, &entities.JobFilters{
+		ChainUUID: job.ChainUUID,
+		Status:    entities.StatusPending,
+		From:      smartAccount.Signer.Hex(),
+		To:        smartAccount.Address.Hex(),
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to search pending jobs of the Safe")
+		return 0, err
+	}
+
+	if lastSent+1-chainNonce.Uint64() > uint64(len(pendingJobs)) {
+		logger.WithField("last_sent", lastSent).WithField("safe_nonce", chainNonce.Uint64()).
+			WithField("pending_jobs", len(pendingJobs)).
+			Warn("Safe nonces were not used by failed transactions, resuming from the Safe nonce on chain")
+		return chainNonce.Uint64(), nil
+	}
+
+	return lastSent + 1, nil
+}
+
+func (uc *sendSmartAccountTxUseCase) sendUserOperation(ctx context.Context, job *entities.Job) error {
+	logger := uc.logger.WithContext(ctx)
+	smartAccount := job.InternalData.SmartAccount
+	proxyURL := utils.GetProxyURL(uc.chainRegistryURL, job.ChainUUID)
+
+	if job.Transaction.To == nil {
+		return errors.InvalidParameterError("smart accounts cannot deploy contracts")
+	}
+
+	if smartAccount.EntryPoint == nil {
+		return errors.InvalidParameterError("smart account has no entry point")
+	}
+
+	chain, err := uc.chainClient.GetChain(ctx, job.ChainUUID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get chain")
+		return err
+	}
+
+	if chain.BundlerURL == "" {
+		errMessage := "no bundler is registered on the chain"
+		logger.Error(errMessage)
+		return errors.InvalidParameterError(errMessage)
+	}
+
+	result, err := uc.ec.CallContract(ctx, proxyURL, &eth.CallMsg{
+		To:   smartAccount.EntryPoint,
+		Data: smartaccounts.PackEntryPointGetNonce(smartAccount.Address),
+	}, nil)
+	if err != nil {
+		logger.WithError(err).Error("failed to get entry point nonce")
+		return err
+	}
+
+	nonce, err := smartaccounts.UnpackEntryPointNonce(result)
+	if err != nil {
+		logger.WithError(err).Error("failed to decode entry point nonce")
+		return err
+	}
+
+	callData, err := smartaccounts.PackAccountExecute(*job.Transaction.To, hexToBig(job.Transaction.Value), job.Transaction.Data)
+	if err != nil {
+		return err
+	}
+
+	maxFeePerGas, maxPriorityFeePerGas, err := uc.userOperationFees(ctx, job, proxyURL)
+	if err != nil {
+		return err
+	}
+
+	userOp := &smartaccounts.UserOperation{
+		Sender:               smartAccount.Address,
+		Nonce:                (*hexutil.Big)(nonce),
+		CallData:             callData,
+		CallGasLimit:         (*hexutil.Big)(big.NewInt(0)),
+		VerificationGasLimit: (*hexutil.Big)(big.NewInt(0)),
+		PreVerificationGas:   (*hexutil.Big)(big.NewInt(0)),
+		MaxFeePerGas:         maxFeePerGas,
+		MaxPriorityFeePerGas: maxPriorityFeePerGas,
+		Signature:            smartaccounts.DummySignature,
+	}
+
+	estimate := &smartaccounts.UserOperationGasEstimate{}
+	err = uc.ec.Call(ctx, chain.BundlerURL, ethclientutils.ProcessResult(estimate), smartaccounts.EstimateUserOperationGasMethod,
+		userOp, smartAccount.EntryPoint)
+	if err != nil {
+		logger.WithError(err).Error("failed to estimate user operation gas")
+		return err
+	}
+
+	if estimate.CallGasLimit == nil || estimate.VerificationGasLimit == nil || estimate.PreVerificationGas == nil {
+		errMessage := "invalid user operation gas estimation"
+		logger.Error(errMessage)
+		return errors.DependencyFailureError(errMessage)
+	}
+
+	userOp.CallGasLimit = estimate.CallGasLimit
+	userOp.VerificationGasLimit = estimate.VerificationGasLimit
+	userOp.PreVerificationGas = estimate.PreVerificationGas
+
+	userOpHash, err := userOp.Hash(*smartAccount.EntryPoint, job.InternalData.ChainID)
+	if err != nil {
+		return err
+	}
+
+	signature, err := uc.signHash(ctx, job, userOpHash)
+	if err != nil {
+		return err
+	}
+
+	if err = userOp.SetSignature(signature); err != nil {
+		logger.WithError(err).Error("invalid user operation signature")
+		return err
+	}
+
+	var sentHash ethcommon.Hash
+	err = uc.ec.Call(ctx, chain.BundlerURL, ethclientutils.ProcessResult(&sentHash), smartaccounts.SendUserOperationMethod,
+		userOp, smartAccount.EntryPoint)
+	if err != nil {
+		logger.WithError(err).Error("failed to send user operation")
+		return err
+	}
+
+	job.Transaction.Hash = &userOpHash
+	job.Transaction.Nonce = utils.ToPtr(nonce.Uint64()).(*uint64)
+	job.Transaction.Gas = utils.ToPtr(estimate.CallGasLimit.ToInt().Uint64()).(*uint64)
+	job.Transaction.GasFeeCap = maxFeePerGas
+	job.Transaction.GasTipCap = maxPriorityFeePerGas
+	job.Transaction.TransactionType = entities.DynamicFeeTxType
+
+	err = utils2.UpdateJobStatus(ctx, uc.jobClient, job, entities.StatusPending, "", job.Transaction)
+	if err != nil {
+		return err
+	}
+
+	if sentHash != userOpHash {
+		warnMessage := fmt.Sprintf("expected user operation hash %s, but got %s. overriding", userOpHash.Hex(), sentHash.Hex())
+		job.Transaction.Hash = &sentHash
+		err = utils2.UpdateJobStatus(ctx, uc.jobClient, job, entities.StatusWarning, warnMessage, job.Transaction)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// userOperationFees returns the fees of the job, the gas price suggested by the chain if none is set
+func (uc *sendSmartAccountTxUseCase) userOperationFees(ctx context.Context, job *entities.Job, proxyURL string) (maxFeePerGas, maxPriorityFeePerGas *hexutil.Big, err error) {
+	switch {
+	case job.Transaction.GasFeeCap != nil && job.Transaction.GasTipCap != nil:
+		return job.Transaction.GasFeeCap, job.Transaction.GasTipCap, nil
+	case job.Transaction.GasPrice != nil:
+		return job.Transaction.GasPrice, job.Transaction.GasPrice, nil
+	}
+
+	gasPrice, err := uc.ec.SuggestGasPrice(ctx, proxyURL)
+	if err != nil {
+		uc.logger.WithContext(ctx).WithError(err).Error("failed to suggest gas price")
+		return nil, nil, err
+	}
+
+	return (*hexutil.Big)(gasPrice), (*hexutil.Big)(gasPrice), nil
+}
+
+// signHash signs the EIP-191 message of a hash with the signer of the smart account
+func (uc *sendSmartAccountTxUseCase) signHash(ctx context.Context, job *entities.Job, hash ethcommon.Hash) ([]byte, error) {
+	logger := uc.logger.WithContext(ctx)
+	signer := job.InternalData.SmartAccount.Signer
+
+	sig, err := uc.keyManagerClient.SignMessage(ctx, job.InternalData.StoreID, signer.Hex(), &qkmtypes.SignMessageRequest{
+		Message: hash.Bytes(),
+	})
+	if err != nil {
+		errMsg := "failed to sign smart account transaction using key manager"
+		logger.WithError(err).Error(errMsg)
+		return nil, errors.DependencyFailureError(errMsg).AppendReason(err.Error())
+	}
+
+	signature, err := hexutil.Decode(sig)
+	if err != nil {
+		errMessage := "failed to decode signature"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.EncodingError(errMessage)
+	}
+
+	return signature, nil
+}
+
+func safeNonceKey(job *entities.Job) string {
+	return fmt.Sprintf("safe-%v@%v", job.InternalData.SmartAccount.Address.Hex(), job.InternalData.ChainID)
+}
+
+func hexToBig(value *hexutil.Big) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+
+	return value.ToInt()
+}
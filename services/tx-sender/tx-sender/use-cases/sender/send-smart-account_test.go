@@ -0,0 +1,273 @@
+// +build unit
+
+package sender
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/smartaccounts"
+	"github.com/consensys/orchestrate/pkg/sdk/client/mock"
+	mock2 "github.com/consensys/orchestrate/pkg/toolkit/ethclient/mock"
+	"github.com/consensys/orchestrate/pkg/types/api"
+	"github.com/consensys/orchestrate/pkg/types/entities"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/pkg/utils"
+	storemocks "github.com/consensys/orchestrate/services/tx-sender/store/mock"
+	"github.com/consensys/orchestrate/services/tx-sender/tx-sender/use-cases/mocks"
+	qkmmock "github.com/consensys/quorum-key-manager/pkg/client/mock"
+	qkmtypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+	eth "github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendSmartAccountTx_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sendETHTx := mocks.NewMockSendETHTxUseCase(ctrl)
+	keyManagerClient := qkmmock.NewMockKeyManagerClient(ctrl)
+	ec := mock2.NewMockMultiClient(ctrl)
+	chainClient := mock.NewMockChainClient(ctrl)
+	jobClient := mock.NewMockJobClient(ctrl)
+	safeNonces := storemocks.NewMockNonceSender(ctrl)
+	chainRegistryURL := "chainRegistryURL:8081"
+	bundlerURL := "http://bundler:4337"
+	ctx := context.Background()
+
+	usecase := NewSendSmartAccountTxUseCase(sendETHTx, keyManagerClient, ec, chainClient, jobClient, safeNonces, chainRegistryURL)
+
+	smartAccountAddress := ethcommon.HexToAddress("0x1abae27a0cbfb02945720425d3b80c7e09728534")
+	signer := ethcommon.HexToAddress("0x5bd1b3b1d4bd1e0e7b8e8e27a1c5dd7e9b1bf8c3")
+	entryPoint := ethcommon.HexToAddress("0x5ff137d4b0fdcd49dca30c7cf57e578a026d2789")
+	signature := make([]byte, 65)
+	signature[64] = 1
+
+	newJob := func(smartAccountType entities.SmartAccountType) *entities.Job {
+		job := testutils.FakeJob()
+		job.Transaction.From = &smartAccountAddress
+		job.InternalData.SmartAccount = &entities.SmartAccount{
+			Type:    smartAccountType,
+			Address: smartAccountAddress,
+			Signer:  signer,
+		}
+		if smartAccountType == entities.ERC4337SmartAccountType {
+			job.InternalData.SmartAccount.EntryPoint = &entryPoint
+		}
+
+		return job
+	}
+
+	t.Run("should wrap Safe transactions in an execTransaction sent by the signer", func(t *testing.T) {
+		job := newJob(entities.SafeSmartAccountType)
+		to, value, data := *job.Transaction.To, job.Transaction.Value.ToInt(), job.Transaction.Data
+		proxyURL := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
+		safeTxHash := ethcommon.HexToHash("0xabc")
+		hashCall, _ := smartaccounts.PackSafeGetTransactionHash(to, value, data, big.NewInt(3))
+
+		nonceKey := safeNonceKey(job)
+
+		ec.EXPECT().CallContract(gomock.Any(), proxyURL, &eth.CallMsg{To: &smartAccountAddress, Data: smartaccounts.PackSafeNonce()}, nil).
+			Return(ethcommon.BigToHash(big.NewInt(3)).Bytes(), nil)
+		safeNonces.EXPECT().GetLastSent(nonceKey).Return(uint64(0), false, nil)
+		ec.EXPECT().CallContract(gomock.Any(), proxyURL, &eth.CallMsg{To: &smartAccountAddress, Data: hashCall}, nil).
+			Return(safeTxHash.Bytes(), nil)
+		keyManagerClient.EXPECT().SignMessage(gomock.Any(), job.InternalData.StoreID, signer.Hex(), &qkmtypes.SignMessageRequest{
+			Message: safeTxHash.Bytes(),
+		}).Return(hexutil.Encode(signature), nil)
+		sendETHTx.EXPECT().Execute(gomock.Any(), job).Return(nil)
+		safeNonces.EXPECT().SetLastSent(nonceKey, uint64(3)).Return(nil)
+
+		err := usecase.Execute(ctx, job)
+
+		require.NoError(t, err)
+		safeSignature, _ := smartaccounts.SafeEthSignSignature(signature)
+		expectedData, _ := smartaccounts.PackSafeExecTransaction(to, value, data, safeSignature)
+		assert.Equal(t, signer, *job.Transaction.From)
+		assert.Equal(t, smartAccountAddress, *job.Transaction.To)
+		assert.Equal(t, hexutil.Bytes(expectedData), job.Transaction.Data)
+		assert.Equal(t, "0x0", job.Transaction.Value.String())
+		assert.Nil(t, job.Transaction.Gas)
+		assert.Nil(t, job.Transaction.Nonce)
+	})
+
+	t.Run("should use the Safe nonce following the last one sent if it is ahead of the chain", func(t *testing.T) {
+		job := newJob(entities.SafeSmartAccountType)
+		to, value, data := *job.Transaction.To, job.Transaction.Value.ToInt(), job.Transaction.Data
+		proxyURL := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
+		safeTxHash := ethcommon.HexToHash("0xabc")
+		hashCall, _ := smartaccounts.PackSafeGetTransactionHash(to, value, data, big.NewInt(6))
+		nonceKey := safeNonceKey(job)
+
+		ec.EXPECT().CallContract(gomock.Any(), proxyURL, &eth.CallMsg{To: &smartAccountAddress, Data: smartaccounts.PackSafeNonce()}, nil).
+			Return(ethcommon.BigToHash(big.NewInt(3)).Bytes(), nil)
+		safeNonces.EXPECT().GetLastSent(nonceKey).Return(uint64(5), true, nil)
+		jobClient.EXPECT().SearchJob(gomock.Any(), &entities.JobFilters{
+			ChainUUID: job.ChainUUID,
+			Status:    entities.StatusPending,
+			From:      signer.Hex(),
+			To:        smartAccountAddress.Hex(),
+		}).Return([]*api.JobResponse{testutils.FakeJobResponse(), testutils.FakeJobResponse(), testutils.FakeJobResponse()}, nil)
+		ec.EXPECT().CallContract(gomock.Any(), proxyURL, &eth.CallMsg{To: &smartAccountAddress, Data: hashCall}, nil).
+			Return(safeTxHash.Bytes(), nil)
+		keyManagerClient.EXPECT().SignMessage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(hexutil.Encode(signature), nil)
+		sendETHTx.EXPECT().Execute(gomock.Any(), job).Return(nil)
+		safeNonces.EXPECT().SetLastSent(nonceKey, uint64(6)).Return(nil)
+
+		err := usecase.Execute(ctx, job)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should use the Safe nonce on chain again once a transaction of the Safe reverted", func(t *testing.T) {
+		job := newJob(entities.SafeSmartAccountType)
+		to, value, data := *job.Transaction.To, job.Transaction.Value.ToInt(), job.Transaction.Data
+		proxyURL := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
+		safeTxHash := ethcommon.HexToHash("0xabc")
+		hashCall, _ := smartaccounts.PackSafeGetTransactionHash(to, value, data, big.NewInt(3))
+		nonceKey := safeNonceKey(job)
+
+		// Safe nonces 3, 4 and 5 were sent, the transaction using 3 reverted so only the one using 5 is still pending
+		ec.EXPECT().CallContract(gomock.Any(), proxyURL, &eth.CallMsg{To: &smartAccountAddress, Data: smartaccounts.PackSafeNonce()}, nil).
+			Return(ethcommon.BigToHash(big.NewInt(3)).Bytes(), nil)
+		safeNonces.EXPECT().GetLastSent(nonceKey).Return(uint64(5), true, nil)
+		jobClient.EXPECT().SearchJob(gomock.Any(), gomock.Any()).Return([]*api.JobResponse{testutils.FakeJobResponse()}, nil)
+		ec.EXPECT().CallContract(gomock.Any(), proxyURL, &eth.CallMsg{To: &smartAccountAddress, Data: hashCall}, nil).
+			Return(safeTxHash.Bytes(), nil)
+		keyManagerClient.EXPECT().SignMessage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(hexutil.Encode(signature), nil)
+		sendETHTx.EXPECT().Execute(gomock.Any(), job).Return(nil)
+		safeNonces.EXPECT().SetLastSent(nonceKey, uint64(3)).Return(nil)
+
+		err := usecase.Execute(ctx, job)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with same error if the pending jobs of the Safe cannot be fetched", func(t *testing.T) {
+		job := newJob(entities.SafeSmartAccountType)
+		expectedErr := errors.ServiceConnectionError("error")
+
+		ec.EXPECT().CallContract(gomock.Any(), gomock.Any(), &eth.CallMsg{To: &smartAccountAddress, Data: smartaccounts.PackSafeNonce()}, nil).
+			Return(ethcommon.BigToHash(big.NewInt(3)).Bytes(), nil)
+		safeNonces.EXPECT().GetLastSent(safeNonceKey(job)).Return(uint64(5), true, nil)
+		jobClient.EXPECT().SearchJob(gomock.Any(), gomock.Any()).Return(nil, expectedErr)
+
+		err := usecase.Execute(ctx, job)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(sendSmartAccountTxComponent), err)
+	})
+
+	t.Run("should forget the last sent Safe nonce if the transaction fails to be sent", func(t *testing.T) {
+		job := newJob(entities.SafeSmartAccountType)
+		safeTxHash := ethcommon.HexToHash("0xabc")
+		expectedErr := errors.ConnectionError("error")
+
+		ec.EXPECT().CallContract(gomock.Any(), gomock.Any(), &eth.CallMsg{To: &smartAccountAddress, Data: smartaccounts.PackSafeNonce()}, nil).
+			Return(ethcommon.BigToHash(big.NewInt(3)).Bytes(), nil)
+		safeNonces.EXPECT().GetLastSent(safeNonceKey(job)).Return(uint64(0), false, nil)
+		ec.EXPECT().CallContract(gomock.Any(), gomock.Any(), gomock.Any(), nil).Return(safeTxHash.Bytes(), nil)
+		keyManagerClient.EXPECT().SignMessage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(hexutil.Encode(signature), nil)
+		sendETHTx.EXPECT().Execute(gomock.Any(), job).Return(expectedErr)
+		safeNonces.EXPECT().DeleteLastSent(safeNonceKey(job)).Return(nil)
+
+		err := usecase.Execute(ctx, job)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should send Safe transactions already wrapped without signing again", func(t *testing.T) {
+		job := newJob(entities.SafeSmartAccountType)
+		job.Transaction.From = &signer
+
+		sendETHTx.EXPECT().Execute(gomock.Any(), job).Return(nil)
+
+		err := usecase.Execute(ctx, job)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with same error if the Safe nonce cannot be fetched", func(t *testing.T) {
+		job := newJob(entities.SafeSmartAccountType)
+		expectedErr := errors.ConnectionError("error")
+
+		ec.EXPECT().CallContract(gomock.Any(), gomock.Any(), gomock.Any(), nil).Return(nil, expectedErr)
+
+		err := usecase.Execute(ctx, job)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(sendSmartAccountTxComponent), err)
+	})
+
+	t.Run("should send ERC-4337 transactions as user operations to the bundler", func(t *testing.T) {
+		job := newJob(entities.ERC4337SmartAccountType)
+		proxyURL := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
+		var userOpHash ethcommon.Hash
+
+		chainClient.EXPECT().GetChain(gomock.Any(), job.ChainUUID).Return(&api.ChainResponse{BundlerURL: bundlerURL}, nil)
+		ec.EXPECT().CallContract(gomock.Any(), proxyURL, &eth.CallMsg{To: &entryPoint, Data: smartaccounts.PackEntryPointGetNonce(smartAccountAddress)}, nil).
+			Return(ethcommon.BigToHash(big.NewInt(5)).Bytes(), nil)
+		ec.EXPECT().Call(gomock.Any(), bundlerURL, gomock.Any(), smartaccounts.EstimateUserOperationGasMethod, gomock.Any(), &entryPoint).
+			DoAndReturn(func(_ context.Context, _ string, processResult func(json.RawMessage) error, _ string, args ...interface{}) error {
+				assert.Equal(t, hexutil.Bytes(smartaccounts.DummySignature), args[0].(*smartaccounts.UserOperation).Signature)
+				return processResult(json.RawMessage(`{"preVerificationGas":"0x5208","verificationGasLimit":"0x186a0","callGasLimit":"0xc350"}`))
+			})
+		keyManagerClient.EXPECT().SignMessage(gomock.Any(), job.InternalData.StoreID, signer.Hex(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ string, req *qkmtypes.SignMessageRequest) (string, error) {
+				userOpHash = ethcommon.BytesToHash(req.Message)
+				return hexutil.Encode(signature), nil
+			})
+		ec.EXPECT().Call(gomock.Any(), bundlerURL, gomock.Any(), smartaccounts.SendUserOperationMethod, gomock.Any(), &entryPoint).
+			DoAndReturn(func(_ context.Context, _ string, processResult func(json.RawMessage) error, _ string, args ...interface{}) error {
+				userOp := args[0].(*smartaccounts.UserOperation)
+				hash, err := userOp.Hash(entryPoint, job.InternalData.ChainID)
+				require.NoError(t, err)
+				assert.Equal(t, userOpHash, hash)
+				assert.Equal(t, byte(28), userOp.Signature[64])
+
+				result, _ := json.Marshal(hash)
+				return processResult(result)
+			})
+		jobClient.EXPECT().UpdateJob(gomock.Any(), job.UUID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, req *api.UpdateJobRequest) (*api.JobResponse, error) {
+				assert.Equal(t, entities.StatusPending, req.Status)
+				return &api.JobResponse{}, nil
+			})
+
+		err := usecase.Execute(ctx, job)
+
+		require.NoError(t, err)
+		assert.Equal(t, userOpHash, *job.Transaction.Hash)
+		assert.Equal(t, uint64(5), *job.Transaction.Nonce)
+		assert.Equal(t, uint64(50000), *job.Transaction.Gas)
+		assert.Equal(t, job.Transaction.GasPrice, job.Transaction.GasFeeCap)
+		assert.Equal(t, smartAccountAddress, *job.Transaction.From)
+	})
+
+	t.Run("should not send user operations twice", func(t *testing.T) {
+		job := newJob(entities.ERC4337SmartAccountType)
+		job.Transaction.Hash = utils.ToPtr(ethcommon.HexToHash("0xabc")).(*ethcommon.Hash)
+
+		err := usecase.Execute(ctx, job)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with InvalidParameterError if no bundler is registered on the chain", func(t *testing.T) {
+		job := newJob(entities.ERC4337SmartAccountType)
+
+		chainClient.EXPECT().GetChain(gomock.Any(), job.ChainUUID).Return(&api.ChainResponse{}, nil)
+
+		err := usecase.Execute(ctx, job)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
@@ -8,4 +8,5 @@ type UseCases interface {
 	SendEEAPrivateTx() SendEEAPrivateTxUseCase
 	SendTesseraPrivateTx() SendTesseraPrivateTxUseCase
 	SendTesseraMarkingTx() SendTesseraMarkingTxUseCase
+	SendSmartAccountTx() SendSmartAccountTxUseCase
 }
@@ -9,10 +9,15 @@ import (
 	orchestrateclient "github.com/consensys/orchestrate/pkg/sdk/client"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	dbredis "github.com/consensys/orchestrate/pkg/toolkit/database/redis"
 	"github.com/consensys/orchestrate/pkg/types/entities"
 	"github.com/consensys/orchestrate/pkg/types/formatters"
 	"github.com/consensys/orchestrate/services/tx-sentry/service/listeners"
+	"github.com/consensys/orchestrate/services/tx-sentry/store"
+	"github.com/consensys/orchestrate/services/tx-sentry/store/memory"
+	"github.com/consensys/orchestrate/services/tx-sentry/store/redis"
 	usecases "github.com/consensys/orchestrate/services/tx-sentry/tx-sentry/use-cases"
+	"github.com/gofrs/uuid"
 	backoffjob "github.com/traefik/traefik/v2/pkg/job"
 )
 
@@ -25,11 +30,21 @@ type TxSentry struct {
 	logger         *log.Logger
 }
 
-func NewTxSentry(client orchestrateclient.OrchestrateClient, config *Config) *TxSentry {
+func NewTxSentry(client orchestrateclient.OrchestrateClient, redisCli *dbredis.Client, config *Config) *TxSentry {
+	var sessionStore store.SessionStore
+	if config.SessionStoreType == SessionStoreTypeRedis {
+		sessionStore = redis.NewSessionStore(redisCli, sessionExpiration)
+	} else {
+		sessionStore = memory.NewSessionStore()
+	}
+
+	// Each instance owns the leases of the sessions it runs
+	owner := uuid.Must(uuid.NewV4()).String()
+
 	createChildJobUC := usecases.NewRetrySessionJobUseCase(client)
 	return &TxSentry{
 		client:         client,
-		sessionManager: listeners.NewSessionManager(client, createChildJobUC),
+		sessionManager: listeners.NewSessionManager(client, createChildJobUC, sessionStore, owner, config.SessionLease),
 		config:         config,
 		logger:         log.NewLogger().SetComponent(txSentryComponent),
 	}
@@ -74,6 +89,12 @@ func (sentry *TxSentry) listen(ctx context.Context) error {
 		return errors.FromError(err).ExtendComponent(txSentryComponent)
 	}
 
+	// Resume persisted sessions which are not owned by any running instance
+	err = sentry.sessionManager.Resume(ctx)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(txSentryComponent)
+	}
+
 	ticker := time.NewTicker(sentry.config.RefreshInterval)
 	defer ticker.Stop()
 	for {
@@ -88,6 +109,11 @@ func (sentry *TxSentry) listen(ctx context.Context) error {
 			if err != nil {
 				return errors.FromError(err).ExtendComponent(txSentryComponent)
 			}
+
+			err = sentry.sessionManager.Resume(ctx)
+			if err != nil {
+				return errors.FromError(err).ExtendComponent(txSentryComponent)
+			}
 		case <-ctx.Done():
 			sentry.logger.WithField("reason", ctx.Err().Error()).Info("gracefully stopping transaction sentry...")
 			return nil
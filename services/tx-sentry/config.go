@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/consensys/orchestrate/pkg/toolkit/database/redis"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 )
@@ -15,9 +16,35 @@ const (
 	sentryRefreshIntervalEnv      = "TX_SENTRY_REFRESH_INTERVAL"
 )
 
+const (
+	sessionStoreTypeFlag     = "tx-sentry-session-store-type"
+	sessionStoreTypeViperKey = "tx-sentry.session-store.type"
+	sessionStoreTypeDefault  = SessionStoreTypeInMemory
+	sessionStoreTypeEnv      = "TX_SENTRY_SESSION_STORE_TYPE"
+
+	SessionStoreTypeInMemory = "in-memory"
+	SessionStoreTypeRedis    = "redis"
+)
+
+const (
+	sessionLeaseFlag     = "tx-sentry-session-lease"
+	sessionLeaseViperKey = "tx-sentry.session-lease"
+	sessionLeaseDefault  = 30 * time.Second
+	sessionLeaseEnv      = "TX_SENTRY_SESSION_LEASE"
+)
+
+// sessionExpiration is the time after which a persisted session which has not been updated is removed
+const sessionExpiration = 24 * time.Hour
+
 func init() {
 	viper.SetDefault(sentryRefreshIntervalViperKey, sentryRefreshIntervalDefault)
 	_ = viper.BindEnv(sentryRefreshIntervalViperKey, sentryRefreshIntervalEnv)
+
+	viper.SetDefault(sessionStoreTypeViperKey, sessionStoreTypeDefault)
+	_ = viper.BindEnv(sessionStoreTypeViperKey, sessionStoreTypeEnv)
+
+	viper.SetDefault(sessionLeaseViperKey, sessionLeaseDefault)
+	_ = viper.BindEnv(sessionLeaseViperKey, sessionLeaseEnv)
 }
 
 // Flags register flags for tx sentry
@@ -25,14 +52,37 @@ func Flags(f *pflag.FlagSet) {
 	pendingDurationDesc := fmt.Sprintf(`Interval of time between checks for pending transactions. Environment variable: %q`, sentryRefreshIntervalEnv)
 	f.Duration(sentryRefreshIntervalFlag, sentryRefreshIntervalDefault, pendingDurationDesc)
 	_ = viper.BindPFlag(sentryRefreshIntervalViperKey, f.Lookup(sentryRefreshIntervalFlag))
+
+	sessionStoreType(f)
+	sessionLease(f)
+	redis.Flags(f)
+}
+
+func sessionStoreType(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Type of storage of retry sessions (one of %q). Use %q to share sessions across replicas and resume them after restart.
+%q sessions and leases are local to the process and give no guarantee across replicas, it must only be used with a single replica.
+Environment variable: %q`, []string{SessionStoreTypeInMemory, SessionStoreTypeRedis}, SessionStoreTypeRedis, SessionStoreTypeInMemory, sessionStoreTypeEnv)
+	f.String(sessionStoreTypeFlag, sessionStoreTypeDefault, desc)
+	_ = viper.BindPFlag(sessionStoreTypeViperKey, f.Lookup(sessionStoreTypeFlag))
+}
+
+func sessionLease(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Duration of the ownership of a retry session by a replica, a session is resumed by another replica if not renewed.
+Environment variable: %q`, sessionLeaseEnv)
+	f.Duration(sessionLeaseFlag, sessionLeaseDefault, desc)
+	_ = viper.BindPFlag(sessionLeaseViperKey, f.Lookup(sessionLeaseFlag))
 }
 
 type Config struct {
-	RefreshInterval time.Duration
+	RefreshInterval  time.Duration
+	SessionStoreType string
+	SessionLease     time.Duration
 }
 
 func NewConfig(vipr *viper.Viper) *Config {
 	return &Config{
-		RefreshInterval: vipr.GetDuration(sentryRefreshIntervalViperKey),
+		RefreshInterval:  vipr.GetDuration(sentryRefreshIntervalViperKey),
+		SessionStoreType: vipr.GetString(sessionStoreTypeViperKey),
+		SessionLease:     vipr.GetDuration(sessionLeaseViperKey),
 	}
 }
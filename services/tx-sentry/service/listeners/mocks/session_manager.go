@@ -6,41 +6,56 @@ package mocks
 
 import (
 	context "context"
-	gomock "github.com/golang/mock/gomock"
-	entities "github.com/consensys/orchestrate/pkg/types/entities"
 	reflect "reflect"
+
+	entities "github.com/consensys/orchestrate/pkg/types/entities"
+	gomock "github.com/golang/mock/gomock"
 )
 
-// MockSessionManager is a mock of SessionManager interface
+// MockSessionManager is a mock of SessionManager interface.
 type MockSessionManager struct {
 	ctrl     *gomock.Controller
 	recorder *MockSessionManagerMockRecorder
 }
 
-// MockSessionManagerMockRecorder is the mock recorder for MockSessionManager
+// MockSessionManagerMockRecorder is the mock recorder for MockSessionManager.
 type MockSessionManagerMockRecorder struct {
 	mock *MockSessionManager
 }
 
-// NewMockSessionManager creates a new mock instance
+// NewMockSessionManager creates a new mock instance.
 func NewMockSessionManager(ctrl *gomock.Controller) *MockSessionManager {
 	mock := &MockSessionManager{ctrl: ctrl}
 	mock.recorder = &MockSessionManagerMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockSessionManager) EXPECT() *MockSessionManagerMockRecorder {
 	return m.recorder
 }
 
-// Start mocks base method
+// Resume mocks base method.
+func (m *MockSessionManager) Resume(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resume", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockSessionManagerMockRecorder) Resume(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockSessionManager)(nil).Resume), ctx)
+}
+
+// Start mocks base method.
 func (m *MockSessionManager) Start(ctx context.Context, job *entities.Job) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "Start", ctx, job)
 }
 
-// Start indicates an expected call of Start
+// Start indicates an expected call of Start.
 func (mr *MockSessionManagerMockRecorder) Start(ctx, job interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockSessionManager)(nil).Start), ctx, job)
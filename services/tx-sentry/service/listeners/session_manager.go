@@ -8,8 +8,10 @@ import (
 	"github.com/consensys/orchestrate/pkg/errors"
 	orchestrateclient "github.com/consensys/orchestrate/pkg/sdk/client"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	types "github.com/consensys/orchestrate/pkg/types/api"
 	"github.com/consensys/orchestrate/pkg/types/formatters"
+	"github.com/consensys/orchestrate/services/tx-sentry/store"
 	usecases "github.com/consensys/orchestrate/services/tx-sentry/tx-sentry/use-cases"
 
 	"github.com/consensys/orchestrate/pkg/types/entities"
@@ -24,6 +26,7 @@ const sessionManagerComponent = "tx-sentry.service.session-manager"
 
 type SessionManager interface {
 	Start(ctx context.Context, job *entities.Job)
+	Resume(ctx context.Context) error
 }
 
 // sessionManager is a manager of job sessions
@@ -32,23 +35,29 @@ type sessionManager struct {
 	sessions               map[string]bool
 	retrySessionJobUseCase usecases.RetrySessionJobUseCase
 	client                 orchestrateclient.OrchestrateClient
+	store                  store.SessionStore
+	owner                  string
+	leaseDuration          time.Duration
 	logger                 *log.Logger
 }
 
-type sessionData struct {
-	parentJob        *entities.Job
-	nChildren        int
-	retries          int
-	lastChildJobUUID string
-}
-
 // NewSessionManager creates a new SessionManager
-func NewSessionManager(client orchestrateclient.OrchestrateClient, retrySessionJobUseCase usecases.RetrySessionJobUseCase) SessionManager {
+// Sessions are persisted in the session store and only run by the owner holding their lease
+func NewSessionManager(
+	client orchestrateclient.OrchestrateClient,
+	retrySessionJobUseCase usecases.RetrySessionJobUseCase,
+	sessionStore store.SessionStore,
+	owner string,
+	leaseDuration time.Duration,
+) SessionManager {
 	return &sessionManager{
 		mutex:                  &sync.RWMutex{},
 		sessions:               make(map[string]bool),
 		retrySessionJobUseCase: retrySessionJobUseCase,
 		client:                 client,
+		store:                  sessionStore,
+		owner:                  owner,
+		leaseDuration:          leaseDuration,
 		logger:                 log.NewLogger().SetComponent(sessionManagerComponent),
 	}
 }
@@ -72,23 +81,39 @@ func (manager *sessionManager) Start(ctx context.Context, job *entities.Job) {
 		return
 	}
 
-	ses, err := manager.retrieveJobSessionData(ctx, job)
+	acquired, err := manager.store.Acquire(ctx, job.UUID, manager.owner, manager.leaseDuration)
+	if err != nil {
+		logger.WithError(err).Error("failed to acquire job session lease")
+		return
+	}
+
+	if !acquired {
+		logger.Trace("job session is owned by another instance, skipping session creation")
+		return
+	}
+
+	ses, err := manager.loadSession(ctx, job)
 	if err != nil {
 		logger.WithError(err).Error("job listening session failed to start")
+		manager.releaseSession(ctx, job.UUID)
 		return
 	}
 
-	if ses.retries >= types.SentryMaxRetries {
+	if ses.Retries >= types.SentryMaxRetries {
 		logger.Warn("job already reached max retries")
+		manager.releaseSession(ctx, job.UUID)
 		return
 	}
 
 	manager.addSession(job.UUID)
 
 	go func() {
+		sessionCtx, cancel := context.WithCancel(ctx)
+		go manager.renewLease(sessionCtx, cancel, job.UUID)
+
 		err := backoff.RetryNotify(
 			func() error {
-				err := manager.runSession(ctx, ses)
+				err := manager.runSession(sessionCtx, ses)
 				return err
 			},
 			pkgbackoff.IncrementalBackOff(time.Second, 5*time.Second, time.Minute),
@@ -97,10 +122,21 @@ func (manager *sessionManager) Start(ctx context.Context, job *entities.Job) {
 			},
 		)
 
+		interrupted := sessionCtx.Err() != nil
+		cancel()
+
 		if err != nil {
 			logger.WithError(err).Error("job listening session unexpectedly stopped")
 		}
 
+		// Session was stopped before completion (shutdown or lease lost), its state is kept so it can be resumed
+		if interrupted {
+			logger.Debug("job session was interrupted")
+			manager.releaseSession(ctx, job.UUID)
+			manager.removeSession(job.UUID)
+			return
+		}
+
 		annotations := formatters.FormatInternalDataToAnnotations(job.InternalData)
 		annotations.HasBeenRetried = true
 		_, err = manager.client.UpdateJob(ctx, job.UUID, &types.UpdateJobRequest{
@@ -111,11 +147,68 @@ func (manager *sessionManager) Start(ctx context.Context, job *entities.Job) {
 			logger.WithError(err).Error("failed to update job labels")
 		}
 
+		err = manager.store.Delete(ctx, job.UUID)
+		if err != nil {
+			logger.WithError(err).Error("failed to delete job session")
+		}
+
 		logger.Debug("job session was completed")
+		manager.releaseSession(ctx, job.UUID)
 		manager.removeSession(job.UUID)
 	}()
 }
 
+// Resume starts the persisted sessions which are not currently run by any instance
+func (manager *sessionManager) Resume(ctx context.Context) error {
+	sessions, err := manager.store.List(ctx)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(sessionManagerComponent)
+	}
+
+	for _, ses := range sessions {
+		if manager.hasSession(ses.ParentJob.UUID) {
+			continue
+		}
+
+		jctx := multitenancy.WithUserInfo(ctx, multitenancy.NewUserInfo(ses.ParentJob.TenantID, ses.ParentJob.OwnerID))
+		job, err := manager.client.GetJob(jctx, ses.ParentJob.UUID)
+		if err != nil {
+			manager.logger.WithContext(ctx).WithField("job", ses.ParentJob.UUID).WithError(err).
+				Warn("failed to fetch job of persisted session, skipping resume")
+			continue
+		}
+
+		// Jobs mined or failed in the meantime do not need to be retried anymore
+		if entities.IsFinalJobStatus(job.Status) {
+			manager.deleteSession(jctx, ses.ParentJob.UUID)
+			continue
+		}
+
+		manager.Start(jctx, ses.ParentJob)
+	}
+
+	return nil
+}
+
+// deleteSession removes the persisted session of a job which does not need to be retried, unless another instance runs it
+func (manager *sessionManager) deleteSession(ctx context.Context, jobUUID string) {
+	logger := manager.logger.WithContext(ctx).WithField("job", jobUUID)
+
+	acquired, err := manager.store.Acquire(ctx, jobUUID, manager.owner, manager.leaseDuration)
+	if err != nil || !acquired {
+		return
+	}
+	defer manager.releaseSession(ctx, jobUUID)
+
+	err = manager.store.Delete(ctx, jobUUID)
+	if err != nil {
+		logger.WithError(err).Warn("failed to delete job session")
+		return
+	}
+
+	logger.Debug("job session of final job deleted")
+}
+
 func (manager *sessionManager) addSession(jobUUID string) {
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
@@ -129,22 +222,22 @@ func (manager *sessionManager) hasSession(jobUUID string) bool {
 	return ok
 }
 
-func (manager *sessionManager) runSession(ctx context.Context, ses *sessionData) error {
+func (manager *sessionManager) runSession(ctx context.Context, ses *store.Session) error {
 	logger := log.FromContext(ctx)
 	logger.Info("job session started")
 
-	ticker := time.NewTicker(ses.parentJob.InternalData.RetryInterval)
+	ticker := time.NewTicker(ses.ParentJob.InternalData.RetryInterval)
 	defer ticker.Stop()
 	for {
 		select {
 		case <-ticker.C:
-			childJobUUID, err := manager.retrySessionJobUseCase.Execute(ctx, ses.parentJob.UUID, ses.lastChildJobUUID, ses.nChildren)
+			childJobUUID, err := manager.retrySessionJobUseCase.Execute(ctx, ses.ParentJob.UUID, ses.LastChildJobUUID, ses.NChildren)
 			if err != nil {
 				return errors.FromError(err).ExtendComponent(sessionManagerComponent)
 			}
 
-			ses.retries++
-			if ses.retries >= types.SentryMaxRetries {
+			ses.Retries++
+			if ses.Retries >= types.SentryMaxRetries {
 				return nil
 			}
 
@@ -153,9 +246,14 @@ func (manager *sessionManager) runSession(ctx context.Context, ses *sessionData)
 				return nil
 			}
 
-			if childJobUUID != ses.lastChildJobUUID {
-				ses.nChildren++
-				ses.lastChildJobUUID = childJobUUID
+			if childJobUUID != ses.LastChildJobUUID {
+				ses.NChildren++
+				ses.LastChildJobUUID = childJobUUID
+			}
+
+			err = manager.store.Save(ctx, ses)
+			if err != nil {
+				return errors.FromError(err).ExtendComponent(sessionManagerComponent)
 			}
 		case <-ctx.Done():
 			logger.WithField("reason", ctx.Err().Error()).Info("session gracefully stopped")
@@ -164,13 +262,71 @@ func (manager *sessionManager) runSession(ctx context.Context, ses *sessionData)
 	}
 }
 
+// renewLease keeps the ownership of the session, and stops it if the lease was taken by another instance
+func (manager *sessionManager) renewLease(ctx context.Context, cancel context.CancelFunc, jobUUID string) {
+	logger := log.FromContext(ctx)
+
+	ticker := time.NewTicker(manager.leaseDuration / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			acquired, err := manager.store.Acquire(ctx, jobUUID, manager.owner, manager.leaseDuration)
+			if err != nil {
+				logger.WithError(err).Warn("failed to renew job session lease")
+				continue
+			}
+
+			if !acquired {
+				logger.Warn("job session lease was lost, stopping session")
+				cancel()
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (manager *sessionManager) releaseSession(ctx context.Context, jobUUID string) {
+	err := manager.store.Release(ctx, jobUUID, manager.owner)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("failed to release job session lease")
+	}
+}
+
 func (manager *sessionManager) removeSession(jobUUID string) {
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
 	delete(manager.sessions, jobUUID)
 }
 
-func (manager *sessionManager) retrieveJobSessionData(ctx context.Context, job *entities.Job) (*sessionData, error) {
+// loadSession retrieves the persisted session of the job, or creates it from the job children
+func (manager *sessionManager) loadSession(ctx context.Context, job *entities.Job) (*store.Session, error) {
+	ses, ok, err := manager.store.Load(ctx, job.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok {
+		log.FromContext(ctx).WithField("retries", ses.Retries).Debug("job session resumed")
+		return ses, nil
+	}
+
+	ses, err = manager.retrieveJobSessionData(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+
+	err = manager.store.Save(ctx, ses)
+	if err != nil {
+		return nil, err
+	}
+
+	return ses, nil
+}
+
+func (manager *sessionManager) retrieveJobSessionData(ctx context.Context, job *entities.Job) (*store.Session, error) {
 	jobs, err := manager.client.SearchJob(ctx, &entities.JobFilters{
 		ChainUUID:     job.ChainUUID,
 		ParentJobUUID: job.UUID,
@@ -192,10 +348,10 @@ func (manager *sessionManager) retrieveJobSessionData(ctx context.Context, job *
 		}
 	}
 
-	return &sessionData{
-		parentJob:        job,
-		nChildren:        nChildren,
-		retries:          nRetries,
-		lastChildJobUUID: jobs[nChildren].UUID,
+	return &store.Session{
+		ParentJob:        job,
+		NChildren:        nChildren,
+		Retries:          nRetries,
+		LastChildJobUUID: jobs[nChildren].UUID,
 	}, nil
 }
@@ -13,7 +13,11 @@ import (
 	types "github.com/consensys/orchestrate/pkg/types/api"
 	"github.com/consensys/orchestrate/pkg/types/entities"
 	"github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/services/tx-sentry/store"
+	"github.com/consensys/orchestrate/services/tx-sentry/store/memory"
 	"github.com/consensys/orchestrate/services/tx-sentry/tx-sentry/use-cases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSessionManager(t *testing.T) {
@@ -24,8 +28,9 @@ func TestSessionManager(t *testing.T) {
 
 	client := mock.NewMockOrchestrateClient(ctrl)
 	retrySessionJobUC := mocks.NewMockRetrySessionJobUseCase(ctrl)
+	sessionStore := memory.NewSessionStore()
 
-	sessionManager := NewSessionManager(client, retrySessionJobUC)
+	sessionManager := NewSessionManager(client, retrySessionJobUC, sessionStore, "owner", time.Minute)
 
 	t.Run("should retry session job successfully at every retry interval with latest child job", func(t *testing.T) {
 		timeout := retryInterval*4 + 500*time.Millisecond
@@ -164,7 +169,6 @@ func TestSessionManager(t *testing.T) {
 
 		retrySessionJobUC.EXPECT().Execute(gomock.Any(), parentJobResponse.UUID, parentJobResponse.UUID, 0).Return("", fmt.Errorf("error"))
 		retrySessionJobUC.EXPECT().Execute(gomock.Any(), parentJobResponse.UUID, parentJobResponse.UUID, gomock.Any()).Return(parentJobResponse.UUID, nil).AnyTimes()
-		client.EXPECT().UpdateJob(gomock.Any(), job.UUID, gomock.Any()).Return(nil, nil)
 		sessionManager.Start(ctx, job)
 
 		<-ctx.Done()
@@ -223,4 +227,107 @@ func TestSessionManager(t *testing.T) {
 
 		<-ctx.Done()
 	})
+
+	t.Run("should persist session state and release lease when interrupted", func(t *testing.T) {
+		timeout := retryInterval + 500*time.Millisecond
+		ctx, _ := context.WithTimeout(context.Background(), timeout)
+		job := testutils.FakeJob()
+		job.InternalData.RetryInterval = retryInterval
+		childJobUUID := "childJobUUID"
+
+		parentJobResponse := testutils.FakeJobResponse()
+		parentJobResponse.UUID = job.UUID
+		client.EXPECT().SearchJob(gomock.Any(), &entities.JobFilters{
+			ChainUUID:     job.ChainUUID,
+			ParentJobUUID: job.UUID,
+			WithLogs:      true,
+		}).Return([]*types.JobResponse{parentJobResponse}, nil)
+		retrySessionJobUC.EXPECT().Execute(gomock.Any(), parentJobResponse.UUID, parentJobResponse.UUID, 0).Return(childJobUUID, nil)
+
+		sessionManager.Start(ctx, job)
+
+		<-ctx.Done()
+		time.Sleep(100 * time.Millisecond)
+
+		ses, ok, err := sessionStore.Load(context.Background(), job.UUID)
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, 1, ses.Retries)
+		assert.Equal(t, 1, ses.NChildren)
+		assert.Equal(t, childJobUUID, ses.LastChildJobUUID)
+
+		acquired, err := sessionStore.Acquire(context.Background(), job.UUID, "other-owner", time.Minute)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+	})
+
+	t.Run("should resume persisted session from its last state", func(t *testing.T) {
+		// Sessions persisted by previous tests are not resumed
+		sessionStore = memory.NewSessionStore()
+		sessionManager = NewSessionManager(client, retrySessionJobUC, sessionStore, "owner", time.Minute)
+
+		timeout := retryInterval + 500*time.Millisecond
+		ctx, _ := context.WithTimeout(context.Background(), timeout)
+		job := testutils.FakeJob()
+		job.InternalData.RetryInterval = retryInterval
+		childJobUUID := "childJobUUID"
+
+		err := sessionStore.Save(ctx, &store.Session{
+			ParentJob:        job,
+			NChildren:        1,
+			Retries:          2,
+			LastChildJobUUID: childJobUUID,
+		})
+		require.NoError(t, err)
+
+		client.EXPECT().GetJob(gomock.Any(), job.UUID).Return(&types.JobResponse{Status: entities.StatusPending}, nil)
+		retrySessionJobUC.EXPECT().Execute(gomock.Any(), job.UUID, childJobUUID, 1).Return("", nil)
+		client.EXPECT().UpdateJob(gomock.Any(), job.UUID, gomock.Any()).Return(nil, nil)
+
+		err = sessionManager.Resume(ctx)
+		require.NoError(t, err)
+
+		<-ctx.Done()
+		time.Sleep(100 * time.Millisecond)
+
+		_, ok, err := sessionStore.Load(context.Background(), job.UUID)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("should delete persisted session of a job which is already mined", func(t *testing.T) {
+		// Sessions persisted by previous tests are not resumed
+		sessionStore = memory.NewSessionStore()
+		sessionManager = NewSessionManager(client, retrySessionJobUC, sessionStore, "owner", time.Minute)
+
+		job := testutils.FakeJob()
+		job.InternalData.RetryInterval = retryInterval
+
+		err := sessionStore.Save(context.Background(), &store.Session{ParentJob: job, NChildren: 1, Retries: 1})
+		require.NoError(t, err)
+
+		client.EXPECT().GetJob(gomock.Any(), job.UUID).Return(&types.JobResponse{Status: entities.StatusMined}, nil)
+
+		err = sessionManager.Resume(context.Background())
+		require.NoError(t, err)
+
+		_, ok, err := sessionStore.Load(context.Background(), job.UUID)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("should do nothing if session is owned by another instance", func(t *testing.T) {
+		timeout := retryInterval + 500*time.Millisecond
+		ctx, _ := context.WithTimeout(context.Background(), timeout)
+		job := testutils.FakeJob()
+		job.InternalData.RetryInterval = retryInterval
+
+		acquired, err := sessionStore.Acquire(ctx, job.UUID, "other-owner", time.Minute)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		sessionManager.Start(ctx, job)
+
+		<-ctx.Done()
+	})
 }
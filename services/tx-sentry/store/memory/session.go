@@ -0,0 +1,97 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/consensys/orchestrate/services/tx-sentry/store"
+)
+
+type lease struct {
+	owner     string
+	expiresAt time.Time
+}
+
+// sessionStore keeps sessions in memory, so sessions are only shared inside the current process
+type sessionStore struct {
+	mutex    *sync.RWMutex
+	sessions map[string]*store.Session
+	leases   map[string]*lease
+}
+
+// NewSessionStore creates a new in-memory SessionStore
+func NewSessionStore() store.SessionStore {
+	return &sessionStore{
+		mutex:    &sync.RWMutex{},
+		sessions: make(map[string]*store.Session),
+		leases:   make(map[string]*lease),
+	}
+}
+
+func (s *sessionStore) Acquire(_ context.Context, jobUUID, owner string, ttl time.Duration) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	l, ok := s.leases[jobUUID]
+	if ok && l.owner != owner && time.Now().Before(l.expiresAt) {
+		return false, nil
+	}
+
+	s.leases[jobUUID] = &lease{owner: owner, expiresAt: time.Now().Add(ttl)}
+	return true, nil
+}
+
+func (s *sessionStore) Release(_ context.Context, jobUUID, owner string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if l, ok := s.leases[jobUUID]; ok && l.owner == owner {
+		delete(s.leases, jobUUID)
+	}
+
+	return nil
+}
+
+func (s *sessionStore) Save(_ context.Context, session *store.Session) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	sess := *session
+	s.sessions[session.ParentJob.UUID] = &sess
+	return nil
+}
+
+func (s *sessionStore) Load(_ context.Context, jobUUID string) (*store.Session, bool, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	session, ok := s.sessions[jobUUID]
+	if !ok {
+		return nil, false, nil
+	}
+
+	sess := *session
+	return &sess, true, nil
+}
+
+func (s *sessionStore) Delete(_ context.Context, jobUUID string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.sessions, jobUUID)
+	return nil
+}
+
+func (s *sessionStore) List(_ context.Context) ([]*store.Session, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	sessions := make([]*store.Session, 0, len(s.sessions))
+	for _, session := range s.sessions {
+		sess := *session
+		sessions = append(sessions, &sess)
+	}
+
+	return sessions, nil
+}
@@ -0,0 +1,69 @@
+// +build unit
+
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/services/tx-sentry/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionStoreInMemory(t *testing.T) {
+	ss := NewSessionStore()
+	ctx := context.Background()
+
+	job := testutils.FakeJob()
+	session := &store.Session{
+		ParentJob:        job,
+		NChildren:        1,
+		Retries:          2,
+		LastChildJobUUID: "childJobUUID",
+	}
+
+	_, ok, err := ss.Load(ctx, job.UUID)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	err = ss.Save(ctx, session)
+	assert.NoError(t, err)
+
+	loaded, ok, err := ss.Load(ctx, job.UUID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, session, loaded)
+
+	sessions, err := ss.List(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 1)
+
+	acquired, err := ss.Acquire(ctx, job.UUID, "owner", 50*time.Millisecond)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+
+	acquired, err = ss.Acquire(ctx, job.UUID, "other-owner", time.Second)
+	assert.NoError(t, err)
+	assert.False(t, acquired)
+
+	time.Sleep(100 * time.Millisecond)
+	acquired, err = ss.Acquire(ctx, job.UUID, "other-owner", time.Second)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+
+	err = ss.Release(ctx, job.UUID, "other-owner")
+	assert.NoError(t, err)
+	acquired, err = ss.Acquire(ctx, job.UUID, "owner", time.Second)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+
+	err = ss.Delete(ctx, job.UUID)
+	assert.NoError(t, err)
+
+	sessions, err = ss.List(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 0)
+}
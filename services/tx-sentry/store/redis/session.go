@@ -0,0 +1,109 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/database/redis"
+	"github.com/consensys/orchestrate/services/tx-sentry/store"
+)
+
+const (
+	sessionsSetKey = "tx-sentry-sessions"
+	sessionSuf     = "tx-sentry-session"
+	leaseSuf       = "tx-sentry-lease"
+)
+
+// sessionStore persists sessions in Redis so they can be shared and resumed across tx-sentry replicas
+type sessionStore struct {
+	redis *redis.Client
+	ttl   time.Duration
+}
+
+// NewSessionStore creates a new Redis SessionStore. Sessions expire after ttl if never updated
+func NewSessionStore(client *redis.Client, ttl time.Duration) store.SessionStore {
+	return &sessionStore{
+		redis: client,
+		ttl:   ttl,
+	}
+}
+
+func (s *sessionStore) Acquire(_ context.Context, jobUUID, owner string, ttl time.Duration) (bool, error) {
+	return s.redis.AcquireLease(computeKey(jobUUID, leaseSuf), owner, ttl)
+}
+
+func (s *sessionStore) Release(_ context.Context, jobUUID, owner string) error {
+	return s.redis.ReleaseLease(computeKey(jobUUID, leaseSuf), owner)
+}
+
+func (s *sessionStore) Save(_ context.Context, session *store.Session) error {
+	bData, err := json.Marshal(session)
+	if err != nil {
+		return errors.EncodingError("failed to marshal session").AppendReason(err.Error())
+	}
+
+	err = s.redis.SetWithTTL(computeKey(session.ParentJob.UUID, sessionSuf), bData, s.ttl)
+	if err != nil {
+		return err
+	}
+
+	return s.redis.AddToSet(sessionsSetKey, session.ParentJob.UUID)
+}
+
+func (s *sessionStore) Load(_ context.Context, jobUUID string) (*store.Session, bool, error) {
+	bData, ok, err := s.redis.LoadBytes(computeKey(jobUUID, sessionSuf))
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	session := &store.Session{}
+	err = json.Unmarshal(bData, session)
+	if err != nil {
+		return nil, false, errors.EncodingError("failed to unmarshal session").AppendReason(err.Error())
+	}
+
+	return session, true, nil
+}
+
+func (s *sessionStore) Delete(_ context.Context, jobUUID string) error {
+	err := s.redis.Delete(computeKey(jobUUID, sessionSuf))
+	if err != nil {
+		return err
+	}
+
+	return s.redis.RemoveFromSet(sessionsSetKey, jobUUID)
+}
+
+func (s *sessionStore) List(ctx context.Context) ([]*store.Session, error) {
+	jobUUIDs, err := s.redis.LoadSet(sessionsSetKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var sessions []*store.Session
+	for _, jobUUID := range jobUUIDs {
+		session, ok, err := s.Load(ctx, jobUUID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Session state has expired, we clean the index
+		if !ok {
+			log.FromContext(ctx).WithField("job", jobUUID).Debug("removing expired session from index")
+			_ = s.redis.RemoveFromSet(sessionsSetKey, jobUUID)
+			continue
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+func computeKey(key, suffix string) string {
+	return fmt.Sprintf("%v-%v", key, suffix)
+}
@@ -0,0 +1,112 @@
+// +build unit
+
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/consensys/orchestrate/pkg/toolkit/database/redis"
+	"github.com/consensys/orchestrate/pkg/types/testutils"
+	"github.com/consensys/orchestrate/services/tx-sentry/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionStoreRedis(t *testing.T) {
+	mredis, _ := miniredis.Run()
+	defer mredis.Close()
+	conf := &redis.Config{
+		Expiration: 1,
+		Host:       mredis.Host(),
+		Port:       mredis.Port(),
+	}
+
+	pool, _ := redis.NewPool(conf)
+	ss := NewSessionStore(redis.NewClient(pool, conf), time.Hour)
+	ctx := context.Background()
+
+	job := testutils.FakeJob()
+	session := &store.Session{
+		ParentJob:        job,
+		NChildren:        1,
+		Retries:          2,
+		LastChildJobUUID: "childJobUUID",
+	}
+
+	_, ok, err := ss.Load(ctx, job.UUID)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	err = ss.Save(ctx, session)
+	assert.NoError(t, err)
+
+	loaded, ok, err := ss.Load(ctx, job.UUID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, job.UUID, loaded.ParentJob.UUID)
+	assert.Equal(t, job.InternalData.RetryInterval, loaded.ParentJob.InternalData.RetryInterval)
+	assert.Equal(t, 1, loaded.NChildren)
+	assert.Equal(t, 2, loaded.Retries)
+	assert.Equal(t, "childJobUUID", loaded.LastChildJobUUID)
+
+	sessions, err := ss.List(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 1)
+
+	acquired, err := ss.Acquire(ctx, job.UUID, "owner", time.Second)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+
+	acquired, err = ss.Acquire(ctx, job.UUID, "other-owner", time.Second)
+	assert.NoError(t, err)
+	assert.False(t, acquired)
+
+	err = ss.Release(ctx, job.UUID, "other-owner")
+	assert.NoError(t, err)
+	acquired, err = ss.Acquire(ctx, job.UUID, "other-owner", time.Second)
+	assert.NoError(t, err)
+	assert.False(t, acquired)
+
+	mredis.FastForward(2 * time.Second)
+	acquired, err = ss.Acquire(ctx, job.UUID, "other-owner", time.Second)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+
+	err = ss.Delete(ctx, job.UUID)
+	assert.NoError(t, err)
+
+	_, ok, err = ss.Load(ctx, job.UUID)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	sessions, err = ss.List(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 0)
+}
+
+func TestSessionStoreRedis_ExpiredSession(t *testing.T) {
+	mredis, _ := miniredis.Run()
+	defer mredis.Close()
+	conf := &redis.Config{
+		Expiration: 1,
+		Host:       mredis.Host(),
+		Port:       mredis.Port(),
+	}
+
+	pool, _ := redis.NewPool(conf)
+	ss := NewSessionStore(redis.NewClient(pool, conf), time.Second)
+	ctx := context.Background()
+
+	err := ss.Save(ctx, &store.Session{ParentJob: testutils.FakeJob()})
+	require.NoError(t, err)
+
+	mredis.FastForward(2 * time.Second)
+
+	sessions, err := ss.List(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 0)
+	assert.False(t, mredis.Exists(sessionsSetKey))
+}
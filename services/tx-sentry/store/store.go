@@ -0,0 +1,38 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/types/entities"
+)
+
+//go:generate mockgen -source=store.go -destination=mock/store.go -package=mock
+
+// Session is the persisted state of a job retry session
+type Session struct {
+	ParentJob        *entities.Job `json:"parentJob"`
+	NChildren        int           `json:"nChildren"`
+	Retries          int           `json:"retries"`
+	LastChildJobUUID string        `json:"lastChildJobUUID"`
+}
+
+type SessionStore interface {
+	// Acquire takes, or renews, the lease of the job session for the owner. Returns false if held by another owner
+	Acquire(ctx context.Context, jobUUID, owner string, ttl time.Duration) (bool, error)
+
+	// Release releases the lease of the job session if held by the owner
+	Release(ctx context.Context, jobUUID, owner string) error
+
+	// Save persists the session state
+	Save(ctx context.Context, session *Session) error
+
+	// Load retrieves the session state of a job
+	Load(ctx context.Context, jobUUID string) (session *Session, ok bool, err error)
+
+	// Delete removes the session state of a job
+	Delete(ctx context.Context, jobUUID string) error
+
+	// List retrieves all persisted sessions
+	List(ctx context.Context) ([]*Session, error)
+}